@@ -0,0 +1,240 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package catchup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/network"
+)
+
+// catchpointChunkPeerAttempts is the number of peers we would try to download a single chunk from
+// before giving up on the current download attempt.
+const catchpointChunkPeerAttempts = 5
+
+// errCatchpointChunkProcessing is returned when a verified chunk could not be processed.
+var errCatchpointChunkProcessing = errors.New("unable to process catchpoint chunk")
+
+// errIncompatibleManifest is returned for peers whose manifest describes a different catchpoint content.
+var errIncompatibleManifest = errors.New("peer manifest does not match the catchpoint manifest")
+
+// catchpointChunkDownloader downloads a catchpoint file chunk by chunk, as listed in its manifest. Chunks are
+// fetched in parallel from the peers returned by the peer selector, verified against the manifest and then
+// fed to the catchup accessor in order. The downloader keeps track of the chunks that were already processed,
+// so that a failed download could be resumed from the last verified chunk rather than from the beginning.
+// That progress is kept in memory only: once algod restarts, the catchpoint catchup starts over, and the
+// download starts again from the first chunk.
+type catchpointChunkDownloader struct {
+	lf          *ledgerFetcher
+	selector    peerSelector
+	round       basics.Round
+	parallelism int
+
+	// manifest is the manifest the download follows, and manifestPeer is the peer that provided it.
+	manifest     *ledger.CatchpointManifest
+	manifestPeer *peerSelectorPeer
+
+	// next is the index of the next chunk to be processed; all the chunks before it were processed.
+	next     int
+	progress ledger.CatchpointCatchupAccessorProgress
+
+	// peerManifests caches the manifests of the peers we downloaded chunks from, by peer address.
+	// A nil entry marks a peer that can't serve this catchpoint file in chunks.
+	peerManifestsMu deadlock.Mutex
+	peerManifests   map[string]*ledger.CatchpointManifest
+}
+
+func makeCatchpointChunkDownloader(lf *ledgerFetcher, selector peerSelector, round basics.Round, parallelism int) *catchpointChunkDownloader {
+	return &catchpointChunkDownloader{
+		lf:            lf,
+		selector:      selector,
+		round:         round,
+		parallelism:   parallelism,
+		peerManifests: make(map[string]*ledger.CatchpointManifest),
+	}
+}
+
+// setManifest starts a download of the catchpoint file described by the given manifest.
+func (d *catchpointChunkDownloader) setManifest(psp *peerSelectorPeer, manifest *ledger.CatchpointManifest) {
+	d.manifest = manifest
+	d.manifestPeer = psp
+	d.next = 0
+	d.progress = ledger.CatchpointCatchupAccessorProgress{}
+	d.peerManifestsMu.Lock()
+	d.peerManifests = map[string]*ledger.CatchpointManifest{peerAddress(psp.Peer): manifest}
+	d.peerManifestsMu.Unlock()
+}
+
+// reset drops the manifest and the processed chunks, so that the next download starts over.
+func (d *catchpointChunkDownloader) reset() {
+	d.manifest = nil
+	d.manifestPeer = nil
+	d.next = 0
+	d.progress = ledger.CatchpointCatchupAccessorProgress{}
+}
+
+// started returns true if some of the chunks were already processed.
+func (d *catchpointChunkDownloader) started() bool {
+	return d.manifest != nil && d.next > 0
+}
+
+type catchpointChunkResult struct {
+	index int
+	data  []byte
+	err   error
+}
+
+// download fetches and processes the chunks that were not processed yet. On failure, the chunks that were
+// processed before the failure stay processed, and the next call to download resumes from there.
+func (d *catchpointChunkDownloader) download(ctx context.Context) error {
+	chunks := d.manifest.Chunks
+	parallelism := d.parallelism
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// results is large enough to hold the result of every chunk in flight, so that the fetching goroutines never block.
+	results := make(chan catchpointChunkResult, parallelism)
+	pending := make(map[int][]byte)
+	inFlight := 0
+	nextToFetch := d.next
+	var writeDuration time.Duration
+	for d.next < len(chunks) {
+		// keep the number of chunks held in memory bounded while waiting for a slow chunk.
+		for inFlight < parallelism && nextToFetch < len(chunks) && nextToFetch-d.next < 2*parallelism {
+			wg.Add(1)
+			go func(index int) {
+				defer wg.Done()
+				data, err := d.fetchChunk(ctx, index)
+				results <- catchpointChunkResult{index: index, data: data, err: err}
+			}(nextToFetch)
+			inFlight++
+			nextToFetch++
+		}
+
+		select {
+		case res := <-results:
+			inFlight--
+			if res.err != nil {
+				return res.err
+			}
+			pending[res.index] = res.data
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		for data, ok := pending[d.next]; ok; data, ok = pending[d.next] {
+			delete(pending, d.next)
+			start := time.Now()
+			err := d.lf.processBalancesBlock(ctx, chunks[d.next].Name, data, &d.progress)
+			if err != nil {
+				return fmt.Errorf("%w %s : %v", errCatchpointChunkProcessing, chunks[d.next].Name, err)
+			}
+			writeDuration += time.Since(start)
+			d.next++
+			if d.lf.reporter != nil {
+				d.lf.reporter.updateLedgerFetcherProgress(&d.progress)
+			}
+		}
+	}
+	d.lf.log.Infof("processing %d catchpoint chunks took %d seconds", len(chunks), writeDuration/time.Second)
+	return nil
+}
+
+// fetchChunk downloads a single chunk, trying different peers until one of them provides a valid chunk.
+func (d *catchpointChunkDownloader) fetchChunk(ctx context.Context, index int) ([]byte, error) {
+	var err error
+	for attempt := 0; attempt < catchpointChunkPeerAttempts; attempt++ {
+		var psp *peerSelectorPeer
+		psp, err = d.selector.getNextPeer()
+		if err != nil {
+			return nil, err
+		}
+		httpPeer, ok := psp.Peer.(network.HTTPPeer)
+		if !ok {
+			d.selector.rankPeer(psp, peerRankDownloadFailed)
+			err = errNonHTTPPeer
+			continue
+		}
+		var manifest *ledger.CatchpointManifest
+		manifest, err = d.peerManifest(ctx, httpPeer)
+		if err == nil {
+			var data []byte
+			data, err = d.lf.getPeerChunk(ctx, httpPeer, d.round, &manifest.Chunks[index])
+			if err == nil {
+				return data, nil
+			}
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		d.lf.log.Infof("failed to download catchpoint chunk %s from peer %s: %v", d.manifest.Chunks[index].Name, peerAddress(psp.Peer), err)
+		switch {
+		case errors.Is(err, errCatchpointChunkMismatch), errors.Is(err, errIncompatibleManifest):
+			d.selector.rankPeer(psp, peerRankInvalidDownload)
+		case errors.Is(err, errNoManifestForRound), errors.Is(err, errNoLedgerForRound):
+			d.selector.rankPeer(psp, peerRankNoCatchpointForRound)
+		default:
+			d.selector.rankPeer(psp, peerRankDownloadFailed)
+		}
+	}
+	return nil, fmt.Errorf("unable to download catchpoint chunk %s : %w", d.manifest.Chunks[index].Name, err)
+}
+
+// peerManifest returns the manifest of the given peer, after checking it describes the same content as the
+// manifest the download follows. Peers may have compressed the content differently, so the chunks are
+// requested from each peer using the offsets of its own manifest.
+func (d *catchpointChunkDownloader) peerManifest(ctx context.Context, peer network.HTTPPeer) (*ledger.CatchpointManifest, error) {
+	address := peerAddress(peer)
+	d.peerManifestsMu.Lock()
+	manifest, known := d.peerManifests[address]
+	d.peerManifestsMu.Unlock()
+	if known {
+		if manifest == nil {
+			return nil, errIncompatibleManifest
+		}
+		return manifest, nil
+	}
+
+	manifest, err := d.lf.getPeerManifest(ctx, peer, d.round)
+	if err != nil {
+		return nil, err
+	}
+	if !d.manifest.Compatible(manifest) {
+		manifest = nil
+	}
+	d.peerManifestsMu.Lock()
+	d.peerManifests[address] = manifest
+	d.peerManifestsMu.Unlock()
+	if manifest == nil {
+		return nil, errIncompatibleManifest
+	}
+	return manifest, nil
+}
@@ -0,0 +1,284 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package catchup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/components/mocks"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/rpcs"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+const testChunkedCatchpointRound = basics.Round(1000)
+
+// makeTestChunkedCatchpoint lays out the given entries the way catchpoint files are repacked: every
+// tar entry in a gzip member of its own, followed by a member holding the tar trailer.
+func makeTestChunkedCatchpoint(t *testing.T, label string, entries int) ([]byte, *ledger.CatchpointManifest) {
+	var file bytes.Buffer
+	manifest := &ledger.CatchpointManifest{Round: testChunkedCatchpointRound, Catchpoint: label}
+	for i := 0; i < entries; i++ {
+		name := fmt.Sprintf("balances.%d.msgpack", i)
+		data := bytes.Repeat([]byte{byte(i)}, 1000+i)
+		offset := int64(file.Len())
+		gz := gzip.NewWriter(&file)
+		tw := tar.NewWriter(gz)
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(data))}))
+		_, err := tw.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, tw.Flush())
+		require.NoError(t, gz.Close())
+		manifest.Chunks = append(manifest.Chunks, ledger.CatchpointManifestChunk{
+			Name:   name,
+			Offset: offset,
+			Length: int64(file.Len()) - offset,
+			Size:   int64(len(data)),
+			Hash:   crypto.Hash(data),
+		})
+	}
+	gz := gzip.NewWriter(&file)
+	require.NoError(t, tar.NewWriter(gz).Close())
+	require.NoError(t, gz.Close())
+	manifest.FileSize = int64(file.Len())
+	require.NoError(t, manifest.Validate())
+	return file.Bytes(), manifest
+}
+
+type testSeekableStream struct {
+	*bytes.Reader
+}
+
+func (s testSeekableStream) Size() (int64, error) {
+	return s.Reader.Size(), nil
+}
+
+func (s testSeekableStream) Close() error {
+	return nil
+}
+
+// testChunkedLedger serves a catchpoint file and, if set, its manifest and delta files.
+type testChunkedLedger struct {
+	file     []byte
+	manifest *ledger.CatchpointManifest
+	deltas   map[basics.Round][]byte
+}
+
+func (l *testChunkedLedger) GetCatchpointStream(round basics.Round) (ledger.ReadCloseSizer, error) {
+	return testSeekableStream{bytes.NewReader(l.file)}, nil
+}
+
+func (l *testChunkedLedger) GetCatchpointManifest(round basics.Round) (ledger.CatchpointManifest, error) {
+	if l.manifest == nil {
+		return ledger.CatchpointManifest{}, ledgercore.ErrNoEntry{Round: round}
+	}
+	return *l.manifest, nil
+}
+
+func (l *testChunkedLedger) GetCatchpointDeltaStream(round basics.Round) (ledger.ReadCloseSizer, error) {
+	delta, ok := l.deltas[round]
+	if !ok {
+		return nil, ledgercore.ErrNoEntry{Round: round}
+	}
+	return testSeekableStream{bytes.NewReader(delta)}, nil
+}
+
+type testLedgerServiceRouter struct {
+	*mux.Router
+}
+
+func (r testLedgerServiceRouter) RegisterHTTPHandler(path string, handler http.Handler) {
+	r.Handle(path, handler)
+}
+
+// startTestLedgerService runs a ledger service for the given ledger, optionally wrapping its handler.
+func startTestLedgerService(t *testing.T, l rpcs.LedgerForService, wrap func(http.Handler) http.Handler) *testHTTPPeer {
+	router := testLedgerServiceRouter{mux.NewRouter()}
+	cfg := config.GetDefaultLocal()
+	cfg.EnableLedgerService = true
+	ledgerService := rpcs.MakeLedgerService(cfg, l, router, (&mocks.MockNetwork{}).GetGenesisID())
+	ledgerService.Start()
+	t.Cleanup(ledgerService.Stop)
+
+	var handler http.Handler = router
+	if wrap != nil {
+		handler = wrap(router)
+	}
+	listener, err := net.Listen("tcp", "localhost:")
+	require.NoError(t, err)
+	s := &http.Server{Handler: handler}
+	go s.Serve(listener)
+	t.Cleanup(func() { s.Close() })
+	peer := testHTTPPeer(listener.Addr().String())
+	return &peer
+}
+
+// chunkRecordingAccessor records the sections it was asked to process.
+type chunkRecordingAccessor struct {
+	mocks.MockCatchpointCatchupAccessor
+	sections []string
+}
+
+func (a *chunkRecordingAccessor) ProcessStagingBalances(ctx context.Context, sectionName string, bytes []byte, progress *ledger.CatchpointCatchupAccessorProgress) error {
+	a.sections = append(a.sections, sectionName)
+	progress.ProcessedBytes += uint64(len(bytes))
+	return nil
+}
+
+// roundRobinPeerSelector hands out the given peers in turn and records the ranks they were given. Like
+// the catchpoint peer selector, it stops handing out peers once they provided invalid data.
+type roundRobinPeerSelector struct {
+	peers []*testHTTPPeer
+	next  atomic.Uint64
+
+	mu    sync.Mutex
+	ranks map[string][]int
+}
+
+func (s *roundRobinPeerSelector) rankPeer(psp *peerSelectorPeer, rank int) (int, int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ranks[peerAddress(psp.Peer)] = append(s.ranks[peerAddress(psp.Peer)], rank)
+	return -1, rank
+}
+
+func (s *roundRobinPeerSelector) peerDownloadDurationToRank(psp *peerSelectorPeer, blockDownloadDuration time.Duration) (rank int) {
+	return peerRankInitialFirstPriority
+}
+
+func (s *roundRobinPeerSelector) getNextPeer() (psp *peerSelectorPeer, err error) {
+	for range s.peers {
+		peer := s.peers[(s.next.Add(1)-1)%uint64(len(s.peers))]
+		if !slices.Contains(s.getRanks(peer), peerRankInvalidDownload) {
+			return &peerSelectorPeer{Peer: peer}, nil
+		}
+	}
+	return nil, errPeerSelectorNoPeerPoolsAvailable
+}
+
+func (s *roundRobinPeerSelector) getRanks(peer *testHTTPPeer) []int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ranks[peer.GetAddress()]
+}
+
+func TestCatchpointChunkDownloaderMultiplePeers(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	file, manifest := makeTestChunkedCatchpoint(t, "1000#label", 10)
+	good := startTestLedgerService(t, &testChunkedLedger{file: file, manifest: manifest}, nil)
+	other := startTestLedgerService(t, &testChunkedLedger{file: file, manifest: manifest}, nil)
+
+	// a peer that serves corrupted chunks.
+	corrupted := bytes.Clone(file)
+	for _, chunk := range manifest.Chunks {
+		corrupted[chunk.Offset+chunk.Length/2] ^= 0xff
+	}
+	bad := startTestLedgerService(t, &testChunkedLedger{file: corrupted, manifest: manifest}, nil)
+
+	// a peer that has the same catchpoint, but a manifest with different content.
+	_, otherManifest := makeTestChunkedCatchpoint(t, "1000#label", 9)
+	incompatible := startTestLedgerService(t, &testChunkedLedger{file: file, manifest: otherManifest}, nil)
+
+	// a peer that only serves the catchpoint file as a whole.
+	legacy := startTestLedgerService(t, &testChunkedLedger{file: file}, nil)
+
+	selector := &roundRobinPeerSelector{peers: []*testHTTPPeer{good, bad, incompatible, legacy, other}, ranks: make(map[string][]int)}
+	accessor := &chunkRecordingAccessor{}
+	lf := makeLedgerFetcher(&mocks.MockNetwork{}, accessor, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, config.GetDefaultLocal())
+	d := makeCatchpointChunkDownloader(lf, selector, testChunkedCatchpointRound, 3)
+	d.setManifest(&peerSelectorPeer{Peer: good}, manifest)
+
+	require.NoError(t, d.download(context.Background()))
+	require.Len(t, accessor.sections, len(manifest.Chunks))
+	for i, chunk := range manifest.Chunks {
+		require.Equal(t, chunk.Name, accessor.sections[i])
+	}
+	require.Equal(t, len(manifest.Chunks), d.next)
+
+	require.Empty(t, selector.getRanks(good))
+	require.Empty(t, selector.getRanks(other))
+	require.Contains(t, selector.getRanks(bad), peerRankInvalidDownload)
+	require.Contains(t, selector.getRanks(incompatible), peerRankInvalidDownload)
+	require.Contains(t, selector.getRanks(legacy), peerRankNoCatchpointForRound)
+}
+
+func TestCatchpointChunkDownloaderResume(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	file, manifest := makeTestChunkedCatchpoint(t, "1000#label", 8)
+	const failingChunk = 5
+	failingRange := fmt.Sprintf("bytes=%d-%d", manifest.Chunks[failingChunk].Offset, manifest.Chunks[failingChunk].Offset+manifest.Chunks[failingChunk].Length-1)
+	var failing atomic.Bool
+	failing.Store(true)
+	var requests atomic.Int32
+	peer := startTestLedgerService(t, &testChunkedLedger{file: file, manifest: manifest}, func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Range") != "" {
+				requests.Add(1)
+				if failing.Load() && r.Header.Get("Range") == failingRange {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	})
+
+	selector := &roundRobinPeerSelector{peers: []*testHTTPPeer{peer}, ranks: make(map[string][]int)}
+	accessor := &chunkRecordingAccessor{}
+	lf := makeLedgerFetcher(&mocks.MockNetwork{}, accessor, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, config.GetDefaultLocal())
+	d := makeCatchpointChunkDownloader(lf, selector, testChunkedCatchpointRound, 2)
+	d.setManifest(&peerSelectorPeer{Peer: peer}, manifest)
+
+	// the download stops at the chunk that can't be retrieved, after processing all the chunks before it.
+	err := d.download(context.Background())
+	require.ErrorContains(t, err, manifest.Chunks[failingChunk].Name)
+	require.Equal(t, failingChunk, d.next)
+	require.True(t, d.started())
+	require.Len(t, accessor.sections, failingChunk)
+	require.Contains(t, selector.getRanks(peer), peerRankDownloadFailed)
+
+	// the next download resumes from the failed chunk, and doesn't download the processed chunks again.
+	failing.Store(false)
+	requests.Store(0)
+	require.NoError(t, d.download(context.Background()))
+	require.Equal(t, int32(len(manifest.Chunks)-failingChunk), requests.Load())
+	require.Len(t, accessor.sections, len(manifest.Chunks))
+	for i, chunk := range manifest.Chunks {
+		require.Equal(t, chunk.Name, accessor.sections[i])
+	}
+}
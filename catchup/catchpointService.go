@@ -17,9 +17,11 @@
 package catchup
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"sync"
 	"time"
 
@@ -100,6 +102,9 @@ type CatchpointCatchupService struct {
 	abortCtxFunc context.CancelFunc
 	// blocksDownloadPeerSelector is the peer selector used for downloading blocks.
 	blocksDownloadPeerSelector peerSelector
+	// catchpointFile is the local catchpoint file the ledger is loaded from, or an empty string when the catchpoint
+	// file is downloaded from peers.
+	catchpointFile string
 }
 
 // MakeResumedCatchpointCatchupService creates a catchpoint catchup service for a node that is already in catchpoint catchup mode
@@ -129,8 +134,9 @@ func MakeResumedCatchpointCatchupService(ctx context.Context, node CatchpointCat
 	return service, nil
 }
 
-// MakeNewCatchpointCatchupService creates a new catchpoint catchup service for a node that is not in catchpoint catchup mode
-func MakeNewCatchpointCatchupService(catchpoint string, node CatchpointCatchupNodeServices, log logging.Logger, net network.GossipNode, accessor ledger.CatchpointCatchupAccessor, cfg config.Local) (service *CatchpointCatchupService, err error) {
+// MakeNewCatchpointCatchupService creates a new catchpoint catchup service for a node that is not in catchpoint catchup mode.
+// When catchpointFile is provided, the ledger is loaded from that local catchpoint file rather than downloaded from peers.
+func MakeNewCatchpointCatchupService(catchpoint string, catchpointFile string, node CatchpointCatchupNodeServices, log logging.Logger, net network.GossipNode, accessor ledger.CatchpointCatchupAccessor, cfg config.Local) (service *CatchpointCatchupService, err error) {
 	if catchpoint == "" {
 		return nil, fmt.Errorf("MakeNewCatchpointCatchupService: catchpoint is invalid")
 	}
@@ -147,6 +153,7 @@ func MakeNewCatchpointCatchupService(catchpoint string, node CatchpointCatchupNo
 		net:            net,
 		ledger:         accessor.Ledger(),
 		config:         cfg,
+		catchpointFile: catchpointFile,
 	}
 	l := accessor.Ledger()
 	service.lastBlockHeader, err = l.BlockHdr(l.Latest())
@@ -242,7 +249,7 @@ func (cs *CatchpointCatchupService) run() {
 	}
 }
 
-// loadStateVariables loads the current stage and catchpoint label from disk. It's used only in the case of catchpoint catchup recovery.
+// loadStateVariables loads the current stage, catchpoint label and catchpoint file from disk. It's used only in the case of catchpoint catchup recovery.
 // ( i.e. the node never completed the catchup, and the node was shutdown )
 func (cs *CatchpointCatchupService) loadStateVariables(ctx context.Context) (err error) {
 	var label string
@@ -258,6 +265,10 @@ func (cs *CatchpointCatchupService) loadStateVariables(ctx context.Context) (err
 	if err != nil {
 		return err
 	}
+	cs.catchpointFile, err = cs.ledgerAccessor.GetCatchpointFile(ctx)
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -271,6 +282,10 @@ func (cs *CatchpointCatchupService) processStageInactive() (err error) {
 	if err != nil {
 		return cs.abort(fmt.Errorf("processStageInactive failed to set a catchpoint label : %v", err))
 	}
+	err = cs.ledgerAccessor.SetCatchpointFile(cs.ctx, cs.catchpointFile)
+	if err != nil {
+		return cs.abort(fmt.Errorf("processStageInactive failed to set a catchpoint file : %v", err))
+	}
 
 	err = cs.updateStage(ledger.CatchpointCatchupStateLedgerDownload)
 	if err != nil {
@@ -296,40 +311,99 @@ func (cs *CatchpointCatchupService) processStageLedgerDownload() error {
 
 	// download balances file.
 	lf := makeLedgerFetcher(cs.net, cs.ledgerAccessor, cs.log, cs, cs.config)
+	chunks := makeCatchpointChunkDownloader(lf, cs.blocksDownloadPeerSelector, round, cs.config.CatchupLedgerDownloadParallelism)
 	attemptsCount := 0
 
-	for {
-		attemptsCount++
+	// a copy of the catchpoint file is kept as the base of the catchpoint delta files applied by the next catchup.
+	keptFile := ""
+	if cs.config.CatchupMaxCatchpointDeltas > 0 && cs.config.CatchpointInterval > 0 {
+		keptFile = cs.ledgerAccessor.GetKeptCatchpointFile()
+	}
+	if keptFile != "" {
+		// drop the copy recorded by an earlier catchup that never got to verify it.
+		os.Remove(catchpointRecordingPath(keptFile))
+	}
+	defer lf.stopRecording()
 
-		err0 := cs.ledgerAccessor.ResetStagingBalances(cs.ctx, true)
+	ledgerStaged := false
+	if cs.catchpointFile != "" {
+		// the ledger is loaded from the catchpoint file we were given, without falling back to peers.
+		err0 := cs.processLedgerFile(lf, label)
 		if err0 != nil {
 			if cs.ctx.Err() != nil {
 				return cs.stopOrAbort()
 			}
-			return cs.abort(fmt.Errorf("processStageLedgerDownload failed to reset staging balances : %v", err0))
+			return cs.abort(fmt.Errorf("processStageLedgerDownload failed to load catchpoint file %s : %v", cs.catchpointFile, err0))
 		}
-		psp, err0 := cs.blocksDownloadPeerSelector.getNextPeer()
-		if err0 != nil {
-			err0 = fmt.Errorf("processStageLedgerDownload: catchpoint catchup was unable to obtain a list of peers to retrieve the catchpoint file from")
-			return cs.abort(err0)
+		ledgerStaged = true
+	} else if keptFile != "" {
+		// when the catchpoint file kept from an earlier catchup is recent enough, try bringing it up to date with the
+		// delta files of the following catchpoints before falling back to downloading the whole catchpoint file.
+		err0 := cs.processLedgerDeltas(lf, round, label, keptFile)
+		if err0 == nil {
+			ledgerStaged = true
+		} else {
+			if cs.ctx.Err() != nil {
+				return cs.stopOrAbort()
+			}
+			cs.log.Infof("unable to catch up using catchpoint delta files, downloading the catchpoint file instead : %v", err0)
+		}
+	}
+
+	for !ledgerStaged {
+		attemptsCount++
+
+		// a chunked download that was interrupted resumes from the last processed chunk, on top of the
+		// staging balances it has already written.
+		if !chunks.started() {
+			err0 := cs.ledgerAccessor.ResetStagingBalances(cs.ctx, true)
+			if err0 != nil {
+				if cs.ctx.Err() != nil {
+					return cs.stopOrAbort()
+				}
+				return cs.abort(fmt.Errorf("processStageLedgerDownload failed to reset staging balances : %v", err0))
+			}
+			if keptFile != "" {
+				lf.startRecording(keptFile)
+			}
+		}
+		psp := chunks.manifestPeer
+		if psp == nil {
+			var err0 error
+			psp, err0 = cs.blocksDownloadPeerSelector.getNextPeer()
+			if err0 != nil {
+				err0 = fmt.Errorf("processStageLedgerDownload: catchpoint catchup was unable to obtain a list of peers to retrieve the catchpoint file from")
+				return cs.abort(err0)
+			}
 		}
 		peer := psp.Peer
 		start := time.Now()
-		err0 = lf.downloadLedger(cs.ctx, peer, round)
+		err0 := cs.downloadLedger(lf, chunks, psp, round, label)
 		if err0 == nil {
 			cs.log.Infof("ledger downloaded from %s in %d seconds", peerAddress(peer), time.Since(start)/time.Second)
 			start = time.Now()
 			err0 = cs.ledgerAccessor.BuildMerkleTrie(cs.ctx, cs.updateVerifiedCounts)
 			if err0 == nil {
 				cs.log.Infof("built merkle trie in %d seconds", time.Since(start)/time.Second)
+				lf.finishRecording()
 				break
 			}
 			// failed to build the merkle trie for the above catchpoint file.
 			cs.log.Infof("failed to build merkle trie for catchpoint file from %s: %v", peerAddress(peer), err0)
 			cs.blocksDownloadPeerSelector.rankPeer(psp, peerRankInvalidDownload)
+			chunks.reset()
 		} else {
 			cs.log.Infof("failed to download catchpoint ledger from peer %s: %v", peerAddress(peer), err0)
-			cs.blocksDownloadPeerSelector.rankPeer(psp, peerRankDownloadFailed)
+			switch {
+			case chunks.manifest == nil:
+				cs.blocksDownloadPeerSelector.rankPeer(psp, peerRankDownloadFailed)
+			case errors.Is(err0, errCatchpointChunkProcessing):
+				// the chunks matched the manifest, so it is the manifest that can't be trusted.
+				cs.blocksDownloadPeerSelector.rankPeer(psp, peerRankInvalidDownload)
+				chunks.reset()
+			}
+			// otherwise, the peers that failed to provide a chunk were already ranked, and the next attempt
+			// resumes from the last processed chunk.
 		}
 
 		// instead of testing for err == cs.ctx.Err() , we'll check on the context itself.
@@ -353,6 +427,207 @@ func (cs *CatchpointCatchupService) processStageLedgerDownload() error {
 	return nil
 }
 
+// downloadLedger downloads the catchpoint file for the given round. When the peer provides a manifest for
+// the catchpoint file, the file is downloaded in verified chunks, possibly from multiple peers. Otherwise,
+// the file is downloaded from the peer as a whole.
+func (cs *CatchpointCatchupService) downloadLedger(lf *ledgerFetcher, chunks *catchpointChunkDownloader, psp *peerSelectorPeer, round basics.Round, label string) error {
+	if chunks.manifest == nil && cs.config.CatchupLedgerDownloadParallelism > 0 {
+		if httpPeer, ok := psp.Peer.(network.HTTPPeer); ok {
+			manifest, err := lf.getPeerManifest(cs.ctx, httpPeer, round)
+			if err == nil {
+				if manifest.Catchpoint != label {
+					return fmt.Errorf("peer provided a manifest for catchpoint %s rather than %s", manifest.Catchpoint, label)
+				}
+				chunks.setManifest(psp, manifest)
+			} else {
+				cs.log.Infof("downloading the catchpoint file from %s as a whole: %v", peerAddress(psp.Peer), err)
+			}
+		}
+	}
+	if chunks.manifest == nil {
+		return lf.downloadLedger(cs.ctx, psp.Peer, round)
+	}
+	return chunks.download(cs.ctx)
+}
+
+// processLedgerFile stages the balances of the catchpoint out of the local catchpoint file, and builds their merkle trie.
+func (cs *CatchpointCatchupService) processLedgerFile(lf *ledgerFetcher, label string) error {
+	err := cs.ledgerAccessor.ResetStagingBalances(cs.ctx, true)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	var progress ledger.CatchpointCatchupAccessorProgress
+	err = lf.loadLedgerFile(cs.ctx, cs.catchpointFile, label, &progress)
+	if err != nil {
+		return err
+	}
+	cs.log.Infof("ledger loaded from %s in %d seconds", cs.catchpointFile, time.Since(start)/time.Second)
+	start = time.Now()
+	err = cs.ledgerAccessor.BuildMerkleTrie(cs.ctx, cs.updateVerifiedCounts)
+	if err != nil {
+		return err
+	}
+	cs.log.Infof("built merkle trie in %d seconds", time.Since(start)/time.Second)
+	return nil
+}
+
+// processLedgerDeltas stages the balances of the catchpoint for the given round out of the catchpoint file kept from
+// an earlier catchup, followed by the delta files of each of the catchpoints in between. Each delta file is verified
+// as it is applied, by rebuilding the merkle trie and checking the resulting balances against the catchpoint label
+// its header states, so that a peer providing an invalid delta file is ranked down and the delta file is retried
+// from another peer. Any error leaves the staging balances to be reset by the caller.
+func (cs *CatchpointCatchupService) processLedgerDeltas(lf *ledgerFetcher, round basics.Round, label string, keptFile string) error {
+	interval := basics.Round(cs.config.CatchpointInterval)
+	if round%interval != 0 {
+		return fmt.Errorf("catchpoint round %d is not a multiple of the catchpoint interval %d", round, interval)
+	}
+	baseLabel, err := readCatchpointFileLabel(keptFile)
+	if err != nil {
+		return fmt.Errorf("no catchpoint file was kept from an earlier catchup : %w", err)
+	}
+	baseRound, _, err := ledgercore.ParseCatchpointLabel(baseLabel)
+	if err != nil {
+		return fmt.Errorf("the catchpoint file kept from an earlier catchup has an invalid label : %w", err)
+	}
+	if baseRound >= round || (round-baseRound)%interval != 0 || (round-baseRound)/interval > basics.Round(cs.config.CatchupMaxCatchpointDeltas) {
+		return fmt.Errorf("the catchpoint file kept from an earlier catchup is for round %d, which is not within %d catchpoints before round %d", baseRound, cs.config.CatchupMaxCatchpointDeltas, round)
+	}
+
+	start := time.Now()
+	var progress ledger.CatchpointCatchupAccessorProgress
+	// deltas holds the verified delta files, which are applied again on top of the base if a later one is invalid.
+	var deltas [][]byte
+	err = cs.stageLedgerDeltas(lf, keptFile, baseLabel, deltas, &progress)
+	if err != nil {
+		return fmt.Errorf("unable to process the catchpoint file kept for round %d : %w", baseRound, err)
+	}
+	invalidDeltas := 0
+	for deltaRound := baseRound + interval; deltaRound <= round; {
+		delta, psp, err := cs.downloadLedgerDelta(lf, deltaRound)
+		if err != nil {
+			return err
+		}
+		expectedLabel := ""
+		if deltaRound == round {
+			expectedLabel = label
+		}
+		err = cs.applyLedgerDelta(lf, delta, deltaRound, expectedLabel, &progress)
+		if err == nil {
+			deltas = append(deltas, delta)
+			deltaRound += interval
+			continue
+		}
+		if cs.ctx.Err() != nil {
+			return cs.ctx.Err()
+		}
+		cs.log.Infof("catchpoint delta file for round %d from peer %s is invalid : %v", deltaRound, peerAddress(psp.Peer), err)
+		cs.blocksDownloadPeerSelector.rankPeer(psp, peerRankInvalidDownload)
+		invalidDeltas++
+		if invalidDeltas >= catchpointChunkPeerAttempts {
+			return fmt.Errorf("unable to obtain a valid catchpoint delta file for round %d : %w", deltaRound, err)
+		}
+		// the invalid delta file might have been partially applied, so the verified ones are staged again.
+		err = cs.stageLedgerDeltas(lf, keptFile, baseLabel, deltas, &progress)
+		if err != nil {
+			return err
+		}
+	}
+	cs.log.Infof("catchpoint %d staged from kept catchpoint %d and %d delta files in %d seconds", round, baseRound, len(deltas), time.Since(start)/time.Second)
+	return nil
+}
+
+// stageLedgerDeltas stages the balances of the kept catchpoint file, followed by the given verified delta files.
+func (cs *CatchpointCatchupService) stageLedgerDeltas(lf *ledgerFetcher, keptFile string, baseLabel string, deltas [][]byte, progress *ledger.CatchpointCatchupAccessorProgress) error {
+	*progress = ledger.CatchpointCatchupAccessorProgress{}
+	err := cs.ledgerAccessor.ResetStagingBalances(cs.ctx, true)
+	if err != nil {
+		return err
+	}
+	err = lf.loadLedgerFile(cs.ctx, keptFile, baseLabel, progress)
+	if err != nil {
+		return err
+	}
+	for _, delta := range deltas {
+		err = lf.processLedgerStream(cs.ctx, bytes.NewReader(delta), progress)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyLedgerDelta applies the catchpoint delta file for the given round on top of the staged balances, and verifies
+// that the resulting balances match the catchpoint label stated by its header. When expectedLabel is provided, the
+// delta file has to lead to that catchpoint.
+func (cs *CatchpointCatchupService) applyLedgerDelta(lf *ledgerFetcher, delta []byte, deltaRound basics.Round, expectedLabel string, progress *ledger.CatchpointCatchupAccessorProgress) error {
+	err := lf.processLedgerStream(cs.ctx, bytes.NewReader(delta), progress)
+	if err != nil {
+		return err
+	}
+	labelRound, _, err := ledgercore.ParseCatchpointLabel(progress.Catchpoint)
+	if err != nil {
+		return err
+	}
+	if labelRound != deltaRound {
+		return fmt.Errorf("the delta file leads to catchpoint %s rather than to a catchpoint for round %d", progress.Catchpoint, deltaRound)
+	}
+	if expectedLabel != "" && progress.Catchpoint != expectedLabel {
+		return fmt.Errorf("the delta file leads to catchpoint %s rather than %s", progress.Catchpoint, expectedLabel)
+	}
+
+	err = cs.ledgerAccessor.BuildMerkleTrie(cs.ctx, cs.updateVerifiedCounts)
+	if err != nil {
+		return err
+	}
+	balancesHash, spVerificationHash, onlineAccountsHash, onlineRoundParamsHash, totals, err := cs.ledgerAccessor.GetVerifyData(cs.ctx)
+	if err != nil {
+		return err
+	}
+	stagedLabel, err := ledger.MakeCatchpointLabel(progress.Version, deltaRound, progress.BlockHeaderDigest, balancesHash, spVerificationHash, onlineAccountsHash, onlineRoundParamsHash, totals)
+	if err != nil {
+		return err
+	}
+	if stagedLabel != progress.Catchpoint {
+		return fmt.Errorf("the delta file leads to mismatching balances; expected %s, calculated %s", progress.Catchpoint, stagedLabel)
+	}
+	return nil
+}
+
+// downloadLedgerDelta downloads the catchpoint delta file for the given round, trying different peers until one of
+// them provides it, and returns it along with the peer that provided it.
+func (cs *CatchpointCatchupService) downloadLedgerDelta(lf *ledgerFetcher, round basics.Round) ([]byte, *peerSelectorPeer, error) {
+	var err error
+	for attempt := 0; attempt < catchpointChunkPeerAttempts; attempt++ {
+		var psp *peerSelectorPeer
+		psp, err = cs.blocksDownloadPeerSelector.getNextPeer()
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to download catchpoint delta file for round %d : %w", round, err)
+		}
+		httpPeer, ok := psp.Peer.(network.HTTPPeer)
+		if !ok {
+			cs.blocksDownloadPeerSelector.rankPeer(psp, peerRankDownloadFailed)
+			err = errNonHTTPPeer
+			continue
+		}
+		var delta []byte
+		delta, err = lf.getPeerLedgerDelta(cs.ctx, httpPeer, round)
+		if err == nil {
+			return delta, psp, nil
+		}
+		if cs.ctx.Err() != nil {
+			return nil, nil, cs.ctx.Err()
+		}
+		cs.log.Infof("failed to download catchpoint delta file for round %d from peer %s: %v", round, peerAddress(psp.Peer), err)
+		if errors.Is(err, errNoDeltaForRound) {
+			cs.blocksDownloadPeerSelector.rankPeer(psp, peerRankNoCatchpointForRound)
+		} else {
+			cs.blocksDownloadPeerSelector.rankPeer(psp, peerRankDownloadFailed)
+		}
+	}
+	return nil, nil, fmt.Errorf("unable to download catchpoint delta file for round %d : %w", round, err)
+}
+
 // updateVerifiedCounts update the user's statistics for the given verified hashes
 func (cs *CatchpointCatchupService) updateVerifiedCounts(accountCount, kvCount uint64) {
 	cs.statsMu.Lock()
@@ -453,6 +728,13 @@ func (cs *CatchpointCatchupService) processStageLatestBlockDownload() (err error
 			}
 			return cs.abort(fmt.Errorf("processStageLatestBlockDownload failed when calling VerifyCatchpoint : %v", err))
 		}
+		// the copy of the catchpoint file recorded while downloading it can now be kept.
+		if keptFile := cs.ledgerAccessor.GetKeptCatchpointFile(); keptFile != "" {
+			err0 := keepCatchpointRecording(keptFile)
+			if err0 != nil {
+				cs.log.Warnf("processStageLatestBlockDownload: unable to keep a copy of the catchpoint file : %v", err0)
+			}
+		}
 		if psp != nil {
 			// give a rank to the download, as the download was successful.
 			// if the block might have been retrieved from the local ledger, nothing to rank
@@ -17,13 +17,20 @@
 package catchup
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
 	"context"
+	"os"
+	"path/filepath"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/algorand/go-algorand/agreement"
 	"github.com/algorand/go-algorand/components/mocks"
+	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
@@ -67,6 +74,10 @@ func (l *catchpointCatchupLedger) Latest() (rnd basics.Round) {
 	return
 }
 
+func (l *catchpointCatchupLedger) GetCatchpointStream(round basics.Round) (ledger.ReadCloseSizer, error) {
+	return nil, ledgercore.ErrNoEntry{Round: round}
+}
+
 type catchpointCatchupAccessorMock struct {
 	mocks.MockCatchpointCatchupAccessor
 	l *catchpointCatchupLedger
@@ -160,3 +171,120 @@ func TestProcessStageBlocksDownloadNilCert(t *testing.T) {
 	err = cs.processStageBlocksDownload()
 	require.NoError(t, err)
 }
+
+// deltaRecordingAccessor records the sections it was asked to process, and takes the labels stated by the catchpoint
+// header and the content of the delta headers as the label of the catchpoint they lead to.
+type deltaRecordingAccessor struct {
+	chunkRecordingAccessor
+}
+
+func (a *deltaRecordingAccessor) ProcessStagingBalances(ctx context.Context, sectionName string, bytes []byte, progress *ledger.CatchpointCatchupAccessorProgress) error {
+	switch sectionName {
+	case ledger.CatchpointContentFileName:
+		var header ledger.CatchpointFileHeader
+		if err := protocol.Decode(bytes, &header); err != nil {
+			return err
+		}
+		progress.Catchpoint = header.Catchpoint
+	case ledger.CatchpointDeltaContentFileName:
+		progress.Catchpoint = string(bytes)
+	}
+	return a.chunkRecordingAccessor.ProcessStagingBalances(ctx, sectionName, bytes, progress)
+}
+
+// makeTestCatchpointDelta lays out a catchpoint delta file leading to the given label.
+func makeTestCatchpointDelta(t *testing.T, label string) []byte {
+	var file bytes.Buffer
+	gz := gzip.NewWriter(&file)
+	tw := tar.NewWriter(gz)
+	for _, entry := range []struct {
+		name string
+		data []byte
+	}{{ledger.CatchpointDeltaContentFileName, []byte(label)}, {"deltachunk.0.msgpack", []byte("chunk")}} {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: entry.name, Mode: 0600, Size: int64(len(entry.data))}))
+		_, err := tw.Write(entry.data)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return file.Bytes()
+}
+
+func TestCatchpointServiceLedgerDeltas(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// the mocked accessor yields empty verify data, so these are the labels of the staged balances.
+	makeLabel := func(round basics.Round, blockDigest crypto.Digest) string {
+		label, err := ledger.MakeCatchpointLabel(0, round, blockDigest, crypto.Digest{}, crypto.Digest{}, crypto.Digest{}, crypto.Digest{}, ledgercore.AccountTotals{})
+		require.NoError(t, err)
+		return label
+	}
+	label := makeLabel(testChunkedCatchpointRound, crypto.Digest{})
+
+	// the catchpoint file kept from an earlier catchup.
+	keptFile := filepath.Join(t.TempDir(), "catchup.catchpoint")
+	writeTestCatchpointFile(t, keptFile, true, map[string][]byte{
+		ledger.CatchpointContentFileName: protocol.Encode(&ledger.CatchpointFileHeader{BlocksRound: 800, Catchpoint: makeLabel(800, crypto.Digest{})}),
+		"balances.0.msgpack":             bytes.Repeat([]byte{0}, 100),
+	}, []string{ledger.CatchpointContentFileName, "balances.0.msgpack"})
+
+	deltas := map[basics.Round][]byte{
+		900:  makeTestCatchpointDelta(t, makeLabel(900, crypto.Digest{})),
+		1000: makeTestCatchpointDelta(t, label),
+	}
+	missing := startTestLedgerService(t, &testChunkedLedger{}, nil)
+	good := startTestLedgerService(t, &testChunkedLedger{deltas: deltas}, nil)
+	// a peer whose delta file states a label the balances it leads to don't match.
+	invalid := startTestLedgerService(t, &testChunkedLedger{deltas: map[basics.Round][]byte{
+		900:  makeTestCatchpointDelta(t, makeLabel(900, crypto.Digest{1})),
+		1000: deltas[1000],
+	}}, nil)
+
+	cfg := config.GetDefaultLocal()
+	cfg.CatchpointInterval = 100
+	cfg.CatchupMaxCatchpointDeltas = 3
+	makeService := func(peers ...*testHTTPPeer) (*CatchpointCatchupService, *ledgerFetcher, *deltaRecordingAccessor, *roundRobinPeerSelector) {
+		accessor := &deltaRecordingAccessor{}
+		selector := &roundRobinPeerSelector{peers: peers, ranks: make(map[string][]int)}
+		cs := &CatchpointCatchupService{
+			ctx:                        context.Background(),
+			ledgerAccessor:             accessor,
+			ledger:                     &catchpointCatchupLedger{},
+			log:                        logging.TestingLog(t),
+			config:                     cfg,
+			blocksDownloadPeerSelector: selector,
+		}
+		return cs, makeLedgerFetcher(&mocks.MockNetwork{}, accessor, cs.log, cs, cfg), accessor, selector
+	}
+	baseSections := []string{ledger.CatchpointContentFileName, "balances.0.msgpack"}
+	deltaSections := []string{ledger.CatchpointDeltaContentFileName, "deltachunk.0.msgpack"}
+
+	cs, lf, accessor, selector := makeService(missing, good)
+	require.NoError(t, cs.processLedgerDeltas(lf, testChunkedCatchpointRound, label, keptFile))
+	require.Equal(t, slices.Concat(baseSections, deltaSections, deltaSections), accessor.sections)
+	require.Contains(t, selector.getRanks(missing), peerRankNoCatchpointForRound)
+	require.Empty(t, selector.getRanks(good))
+
+	// an invalid delta file is retried from the next peer, on top of the balances staged again.
+	cs, lf, accessor, selector = makeService(invalid, good)
+	require.NoError(t, cs.processLedgerDeltas(lf, testChunkedCatchpointRound, label, keptFile))
+	require.Equal(t, slices.Concat(baseSections, deltaSections, baseSections, deltaSections, deltaSections), accessor.sections)
+	require.Equal(t, []int{peerRankInvalidDownload}, selector.getRanks(invalid))
+	require.Empty(t, selector.getRanks(good))
+
+	// delta files leading to another catchpoint are rejected.
+	cs, lf, _, selector = makeService(good)
+	require.Error(t, cs.processLedgerDeltas(lf, testChunkedCatchpointRound, makeLabel(testChunkedCatchpointRound, crypto.Digest{1}), keptFile))
+	require.Equal(t, []int{peerRankInvalidDownload}, selector.getRanks(good))
+
+	// the kept catchpoint file is too old to catch up using delta files.
+	cs, lf, accessor, _ = makeService(good)
+	cs.config.CatchupMaxCatchpointDeltas = 1
+	require.ErrorContains(t, cs.processLedgerDeltas(lf, testChunkedCatchpointRound, label, keptFile), "not within")
+	require.Empty(t, accessor.sections)
+
+	// no catchpoint file was kept.
+	cs, lf, accessor, _ = makeService(good)
+	require.ErrorIs(t, cs.processLedgerDeltas(lf, testChunkedCatchpointRound, label, filepath.Join(t.TempDir(), "missing")), os.ErrNotExist)
+	require.Empty(t, accessor.sections)
+}
@@ -18,26 +18,45 @@ package catchup
 
 import (
 	"archive/tar"
+	"bytes"
+	"compress/gzip"
 	"context"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"os"
+	"path/filepath"
 	"strconv"
 	"time"
 
 	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/encoded"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/rpcs"
 	"github.com/algorand/go-algorand/util"
 )
 
 var errNoLedgerForRound = errors.New("no ledger available for given round")
 
+// errNoManifestForRound is returned by peers that serve a catchpoint file without a chunk manifest,
+// and could only be downloaded as a whole.
+var errNoManifestForRound = errors.New("no catchpoint manifest available for given round")
+
+// errNoDeltaForRound is returned by peers that have no catchpoint delta file for the given round.
+var errNoDeltaForRound = errors.New("no catchpoint delta available for given round")
+
+// errCatchpointFileMismatch is returned when a local catchpoint file is not for the requested catchpoint.
+var errCatchpointFileMismatch = errors.New("catchpoint file does not match the catchpoint label")
+
+// errCatchpointChunkMismatch is returned when a downloaded chunk does not match its manifest entry.
+var errCatchpointChunkMismatch = errors.New("catchpoint chunk does not match the manifest")
+
 const (
 	// maxCatchpointFileChunkSize is a rough estimate for the worst-case scenario we're going to have of all the accounts data per a single catchpoint file chunk and one account with max resources.
 	maxCatchpointFileChunkSize = ledger.BalancesPerCatchpointFileChunk*(ledger.MaxEncodedBaseAccountDataSize+encoded.MaxEncodedKVDataSize) + ledger.ResourcesPerCatchpointFileChunk*ledger.MaxEncodedBaseResourceDataSize
@@ -45,6 +64,10 @@ const (
 	defaultMinCatchpointFileDownloadBytesPerSecond = 20 * 1024
 	// catchpointFileStreamReadSize defines the number of bytes we would attempt to read at each iteration from the incoming http data stream
 	catchpointFileStreamReadSize = 4096
+	// maxCatchpointManifestSize is the largest catchpoint manifest we are willing to download.
+	maxCatchpointManifestSize = 16 * 1024 * 1024
+	// maxCatchpointDeltaFileSize is the largest compressed catchpoint delta file we are willing to download.
+	maxCatchpointDeltaFileSize = 256 * 1024 * 1024
 )
 
 var errNonHTTPPeer = fmt.Errorf("downloadLedger : non-HTTPPeer encountered")
@@ -60,6 +83,9 @@ type ledgerFetcher struct {
 
 	reporter ledgerFetcherReporter
 	config   config.Local
+
+	// recorder keeps a copy of the processed catchpoint file entries, when set.
+	recorder *catchpointFileRecorder
 }
 
 func makeLedgerFetcher(net network.GossipNode, accessor ledger.CatchpointCatchupAccessor, log logging.Logger, reporter ledgerFetcherReporter, cfg config.Local) *ledgerFetcher {
@@ -73,13 +99,22 @@ func makeLedgerFetcher(net network.GossipNode, accessor ledger.CatchpointCatchup
 }
 
 func (lf *ledgerFetcher) requestLedger(ctx context.Context, peer network.HTTPPeer, round basics.Round, method string) (*http.Response, error) {
-	ledgerURL := network.SubstituteGenesisID(lf.net, "/v1/{genesisID}/ledger/"+strconv.FormatUint(uint64(round), 36))
+	return lf.requestLedgerPath(ctx, peer, round, "", method, nil)
+}
+
+// requestLedgerPath requests the given path suffix of the ledger of the provided round, i.e. the
+// ledger itself or its manifest, with the provided extra request headers.
+func (lf *ledgerFetcher) requestLedgerPath(ctx context.Context, peer network.HTTPPeer, round basics.Round, suffix string, method string, header http.Header) (*http.Response, error) {
+	ledgerURL := network.SubstituteGenesisID(lf.net, "/v1/{genesisID}/ledger/"+strconv.FormatUint(uint64(round), 36)+suffix)
 	lf.log.Debugf("ledger %s %#v peer %#v %T", method, ledgerURL, peer, peer)
 	request, err := http.NewRequestWithContext(ctx, method, ledgerURL, nil)
 	if err != nil {
 		return nil, err
 	}
 
+	for key, values := range header {
+		request.Header[key] = values
+	}
 	network.SetUserAgentHeader(request.Header)
 	httpClient := peer.GetHTTPClient()
 	if httpClient == nil {
@@ -153,15 +188,7 @@ func (lf *ledgerFetcher) getPeerLedger(ctx context.Context, peer network.HTTPPee
 		return err
 	}
 
-	// maxCatchpointFileChunkDownloadDuration is the maximum amount of time we would wait to download a single chunk off a catchpoint file
-	maxCatchpointFileChunkDownloadDuration := 2 * time.Minute
-	if lf.config.MinCatchpointFileDownloadBytesPerSecond > 0 {
-		maxCatchpointFileChunkDownloadDuration += maxCatchpointFileChunkSize * time.Second / time.Duration(lf.config.MinCatchpointFileDownloadBytesPerSecond)
-	} else {
-		maxCatchpointFileChunkDownloadDuration += maxCatchpointFileChunkSize * time.Second / defaultMinCatchpointFileDownloadBytesPerSecond
-	}
-
-	watchdogReader := util.MakeWatchdogStreamReader(response.Body, catchpointFileStreamReadSize, 2*maxCatchpointFileChunkSize, maxCatchpointFileChunkDownloadDuration)
+	watchdogReader := util.MakeWatchdogStreamReader(response.Body, catchpointFileStreamReadSize, 2*maxCatchpointFileChunkSize, lf.chunkDownloadDuration())
 	defer watchdogReader.Close()
 	tarReader := tar.NewReader(watchdogReader)
 	var downloadProgress ledger.CatchpointCatchupAccessorProgress
@@ -218,6 +245,367 @@ func (lf *ledgerFetcher) getPeerLedger(ctx context.Context, peer network.HTTPPee
 	}
 }
 
+// chunkDownloadDuration is the maximum amount of time we would wait to download a single chunk off a catchpoint file
+func (lf *ledgerFetcher) chunkDownloadDuration() time.Duration {
+	maxCatchpointFileChunkDownloadDuration := 2 * time.Minute
+	if lf.config.MinCatchpointFileDownloadBytesPerSecond > 0 {
+		maxCatchpointFileChunkDownloadDuration += maxCatchpointFileChunkSize * time.Second / time.Duration(lf.config.MinCatchpointFileDownloadBytesPerSecond)
+	} else {
+		maxCatchpointFileChunkDownloadDuration += maxCatchpointFileChunkSize * time.Second / defaultMinCatchpointFileDownloadBytesPerSecond
+	}
+	return maxCatchpointFileChunkDownloadDuration
+}
+
 func (lf *ledgerFetcher) processBalancesBlock(ctx context.Context, sectionName string, bytes []byte, downloadProgress *ledger.CatchpointCatchupAccessorProgress) error {
-	return lf.accessor.ProcessStagingBalances(ctx, sectionName, bytes, downloadProgress)
+	err := lf.accessor.ProcessStagingBalances(ctx, sectionName, bytes, downloadProgress)
+	if err != nil {
+		return err
+	}
+	if lf.recorder != nil {
+		// failing to keep a copy of the catchpoint file only costs the next catchup its catchpoint delta base.
+		err = lf.recorder.add(sectionName, bytes)
+		if err != nil {
+			lf.log.Warnf("unable to keep a copy of the catchpoint file : %v", err)
+			lf.stopRecording()
+		}
+	}
+	return nil
+}
+
+// startRecording starts keeping a copy of the processed catchpoint file entries at the given path, dropping the
+// copy of any previous download.
+func (lf *ledgerFetcher) startRecording(path string) {
+	lf.stopRecording()
+	recorder, err := makeCatchpointFileRecorder(path)
+	if err != nil {
+		lf.log.Warnf("unable to keep a copy of the catchpoint file : %v", err)
+		return
+	}
+	lf.recorder = recorder
+}
+
+// stopRecording drops the copy of the processed catchpoint file entries, if any.
+func (lf *ledgerFetcher) stopRecording() {
+	if lf.recorder != nil {
+		lf.recorder.discard()
+		lf.recorder = nil
+	}
+}
+
+// finishRecording completes the copy of the processed catchpoint file entries, if any. The copy is only kept in
+// place of the previous one by keepCatchpointRecording, once the catchpoint was verified.
+func (lf *ledgerFetcher) finishRecording() {
+	if lf.recorder == nil {
+		return
+	}
+	err := lf.recorder.finish()
+	if err != nil {
+		lf.log.Warnf("unable to keep a copy of the catchpoint file : %v", err)
+		lf.recorder.discard()
+	}
+	lf.recorder = nil
+}
+
+// catchpointFileRecorder writes the entries of a downloaded catchpoint file into a compressed catchpoint file,
+// at the recording path of the path it is eventually kept at.
+type catchpointFileRecorder struct {
+	file       *os.File
+	gzipWriter *gzip.Writer
+	tarWriter  *tar.Writer
+}
+
+func makeCatchpointFileRecorder(path string) (*catchpointFileRecorder, error) {
+	err := os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Create(catchpointRecordingPath(path))
+	if err != nil {
+		return nil, err
+	}
+	gzipWriter := gzip.NewWriter(file)
+	return &catchpointFileRecorder{
+		file:       file,
+		gzipWriter: gzipWriter,
+		tarWriter:  tar.NewWriter(gzipWriter),
+	}, nil
+}
+
+func (r *catchpointFileRecorder) add(name string, data []byte) error {
+	err := r.tarWriter.WriteHeader(&tar.Header{
+		Name: name,
+		Mode: 0600,
+		Size: int64(len(data)),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = r.tarWriter.Write(data)
+	return err
+}
+
+func (r *catchpointFileRecorder) finish() error {
+	err := r.tarWriter.Close()
+	if err != nil {
+		return err
+	}
+	err = r.gzipWriter.Close()
+	if err != nil {
+		return err
+	}
+	err = r.file.Sync()
+	if err != nil {
+		return err
+	}
+	return r.file.Close()
+}
+
+func (r *catchpointFileRecorder) discard() {
+	r.file.Close()
+	os.Remove(r.file.Name())
+}
+
+// catchpointRecordingPath returns the path a catchpoint file is recorded at before it is kept at the given path.
+func catchpointRecordingPath(path string) string {
+	return path + ".recording"
+}
+
+// keepCatchpointRecording replaces the catchpoint file kept at the given path with the completed recording, if
+// there is one.
+func keepCatchpointRecording(path string) error {
+	err := os.Rename(catchpointRecordingPath(path), path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// getPeerManifest retrieves the chunk manifest of the catchpoint file for the given round. Peers that don't
+// support chunked downloads, or that have no manifest for this catchpoint file, yield errNoManifestForRound.
+func (lf *ledgerFetcher) getPeerManifest(ctx context.Context, peer network.HTTPPeer, round basics.Round) (*ledger.CatchpointManifest, error) {
+	timeoutContext, timeoutContextCancel := context.WithTimeout(ctx, time.Duration(lf.config.CatchupHTTPBlockFetchTimeoutSec)*time.Second)
+	defer timeoutContextCancel()
+	response, err := lf.requestLedgerPath(timeoutContext, peer, round, "/manifest", http.MethodGet, nil)
+	if err != nil {
+		lf.log.Debugf("getPeerManifest GET : %s", err)
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound: // older peers don't know this path, and newer ones might have a catchpoint file with no manifest.
+		return nil, errNoManifestForRound
+	default:
+		return nil, fmt.Errorf("getPeerManifest error response status code %d", response.StatusCode)
+	}
+	if contentType := response.Header.Get("Content-Type"); contentType != rpcs.LedgerManifestResponseContentType {
+		return nil, fmt.Errorf("getPeerManifest : http ledger fetcher response has an invalid content type : %s", contentType)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(response.Body, maxCatchpointManifestSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > maxCatchpointManifestSize {
+		return nil, fmt.Errorf("getPeerManifest : manifest exceeds %d bytes", maxCatchpointManifestSize)
+	}
+	var manifest ledger.CatchpointManifest
+	err = protocol.DecodeReflect(body, &manifest)
+	if err != nil {
+		return nil, fmt.Errorf("getPeerManifest : unable to decode manifest : %w", err)
+	}
+	if manifest.Round != round {
+		return nil, fmt.Errorf("getPeerManifest : manifest is for round %d rather than %d", manifest.Round, round)
+	}
+	err = manifest.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return &manifest, nil
+}
+
+// getPeerChunk downloads the given chunk of the catchpoint file using a range request and returns the
+// content of the tar entry it holds, once it was verified against the manifest entry.
+func (lf *ledgerFetcher) getPeerChunk(ctx context.Context, peer network.HTTPPeer, round basics.Round, chunk *ledger.CatchpointManifestChunk) ([]byte, error) {
+	if chunk.Size > maxCatchpointFileChunkSize || chunk.Length > 2*maxCatchpointFileChunkSize {
+		return nil, fmt.Errorf("getPeerChunk : chunk %s is too large (%d bytes)", chunk.Name, chunk.Size)
+	}
+
+	timeoutContext, timeoutContextCancel := context.WithTimeout(ctx, lf.chunkDownloadDuration())
+	defer timeoutContextCancel()
+	header := http.Header{}
+	header.Set("Range", fmt.Sprintf("bytes=%d-%d", chunk.Offset, chunk.Offset+chunk.Length-1))
+	// the range addresses the stored gzip file, so make sure the transport won't decompress it for us.
+	header.Set("Accept-Encoding", "identity")
+	response, err := lf.requestLedgerPath(timeoutContext, peer, round, "", http.MethodGet, header)
+	if err != nil {
+		lf.log.Debugf("getPeerChunk GET : %s", err)
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusPartialContent:
+	case http.StatusNotFound:
+		return nil, errNoLedgerForRound
+	default:
+		return nil, fmt.Errorf("getPeerChunk error response status code %d", response.StatusCode)
+	}
+	if contentType := response.Header.Get("Content-Type"); contentType != rpcs.LedgerResponseContentType {
+		return nil, fmt.Errorf("getPeerChunk : http ledger fetcher response has an invalid content type : %s", contentType)
+	}
+
+	compressed := make([]byte, chunk.Length)
+	_, err = io.ReadFull(response.Body, compressed)
+	if err != nil {
+		return nil, fmt.Errorf("getPeerChunk : unable to read chunk %s : %w", chunk.Name, err)
+	}
+	return verifyCatchpointChunk(compressed, chunk)
+}
+
+// verifyCatchpointChunk extracts the tar entry from a compressed chunk and verifies it against its manifest entry.
+func verifyCatchpointChunk(compressed []byte, chunk *ledger.CatchpointManifestChunk) ([]byte, error) {
+	gzipReader, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, fmt.Errorf("%w : %s : %v", errCatchpointChunkMismatch, chunk.Name, err)
+	}
+	defer gzipReader.Close()
+	tarReader := tar.NewReader(gzipReader)
+	header, err := tarReader.Next()
+	if err != nil {
+		return nil, fmt.Errorf("%w : %s : %v", errCatchpointChunkMismatch, chunk.Name, err)
+	}
+	if header.Name != chunk.Name || header.Size != chunk.Size {
+		return nil, fmt.Errorf("%w : expected %s of %d bytes, got %s of %d bytes", errCatchpointChunkMismatch, chunk.Name, chunk.Size, header.Name, header.Size)
+	}
+	data := make([]byte, header.Size)
+	_, err = io.ReadFull(tarReader, data)
+	if err != nil {
+		return nil, fmt.Errorf("%w : %s : %v", errCatchpointChunkMismatch, chunk.Name, err)
+	}
+	if crypto.Hash(data) != chunk.Hash {
+		return nil, fmt.Errorf("%w : %s has a mismatching hash", errCatchpointChunkMismatch, chunk.Name)
+	}
+	return data, nil
+}
+
+// getPeerLedgerDelta downloads the compressed catchpoint delta file for the given round. The file is downloaded as
+// a whole before it is processed, since a partially applied delta can't be resumed from another peer.
+func (lf *ledgerFetcher) getPeerLedgerDelta(ctx context.Context, peer network.HTTPPeer, round basics.Round) ([]byte, error) {
+	timeoutContext, timeoutContextCancel := context.WithTimeout(ctx, lf.config.MaxCatchpointDownloadDuration)
+	defer timeoutContextCancel()
+	response, err := lf.requestLedgerPath(timeoutContext, peer, round, "/delta", http.MethodGet, nil)
+	if err != nil {
+		lf.log.Debugf("getPeerLedgerDelta GET : %s", err)
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound: // older peers don't know this path, and newer ones might not generate delta files.
+		return nil, errNoDeltaForRound
+	default:
+		return nil, fmt.Errorf("getPeerLedgerDelta error response status code %d", response.StatusCode)
+	}
+	if contentType := response.Header.Get("Content-Type"); contentType != rpcs.LedgerDeltaResponseContentType {
+		return nil, fmt.Errorf("getPeerLedgerDelta : http ledger fetcher response has an invalid content type : %s", contentType)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(response.Body, maxCatchpointDeltaFileSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > maxCatchpointDeltaFileSize {
+		return nil, fmt.Errorf("getPeerLedgerDelta : delta file exceeds %d bytes", maxCatchpointDeltaFileSize)
+	}
+	return body, nil
+}
+
+// processLedgerStream processes the entries of a compressed catchpoint file, or catchpoint delta file, read from in.
+func (lf *ledgerFetcher) processLedgerStream(ctx context.Context, in io.Reader, progress *ledger.CatchpointCatchupAccessorProgress) error {
+	gzipReader, err := gzip.NewReader(in)
+	if err != nil {
+		return err
+	}
+	defer gzipReader.Close()
+	return lf.processLedgerTar(ctx, tar.NewReader(gzipReader), progress)
+}
+
+// processLedgerTar processes the entries of a catchpoint file, or catchpoint delta file, read from tarReader.
+func (lf *ledgerFetcher) processLedgerTar(ctx context.Context, tarReader *tar.Reader, progress *ledger.CatchpointCatchupAccessorProgress) error {
+	for {
+		header, err := tarReader.Next()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		data, err := readLedgerEntry(tarReader, header)
+		if err != nil {
+			return err
+		}
+		err = lf.processBalancesBlock(ctx, header.Name, data, progress)
+		if err != nil {
+			return err
+		}
+		if lf.reporter != nil {
+			lf.reporter.updateLedgerFetcherProgress(progress)
+		}
+	}
+}
+
+// readLedgerEntry reads the content of the current tar entry of a catchpoint file.
+func readLedgerEntry(tarReader *tar.Reader, header *tar.Header) ([]byte, error) {
+	if header.Size > maxCatchpointFileChunkSize || header.Size < 1 {
+		return nil, fmt.Errorf("catchpoint file has a tar header with data size of %d", header.Size)
+	}
+	data := make([]byte, header.Size)
+	_, err := io.ReadFull(tarReader, data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// readCatchpointFileLabel returns the catchpoint label stated by the header of a local catchpoint file.
+func readCatchpointFileLabel(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	reader, err := ledger.OpenCatchpointFile(file)
+	if err != nil {
+		return "", fmt.Errorf("readCatchpointFileLabel : %w", err)
+	}
+	defer reader.Close()
+	return reader.Header.Catchpoint, nil
+}
+
+// loadLedgerFile processes a local catchpoint file, which is either a tar or a gzip compressed tar. The header of
+// the file is checked against the given label before any of the balances are processed.
+func (lf *ledgerFetcher) loadLedgerFile(ctx context.Context, path string, label string, progress *ledger.CatchpointCatchupAccessorProgress) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	reader, err := ledger.OpenCatchpointFile(file)
+	if err != nil {
+		return fmt.Errorf("loadLedgerFile : %w", err)
+	}
+	defer reader.Close()
+	if reader.Header.Catchpoint != label {
+		return fmt.Errorf("%w : the file is for catchpoint %s rather than %s", errCatchpointFileMismatch, reader.Header.Catchpoint, label)
+	}
+	err = lf.processBalancesBlock(ctx, ledger.CatchpointContentFileName, reader.EncodedHeader, progress)
+	if err != nil {
+		return err
+	}
+	return lf.processLedgerTar(ctx, reader.Entries, progress)
 }
@@ -18,10 +18,15 @@ package catchup
 
 import (
 	"archive/tar"
+	"bytes"
+	"compress/gzip"
 	"context"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -32,6 +37,7 @@ import (
 	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/logging"
 	p2ptesting "github.com/algorand/go-algorand/network/p2p/testing"
+	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/rpcs"
 	"github.com/algorand/go-algorand/test/partitiontest"
 )
@@ -217,3 +223,160 @@ func TestLedgerFetcherP2P(t *testing.T) {
 	err = lf.downloadLedger(context.Background(), successPeer, basics.Round(0))
 	require.NoError(t, err)
 }
+
+func TestLedgerFetcherManifestAndChunks(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	file, manifest := makeTestChunkedCatchpoint(t, "1000#label", 3)
+	peer := startTestLedgerService(t, &testChunkedLedger{file: file, manifest: manifest}, nil)
+	legacyPeer := startTestLedgerService(t, &testChunkedLedger{file: file}, nil)
+	lf := makeLedgerFetcher(&mocks.MockNetwork{}, &mocks.MockCatchpointCatchupAccessor{}, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, config.GetDefaultLocal())
+
+	// peers with no manifest for the catchpoint file
+	_, err := lf.getPeerManifest(context.Background(), legacyPeer, testChunkedCatchpointRound)
+	require.Equal(t, errNoManifestForRound, err)
+
+	// a manifest of a different round
+	_, err = lf.getPeerManifest(context.Background(), peer, testChunkedCatchpointRound+1)
+	require.ErrorContains(t, err, "rather than")
+
+	peerManifest, err := lf.getPeerManifest(context.Background(), peer, testChunkedCatchpointRound)
+	require.NoError(t, err)
+	require.Equal(t, manifest, peerManifest)
+
+	for _, chunk := range peerManifest.Chunks {
+		data, err := lf.getPeerChunk(context.Background(), peer, testChunkedCatchpointRound, &chunk)
+		require.NoError(t, err)
+		require.Equal(t, chunk.Size, int64(len(data)))
+	}
+
+	// chunks that don't match the manifest are rejected
+	badHash := peerManifest.Chunks[1]
+	badHash.Hash[0] ^= 1
+	_, err = lf.getPeerChunk(context.Background(), peer, testChunkedCatchpointRound, &badHash)
+	require.ErrorIs(t, err, errCatchpointChunkMismatch)
+
+	badName := peerManifest.Chunks[1]
+	badName.Name = "balances.9.msgpack"
+	_, err = lf.getPeerChunk(context.Background(), peer, testChunkedCatchpointRound, &badName)
+	require.ErrorIs(t, err, errCatchpointChunkMismatch)
+
+	badOffset := peerManifest.Chunks[1]
+	badOffset.Offset++
+	_, err = lf.getPeerChunk(context.Background(), peer, testChunkedCatchpointRound, &badOffset)
+	require.ErrorIs(t, err, errCatchpointChunkMismatch)
+}
+
+// recordingCatchupAccessor records the names of the sections it was asked to process.
+type recordingCatchupAccessor struct {
+	mocks.MockCatchpointCatchupAccessor
+	sections []string
+}
+
+func (r *recordingCatchupAccessor) ProcessStagingBalances(ctx context.Context, sectionName string, bytes []byte, progress *ledger.CatchpointCatchupAccessorProgress) error {
+	r.sections = append(r.sections, sectionName)
+	return nil
+}
+
+func writeTestCatchpointFile(t *testing.T, path string, compress bool, entries map[string][]byte, order []string) {
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+	var out io.Writer = f
+	if compress {
+		gz := gzip.NewWriter(f)
+		defer gz.Close()
+		out = gz
+	}
+	tw := tar.NewWriter(out)
+	for _, name := range order {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(entries[name]))}))
+		_, err = tw.Write(entries[name])
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+}
+
+func TestLedgerFetcherLoadLedgerFile(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	const label = "1000#DVFRZUYHEFKRLK5N6DNJRR4IABEVN2D6H76F3ZSEPIE6MKXMQWQA"
+	entries := map[string][]byte{
+		ledger.CatchpointContentFileName: protocol.Encode(&ledger.CatchpointFileHeader{BlocksRound: 1000, Catchpoint: label}),
+		"balances.1.msgpack":             bytes.Repeat([]byte{1}, 100),
+		"balances.2.msgpack":             bytes.Repeat([]byte{2}, 100),
+	}
+	order := []string{ledger.CatchpointContentFileName, "balances.1.msgpack", "balances.2.msgpack"}
+	dir := t.TempDir()
+
+	for _, compress := range []bool{false, true} {
+		path := filepath.Join(dir, fmt.Sprintf("catchpoint-%v.tar", compress))
+		writeTestCatchpointFile(t, path, compress, entries, order)
+
+		accessor := &recordingCatchupAccessor{}
+		lf := makeLedgerFetcher(&mocks.MockNetwork{}, accessor, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, config.GetDefaultLocal())
+		var progress ledger.CatchpointCatchupAccessorProgress
+		require.NoError(t, lf.loadLedgerFile(context.Background(), path, label, &progress))
+		require.Equal(t, order, accessor.sections)
+
+		// nothing is processed from a file of another catchpoint
+		accessor.sections = nil
+		err := lf.loadLedgerFile(context.Background(), path, "1000#J6BAYCJ4TKKWGYYI7DJNVDQ3SEZI3SK4D3AB3YVP5A2H3NLNVDUQ", &progress)
+		require.ErrorIs(t, err, errCatchpointFileMismatch)
+		require.Empty(t, accessor.sections)
+	}
+
+	// the header must come first
+	path := filepath.Join(dir, "unordered.tar")
+	writeTestCatchpointFile(t, path, false, entries, []string{"balances.1.msgpack", ledger.CatchpointContentFileName})
+	lf := makeLedgerFetcher(&mocks.MockNetwork{}, &recordingCatchupAccessor{}, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, config.GetDefaultLocal())
+	var progress ledger.CatchpointCatchupAccessorProgress
+	err := lf.loadLedgerFile(context.Background(), path, label, &progress)
+	require.ErrorContains(t, err, "rather than")
+
+	err = lf.loadLedgerFile(context.Background(), filepath.Join(dir, "missing.tar"), label, &progress)
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestLedgerFetcherRecording(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	const label = "1000#DVFRZUYHEFKRLK5N6DNJRR4IABEVN2D6H76F3ZSEPIE6MKXMQWQA"
+	entries := map[string][]byte{
+		ledger.CatchpointContentFileName: protocol.Encode(&ledger.CatchpointFileHeader{BlocksRound: 1000, Catchpoint: label}),
+		"balances.1.msgpack":             bytes.Repeat([]byte{1}, 100),
+		"balances.2.msgpack":             bytes.Repeat([]byte{2}, 100),
+	}
+	order := []string{ledger.CatchpointContentFileName, "balances.1.msgpack", "balances.2.msgpack"}
+	keptFile := filepath.Join(t.TempDir(), "catchpoints", "catchup.catchpoint")
+	lf := makeLedgerFetcher(&mocks.MockNetwork{}, &recordingCatchupAccessor{}, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, config.GetDefaultLocal())
+	record := func() {
+		lf.startRecording(keptFile)
+		var progress ledger.CatchpointCatchupAccessorProgress
+		for _, name := range order {
+			require.NoError(t, lf.processBalancesBlock(context.Background(), name, entries[name], &progress))
+		}
+	}
+
+	// a dropped recording is not kept.
+	record()
+	lf.stopRecording()
+	require.NoError(t, keepCatchpointRecording(keptFile))
+	require.NoFileExists(t, keptFile)
+
+	// a completed recording is only kept once the catchpoint was verified.
+	record()
+	lf.finishRecording()
+	require.NoFileExists(t, keptFile)
+	require.NoError(t, keepCatchpointRecording(keptFile))
+	require.NoFileExists(t, catchpointRecordingPath(keptFile))
+
+	recordedLabel, err := readCatchpointFileLabel(keptFile)
+	require.NoError(t, err)
+	require.Equal(t, label, recordedLabel)
+	accessor := &recordingCatchupAccessor{}
+	lf = makeLedgerFetcher(&mocks.MockNetwork{}, accessor, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, config.GetDefaultLocal())
+	var progress ledger.CatchpointCatchupAccessorProgress
+	require.NoError(t, lf.loadLedgerFile(context.Background(), keptFile, label, &progress))
+	require.Equal(t, order, accessor.sections)
+}
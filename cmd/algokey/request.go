@@ -0,0 +1,222 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/signreq"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+var requestTxfile string
+var requestFile string
+var requestOutfile string
+var requestKeyfile string
+var requestMnemonic string
+var requestMsigParams []string
+
+func init() {
+	requestCmd.AddCommand(requestCreateCmd)
+	requestCmd.AddCommand(requestInspectCmd)
+	requestCmd.AddCommand(requestSignCmd)
+	requestCmd.AddCommand(requestMergeCmd)
+	requestCmd.AddCommand(requestFinalizeCmd)
+
+	requestCreateCmd.Flags().StringVarP(&requestTxfile, "txfile", "t", "", "Transaction group input filename")
+	requestCreateCmd.MarkFlagRequired("txfile")
+	requestCreateCmd.Flags().StringArrayVarP(&requestMsigParams, "params", "p", nil, "Multisig pre image parameters of an account authorizing transactions - [threshold] [Address 1] [Address 2] ... (may be repeated)")
+	requestCreateCmd.Flags().StringVarP(&requestOutfile, "outfile", "o", "", "Signing request output filename")
+	requestCreateCmd.MarkFlagRequired("outfile")
+
+	requestInspectCmd.Flags().StringVarP(&requestFile, "request", "r", "", "Signing request filename")
+	requestInspectCmd.MarkFlagRequired("request")
+
+	requestSignCmd.Flags().StringVarP(&requestFile, "request", "r", "", "Signing request filename")
+	requestSignCmd.MarkFlagRequired("request")
+	requestSignCmd.Flags().StringVarP(&requestKeyfile, "keyfile", "k", "", "Private key filename")
+	requestSignCmd.Flags().StringVarP(&requestMnemonic, "mnemonic", "m", "", "Private key mnemonic")
+	requestSignCmd.Flags().StringVarP(&requestOutfile, "outfile", "o", "", "Signing request output filename. If not specified, the original file will be modified")
+
+	requestMergeCmd.Flags().StringVarP(&requestOutfile, "outfile", "o", "", "Signing request output filename")
+	requestMergeCmd.MarkFlagRequired("outfile")
+
+	requestFinalizeCmd.Flags().StringVarP(&requestFile, "request", "r", "", "Signing request filename")
+	requestFinalizeCmd.MarkFlagRequired("request")
+	requestFinalizeCmd.Flags().StringVarP(&requestOutfile, "outfile", "o", "", "Signed transaction group output filename")
+	requestFinalizeCmd.MarkFlagRequired("outfile")
+}
+
+var requestCmd = &cobra.Command{
+	Use:   "request",
+	Short: "Create and sign signing requests, which carry a transaction group between offline signers",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.HelpFunc()(cmd, args)
+	},
+}
+
+var requestCreateCmd = &cobra.Command{
+	Use:   "create -t [transaction file] -o [request file]",
+	Short: "Create a signing request for the transactions of a file",
+	Long:  "Create a signing request for the transactions of a file, which are grouped if they are not already. Pass the preimage of each multisig account which authorizes some of the transactions with -p.",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, _ []string) {
+		txdata, err := readFile(requestTxfile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Cannot read transactions from %s: %v\n", requestTxfile, err)
+			os.Exit(1)
+		}
+
+		var stxns []transactions.SignedTxn
+		dec := protocol.NewMsgpDecoderBytes(txdata)
+		for {
+			var stxn transactions.SignedTxn
+			err = dec.Decode(&stxn)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Cannot decode transaction: %v\n", err)
+				os.Exit(1)
+			}
+			stxns = append(stxns, stxn)
+		}
+
+		var msigs []crypto.MultisigSig
+		for _, params := range requestMsigParams {
+			msig, err1 := signreq.ParseMultisig(params)
+			if err1 != nil {
+				fmt.Fprintf(os.Stderr, "Cannot parse multisig: %v\n", err1)
+				os.Exit(1)
+			}
+			msigs = append(msigs, msig)
+		}
+
+		req, err := signreq.New(stxns, msigs)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Cannot create signing request: %v\n", err)
+			os.Exit(1)
+		}
+		writeRequest(requestOutfile, req)
+	},
+}
+
+var requestInspectCmd = &cobra.Command{
+	Use:   "inspect -r [request file]",
+	Short: "Describe the transactions of a signing request and who has signed them",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, _ []string) {
+		req := readRequest(requestFile)
+		fmt.Print(req.Summary())
+	},
+}
+
+var requestSignCmd = &cobra.Command{
+	Use:   "sign -r [request file]",
+	Short: "Add the signatures of a private key to a signing request",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, _ []string) {
+		seed := loadKeyfileOrMnemonic(requestKeyfile, requestMnemonic)
+		key := crypto.GenerateSignatureSecrets(seed)
+		req := readRequest(requestFile)
+
+		n, err := req.Sign([]crypto.PublicKey{crypto.PublicKey(key.SignatureVerifier)}, func(_ crypto.PublicKey, stxn transactions.SignedTxn) (crypto.Signature, error) {
+			return key.Sign(stxn.Txn), nil
+		})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Cannot sign request: %v\n", err)
+			os.Exit(1)
+		}
+
+		if requestOutfile == "" {
+			requestOutfile = requestFile
+		}
+		writeRequest(requestOutfile, req)
+		fmt.Fprintf(os.Stderr, "Added %d signatures\n", n)
+	},
+}
+
+var requestMergeCmd = &cobra.Command{
+	Use:   "merge -o [request file] [request file] [request file] ...",
+	Short: "Merge the signatures of copies of a signing request",
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		var reqs []*signreq.Request
+		for _, filename := range args {
+			reqs = append(reqs, readRequest(filename))
+		}
+		merged, err := signreq.Merge(reqs...)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Cannot merge signing requests: %v\n", err)
+			os.Exit(1)
+		}
+		writeRequest(requestOutfile, merged)
+	},
+}
+
+var requestFinalizeCmd = &cobra.Command{
+	Use:   "finalize -r [request file] -o [transaction file]",
+	Short: "Write out the signed transaction group of a completely signed request",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, _ []string) {
+		req := readRequest(requestFile)
+		stxns, err := req.Finalize()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Cannot finalize signing request: %v\n", err)
+			os.Exit(1)
+		}
+
+		var outBytes []byte
+		for i := range stxns {
+			outBytes = append(outBytes, protocol.Encode(&stxns[i])...)
+		}
+		err = writeFile(requestOutfile, outBytes, 0600)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Cannot write signed transactions to %s: %v\n", requestOutfile, err)
+			os.Exit(1)
+		}
+	},
+}
+
+func readRequest(filename string) *signreq.Request {
+	data, err := readFile(filename)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Cannot read signing request from %s: %v\n", filename, err)
+		os.Exit(1)
+	}
+	req, err := signreq.Decode(data)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Cannot decode signing request %s: %v\n", filename, err)
+		os.Exit(1)
+	}
+	return req
+}
+
+func writeRequest(filename string, req *signreq.Request) {
+	err := writeFile(filename, req.Encode(), 0600)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Cannot write signing request to %s: %v\n", filename, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh/terminal"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/remotesigner"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet/driver"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+)
+
+var signerSocket string
+var signerKeyfiles []string
+
+func init() {
+	signerCmd.Flags().StringVarP(&signerSocket, "socket", "s", "", "Unix socket to listen on")
+	signerCmd.MarkFlagRequired("socket")
+	signerCmd.Flags().StringArrayVarP(&signerKeyfiles, "keyfile", "k", nil, "Private key filename (may be repeated)")
+	signerCmd.MarkFlagRequired("keyfile")
+
+	signerCmd.AddCommand(signerPasswordVerifierCmd)
+}
+
+var signerCmd = &cobra.Command{
+	Use:   "signer",
+	Short: "Run a reference remote signer for kmd's remote wallet driver",
+	Long:  "Run a reference remote signer for kmd's remote wallet driver. The signer holds the given keys in memory, and signs whatever kmd asks it to; it is meant for testing, not for production use.",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, _ []string) {
+		var keys []*crypto.SignatureSecrets
+		for _, keyfile := range signerKeyfiles {
+			key := crypto.GenerateSignatureSecrets(loadKeyfile(keyfile))
+			fmt.Printf("Serving key for %s\n", basics.Address(key.SignatureVerifier))
+			keys = append(keys, key)
+		}
+
+		listener, err := net.Listen("unix", signerSocket)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Cannot listen on %s: %v\n", signerSocket, err)
+			os.Exit(1)
+		}
+		err = os.Chmod(signerSocket, 0600)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Cannot restrict access to %s: %v\n", signerSocket, err)
+			os.Exit(1)
+		}
+
+		// Closing the listener removes the socket
+		sigs := make(chan os.Signal, 1)
+		signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
+		go func() {
+			<-sigs
+			listener.Close()
+		}()
+
+		err = remotesigner.MakeServer(keys, logging.Base()).Serve(listener)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Signer failed: %v\n", err)
+			os.Exit(1)
+		}
+	},
+}
+
+var signerPasswordVerifierCmd = &cobra.Command{
+	Use:   "password-verifier",
+	Short: "Make the password verifier of a remote signer's wallet",
+	Long:  "Prompt for a password, and print the password_verifier to set for a signer in kmd_config.json, so that its wallet is opened with that password.",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, _ []string) {
+		fmt.Printf("Password: ")
+		pw, err := terminal.ReadPassword(int(os.Stdin.Fd()))
+		fmt.Printf("\n")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Cannot read password: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Confirm password: ")
+		confirm, err := terminal.ReadPassword(int(os.Stdin.Fd()))
+		fmt.Printf("\n")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Cannot read password: %v\n", err)
+			os.Exit(1)
+		}
+		if string(pw) != string(confirm) {
+			fmt.Fprintf(os.Stderr, "Passwords do not match\n")
+			os.Exit(1)
+		}
+
+		scrypt := config.DefaultConfig("").DriverConfig.SQLiteWalletDriverConfig.ScryptParams
+		verifier, err := driver.MakeRemotePasswordVerifier(pw, scrypt)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Cannot make password verifier: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println(verifier)
+	},
+}
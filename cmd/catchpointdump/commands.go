@@ -46,6 +46,8 @@ func init() {
 	rootCmd.AddCommand(benchCmd)
 	rootCmd.AddCommand(databaseCmd)
 	rootCmd.AddCommand(infoCmd)
+	rootCmd.AddCommand(diffCmd)
+	rootCmd.AddCommand(exportCmd)
 }
 
 var rootCmd = &cobra.Command{
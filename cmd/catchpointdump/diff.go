@@ -0,0 +1,557 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb/sqlitedriver"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+var diffBaseFile string
+var diffTargetFile string
+var diffStaging bool
+var diffSummaryOnly bool
+var diffWorkDir string
+
+func init() {
+	diffCmd.Flags().StringVarP(&diffBaseFile, "base", "b", "", "Specify the base catchpoint file (either .tar or .tar.gz) or ledger tracker database to compare")
+	diffCmd.Flags().StringVarP(&diffTargetFile, "target", "t", "", "Specify the target catchpoint file (either .tar or .tar.gz) or ledger tracker database to compare against the base")
+	diffCmd.Flags().StringVarP(&outFileName, "output", "o", "", "Specify an outfile for the differences ( i.e. catchpoint.diff.txt )")
+	diffCmd.Flags().BoolVarP(&diffStaging, "staging", "s", false, "Specify whether to look in the catchpoint staging or regular tables of ledger tracker databases. (default false)")
+	diffCmd.Flags().BoolVar(&diffSummaryOnly, "summary", false, "Only print the number of differing records rather than the records themselves")
+	diffCmd.Flags().StringVarP(&diffWorkDir, "work-dir", "w", "", "Specify the directory catchpoint files are loaded into while being compared (default is the system temporary directory)")
+}
+
+var diffCmd = &cobra.Command{
+	Use:   "diff",
+	Short: "Compare two catchpoint files or ledger tracker databases",
+	Long:  "Compare two catchpoint files, or a catchpoint file and a ledger tracker database, and report the accounts, resources, key-value store entries (boxes), online accounts and online round params that were added, removed or changed between the base and the target, along with any mismatch in the account totals.\nCatchpoint files are loaded into temporary databases first, and the records are then compared in order, so the comparison does not need to hold the ledger in memory.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		if diffBaseFile == "" || diffTargetFile == "" {
+			cmd.HelpFunc()(cmd, args)
+			return
+		}
+		// the comparison runs in its own function, so that its working directory is removed before an error exits.
+		err := runDiff()
+		if err != nil {
+			reportErrorf("%v", err)
+		}
+	},
+}
+
+// runDiff compares the base and the target given on the command line, and writes the report to the output file.
+func runDiff() error {
+	workDir, err := os.MkdirTemp(diffWorkDir, "catchpointdump-diff-")
+	if err != nil {
+		return fmt.Errorf("unable to create a working directory : %v", err)
+	}
+	defer os.RemoveAll(workDir)
+
+	base, err := openDiffSource(diffBaseFile, filepath.Join(workDir, "base"), diffStaging)
+	if err != nil {
+		return fmt.Errorf("unable to open '%s' : %v", diffBaseFile, err)
+	}
+	defer base.close()
+	target, err := openDiffSource(diffTargetFile, filepath.Join(workDir, "target"), diffStaging)
+	if err != nil {
+		return fmt.Errorf("unable to open '%s' : %v", diffTargetFile, err)
+	}
+	defer target.close()
+
+	outFile := os.Stdout
+	if outFileName != "" {
+		outFile, err = os.OpenFile(outFileName, os.O_RDWR|os.O_TRUNC|os.O_CREATE, 0644)
+		if err != nil {
+			return fmt.Errorf("unable to create file '%s' : %v", outFileName, err)
+		}
+		defer outFile.Close()
+	}
+	_, err = diffSources(context.Background(), base, target, diffSummaryOnly, outFile)
+	if err != nil {
+		return fmt.Errorf("unable to compare '%s' and '%s' : %v", diffBaseFile, diffTargetFile, err)
+	}
+	return nil
+}
+
+// diffSource is one of the two ledger states being compared: either a ledger tracker database, or the
+// catchpoint staging tables a catchpoint file was loaded into.
+type diffSource struct {
+	path    string
+	staging bool
+	// header is the header of the catchpoint file the source was loaded from, if any.
+	header *ledger.CatchpointFileHeader
+	dbs    db.Accessor
+}
+
+// openDiffSource opens the given ledger tracker database, or loads the given catchpoint file into the
+// staging tables of a new ledger created at ledgerPrefix.
+func openDiffSource(path string, ledgerPrefix string, staging bool) (*diffSource, error) {
+	isDatabase, err := isSQLiteDatabase(path)
+	if err != nil {
+		return nil, err
+	}
+	source := &diffSource{path: path, staging: staging}
+	if !isDatabase {
+		var header ledger.CatchpointFileHeader
+		header, err = loadCatchpointFileForDiff(path, ledgerPrefix)
+		if err != nil {
+			return nil, err
+		}
+		source.header = &header
+		source.staging = true
+		path = ledgerPrefix + ".tracker.sqlite"
+	}
+	source.dbs, err = db.MakeAccessor(path, true, false)
+	if err != nil {
+		return nil, err
+	}
+	return source, nil
+}
+
+func (s *diffSource) close() {
+	s.dbs.Close()
+}
+
+// isSQLiteDatabase tells SQLite databases apart from catchpoint files by the SQLite file header.
+func isSQLiteDatabase(path string) (bool, error) {
+	const sqliteHeader = "SQLite format 3\x00"
+	f, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+	prefix := make([]byte, len(sqliteHeader))
+	_, err = io.ReadFull(f, prefix)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return string(prefix) == sqliteHeader, nil
+}
+
+// loadCatchpointFileForDiff loads a catchpoint file into the staging tables of a new ledger.
+func loadCatchpointFileForDiff(catchpointFile string, ledgerPrefix string) (fileHeader ledger.CatchpointFileHeader, err error) {
+	stats, err := os.Stat(catchpointFile)
+	if err != nil {
+		return fileHeader, err
+	}
+	if stats.Size() == 0 {
+		return fileHeader, fmt.Errorf("file '%s' is empty", catchpointFile)
+	}
+	reader, err := os.Open(catchpointFile)
+	if err != nil {
+		return fileHeader, err
+	}
+	defer reader.Close()
+
+	// TODO: store CurrentProtocol in catchpoint file header.
+	// As a temporary workaround use a current protocol version.
+	genesisInitState := ledgercore.InitState{
+		Block: bookkeeping.Block{BlockHeader: bookkeeping.BlockHeader{
+			UpgradeState: bookkeeping.UpgradeState{
+				CurrentProtocol: protocol.ConsensusCurrentVersion,
+			},
+		}},
+	}
+	l, err := ledger.OpenLedger(logging.Base(), ledgerPrefix, false, genesisInitState, config.GetDefaultLocal())
+	if err != nil {
+		return fileHeader, err
+	}
+	defer l.Close()
+
+	catchupAccessor := ledger.MakeCatchpointCatchupAccessor(l, logging.Base())
+	err = catchupAccessor.ResetStagingBalances(context.Background(), true)
+	if err != nil {
+		return fileHeader, err
+	}
+	return loadCatchpointIntoDatabase(context.Background(), catchupAccessor, reader, stats.Size())
+}
+
+// describe returns a short description of the source and of the round of its balances.
+func (s *diffSource) describe(ctx context.Context) (string, basics.Round, error) {
+	if s.header != nil {
+		return fmt.Sprintf("catchpoint file %s, catchpoint %s", s.path, s.header.Catchpoint), s.header.BalancesRound, nil
+	}
+	if s.staging {
+		rnd, err := sqlitedriver.NewCatchpointSQLReaderWriter(s.dbs.Handle).ReadCatchpointStateUint64(ctx, trackerdb.CatchpointStateCatchupBalancesRound)
+		return fmt.Sprintf("catchpoint staging tables of %s", s.path), basics.Round(rnd), err
+	}
+	rnd, err := sqlitedriver.NewAccountsSQLReader(s.dbs.Handle).AccountsRound()
+	return fmt.Sprintf("tracker database %s", s.path), rnd, err
+}
+
+// diffRecord is a single row of one of the compared tables. Records are matched by their key, and the
+// tables are read ordered by it.
+type diffRecord struct {
+	key   []byte
+	value []byte
+}
+
+// diffTable describes how to read and report the records of one kind.
+type diffTable struct {
+	// name is the name the records are reported under.
+	name string
+	// tables returns the tables the query reads from, given whether to use the staging tables.
+	tables func(staging bool) []string
+	// query selects the records ordered by key. It is formatted with the names returned by tables.
+	query string
+	// scan reads a record out of a row of the query results.
+	scan func(rows *sql.Rows) (diffRecord, error)
+	// formatKey and formatValue render a record for the report.
+	formatKey   func(key []byte) string
+	formatValue func(value []byte) string
+}
+
+func stagingTableNames(regular []string, staging []string) func(bool) []string {
+	return func(useStaging bool) []string {
+		if useStaging {
+			return staging
+		}
+		return regular
+	}
+}
+
+// uint64Key appends v to the prefix in big endian order, so that the keys sort like the database columns they were
+// read from.
+func uint64Key(prefix []byte, v uint64) []byte {
+	return binary.BigEndian.AppendUint64(append([]byte(nil), prefix...), v)
+}
+
+// formatAddressKey renders keys made of an address, optionally followed by a round or a creatable index.
+func formatAddressKey(key []byte) string {
+	var addr basics.Address
+	if len(key) < len(addr) {
+		return base64.StdEncoding.EncodeToString(key)
+	}
+	copy(addr[:], key)
+	if len(key) == len(addr)+8 {
+		return fmt.Sprintf("%s %d", addr, binary.BigEndian.Uint64(key[len(addr):]))
+	}
+	return addr.String()
+}
+
+// formatDecodedValue returns a function that renders msgpack encoded values of type T as json.
+func formatDecodedValue[T any]() func([]byte) string {
+	return func(value []byte) string {
+		var decoded T
+		err := protocol.DecodeReflect(value, &decoded)
+		if err != nil {
+			return fmt.Sprintf("<unable to decode %s : %v>", base64.StdEncoding.EncodeToString(value), err)
+		}
+		jsonData, err := json.Marshal(decoded)
+		if err != nil {
+			return fmt.Sprintf("<unable to marshal : %v>", err)
+		}
+		return string(jsonData)
+	}
+}
+
+var diffTables = []diffTable{
+	{
+		name:   "account",
+		tables: stagingTableNames([]string{"accountbase"}, []string{"catchpointbalances"}),
+		query:  "SELECT address, data FROM %s ORDER BY address",
+		scan: func(rows *sql.Rows) (rec diffRecord, err error) {
+			err = rows.Scan(&rec.key, &rec.value)
+			return
+		},
+		formatKey:   formatAddressKey,
+		formatValue: formatDecodedValue[trackerdb.BaseAccountData](),
+	},
+	{
+		name:   "resource",
+		tables: stagingTableNames([]string{"resources", "accountbase"}, []string{"catchpointresources", "catchpointbalances"}),
+		query:  "SELECT b.address, r.aidx, r.data FROM %s r JOIN %s b ON r.addrid = b.rowid ORDER BY b.address, r.aidx",
+		scan: func(rows *sql.Rows) (rec diffRecord, err error) {
+			var addr []byte
+			var aidx uint64
+			err = rows.Scan(&addr, &aidx, &rec.value)
+			rec.key = uint64Key(addr, aidx)
+			return
+		},
+		formatKey:   formatAddressKey,
+		formatValue: formatDecodedValue[trackerdb.ResourcesData](),
+	},
+	{
+		name:   "kv",
+		tables: stagingTableNames([]string{"kvstore"}, []string{"catchpointkvstore"}),
+		query:  "SELECT key, value FROM %s ORDER BY key",
+		scan: func(rows *sql.Rows) (rec diffRecord, err error) {
+			err = rows.Scan(&rec.key, &rec.value)
+			return
+		},
+		formatKey:   formatKeyValueKey,
+		formatValue: base64.StdEncoding.EncodeToString,
+	},
+	{
+		name:   "online account",
+		tables: stagingTableNames([]string{"onlineaccounts"}, []string{"catchpointonlineaccounts"}),
+		query:  "SELECT address, updround, data FROM %s ORDER BY address, updround",
+		scan: func(rows *sql.Rows) (rec diffRecord, err error) {
+			var addr []byte
+			var updRound uint64
+			err = rows.Scan(&addr, &updRound, &rec.value)
+			rec.key = uint64Key(addr, updRound)
+			return
+		},
+		formatKey:   formatAddressKey,
+		formatValue: formatDecodedValue[trackerdb.BaseOnlineAccountData](),
+	},
+	{
+		name:   "online round params",
+		tables: stagingTableNames([]string{"onlineroundparamstail"}, []string{"catchpointonlineroundparamstail"}),
+		query:  "SELECT rnd, data FROM %s ORDER BY rnd",
+		scan: func(rows *sql.Rows) (rec diffRecord, err error) {
+			var rnd uint64
+			err = rows.Scan(&rnd, &rec.value)
+			rec.key = uint64Key(nil, rnd)
+			return
+		},
+		formatKey: func(key []byte) string {
+			return fmt.Sprintf("%d", binary.BigEndian.Uint64(key))
+		},
+		formatValue: formatDecodedValue[ledgercore.OnlineRoundParamsData](),
+	},
+}
+
+// diffTableIterator reads the records of a table in key order. It yields no records for tables that do not
+// exist, such as the online accounts tables of databases created before they were introduced.
+type diffTableIterator struct {
+	rows  *sql.Rows
+	table *diffTable
+}
+
+func (s *diffSource) iterate(ctx context.Context, table *diffTable) (*diffTableIterator, error) {
+	tables := table.tables(s.staging)
+	args := make([]interface{}, len(tables))
+	for i, name := range tables {
+		var count int
+		err := s.dbs.Handle.QueryRowContext(ctx, "SELECT count(*) FROM sqlite_master WHERE type='table' AND name=?", name).Scan(&count)
+		if err != nil {
+			return nil, err
+		}
+		if count == 0 {
+			return &diffTableIterator{table: table}, nil
+		}
+		args[i] = name
+	}
+	rows, err := s.dbs.Handle.QueryContext(ctx, fmt.Sprintf(table.query, args...))
+	if err != nil {
+		return nil, err
+	}
+	return &diffTableIterator{rows: rows, table: table}, nil
+}
+
+func (it *diffTableIterator) next() (rec diffRecord, ok bool, err error) {
+	if it.rows == nil || !it.rows.Next() {
+		if it.rows != nil {
+			err = it.rows.Err()
+		}
+		return
+	}
+	rec, err = it.table.scan(it.rows)
+	return rec, err == nil, err
+}
+
+func (it *diffTableIterator) close() {
+	if it.rows != nil {
+		it.rows.Close()
+	}
+}
+
+// diffCounts counts the differing records of a single kind.
+type diffCounts struct {
+	added   uint64
+	removed uint64
+	changed uint64
+}
+
+func (c diffCounts) total() uint64 {
+	return c.added + c.removed + c.changed
+}
+
+// diffTableRecords compares the records of a table by merging the two ordered record streams, reporting the
+// records found only in the base as removed, the ones found only in the target as added and the ones whose
+// value differ as changed.
+func diffTableRecords(ctx context.Context, base, target *diffSource, table *diffTable, summaryOnly bool, out io.Writer) (counts diffCounts, err error) {
+	baseIt, err := base.iterate(ctx, table)
+	if err != nil {
+		return counts, err
+	}
+	defer baseIt.close()
+	targetIt, err := target.iterate(ctx, table)
+	if err != nil {
+		return counts, err
+	}
+	defer targetIt.close()
+
+	baseRec, baseOk, err := baseIt.next()
+	if err != nil {
+		return counts, err
+	}
+	targetRec, targetOk, err := targetIt.next()
+	if err != nil {
+		return counts, err
+	}
+	for baseOk || targetOk {
+		cmp := 0
+		switch {
+		case !targetOk:
+			cmp = -1
+		case !baseOk:
+			cmp = 1
+		default:
+			cmp = bytes.Compare(baseRec.key, targetRec.key)
+		}
+
+		switch {
+		case cmp < 0:
+			counts.removed++
+			if !summaryOnly {
+				fmt.Fprintf(out, "- %s %s : %s\n", table.name, table.formatKey(baseRec.key), table.formatValue(baseRec.value))
+			}
+		case cmp > 0:
+			counts.added++
+			if !summaryOnly {
+				fmt.Fprintf(out, "+ %s %s : %s\n", table.name, table.formatKey(targetRec.key), table.formatValue(targetRec.value))
+			}
+		default:
+			if !bytes.Equal(baseRec.value, targetRec.value) {
+				counts.changed++
+				if !summaryOnly {
+					fmt.Fprintf(out, "~ %s %s\n    base   : %s\n    target : %s\n", table.name, table.formatKey(baseRec.key), table.formatValue(baseRec.value), table.formatValue(targetRec.value))
+				}
+			}
+		}
+
+		if cmp <= 0 {
+			baseRec, baseOk, err = baseIt.next()
+			if err != nil {
+				return counts, err
+			}
+		}
+		if cmp >= 0 {
+			targetRec, targetOk, err = targetIt.next()
+			if err != nil {
+				return counts, err
+			}
+		}
+	}
+	return counts, nil
+}
+
+// diffTotals reports the account totals that differ between the base and the target, and returns their number.
+func diffTotals(ctx context.Context, base, target *diffSource, out io.Writer) (int, error) {
+	baseTotals, err := sqlitedriver.NewAccountsSQLReader(base.dbs.Handle).AccountsTotals(ctx, base.staging)
+	if err != nil {
+		return 0, err
+	}
+	targetTotals, err := sqlitedriver.NewAccountsSQLReader(target.dbs.Handle).AccountsTotals(ctx, target.staging)
+	if err != nil {
+		return 0, err
+	}
+	fields := []struct {
+		name         string
+		base, target uint64
+	}{
+		{"Online Money", baseTotals.Online.Money.Raw, targetTotals.Online.Money.Raw},
+		{"Online RewardUnits", baseTotals.Online.RewardUnits, targetTotals.Online.RewardUnits},
+		{"Offline Money", baseTotals.Offline.Money.Raw, targetTotals.Offline.Money.Raw},
+		{"Offline RewardUnits", baseTotals.Offline.RewardUnits, targetTotals.Offline.RewardUnits},
+		{"Not Participating Money", baseTotals.NotParticipating.Money.Raw, targetTotals.NotParticipating.Money.Raw},
+		{"Not Participating Money RewardUnits", baseTotals.NotParticipating.RewardUnits, targetTotals.NotParticipating.RewardUnits},
+		{"Rewards Level", baseTotals.RewardsLevel, targetTotals.RewardsLevel},
+	}
+	mismatches := 0
+	for _, field := range fields {
+		if field.base != field.target {
+			mismatches++
+			fmt.Fprintf(out, "~ AccountTotals - %s: %d => %d\n", field.name, field.base, field.target)
+		}
+	}
+	return mismatches, nil
+}
+
+// diffSources reports the differences between the base and the target, followed by a summary, and returns the
+// total number of differences found.
+func diffSources(ctx context.Context, base, target *diffSource, summaryOnly bool, outFile io.Writer) (uint64, error) {
+	out := bufio.NewWriterSize(outFile, 1024*1024)
+	defer out.Flush()
+
+	baseDesc, baseRound, err := base.describe(ctx)
+	if err != nil {
+		return 0, err
+	}
+	targetDesc, targetRound, err := target.describe(ctx)
+	if err != nil {
+		return 0, err
+	}
+	fmt.Fprintf(out, "Base: %s, balances round %d\n", baseDesc, baseRound)
+	fmt.Fprintf(out, "Target: %s, balances round %d\n", targetDesc, targetRound)
+	if baseRound != targetRound {
+		fmt.Fprintf(out, "Warning: comparing the balances of different rounds\n")
+	}
+
+	mismatches, err := diffTotals(ctx, base, target, out)
+	if err != nil {
+		return 0, err
+	}
+	differences := uint64(mismatches)
+	summary := make([]diffCounts, len(diffTables))
+	for i := range diffTables {
+		summary[i], err = diffTableRecords(ctx, base, target, &diffTables[i], summaryOnly, out)
+		if err != nil {
+			return 0, fmt.Errorf("unable to compare %s records : %w", diffTables[i].name, err)
+		}
+		differences += summary[i].total()
+	}
+
+	fmt.Fprintf(out, "\nSummary:\n")
+	fmt.Fprintf(out, "account totals: %d mismatching\n", mismatches)
+	for i := range diffTables {
+		fmt.Fprintf(out, "%s records: %d added, %d removed, %d changed\n", diffTables[i].name, summary[i].added, summary[i].removed, summary[i].changed)
+	}
+	if differences == 0 {
+		fmt.Fprintf(out, "No differences found\n")
+	}
+	return differences, nil
+}
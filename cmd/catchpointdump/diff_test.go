@@ -0,0 +1,141 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb/sqlitedriver"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+func makeTestDiffDatabase(t *testing.T, path string, accts map[basics.Address]basics.AccountData, update func(tx *sql.Tx)) {
+	dbs, err := db.MakeAccessor(path, false, false)
+	require.NoError(t, err)
+	defer dbs.Close()
+	err = dbs.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		sqlitedriver.AccountsInitTest(t, tx, accts, protocol.ConsensusCurrentVersion)
+		_, err := tx.Exec("INSERT INTO kvstore (key, value) VALUES (?, ?), (?, ?)", []byte("a"), []byte("1"), []byte("b"), []byte("2"))
+		require.NoError(t, err)
+		update(tx)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestDiffTrackerDatabases(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	accts := ledgertesting.RandomAccounts(10, true)
+	var addrs []basics.Address
+	for addr := range accts {
+		addrs = append(addrs, addr)
+	}
+	dir := t.TempDir()
+	basePath := filepath.Join(dir, "base.sqlite")
+	targetPath := filepath.Join(dir, "target.sqlite")
+	makeTestDiffDatabase(t, basePath, accts, func(tx *sql.Tx) {})
+	makeTestDiffDatabase(t, targetPath, accts, func(tx *sql.Tx) {
+		var changed trackerdb.BaseAccountData
+		changed.SetAccountData(&basics.AccountData{MicroAlgos: basics.MicroAlgos{Raw: 1}})
+		_, err := tx.Exec("UPDATE accountbase SET data = ? WHERE address = ?", protocol.Encode(&changed), addrs[0][:])
+		require.NoError(t, err)
+		_, err = tx.Exec("DELETE FROM kvstore WHERE key = ?", []byte("a"))
+		require.NoError(t, err)
+		_, err = tx.Exec("UPDATE kvstore SET value = ? WHERE key = ?", []byte("3"), []byte("b"))
+		require.NoError(t, err)
+		_, err = tx.Exec("INSERT INTO kvstore (key, value) VALUES (?, ?)", []byte("c"), []byte("4"))
+		require.NoError(t, err)
+		_, err = tx.Exec("UPDATE accounttotals SET rewardslevel = rewardslevel + 1")
+		require.NoError(t, err)
+	})
+
+	base, err := openDiffSource(basePath, "", false)
+	require.NoError(t, err)
+	defer base.close()
+	target, err := openDiffSource(targetPath, "", false)
+	require.NoError(t, err)
+	defer target.close()
+
+	// a source is identical to itself
+	var out bytes.Buffer
+	differences, err := diffSources(context.Background(), base, base, false, &out)
+	require.NoError(t, err)
+	require.Zero(t, differences)
+	require.Contains(t, out.String(), "No differences found")
+
+	out.Reset()
+	differences, err = diffSources(context.Background(), base, target, false, &out)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), differences)
+	report := out.String()
+	require.Contains(t, report, "~ AccountTotals - Rewards Level")
+	require.Contains(t, report, "~ account "+addrs[0].String())
+	require.Contains(t, report, "- kv YQ== : MQ==")
+	require.Contains(t, report, "~ kv Yg==")
+	require.Contains(t, report, "+ kv Yw== : NA==")
+	require.Contains(t, report, "account records: 0 added, 0 removed, 1 changed")
+	require.Contains(t, report, "kv records: 1 added, 1 removed, 1 changed")
+
+	// the summary only reports the counts
+	out.Reset()
+	differences, err = diffSources(context.Background(), base, target, true, &out)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), differences)
+	require.NotContains(t, out.String(), "+ kv")
+	require.Contains(t, out.String(), "kv records: 1 added, 1 removed, 1 changed")
+}
+
+func TestDiffRemovesWorkDir(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dir := t.TempDir()
+	basePath := filepath.Join(dir, "base.sqlite")
+	makeTestDiffDatabase(t, basePath, ledgertesting.RandomAccounts(1, true), func(tx *sql.Tx) {})
+	workDir := t.TempDir()
+	defer func(base, target, work, out string) {
+		diffBaseFile, diffTargetFile, diffWorkDir, outFileName = base, target, work, out
+	}(diffBaseFile, diffTargetFile, diffWorkDir, outFileName)
+	diffBaseFile, diffWorkDir = basePath, workDir
+
+	// the working directory is removed whether the comparison fails or succeeds.
+	diffTargetFile = filepath.Join(dir, "missing.sqlite")
+	require.ErrorContains(t, runDiff(), "missing.sqlite")
+	entries, err := os.ReadDir(workDir)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	diffTargetFile = basePath
+	outFileName = filepath.Join(dir, "diff.txt")
+	require.NoError(t, runDiff())
+	entries, err = os.ReadDir(workDir)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+	require.FileExists(t, outFileName)
+}
@@ -0,0 +1,879 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/avm-abi/apps"
+	cmdutil "github.com/algorand/go-algorand/cmd/util"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/ledger/encoded"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb/sqlitedriver"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// exportSchemaFileName is the name of the file describing the exported tables, written to the output directory.
+const exportSchemaFileName = "schema.json"
+
+// exportAccountsBatchSize and exportResourcesBatchSize bound the number of records read from a tracker database at once.
+const exportAccountsBatchSize = 1000
+const exportResourcesBatchSize = 10000
+
+var exportOutputDir string
+var exportRowsPerFile uint64
+var exportTrackerFilename string
+var exportTables = cmdutil.MakeCobraStringSliceValue(nil, exportTableNames())
+
+func init() {
+	exportCmd.Flags().StringVarP(&catchpointFile, "tar", "t", "", "Specify the catchpoint file (either .tar or .tar.gz) to export")
+	exportCmd.Flags().StringVar(&exportTrackerFilename, "tracker", "", "Specify the ledger tracker database to export ( i.e. ./ledger.tracker.sqlite )")
+	exportCmd.Flags().StringVarP(&exportOutputDir, "output-dir", "o", "", "Specify the directory the exported files are written to")
+	exportCmd.Flags().Uint64VarP(&exportRowsPerFile, "rows-per-file", "r", 1000000, "Specify the maximal number of rows of each exported file, or 0 for a single file per table")
+	exportCmd.Flags().Var(exportTables, "tables", "List of tables to export: ["+exportTables.AllowedString()+"] (default all)")
+}
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export the ledger state of a catchpoint file or a ledger tracker database to CSV files",
+	Long: "Export the accounts, assets, asset holdings, applications, application local states, boxes, online accounts and online round params of a catchpoint file or a ledger tracker database to CSV files.\n" +
+		"Every table is written to a directory of its own under the output directory, split into files of at most --rows-per-file rows (part-00000.csv, part-00001.csv, ...), each starting with a header row. " +
+		"The records are streamed from the source, so the export does not need to hold the ledger in memory.\n" +
+		"The output directory also holds " + exportSchemaFileName + ", which describes the source, the columns of every table, and the number of rows and files written. " +
+		"Addresses are exported in their base32 form, binary values as base64, and TEAL key-value stores as json arrays of {key, type, bytes, uint} objects with base64 keys and byte values.",
+	Args: validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		if (catchpointFile == "") == (exportTrackerFilename == "") || exportOutputDir == "" {
+			cmd.HelpFunc()(cmd, args)
+			return
+		}
+		exporter, err := makeLedgerExporter(exportOutputDir, exportRowsPerFile, exportTables.GetSlice())
+		if err != nil {
+			reportErrorf("Unable to create the export directory '%s' : %v", exportOutputDir, err)
+		}
+		if catchpointFile != "" {
+			err = exportCatchpointFile(catchpointFile, exporter)
+		} else {
+			err = exportTrackerDatabase(context.Background(), exportTrackerFilename, exporter)
+		}
+		if err != nil {
+			exporter.close()
+			reportErrorf("Unable to export ledger state : %v", err)
+		}
+		err = exporter.finish()
+		if err != nil {
+			reportErrorf("Unable to complete the export : %v", err)
+		}
+		for _, table := range exporter.tables {
+			if table.enabled {
+				reportInfof("%s: %d rows in %d files", table.Name, table.Rows, table.Files)
+			}
+		}
+	},
+}
+
+// exportColumn describes a single column of an exported table.
+type exportColumn struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Description string `json:"description"`
+}
+
+// exportTable is an exported table. Its rows are written to CSV files of at most rowsPerFile rows each.
+type exportTable struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	Columns     []exportColumn `json:"columns"`
+	Rows        uint64         `json:"rows"`
+	Files       int            `json:"files"`
+
+	enabled     bool
+	dir         string
+	rowsPerFile uint64
+	fileRows    uint64
+	file        *os.File
+	buffered    *bufio.Writer
+	writer      *csv.Writer
+}
+
+const (
+	exportAccountsTable = iota
+	exportAssetsTable
+	exportAssetHoldingsTable
+	exportAppsTable
+	exportAppLocalStatesTable
+	exportBoxesTable
+	exportOnlineAccountsTable
+	exportOnlineRoundParamsTable
+)
+
+func col(name, typ, description string) exportColumn {
+	return exportColumn{Name: name, Type: typ, Description: description}
+}
+
+// votingColumns are the participation key columns shared by the accounts and online accounts tables.
+var votingColumns = []exportColumn{
+	col("vote_id", "base64", "participation key used for voting"),
+	col("selection_id", "base64", "VRF public key used for selection"),
+	col("state_proof_id", "base64", "state proof key commitment"),
+	col("vote_first_valid", "uint64", "first round the participation key is valid for"),
+	col("vote_last_valid", "uint64", "last round the participation key is valid for"),
+	col("vote_key_dilution", "uint64", "participation key dilution"),
+}
+
+// makeExportTables returns the exported tables, in the order of the exportXXXTable constants.
+func makeExportTables() []*exportTable {
+	return []*exportTable{
+		{
+			Name:        "accounts",
+			Description: "one row per account",
+			Columns: append([]exportColumn{
+				col("address", "address", "account address"),
+				col("status", "string", "Offline, Online or Not Participating"),
+				col("microalgos", "uint64", "balance in microalgos, excluding pending rewards"),
+				col("rewards_base", "uint64", "rewards level the balance was last updated at"),
+				col("rewarded_microalgos", "uint64", "total rewards received by the account"),
+				col("auth_addr", "address", "rekeyed authorization address, empty when not rekeyed"),
+				col("total_assets", "uint64", "number of assets the account is opted in to"),
+				col("total_asset_params", "uint64", "number of assets created by the account"),
+				col("total_app_local_states", "uint64", "number of applications the account is opted in to"),
+				col("total_app_params", "uint64", "number of applications created by the account"),
+				col("total_app_schema_num_uint", "uint64", "total uint slots of the application schemas allocated by the account"),
+				col("total_app_schema_num_byte_slice", "uint64", "total byte slice slots of the application schemas allocated by the account"),
+				col("total_extra_app_pages", "uint32", "total extra program pages of the applications created by the account"),
+				col("total_boxes", "uint64", "number of boxes of the account, when it is an application account"),
+				col("total_box_bytes", "uint64", "total size of the boxes of the account, when it is an application account"),
+				col("incentive_eligible", "bool", "whether the account is eligible for block incentives"),
+				col("last_proposed", "uint64", "last round the account proposed a block"),
+				col("last_heartbeat", "uint64", "last round the account sent a heartbeat"),
+			}, append(append([]exportColumn(nil), votingColumns...),
+				col("update_round", "uint64", "round the account was last updated at"),
+			)...),
+		},
+		{
+			Name:        "assets",
+			Description: "one row per asset, with the asset parameters",
+			Columns: []exportColumn{
+				col("asset_id", "uint64", "asset id"),
+				col("creator", "address", "address of the account that created the asset"),
+				col("total", "uint64", "total number of units of the asset"),
+				col("decimals", "uint32", "number of digits after the decimal point of the asset units"),
+				col("default_frozen", "bool", "whether holdings of the asset are frozen by default"),
+				col("unit_name", "string", "asset unit name"),
+				col("asset_name", "string", "asset name"),
+				col("url", "string", "asset url"),
+				col("metadata_hash", "base64", "asset metadata hash"),
+				col("manager", "address", "manager address"),
+				col("reserve", "address", "reserve address"),
+				col("freeze", "address", "freeze address"),
+				col("clawback", "address", "clawback address"),
+				col("update_round", "uint64", "round the asset parameters were last updated at"),
+			},
+		},
+		{
+			Name:        "asset_holdings",
+			Description: "one row per account opted in to an asset",
+			Columns: []exportColumn{
+				col("address", "address", "address of the holding account"),
+				col("asset_id", "uint64", "asset id"),
+				col("amount", "uint64", "number of asset units held"),
+				col("frozen", "bool", "whether the holding is frozen"),
+				col("update_round", "uint64", "round the holding was last updated at"),
+			},
+		},
+		{
+			Name:        "apps",
+			Description: "one row per application, with the application parameters",
+			Columns: []exportColumn{
+				col("app_id", "uint64", "application id"),
+				col("creator", "address", "address of the account that created the application"),
+				col("approval_program", "base64", "approval program"),
+				col("clear_state_program", "base64", "clear state program"),
+				col("global_state", "json", "global state key-value store"),
+				col("local_num_uint", "uint64", "uint slots of the local state schema"),
+				col("local_num_byte_slice", "uint64", "byte slice slots of the local state schema"),
+				col("global_num_uint", "uint64", "uint slots of the global state schema"),
+				col("global_num_byte_slice", "uint64", "byte slice slots of the global state schema"),
+				col("extra_program_pages", "uint32", "extra program pages"),
+				col("version", "uint64", "number of times the application was updated"),
+				col("update_round", "uint64", "round the application parameters were last updated at"),
+			},
+		},
+		{
+			Name:        "app_local_states",
+			Description: "one row per account opted in to an application",
+			Columns: []exportColumn{
+				col("address", "address", "address of the opted in account"),
+				col("app_id", "uint64", "application id"),
+				col("num_uint", "uint64", "uint slots of the local state schema"),
+				col("num_byte_slice", "uint64", "byte slice slots of the local state schema"),
+				col("key_value", "json", "local state key-value store"),
+				col("update_round", "uint64", "round the local state was last updated at"),
+			},
+		},
+		{
+			Name:        "boxes",
+			Description: "one row per box",
+			Columns: []exportColumn{
+				col("app_id", "uint64", "id of the application owning the box"),
+				col("name", "base64", "box name"),
+				col("value", "base64", "box content"),
+			},
+		},
+		{
+			Name:        "online_accounts",
+			Description: "the history of the online accounts over the lookback period, one row per account and update round",
+			Columns: append([]exportColumn{
+				col("address", "address", "account address"),
+				col("update_round", "uint64", "round this version of the account data was written at"),
+				col("normalized_online_balance", "uint64", "balance normalized by the rewards base, used to order the online accounts"),
+				col("microalgos", "uint64", "balance in microalgos, excluding pending rewards"),
+				col("rewards_base", "uint64", "rewards level the balance was last updated at"),
+				col("incentive_eligible", "bool", "whether the account is eligible for block incentives"),
+				col("last_proposed", "uint64", "last round the account proposed a block"),
+				col("last_heartbeat", "uint64", "last round the account sent a heartbeat"),
+			}, votingColumns...),
+		},
+		{
+			Name:        "online_round_params",
+			Description: "the online stake parameters over the lookback period, one row per round",
+			Columns: []exportColumn{
+				col("round", "uint64", "round"),
+				col("online_supply", "uint64", "total online stake in microalgos"),
+				col("rewards_level", "uint64", "rewards level"),
+				col("current_protocol", "string", "consensus protocol of the round"),
+			},
+		},
+	}
+}
+
+func exportTableNames() []string {
+	var names []string
+	for _, table := range makeExportTables() {
+		names = append(names, table.Name)
+	}
+	return names
+}
+
+// exportSource describes where the exported state was read from.
+type exportSource struct {
+	CatchpointFile string             `json:"catchpoint_file,omitempty"`
+	TrackerFile    string             `json:"tracker_database,omitempty"`
+	Catchpoint     string             `json:"catchpoint,omitempty"`
+	BalancesRound  basics.Round       `json:"balances_round"`
+	BlocksRound    basics.Round       `json:"blocks_round,omitempty"`
+	Totals         exportAccountTotal `json:"totals"`
+}
+
+// exportAccountTotal holds the account totals of the exported state.
+type exportAccountTotal struct {
+	OnlineMicroAlgos           uint64 `json:"online_microalgos"`
+	OfflineMicroAlgos          uint64 `json:"offline_microalgos"`
+	NotParticipatingMicroAlgos uint64 `json:"not_participating_microalgos"`
+	RewardsLevel               uint64 `json:"rewards_level"`
+}
+
+func makeExportAccountTotal(totals ledgercore.AccountTotals) exportAccountTotal {
+	return exportAccountTotal{
+		OnlineMicroAlgos:           totals.Online.Money.Raw,
+		OfflineMicroAlgos:          totals.Offline.Money.Raw,
+		NotParticipatingMicroAlgos: totals.NotParticipating.Money.Raw,
+		RewardsLevel:               totals.RewardsLevel,
+	}
+}
+
+// ledgerExporter writes the ledger state records to the CSV files of the exported tables.
+type ledgerExporter struct {
+	dir    string
+	source exportSource
+	tables []*exportTable
+
+	// lastAccount is the address of the last balance record, and lastAccountPending is set when more records
+	// of the same account follow, so that the account itself is only exported once.
+	lastAccount        basics.Address
+	lastAccountPending bool
+}
+
+func makeLedgerExporter(dir string, rowsPerFile uint64, tableNames []string) (*ledgerExporter, error) {
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return nil, err
+	}
+	e := &ledgerExporter{dir: dir, tables: makeExportTables()}
+	for _, table := range e.tables {
+		table.enabled = len(tableNames) == 0
+		for _, name := range tableNames {
+			if name == table.Name {
+				table.enabled = true
+			}
+		}
+		table.dir = filepath.Join(dir, table.Name)
+		table.rowsPerFile = rowsPerFile
+	}
+	return e, nil
+}
+
+// write appends a row to the table, starting a new file when the current one is full.
+func (t *exportTable) write(row []string) error {
+	if !t.enabled {
+		return nil
+	}
+	if len(row) != len(t.Columns) {
+		return fmt.Errorf("%s row has %d values rather than %d", t.Name, len(row), len(t.Columns))
+	}
+	if t.writer == nil || (t.rowsPerFile != 0 && t.fileRows >= t.rowsPerFile) {
+		err := t.closeFile()
+		if err != nil {
+			return err
+		}
+		err = t.openFile()
+		if err != nil {
+			return err
+		}
+	}
+	t.fileRows++
+	t.Rows++
+	return t.writer.Write(row)
+}
+
+func (t *exportTable) openFile() error {
+	err := os.MkdirAll(t.dir, 0755)
+	if err != nil {
+		return err
+	}
+	t.file, err = os.Create(filepath.Join(t.dir, fmt.Sprintf("part-%05d.csv", t.Files)))
+	if err != nil {
+		return err
+	}
+	t.Files++
+	t.fileRows = 0
+	t.buffered = bufio.NewWriterSize(t.file, 1024*1024)
+	t.writer = csv.NewWriter(t.buffered)
+	header := make([]string, len(t.Columns))
+	for i, column := range t.Columns {
+		header[i] = column.Name
+	}
+	return t.writer.Write(header)
+}
+
+func (t *exportTable) closeFile() error {
+	if t.writer == nil {
+		return nil
+	}
+	t.writer.Flush()
+	err := t.writer.Error()
+	if err == nil {
+		err = t.buffered.Flush()
+	}
+	closeErr := t.file.Close()
+	if err == nil {
+		err = closeErr
+	}
+	t.writer, t.buffered, t.file = nil, nil, nil
+	return err
+}
+
+// close closes the files that are still open, without writing the schema.
+func (e *ledgerExporter) close() {
+	for _, table := range e.tables {
+		table.closeFile()
+	}
+}
+
+// finish closes the exported files, and writes the schema file describing them.
+func (e *ledgerExporter) finish() error {
+	for _, table := range e.tables {
+		err := table.closeFile()
+		if err != nil {
+			return err
+		}
+	}
+	var tables []*exportTable
+	for _, table := range e.tables {
+		if table.enabled {
+			tables = append(tables, table)
+		}
+	}
+	schema := struct {
+		Source exportSource   `json:"source"`
+		Tables []*exportTable `json:"tables"`
+	}{e.source, tables}
+	data, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(e.dir, exportSchemaFileName), append(data, '\n'), 0644)
+}
+
+func formatUint(v uint64) string {
+	return strconv.FormatUint(v, 10)
+}
+
+func formatAddress(addr basics.Address) string {
+	if addr.IsZero() {
+		return ""
+	}
+	return addr.String()
+}
+
+func formatBytes(b []byte) string {
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+// exportTealValue is the json representation of an entry of a TEAL key-value store.
+type exportTealValue struct {
+	Key   string `json:"key"`
+	Type  string `json:"type"`
+	Bytes string `json:"bytes,omitempty"`
+	Uint  uint64 `json:"uint,omitempty"`
+}
+
+// formatTealKeyValue renders a TEAL key-value store as a json array sorted by key. Keys and byte values are
+// base64 encoded, since they are not necessarily valid UTF-8.
+func formatTealKeyValue(kv basics.TealKeyValue) (string, error) {
+	values := make([]exportTealValue, 0, len(kv))
+	for key, value := range kv {
+		v := exportTealValue{Key: formatBytes([]byte(key))}
+		switch value.Type {
+		case basics.TealBytesType:
+			v.Type = "bytes"
+			v.Bytes = formatBytes([]byte(value.Bytes))
+		case basics.TealUintType:
+			v.Type = "uint"
+			v.Uint = value.Uint
+		default:
+			return "", fmt.Errorf("unknown TEAL value type %d", value.Type)
+		}
+		values = append(values, v)
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i].Key < values[j].Key })
+	data, err := json.Marshal(values)
+	return string(data), err
+}
+
+func votingRow(v *trackerdb.BaseVotingData) []string {
+	return []string{
+		formatBytes(v.VoteID[:]),
+		formatBytes(v.SelectionID[:]),
+		formatBytes(v.StateProofID[:]),
+		formatUint(uint64(v.VoteFirstValid)),
+		formatUint(uint64(v.VoteLastValid)),
+		formatUint(v.VoteKeyDilution),
+	}
+}
+
+// writeBalances exports a batch of balance records, which hold an account along with some or all of its resources.
+func (e *ledgerExporter) writeBalances(records []encoded.BalanceRecordV6) error {
+	for i := range records {
+		record := &records[i]
+		if !e.lastAccountPending || record.Address != e.lastAccount {
+			err := e.writeAccount(record.Address, record.AccountData)
+			if err != nil {
+				return err
+			}
+		}
+		e.lastAccount = record.Address
+		e.lastAccountPending = record.ExpectingMoreEntries
+
+		// resources are exported in creatable index order, to make the output deterministic.
+		indexes := make([]uint64, 0, len(record.Resources))
+		for cidx := range record.Resources {
+			indexes = append(indexes, cidx)
+		}
+		sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+		for _, cidx := range indexes {
+			err := e.writeResource(record.Address, cidx, record.Resources[cidx])
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (e *ledgerExporter) writeAccount(addr basics.Address, data []byte) error {
+	var ad trackerdb.BaseAccountData
+	err := protocol.Decode(data, &ad)
+	if err != nil {
+		return fmt.Errorf("unable to decode account %s : %w", addr, err)
+	}
+	row := []string{
+		addr.String(),
+		ad.Status.String(),
+		formatUint(ad.MicroAlgos.Raw),
+		formatUint(ad.RewardsBase),
+		formatUint(ad.RewardedMicroAlgos.Raw),
+		formatAddress(ad.AuthAddr),
+		formatUint(ad.TotalAssets),
+		formatUint(ad.TotalAssetParams),
+		formatUint(ad.TotalAppLocalStates),
+		formatUint(ad.TotalAppParams),
+		formatUint(ad.TotalAppSchemaNumUint),
+		formatUint(ad.TotalAppSchemaNumByteSlice),
+		formatUint(uint64(ad.TotalExtraAppPages)),
+		formatUint(ad.TotalBoxes),
+		formatUint(ad.TotalBoxBytes),
+		strconv.FormatBool(ad.IncentiveEligible),
+		formatUint(uint64(ad.LastProposed)),
+		formatUint(uint64(ad.LastHeartbeat)),
+	}
+	row = append(row, votingRow(&ad.BaseVotingData)...)
+	row = append(row, formatUint(ad.UpdateRound))
+	return e.tables[exportAccountsTable].write(row)
+}
+
+func (e *ledgerExporter) writeResource(addr basics.Address, cidx uint64, data []byte) error {
+	var rd trackerdb.ResourcesData
+	err := protocol.Decode(data, &rd)
+	if err != nil {
+		return fmt.Errorf("unable to decode resource %d of %s : %w", cidx, addr, err)
+	}
+	if rd.IsAsset() {
+		if rd.IsOwning() {
+			params := rd.GetAssetParams()
+			err = e.tables[exportAssetsTable].write([]string{
+				formatUint(cidx),
+				addr.String(),
+				formatUint(params.Total),
+				formatUint(uint64(params.Decimals)),
+				strconv.FormatBool(params.DefaultFrozen),
+				params.UnitName,
+				params.AssetName,
+				params.URL,
+				formatBytes(params.MetadataHash[:]),
+				formatAddress(params.Manager),
+				formatAddress(params.Reserve),
+				formatAddress(params.Freeze),
+				formatAddress(params.Clawback),
+				formatUint(rd.UpdateRound),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if rd.IsHolding() {
+			holding := rd.GetAssetHolding()
+			err = e.tables[exportAssetHoldingsTable].write([]string{
+				addr.String(),
+				formatUint(cidx),
+				formatUint(holding.Amount),
+				strconv.FormatBool(holding.Frozen),
+				formatUint(rd.UpdateRound),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if rd.IsApp() {
+		if rd.IsOwning() {
+			params := rd.GetAppParams()
+			globalState, err := formatTealKeyValue(params.GlobalState)
+			if err != nil {
+				return fmt.Errorf("unable to export the global state of application %d : %w", cidx, err)
+			}
+			err = e.tables[exportAppsTable].write([]string{
+				formatUint(cidx),
+				addr.String(),
+				formatBytes(params.ApprovalProgram),
+				formatBytes(params.ClearStateProgram),
+				globalState,
+				formatUint(params.LocalStateSchema.NumUint),
+				formatUint(params.LocalStateSchema.NumByteSlice),
+				formatUint(params.GlobalStateSchema.NumUint),
+				formatUint(params.GlobalStateSchema.NumByteSlice),
+				formatUint(uint64(params.ExtraProgramPages)),
+				formatUint(params.Version),
+				formatUint(rd.UpdateRound),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if rd.IsHolding() {
+			localState := rd.GetAppLocalState()
+			keyValue, err := formatTealKeyValue(localState.KeyValue)
+			if err != nil {
+				return fmt.Errorf("unable to export the local state of %s in application %d : %w", addr, cidx, err)
+			}
+			err = e.tables[exportAppLocalStatesTable].write([]string{
+				addr.String(),
+				formatUint(cidx),
+				formatUint(localState.Schema.NumUint),
+				formatUint(localState.Schema.NumByteSlice),
+				keyValue,
+				formatUint(rd.UpdateRound),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// writeKV exports a key-value store entry. Only boxes are exported, since they are the only entries of the store.
+func (e *ledgerExporter) writeKV(key []byte, value []byte) error {
+	app, name, err := apps.SplitBoxKey(string(key))
+	if err != nil {
+		return fmt.Errorf("unable to export key-value entry %s : %w", formatBytes(key), err)
+	}
+	return e.tables[exportBoxesTable].write([]string{
+		formatUint(app),
+		formatBytes([]byte(name)),
+		formatBytes(value),
+	})
+}
+
+func (e *ledgerExporter) writeOnlineAccount(record *encoded.OnlineAccountRecordV6) error {
+	var oa trackerdb.BaseOnlineAccountData
+	err := protocol.Decode(record.Data, &oa)
+	if err != nil {
+		return fmt.Errorf("unable to decode online account %s : %w", record.Address, err)
+	}
+	row := []string{
+		record.Address.String(),
+		formatUint(uint64(record.UpdateRound)),
+		formatUint(record.NormalizedOnlineBalance),
+		formatUint(oa.MicroAlgos.Raw),
+		formatUint(oa.RewardsBase),
+		strconv.FormatBool(oa.IncentiveEligible),
+		formatUint(uint64(oa.LastProposed)),
+		formatUint(uint64(oa.LastHeartbeat)),
+	}
+	row = append(row, votingRow(&oa.BaseVotingData)...)
+	return e.tables[exportOnlineAccountsTable].write(row)
+}
+
+func (e *ledgerExporter) writeOnlineRoundParams(record *encoded.OnlineRoundParamsRecordV6) error {
+	var params ledgercore.OnlineRoundParamsData
+	err := protocol.Decode(record.Data, &params)
+	if err != nil {
+		return fmt.Errorf("unable to decode online round params of round %d : %w", record.Round, err)
+	}
+	return e.tables[exportOnlineRoundParamsTable].write([]string{
+		formatUint(uint64(record.Round)),
+		formatUint(params.OnlineSupply),
+		formatUint(params.RewardsLevel),
+		string(params.CurrentProtocol),
+	})
+}
+
+// exportCatchpointFile streams the chunks of a catchpoint file to the exporter.
+func exportCatchpointFile(catchpointFile string, e *ledgerExporter) error {
+	stats, err := os.Stat(catchpointFile)
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(catchpointFile)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	tarReader, _, err := getCatchpointTarReader(bufio.NewReader(f), stats.Size())
+	if err != nil {
+		return err
+	}
+
+	e.source.CatchpointFile = catchpointFile
+	var fileHeader ledger.CatchpointFileHeader
+	headerFound := false
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		data := make([]byte, header.Size)
+		_, err = io.ReadFull(tarReader, data)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case header.Name == ledger.CatchpointContentFileName:
+			err = protocol.Decode(data, &fileHeader)
+			if err != nil {
+				return err
+			}
+			if fileHeader.Version < ledger.CatchpointFileVersionV6 {
+				return fmt.Errorf("catchpoint file version %d is not supported", fileHeader.Version)
+			}
+			headerFound = true
+			e.source.Catchpoint = fileHeader.Catchpoint
+			e.source.BalancesRound = fileHeader.BalancesRound
+			e.source.BlocksRound = fileHeader.BlocksRound
+			e.source.Totals = makeExportAccountTotal(fileHeader.Totals)
+		case strings.HasPrefix(header.Name, "balances.") && strings.HasSuffix(header.Name, ".msgpack"):
+			if !headerFound {
+				return fmt.Errorf("found %s before %s", header.Name, ledger.CatchpointContentFileName)
+			}
+			var chunk ledger.CatchpointSnapshotChunkV6
+			err = protocol.Decode(data, &chunk)
+			if err != nil {
+				return fmt.Errorf("unable to decode %s : %w", header.Name, err)
+			}
+			err = e.writeChunk(&chunk)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if !headerFound {
+		return fmt.Errorf("no %s found in %s", ledger.CatchpointContentFileName, catchpointFile)
+	}
+	return nil
+}
+
+func (e *ledgerExporter) writeChunk(chunk *ledger.CatchpointSnapshotChunkV6) error {
+	err := e.writeBalances(chunk.Balances)
+	if err != nil {
+		return err
+	}
+	for i := range chunk.KVs {
+		err = e.writeKV(chunk.KVs[i].Key, chunk.KVs[i].Value)
+		if err != nil {
+			return err
+		}
+	}
+	for i := range chunk.OnlineAccounts {
+		err = e.writeOnlineAccount(&chunk.OnlineAccounts[i])
+		if err != nil {
+			return err
+		}
+	}
+	for i := range chunk.OnlineRoundParams {
+		err = e.writeOnlineRoundParams(&chunk.OnlineRoundParams[i])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exportTrackerDatabase streams the state of a ledger tracker database to the exporter, using the same iterators
+// that are used for creating catchpoint files.
+func exportTrackerDatabase(ctx context.Context, databaseName string, e *ledgerExporter) error {
+	dbAccessor, err := db.MakeAccessor(databaseName, true, false)
+	if err != nil {
+		return err
+	}
+	defer dbAccessor.Close()
+
+	e.source.TrackerFile = databaseName
+	return dbAccessor.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
+		ar := sqlitedriver.NewAccountsSQLReader(tx)
+		e.source.BalancesRound, err = ar.AccountsRound()
+		if err != nil {
+			return err
+		}
+		totals, err := ar.AccountsTotals(ctx, false)
+		if err != nil {
+			return err
+		}
+		e.source.Totals = makeExportAccountTotal(totals)
+
+		accountsIter := sqlitedriver.MakeEncodedAccountsBatchIter(tx)
+		defer accountsIter.Close()
+		for {
+			bals, _, err := accountsIter.Next(ctx, exportAccountsBatchSize, exportResourcesBatchSize)
+			if err != nil {
+				return err
+			}
+			if len(bals) == 0 {
+				break
+			}
+			err = e.writeBalances(bals)
+			if err != nil {
+				return err
+			}
+		}
+
+		kvsIter, err := sqlitedriver.MakeKVsIter(ctx, tx)
+		if err != nil {
+			return err
+		}
+		defer kvsIter.Close()
+		for kvsIter.Next() {
+			key, value, err := kvsIter.KeyValue()
+			if err != nil {
+				return err
+			}
+			err = e.writeKV(key, value)
+			if err != nil {
+				return err
+			}
+		}
+
+		onlineAccountsIter, err := sqlitedriver.MakeOrderedOnlineAccountsIter(ctx, tx, false, 0)
+		if err != nil {
+			return err
+		}
+		defer onlineAccountsIter.Close()
+		for onlineAccountsIter.Next() {
+			record, err := onlineAccountsIter.GetItem()
+			if err != nil {
+				return err
+			}
+			err = e.writeOnlineAccount(record)
+			if err != nil {
+				return err
+			}
+		}
+
+		onlineRoundParamsIter, err := sqlitedriver.MakeOnlineRoundParamsIter(ctx, tx, false, 0)
+		if err != nil {
+			return err
+		}
+		defer onlineRoundParamsIter.Close()
+		for onlineRoundParamsIter.Next() {
+			record, err := onlineRoundParamsIter.GetItem()
+			if err != nil {
+				return err
+			}
+			err = e.writeOnlineRoundParams(record)
+			if err != nil {
+				return err
+			}
+		}
+
+		// increase the deadline warning to disable the warning message.
+		_, _ = db.ResetTransactionWarnDeadline(ctx, tx, time.Now().Add(5*time.Second))
+		return nil
+	})
+}
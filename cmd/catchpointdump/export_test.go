@@ -0,0 +1,105 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/avm-abi/apps"
+	"github.com/algorand/go-algorand/data/basics"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func readTestExportedTable(t *testing.T, dir string, table string) (files int, rows [][]string) {
+	paths, err := filepath.Glob(filepath.Join(dir, table, "part-*.csv"))
+	require.NoError(t, err)
+	for _, path := range paths {
+		f, err := os.Open(path)
+		require.NoError(t, err)
+		records, err := csv.NewReader(f).ReadAll()
+		f.Close()
+		require.NoError(t, err)
+		require.NotEmpty(t, records)
+		rows = append(rows, records[1:]...)
+	}
+	return len(paths), rows
+}
+
+func TestExportTrackerDatabase(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	accts := ledgertesting.RandomAccounts(10, true)
+	dir := t.TempDir()
+	trackerPath := filepath.Join(dir, "tracker.sqlite")
+	makeTestDiffDatabase(t, trackerPath, accts, func(tx *sql.Tx) {
+		// replace the entries written by makeTestDiffDatabase with boxes.
+		_, err := tx.Exec("DELETE FROM kvstore")
+		require.NoError(t, err)
+		_, err = tx.Exec("INSERT INTO kvstore (key, value) VALUES (?, ?), (?, ?)",
+			[]byte(apps.MakeBoxKey(5, "one")), []byte("1"), []byte(apps.MakeBoxKey(7, "two")), []byte("2"))
+		require.NoError(t, err)
+	})
+
+	outDir := filepath.Join(dir, "export")
+	exporter, err := makeLedgerExporter(outDir, 3, []string{"accounts", "boxes"})
+	require.NoError(t, err)
+	require.NoError(t, exportTrackerDatabase(context.Background(), trackerPath, exporter))
+	require.NoError(t, exporter.finish())
+
+	files, rows := readTestExportedTable(t, outDir, "accounts")
+	require.Equal(t, 4, files)
+	require.Len(t, rows, len(accts))
+	for _, row := range rows {
+		addr, err := basics.UnmarshalChecksumAddress(row[0])
+		require.NoError(t, err)
+		require.Contains(t, accts, addr)
+		require.Equal(t, accts[addr].Status.String(), row[1])
+	}
+
+	files, rows = readTestExportedTable(t, outDir, "boxes")
+	require.Equal(t, 1, files)
+	require.Equal(t, [][]string{{"5", "b25l", "MQ=="}, {"7", "dHdv", "Mg=="}}, rows)
+
+	// tables that were not selected are not exported
+	_, err = os.Stat(filepath.Join(outDir, "assets"))
+	require.True(t, os.IsNotExist(err))
+
+	data, err := os.ReadFile(filepath.Join(outDir, exportSchemaFileName))
+	require.NoError(t, err)
+	var schema struct {
+		Source exportSource   `json:"source"`
+		Tables []*exportTable `json:"tables"`
+	}
+	require.NoError(t, json.Unmarshal(data, &schema))
+	require.Equal(t, trackerPath, schema.Source.TrackerFile)
+	require.Len(t, schema.Tables, 2)
+	require.Equal(t, "accounts", schema.Tables[0].Name)
+	require.Equal(t, uint64(len(accts)), schema.Tables[0].Rows)
+	require.Equal(t, 4, schema.Tables[0].Files)
+	require.Equal(t, "boxes", schema.Tables[1].Name)
+	require.Equal(t, uint64(2), schema.Tables[1].Rows)
+	require.Len(t, schema.Tables[1].Columns, 3)
+}
@@ -696,16 +696,17 @@ func printStateProofVerificationContext(databaseName string, stagingTables bool,
 	return nil
 }
 
-func printKeyValue(writer *bufio.Writer, key, value []byte) {
-	var pretty string
+// formatKeyValueKey renders box keys as box(app, name), and any other key as base64.
+func formatKeyValueKey(key []byte) string {
 	ai, rest, err := apps.SplitBoxKey(string(key))
 	if err == nil {
-		pretty = fmt.Sprintf("box(%d, %s)", ai, base64.StdEncoding.EncodeToString([]byte(rest)))
-	} else {
-		pretty = base64.StdEncoding.EncodeToString(key)
+		return fmt.Sprintf("box(%d, %s)", ai, base64.StdEncoding.EncodeToString([]byte(rest)))
 	}
+	return base64.StdEncoding.EncodeToString(key)
+}
 
-	fmt.Fprintf(writer, "%s : %v\n", pretty, base64.StdEncoding.EncodeToString(value))
+func printKeyValue(writer *bufio.Writer, key, value []byte) {
+	fmt.Fprintf(writer, "%s : %v\n", formatKeyValueKey(key), base64.StdEncoding.EncodeToString(value))
 }
 
 func printKeyValueStore(databaseName string, stagingTables bool, outFile *os.File) error {
@@ -35,6 +35,7 @@ import (
 	"github.com/algorand/go-algorand/cmd/util/datadir"
 	"github.com/algorand/go-algorand/crypto"
 	apiclient "github.com/algorand/go-algorand/daemon/algod/api/client"
+	"github.com/algorand/go-algorand/data/appspec"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
@@ -170,7 +171,7 @@ func init() {
 	optInAppCmd.MarkFlagRequired("app-id")
 	optInAppCmd.MarkFlagRequired("from")
 
-	callAppCmd.MarkFlagRequired("app-id")
+	// --app-id is optional with --spec, which may record it
 	callAppCmd.MarkFlagRequired("from")
 
 	closeOutAppCmd.MarkFlagRequired("app-id")
@@ -376,23 +377,31 @@ var appCmd = &cobra.Command{
 }
 
 func mustParseOnCompletion(ocString string) (oc transactions.OnCompletion) {
-	switch strings.ToLower(ocString) {
+	oc, err := parseOnCompletion(ocString)
+	if err != nil {
+		reportErrorf("unknown value for --on-completion: %s (possible values: {NoOp, OptIn, CloseOut, ClearState, UpdateApplication, DeleteApplication})", ocString)
+	}
+	return
+}
+
+// parseOnCompletion parses an on-completion action, named as for
+// --on-completion
+func parseOnCompletion(s string) (transactions.OnCompletion, error) {
+	switch strings.ToLower(s) {
 	case "noop":
-		return transactions.NoOpOC
+		return transactions.NoOpOC, nil
 	case "optin":
-		return transactions.OptInOC
+		return transactions.OptInOC, nil
 	case "closeout":
-		return transactions.CloseOutOC
+		return transactions.CloseOutOC, nil
 	case "clearstate":
-		return transactions.ClearStateOC
+		return transactions.ClearStateOC, nil
 	case "updateapplication":
-		return transactions.UpdateApplicationOC
+		return transactions.UpdateApplicationOC, nil
 	case "deleteapplication":
-		return transactions.DeleteApplicationOC
-	default:
-		reportErrorf("unknown value for --on-completion: %s (possible values: {NoOp, OptIn, CloseOut, ClearState, UpdateApplication, DeleteApplication})", ocString)
-		return
+		return transactions.DeleteApplicationOC, nil
 	}
+	return 0, fmt.Errorf("unknown on-completion %s (possible values: {NoOp, OptIn, CloseOut, ClearState, UpdateApplication, DeleteApplication})", s)
 }
 
 func getDataDirAndClient() (dataDir string, client libgoal.Client) {
@@ -804,6 +813,13 @@ var callAppCmd = &cobra.Command{
 	Long:  `Call an application, invoking application-specific functionality`,
 	Args:  validateNoPosArgsFn,
 	Run: func(cmd *cobra.Command, _ []string) {
+		if appSpecFilename != "" {
+			callAppSpecMethod(cmd)
+			return
+		}
+		if !cmd.Flags().Changed("app-id") {
+			reportErrorf(`required flag(s) "app-id" not set`)
+		}
 		dataDir, client := getDataDirAndClient()
 
 		// Parse transaction parameters
@@ -956,6 +972,12 @@ var readStateAppCmd = &cobra.Command{
 			reportErrorf(errorLocalStateRequiresAccount)
 		}
 
+		source := appspec.SourceGlobal
+		if fetchLocal {
+			source = appspec.SourceLocal
+		}
+		layout := newStateLayout(source, abiTypes)
+
 		if fetchLocal {
 			// Fetching local state. Get account information
 			ai, err := client.RawAccountApplicationInformation(account, appIdx)
@@ -972,6 +994,10 @@ var readStateAppCmd = &cobra.Command{
 			}
 
 			kv := ai.AppLocalState.KeyValue
+			if layout != nil {
+				os.Stdout.Write(decodeState(layout, kv))
+				return
+			}
 			if guessFormat {
 				kv = heuristicFormat(kv)
 			}
@@ -1002,6 +1028,10 @@ var readStateAppCmd = &cobra.Command{
 			}
 
 			kv := ai.AppParams.GlobalState
+			if layout != nil {
+				os.Stdout.Write(decodeState(layout, kv))
+				return
+			}
 			if guessFormat {
 				kv = heuristicFormat(kv)
 			}
@@ -1245,78 +1275,45 @@ func parseMethodArgJSONtoByteSlice(argTypes []string, jsonArgs []string, applica
 	return nil
 }
 
-var methodAppCmd = &cobra.Command{
-	Use:   "method",
-	Short: "Invoke an ABI method",
-	Long:  `Invoke an ARC-4 ABI method on an App (stateful contract) with an application call transaction`,
-	Args:  validateNoPosArgsFn,
-	Run: func(cmd *cobra.Command, args []string) {
-		dataDir, client := getDataDirAndClient()
-
-		// Parse transaction parameters
-		appArgsParsed, appAccounts, foreignApps, foreignAssets, boxes := getAppInputs()
-		if len(appArgsParsed) > 0 {
-			reportErrorf("--arg and --app-arg are mutually exclusive, do not use --app-arg")
-		}
-
-		// Construct schemas from args
-		localSchema := basics.StateSchema{
-			NumUint:      localSchemaUints,
-			NumByteSlice: localSchemaByteSlices,
-		}
-
-		globalSchema := basics.StateSchema{
-			NumUint:      globalSchemaUints,
-			NumByteSlice: globalSchemaByteSlices,
-		}
-
-		onCompletionEnum := mustParseOnCompletion(onCompletion)
-
-		if methodCreatesApp {
-			if appIdx != 0 {
-				reportErrorf("--app-id and --create are mutually exclusive, only provide one")
-			}
-
-			switch onCompletionEnum {
-			case transactions.CloseOutOC, transactions.ClearStateOC:
-				reportWarnf("'--on-completion %s' may be ill-formed for use with --create", onCompletion)
-			}
-
-			if rejectVersion != 0 {
-				reportErrorf("--reject-version should not be provided with --create")
-			}
-		} else {
-			if appIdx == 0 {
-				reportErrorf("one of --app-id or --create must be provided")
-			}
-
-			if localSchema != (basics.StateSchema{}) || globalSchema != (basics.StateSchema{}) {
-				reportErrorf("--global-ints, --global-byteslices, --local-ints, and --local-byteslices must only be provided with --create")
-			}
-
-			if extraPages != 0 {
-				reportErrorf("--extra-pages must only be provided with --create")
-			}
-		}
-
-		var approvalProg, clearProg []byte
-		if methodCreatesApp || onCompletionEnum == transactions.UpdateApplicationOC {
-			approvalProg, clearProg = mustParseProgArgs()
-		}
-
-		var applicationArgs [][]byte
+// methodCall is an ABI method call, or a bare application call if method is
+// empty, as made by goal app method and the app spec commands
+type methodCall struct {
+	appID        basics.AppIndex
+	method       string
+	args         []string
+	onCompletion transactions.OnCompletion
+
+	approvalProg []byte
+	clearProg    []byte
+	globalSchema basics.StateSchema
+	localSchema  basics.StateSchema
+	extraPages   uint32
+
+	appAccounts   []string
+	foreignApps   []uint64
+	foreignAssets []uint64
+	boxes         []transactions.BoxRef
+}
 
+// buildMethodCall returns the unsigned transaction group of a method call,
+// which is the transactions passed as arguments followed by the application
+// call, along with the transactions as they were read and the return type
+// of the method, which is nil for void methods and bare calls.
+func buildMethodCall(cmd *cobra.Command, client libgoal.Client, call methodCall) ([]transactions.Transaction, []transactions.SignedTxn, *abi.Type) {
+	var applicationArgs [][]byte
+	var retType *abi.Type
+	var txnArgs []transactions.SignedTxn
+	if call.method != "" {
 		// insert the method selector hash
-		hash := sha512.Sum512_256([]byte(method))
+		hash := sha512.Sum512_256([]byte(call.method))
 		applicationArgs = append(applicationArgs, hash[0:4])
 
 		// parse down the ABI type from method signature
-		_, argTypes, retTypeStr, err := abi.ParseMethodSignature(method)
+		_, argTypes, retTypeStr, err := abi.ParseMethodSignature(call.method)
 		if err != nil {
 			reportErrorf("cannot parse method signature: %v", err)
 		}
 
-		var retType *abi.Type
 		if retTypeStr != abi.VoidReturnType {
 			theRetType, typeErr := abi.TypeOf(retTypeStr)
 			if typeErr != nil {
@@ -1325,8 +1322,8 @@ var methodAppCmd = &cobra.Command{
 			retType = &theRetType
 		}
 
-		if len(methodArgs) != len(argTypes) {
-			reportErrorf("incorrect number of arguments, method expected %d but got %d", len(argTypes), len(methodArgs))
+		if len(call.args) != len(argTypes) {
+			reportErrorf("incorrect number of arguments, method expected %d but got %d", len(argTypes), len(call.args))
 		}
 
 		var txnArgTypes []string
@@ -1337,7 +1334,7 @@ var methodAppCmd = &cobra.Command{
 		var refArgValues []string
 		refArgIndexToBasicArgIndex := make(map[int]int)
 		for i, argType := range argTypes {
-			argValue := methodArgs[i]
+			argValue := call.args[i]
 			if abi.IsTransactionType(argType) {
 				txnArgTypes = append(txnArgTypes, argType)
 				txnArgValues = append(txnArgValues, argValue)
@@ -1354,7 +1351,7 @@ var methodAppCmd = &cobra.Command{
 			}
 		}
 
-		refArgsResolved, err := populateMethodCallReferenceArgs(account, appIdx, refArgTypes, refArgValues, &appAccounts, &foreignApps, &foreignAssets)
+		refArgsResolved, err := populateMethodCallReferenceArgs(account, call.appID, refArgTypes, refArgValues, &call.appAccounts, &call.foreignApps, &call.foreignAssets)
 		if err != nil {
 			reportErrorf("error populating reference arguments: %v", err)
 		}
@@ -1369,83 +1366,188 @@ var methodAppCmd = &cobra.Command{
 			reportErrorf("cannot parse arguments to ABI encoding: %v", err)
 		}
 
-		txnArgs, err := populateMethodCallTxnArgs(txnArgTypes, txnArgValues)
+		txnArgs, err = populateMethodCallTxnArgs(txnArgTypes, txnArgValues)
 		if err != nil {
 			reportErrorf("error populating transaction arguments: %v", err)
 		}
+	}
 
-		appCallTxn, err := client.MakeUnsignedApplicationCallTx(
-			appIdx, applicationArgs, appAccounts, foreignApps, foreignAssets, boxes,
-			onCompletionEnum, approvalProg, clearProg, globalSchema, localSchema, extraPages, rejectVersion)
+	appCallTxn, err := client.MakeUnsignedApplicationCallTx(
+		call.appID, applicationArgs, call.appAccounts, call.foreignApps, call.foreignAssets, call.boxes,
+		call.onCompletion, call.approvalProg, call.clearProg, call.globalSchema, call.localSchema, call.extraPages, rejectVersion)
 
-		if err != nil {
-			reportErrorf("Cannot create application txn: %v", err)
+	if err != nil {
+		reportErrorf("Cannot create application txn: %v", err)
+	}
+
+	// Fill in note and lease
+	appCallTxn.Note = parseNoteField(cmd)
+	appCallTxn.Lease = parseLease(cmd)
+
+	// Fill in rounds, fee, etc.
+	fv, lv, _, err := client.ComputeValidityRounds(firstValid, lastValid, numValidRounds)
+	if err != nil {
+		reportErrorf("Cannot determine last valid round: %s", err)
+	}
+
+	appCallTxn, err = client.FillUnsignedTxTemplate(account, fv, lv, fee, appCallTxn)
+	if err != nil {
+		reportErrorf("Cannot construct transaction: %s", err)
+	}
+	explicitFee := cmd.Flags().Changed("fee")
+	if explicitFee {
+		appCallTxn.Fee = basics.MicroAlgos{Raw: fee}
+	}
+
+	// Compile group
+	var txnGroup []transactions.Transaction
+	for i := range txnArgs {
+		txnGroup = append(txnGroup, txnArgs[i].Txn)
+	}
+	txnGroup = append(txnGroup, appCallTxn)
+	if len(txnGroup) > 1 {
+		// Only if transaction arguments are present, assign group ID
+		groupID, gidErr := client.GroupID(txnGroup)
+		if gidErr != nil {
+			reportErrorf("Cannot assign transaction group ID: %s", gidErr)
+		}
+		for i := range txnGroup {
+			txnGroup[i].Group = groupID
 		}
+	}
 
-		// Fill in note and lease
-		appCallTxn.Note = parseNoteField(cmd)
-		appCallTxn.Lease = parseLease(cmd)
+	return txnGroup, txnArgs, retType
+}
 
-		// Fill in rounds, fee, etc.
-		fv, lv, _, err := client.ComputeValidityRounds(firstValid, lastValid, numValidRounds)
-		if err != nil {
-			reportErrorf("Cannot determine last valid round: %s", err)
+// signMethodCall signs the transaction group of a method call, unless it is
+// only being written out, keeping the logic signatures of the transactions
+// passed as arguments
+func signMethodCall(client libgoal.Client, dataDir string, txnGroup []transactions.Transaction, txnArgs []transactions.SignedTxn) []transactions.SignedTxn {
+	var signedTxnGroup []transactions.SignedTxn
+	shouldSign := sign || outFilename == ""
+	for i, unsignedTxn := range txnGroup {
+		txnFromArgs := transactions.SignedTxn{}
+		if i < len(txnArgs) {
+			txnFromArgs = txnArgs[i]
 		}
 
-		appCallTxn, err = client.FillUnsignedTxTemplate(account, fv, lv, fee, appCallTxn)
-		if err != nil {
-			reportErrorf("Cannot construct transaction: %s", err)
+		if !txnFromArgs.Lsig.Blank() {
+			signedTxnGroup = append(signedTxnGroup, transactions.SignedTxn{
+				Lsig:     txnFromArgs.Lsig,
+				AuthAddr: txnFromArgs.AuthAddr,
+				Txn:      unsignedTxn,
+			})
+			continue
 		}
-		explicitFee := cmd.Flags().Changed("fee")
-		if explicitFee {
-			appCallTxn.Fee = basics.MicroAlgos{Raw: fee}
+
+		signedTxn, signErr := createSignedTransaction(client, shouldSign, dataDir, walletName, unsignedTxn, txnFromArgs.AuthAddr)
+		if signErr != nil {
+			reportErrorf(errorSigningTX, signErr)
 		}
 
-		// Compile group
-		var txnGroup []transactions.Transaction
-		for i := range txnArgs {
-			txnGroup = append(txnGroup, txnArgs[i].Txn)
+		signedTxnGroup = append(signedTxnGroup, signedTxn)
+	}
+	return signedTxnGroup
+}
+
+// the 4-byte prefix for logged return values, from https://github.com/algorandfoundation/ARCs/blob/main/ARCs/arc-0004.md#standard-format
+var abiReturnHash = []byte{0x15, 0x1f, 0x7c, 0x75}
+
+// methodReturnValue returns the value a method call returned in the last of
+// its logs
+func methodReturnValue(logs *[][]byte) ([]byte, bool) {
+	if logs == nil || len(*logs) == 0 {
+		return nil, false
+	}
+
+	lastLog := (*logs)[len(*logs)-1]
+	if !bytes.HasPrefix(lastLog, abiReturnHash) {
+		return nil, false
+	}
+	return lastLog[len(abiReturnHash):], true
+}
+
+var methodAppCmd = &cobra.Command{
+	Use:   "method",
+	Short: "Invoke an ABI method",
+	Long:  `Invoke an ARC-4 ABI method on an App (stateful contract) with an application call transaction`,
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		dataDir, client := getDataDirAndClient()
+
+		// Parse transaction parameters
+		appArgsParsed, appAccounts, foreignApps, foreignAssets, boxes := getAppInputs()
+		if len(appArgsParsed) > 0 {
+			reportErrorf("--arg and --app-arg are mutually exclusive, do not use --app-arg")
 		}
-		txnGroup = append(txnGroup, appCallTxn)
-		if len(txnGroup) > 1 {
-			// Only if transaction arguments are present, assign group ID
-			groupID, gidErr := client.GroupID(txnGroup)
-			if gidErr != nil {
-				reportErrorf("Cannot assign transaction group ID: %s", gidErr)
+
+		// Construct schemas from args
+		localSchema := basics.StateSchema{
+			NumUint:      localSchemaUints,
+			NumByteSlice: localSchemaByteSlices,
+		}
+
+		globalSchema := basics.StateSchema{
+			NumUint:      globalSchemaUints,
+			NumByteSlice: globalSchemaByteSlices,
+		}
+
+		onCompletionEnum := mustParseOnCompletion(onCompletion)
+
+		if methodCreatesApp {
+			if appIdx != 0 {
+				reportErrorf("--app-id and --create are mutually exclusive, only provide one")
 			}
-			for i := range txnGroup {
-				txnGroup[i].Group = groupID
+
+			switch onCompletionEnum {
+			case transactions.CloseOutOC, transactions.ClearStateOC:
+				reportWarnf("'--on-completion %s' may be ill-formed for use with --create", onCompletion)
 			}
-		}
 
-		// Sign transactions
-		var signedTxnGroup []transactions.SignedTxn
-		shouldSign := sign || outFilename == ""
-		for i, unsignedTxn := range txnGroup {
-			txnFromArgs := transactions.SignedTxn{}
-			if i < len(txnArgs) {
-				txnFromArgs = txnArgs[i]
+			if rejectVersion != 0 {
+				reportErrorf("--reject-version should not be provided with --create")
+			}
+		} else {
+			if appIdx == 0 {
+				reportErrorf("one of --app-id or --create must be provided")
 			}
 
-			if !txnFromArgs.Lsig.Blank() {
-				signedTxnGroup = append(signedTxnGroup, transactions.SignedTxn{
-					Lsig:     txnFromArgs.Lsig,
-					AuthAddr: txnFromArgs.AuthAddr,
-					Txn:      unsignedTxn,
-				})
-				continue
+			if localSchema != (basics.StateSchema{}) || globalSchema != (basics.StateSchema{}) {
+				reportErrorf("--global-ints, --global-byteslices, --local-ints, and --local-byteslices must only be provided with --create")
 			}
 
-			signedTxn, signErr := createSignedTransaction(client, shouldSign, dataDir, walletName, unsignedTxn, txnFromArgs.AuthAddr)
-			if signErr != nil {
-				reportErrorf(errorSigningTX, signErr)
+			if extraPages != 0 {
+				reportErrorf("--extra-pages must only be provided with --create")
 			}
+		}
 
-			signedTxnGroup = append(signedTxnGroup, signedTxn)
+		var approvalProg, clearProg []byte
+		if methodCreatesApp || onCompletionEnum == transactions.UpdateApplicationOC {
+			approvalProg, clearProg = mustParseProgArgs()
 		}
 
+		call := methodCall{
+			appID:         appIdx,
+			method:        method,
+			args:          methodArgs,
+			onCompletion:  onCompletionEnum,
+			approvalProg:  approvalProg,
+			clearProg:     clearProg,
+			globalSchema:  globalSchema,
+			localSchema:   localSchema,
+			extraPages:    extraPages,
+			appAccounts:   appAccounts,
+			foreignApps:   foreignApps,
+			foreignAssets: foreignAssets,
+			boxes:         boxes,
+		}
+		txnGroup, txnArgs, retType := buildMethodCall(cmd, client, call)
+		lv := txnGroup[len(txnGroup)-1].LastValid
+		signedTxnGroup := signMethodCall(client, dataDir, txnGroup, txnArgs)
+
 		// Output to file
 		if outFilename != "" {
+			var err error
 			if dumpForDryrun {
 				err = writeDryrunReqToFile(client, signedTxnGroup, outFilename)
 			} else {
@@ -1458,7 +1560,7 @@ var methodAppCmd = &cobra.Command{
 		}
 
 		// Broadcast
-		err = client.BroadcastTransactionGroup(signedTxnGroup)
+		err := client.BroadcastTransactionGroup(signedTxnGroup)
 		if err != nil {
 			reportErrorf(errorBroadcastingTX, err)
 		}
@@ -1499,19 +1601,11 @@ var methodAppCmd = &cobra.Command{
 				return
 			}
 
-			// the 4-byte prefix for logged return values, from https://github.com/algorandfoundation/ARCs/blob/main/ARCs/arc-0004.md#standard-format
-			var abiReturnHash = []byte{0x15, 0x1f, 0x7c, 0x75}
-
-			if resp.Logs == nil || len(*resp.Logs) == 0 {
-				reportErrorf("method %s succeed but did not log a return value", method)
-			}
-
-			lastLog := (*resp.Logs)[len(*resp.Logs)-1]
-			if !bytes.HasPrefix(lastLog, abiReturnHash) {
+			rawReturnValue, ok := methodReturnValue(resp.Logs)
+			if !ok {
 				reportErrorf("method %s succeed but did not log a return value", method)
 			}
 
-			rawReturnValue := lastLog[len(abiReturnHash):]
 			decoded, err := retType.Decode(rawReturnValue)
 			if err != nil {
 				reportErrorf("method %s succeed but its return value could not be decoded.\nThe raw return value in hex is:%s\nThe error is: %s", method, hex.EncodeToString(rawReturnValue), err)
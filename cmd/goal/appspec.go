@@ -0,0 +1,581 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/algorand/avm-abi/abi"
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/config"
+	v2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/appspec"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/libgoal"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+var (
+	appSpecFilename string
+	templateValues  []string
+)
+
+func init() {
+	appCmd.AddCommand(deployAppCmd)
+
+	deployAppCmd.Flags().StringVar(&appSpecFilename, "spec", "", "ARC-56 or ARC-32 application specification")
+	deployAppCmd.Flags().StringVarP(&account, "from", "f", "", "Account to create or update the app from")
+	deployAppCmd.Flags().Uint64Var((*uint64)(&appIdx), "app-id", 0, "Application ID to update (default is the app the specification records for this network)")
+	deployAppCmd.Flags().StringArrayVar(&templateValues, "tmpl", nil, "Value of a template variable of the programs, NAME=value, where the value has the same form as app-arg (may be repeated)")
+	deployAppCmd.Flags().StringVar(&method, "method", "", "Method to create or update the app with, by name or signature (default is a bare call when the specification allows it)")
+	deployAppCmd.Flags().StringArrayVar(&methodArgs, "arg", nil, "Args to pass to the method")
+	addTxnFlags(deployAppCmd)
+	deployAppCmd.MarkFlagRequired("spec")
+	deployAppCmd.MarkFlagRequired("from")
+
+	callAppCmd.Flags().StringVar(&appSpecFilename, "spec", "", "ARC-56 or ARC-32 application specification of the app, with which --method is called instead of passing --app-arg (the app ID defaults to the one the specification records for this network)")
+	callAppCmd.Flags().StringVar(&method, "method", "", "Method of the specification to call, by name or signature")
+	callAppCmd.Flags().StringArrayVar(&methodArgs, "arg", nil, "Args to pass to the method, with structs as JSON objects. Args left off the end take the defaults of the specification")
+}
+
+var deployAppCmd = &cobra.Command{
+	Use:   "deploy",
+	Short: "Create or update an application from its ARC-56 or ARC-32 specification",
+	Long: `Create an application from its specification, or update it when its programs have changed. ` +
+		`The application is the one given by --app-id, or else the one the specification records for this network; ` +
+		`once an application is created it is recorded in the specification, so that deploying again updates it. ` +
+		`The programs are compiled from the source in the specification, with template variables set by --tmpl. ` +
+		`A bare call is used when the specification allows one, and otherwise the method given by --method.`,
+	Args: validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		dataDir, client := getDataDirAndClient()
+		spec := readAppSpec(appSpecFilename)
+		approval, clear := compileAppSpec(spec)
+
+		params, err := client.SuggestedParams()
+		if err != nil {
+			reportErrorf(errorRequestFail, err)
+		}
+		genesisHash := base64.StdEncoding.EncodeToString(params.GenesisHash)
+		if appIdx == 0 {
+			appIdx = spec.AppID(genesisHash)
+		}
+
+		call := methodCall{appID: appIdx, approvalProg: approval, clearProg: clear}
+		create := appIdx == 0
+		action := "created"
+		if create {
+			call.onCompletion = transactions.NoOpOC
+			call.globalSchema = spec.State.Schema.Global.StateSchema()
+			call.localSchema = spec.State.Schema.Local.StateSchema()
+			call.extraPages = appSpecExtraPages(params, approval, clear)
+		} else {
+			app, err1 := client.ApplicationInformation(appIdx)
+			if err1 != nil {
+				reportErrorf(errorRequestFail, err1)
+			}
+			if bytes.Equal(app.Params.ApprovalProgram, approval) && bytes.Equal(app.Params.ClearStateProgram, clear) {
+				reportInfof(infoAppSpecUpToDate, appIdx, spec.Name)
+				return
+			}
+			// The schema of an application is fixed when it is created
+			global := spec.State.Schema.Global.StateSchema()
+			local := spec.State.Schema.Local.StateSchema()
+			if !schemaFits(global, app.Params.GlobalStateSchema) || !schemaFits(local, app.Params.LocalStateSchema) {
+				reportErrorf(appSpecSchemaError, appIdx, global, local)
+			}
+			call.onCompletion = transactions.UpdateApplicationOC
+			action = "updated"
+		}
+
+		m := appSpecDeployMethod(spec, create, call.onCompletion, action)
+		if m != nil {
+			call.method = m.Signature()
+			args, boxes := appSpecArgs(cmd, client, spec, m, methodArgs)
+			call.args = args
+			call.boxes = boxes
+		}
+
+		resp := sendAppSpecCall(cmd, client, dataDir, call)
+		if resp == nil {
+			return
+		}
+		if create && resp.ApplicationIndex != nil && *resp.ApplicationIndex != 0 {
+			reportInfof("Created app with app index %d", *resp.ApplicationIndex)
+			err = recordAppSpecNetwork(appSpecFilename, genesisHash, *resp.ApplicationIndex)
+			if err != nil {
+				reportErrorf(fileWriteError, appSpecFilename, err)
+			}
+			reportInfof(infoAppSpecRecorded, *resp.ApplicationIndex, appSpecFilename)
+		}
+		if m != nil {
+			reportAppSpecReturn(spec, m, resp.Logs)
+		}
+	},
+}
+
+// callAppSpecMethod calls a method of an application specification, for
+// goal app call --spec
+func callAppSpecMethod(cmd *cobra.Command) {
+	dataDir, client := getDataDirAndClient()
+	spec := readAppSpec(appSpecFilename)
+	if method == "" {
+		reportErrorf(appSpecNoMethodError)
+	}
+	m, err := spec.Method(method)
+	if err != nil {
+		reportErrorln(err.Error())
+	}
+
+	if appIdx == 0 {
+		params, err1 := client.SuggestedParams()
+		if err1 != nil {
+			reportErrorf(errorRequestFail, err1)
+		}
+		appIdx = spec.AppID(base64.StdEncoding.EncodeToString(params.GenesisHash))
+		if appIdx == 0 {
+			reportErrorf(appSpecNoApp, spec.Name)
+		}
+	}
+
+	// Methods which may only be called with one action, such as opting in,
+	// are called with it
+	oc := transactions.NoOpOC
+	if !m.Actions.Allows(false, oc) {
+		if len(m.Actions.Call) != 1 {
+			reportErrorf(appSpecActionError, m.Signature(), "calls", "NoOp")
+		}
+		oc = mustParseOnCompletion(m.Actions.Call[0])
+	}
+
+	// Boxes may also be named by the keys of the specification
+	for i, box := range appBoxes {
+		if key, ok := spec.State.Keys.Box[box]; ok {
+			appBoxes[i] = "b64:" + base64.StdEncoding.EncodeToString(key.Key)
+		}
+	}
+	appArgsParsed, appAccounts, foreignApps, foreignAssets, boxes := getAppInputs()
+	if len(appArgsParsed) > 0 {
+		reportErrorf("--arg and --app-arg are mutually exclusive, do not use --app-arg")
+	}
+	args, defaultBoxes := appSpecArgs(cmd, client, spec, m, methodArgs)
+
+	call := methodCall{
+		appID:         appIdx,
+		method:        m.Signature(),
+		args:          args,
+		onCompletion:  oc,
+		appAccounts:   appAccounts,
+		foreignApps:   foreignApps,
+		foreignAssets: foreignAssets,
+		boxes:         append(boxes, defaultBoxes...),
+	}
+
+	// Readonly methods are simulated rather than sent
+	if m.Readonly && outFilename == "" {
+		reportAppSpecReturn(spec, m, simulateMethodCall(cmd, client, call))
+		return
+	}
+	resp := sendAppSpecCall(cmd, client, dataDir, call)
+	if resp != nil {
+		reportAppSpecReturn(spec, m, resp.Logs)
+	}
+}
+
+func readAppSpec(filename string) *appspec.Spec {
+	data, err := readFile(filename)
+	if err != nil {
+		reportErrorf(fileReadError, filename, err)
+	}
+	spec, err := appspec.Parse(data)
+	if err != nil {
+		reportErrorf(appSpecParseError, filename, err)
+	}
+	return spec
+}
+
+// compileAppSpec compiles the programs of spec with the values of --tmpl
+func compileAppSpec(spec *appspec.Spec) (approval []byte, clear []byte) {
+	names := spec.TemplateNames()
+	values := make(map[string]appspec.TemplateValue)
+	for _, tv := range templateValues {
+		name, value, found := strings.Cut(tv, "=")
+		name = strings.TrimPrefix(name, "TMPL_")
+		if !found {
+			reportErrorf(appSpecTemplateError, tv, "expected NAME=value")
+		}
+		if !slices.Contains(names, name) {
+			reportErrorf(appSpecTemplateError, tv, spec.Name+" has no such variable")
+		}
+		raw, err := newAppCallBytes(value).Raw()
+		if err != nil {
+			reportErrorf(appSpecTemplateError, tv, err)
+		}
+		values[name], err = spec.TemplateValue(name, raw, strings.HasPrefix(value, "int:"))
+		if err != nil {
+			reportErrorf(appSpecTemplateError, tv, err)
+		}
+	}
+
+	approval, clear, err := spec.Compile(values)
+	if err != nil {
+		reportErrorf(appSpecCompileError, spec.Name, err)
+	}
+	return approval, clear
+}
+
+// appSpecExtraPages returns the number of extra pages programs need
+func appSpecExtraPages(params model.TransactionParametersResponse, approval []byte, clear []byte) uint32 {
+	proto, ok := config.Consensus[protocol.ConsensusVersion(params.ConsensusVersion)]
+	if !ok {
+		proto = config.Consensus[protocol.ConsensusCurrentVersion]
+	}
+	size := max(len(approval)+len(clear), 1)
+	return uint32((size - 1) / proto.MaxAppProgramLen)
+}
+
+// schemaFits tells if an application with the schema have can hold want
+func schemaFits(want basics.StateSchema, have *model.ApplicationStateSchema) bool {
+	if have == nil {
+		return want == basics.StateSchema{}
+	}
+	return want.NumUint <= have.NumUint && want.NumByteSlice <= have.NumByteSlice
+}
+
+// appSpecDeployMethod returns the method to create or update an application
+// with, or nil for a bare call
+func appSpecDeployMethod(spec *appspec.Spec, create bool, oc transactions.OnCompletion, action string) *appspec.Method {
+	if method != "" {
+		m, err := spec.Method(method)
+		if err != nil {
+			reportErrorln(err.Error())
+		}
+		if !m.Actions.Allows(create, oc) {
+			how := "calls"
+			if create {
+				how = "creation"
+			}
+			reportErrorf(appSpecActionError, m.Signature(), how, appspec.ActionName(oc))
+		}
+		return m
+	}
+
+	if spec.BareActions.Allows(create, oc) {
+		return nil
+	}
+	methods := spec.MethodsFor(create, oc)
+	if len(methods) != 1 {
+		reportErrorf(appSpecDeployError, spec.Name, action)
+	}
+	return methods[0]
+}
+
+// appSpecArgs returns the JSON arguments of a method call, along with the
+// boxes which default values were read from. Structs may be given as
+// objects, and arguments which are left off take their default values.
+func appSpecArgs(cmd *cobra.Command, client libgoal.Client, spec *appspec.Spec, m *appspec.Method, given []string) ([]string, []transactions.BoxRef) {
+	if len(given) > len(m.Args) {
+		reportErrorf("incorrect number of arguments, method expected %d but got %d", len(m.Args), len(given))
+	}
+
+	args := make([]string, len(m.Args))
+	var boxes []transactions.BoxRef
+	for i, arg := range m.Args {
+		name := arg.Name
+		if name == "" {
+			name = fmt.Sprint(i)
+		}
+		if i < len(given) {
+			args[i] = given[i]
+		} else {
+			if arg.DefaultValue == nil {
+				reportErrorf(appSpecArgError, name, m.Signature(), "it has no default")
+			}
+			value, box, err := appSpecDefault(cmd, client, spec, arg)
+			if err != nil {
+				reportErrorf(appSpecArgError, name, m.Signature(), err)
+			}
+			if box != nil {
+				boxes = append(boxes, *box)
+			}
+			// References and transactions are not given as JSON
+			var str string
+			if json.Unmarshal(value, &str) == nil && (abi.IsReferenceType(arg.Type) || abi.IsTransactionType(arg.Type)) {
+				value = []byte(str)
+			}
+			args[i] = string(value)
+		}
+
+		if arg.Struct != "" {
+			value, err := spec.ToABIJSON(arg.Struct, []byte(args[i]))
+			if err != nil {
+				reportErrorf("cannot parse argument %s of %s as %s: %v", name, m.Signature(), arg.Struct, err)
+			}
+			args[i] = string(value)
+		}
+	}
+	return args, boxes
+}
+
+// appSpecDefault returns the JSON default value of an argument, and the box
+// it was read from
+func appSpecDefault(cmd *cobra.Command, client libgoal.Client, spec *appspec.Spec, arg appspec.Arg) ([]byte, *transactions.BoxRef, error) {
+	dv := arg.DefaultValue
+	if dv.Source == appspec.SourceMethod {
+		m, err := spec.Method(dv.Data)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(m.Args) != 0 {
+			return nil, nil, fmt.Errorf("default method %s takes arguments", m.Signature())
+		}
+		logs := simulateMethodCall(cmd, client, methodCall{appID: appIdx, method: m.Signature()})
+		raw, ok := methodReturnValue(logs)
+		if !ok {
+			return nil, nil, fmt.Errorf("default method %s did not log a return value", m.Signature())
+		}
+		typ := m.Returns.Type
+		if m.Returns.Struct != "" {
+			typ = m.Returns.Struct
+		}
+		if dv.Type == "" {
+			dv.Type = typ
+		}
+		value, err := spec.ArgJSON(arg, dv.Type, raw)
+		return value, nil, err
+	}
+
+	key, err := base64.StdEncoding.DecodeString(dv.Data)
+	if err != nil {
+		return nil, nil, err
+	}
+	var raw []byte
+	var box *transactions.BoxRef
+	switch dv.Source {
+	case appspec.SourceLiteral:
+		raw = key
+	case appspec.SourceGlobal:
+		app, err1 := client.ApplicationInformation(appIdx)
+		if err1 != nil {
+			return nil, nil, err1
+		}
+		raw, err = findTealValue(app.Params.GlobalState, key)
+	case appspec.SourceLocal:
+		resp, err1 := client.AccountApplicationInformation(account, appIdx)
+		if err1 != nil {
+			return nil, nil, err1
+		}
+		if resp.AppLocalState == nil {
+			return nil, nil, fmt.Errorf(errorAccountNotOptedInToApp, account, appIdx)
+		}
+		raw, err = findTealValue(resp.AppLocalState.KeyValue, key)
+	case appspec.SourceBox:
+		b, err1 := client.GetApplicationBoxByName(appIdx, "b64:"+dv.Data)
+		if err1 != nil {
+			return nil, nil, err1
+		}
+		raw = b.Value
+		box = &transactions.BoxRef{Name: key}
+	default:
+		return nil, nil, fmt.Errorf("unknown source %s", dv.Source)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	value, err := spec.ArgJSON(arg, dv.Type, raw)
+	return value, box, err
+}
+
+// findTealValue returns the value of key in state, with integers as their
+// 8 byte encoding
+func findTealValue(state *model.TealKeyValueStore, key []byte) ([]byte, error) {
+	if state != nil {
+		for _, kv := range *state {
+			k, err := base64.StdEncoding.DecodeString(kv.Key)
+			if err != nil || !bytes.Equal(k, key) {
+				continue
+			}
+			if kv.Value.Type == uint64(basics.TealBytesType) {
+				return base64.StdEncoding.DecodeString(kv.Value.Bytes)
+			}
+			return binary.BigEndian.AppendUint64(nil, kv.Value.Uint), nil
+		}
+	}
+	return nil, fmt.Errorf("no state has key %s", encodeBytesAsAppCallBytes(key))
+}
+
+// simulateMethodCall simulates a method call without signing it, and
+// returns the logs of the application call
+func simulateMethodCall(cmd *cobra.Command, client libgoal.Client, call methodCall) *[][]byte {
+	txnGroup, txnArgs, _ := buildMethodCall(cmd, client, call)
+	stxns := make([]transactions.SignedTxn, len(txnGroup))
+	for i := range txnGroup {
+		if i < len(txnArgs) {
+			stxns[i] = txnArgs[i]
+		}
+		stxns[i].Txn = txnGroup[i]
+	}
+
+	resp, err := client.SimulateTransactions(v2.PreEncodedSimulateRequest{
+		TxnGroups:             []v2.PreEncodedSimulateRequestTransactionGroup{{Txns: stxns}},
+		AllowEmptySignatures:  true,
+		AllowUnnamedResources: true,
+	})
+	if err != nil {
+		reportErrorf(errorRequestFail, err)
+	}
+	result := resp.TxnGroups[0]
+	if result.FailureMessage != nil && *result.FailureMessage != "" {
+		reportErrorf(appSpecSimulateError, call.method, *result.FailureMessage)
+	}
+	return result.Txns[len(result.Txns)-1].Txn.Logs
+}
+
+// sendAppSpecCall signs and sends the transaction group of a call, or writes
+// it out with --out. It returns the committed application call, unless it
+// was written out or not waited for.
+func sendAppSpecCall(cmd *cobra.Command, client libgoal.Client, dataDir string, call methodCall) *model.PendingTransactionResponse {
+	txnGroup, txnArgs, _ := buildMethodCall(cmd, client, call)
+	lv := txnGroup[len(txnGroup)-1].LastValid
+	signedTxnGroup := signMethodCall(client, dataDir, txnGroup, txnArgs)
+
+	if outFilename != "" {
+		var err error
+		if dumpForDryrun {
+			err = writeDryrunReqToFile(client, signedTxnGroup, outFilename)
+		} else {
+			err = writeSignedTxnsToFile(signedTxnGroup, outFilename)
+		}
+		if err != nil {
+			reportErrorln(err.Error())
+		}
+		return nil
+	}
+
+	err := client.BroadcastTransactionGroup(signedTxnGroup)
+	if err != nil {
+		reportErrorf(errorBroadcastingTX, err)
+	}
+	var txid string
+	for _, stxn := range signedTxnGroup {
+		txid = stxn.Txn.ID().String()
+		reportInfof("Issued transaction from account %s, txid %s (fee %d)", stxn.Txn.Sender, txid, stxn.Txn.Fee.Raw)
+	}
+	if noWaitAfterSend {
+		return nil
+	}
+
+	_, err = waitForCommit(client, txid, lv)
+	if err != nil {
+		reportErrorln(err.Error())
+	}
+	resp, err := client.PendingTransactionInformation(txid)
+	if err != nil {
+		reportErrorln(err.Error())
+	}
+	return &resp
+}
+
+// reportAppSpecReturn reports the value a method returned, with the fields
+// of structs named
+func reportAppSpecReturn(spec *appspec.Spec, m *appspec.Method, logs *[][]byte) {
+	sig := m.Signature()
+	if m.Returns.Type == "" || m.Returns.Type == abi.VoidReturnType {
+		reportInfof("method %s succeeded", sig)
+		return
+	}
+	raw, ok := methodReturnValue(logs)
+	if !ok {
+		reportErrorf("method %s succeed but did not log a return value", sig)
+	}
+	typ := m.Returns.Type
+	if m.Returns.Struct != "" {
+		typ = m.Returns.Struct
+	}
+	value, err := spec.Decode(typ, raw)
+	if err != nil {
+		reportErrorf(appSpecReturnError, sig, raw, err)
+	}
+	reportInfof(infoAppSpecMethodOutput, sig, value)
+}
+
+// recordAppSpecNetwork records the application created on the network with
+// a genesis hash in the networks of a specification file, leaving the rest
+// of the file as it was
+func recordAppSpecNetwork(filename string, genesisHash string, id basics.AppIndex) error {
+	data, err := readFile(filename)
+	if err != nil {
+		return err
+	}
+	var fields map[string]json.RawMessage
+	err = json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+
+	// ARC-32 keeps the networks in its contract, like ARC-4
+	parent := fields
+	var contract map[string]json.RawMessage
+	if raw, ok := fields["contract"]; ok {
+		err = json.Unmarshal(raw, &contract)
+		if err != nil {
+			return err
+		}
+		parent = contract
+	}
+
+	networks := make(map[string]json.RawMessage)
+	if raw, ok := parent["networks"]; ok && string(raw) != "null" {
+		err = json.Unmarshal(raw, &networks)
+		if err != nil {
+			return err
+		}
+	}
+	networks[genesisHash], err = json.Marshal(appspec.Network{AppID: id})
+	if err != nil {
+		return err
+	}
+	parent["networks"], err = json.Marshal(networks)
+	if err != nil {
+		return err
+	}
+	if contract != nil {
+		fields["contract"], err = json.Marshal(contract)
+		if err != nil {
+			return err
+		}
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	err = enc.Encode(fields)
+	if err != nil {
+		return err
+	}
+	return writeFile(filename, buf.Bytes(), 0600)
+}
@@ -0,0 +1,126 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/appspec"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestAppSpecExample(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	spec := readAppSpec(filepath.Join("examples", "counter.arc56.json"))
+	require.Equal(t, []string{"START"}, spec.TemplateNames())
+
+	// The example starts from zero unless told otherwise
+	approval, clear, err := spec.Compile(nil)
+	require.NoError(t, err)
+	start, err := spec.TemplateValue("START", []byte{5}, false)
+	require.NoError(t, err)
+	approval5, _, err := spec.Compile(map[string]appspec.TemplateValue{"START": start})
+	require.NoError(t, err)
+	require.NotEqual(t, approval, approval5)
+	ops, err := logic.AssembleString("#pragma version 10\nint 1\n")
+	require.NoError(t, err)
+	require.Equal(t, ops.Program, clear)
+
+	require.Nil(t, spec.MethodsFor(true, transactions.NoOpOC))
+	require.True(t, spec.BareActions.Allows(true, transactions.NoOpOC))
+	require.True(t, spec.BareActions.Allows(false, transactions.UpdateApplicationOC))
+
+	// status returns its struct by name
+	m, err := spec.Method("status")
+	require.NoError(t, err)
+	require.True(t, m.Readonly)
+	var last basics.Address
+	last[0] = 7
+	raw := append(binary.BigEndian.AppendUint64(nil, 3), last[:]...)
+	value, err := spec.Decode(m.Returns.Struct, raw)
+	require.NoError(t, err)
+	require.Equal(t, `{"count":3,"last":"`+last.String()+`"}`, string(value))
+
+	// increment counts by one unless told otherwise
+	m, err = spec.Method("increment")
+	require.NoError(t, err)
+	by, err := spec.ArgJSON(m.Args[0], m.Args[0].DefaultValue.Type, []byte{0, 0, 0, 0, 0, 0, 0, 1})
+	require.NoError(t, err)
+	require.Equal(t, "1", string(by))
+
+	params := model.TransactionParametersResponse{ConsensusVersion: string(protocol.ConsensusCurrentVersion)}
+	require.Zero(t, appSpecExtraPages(params, approval, clear))
+	require.Equal(t, uint32(1), appSpecExtraPages(params, make([]byte, 2000), make([]byte, 49)))
+	require.Equal(t, uint32(0), appSpecExtraPages(params, make([]byte, 2000), make([]byte, 48)))
+}
+
+func TestAppSpecSchemaFits(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	have := &model.ApplicationStateSchema{NumUint: 2, NumByteSlice: 1}
+	require.True(t, schemaFits(basics.StateSchema{}, nil))
+	require.False(t, schemaFits(basics.StateSchema{NumUint: 1}, nil))
+	require.True(t, schemaFits(basics.StateSchema{NumUint: 2, NumByteSlice: 1}, have))
+	require.False(t, schemaFits(basics.StateSchema{NumUint: 1, NumByteSlice: 2}, have))
+}
+
+func TestRecordAppSpecNetwork(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dir := t.TempDir()
+	for _, tc := range []struct {
+		name string
+		spec string
+	}{
+		{"arc56.json", `{"name": "A", "desc": "<keep>", "networks": {"other": {"appID": 3}}}`},
+		{"arc56-none.json", `{"name": "A", "methods": []}`},
+		{"arc32.json", `{"contract": {"name": "A", "methods": [], "networks": null}, "hints": {}}`},
+	} {
+		filename := filepath.Join(dir, tc.name)
+		require.NoError(t, os.WriteFile(filename, []byte(tc.spec), 0600))
+		require.NoError(t, recordAppSpecNetwork(filename, "genesis", 42))
+
+		data, err := os.ReadFile(filename)
+		require.NoError(t, err)
+		spec, err := appspec.Parse(data)
+		require.NoError(t, err)
+		require.Equal(t, basics.AppIndex(42), spec.AppID("genesis"), tc.name)
+		require.Equal(t, "A", spec.Name)
+	}
+
+	// Other networks and fields are kept
+	data, err := os.ReadFile(filepath.Join(dir, "arc56.json"))
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"desc": "<keep>"`)
+	spec, err := appspec.Parse(data)
+	require.NoError(t, err)
+	require.Equal(t, basics.AppIndex(3), spec.AppID("other"))
+}
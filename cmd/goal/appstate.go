@@ -0,0 +1,172 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"strings"
+
+	"github.com/algorand/go-algorand/data/appspec"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+var (
+	abiTypes []string
+	boxType  string
+)
+
+func init() {
+	readStateAppCmd.Flags().StringVar(&appSpecFilename, "spec", "", "ARC-56 or ARC-32 application specification whose storage layout decodes the state")
+	readStateAppCmd.Flags().StringArrayVar(&abiTypes, "abi-type", nil, "Type to decode state as: KEY=TYPE for the value under KEY, in the same form as app-arg, or TYPE for every value not otherwise described. TYPE is an ABI type, an AVM type or a struct of --spec (may be repeated)")
+	appBoxInfoCmd.Flags().StringVar(&appSpecFilename, "spec", "", "ARC-56 or ARC-32 application specification whose storage layout decodes the box")
+	appBoxInfoCmd.Flags().StringVar(&boxType, "abi-type", "", "Type to decode the box as, instead of by the layout of --spec: an ABI type, an AVM type or a struct of --spec")
+}
+
+// stateLayout decodes state and box values by the storage layout of a
+// specification, and by the types given with --abi-type
+type stateLayout struct {
+	spec   *appspec.Spec
+	source string
+	// keys holds the name and type given for a key, by the key's bytes
+	keys map[string][2]string
+	// fallback is the type of values which nothing else describes
+	fallback string
+}
+
+// newStateLayout returns the layout of global state, local state or boxes,
+// as named by source, given by --spec and types, or nil when there is none
+func newStateLayout(source string, types []string) *stateLayout {
+	if appSpecFilename == "" && len(types) == 0 {
+		return nil
+	}
+	l := &stateLayout{spec: &appspec.Spec{}, source: source, keys: make(map[string][2]string)}
+	if appSpecFilename != "" {
+		l.spec = readAppSpec(appSpecFilename)
+	}
+	for _, t := range types {
+		// Types never hold '=', but keys in base64 may end with it
+		eq := strings.LastIndex(t, "=")
+		if eq < 0 {
+			if l.fallback != "" {
+				reportErrorf(appStateFallbackError, l.fallback, t)
+			}
+			l.fallback = t
+			continue
+		}
+		name, typ := t[:eq], t[eq+1:]
+		key, err := newAppCallBytes(name).Raw()
+		if err != nil {
+			reportErrorf(appStateKeyError, name, err)
+		}
+		l.keys[string(key)] = [2]string{name, typ}
+	}
+	return l
+}
+
+// decode returns the decoded value stored under key, or nil when the layout
+// does not describe it. Values of the fallback type have no name.
+func (l *stateLayout) decode(key, value []byte) (*appspec.StorageValue, error) {
+	if k, ok := l.keys[string(key)]; ok {
+		decoded, err := l.spec.Decode(k[1], value)
+		if err != nil {
+			return nil, err
+		}
+		return &appspec.StorageValue{Name: k[0], Value: decoded}, nil
+	}
+	v, err := l.spec.DecodeStorage(l.source, key, value)
+	if v != nil || err != nil || l.fallback == "" {
+		return v, err
+	}
+	decoded, err := l.spec.Decode(l.fallback, value)
+	if err != nil {
+		return nil, err
+	}
+	return &appspec.StorageValue{Value: decoded}, nil
+}
+
+// tealValueBytes returns the bytes of a state value, with uints as 8 bytes
+// in big-endian order, as they are ABI encoded
+func tealValueBytes(v basics.TealValue) []byte {
+	if v.Type == basics.TealUintType {
+		return binary.BigEndian.AppendUint64(nil, v.Uint)
+	}
+	return []byte(v.Bytes)
+}
+
+// mapKeyString returns the JSON form of a key within a map as the name of
+// an object member: strings as they are, and other values as JSON
+func mapKeyString(key []byte) string {
+	var s string
+	if json.Unmarshal(key, &s) == nil {
+		return s
+	}
+	return string(key)
+}
+
+// decodeState returns the JSON form of kv decoded by l. Values held by keys
+// are named by the key, and values held by maps are gathered in an object
+// named by the map. Values which l does not describe, or which cannot be
+// decoded, are left as they are.
+func decodeState(l *stateLayout, kv basics.TealKeyValue) []byte {
+	state := make(map[string]interface{}, len(kv))
+	maps := make(map[string]map[string]json.RawMessage)
+	for k, v := range kv {
+		decoded, err := l.decode([]byte(k), tealValueBytes(v))
+		if err != nil {
+			reportWarnf(appStateDecodeWarning, encodeBytesAsAppCallBytes([]byte(k)), err)
+		}
+		switch {
+		case decoded == nil:
+			if guessFormat {
+				k, v = heuristicFormatKey(k), heuristicFormatVal(v)
+			}
+			state[k] = json.RawMessage(protocol.EncodeJSON(v))
+		case decoded.Name == "":
+			state[encodeBytesAsAppCallBytes([]byte(k))] = json.RawMessage(decoded.Value)
+		case decoded.Key == nil:
+			state[decoded.Name] = json.RawMessage(decoded.Value)
+		default:
+			if maps[decoded.Name] == nil {
+				maps[decoded.Name] = make(map[string]json.RawMessage)
+				state[decoded.Name] = maps[decoded.Name]
+			}
+			maps[decoded.Name][mapKeyString(decoded.Key)] = decoded.Value
+		}
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	err := enc.Encode(state)
+	if err != nil {
+		reportErrorf(errorMarshalingState, err)
+	}
+	return buf.Bytes()
+}
+
+// storageName describes where a layout found a value: the name of its key,
+// or the name of its map with the key within the map
+func storageName(v *appspec.StorageValue) string {
+	if v.Key == nil {
+		return v.Name
+	}
+	return v.Name + "[" + string(v.Key) + "]"
+}
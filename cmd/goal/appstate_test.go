@@ -0,0 +1,96 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/appspec"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestDecodeState(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var last basics.Address
+	last[0] = 7
+	kv := basics.TealKeyValue{
+		"count": {Type: basics.TealUintType, Uint: 5},
+		"last":  {Type: basics.TealBytesType, Bytes: string(last[:])},
+		"other": {Type: basics.TealBytesType, Bytes: "hi"},
+	}
+
+	l := &stateLayout{
+		spec:   readAppSpec(filepath.Join("examples", "counter.arc56.json")),
+		source: appspec.SourceGlobal,
+	}
+	require.JSONEq(t, `{
+  "count": 5,
+  "last": "`+last.String()+`",
+  "other": {"tb": "hi", "tt": 1}
+}`, string(decodeState(l, kv)))
+
+	// --abi-type names keys in the form of app-arg, and types the rest
+	l.keys = map[string][2]string{"count": {"str:count", "uint64"}}
+	l.fallback = appspec.AVMString
+	require.JSONEq(t, `{
+  "str:count": 5,
+  "last": "`+last.String()+`",
+  "str:other": "hi"
+}`, string(decodeState(l, kv)))
+
+	// Values which cannot be decoded are left alone
+	l.keys = map[string][2]string{"count": {"str:count", "uint32"}}
+	l.fallback = ""
+	require.JSONEq(t, `{
+  "count": {"tt": 2, "ui": 5},
+  "last": "`+last.String()+`",
+  "other": {"tb": "hi", "tt": 1}
+}`, string(decodeState(l, kv)))
+}
+
+func TestDecodeBoxMap(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	spec, err := appspec.Parse([]byte(`{"name": "Owners", "state": {"maps": {"box": {
+  "owner": {"keyType": "uint64", "valueType": "address", "prefix": "bw=="}}}}}`))
+	require.NoError(t, err)
+	l := &stateLayout{spec: spec, source: appspec.SourceBox}
+
+	var owner basics.Address
+	owner[0] = 1
+	v, err := l.decode([]byte("o\x00\x00\x00\x00\x00\x00\x00\x03"), owner[:])
+	require.NoError(t, err)
+	require.Equal(t, "owner[3]", storageName(v))
+	require.Equal(t, `"`+owner.String()+`"`, string(v.Value))
+
+	v, err = l.decode([]byte("x"), owner[:])
+	require.NoError(t, err)
+	require.Nil(t, v)
+
+	kv := basics.TealKeyValue{
+		"o\x00\x00\x00\x00\x00\x00\x00\x03": {Type: basics.TealBytesType, Bytes: string(owner[:])},
+		"o\x00\x00\x00\x00\x00\x00\x00\x04": {Type: basics.TealBytesType, Bytes: string(owner[:])},
+	}
+	require.JSONEq(t, `{"owner": {"3": "`+owner.String()+`", "4": "`+owner.String()+`"}}`, string(decodeState(l, kv)))
+}
@@ -21,6 +21,8 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/data/appspec"
 )
 
 var boxName string
@@ -62,6 +64,13 @@ var appBoxInfoCmd = &cobra.Command{
 			reportErrorf(errorMissingBoxName)
 		}
 
+		// The type given for the box wins over the layout of --spec
+		var types []string
+		if boxType != "" {
+			types = []string{boxName + "=" + boxType}
+		}
+		layout := newStateLayout(appspec.SourceBox, types)
+
 		// Get box info
 		box, err := client.GetApplicationBoxByName(appIdx, boxName)
 		if err != nil {
@@ -80,10 +89,29 @@ var appBoxInfoCmd = &cobra.Command{
 		if !bytes.Equal(box.Name, boxNameBytes) {
 			reportErrorf(errorBoxNameMismatch, box.Name, boxNameBytes)
 		}
-		reportInfof("Name:  %s", boxName)
+		if layout == nil {
+			reportInfof("Name:  %s", boxName)
+			reportInfof("Value: %s", encodeBytesAsAppCallBytes(box.Value))
+			return
+		}
 
-		// Print box value
-		reportInfof("Value: %s", encodeBytesAsAppCallBytes(box.Value))
+		// Print the box value as the layout describes it
+		decoded, err := layout.decode(box.Name, box.Value)
+		if err != nil {
+			reportErrorf(errorDecodingBox, boxName, err)
+		}
+		if decoded == nil {
+			reportWarnln(appStateUndescribed)
+			reportInfof("Name:  %s", boxName)
+			reportInfof("Value: %s", encodeBytesAsAppCallBytes(box.Value))
+			return
+		}
+		if storageName(decoded) == boxName {
+			reportInfof("Name:  %s", boxName)
+		} else {
+			reportInfof("Name:  %s (%s)", boxName, storageName(decoded))
+		}
+		reportInfof("Value: %s", decoded.Value)
 	},
 }
 
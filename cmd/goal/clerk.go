@@ -23,6 +23,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -81,6 +82,18 @@ var (
 	simulateScratchChange         bool
 	simulateAppStateChange        bool
 	simulateAllowUnnamedResources bool
+
+	simulateProfile        bool
+	simulateProfileSources []string
+
+	simulatePopulateResources bool
+	simulatePopulatedOut      string
+
+	lintMode     string
+	lintDisabled []string
+
+	compileCost     bool
+	compileCostMode string
 )
 
 func init() {
@@ -91,6 +104,7 @@ func init() {
 	clerkCmd.AddCommand(groupCmd)
 	clerkCmd.AddCommand(splitCmd)
 	clerkCmd.AddCommand(compileCmd)
+	clerkCmd.AddCommand(lintCmd)
 	clerkCmd.AddCommand(dryrunCmd)
 	clerkCmd.AddCommand(dryrunRemoteCmd)
 	clerkCmd.AddCommand(simulateCmd)
@@ -147,6 +161,12 @@ func init() {
 	compileCmd.Flags().BoolVarP(&signProgram, "sign", "s", false, "Sign program, output is a binary signed LogicSig record")
 	compileCmd.Flags().StringVarP(&outFilename, "outfile", "o", "", "Filename to write program bytes or signed LogicSig to")
 	compileCmd.Flags().StringVarP(&account, "account", "a", "", "Account address to sign the program (If not specified, uses default account)")
+	compileCmd.Flags().BoolVar(&compileCost, "cost", false, "Estimate the minimum and worst-case opcode cost of the program over all of its control flow paths")
+	compileCmd.Flags().StringVar(&compileCostMode, "mode", "", "Mode the program runs in, for --cost: signature or application (default application for programs with stateful opcodes, signature otherwise)")
+	compileCmd.Flags().StringVarP(&protoVersion, "proto", "P", "", "Consensus protocol version id string, for the cost limits that --cost compares against")
+
+	lintCmd.Flags().StringVar(&lintMode, "mode", "", "Mode the programs run in: signature or application (default application for programs with stateful opcodes, signature otherwise)")
+	lintCmd.Flags().StringSliceVar(&lintDisabled, "disable", nil, fmt.Sprintf("Checks to skip, among: %s", lintCheckNames()))
 
 	dryrunCmd.Flags().StringVarP(&txFilename, "txfile", "t", "", "Transaction or transaction-group to test")
 	dryrunCmd.Flags().StringVarP(&protoVersion, "proto", "P", "", "Consensus protocol version id string")
@@ -177,6 +197,12 @@ func init() {
 	simulateCmd.Flags().BoolVar(&simulateScratchChange, "scratch", false, "Report scratch change during simulation time")
 	simulateCmd.Flags().BoolVar(&simulateAppStateChange, "state", false, "Report application state changes during simulation time")
 	simulateCmd.Flags().BoolVar(&simulateAllowUnnamedResources, "allow-unnamed-resources", false, "Allow access to unnamed resources during simulation")
+
+	simulateCmd.Flags().BoolVar(&simulateProfile, "profile", false, "Report opcode cost profiles of the programs evaluated during simulation")
+	simulateCmd.Flags().StringArrayVar(&simulateProfileSources, "profile-source", nil, "TEAL source of a simulated program, to print its opcode cost profile by source line (implies --profile, may be repeated)")
+
+	simulateCmd.Flags().BoolVar(&simulatePopulateResources, "populate-resources", false, "Assign the unnamed resources accessed during simulation to the transactions' reference arrays (implies --allow-unnamed-resources)")
+	simulateCmd.Flags().StringVar(&simulatePopulatedOut, "populated-out", "", "Filename for writing the populated, unsigned transaction groups (implies --populate-resources)")
 }
 
 var clerkCmd = &cobra.Command{
@@ -1002,13 +1028,102 @@ func assembleFile(fname string, printWarnings bool) (program []byte) {
 	return ops.Program
 }
 
-func assembleFileWithMap(sourceFile string, outFile string, printWarnings bool) ([]byte, logic.SourceMap, error) {
+func assembleFileWithMap(sourceFile string, outFile string, printWarnings bool) (*logic.OpStream, logic.SourceMap, error) {
 	ops := assembleFileImpl(sourceFile, printWarnings)
 	pathToSourceFromSourceMap, err := determinePathToSourceFromSourceMap(sourceFile, outFile)
 	if err != nil {
 		return nil, logic.SourceMap{}, err
 	}
-	return ops.Program, logic.GetSourceMap([]string{pathToSourceFromSourceMap}, ops.OffsetToSource), nil
+	return ops, logic.GetSourceMap([]string{pathToSourceFromSourceMap}, ops.OffsetToSource), nil
+}
+
+// programRunMode returns the mode that an assembled program runs in, given the value of a --mode
+// flag, and tells if the mode was guessed from the opcodes of the program.
+func programRunMode(ops *logic.OpStream, mode string) (logic.RunMode, bool) {
+	switch mode {
+	case "signature":
+		return logic.ModeSig, false
+	case "application":
+		return logic.ModeApp, false
+	case "":
+		if ops.HasStatefulOps {
+			return logic.ModeApp, true
+		}
+		return logic.ModeSig, true
+	default:
+		reportErrorf("unknown mode %s, expected signature or application", mode)
+		return 0, false
+	}
+}
+
+// sourceLocation returns the source line of the opcode at pc, counted from 1, or "end" for the end
+// of the program.
+func sourceLocation(ops *logic.OpStream, pc int) string {
+	if pc == len(ops.Program) {
+		return "end"
+	}
+	return strconv.Itoa(ops.OffsetToSource[pc].Line + 1)
+}
+
+// sourceLineRanges returns the source lines of the opcodes at pcs, in order, with runs of
+// consecutive lines collapsed into ranges.
+func sourceLineRanges(ops *logic.OpStream, pcs []int) string {
+	var ranges []string
+	for k := 0; k < len(pcs); {
+		first := ops.OffsetToSource[pcs[k]].Line + 1
+		last := first
+		k++
+		for ; k < len(pcs); k++ {
+			line := ops.OffsetToSource[pcs[k]].Line + 1
+			if line != last && line != last+1 {
+				break
+			}
+			last = line
+		}
+		if first == last {
+			ranges = append(ranges, strconv.Itoa(first))
+		} else {
+			ranges = append(ranges, fmt.Sprintf("%d-%d", first, last))
+		}
+	}
+	return strings.Join(ranges, ", ")
+}
+
+// printCostEstimate reports the estimated opcode cost of an assembled program, and compares it
+// with the cost limit of its mode in the consensus protocol proto.
+func printCostEstimate(w io.Writer, fname string, ops *logic.OpStream, mode logic.RunMode, proto config.ConsensusParams) {
+	estimate, err := logic.EstimateCost(ops.Program, mode)
+	if err != nil {
+		reportErrorf("%s: %s", fname, err)
+	}
+	if estimate.Bounded {
+		fmt.Fprintf(w, "%s: opcode cost %d to %d\n", fname, estimate.Min, estimate.Max)
+	} else {
+		fmt.Fprintf(w, "%s: opcode cost %d to unbounded, because of loops or recursion\n", fname, estimate.Min)
+	}
+	if len(estimate.LengthDependentPCs) != 0 {
+		fmt.Fprintf(w, "%s: worst case assumes byte arguments of the maximum length, at line(s) %s\n",
+			fname, sourceLineRanges(ops, estimate.LengthDependentPCs))
+	}
+	if len(estimate.Dominating) != 0 {
+		fmt.Fprintf(w, "%s: most expensive path: line(s) %s\n", fname, sourceLineRanges(ops, estimate.Dominating))
+	}
+
+	limit, name := proto.LogicSigMaxCost, "LogicSigMaxCost"
+	if mode == logic.ModeApp {
+		// apps can pool their budget with the other app calls of their group
+		limit, name = uint64(proto.MaxAppProgramCost), "MaxAppProgramCost"
+	}
+	switch {
+	case !estimate.Bounded:
+		fmt.Fprintf(w, "%s: cost may exceed %s %d\n", fname, name, limit)
+	case uint64(estimate.Min) > limit:
+		fmt.Fprintf(w, "%s: cost always exceeds %s %d\n", fname, name, limit)
+	case uint64(estimate.Max) > limit:
+		fmt.Fprintf(w, "%s: cost may exceed %s %d\n", fname, name, limit)
+	default:
+		fmt.Fprintf(w, "%s: cost never exceeds %s %d\n", fname, name, limit)
+	}
 }
 
 func determinePathToSourceFromSourceMap(sourceFile string, outFile string) (string, error) {
@@ -1072,7 +1187,7 @@ func disassembleFile(fname, outname string) {
 var compileCmd = &cobra.Command{
 	Use:   "compile [input file 1] [input file 2]...",
 	Short: "Compile a contract program",
-	Long:  "Reads a TEAL contract program and compiles it to binary output and contract address.",
+	Long:  "Reads a TEAL contract program and compiles it to binary output and contract address. With --cost, also estimates the range of its opcode cost over all control flow paths, the most expensive path, and whether it may exceed the cost limit of its mode.",
 	Run: func(cmd *cobra.Command, args []string) {
 		for _, fname := range args {
 			if disassemble {
@@ -1088,10 +1203,11 @@ var compileCmd = &cobra.Command{
 				}
 			}
 			shouldPrintAdditionalInfo := outname != stdoutFilenameValue
-			program, sourceMap, err := assembleFileWithMap(fname, outname, true)
+			ops, sourceMap, err := assembleFileWithMap(fname, outname, true)
 			if err != nil {
 				reportErrorf("Could not assemble: %s", err)
 			}
+			program := ops.Program
 			outblob := program
 			if signProgram {
 				dataDir := datadir.EnsureSingleDataDir()
@@ -1141,10 +1257,83 @@ var compileCmd = &cobra.Command{
 				addr := basics.Address(pd)
 				fmt.Printf("%s: %s\n", fname, addr.String())
 			}
+			if compileCost {
+				// keep the program bytes alone on stdout
+				w := io.Writer(os.Stdout)
+				if !shouldPrintAdditionalInfo {
+					w = os.Stderr
+				}
+				mode, guessed := programRunMode(ops, compileCostMode)
+				if guessed {
+					fmt.Fprintf(w, "%s: estimating cost in %s mode\n", fname, mode)
+				}
+				_, proto := getProto(protoVersion)
+				printCostEstimate(w, fname, ops, mode, proto)
+			}
+		}
+	},
+}
+
+var lintCmd = &cobra.Command{
+	Use:   "lint [input file 1] [input file 2]...",
+	Short: "Analyze contract programs for common mistakes",
+	Long: `Reads TEAL contract programs, and reports unreachable code, LogicSigs that may approve without checking RekeyTo, CloseRemainderTo or AssetCloseTo, applications that may approve without checking OnCompletion, and loops or recursion that cannot be bounded statically.
+Also reports the minimum and worst-case opcode cost of the paths to each exit of the programs. Exits with an error if there are findings.`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		disabled := make(map[logic.LintCheck]bool)
+		for _, name := range lintDisabled {
+			check := logic.LintCheck(name)
+			if !slices.Contains(logic.LintChecks, check) {
+				reportErrorf("unknown check %s, expected one of: %s", name, lintCheckNames())
+			}
+			disabled[check] = true
+		}
+
+		total := 0
+		for _, fname := range args {
+			ops := assembleFileImpl(fname, false)
+			mode, guessed := programRunMode(ops, lintMode)
+			if guessed {
+				reportInfof("%s: linting in %s mode", fname, mode)
+			}
+			report, err := logic.Lint(ops.Program, mode)
+			if err != nil {
+				reportErrorf("%s: %s", fname, err)
+			}
+			for _, finding := range report.Findings {
+				if disabled[finding.Check] {
+					continue
+				}
+				fmt.Printf("%s: %s: [%s] %s\n", fname, sourceLocation(ops, finding.PC), finding.Check, finding.Message)
+				total++
+			}
+			for _, exit := range report.Exits {
+				op := exit.Op
+				if op == "" {
+					op = "end of program"
+				}
+				if exit.Bounded {
+					fmt.Printf("%s: %s: %s cost %d to %d\n", fname, sourceLocation(ops, exit.PC), op, exit.Min, exit.Max)
+				} else {
+					fmt.Printf("%s: %s: %s cost %d to unbounded\n", fname, sourceLocation(ops, exit.PC), op, exit.Min)
+				}
+			}
+		}
+		if total != 0 {
+			reportErrorf("%d finding(s)", total)
 		}
 	},
 }
 
+func lintCheckNames() string {
+	names := make([]string, len(logic.LintChecks))
+	for i, check := range logic.LintChecks {
+		names[i] = string(check)
+	}
+	return strings.Join(names, ", ")
+}
+
 var dryrunCmd = &cobra.Command{
 	Use:   "dryrun",
 	Short: "Test a program offline",
@@ -1298,6 +1487,13 @@ var simulateCmd = &cobra.Command{
 			simulateExtraOpcodeBudget = simulation.MaxExtraOpcodeBudget
 		}
 
+		if simulatePopulatedOut != "" {
+			simulatePopulateResources = true
+		}
+		if simulatePopulateResources {
+			simulateAllowUnnamedResources = true
+		}
+
 		requestOutProvided := cmd.Flags().Changed("request-only-out")
 		resultOutProvided := cmd.Flags().Changed("result-out")
 		if requestOutProvided && resultOutProvided {
@@ -1323,6 +1519,7 @@ var simulateCmd = &cobra.Command{
 				AllowUnnamedResources: simulateAllowUnnamedResources,
 				ExtraOpcodeBudget:     simulateExtraOpcodeBudget,
 				ExecTraceConfig:       traceCmdOptionToSimulateTraceConfigModel(),
+				PopulateResources:     simulatePopulateResources,
 			}
 			err := writeFile(requestOutFilename, protocol.EncodeJSON(simulateRequest), 0600)
 			if err != nil {
@@ -1349,6 +1546,7 @@ var simulateCmd = &cobra.Command{
 				AllowUnnamedResources: simulateAllowUnnamedResources,
 				ExtraOpcodeBudget:     simulateExtraOpcodeBudget,
 				ExecTraceConfig:       traceCmdOptionToSimulateTraceConfigModel(),
+				PopulateResources:     simulatePopulateResources,
 			}
 			simulateResponse, responseErr = client.SimulateTransactions(simulateRequest)
 		} else {
@@ -1372,9 +1570,40 @@ var simulateCmd = &cobra.Command{
 		} else {
 			fmt.Println(string(encodedResponse))
 		}
+
+		if len(simulateProfileSources) != 0 {
+			reportSimulationProfiles(simulateResponse, simulateProfileSources)
+		}
+
+		if simulatePopulatedOut != "" {
+			writePopulatedTxns(simulateResponse, simulatePopulatedOut)
+		}
 	},
 }
 
+// writePopulatedTxns writes the populated transaction groups of a simulation to filename, so that
+// they can be signed with `goal clerk sign`.
+func writePopulatedTxns(simulateResponse v2.PreEncodedSimulateResponse, filename string) {
+	var populated []transactions.SignedTxn
+	for i, group := range simulateResponse.TxnGroups {
+		if group.FailureMessage != nil {
+			reportErrorf("transaction group %d failed, so its resources were not populated: %s", i, *group.FailureMessage)
+		}
+		if group.PopulateFailureMessage != nil {
+			reportErrorf("could not populate the resources of transaction group %d: %s", i, *group.PopulateFailureMessage)
+		}
+		if group.PopulatedTxns == nil {
+			reportErrorf("transaction group %d was simulated without populate-resources", i)
+		}
+		populated = append(populated, group.PopulatedTxns...)
+	}
+	err := writeSignedTxnsToFile(populated, filename)
+	if err != nil {
+		reportErrorf(fileWriteError, filename, err)
+	}
+	reportInfof("Wrote %d populated transactions to %s", len(populated), filename)
+}
+
 // unmarshalSlice converts string addresses to basics.Address
 func unmarshalSlice(accts []string) ([]basics.Address, error) {
 	result := make([]basics.Address, 0, len(accts))
@@ -1423,6 +1652,95 @@ func traceCmdOptionToSimulateTraceConfigModel() simulation.ExecTraceConfig {
 	traceConfig.Stack = traceConfig.Stack || simulateStackChange
 	traceConfig.Scratch = traceConfig.Scratch || simulateScratchChange
 	traceConfig.State = traceConfig.State || simulateAppStateChange
+	traceConfig.Profile = simulateProfile || len(simulateProfileSources) != 0
 
 	return traceConfig
 }
+
+// profiledSource is a TEAL source given to --profile-source, assembled to match it with the
+// profiles in a simulate response.
+type profiledSource struct {
+	filename string
+	lines    []string
+	ops      *logic.OpStream
+}
+
+// reportSimulationProfiles prints the opcode cost profile, by source line, of every program in the
+// response that was assembled from one of the sources. Programs are matched by their hash.
+func reportSimulationProfiles(response v2.PreEncodedSimulateResponse, sourceFiles []string) {
+	sources := make(map[crypto.Digest]profiledSource, len(sourceFiles))
+	for _, filename := range sourceFiles {
+		text, err := readFile(filename)
+		if err != nil {
+			reportErrorf(fileReadError, filename, err)
+		}
+		ops, err := logic.AssembleString(string(text))
+		if err != nil {
+			reportErrorf("%s: %s", filename, err)
+		}
+		sources[crypto.Hash(ops.Program)] = profiledSource{
+			filename: filename,
+			lines:    strings.Split(string(text), "\n"),
+			ops:      ops,
+		}
+	}
+
+	var report func(location string, profile *model.SimulationTransactionExecProfile)
+	report = func(location string, profile *model.SimulationTransactionExecProfile) {
+		if profile == nil {
+			return
+		}
+		reportProgramProfile(location+" logic sig", profile.LogicSigProfile, sources)
+		reportProgramProfile(location+" approval program", profile.ApprovalProgramProfile, sources)
+		reportProgramProfile(location+" clear state program", profile.ClearStateProgramProfile, sources)
+		if profile.InnerProfiles != nil {
+			for i := range *profile.InnerProfiles {
+				report(fmt.Sprintf("%s inner %d", location, i), &(*profile.InnerProfiles)[i])
+			}
+		}
+	}
+	for i, group := range response.TxnGroups {
+		for j, txn := range group.Txns {
+			report(fmt.Sprintf("group %d txn %d", i, j), txn.TransactionProfile)
+		}
+	}
+}
+
+func reportProgramProfile(location string, modelProfile *model.SimulationProgramProfile, sources map[crypto.Digest]profiledSource) {
+	if modelProfile == nil {
+		return
+	}
+	var hash crypto.Digest
+	copy(hash[:], modelProfile.Hash)
+	source, ok := sources[hash]
+	if !ok {
+		return
+	}
+	profile := simulation.ProgramProfile{
+		Hash: hash,
+		Cost: modelProfile.Cost,
+		PCs:  make([]simulation.PCProfile, len(modelProfile.Pcs)),
+	}
+	for i, pc := range modelProfile.Pcs {
+		profile.PCs[i] = simulation.PCProfile{PC: pc.Pc, Hits: pc.Hits, Cost: pc.Cost}
+	}
+
+	fmt.Printf("%s (%s), cost %d:\n", location, source.filename, profile.Cost)
+	fmt.Printf("%6s %8s %8s  %s\n", "line", "hits", "cost", "source")
+	for _, line := range profile.Lines(source.ops.OffsetToSource) {
+		var text string
+		if line.Line < len(source.lines) {
+			text = strings.TrimSpace(source.lines[line.Line])
+		}
+		fmt.Printf("%6d %8d %8d  %s\n", line.Line+1, line.Hits, line.Cost, text)
+	}
+	if modelProfile.Subroutines != nil {
+		for _, sub := range *modelProfile.Subroutines {
+			if loc, ok := source.ops.OffsetToSource[sub.Pc]; ok {
+				fmt.Printf("subroutine at line %d: %d calls, cost %d\n", loc.Line+1, sub.Calls, sub.Cost)
+			} else {
+				fmt.Printf("subroutine at pc %d: %d calls, cost %d\n", sub.Pc, sub.Calls, sub.Cost)
+			}
+		}
+	}
+}
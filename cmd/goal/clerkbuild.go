@@ -0,0 +1,810 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/algorand/avm-abi/apps"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/algorand/go-algorand/cmd/util/datadir"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	v2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// A batch file describes a transaction group for `goal clerk build`. It is
+// YAML, or JSON, which is YAML too:
+//
+//	sender: <default sender of the transactions>
+//	fee-payer: <entry which pays the fees of the group, default the first>
+//	txns:
+//	  - name: app
+//	    type: appl
+//	    approval-prog: approval.teal
+//	    clear-prog: clear.teal
+//	  - type: pay
+//	    receiver: ${app.address}
+//	    amount: 200000
+//	  - type: appl
+//	    app: ${app}
+//	    app-args: ["str:init"]
+//
+// Strings refer to the application or asset created by an earlier entry as
+// ${name}, and to the address of a created application as ${name.address}.
+// Entries are named by their index in the group, and by their name if they
+// have one. Created IDs are found by simulating the entries before the first
+// one which refers to them, so they are the IDs the group creates if nothing
+// else creates applications or assets before it is committed.
+
+func init() {
+	clerkCmd.AddCommand(buildCmd)
+
+	buildCmd.Flags().StringVarP(&txFilename, "infile", "i", "", "Batch file describing the transactions, in YAML or JSON")
+	buildCmd.Flags().StringVarP(&outFilename, "outfile", "o", "", "Filename for writing the unsigned transaction group")
+	buildCmd.Flags().StringVarP(&account, "from", "f", "", "Sender of the transactions which do not name one, in place of the sender of the batch file (If neither is specified, uses default account)")
+	buildCmd.MarkFlagRequired("infile")
+	buildCmd.MarkFlagRequired("outfile")
+}
+
+var buildCmd = &cobra.Command{
+	Use:   "build -i [batch file] -o [transaction file]",
+	Short: "Build a transaction group from a batch file",
+	Long: `Build a grouped, unsigned transaction group from a batch file, which lists its transactions in YAML or JSON. The fee payer of the batch pays the fees of the whole group, including those of the inner transactions the group issues in simulation.
+
+Transactions may refer to the application or asset created by an earlier transaction of the batch as ${name}, or to the address of a created application as ${name.address}, where name is the name or index of the creating transaction. These IDs are found by simulating the group, so they only hold if nothing else creates applications or assets before the group is committed.`,
+	Args: validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		data, err := readFile(txFilename)
+		if err != nil {
+			reportErrorf(fileReadError, txFilename, err)
+		}
+		batch, err := parseBatchFile(data)
+		if err != nil {
+			reportErrorf(batchParseError, txFilename, err)
+		}
+
+		dataDir := datadir.EnsureSingleDataDir()
+		client := ensureFullClient(dataDir)
+		if account != "" {
+			batch.Sender = account
+		}
+		if batch.Sender == "" {
+			accountList := makeAccountsList(dataDir)
+			batch.Sender = accountList.getDefaultAccount()
+		}
+		builder, err := newBatchBuilder(&client, filepath.Dir(txFilename), batch)
+		if err != nil {
+			reportErrorf(batchParseError, txFilename, err)
+		}
+		stxns, err := builder.build()
+		if err != nil {
+			reportErrorf(batchBuildError, err)
+		}
+
+		for i := range batch.Txns {
+			if id, ok := builder.created[i]; ok {
+				reportInfof(infoBatchCreated, builder.entryName(i), id)
+			}
+		}
+		err = writeSignedTxnsToFile(stxns, outFilename)
+		if err != nil {
+			reportErrorf(fileWriteError, outFilename, err)
+		}
+		reportInfof(infoBatchBuilt, len(stxns), outFilename, stxns[builder.feePayer].Txn.Fee.Raw, builder.entryName(builder.feePayer))
+	},
+}
+
+// batchRefRegexp matches the references to created IDs in batch files
+var batchRefRegexp = regexp.MustCompile(`\$\{([^}.]+)(\.address)?\}`)
+
+type batchFile struct {
+	Sender      string       `yaml:"sender"`
+	FeePayer    string       `yaml:"fee-payer"`
+	FirstValid  basics.Round `yaml:"firstvalid"`
+	LastValid   basics.Round `yaml:"lastvalid"`
+	ValidRounds basics.Round `yaml:"validrounds"`
+	Txns        []batchEntry `yaml:"txns"`
+}
+
+type batchEntry struct {
+	Name    string  `yaml:"name"`
+	Type    string  `yaml:"type"`
+	Sender  string  `yaml:"sender"`
+	Fee     *uint64 `yaml:"fee"`
+	Note    string  `yaml:"note"`
+	RekeyTo string  `yaml:"rekey-to"`
+
+	// Payments and asset transfers
+	Receiver string `yaml:"receiver"`
+	Amount   uint64 `yaml:"amount"`
+	CloseTo  string `yaml:"close-to"`
+
+	// Asset transfers, configurations and freezes
+	Asset        string `yaml:"asset"`
+	ClawbackFrom string `yaml:"clawback-from"`
+
+	// Asset creations and reconfigurations. The addresses of a created
+	// asset default to its creator.
+	Total         uint64  `yaml:"total"`
+	Decimals      uint32  `yaml:"decimals"`
+	DefaultFrozen bool    `yaml:"default-frozen"`
+	UnitName      string  `yaml:"unit-name"`
+	AssetName     string  `yaml:"asset-name"`
+	URL           string  `yaml:"url"`
+	Manager       *string `yaml:"manager"`
+	Reserve       *string `yaml:"reserve"`
+	Freeze        *string `yaml:"freeze"`
+	Clawback      *string `yaml:"clawback"`
+	Destroy       bool    `yaml:"destroy"`
+
+	// Asset freezes
+	Account string `yaml:"account"`
+	Frozen  bool   `yaml:"frozen"`
+
+	// Application calls. Programs are TEAL files, assembled by goal, or
+	// compiled programs with the -raw keys.
+	App             string      `yaml:"app"`
+	OnCompletion    string      `yaml:"on-completion"`
+	ApprovalProg    string      `yaml:"approval-prog"`
+	ClearProg       string      `yaml:"clear-prog"`
+	ApprovalProgRaw string      `yaml:"approval-prog-raw"`
+	ClearProgRaw    string      `yaml:"clear-prog-raw"`
+	GlobalSchema    batchSchema `yaml:"global-schema"`
+	LocalSchema     batchSchema `yaml:"local-schema"`
+	ExtraPages      uint32      `yaml:"extra-pages"`
+	AppArgs         []string    `yaml:"app-args"`
+	Accounts        []string    `yaml:"accounts"`
+	ForeignApps     []string    `yaml:"foreign-apps"`
+	ForeignAssets   []string    `yaml:"foreign-assets"`
+	Boxes           []string    `yaml:"boxes"`
+}
+
+type batchSchema struct {
+	Ints  uint64 `yaml:"ints"`
+	Bytes uint64 `yaml:"bytes"`
+}
+
+// batchClient is what building a batch needs from the node
+type batchClient interface {
+	SuggestedParams() (model.TransactionParametersResponse, error)
+	ComputeValidityRounds(firstValid, lastValid, validRounds basics.Round) (first, last, latest basics.Round, err error)
+	SimulateTransactions(request v2.PreEncodedSimulateRequest) (v2.PreEncodedSimulateResponse, error)
+}
+
+// batchBuilder builds the transactions of a batch file
+type batchBuilder struct {
+	client batchClient
+	// dir is the directory the paths of programs are relative to
+	dir   string
+	batch batchFile
+	names map[string]int
+
+	params      model.TransactionParametersResponse
+	proto       config.ConsensusParams
+	first, last basics.Round
+	feePayer    int
+
+	// created holds the IDs created by entries, once they are known
+	created map[int]uint64
+	txns    []transactions.Transaction
+}
+
+// parseBatchFile decodes a batch file, rejecting unknown keys
+func parseBatchFile(data []byte) (batchFile, error) {
+	var batch batchFile
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	err := dec.Decode(&batch)
+	if err != nil {
+		return batchFile{}, err
+	}
+	if len(batch.Txns) == 0 {
+		return batchFile{}, errors.New("batch has no transactions")
+	}
+	return batch, nil
+}
+
+func newBatchBuilder(client batchClient, dir string, batch batchFile) (*batchBuilder, error) {
+	b := &batchBuilder{client: client, dir: dir, batch: batch, names: make(map[string]int), created: make(map[int]uint64)}
+	for i := range batch.Txns {
+		b.names[strconv.Itoa(i)] = i
+	}
+	for i, e := range batch.Txns {
+		if e.Name == "" {
+			continue
+		}
+		if _, ok := b.names[e.Name]; ok {
+			return nil, fmt.Errorf("entry %d: name %q is already taken", i, e.Name)
+		}
+		b.names[e.Name] = i
+	}
+	if batch.FeePayer != "" {
+		payer, ok := b.names[batch.FeePayer]
+		if !ok {
+			return nil, fmt.Errorf("fee payer %q is not an entry", batch.FeePayer)
+		}
+		b.feePayer = payer
+	}
+	return b, nil
+}
+
+// build returns the group of the batch, unsigned
+func (b *batchBuilder) build() ([]transactions.SignedTxn, error) {
+	var err error
+	b.params, err = b.client.SuggestedParams()
+	if err != nil {
+		return nil, err
+	}
+	var ok bool
+	b.proto, ok = config.Consensus[protocol.ConsensusVersion(b.params.ConsensusVersion)]
+	if !ok {
+		return nil, fmt.Errorf("unknown consensus version %s", b.params.ConsensusVersion)
+	}
+	if len(b.batch.Txns) > b.proto.MaxTxGroupSize {
+		return nil, fmt.Errorf("batch has %d transactions, more than the %d of a group", len(b.batch.Txns), b.proto.MaxTxGroupSize)
+	}
+	b.first, b.last, _, err = b.client.ComputeValidityRounds(b.batch.FirstValid, b.batch.LastValid, b.batch.ValidRounds)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, e := range b.batch.Txns {
+		refs, err1 := b.references(i, e)
+		if err1 != nil {
+			return nil, fmt.Errorf("entry %s: %w", b.entryName(i), err1)
+		}
+		if slices.ContainsFunc(refs, func(ref int) bool { _, known := b.created[ref]; return !known }) {
+			err1 = b.learnCreated()
+			if err1 != nil {
+				return nil, err1
+			}
+		}
+		tx, err1 := b.txn(b.resolve(e))
+		if err1 != nil {
+			return nil, fmt.Errorf("entry %s: %w", b.entryName(i), err1)
+		}
+		b.txns = append(b.txns, tx)
+	}
+
+	// Simulate the whole group, to check it and to count the inner
+	// transactions its fees must cover
+	results, err := b.simulate(b.txns)
+	if err != nil {
+		return nil, err
+	}
+	inners := 0
+	for _, result := range results {
+		inners += countInnerTxns(result.Txn)
+	}
+	b.setFees(b.txns, inners)
+
+	var group transactions.TxGroup
+	for _, tx := range b.txns {
+		group.TxGroupHashes = append(group.TxGroupHashes, crypto.Digest(tx.ID()))
+	}
+	stxns := make([]transactions.SignedTxn, len(b.txns))
+	for i, tx := range b.txns {
+		if len(b.txns) > 1 {
+			tx.Group = crypto.HashObj(group)
+		}
+		stxns[i] = transactions.SignedTxn{Txn: tx}
+	}
+	return stxns, nil
+}
+
+// entryName names the entry at index i in messages
+func (b *batchBuilder) entryName(i int) string {
+	if b.batch.Txns[i].Name != "" {
+		return fmt.Sprintf("%d (%s)", i, b.batch.Txns[i].Name)
+	}
+	return strconv.Itoa(i)
+}
+
+// creates tells whether e creates an application or an asset
+func (e *batchEntry) creates() bool {
+	return (e.Type == string(protocol.ApplicationCallTx) && e.App == "") ||
+		(e.Type == string(protocol.AssetConfigTx) && e.Asset == "")
+}
+
+// strings returns pointers to the strings of e which may hold references
+func (e *batchEntry) strings() []*string {
+	out := []*string{&e.Sender, &e.Note, &e.RekeyTo, &e.Receiver, &e.CloseTo, &e.Asset, &e.ClawbackFrom,
+		&e.UnitName, &e.AssetName, &e.URL, &e.Account, &e.App}
+	for _, p := range []*string{e.Manager, e.Reserve, e.Freeze, e.Clawback} {
+		if p != nil {
+			out = append(out, p)
+		}
+	}
+	for _, list := range [][]string{e.AppArgs, e.Accounts, e.ForeignApps, e.ForeignAssets, e.Boxes} {
+		for i := range list {
+			out = append(out, &list[i])
+		}
+	}
+	return out
+}
+
+// clone returns a copy of e which shares none of its strings
+func (e batchEntry) clone() batchEntry {
+	for _, p := range []**string{&e.Manager, &e.Reserve, &e.Freeze, &e.Clawback} {
+		if *p != nil {
+			s := **p
+			*p = &s
+		}
+	}
+	e.AppArgs = slices.Clone(e.AppArgs)
+	e.Accounts = slices.Clone(e.Accounts)
+	e.ForeignApps = slices.Clone(e.ForeignApps)
+	e.ForeignAssets = slices.Clone(e.ForeignAssets)
+	e.Boxes = slices.Clone(e.Boxes)
+	return e
+}
+
+// references returns the entries which the entry at index i refers to
+func (b *batchBuilder) references(i int, e batchEntry) ([]int, error) {
+	var refs []int
+	for _, s := range e.strings() {
+		for _, match := range batchRefRegexp.FindAllStringSubmatch(*s, -1) {
+			ref, ok := b.names[match[1]]
+			if !ok {
+				return nil, fmt.Errorf("%s refers to an unknown entry", match[0])
+			}
+			if ref >= i {
+				return nil, fmt.Errorf("%s refers to an entry which is not before it", match[0])
+			}
+			target := b.batch.Txns[ref]
+			if !target.creates() {
+				return nil, fmt.Errorf("%s refers to an entry which creates nothing", match[0])
+			}
+			if match[2] != "" && target.Type != string(protocol.ApplicationCallTx) {
+				return nil, fmt.Errorf("%s refers to the address of an asset", match[0])
+			}
+			refs = append(refs, ref)
+		}
+	}
+	return refs, nil
+}
+
+// resolve returns e with its references replaced by the IDs they refer to,
+// which references has checked are known
+func (b *batchBuilder) resolve(e batchEntry) batchEntry {
+	e = e.clone()
+	for _, s := range e.strings() {
+		*s = batchRefRegexp.ReplaceAllStringFunc(*s, func(ref string) string {
+			match := batchRefRegexp.FindStringSubmatch(ref)
+			id := b.created[b.names[match[1]]]
+			if match[2] != "" {
+				return basics.AppIndex(id).Address().String()
+			}
+			return strconv.FormatUint(id, 10)
+		})
+	}
+	return e
+}
+
+// learnCreated simulates the transactions built so far, and records the
+// IDs they create
+func (b *batchBuilder) learnCreated() error {
+	results, err := b.simulate(b.txns)
+	if err != nil {
+		return err
+	}
+	for i, result := range results {
+		switch {
+		case result.Txn.ApplicationIndex != nil:
+			b.created[i] = uint64(*result.Txn.ApplicationIndex)
+		case result.Txn.AssetIndex != nil:
+			b.created[i] = uint64(*result.Txn.AssetIndex)
+		}
+	}
+	for i := range b.txns {
+		if _, ok := b.created[i]; b.batch.Txns[i].creates() && !ok {
+			return fmt.Errorf("simulation did not report the ID created by entry %s", b.entryName(i))
+		}
+	}
+	return nil
+}
+
+// simulate simulates txns as a group. The fee payer, or the first
+// transaction if the fee payer is not among txns, pays enough for every
+// inner transaction the group could issue, since their number is not known
+// yet.
+func (b *batchBuilder) simulate(txns []transactions.Transaction) ([]v2.PreEncodedSimulateTxnResult, error) {
+	group := slices.Clone(txns)
+	b.setFees(group, b.proto.MaxTxGroupSize*b.proto.MaxInnerTransactions)
+	var txgroup transactions.TxGroup
+	for _, tx := range group {
+		txgroup.TxGroupHashes = append(txgroup.TxGroupHashes, crypto.Digest(tx.ID()))
+	}
+	request := v2.PreEncodedSimulateRequest{
+		TxnGroups:            []v2.PreEncodedSimulateRequestTransactionGroup{{}},
+		AllowEmptySignatures: true,
+	}
+	for _, tx := range group {
+		if len(group) > 1 {
+			tx.Group = crypto.HashObj(txgroup)
+		}
+		request.TxnGroups[0].Txns = append(request.TxnGroups[0].Txns, transactions.SignedTxn{Txn: tx})
+	}
+
+	response, err := b.client.SimulateTransactions(request)
+	if err != nil {
+		return nil, fmt.Errorf("simulation error: %w", err)
+	}
+	if len(response.TxnGroups) != 1 {
+		return nil, fmt.Errorf("simulation returned %d groups", len(response.TxnGroups))
+	}
+	result := response.TxnGroups[0]
+	if result.FailureMessage != nil && *result.FailureMessage != "" {
+		at := ""
+		if result.FailedAt != nil && len(*result.FailedAt) > 0 {
+			at = fmt.Sprintf(" at entry %s", b.entryName((*result.FailedAt)[0]))
+		}
+		return nil, fmt.Errorf("simulation of entries 0-%d failed%s: %s", len(group)-1, at, *result.FailureMessage)
+	}
+	if len(result.Txns) != len(group) {
+		return nil, fmt.Errorf("simulation returned %d results for %d transactions", len(result.Txns), len(group))
+	}
+	return result.Txns, nil
+}
+
+// setFees sets the fees of txns, which are the first of the batch. The fee
+// payer pays for every transaction which has no fee of its own, and for
+// inners inner transactions. The first transaction pays when the fee payer
+// is not among txns.
+func (b *batchBuilder) setFees(txns []transactions.Transaction, inners int) {
+	payer := b.feePayer
+	if payer >= len(txns) {
+		payer = 0
+	}
+
+	needed := basics.MulAIntSaturate(basics.MicroAlgos{Raw: b.params.MinFee}, inners)
+	var paid basics.MicroAlgos
+	for i := range txns {
+		fee := basics.MulAIntSaturate(basics.MicroAlgos{Raw: b.params.Fee}, txns[i].EstimateEncodedSize())
+		if fee.Raw < b.params.MinFee {
+			fee.Raw = b.params.MinFee
+		}
+		needed.Raw = basics.AddSaturate(needed.Raw, fee.Raw)
+
+		txns[i].Fee = basics.MicroAlgos{}
+		if own := b.batch.Txns[i].Fee; own != nil {
+			txns[i].Fee = basics.MicroAlgos{Raw: *own}
+			if i != payer {
+				paid.Raw = basics.AddSaturate(paid.Raw, txns[i].Fee.Raw)
+			}
+		}
+	}
+	if b.batch.Txns[payer].Fee == nil {
+		txns[payer].Fee.Raw = basics.SubSaturate(needed.Raw, paid.Raw)
+	}
+}
+
+// countInnerTxns counts the inner transactions of a transaction, at all
+// depths
+func countInnerTxns(info v2.PreEncodedTxInfo) int {
+	if info.Inners == nil {
+		return 0
+	}
+	n := len(*info.Inners)
+	for _, inner := range *info.Inners {
+		n += countInnerTxns(inner)
+	}
+	return n
+}
+
+// txn makes the transaction of e, whose references are resolved
+func (b *batchBuilder) txn(e batchEntry) (transactions.Transaction, error) {
+	var tx transactions.Transaction
+	var err error
+
+	sender := e.Sender
+	if sender == "" {
+		sender = b.batch.Sender
+	}
+	if sender == "" {
+		return tx, errors.New("no sender")
+	}
+	tx.Sender, err = basics.UnmarshalChecksumAddress(sender)
+	if err != nil {
+		return tx, fmt.Errorf("sender: %w", err)
+	}
+	tx.FirstValid = b.first
+	tx.LastValid = b.last
+	tx.GenesisID = b.params.GenesisId
+	if b.proto.SupportGenesisHash {
+		copy(tx.GenesisHash[:], b.params.GenesisHash)
+	}
+	if e.Note != "" {
+		tx.Note = []byte(e.Note)
+	}
+	tx.RekeyTo, err = parseBatchAddress("rekey-to", e.RekeyTo)
+	if err != nil {
+		return tx, err
+	}
+
+	tx.Type = protocol.TxType(e.Type)
+	switch tx.Type {
+	case protocol.PaymentTx:
+		if e.Receiver == "" {
+			return tx, errors.New("payment has no receiver")
+		}
+		tx.Receiver, err = parseBatchAddress("receiver", e.Receiver)
+		if err != nil {
+			return tx, err
+		}
+		tx.Amount = basics.MicroAlgos{Raw: e.Amount}
+		tx.CloseRemainderTo, err = parseBatchAddress("close-to", e.CloseTo)
+		return tx, err
+
+	case protocol.AssetTransferTx:
+		tx.XferAsset, err = parseBatchID[basics.AssetIndex]("asset", e.Asset)
+		if err != nil {
+			return tx, err
+		}
+		if tx.XferAsset == 0 {
+			return tx, errors.New("asset transfer has no asset")
+		}
+		tx.AssetAmount = e.Amount
+		// Transfers to nobody are opt-ins
+		tx.AssetReceiver = tx.Sender
+		if e.Receiver != "" {
+			tx.AssetReceiver, err = parseBatchAddress("receiver", e.Receiver)
+			if err != nil {
+				return tx, err
+			}
+		}
+		tx.AssetCloseTo, err = parseBatchAddress("close-to", e.CloseTo)
+		if err != nil {
+			return tx, err
+		}
+		tx.AssetSender, err = parseBatchAddress("clawback-from", e.ClawbackFrom)
+		return tx, err
+
+	case protocol.AssetConfigTx:
+		tx.ConfigAsset, err = parseBatchID[basics.AssetIndex]("asset", e.Asset)
+		if err != nil {
+			return tx, err
+		}
+		if e.Destroy {
+			if tx.ConfigAsset == 0 {
+				return tx, errors.New("asset destruction has no asset")
+			}
+			return tx, nil
+		}
+		creating := tx.ConfigAsset == 0
+		if creating {
+			tx.AssetParams = basics.AssetParams{
+				Total:         e.Total,
+				Decimals:      e.Decimals,
+				DefaultFrozen: e.DefaultFrozen,
+				UnitName:      e.UnitName,
+				AssetName:     e.AssetName,
+				URL:           e.URL,
+			}
+		}
+		for _, role := range []struct {
+			key  string
+			addr *string
+			out  *basics.Address
+		}{
+			{"manager", e.Manager, &tx.AssetParams.Manager},
+			{"reserve", e.Reserve, &tx.AssetParams.Reserve},
+			{"freeze", e.Freeze, &tx.AssetParams.Freeze},
+			{"clawback", e.Clawback, &tx.AssetParams.Clawback},
+		} {
+			switch {
+			case role.addr != nil:
+				*role.out, err = parseBatchAddress(role.key, *role.addr)
+				if err != nil {
+					return tx, err
+				}
+			case creating:
+				*role.out = tx.Sender
+			default:
+				// Reconfigurations set every address, so leaving one out
+				// would clear it
+				return tx, fmt.Errorf("asset reconfiguration needs %s, which may be \"\" to clear it", role.key)
+			}
+		}
+		return tx, nil
+
+	case protocol.AssetFreezeTx:
+		tx.FreezeAsset, err = parseBatchID[basics.AssetIndex]("asset", e.Asset)
+		if err != nil {
+			return tx, err
+		}
+		if tx.FreezeAsset == 0 || e.Account == "" {
+			return tx, errors.New("asset freeze needs an asset and an account")
+		}
+		tx.FreezeAccount, err = parseBatchAddress("account", e.Account)
+		tx.AssetFrozen = e.Frozen
+		return tx, err
+
+	case protocol.ApplicationCallTx:
+		return b.appCall(tx, e)
+	}
+	return tx, fmt.Errorf("unknown transaction type %q (possible values: pay, axfer, acfg, afrz, appl)", e.Type)
+}
+
+// appCall fills in the application call fields of tx from e
+func (b *batchBuilder) appCall(tx transactions.Transaction, e batchEntry) (transactions.Transaction, error) {
+	var err error
+	tx.ApplicationID, err = parseBatchID[basics.AppIndex]("app", e.App)
+	if err != nil {
+		return tx, err
+	}
+	tx.OnCompletion = transactions.NoOpOC
+	if e.OnCompletion != "" {
+		tx.OnCompletion, err = parseOnCompletion(e.OnCompletion)
+		if err != nil {
+			return tx, err
+		}
+	}
+
+	tx.ApprovalProgram, err = b.program("approval-prog", e.ApprovalProg, e.ApprovalProgRaw)
+	if err != nil {
+		return tx, err
+	}
+	tx.ClearStateProgram, err = b.program("clear-prog", e.ClearProg, e.ClearProgRaw)
+	if err != nil {
+		return tx, err
+	}
+	if tx.ApplicationID == 0 {
+		if len(tx.ApprovalProgram) == 0 || len(tx.ClearStateProgram) == 0 {
+			return tx, errors.New("application creation needs an approval and a clear program")
+		}
+		tx.GlobalStateSchema = basics.StateSchema{NumUint: e.GlobalSchema.Ints, NumByteSlice: e.GlobalSchema.Bytes}
+		tx.LocalStateSchema = basics.StateSchema{NumUint: e.LocalSchema.Ints, NumByteSlice: e.LocalSchema.Bytes}
+		tx.ExtraProgramPages = e.ExtraPages
+	}
+
+	for i, arg := range e.AppArgs {
+		encoded, err := apps.NewAppCallBytes(arg)
+		if err != nil {
+			return tx, fmt.Errorf("app-args %d: %w", i, err)
+		}
+		raw, err := encoded.Raw()
+		if err != nil {
+			return tx, fmt.Errorf("app-args %d: %w", i, err)
+		}
+		tx.ApplicationArgs = append(tx.ApplicationArgs, raw)
+	}
+	for _, acct := range e.Accounts {
+		addr, err := parseBatchAddress("accounts", acct)
+		if err != nil {
+			return tx, err
+		}
+		tx.Accounts = append(tx.Accounts, addr)
+	}
+	for _, app := range e.ForeignApps {
+		id, err := parseBatchID[basics.AppIndex]("foreign-apps", app)
+		if err != nil {
+			return tx, err
+		}
+		tx.ForeignApps = append(tx.ForeignApps, id)
+	}
+	for _, asset := range e.ForeignAssets {
+		id, err := parseBatchID[basics.AssetIndex]("foreign-assets", asset)
+		if err != nil {
+			return tx, err
+		}
+		tx.ForeignAssets = append(tx.ForeignAssets, id)
+	}
+
+	// Boxes are [app,]encoding:value, like the --box of goal app
+	for _, box := range e.Boxes {
+		encoding, value, found := strings.Cut(box, ":")
+		if !found {
+			return tx, fmt.Errorf("boxes: %q should be of the form '[<app>,]encoding:value'", box)
+		}
+		var ref transactions.BoxRef
+		if appStr, enc, found := strings.Cut(encoding, ","); found {
+			encoding = enc
+			app, err := parseBatchID[basics.AppIndex]("boxes", appStr)
+			if err != nil {
+				return tx, err
+			}
+			if app != tx.ApplicationID {
+				index := slices.Index(tx.ForeignApps, app)
+				if index < 0 {
+					return tx, fmt.Errorf("boxes: app %d is not in foreign-apps", app)
+				}
+				ref.Index = uint64(index + 1)
+			}
+		}
+		name, err := apps.NewAppCallBytes(encoding + ":" + value)
+		if err != nil {
+			return tx, fmt.Errorf("boxes: %w", err)
+		}
+		ref.Name, err = name.Raw()
+		if err != nil {
+			return tx, fmt.Errorf("boxes: %w", err)
+		}
+		tx.Boxes = append(tx.Boxes, ref)
+	}
+	return tx, nil
+}
+
+// program reads the program of an application call, from TEAL source or
+// compiled bytes
+func (b *batchBuilder) program(key string, source string, raw string) ([]byte, error) {
+	if source != "" && raw != "" {
+		return nil, fmt.Errorf("only one of %s and %s-raw may be given", key, key)
+	}
+	path := source + raw
+	if path == "" {
+		return nil, nil
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(b.dir, path)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", key, err)
+	}
+	if raw != "" {
+		return data, nil
+	}
+	ops, err := logic.AssembleString(string(data))
+	if err != nil {
+		ops.ReportMultipleErrors(path, os.Stderr)
+		return nil, fmt.Errorf("%s: %w", key, err)
+	}
+	return ops.Program, nil
+}
+
+// parseBatchAddress parses an address of a batch entry, which is the zero
+// address if s is empty
+func parseBatchAddress(key string, s string) (basics.Address, error) {
+	if s == "" {
+		return basics.Address{}, nil
+	}
+	addr, err := basics.UnmarshalChecksumAddress(s)
+	if err != nil {
+		return basics.Address{}, fmt.Errorf("%s: %w", key, err)
+	}
+	return addr, nil
+}
+
+// parseBatchID parses an application or asset ID of a batch entry, which is
+// 0 if s is empty
+func parseBatchID[T basics.AppIndex | basics.AssetIndex](key string, s string) (T, error) {
+	if s == "" {
+		return 0, nil
+	}
+	id, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %q is not an ID", key, s)
+	}
+	return T(id), nil
+}
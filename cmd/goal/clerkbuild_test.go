@@ -0,0 +1,228 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// fakeBatchClient simulates groups by numbering the applications and assets
+// they create from 1000, and by giving every application call innerTxns
+// inner transactions
+type fakeBatchClient struct {
+	innerTxns   int
+	simulations [][]transactions.SignedTxn
+}
+
+func (c *fakeBatchClient) SuggestedParams() (model.TransactionParametersResponse, error) {
+	return model.TransactionParametersResponse{
+		ConsensusVersion: string(protocol.ConsensusCurrentVersion),
+		MinFee:           1000,
+		GenesisId:        "test-v1",
+		GenesisHash:      make([]byte, 32),
+		LastRound:        100,
+	}, nil
+}
+
+func (c *fakeBatchClient) ComputeValidityRounds(firstValid, lastValid, validRounds basics.Round) (basics.Round, basics.Round, basics.Round, error) {
+	return 100, 1100, 100, nil
+}
+
+func (c *fakeBatchClient) SimulateTransactions(request v2.PreEncodedSimulateRequest) (v2.PreEncodedSimulateResponse, error) {
+	txns := request.TxnGroups[0].Txns
+	c.simulations = append(c.simulations, txns)
+	var result v2.PreEncodedSimulateTxnGroupResult
+	next := uint64(1000)
+	for _, stxn := range txns {
+		var info v2.PreEncodedTxInfo
+		switch {
+		case stxn.Txn.Type == protocol.ApplicationCallTx && stxn.Txn.ApplicationID == 0:
+			id := basics.AppIndex(next)
+			info.ApplicationIndex = &id
+			next++
+		case stxn.Txn.Type == protocol.AssetConfigTx && stxn.Txn.ConfigAsset == 0:
+			id := basics.AssetIndex(next)
+			info.AssetIndex = &id
+			next++
+		}
+		if stxn.Txn.Type == protocol.ApplicationCallTx {
+			inners := make([]v2.PreEncodedTxInfo, c.innerTxns)
+			info.Inners = &inners
+		}
+		result.Txns = append(result.Txns, v2.PreEncodedSimulateTxnResult{Txn: info})
+	}
+	return v2.PreEncodedSimulateResponse{TxnGroups: []v2.PreEncodedSimulateTxnGroupResult{result}}, nil
+}
+
+func buildTestBatch(t *testing.T, client batchClient, spec string) ([]transactions.SignedTxn, *batchBuilder, error) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "approval.teal"), []byte("#pragma version 8\nint 1\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "clear.teal"), []byte("#pragma version 8\nint 1\n"), 0600))
+	batch, err := parseBatchFile([]byte(spec))
+	if err != nil {
+		return nil, nil, err
+	}
+	b, err := newBatchBuilder(client, dir, batch)
+	if err != nil {
+		return nil, nil, err
+	}
+	stxns, err := b.build()
+	return stxns, b, err
+}
+
+func TestClerkBuildBatch(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var sender, other basics.Address
+	sender[0] = 1
+	other[0] = 2
+	spec := strings.NewReplacer("SENDER", sender.String(), "OTHER", other.String()).Replace(`
+sender: SENDER
+fee-payer: fund
+txns:
+  - name: app
+    type: appl
+    approval-prog: approval.teal
+    clear-prog: clear.teal
+    global-schema: {ints: 1}
+  - name: token
+    type: acfg
+    total: 1000
+    unit-name: TOK
+  - name: fund
+    type: pay
+    receiver: ${app.address}
+    amount: 200000
+  - type: appl
+    app: ${app}
+    app-args: ["int:${token}", "str:init"]
+    foreign-assets: ["${1}"]
+    boxes: ["str:box"]
+  - type: axfer
+    sender: OTHER
+    asset: ${token}
+    fee: 500
+`)
+
+	client := &fakeBatchClient{innerTxns: 2}
+	stxns, b, err := buildTestBatch(t, client, spec)
+	require.NoError(t, err)
+	require.Len(t, stxns, 5)
+
+	// The IDs were learned from simulating the first two entries only
+	require.Len(t, client.simulations, 2)
+	require.Len(t, client.simulations[0], 2)
+	require.Equal(t, map[int]uint64{0: 1000, 1: 1001}, b.created)
+
+	require.Equal(t, basics.AppIndex(1000).Address(), stxns[2].Txn.Receiver)
+	call := stxns[3].Txn
+	require.Equal(t, basics.AppIndex(1000), call.ApplicationID)
+	require.Equal(t, [][]byte{{0, 0, 0, 0, 0, 0, 0x03, 0xe9}, []byte("init")}, call.ApplicationArgs)
+	require.Equal(t, []basics.AssetIndex{1001}, call.ForeignAssets)
+	require.Equal(t, []transactions.BoxRef{{Index: 0, Name: []byte("box")}}, call.Boxes)
+	optin := stxns[4].Txn
+	require.Equal(t, basics.AssetIndex(1001), optin.XferAsset)
+	require.Equal(t, other, optin.AssetReceiver)
+	require.Equal(t, sender, stxns[1].Txn.AssetParams.Manager)
+
+	// The fund entry pays for the five transactions and the four inner
+	// transactions of the application calls, less the fee the transfer pays
+	for i, stxn := range stxns {
+		require.Equal(t, stxns[0].Txn.Group, stxn.Txn.Group)
+		require.Equal(t, basics.Round(1100), stxn.Txn.LastValid)
+		require.Equal(t, "test-v1", stxn.Txn.GenesisID)
+		switch i {
+		case 2:
+			require.Equal(t, uint64(8500), stxn.Txn.Fee.Raw)
+		case 4:
+			require.Equal(t, uint64(500), stxn.Txn.Fee.Raw)
+		default:
+			require.Zero(t, stxn.Txn.Fee.Raw)
+		}
+	}
+	require.False(t, stxns[0].Txn.Group.IsZero())
+}
+
+func TestClerkBuildBatchErrors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var sender basics.Address
+	sender[0] = 1
+	s := sender.String()
+
+	for _, tc := range []struct {
+		spec string
+		err  string
+	}{
+		{"txns: []", "no transactions"},
+		{"txns: [{type: pay, receiver: " + s + ", amount: 1, colour: red}]", "field colour not found"},
+		{"txns: [{type: pay, receiver: " + s + "}]", "no sender"},
+		{"sender: " + s + "\ntxns: [{type: pay}]", "no receiver"},
+		{"sender: " + s + "\ntxns: [{type: keyreg}]", "unknown transaction type"},
+		{"sender: " + s + "\ntxns: [{type: pay, receiver: '${1}'}, {type: acfg, total: 1}]", "not before it"},
+		{"sender: " + s + "\ntxns: [{type: pay, receiver: " + s + "}, {type: axfer, asset: '${0}'}]", "creates nothing"},
+		{"sender: " + s + "\ntxns: [{type: acfg, total: 1}, {type: pay, receiver: '${0.address}'}]", "address of an asset"},
+		{"sender: " + s + "\ntxns: [{type: acfg, asset: 5, manager: " + s + "}]", "needs reserve"},
+		{"sender: " + s + "\ntxns: [{type: appl}]", "needs an approval and a clear program"},
+		{"sender: " + s + "\nfee-payer: nobody\ntxns: [{type: pay, receiver: " + s + "}]", "not an entry"},
+		{"sender: " + s + "\ntxns: [{name: x, type: pay, receiver: " + s + "}, {name: x, type: pay, receiver: " + s + "}]", "already taken"},
+	} {
+		_, _, err := buildTestBatch(t, &fakeBatchClient{}, tc.spec)
+		require.ErrorContains(t, err, tc.err, tc.spec)
+	}
+
+	// JSON works as well
+	stxns, _, err := buildTestBatch(t, &fakeBatchClient{}, `{"sender": "`+s+`", "txns": [{"type": "pay", "receiver": "`+s+`", "amount": 5}]}`)
+	require.NoError(t, err)
+	require.Len(t, stxns, 1)
+	require.True(t, stxns[0].Txn.Group.IsZero())
+	require.Equal(t, uint64(1000), stxns[0].Txn.Fee.Raw)
+}
+
+func TestClerkBuildExampleBatch(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	data, err := os.ReadFile(filepath.Join("examples", "boxes-batch.yaml"))
+	require.NoError(t, err)
+	batch, err := parseBatchFile(data)
+	require.NoError(t, err)
+	var sender basics.Address
+	sender[0] = 1
+	batch.Sender = sender.String()
+
+	b, err := newBatchBuilder(&fakeBatchClient{}, "examples", batch)
+	require.NoError(t, err)
+	stxns, err := b.build()
+	require.NoError(t, err)
+	require.Len(t, stxns, 3)
+	require.Equal(t, basics.AppIndex(1000), stxns[2].Txn.ApplicationID)
+}
@@ -92,6 +92,7 @@ const (
 	errorCatchpointLabelMissing             = "A catchpoint argument is needed: %s: %s"
 	errorUnableToLookupCatchpointLabel      = "Unable to fetch catchpoint label"
 	errorTooManyCatchpointLabels            = "The catchup command expect a single catchpoint"
+	errorReadingCatchpointFile              = "Unable to read catchpoint file %s: %v"
 
 	// Asset
 	malformedMetadataHash = "Cannot base64-decode metadata hash %s: %s"
@@ -108,6 +109,27 @@ const (
 	errorInvalidBoxName            = "Failed to parse box name %s. It must have the same form as app-arg. Error: %s"
 	errorBoxNameMismatch           = "Inputted box name %s does not match box name %s received from algod"
 
+	// Application specifications
+	appSpecParseError       = "Cannot parse application specification %s: %s"
+	appSpecCompileError     = "Cannot compile %s: %s"
+	appSpecTemplateError    = "Cannot parse template variable %s: %s"
+	appSpecNoApp            = "No application of %s is recorded for this network, give one with --app-id"
+	appSpecNoMethodError    = "--method is required with --spec"
+	appSpecActionError      = "%s does not allow %s with %s"
+	appSpecDeployError      = "%s cannot be %s with a bare call, and no single method allows it; choose one with --method"
+	appSpecArgError         = "Cannot find a value for argument %s of %s: %s"
+	appSpecSchemaError      = "Cannot update application %d: the specification needs a schema of %v global and %v local, larger than the application's"
+	appSpecReturnError      = "method %s succeeded but its return value %x could not be decoded: %s"
+	appSpecSimulateError    = "method %s failed when simulated: %s"
+	infoAppSpecUpToDate     = "Application %d already has the programs of %s"
+	infoAppSpecRecorded     = "Recorded application %d for this network in %s"
+	infoAppSpecMethodOutput = "method %s succeeded with output: %s"
+	appStateFallbackError   = "--abi-type gives both %s and %s as the type of every other value"
+	appStateKeyError        = "Cannot parse --abi-type key %s: %s"
+	appStateDecodeWarning   = "Cannot decode %s, leaving it as it is: %s"
+	appStateUndescribed     = "The box is not described by --spec or --abi-type"
+	errorDecodingBox        = "Cannot decode box %s: %s"
+
 	// Clerk
 	infoTxIssued               = "Sent %d MicroAlgos from account %s to address %s, transaction ID: %s. Fee set to %d"
 	infoTxCommitted            = "Transaction %s committed in round %d"
@@ -134,6 +156,20 @@ const (
 	infoAutoFeeSet             = "Automatically set fee to %d MicroAlgos"
 	errorTransactionExpired    = "Transaction %s expired before it could be included in a block"
 
+	// Clerk signing requests
+	signreqCreateError   = "Cannot create signing request: %s"
+	signreqDecodeError   = "Cannot decode signing request %s: %s"
+	signreqSignError     = "Cannot sign request: %s"
+	signreqMergeError    = "Cannot merge signing requests: %s"
+	signreqFinalizeError = "Cannot finalize signing request: %s"
+	infoSignreqSigned    = "Added %d signatures to %s"
+
+	// Clerk batches
+	batchParseError  = "Cannot parse batch file %s: %s"
+	batchBuildError  = "Cannot build batch: %s"
+	infoBatchCreated = "Entry %s creates %d"
+	infoBatchBuilt   = "Wrote %d transactions to %s, with fees of %d microAlgos paid by entry %s"
+
 	loggingNotConfigured = "Remote logging is not currently configured and won't be enabled"
 	loggingNotEnabled    = "Remote logging is current disabled"
 	loggingEnabled       = "Remote logging is enabled.  Node = %s, Guid = %s"
@@ -168,6 +204,7 @@ const (
 	// Wallet
 	infoRecoveryPrompt           = "Please type your recovery mnemonic below, and hit return when you are done: "
 	infoChoosePasswordPrompt     = "Please choose a password for wallet '%s': "
+	infoHDPassphrasePrompt       = "Please type the BIP39 passphrase of the mnemonic: "
 	infoPasswordConfirmation     = "Please confirm the password: "
 	infoCreatingWallet           = "Creating wallet..."
 	infoCreatedWallet            = "Created wallet '%s'"
@@ -186,9 +223,21 @@ const (
 	errorPasswordConfirmation    = "Password confirmation did not match"
 	errorBadMnemonic             = "Problem with mnemonic: %s"
 	errorBadRecoveredKey         = "Recovered invalid key"
+	errorHDPassphraseMismatch    = "BIP39 passphrases did not match"
 	errorFailedToReadResponse    = "Couldn't read response: %s"
 	errorFailedToReadPassword    = "Couldn't read password: %s"
 	errorCouldntRenameWallet     = "Couldn't rename wallet: %s"
+	errorCouldntReadAuditLog     = "Couldn't read audit log: %s"
+	errorAuditLogBroken          = "Audit log verification failed: %s"
+	infoNoAuditLog               = "No audit log found at %s"
+	infoAuditLogVerified         = "Verified %d audit log entries. Head hash: %s"
+	infoChooseBackupPassword     = "Please choose a password for the backup: "
+	infoBackupPasswordPrompt     = "Please enter the password of the backup: "
+	infoChooseRestoredPassword   = "Please choose a password for the restored wallet: "
+	infoWroteBackup              = "Wrote the wallet backup to %s"
+	infoRestoredWallet           = "Restored wallet '%s'"
+	errorCouldntBackupWallet     = "Couldn't back up wallet: %s"
+	errorCouldntRestoreWallet    = "Couldn't restore wallet: %s"
 
 	// Commands
 	infoPasswordPrompt       = "Please enter the password for wallet '%s': "
@@ -37,6 +37,7 @@ import (
 	"github.com/algorand/go-algorand/cmd/util/datadir"
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/libgoal"
 	naddr "github.com/algorand/go-algorand/network/addr"
@@ -62,6 +63,7 @@ var watchMillisecond uint64
 var abortCatchup bool
 var fastCatchupForce bool
 var minCatchupRounds uint64
+var catchpointFile string
 
 const catchpointURL = "https://algorand-catchpoints.s3.us-east-2.amazonaws.com/channel/%s/latest.catchpoint"
 
@@ -119,6 +121,7 @@ func init() {
 	catchupCmd.Flags().BoolVarP(&abortCatchup, "abort", "x", false, "Aborts the current catchup process")
 	catchupCmd.Flags().BoolVar(&fastCatchupForce, "force", false, "Forces fast catchup with implicit catchpoint to start without a consent prompt")
 	catchupCmd.Flags().Uint64VarP(&minCatchupRounds, "min", "m", 0, "Catchup only if the catchpoint would advance the node by the specified minimum number of rounds")
+	catchupCmd.Flags().StringVarP(&catchpointFile, "file", "f", "", "Load the ledger from a catchpoint file rather than downloading it from peers. The file must be in the catchpoint import directory (CatchpointImportDir) of the host running algod, which reads the file itself")
 
 }
 
@@ -157,11 +160,30 @@ func getMissingCatchpointLabel(URL string) (label string, err error) {
 	return
 }
 
+// readCatchpointFileLabel returns the catchpoint label stored in the header of a catchpoint file,
+// which is either a tar or a gzip compressed tar.
+func readCatchpointFileLabel(path string) (label string, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	reader, err := ledger.OpenCatchpointFile(f)
+	if err != nil {
+		return
+	}
+	defer reader.Close()
+	label = reader.Header.Catchpoint
+	_, _, err = ledgercore.ParseCatchpointLabel(label)
+	return
+}
+
 var catchupCmd = &cobra.Command{
 	Use:     "catchup",
 	Short:   "Catchup the Algorand node to a specific catchpoint",
-	Long:    "Catchup allows making large jumps over round ranges without the need to incrementally validate each individual round. Using external catchpoints is not a secure practice and should not be done for consensus participating nodes.\nIf no catchpoint is provided, this command attempts to lookup the latest catchpoint from algorand-catchpoints.s3.us-east-2.amazonaws.com.",
-	Example: "goal node catchup 6500000#1234567890ABCDEF01234567890ABCDEF0\tStart catching up to round 6500000 with the provided catchpoint\ngoal node catchup --abort\t\t\t\t\tAbort the current catchup",
+	Long:    "Catchup allows making large jumps over round ranges without the need to incrementally validate each individual round. Using external catchpoints is not a secure practice and should not be done for consensus participating nodes.\nIf no catchpoint is provided, this command attempts to lookup the latest catchpoint from algorand-catchpoints.s3.us-east-2.amazonaws.com, or, when a catchpoint file is provided with --file, reads it from the file.\nWith --file, the ledger is loaded from the catchpoint file and only the blocks are downloaded from peers. The file is verified against the catchpoint the same way a downloaded one would be.",
+	Example: "goal node catchup 6500000#1234567890ABCDEF01234567890ABCDEF0\tStart catching up to round 6500000 with the provided catchpoint\ngoal node catchup 6500000#1234567890ABCDEF01234567890ABCDEF0 --file /data/6500000.catchpoint\tStart catching up to round 6500000 using a local catchpoint file\ngoal node catchup --abort\t\t\t\t\tAbort the current catchup",
 	Args:    catchpointCmdArgument,
 	Run: func(cmd *cobra.Command, args []string) {
 		var catchpoint string
@@ -180,8 +202,33 @@ var catchupCmd = &cobra.Command{
 				return
 			}
 
+			if catchpointFile != "" {
+				path, err := filepath.Abs(catchpointFile)
+				if err != nil {
+					reportErrorf(errorReadingCatchpointFile, catchpointFile, err)
+				}
+				catchpointFile = path
+			}
+
 			// lookup missing catchpoint
-			if catchpoint == "" {
+			if catchpoint == "" && catchpointFile != "" {
+				label, err := readCatchpointFileLabel(catchpointFile)
+				if err != nil {
+					reportErrorf(errorReadingCatchpointFile, catchpointFile, err)
+				}
+				catchpoint = label
+
+				// Prompt user to confirm using the catchpoint of the file.
+				if !fastCatchupForce {
+					fmt.Printf(nodeConfirmImplicitCatchpoint, catchpoint)
+					reader := bufio.NewReader(os.Stdin)
+					text, _ := reader.ReadString('\n')
+					text = strings.Replace(text, "\n", "", -1)
+					if text != "yes" {
+						reportErrorf(errorAbortedPerUserRequest)
+					}
+				}
+			} else if catchpoint == "" {
 				vers, err := client.AlgodVersions()
 				if err != nil {
 					reportErrorf(errorNodeStatus, err)
@@ -205,7 +252,13 @@ var catchupCmd = &cobra.Command{
 				}
 			}
 
-			resp, err := client.Catchup(catchpoint, minCatchupRounds)
+			var resp model.CatchpointStartResponse
+			var err error
+			if catchpointFile != "" {
+				resp, err = client.CatchupFromFile(catchpoint, minCatchupRounds, catchpointFile)
+			} else {
+				resp, err = client.Catchup(catchpoint, minCatchupRounds)
+			}
 			if err != nil {
 				reportErrorf(errorNodeStatus, err)
 			}
@@ -16,13 +16,22 @@
 package main
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"testing"
 
+	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/partitiontest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -112,3 +121,38 @@ func TestGetMissingCatchpointLabel(t *testing.T) {
 		})
 	}
 }
+
+func TestReadCatchpointFileLabel(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	const label = "1000#DVFRZUYHEFKRLK5N6DNJRR4IABEVN2D6H76F3ZSEPIE6MKXMQWQA"
+	header := protocol.Encode(&ledger.CatchpointFileHeader{BlocksRound: 1000, Catchpoint: label})
+	dir := t.TempDir()
+	for _, compress := range []bool{false, true} {
+		var buf bytes.Buffer
+		var out io.Writer = &buf
+		var gz *gzip.Writer
+		if compress {
+			gz = gzip.NewWriter(&buf)
+			out = gz
+		}
+		tw := tar.NewWriter(out)
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: ledger.CatchpointContentFileName, Mode: 0600, Size: int64(len(header))}))
+		_, err := tw.Write(header)
+		require.NoError(t, err)
+		require.NoError(t, tw.Close())
+		if gz != nil {
+			require.NoError(t, gz.Close())
+		}
+
+		path := filepath.Join(dir, fmt.Sprintf("catchpoint-%v.tar", compress))
+		require.NoError(t, os.WriteFile(path, buf.Bytes(), 0644))
+		readLabel, err := readCatchpointFileLabel(path)
+		require.NoError(t, err)
+		require.Equal(t, label, readLabel)
+	}
+
+	_, err := readCatchpointFileLabel(filepath.Join(dir, "missing.tar"))
+	require.Error(t, err)
+}
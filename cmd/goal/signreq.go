@@ -0,0 +1,242 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/cmd/util/datadir"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/signreq"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+var (
+	signreqFilename   string
+	signreqMsigParams []string
+)
+
+func init() {
+	clerkCmd.AddCommand(signreqCmd)
+	signreqCmd.AddCommand(signreqCreateCmd)
+	signreqCmd.AddCommand(signreqInspectCmd)
+	signreqCmd.AddCommand(signreqSignCmd)
+	signreqCmd.AddCommand(signreqMergeCmd)
+	signreqCmd.AddCommand(signreqFinalizeCmd)
+
+	signreqCreateCmd.Flags().StringVarP(&txFilename, "infile", "i", "", "File storing the transactions to be signed")
+	signreqCreateCmd.Flags().StringArrayVar(&signreqMsigParams, "msig-params", nil, "Multisig preimage parameters of an account authorizing transactions - [threshold] [Address 1] [Address 2] ... (may be repeated)")
+	signreqCreateCmd.Flags().StringVarP(&outFilename, "outfile", "o", "", "Filename for writing the signing request")
+	signreqCreateCmd.MarkFlagRequired("infile")
+	signreqCreateCmd.MarkFlagRequired("outfile")
+
+	signreqInspectCmd.Flags().StringVarP(&signreqFilename, "request", "r", "", "Signing request file")
+	signreqInspectCmd.MarkFlagRequired("request")
+
+	signreqSignCmd.Flags().StringVarP(&signreqFilename, "request", "r", "", "Signing request file to add signatures to")
+	signreqSignCmd.Flags().StringVarP(&outFilename, "outfile", "o", "", "Filename for writing the signed request (default overwrites the request file)")
+	signreqSignCmd.MarkFlagRequired("request")
+
+	signreqMergeCmd.Flags().StringVarP(&outFilename, "outfile", "o", "", "Filename for writing the merged request")
+	signreqMergeCmd.MarkFlagRequired("outfile")
+
+	signreqFinalizeCmd.Flags().StringVarP(&signreqFilename, "request", "r", "", "Completely signed request file")
+	signreqFinalizeCmd.Flags().StringVarP(&outFilename, "outfile", "o", "", "Filename for writing the signed transactions, ready for rawsend")
+	signreqFinalizeCmd.MarkFlagRequired("request")
+	signreqFinalizeCmd.MarkFlagRequired("outfile")
+}
+
+var signreqCmd = &cobra.Command{
+	Use:   "request",
+	Short: "Provides tools working with signing requests",
+	Long:  `Create, inspect, sign, merge and finalize signing requests. A signing request carries a transaction group, the accounts which must authorize it and the signatures collected so far between the machines of its signers, which may be offline. The same files are handled by algokey request.`,
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		//If no arguments passed, we should fallback to help
+		cmd.HelpFunc()(cmd, args)
+	},
+}
+
+var signreqCreateCmd = &cobra.Command{
+	Use:   "create -i [transaction file] -o [request file]",
+	Short: "Create a signing request for the transactions of a file",
+	Long:  `Create a signing request for the transactions of a file, which are grouped if they are not already. Give the preimage of every multisig account which authorizes some of the transactions with --msig-params.`,
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		data, err := readFile(txFilename)
+		if err != nil {
+			reportErrorf(fileReadError, txFilename, err)
+		}
+
+		var stxns []transactions.SignedTxn
+		dec := protocol.NewMsgpDecoderBytes(data)
+		for {
+			var stxn transactions.SignedTxn
+			err = dec.Decode(&stxn)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				reportErrorf(txDecodeError, txFilename, err)
+			}
+			stxns = append(stxns, stxn)
+		}
+
+		var msigs []crypto.MultisigSig
+		for _, params := range signreqMsigParams {
+			msig, err1 := signreq.ParseMultisig(params)
+			if err1 != nil {
+				reportErrorf(msigParseError, err1)
+			}
+			msigs = append(msigs, msig)
+		}
+
+		req, err := signreq.New(stxns, msigs)
+		if err != nil {
+			reportErrorf(signreqCreateError, err)
+		}
+		writeSignreq(outFilename, req)
+	},
+}
+
+var signreqInspectCmd = &cobra.Command{
+	Use:   "inspect -r [request file]",
+	Short: "Describe the transactions of a signing request and who has signed them",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		req := readSignreq(signreqFilename)
+		fmt.Print(req.Summary())
+	},
+}
+
+var signreqSignCmd = &cobra.Command{
+	Use:   "sign -r [request file]",
+	Short: "Add the signatures of the keys of a wallet to a signing request",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		req := readSignreq(signreqFilename)
+
+		dataDir := datadir.EnsureSingleDataDir()
+		client := ensureKmdClient(dataDir)
+		wh, pw := ensureWalletHandleMaybePassword(dataDir, walletName, true)
+		addrs, err := client.ListAddresses(wh)
+		if err != nil {
+			reportErrorf(errorRequestFail, err)
+		}
+		var keys []crypto.PublicKey
+		for _, pk := range req.Keys() {
+			if slices.Contains(addrs, basics.Address(pk).String()) {
+				keys = append(keys, pk)
+			}
+		}
+
+		n, err := req.Sign(keys, func(pk crypto.PublicKey, stxn transactions.SignedTxn) (crypto.Signature, error) {
+			signer := basics.Address(pk).String()
+			if stxn.Msig.Blank() {
+				signed, err1 := client.SignTransactionWithWalletAndSigner(wh, pw, signer, stxn.Txn)
+				return signed.Sig, err1
+			}
+
+			// Sign a blank copy of the multisig, so that kmd does not see
+			// the signatures of others
+			msig, err1 := client.MultisigSignTransactionWithWalletAndSigner(wh, pw, stxn.Txn, signer, crypto.MultisigPreimageFromPKs(stxn.Msig.Preimage()), stxn.Authorizer().String())
+			if err1 != nil {
+				return crypto.Signature{}, err1
+			}
+			for _, subsig := range msig.Subsigs {
+				if subsig.Key == pk && !subsig.Sig.Blank() {
+					return subsig.Sig, nil
+				}
+			}
+			return crypto.Signature{}, fmt.Errorf("kmd did not sign with %s", signer)
+		})
+		if err != nil {
+			reportErrorf(signreqSignError, err)
+		}
+
+		out := outFilename
+		if out == "" {
+			out = signreqFilename
+		}
+		writeSignreq(out, req)
+		reportInfof(infoSignreqSigned, n, out)
+	},
+}
+
+var signreqMergeCmd = &cobra.Command{
+	Use:   "merge -o [request file] [request file] [request file] ...",
+	Short: "Merge the signatures of copies of a signing request",
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		var reqs []*signreq.Request
+		for _, filename := range args {
+			reqs = append(reqs, readSignreq(filename))
+		}
+		merged, err := signreq.Merge(reqs...)
+		if err != nil {
+			reportErrorf(signreqMergeError, err)
+		}
+		writeSignreq(outFilename, merged)
+	},
+}
+
+var signreqFinalizeCmd = &cobra.Command{
+	Use:   "finalize -r [request file] -o [transaction file]",
+	Short: "Write out the signed transactions of a completely signed request",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		req := readSignreq(signreqFilename)
+		stxns, err := req.Finalize()
+		if err != nil {
+			reportErrorf(signreqFinalizeError, err)
+		}
+
+		var outData []byte
+		for i := range stxns {
+			outData = append(outData, protocol.Encode(&stxns[i])...)
+		}
+		err = writeFile(outFilename, outData, 0600)
+		if err != nil {
+			reportErrorf(fileWriteError, outFilename, err)
+		}
+	},
+}
+
+func readSignreq(filename string) *signreq.Request {
+	data, err := readFile(filename)
+	if err != nil {
+		reportErrorf(fileReadError, filename, err)
+	}
+	req, err := signreq.Decode(data)
+	if err != nil {
+		reportErrorf(signreqDecodeError, filename, err)
+	}
+	return req
+}
+
+func writeSignreq(filename string, req *signreq.Request) {
+	err := writeFile(filename, req.Encode(), 0600)
+	if err != nil {
+		reportErrorf(fileWriteError, filename, err)
+	}
+}
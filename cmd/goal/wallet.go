@@ -19,8 +19,10 @@ package main
 import (
 	"bufio"
 	"bytes"
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -28,6 +30,7 @@ import (
 	"github.com/algorand/go-algorand/cmd/util/datadir"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/crypto/passphrase"
+	"github.com/algorand/go-algorand/daemon/kmd/audit"
 	"github.com/algorand/go-algorand/daemon/kmd/lib/kmdapi"
 )
 
@@ -35,13 +38,23 @@ var (
 	recoverWallet           bool
 	createUnencryptedWallet bool
 	noDisplaySeed           bool
+	hdWallet                bool
+	hdAccount               uint32
+	hdPassphrase            bool
 	defaultWalletName       string
+	auditWalletName         string
+	auditVerifyOnly         bool
+	backupWalletName        string
+	backupFile              string
 )
 
 func init() {
 	walletCmd.AddCommand(newWalletCmd)
 	walletCmd.AddCommand(listWalletsCmd)
 	walletCmd.AddCommand(renameWalletCmd)
+	walletCmd.AddCommand(auditWalletCmd)
+	walletCmd.AddCommand(backupWalletCmd)
+	walletCmd.AddCommand(restoreWalletCmd)
 
 	// Default wallet to use when -w not specified
 	walletCmd.Flags().StringVarP(&defaultWalletName, "default", "f", "", "Set the wallet with this name to be the default wallet")
@@ -50,6 +63,19 @@ func init() {
 	newWalletCmd.Flags().BoolVarP(&recoverWallet, "recover", "r", false, "Recover the wallet from the backup mnemonic provided at wallet creation (NOT the mnemonic provided by goal account export or by algokey). Regenerate accounts in the wallet with `goal account new`")
 	newWalletCmd.Flags().BoolVar(&createUnencryptedWallet, "unencrypted", false, "Create a new wallet without a password.")
 	newWalletCmd.Flags().BoolVar(&noDisplaySeed, "no-display-seed", false, "Create a new wallet without displaying the seed phrase.")
+	newWalletCmd.Flags().BoolVar(&hdWallet, "hd", false, "Create a wallet which derives its accounts by BIP32-Ed25519 path (m/44'/283'/account'/0/index) from a 24 word BIP39 mnemonic, like hardware wallets. With --recover, the mnemonic is a BIP39 mnemonic of 12 to 24 words")
+	newWalletCmd.Flags().Uint32Var(&hdAccount, "hd-account", 0, "The account of the derivation path of an HD wallet")
+	newWalletCmd.Flags().BoolVar(&hdPassphrase, "hd-passphrase", false, "Prompt for the BIP39 passphrase of the mnemonic of an HD wallet")
+
+	auditWalletCmd.Flags().StringVarP(&auditWalletName, "wallet", "w", "", "Only show the operations made with the wallet with this name")
+	auditWalletCmd.Flags().BoolVar(&auditVerifyOnly, "verify", false, "Only verify the audit log, without showing its entries")
+
+	backupWalletCmd.Flags().StringVarP(&backupWalletName, "wallet", "w", "", "Set the wallet to back up")
+	backupWalletCmd.Flags().StringVarP(&backupFile, "outfile", "o", "", "Filename for writing the backup")
+	backupWalletCmd.MarkFlagRequired("outfile")
+
+	restoreWalletCmd.Flags().StringVarP(&backupFile, "infile", "i", "", "Filename of the backup to restore")
+	restoreWalletCmd.MarkFlagRequired("infile")
 }
 
 var walletCmd = &cobra.Command{
@@ -97,8 +123,10 @@ var newWalletCmd = &cobra.Command{
 
 		reader := bufio.NewReader(os.Stdin)
 
-		// Check if we should recover the wallet from a mnemonic
+		// Check if we should recover the wallet from a mnemonic. The
+		// entropy of a BIP39 mnemonic may be shorter than the key.
 		var mdk crypto.MasterDerivationKey
+		entropyLen := len(mdk)
 		if recoverWallet {
 			fmt.Println(infoRecoveryPrompt)
 			resp, err1 := reader.ReadString('\n')
@@ -107,17 +135,31 @@ var newWalletCmd = &cobra.Command{
 				reportErrorf(errorFailedToReadResponse, err1)
 			}
 			var key []byte
-			key, err1 = passphrase.MnemonicToKey(resp)
+			if hdWallet {
+				key, err1 = passphrase.BIP39MnemonicToEntropy(resp)
+			} else {
+				key, err1 = passphrase.MnemonicToKey(resp)
+			}
 			if err1 != nil {
 				reportErrorf(errorBadMnemonic, err1)
 			}
 			// Copy the recovered key into the mdk
-			n := copy(mdk[:], key)
-			if n != len(mdk) {
+			entropyLen = copy(mdk[:], key)
+			if entropyLen != len(key) || (!hdWallet && entropyLen != len(mdk)) {
 				reportErrorln(errorBadRecoveredKey)
 			}
 		}
 
+		var bip39Passphrase []byte
+		if hdWallet && hdPassphrase {
+			fmt.Print(infoHDPassphrasePrompt)
+			bip39Passphrase = ensurePassword()
+			fmt.Print(infoPasswordConfirmation)
+			if !bytes.Equal(bip39Passphrase, ensurePassword()) {
+				reportErrorln(errorHDPassphraseMismatch)
+			}
+		}
+
 		walletPassword := []byte{}
 
 		if createUnencryptedWallet {
@@ -139,7 +181,12 @@ var newWalletCmd = &cobra.Command{
 
 		// Create the wallet
 		reportInfoln(infoCreatingWallet)
-		walletID, err := client.CreateWallet(walletName, walletPassword, mdk)
+		var walletID []byte
+		if hdWallet {
+			walletID, err = client.CreateHDWallet(walletName, walletPassword, mdk, !recoverWallet, entropyLen, string(bip39Passphrase), hdAccount)
+		} else {
+			walletID, err = client.CreateWallet(walletName, walletPassword, mdk)
+		}
 		if err != nil {
 			reportErrorf(errorCouldntCreateWallet, err)
 		}
@@ -170,8 +217,14 @@ var newWalletCmd = &cobra.Command{
 					reportErrorf(errorCouldntExportMDK, err1)
 				}
 
-				// Convert the key to a mnemonic
-				mnemonic, err1 := passphrase.KeyToMnemonic(mdk[:])
+				// Convert the key to a mnemonic. The key of an HD wallet is
+				// the entropy of its BIP39 mnemonic.
+				var mnemonic string
+				if hdWallet {
+					mnemonic, err1 = passphrase.EntropyToBIP39Mnemonic(mdk[:])
+				} else {
+					mnemonic, err1 = passphrase.KeyToMnemonic(mdk[:])
+				}
 				if err1 != nil {
 					reportErrorf(errorCouldntMakeMnemonic, err1)
 				}
@@ -258,6 +311,169 @@ var renameWalletCmd = &cobra.Command{
 	},
 }
 
+var auditWalletCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Show and verify kmd's audit log",
+	Long:  "Show the signing and key-management operations recorded in kmd's audit log, and verify that the log has not been tampered with. The head hash printed after verification can be recorded elsewhere, to later detect entries being removed from the end of the log.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		dataDir := datadir.EnsureSingleDataDir()
+		auditPath := filepath.Join(resolveKmdDataDir(dataDir), audit.Filename)
+		if _, err := os.Stat(auditPath); errors.Is(err, os.ErrNotExist) {
+			reportInfof(infoNoAuditLog, auditPath)
+			return
+		}
+
+		if !auditVerifyOnly {
+			var walletID string
+			if auditWalletName != "" {
+				client := ensureKmdClient(dataDir)
+				wid, duplicate, err := client.FindWalletIDByName([]byte(auditWalletName))
+				if err != nil {
+					reportErrorf(errFindingWallet, err)
+				}
+				if wid == nil {
+					reportErrorf(errWalletNotFound, auditWalletName)
+				}
+				if duplicate {
+					reportErrorf(errWalletNameAmbiguous, auditWalletName)
+				}
+				walletID = string(wid)
+			}
+
+			err := audit.Read(auditPath, func(e audit.Entry) error {
+				if walletID == "" || e.WalletID == walletID {
+					printAuditEntry(e)
+				}
+				return nil
+			})
+			if err != nil {
+				reportErrorf(errorCouldntReadAuditLog, err)
+			}
+		}
+
+		count, head, err := audit.Verify(auditPath)
+		if err != nil {
+			reportErrorf(errorAuditLogBroken, err)
+		}
+		reportInfof(infoAuditLogVerified, count, head)
+	},
+}
+
+var backupWalletCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "Back up a wallet to an encrypted file",
+	Long:  "Write a backup of a wallet, encrypted with a password of its own, which `goal wallet restore` restores on any node. Unlike the backup phrase, it includes the keys imported into the wallet and its multisig accounts.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		dataDir := datadir.EnsureSingleDataDir()
+		client := ensureKmdClient(dataDir)
+		wh, pw := ensureWalletHandleMaybePassword(dataDir, backupWalletName, true)
+		defer client.ReleaseWalletHandle(wh)
+
+		// Fetch a password for the backup
+		fmt.Print(infoChooseBackupPassword)
+		backupPassword := ensurePassword()
+		fmt.Print(infoPasswordConfirmation)
+		if !bytes.Equal(backupPassword, ensurePassword()) {
+			reportErrorln(errorPasswordConfirmation)
+		}
+
+		backup, err := client.BackupWallet(wh, pw, backupPassword)
+		if err != nil {
+			reportErrorf(errorCouldntBackupWallet, err)
+		}
+		err = writeFile(backupFile, backup, 0600)
+		if err != nil {
+			reportErrorf(fileWriteError, backupFile, err)
+		}
+		reportInfof(infoWroteBackup, backupFile)
+	},
+}
+
+var restoreWalletCmd = &cobra.Command{
+	Use:   "restore [wallet name]",
+	Short: "Restore a wallet from a backup file",
+	Long:  "Restore a wallet from a file written by `goal wallet backup`, with the name it had unless another is given. The wallet is only created once it has been completely restored.",
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		dataDir := datadir.EnsureSingleDataDir()
+		accountList := makeAccountsList(dataDir)
+		client := ensureKmdClient(dataDir)
+
+		var walletName []byte
+		if len(args) > 0 {
+			walletName = []byte(args[0])
+		}
+
+		backup, err := readFile(backupFile)
+		if err != nil {
+			reportErrorf(fileReadError, backupFile, err)
+		}
+
+		fmt.Print(infoBackupPasswordPrompt)
+		backupPassword := ensurePassword()
+
+		// Fetch a password for the restored wallet
+		fmt.Print(infoChooseRestoredPassword)
+		walletPassword := ensurePassword()
+		fmt.Print(infoPasswordConfirmation)
+		if !bytes.Equal(walletPassword, ensurePassword()) {
+			reportErrorln(errorPasswordConfirmation)
+		}
+
+		walletID, err := client.RestoreWallet(walletName, walletPassword, backup, backupPassword)
+		if err != nil {
+			reportErrorf(errorCouldntRestoreWallet, err)
+		}
+		name, _, err := client.FindWalletNameByID(walletID)
+		if err != nil {
+			reportErrorf(errorCouldntFindWallet, err)
+		}
+		reportInfof(infoRestoredWallet, name)
+
+		// We are the only wallet -- make us the default
+		wallets, err := client.ListWallets()
+		if err != nil {
+			reportErrorf(errorCouldntListWallets, err)
+		}
+		if len(wallets) == 1 {
+			accountList.setDefaultWalletID(walletID)
+		}
+	},
+}
+
+func printAuditEntry(e audit.Entry) {
+	fields := []string{
+		fmt.Sprintf("%d", e.Seq),
+		e.Time.Format("2006-01-02T15:04:05Z"),
+		"wallet=" + e.WalletID,
+		string(e.Operation),
+	}
+	if e.Intent {
+		fields = append(fields, "intent")
+	}
+	if e.Handle != "" {
+		fields = append(fields, "handle="+e.Handle)
+	}
+	if e.Address != "" {
+		fields = append(fields, "address="+e.Address)
+	}
+	if e.Txid != "" {
+		fields = append(fields, "txid="+e.Txid)
+	}
+	if e.Group != "" {
+		fields = append(fields, "group="+e.Group)
+	}
+	if e.ProgramHash != "" {
+		fields = append(fields, "program="+e.ProgramHash)
+	}
+	if e.Error != "" {
+		fields = append(fields, fmt.Sprintf("error=%q", e.Error))
+	}
+	fmt.Println(strings.Join(fields, " "))
+}
+
 func printWallets(dataDir string, wallets []kmdapi.APIV1Wallet) {
 	accountList := makeAccountsList(dataDir)
 	defaultWalletID := string(accountList.getDefaultWalletID())
@@ -276,6 +492,9 @@ func printWallets(dataDir string, wallets []kmdapi.APIV1Wallet) {
 		fmt.Println(strings.Repeat("#", 50))
 		fmt.Printf("Wallet:\t%s%s\n", w.Name, defaultIndicator)
 		fmt.Printf("ID:\t%s\n", w.ID)
+		if w.HD {
+			fmt.Println("HD:\tyes")
+		}
 	}
 	fmt.Println(strings.Repeat("#", 50))
 }
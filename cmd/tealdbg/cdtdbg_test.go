@@ -147,6 +147,10 @@ func (c *MockDebugControl) GetSource() (string, []byte) {
 	return "name", []byte("int 1")
 }
 
+func (c *MockDebugControl) GetOffsetToSource() map[int]logic.SourceLocation {
+	return map[int]logic.SourceLocation{1: {Line: 0}}
+}
+
 func (c *MockDebugControl) GetStates(s *logic.DebugState) AppState {
 	return AppState{}
 }
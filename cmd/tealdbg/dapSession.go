@@ -0,0 +1,468 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/cmd/tealdbg/dap"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+)
+
+// dapMaxFrames bounds the call stack depth, so that frame IDs encode the thread and the level
+const dapMaxFrames = 1 << 16
+
+// dapSession is a program execution, shown to the DAP client as a thread
+type dapSession struct {
+	threadID      int
+	debugger      Control
+	notifications chan Notification
+	done          chan struct{}
+
+	mu             deadlock.Mutex
+	name           string
+	sourceLines    []string
+	offsetToSource map[int]logic.SourceLocation // nil if there is only the disassembly
+	state          logic.DebugState
+	registered     bool
+	stopped        bool
+	pausing        bool
+
+	// step repeats the current step while it stays on the source line it started from
+	step      func()
+	stepLine  int
+	stepDepth int
+
+	// applied are the disassembly lines of the breakpoints set in the debugger
+	applied []int
+}
+
+func makeDapSession(threadID int, debugger Control, ch chan Notification) *dapSession {
+	s := new(dapSession)
+	s.threadID = threadID
+	s.debugger = debugger
+	s.notifications = ch
+	s.done = make(chan struct{})
+	var source []byte
+	s.name, source = debugger.GetSource()
+	s.sourceLines = strings.Split(string(source), "\n")
+	s.offsetToSource = debugger.GetOffsetToSource()
+	return s
+}
+
+func (s *dapSession) register(state logic.DebugState) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.state = state
+	s.registered = true
+	if s.name == "" {
+		id := state.ExecID
+		if len(id) > 8 {
+			id = id[:8]
+		}
+		s.name = "program " + id
+	}
+}
+
+// update records the state of a break, and returns whether to stop there and why
+func (s *dapSession) update(state logic.DebugState) (reason string, stop bool) {
+	s.mu.Lock()
+	s.state = state
+	step := s.step
+	if step == nil {
+		reason = "breakpoint"
+		if s.pausing {
+			reason = "pause"
+			s.pausing = false
+		}
+		s.mu.Unlock()
+		return reason, true
+	}
+	if s.offsetToSource != nil {
+		// instructions without source, such as constant blocks, and the remaining instructions
+		// of the source line are stepped over
+		loc, ok := s.offsetToSource[state.PC]
+		if !ok || (loc.Line == s.stepLine && len(state.CallStack) == s.stepDepth) {
+			s.mu.Unlock()
+			step()
+			return "", false
+		}
+	}
+	s.step = nil
+	s.mu.Unlock()
+	return "step", true
+}
+
+func (s *dapSession) setStopped() {
+	s.mu.Lock()
+	s.stopped = true
+	s.mu.Unlock()
+}
+
+// running marks the session as running, and fails if it is not stopped
+func (s *dapSession) running(step func()) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.stopped {
+		return fmt.Errorf("thread %d is not stopped", s.threadID)
+	}
+	s.stopped = false
+	s.step = step
+	if step != nil {
+		s.stepLine, _ = s.sourceLine(s.state.PC)
+		s.stepDepth = len(s.state.CallStack)
+	}
+	return nil
+}
+
+func (s *dapSession) resume() error {
+	if err := s.running(nil); err != nil {
+		return err
+	}
+	s.debugger.Resume()
+	return nil
+}
+
+func (s *dapSession) stepWith(step func()) error {
+	if err := s.running(step); err != nil {
+		return err
+	}
+	step()
+	return nil
+}
+
+// pause breaks on the next instruction of a running session
+func (s *dapSession) pause() {
+	s.mu.Lock()
+	if s.stopped {
+		s.mu.Unlock()
+		return
+	}
+	s.pausing = true
+	s.mu.Unlock()
+	s.debugger.Step()
+}
+
+// detach lets the session run to completion without breaking
+func (s *dapSession) detach() {
+	s.mu.Lock()
+	wasStopped := s.stopped
+	s.stopped = false
+	s.step = nil
+	s.mu.Unlock()
+	s.debugger.SetBreakpointsActive(false)
+	if wasStopped {
+		s.debugger.Resume()
+	}
+}
+
+// matches tells if the breakpoints of the source key belong to the session
+func (s *dapSession) matches(key string) bool {
+	if s.offsetToSource == nil {
+		return key == fmt.Sprintf("ref:%d", s.threadID)
+	}
+	if strings.HasPrefix(key, "ref:") {
+		return false
+	}
+	if path, err := filepath.Abs(s.name); err == nil && path == key {
+		return true
+	}
+	return filepath.Base(key) == filepath.Base(s.name)
+}
+
+// breakpointLine returns the disassembly line of a breakpoint on the 0-based line of the source
+func (s *dapSession) breakpointLine(line int) (int, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.disassemblyLine(line)
+}
+
+// disassemblyLine must be called with lock taken
+func (s *dapSession) disassemblyLine(line int) (int, bool) {
+	if !s.registered {
+		return 0, false
+	}
+	if s.offsetToSource == nil {
+		lines := strings.Split(s.state.Disassembly, "\n")
+		return line, line >= 0 && line < len(lines)
+	}
+	pc := -1
+	for offset, loc := range s.offsetToSource {
+		if loc.Line == line && (pc == -1 || offset < pc) {
+			pc = offset
+		}
+	}
+	if pc == -1 {
+		return 0, false
+	}
+	return s.state.PCToLine(pc), true
+}
+
+// setBreakpoints replaces the breakpoints of the session by those on the 0-based source lines
+func (s *dapSession) setBreakpoints(lines []int) {
+	s.mu.Lock()
+	previous := s.applied
+	s.applied = nil
+	for _, line := range lines {
+		if dline, ok := s.disassemblyLine(line); ok {
+			s.applied = append(s.applied, dline)
+		}
+	}
+	applied := s.applied
+	s.mu.Unlock()
+
+	for _, line := range previous {
+		s.debugger.RemoveBreakpoint(line)
+	}
+	for _, line := range applied {
+		s.debugger.SetBreakpoint(line)
+	}
+}
+
+// sourceLine must be called with lock taken. It returns the 0-based line of pc in the source, or
+// in the disassembly if there is no source. Instructions without source take the line of the
+// closest preceding instruction.
+func (s *dapSession) sourceLine(pc int) (int, bool) {
+	if s.offsetToSource == nil {
+		return s.state.PCToLine(pc), true
+	}
+	if loc, ok := s.offsetToSource[pc]; ok {
+		return loc.Line, true
+	}
+	closest := -1
+	for offset := range s.offsetToSource {
+		if offset < pc && offset > closest {
+			closest = offset
+		}
+	}
+	if closest == -1 {
+		return 0, false
+	}
+	return s.offsetToSource[closest].Line, false
+}
+
+func (s *dapSession) threadName() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return filepath.Base(s.name)
+}
+
+// dapSource must be called with lock taken
+func (s *dapSession) dapSource() *dap.Source {
+	if s.offsetToSource == nil {
+		return &dap.Source{Name: filepath.Base(s.name) + ".dis", SourceReference: s.threadID}
+	}
+	path, err := filepath.Abs(s.name)
+	if err != nil {
+		path = s.name
+	}
+	return &dap.Source{Name: filepath.Base(s.name), Path: path}
+}
+
+// stackFrames returns the call stack, innermost frame first
+func (s *dapSession) stackFrames(lineBase int) []dap.StackFrame {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	source := s.dapSource()
+	callStack := s.state.CallStack
+	frames := make([]dap.StackFrame, 0, len(callStack)+1)
+	pc := s.state.PC
+	for level := 0; level <= len(callStack); level++ {
+		name := "main"
+		if depth := len(callStack) - level; depth > 0 {
+			name = s.subroutineName(callStack[depth-1])
+		}
+		line, _ := s.sourceLine(pc)
+		frames = append(frames, dap.StackFrame{
+			ID:     s.threadID*dapMaxFrames + level,
+			Name:   name,
+			Source: source,
+			Line:   line + lineBase,
+			Column: lineBase,
+		})
+		if depth := len(callStack) - level; depth > 0 {
+			pc = s.state.LineToPC(callStack[depth-1].FrameLine)
+		}
+	}
+	return frames
+}
+
+// subroutineName must be called with lock taken. It returns the label called by a frame, as
+// in the source if there is one.
+func (s *dapSession) subroutineName(frame logic.CallFrame) string {
+	if loc, ok := s.offsetToSource[s.state.LineToPC(frame.FrameLine)]; ok && loc.Line < len(s.sourceLines) {
+		fields := strings.Fields(s.sourceLines[loc.Line])
+		if len(fields) > 1 && fields[0] == "callsub" {
+			return fields[1]
+		}
+	}
+	return frame.LabelName
+}
+
+func (s *dapSession) disassembly() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.state.Disassembly
+}
+
+// scopes returns the containers of the variables at the current break, registering them with
+// handle. Variables are not scoped by frame, so all frames have the same scopes.
+func (s *dapSession) scopes(handle func(func() []dap.Variable) int) []dap.Scope {
+	s.mu.Lock()
+	state := s.state
+	s.mu.Unlock()
+	appState := s.debugger.GetStates(&state)
+
+	scratch := make([]dap.Variable, 0)
+	for i, tv := range state.Scratch {
+		if tv.Type == basics.TealUintType && tv.Uint == 0 || tv.Type == basics.TealBytesType && tv.Bytes == "" {
+			continue
+		}
+		scratch = append(scratch, fieldVariable(tealValueToFieldDesc(strconv.Itoa(i), tv)))
+	}
+
+	scopes := []dap.Scope{
+		{
+			Name:               "Stack",
+			VariablesReference: handle(func() []dap.Variable { return fieldVariables(prepareArray(state.Stack)) }),
+			IndexedVariables:   len(state.Stack),
+		},
+		{
+			Name:               "Scratch",
+			VariablesReference: handle(func() []dap.Variable { return scratch }),
+			NamedVariables:     len(scratch),
+		},
+	}
+
+	if appIdx := appState.appIdx; appIdx != 0 {
+		global := appState.global[appIdx]
+		scopes = append(scopes, dap.Scope{
+			Name:               "Global State",
+			VariablesReference: handle(func() []dap.Variable { return stateVariables(global) }),
+			NamedVariables:     len(global),
+		})
+
+		accounts := make([]basics.Address, 0, len(appState.locals))
+		for addr, local := range appState.locals {
+			if _, ok := local[appIdx]; ok {
+				accounts = append(accounts, addr)
+			}
+		}
+		slices.SortFunc(accounts, func(x, y basics.Address) int { return bytes.Compare(x[:], y[:]) })
+		scopes = append(scopes, dap.Scope{
+			Name: "Local State",
+			VariablesReference: handle(func() []dap.Variable {
+				vars := make([]dap.Variable, len(accounts))
+				for i, addr := range accounts {
+					tkv := appState.locals[addr][appIdx]
+					vars[i] = dap.Variable{
+						Name:               addr.String(),
+						Value:              fmt.Sprintf("%d keys", len(tkv)),
+						VariablesReference: handle(func() []dap.Variable { return stateVariables(tkv) }),
+					}
+				}
+				return vars
+			}),
+			NamedVariables: len(accounts),
+		})
+
+		boxes := state.Boxes
+		scopes = append(scopes, dap.Scope{
+			Name: "Boxes",
+			VariablesReference: handle(func() []dap.Variable {
+				vars := make([]dap.Variable, len(boxes))
+				for i, box := range boxes {
+					name := displayBytes(box.Name)
+					if box.App != appIdx {
+						name = fmt.Sprintf("%d/%s", box.App, name)
+					}
+					vars[i] = fieldVariable(rawValueToFieldDesc(name, basics.TealValue{Type: basics.TealBytesType, Bytes: string(box.Value)}))
+				}
+				return vars
+			}),
+			NamedVariables: len(boxes),
+		})
+	}
+
+	if state.GroupIndex >= 0 && state.GroupIndex < len(state.TxnGroup) {
+		txn := &state.TxnGroup[state.GroupIndex].Txn
+		scopes = append(scopes, dap.Scope{
+			Name:               "Transaction",
+			VariablesReference: handle(func() []dap.Variable { return fieldVariables(prepareTxn(txn, state.GroupIndex, false)) }),
+		})
+	}
+	if len(state.Globals) > 0 {
+		scopes = append(scopes, dap.Scope{
+			Name:               "Globals",
+			VariablesReference: handle(func() []dap.Variable { return fieldVariables(prepareGlobals(state.Globals)) }),
+		})
+	}
+	return scopes
+}
+
+func fieldVariable(field fieldDesc) dap.Variable {
+	return dap.Variable{Name: field.Name, Value: field.Value, Type: field.Type}
+}
+
+func fieldVariables(fields []fieldDesc) []dap.Variable {
+	vars := make([]dap.Variable, len(fields))
+	for i, field := range fields {
+		vars[i] = fieldVariable(field)
+	}
+	return vars
+}
+
+// rawValueToFieldDesc is tealValueToFieldDesc for values with raw bytes, as in app state
+func rawValueToFieldDesc(name string, tv basics.TealValue) fieldDesc {
+	if tv.Type == basics.TealBytesType {
+		tv.Bytes = base64.StdEncoding.EncodeToString([]byte(tv.Bytes))
+	}
+	return tealValueToFieldDesc(name, tv)
+}
+
+// stateVariables returns the keys and values of app state, sorted by key
+func stateVariables(tkv basics.TealKeyValue) []dap.Variable {
+	keys := make([]string, 0, len(tkv))
+	for key := range tkv {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	vars := make([]dap.Variable, len(keys))
+	for i, key := range keys {
+		vars[i] = fieldVariable(rawValueToFieldDesc(displayBytes([]byte(key)), tkv[key]))
+	}
+	return vars
+}
+
+// displayBytes shows a key or a box name as text if it is printable, or in hex otherwise
+func displayBytes(data []byte) string {
+	if IsText(data) {
+		return string(data)
+	}
+	return "0x" + hex.EncodeToString(data)
+}
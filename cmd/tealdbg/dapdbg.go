@@ -0,0 +1,629 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/cmd/tealdbg/dap"
+)
+
+// DapFrontend is Debug Adapter Protocol frontend, for VS Code and other editors.
+// It serves one client at a time over TCP, and shows each program execution as a thread.
+type DapFrontend struct {
+	mu       deadlock.Mutex
+	listener net.Listener
+	verbose  bool
+
+	client *dapClient
+	// configured is closed when the client is done setting breakpoints, so that
+	// executions may start. It is replaced when the client disconnects.
+	configured     chan struct{}
+	configuredDone bool
+	stopOnEntry    bool
+	lineBase       int
+
+	sessions map[string]*dapSession
+	threads  int
+	started  bool
+	// breakpoints holds the 0-based lines of the breakpoints requested by the client, by source
+	breakpoints map[string][]int
+	// handles are the containers of variables that the client may expand, valid until
+	// execution resumes
+	handles []func() []dap.Variable
+}
+
+// DapFrontendParams for Setup
+type DapFrontendParams struct {
+	address string
+	verbose bool
+}
+
+// dapClient is a connection to a DAP client
+type dapClient struct {
+	mu      deadlock.Mutex
+	conn    net.Conn
+	seq     int
+	verbose bool
+}
+
+// MakeDapFrontend creates new DapFrontend, listening on params.address
+func MakeDapFrontend(params *DapFrontendParams) (a *DapFrontend, err error) {
+	a = new(DapFrontend)
+	a.sessions = make(map[string]*dapSession)
+	a.breakpoints = make(map[string][]int)
+	a.configured = make(chan struct{})
+	a.stopOnEntry = true
+	a.lineBase = 1
+	a.verbose = params.verbose
+
+	a.listener, err = net.Listen("tcp", params.address)
+	if err != nil {
+		return nil, err
+	}
+	log.Println("------------------------------------------------")
+	log.Printf("DAP debugger listening on: %s", a.listener.Addr())
+	log.Println("------------------------------------------------")
+
+	go a.accept()
+	return a, nil
+}
+
+// SessionStarted registers new session
+func (a *DapFrontend) SessionStarted(sid string, debugger Control, ch chan Notification) {
+	a.mu.Lock()
+	a.threads++
+	s := makeDapSession(a.threads, debugger, ch)
+	a.sessions[sid] = s
+	a.started = true
+	a.mu.Unlock()
+
+	go a.run(s)
+}
+
+// SessionEnded removes the session
+func (a *DapFrontend) SessionEnded(sid string) {
+	a.mu.Lock()
+	s, ok := a.sessions[sid]
+	a.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	go func() {
+		<-s.done
+		a.mu.Lock()
+		// the program may already run again in a new session
+		if a.sessions[sid] == s {
+			delete(a.sessions, sid)
+		}
+		a.mu.Unlock()
+		log.Printf("DAP session %s closed\n", sid)
+	}()
+}
+
+// URL returns the address of the DAP server once a session started
+func (a *DapFrontend) URL() string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if !a.started {
+		return ""
+	}
+	return a.listener.Addr().String()
+}
+
+// WaitForCompletion returns when no active sessions left, and the client disconnected
+func (a *DapFrontend) WaitForCompletion() {
+	for {
+		a.mu.Lock()
+		active := len(a.sessions)
+		client := a.client
+		a.mu.Unlock()
+		if active == 0 {
+			if client != nil {
+				client.event("terminated", nil)
+			}
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	for {
+		a.mu.Lock()
+		client := a.client
+		a.mu.Unlock()
+		if client == nil {
+			return
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+func (a *DapFrontend) accept() {
+	for {
+		conn, err := a.listener.Accept()
+		if err != nil {
+			return
+		}
+		a.mu.Lock()
+		busy := a.client != nil
+		var client *dapClient
+		if !busy {
+			client = &dapClient{conn: conn, verbose: a.verbose}
+			a.client = client
+		}
+		a.mu.Unlock()
+		if busy {
+			log.Printf("DAP client %s rejected: another client is connected\n", conn.RemoteAddr())
+			conn.Close()
+			continue
+		}
+		log.Printf("DAP client %s connected\n", conn.RemoteAddr())
+		go a.serve(client)
+	}
+}
+
+func (a *DapFrontend) serve(c *dapClient) {
+	reader := bufio.NewReader(c.conn)
+	for {
+		content, err := dap.ReadMessage(reader)
+		if err != nil {
+			a.disconnect(c)
+			return
+		}
+		var req dap.Request
+		err = json.Unmarshal(content, &req)
+		if err != nil {
+			log.Printf("invalid DAP message: %s\n", err.Error())
+			continue
+		}
+		if a.verbose {
+			log.Printf("received: %s %s\n", req.Command, string(req.Arguments))
+		}
+		if !a.handleRequest(c, &req) {
+			a.disconnect(c)
+			return
+		}
+	}
+}
+
+// disconnect forgets the client, and lets the executions run to completion without it
+func (a *DapFrontend) disconnect(c *dapClient) {
+	a.mu.Lock()
+	if a.client != c {
+		a.mu.Unlock()
+		return
+	}
+	a.client = nil
+	a.handles = nil
+	if !a.configuredDone {
+		close(a.configured)
+	}
+	a.configured = make(chan struct{})
+	a.configuredDone = false
+	sessions := a.sessionList()
+	a.mu.Unlock()
+
+	c.conn.Close()
+	log.Printf("DAP client %s disconnected\n", c.conn.RemoteAddr())
+	for _, s := range sessions {
+		s.detach()
+	}
+}
+
+// sessionList returns the sessions in thread order, and must be called with a.mu locked
+func (a *DapFrontend) sessionList() []*dapSession {
+	sessions := make([]*dapSession, 0, len(a.sessions))
+	for _, s := range a.sessions {
+		sessions = append(sessions, s)
+	}
+	slices.SortFunc(sessions, func(x, y *dapSession) int { return x.threadID - y.threadID })
+	return sessions
+}
+
+func (a *DapFrontend) session(threadID int) (*dapSession, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for _, s := range a.sessions {
+		if s.threadID == threadID {
+			return s, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown thread %d", threadID)
+}
+
+// event sends an event to the client, if any
+func (a *DapFrontend) event(name string, body interface{}) {
+	a.mu.Lock()
+	client := a.client
+	a.mu.Unlock()
+	if client != nil {
+		client.event(name, body)
+	}
+}
+
+// handle registers a container of variables, and returns its reference for the client
+func (a *DapFrontend) handle(variables func() []dap.Variable) int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.handles = append(a.handles, variables)
+	return len(a.handles)
+}
+
+func (a *DapFrontend) clearHandles() {
+	a.mu.Lock()
+	a.handles = nil
+	a.mu.Unlock()
+}
+
+// run processes the notifications of an execution
+func (a *DapFrontend) run(s *dapSession) {
+	defer close(s.done)
+	for notification := range s.notifications {
+		if a.verbose {
+			log.Printf("received: %s\n", notification.Event)
+		}
+		switch notification.Event {
+		case "registered":
+			s.register(notification.DebugState)
+			// wait until the client set its breakpoints
+			a.mu.Lock()
+			configured := a.configured
+			a.mu.Unlock()
+			<-configured
+
+			a.event("thread", dap.ThreadEventBody{Reason: "started", ThreadID: s.threadID})
+			a.applyBreakpoints(s)
+			a.mu.Lock()
+			stopOnEntry := a.stopOnEntry
+			a.mu.Unlock()
+			if stopOnEntry {
+				a.stop(s, "entry")
+			} else {
+				s.debugger.Resume()
+			}
+		case "updated":
+			if reason, stop := s.update(notification.DebugState); stop {
+				a.stop(s, reason)
+			}
+		case "completed":
+			s.update(notification.DebugState)
+			result := "passed"
+			if notification.DebugState.Error != "" {
+				result = "failed: " + notification.DebugState.Error
+			}
+			a.event("output", dap.OutputEventBody{
+				Category: "console",
+				Output:   fmt.Sprintf("%s %s\n", s.name, result),
+			})
+			a.event("thread", dap.ThreadEventBody{Reason: "exited", ThreadID: s.threadID})
+			return
+		default:
+			log.Println("Unk event: " + notification.Event)
+		}
+	}
+}
+
+// stop tells the client that the execution paused, or lets it continue if there is no client
+func (a *DapFrontend) stop(s *dapSession, reason string) {
+	a.mu.Lock()
+	client := a.client
+	a.handles = nil
+	a.mu.Unlock()
+
+	if client == nil {
+		s.detach()
+		return
+	}
+	s.setStopped()
+	client.event("stopped", dap.StoppedEventBody{Reason: reason, ThreadID: s.threadID})
+}
+
+// applyBreakpoints sets the requested breakpoints in the source of a session
+func (a *DapFrontend) applyBreakpoints(s *dapSession) {
+	a.mu.Lock()
+	var lines []int
+	for key, requested := range a.breakpoints {
+		if s.matches(key) {
+			lines = append(lines, requested...)
+		}
+	}
+	a.mu.Unlock()
+	s.setBreakpoints(lines)
+}
+
+// sourceKey identifies a source in breakpoints
+func sourceKey(source dap.Source) string {
+	if source.SourceReference != 0 {
+		return fmt.Sprintf("ref:%d", source.SourceReference)
+	}
+	path, err := filepath.Abs(source.Path)
+	if err != nil {
+		return source.Path
+	}
+	return path
+}
+
+func (c *dapClient) write(message interface{}, pm *dap.ProtocolMessage) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.seq++
+	pm.Seq = c.seq
+	if c.verbose {
+		log.Printf("sending: %v\n", message)
+	}
+	err := dap.WriteMessage(c.conn, message)
+	if err != nil {
+		log.Println(err.Error())
+	}
+}
+
+func (c *dapClient) respond(req *dap.Request, body interface{}) {
+	resp := dap.Response{
+		ProtocolMessage: dap.ProtocolMessage{Type: "response"},
+		RequestSeq:      req.Seq,
+		Success:         true,
+		Command:         req.Command,
+		Body:            body,
+	}
+	c.write(&resp, &resp.ProtocolMessage)
+}
+
+func (c *dapClient) fail(req *dap.Request, err error) {
+	resp := dap.Response{
+		ProtocolMessage: dap.ProtocolMessage{Type: "response"},
+		RequestSeq:      req.Seq,
+		Success:         false,
+		Command:         req.Command,
+		Message:         err.Error(),
+	}
+	c.write(&resp, &resp.ProtocolMessage)
+}
+
+func (c *dapClient) event(name string, body interface{}) {
+	ev := dap.Event{
+		ProtocolMessage: dap.ProtocolMessage{Type: "event"},
+		Event:           name,
+		Body:            body,
+	}
+	c.write(&ev, &ev.ProtocolMessage)
+}
+
+// handleRequest handles a request of the client, and returns false if the client disconnects
+func (a *DapFrontend) handleRequest(c *dapClient, req *dap.Request) bool {
+	var err error
+	switch req.Command {
+	case "initialize":
+		var args dap.InitializeRequestArguments
+		if err = decodeDapArguments(req, &args); err != nil {
+			break
+		}
+		a.mu.Lock()
+		if args.LinesStartAt1 != nil && !*args.LinesStartAt1 {
+			a.lineBase = 0
+		} else {
+			a.lineBase = 1
+		}
+		a.mu.Unlock()
+		c.respond(req, dap.Capabilities{
+			SupportsConfigurationDoneRequest: true,
+			SupportsTerminateRequest:         true,
+		})
+		c.event("initialized", nil)
+	case "launch", "attach":
+		// programs are set up on the command line, so launching attaches to them
+		var args dap.LaunchRequestArguments
+		if err = decodeDapArguments(req, &args); err != nil {
+			break
+		}
+		a.mu.Lock()
+		if args.StopOnEntry != nil {
+			a.stopOnEntry = *args.StopOnEntry
+		}
+		a.mu.Unlock()
+		c.respond(req, nil)
+	case "setBreakpoints":
+		err = a.setBreakpoints(c, req)
+	case "setExceptionBreakpoints":
+		c.respond(req, dap.SetBreakpointsResponseBody{Breakpoints: []dap.Breakpoint{}})
+	case "configurationDone":
+		a.mu.Lock()
+		if !a.configuredDone {
+			a.configuredDone = true
+			close(a.configured)
+		}
+		a.mu.Unlock()
+		c.respond(req, nil)
+	case "threads":
+		a.mu.Lock()
+		sessions := a.sessionList()
+		a.mu.Unlock()
+		threads := make([]dap.Thread, 0, len(sessions))
+		for _, s := range sessions {
+			threads = append(threads, dap.Thread{ID: s.threadID, Name: s.threadName()})
+		}
+		c.respond(req, dap.ThreadsResponseBody{Threads: threads})
+	case "stackTrace":
+		var args dap.StackTraceArguments
+		if err = decodeDapArguments(req, &args); err != nil {
+			break
+		}
+		var s *dapSession
+		if s, err = a.session(args.ThreadID); err != nil {
+			break
+		}
+		a.mu.Lock()
+		lineBase := a.lineBase
+		a.mu.Unlock()
+		frames := s.stackFrames(lineBase)
+		total := len(frames)
+		if args.StartFrame < len(frames) {
+			frames = frames[args.StartFrame:]
+		} else {
+			frames = nil
+		}
+		if args.Levels > 0 && args.Levels < len(frames) {
+			frames = frames[:args.Levels]
+		}
+		c.respond(req, dap.StackTraceResponseBody{StackFrames: frames, TotalFrames: total})
+	case "scopes":
+		var args dap.ScopesArguments
+		if err = decodeDapArguments(req, &args); err != nil {
+			break
+		}
+		var s *dapSession
+		if s, err = a.session(args.FrameID / dapMaxFrames); err != nil {
+			break
+		}
+		c.respond(req, dap.ScopesResponseBody{Scopes: s.scopes(a.handle)})
+	case "variables":
+		var args dap.VariablesArguments
+		if err = decodeDapArguments(req, &args); err != nil {
+			break
+		}
+		a.mu.Lock()
+		var variables func() []dap.Variable
+		if args.VariablesReference > 0 && args.VariablesReference <= len(a.handles) {
+			variables = a.handles[args.VariablesReference-1]
+		}
+		a.mu.Unlock()
+		if variables == nil {
+			err = fmt.Errorf("unknown variables reference %d", args.VariablesReference)
+			break
+		}
+		c.respond(req, dap.VariablesResponseBody{Variables: variables()})
+	case "source":
+		var args dap.SourceArguments
+		if err = decodeDapArguments(req, &args); err != nil {
+			break
+		}
+		var s *dapSession
+		if s, err = a.session(args.SourceReference); err != nil {
+			break
+		}
+		c.respond(req, dap.SourceResponseBody{Content: s.disassembly(), MimeType: "text/x-teal"})
+	case "continue", "next", "stepIn", "stepOut", "pause":
+		var args dap.ThreadArguments
+		if err = decodeDapArguments(req, &args); err != nil {
+			break
+		}
+		var s *dapSession
+		if s, err = a.session(args.ThreadID); err != nil {
+			break
+		}
+		if req.Command == "pause" {
+			s.pause()
+			c.respond(req, nil)
+			break
+		}
+		a.clearHandles()
+		switch req.Command {
+		case "continue":
+			err = s.resume()
+		case "next":
+			err = s.stepWith(s.debugger.StepOver)
+		case "stepIn":
+			err = s.stepWith(s.debugger.Step)
+		case "stepOut":
+			err = s.stepWith(s.debugger.StepOut)
+		}
+		if err != nil {
+			break
+		}
+		if req.Command == "continue" {
+			c.respond(req, dap.ContinueResponseBody{AllThreadsContinued: false})
+		} else {
+			c.respond(req, nil)
+		}
+	case "terminate":
+		// run the executions to completion, without breaking
+		c.respond(req, nil)
+		a.mu.Lock()
+		sessions := a.sessionList()
+		a.mu.Unlock()
+		for _, s := range sessions {
+			s.detach()
+		}
+	case "disconnect":
+		c.respond(req, nil)
+		return false
+	default:
+		err = fmt.Errorf("unsupported request %s", req.Command)
+	}
+	if err != nil {
+		c.fail(req, err)
+	}
+	return true
+}
+
+func (a *DapFrontend) setBreakpoints(c *dapClient, req *dap.Request) error {
+	var args dap.SetBreakpointsArguments
+	if err := decodeDapArguments(req, &args); err != nil {
+		return err
+	}
+	key := sourceKey(args.Source)
+
+	a.mu.Lock()
+	lines := make([]int, len(args.Breakpoints))
+	for i, bp := range args.Breakpoints {
+		lines[i] = bp.Line - a.lineBase
+	}
+	a.breakpoints[key] = lines
+	lineBase := a.lineBase
+	sessions := a.sessionList()
+	a.mu.Unlock()
+
+	// verify the breakpoints in the executions of the source, or accept them until one starts
+	verified := make([]bool, len(lines))
+	matched := false
+	for _, s := range sessions {
+		if !s.matches(key) {
+			continue
+		}
+		matched = true
+		a.applyBreakpoints(s)
+		for i, line := range lines {
+			if _, ok := s.breakpointLine(line); ok {
+				verified[i] = true
+			}
+		}
+	}
+	breakpoints := make([]dap.Breakpoint, len(lines))
+	for i, line := range lines {
+		breakpoints[i] = dap.Breakpoint{ID: i + 1, Verified: verified[i] || !matched, Line: line + lineBase}
+		if !breakpoints[i].Verified {
+			breakpoints[i].Message = "no TEAL opcode on this line"
+		}
+	}
+	c.respond(req, dap.SetBreakpointsResponseBody{Breakpoints: breakpoints})
+	return nil
+}
+
+func decodeDapArguments(req *dap.Request, args interface{}) error {
+	if len(req.Arguments) == 0 {
+		return nil
+	}
+	return json.Unmarshal(req.Arguments, args)
+}
@@ -0,0 +1,283 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/cmd/tealdbg/dap"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// testDapMessage is a response or an event received by testDapClient
+type testDapMessage struct {
+	Type       string          `json:"type"`
+	Event      string          `json:"event"`
+	Command    string          `json:"command"`
+	RequestSeq int             `json:"request_seq"`
+	Success    bool            `json:"success"`
+	Message    string          `json:"message"`
+	Body       json.RawMessage `json:"body"`
+}
+
+type testDapClient struct {
+	t      *testing.T
+	conn   net.Conn
+	reader *bufio.Reader
+	seq    int
+	events []testDapMessage
+}
+
+func makeTestDapClient(t *testing.T, address string) *testDapClient {
+	conn, err := net.Dial("tcp", address)
+	require.NoError(t, err)
+	return &testDapClient{t: t, conn: conn, reader: bufio.NewReader(conn)}
+}
+
+func (c *testDapClient) read() testDapMessage {
+	c.conn.SetReadDeadline(time.Now().Add(10 * time.Second))
+	content, err := dap.ReadMessage(c.reader)
+	require.NoError(c.t, err)
+	var msg testDapMessage
+	require.NoError(c.t, json.Unmarshal(content, &msg))
+	return msg
+}
+
+// request sends a request, and decodes the body of its response into body, if not nil
+func (c *testDapClient) request(command string, args interface{}, body interface{}) testDapMessage {
+	c.seq++
+	req := struct {
+		dap.ProtocolMessage
+		Command   string      `json:"command"`
+		Arguments interface{} `json:"arguments,omitempty"`
+	}{dap.ProtocolMessage{Seq: c.seq, Type: "request"}, command, args}
+	require.NoError(c.t, dap.WriteMessage(c.conn, req))
+	for {
+		msg := c.read()
+		if msg.Type == "event" {
+			c.events = append(c.events, msg)
+			continue
+		}
+		require.Equal(c.t, c.seq, msg.RequestSeq)
+		require.Equal(c.t, command, msg.Command)
+		if body != nil {
+			require.True(c.t, msg.Success, msg.Message)
+			require.NoError(c.t, json.Unmarshal(msg.Body, body))
+		}
+		return msg
+	}
+}
+
+// event waits for an event, and decodes its body into body, if not nil
+func (c *testDapClient) event(name string, body interface{}) {
+	for {
+		var msg testDapMessage
+		if len(c.events) > 0 {
+			msg, c.events = c.events[0], c.events[1:]
+		} else {
+			msg = c.read()
+		}
+		if msg.Type == "event" && msg.Event == name {
+			if body != nil {
+				require.NoError(c.t, json.Unmarshal(msg.Body, body))
+			}
+			return
+		}
+	}
+}
+
+// stopped waits until the thread stops, and returns the reason and the stack trace
+func (c *testDapClient) stopped() (string, []dap.StackFrame) {
+	var stopped dap.StoppedEventBody
+	c.event("stopped", &stopped)
+	var trace dap.StackTraceResponseBody
+	c.request("stackTrace", dap.StackTraceArguments{ThreadID: stopped.ThreadID}, &trace)
+	return stopped.Reason, trace.StackFrames
+}
+
+func TestDapFrontend(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	source := `#pragma version 8
+int 1
+callsub double
+int 2
+==
+return
+double:
+dup
++
+retsub`
+	ops, err := logic.AssembleString(source)
+	require.NoError(t, err)
+
+	debugger := MakeDebugger()
+	a, err := MakeDapFrontend(&DapFrontendParams{address: "127.0.0.1:0"})
+	require.NoError(t, err)
+	defer a.listener.Close()
+	debugger.AddAdapter(a)
+	debugger.SaveProgram("test.teal", ops.Program, source, ops.OffsetToSource, AppState{})
+
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+	txn := transactions.SignedTxn{}
+	txn.Lsig.Logic = ops.Program
+	ep := logic.NewSigEvalParams([]transactions.SignedTxn{txn}, &proto, logic.NoHeaderLedger{})
+	ep.Tracer = logic.MakeEvalTracerDebuggerAdaptor(debugger)
+	type evalResult struct {
+		pass bool
+		err  error
+	}
+	result := make(chan evalResult, 1)
+	go func() {
+		pass, err := logic.EvalSignature(0, ep)
+		result <- evalResult{pass, err}
+	}()
+
+	c := makeTestDapClient(t, a.listener.Addr().String())
+	var caps dap.Capabilities
+	c.request("initialize", dap.InitializeRequestArguments{AdapterID: "teal"}, &caps)
+	require.True(t, caps.SupportsConfigurationDoneRequest)
+	c.event("initialized", nil)
+	c.request("attach", dap.LaunchRequestArguments{}, nil)
+
+	var bps dap.SetBreakpointsResponseBody
+	c.request("setBreakpoints", dap.SetBreakpointsArguments{
+		Source:      dap.Source{Path: "/src/test.teal"},
+		Breakpoints: []dap.SourceBreakpoint{{Line: 9}},
+	}, &bps)
+	require.Len(t, bps.Breakpoints, 1)
+	require.True(t, bps.Breakpoints[0].Verified)
+	c.request("configurationDone", nil, nil)
+
+	var thread dap.ThreadEventBody
+	c.event("thread", &thread)
+	require.Equal(t, dap.ThreadEventBody{Reason: "started", ThreadID: 1}, thread)
+	var threads dap.ThreadsResponseBody
+	c.request("threads", nil, &threads)
+	require.Equal(t, []dap.Thread{{ID: 1, Name: "test.teal"}}, threads.Threads)
+
+	reason, frames := c.stopped()
+	require.Equal(t, "entry", reason)
+	require.Len(t, frames, 1)
+	require.Equal(t, "test.teal", frames[0].Source.Name)
+	require.Equal(t, 2, frames[0].Line)
+
+	c.request("next", dap.ThreadArguments{ThreadID: 1}, nil)
+	reason, frames = c.stopped()
+	require.Equal(t, "step", reason)
+	require.Equal(t, 3, frames[0].Line)
+
+	c.request("stepIn", dap.ThreadArguments{ThreadID: 1}, nil)
+	_, frames = c.stopped()
+	require.Len(t, frames, 2)
+	require.Equal(t, "double", frames[0].Name)
+	require.Equal(t, 8, frames[0].Line)
+	require.Equal(t, "main", frames[1].Name)
+	require.Equal(t, 3, frames[1].Line)
+
+	var scopes dap.ScopesResponseBody
+	c.request("scopes", dap.ScopesArguments{FrameID: frames[0].ID}, &scopes)
+	require.Equal(t, "Stack", scopes.Scopes[0].Name)
+	var vars dap.VariablesResponseBody
+	c.request("variables", dap.VariablesArguments{VariablesReference: scopes.Scopes[0].VariablesReference}, &vars)
+	require.Equal(t, []dap.Variable{{Name: "0", Value: "1", Type: "bigint"}}, vars.Variables)
+
+	c.request("continue", dap.ThreadArguments{ThreadID: 1}, nil)
+	reason, frames = c.stopped()
+	require.Equal(t, "breakpoint", reason)
+	require.Equal(t, 9, frames[0].Line)
+
+	c.request("stepOut", dap.ThreadArguments{ThreadID: 1}, nil)
+	reason, frames = c.stopped()
+	require.Equal(t, "step", reason)
+	require.Len(t, frames, 1)
+	require.Equal(t, 4, frames[0].Line)
+
+	resp := c.request("next", dap.ThreadArguments{ThreadID: 2}, nil)
+	require.False(t, resp.Success)
+
+	c.request("continue", dap.ThreadArguments{ThreadID: 1}, nil)
+	var output dap.OutputEventBody
+	c.event("output", &output)
+	require.Equal(t, "test.teal passed\n", output.Output)
+	c.event("thread", &thread)
+	require.Equal(t, dap.ThreadEventBody{Reason: "exited", ThreadID: 1}, thread)
+
+	res := <-result
+	require.NoError(t, res.err)
+	require.True(t, res.pass)
+
+	done := make(chan struct{})
+	go func() {
+		a.WaitForCompletion()
+		close(done)
+	}()
+	c.event("terminated", nil)
+	c.request("disconnect", nil, nil)
+	<-done
+}
+
+func TestDapFrontendDisconnect(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ops, err := logic.AssembleString("#pragma version 8\nint 1\nint 2\n+")
+	require.NoError(t, err)
+
+	debugger := MakeDebugger()
+	a, err := MakeDapFrontend(&DapFrontendParams{address: "127.0.0.1:0"})
+	require.NoError(t, err)
+	defer a.listener.Close()
+	debugger.AddAdapter(a)
+
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+	txn := transactions.SignedTxn{}
+	txn.Lsig.Logic = ops.Program
+	ep := logic.NewSigEvalParams([]transactions.SignedTxn{txn}, &proto, logic.NoHeaderLedger{})
+	ep.Tracer = logic.MakeEvalTracerDebuggerAdaptor(debugger)
+	result := make(chan error, 1)
+	go func() {
+		_, err := logic.EvalSignature(0, ep)
+		result <- err
+	}()
+
+	// without source, the program is shown as its disassembly
+	c := makeTestDapClient(t, a.listener.Addr().String())
+	c.request("initialize", dap.InitializeRequestArguments{AdapterID: "teal"}, nil)
+	c.request("configurationDone", nil, nil)
+	_, frames := c.stopped()
+	require.Equal(t, 1, frames[0].Source.SourceReference)
+	var src dap.SourceResponseBody
+	c.request("source", dap.SourceArguments{SourceReference: 1}, &src)
+	require.Contains(t, src.Content, "pushint 2")
+
+	// the execution completes once the client is gone
+	c.request("disconnect", nil, nil)
+	require.NoError(t, <-result)
+	a.WaitForCompletion()
+}
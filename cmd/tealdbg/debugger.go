@@ -63,6 +63,7 @@ type Control interface {
 
 	GetSourceMap() ([]byte, error)
 	GetSource() (string, []byte)
+	GetOffsetToSource() map[int]logic.SourceLocation
 	GetStates(s *logic.DebugState) AppState
 }
 
@@ -406,6 +407,14 @@ func (s *session) GetSource() (string, []byte) {
 	return s.programName, []byte(s.source)
 }
 
+// GetOffsetToSource returns the source location of each pc, or nil if there is no source
+func (s *session) GetOffsetToSource() map[int]logic.SourceLocation {
+	if len(s.source) == 0 {
+		return nil
+	}
+	return s.offsetToSource
+}
+
 func (s *session) GetStates(st *logic.DebugState) AppState {
 	if st == nil {
 		return s.states
@@ -441,6 +450,7 @@ func (s *session) GetStates(st *logic.DebugState) AppState {
 
 	txn := st.TxnGroup[st.GroupIndex].Txn
 	accounts := append([]basics.Address{txn.Sender}, txn.Accounts...)
+	accounts = append(accounts, changes.SharedAccts...)
 	for idx, delta := range changes.LocalDeltas {
 		addr := accounts[idx]
 		local := newStates.locals[addr]
@@ -17,6 +17,7 @@
 package main
 
 import (
+	"fmt"
 	"log"
 	"os"
 
@@ -50,7 +51,7 @@ var rootCmd = &cobra.Command{
 	Use:   "tealdbg",
 	Short: "Algorand TEAL Debugger",
 	Long: `Debug a local or remote TEAL code in controlled environment
-with Web or Chrome DevTools frontends`,
+with Web, Chrome DevTools or Debug Adapter Protocol frontends`,
 	Run: func(cmd *cobra.Command, args []string) {
 		//If no arguments passed, we should fallback to help
 		cmd.HelpFunc()(cmd, args)
@@ -68,6 +69,17 @@ var debugCmd = &cobra.Command{
 	},
 }
 
+var replayCmd = &cobra.Command{
+	Use:   "replay --trace simulate.json [program.tok [program.teal ...]]",
+	Short: "Debug the execution trace(s) of a simulate response",
+	Long: `Replay the execution traces of a simulate response, as written by goal clerk simulate --full-trace,
+in the debugger. Programs of logic sigs and created apps are taken from the transactions,
+other programs (such as those of existing apps) must be specified. TEAL sources provide source maps.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		debugReplay(args)
+	},
+}
+
 var remoteCmd = &cobra.Command{
 	Use:   "remote",
 	Short: "Debug TEAL program on-chain",
@@ -90,6 +102,12 @@ func (f *frontendValue) Make(router *mux.Router, appAddress string) (da DebugAda
 	case "web":
 		wa := MakeWebPageFrontend(&WebPageFrontendParams{router, appAddress})
 		return wa
+	case "dap":
+		da, err := MakeDapFrontend(&DapFrontendParams{fmt.Sprintf("%s:%d", iface, dapPort), verbose})
+		if err != nil {
+			log.Fatalf("Error starting DAP server: %s", err.Error())
+		}
+		return da
 	case "cdt":
 		fallthrough
 	default:
@@ -102,7 +120,7 @@ type runModeValue struct {
 	*cmdutil.CobraStringValue
 }
 
-var frontend frontendValue = frontendValue{cmdutil.MakeCobraStringValue("cdt", []string{"web"})}
+var frontend frontendValue = frontendValue{cmdutil.MakeCobraStringValue("cdt", []string{"web", "dap"})}
 var proto string
 var txnFile string
 var groupIndex int
@@ -114,6 +132,8 @@ var roundNumber uint64
 var timestamp int64
 var runMode runModeValue = runModeValue{cmdutil.MakeCobraStringValue("auto", []string{"signature", "application"})}
 var port int
+var dapPort int
+var traceFile string
 var iface string
 var noFirstRun bool
 var noBrowserCheck bool
@@ -126,6 +146,7 @@ var listenForDrReq bool
 func init() {
 	rootCmd.PersistentFlags().VarP(&frontend, "frontend", "f", "Frontend to use: "+frontend.AllowedString())
 	rootCmd.PersistentFlags().IntVar(&port, "remote-debugging-port", 9392, "Port to listen on")
+	rootCmd.PersistentFlags().IntVar(&dapPort, "dap-port", 9393, "Port to listen on for Debug Adapter Protocol clients, with the dap frontend")
 	rootCmd.PersistentFlags().StringVar(&iface, "listen", "127.0.0.1", "Network interface to listen on")
 	rootCmd.PersistentFlags().BoolVar(&noFirstRun, "no-first-run", false, "")
 	rootCmd.PersistentFlags().MarkHidden("no-first-run")
@@ -148,7 +169,12 @@ func init() {
 	debugCmd.Flags().StringVarP(&indexerToken, "indexer-token", "", "", "API token for indexer to fetch Balance records from to evaluate stateful TEAL")
 	debugCmd.Flags().BoolVarP(&listenForDrReq, "listen-dr-req", "q", false, "Listen for upcoming debugging dryrun request objects instead of taking program(s) from command line")
 
+	replayCmd.Flags().StringVar(&traceFile, "trace", "", "Simulate response with execution trace(s) in form of json file")
+	replayCmd.Flags().StringVarP(&proto, "proto", "p", "", "Consensus protocol version for TEAL disassembly")
+	replayCmd.MarkFlagRequired("trace")
+
 	rootCmd.AddCommand(debugCmd)
+	rootCmd.AddCommand(replayCmd)
 	rootCmd.AddCommand(remoteCmd)
 }
 
@@ -260,3 +286,36 @@ func debugLocal(args []string) {
 		log.Fatalf("Debug error: %s", err.Error())
 	}
 }
+
+func debugReplay(args []string) {
+	traceBlob, err := os.ReadFile(traceFile)
+	if err != nil {
+		log.Fatalf("Error trace reading %s: %s", traceFile, err)
+	}
+
+	programNames := make([]string, len(args))
+	programBlobs := make([][]byte, len(args))
+	for i, file := range args {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			log.Fatalf("Error program reading %s: %s", file, err)
+		}
+		programNames[i] = file
+		programBlobs[i] = data
+	}
+
+	dp := DebugParams{
+		ProgramNames:     programNames,
+		ProgramBlobs:     programBlobs,
+		Proto:            proto,
+		DisableSourceMap: noSourceMap,
+		TraceBlob:        traceBlob,
+	}
+
+	ds := makeDebugServer(iface, port, &frontend, &dp)
+
+	err = ds.startDebug()
+	if err != nil {
+		log.Fatalf("Debug error: %s", err.Error())
+	}
+}
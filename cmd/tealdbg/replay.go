@@ -0,0 +1,606 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	v2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// ReplayRunner replays the execution traces of a simulate response in the debugger, instead of
+// evaluating programs
+type ReplayRunner struct {
+	debugger  *Debugger
+	protoName string
+	proto     config.ConsensusParams
+	response  v2.PreEncodedSimulateResponse
+
+	// programs by hash, as in the traces
+	programs map[crypto.Digest]replayProgram
+
+	// app state as of the program being replayed
+	global map[basics.AppIndex]basics.TealKeyValue
+	locals map[basics.Address]map[basics.AppIndex]basics.TealKeyValue
+	boxes  map[replayBoxKey][]byte
+}
+
+type replayProgram struct {
+	name           string
+	program        []byte
+	source         string
+	offsetToSource map[int]logic.SourceLocation
+}
+
+type replayBoxKey struct {
+	app  basics.AppIndex
+	name string
+}
+
+// replayRun is a program execution to replay
+type replayRun struct {
+	program *replayProgram
+	trace   []model.SimulationOpcodeTraceUnit
+	// txnGroup and groupIndex locate the transaction that runs the program
+	txnGroup   []transactions.SignedTxnWithAD
+	groupIndex int
+	appIdx     basics.AppIndex
+	// inners are the inner transactions of the transaction, and their traces
+	inners      []v2.PreEncodedTxInfo
+	innerTraces []model.SimulationTransactionExecTrace
+	err         string
+}
+
+// MakeReplayRunner creates ReplayRunner
+func MakeReplayRunner(debugger *Debugger) *ReplayRunner {
+	r := new(ReplayRunner)
+	r.debugger = debugger
+	r.programs = make(map[crypto.Digest]replayProgram)
+	r.global = make(map[basics.AppIndex]basics.TealKeyValue)
+	r.locals = make(map[basics.Address]map[basics.AppIndex]basics.TealKeyValue)
+	r.boxes = make(map[replayBoxKey][]byte)
+	return r
+}
+
+// Setup decodes the simulate response in DebugParams.TraceBlob, and the programs of its traces.
+// Programs are taken from DebugParams.ProgramBlobs, assembled if they are TEAL source, and from the
+// transactions: logic sigs and the programs of created apps.
+func (r *ReplayRunner) Setup(dp *DebugParams) (err error) {
+	r.protoName, r.proto, err = protoFromString(dp.Proto)
+	if err != nil {
+		return
+	}
+	log.Printf("Using proto: %s", r.protoName)
+
+	err = protocol.DecodeJSON(dp.TraceBlob, &r.response)
+	if err != nil {
+		return fmt.Errorf("invalid simulate response: %w", err)
+	}
+	if len(r.response.TxnGroups) == 0 {
+		return fmt.Errorf("no transaction groups in simulate response")
+	}
+
+	for i, data := range dp.ProgramBlobs {
+		program := replayProgram{name: dp.ProgramNames[i], program: data}
+		if IsTextFile(data) {
+			ops, err1 := logic.AssembleString(string(data))
+			if err1 != nil {
+				return fmt.Errorf("%s: %w", program.name, err1)
+			}
+			program.program = ops.Program
+			if !dp.DisableSourceMap {
+				program.source = string(data)
+				program.offsetToSource = ops.OffsetToSource
+			}
+		}
+		r.programs[crypto.Hash(program.program)] = program
+	}
+
+	for gi, group := range r.response.TxnGroups {
+		for ti := range group.Txns {
+			r.addTxnPrograms(fmt.Sprintf("txn %d/%d", gi, ti), &group.Txns[ti].Txn)
+		}
+	}
+
+	if r.response.InitialStates != nil && r.response.InitialStates.AppInitialStates != nil {
+		for _, app := range *r.response.InitialStates.AppInitialStates {
+			err = r.addInitialState(app)
+			if err != nil {
+				return
+			}
+		}
+	}
+	return nil
+}
+
+// addTxnPrograms adds the programs found in a transaction and its inner transactions, unless
+// they were given on the command line
+func (r *ReplayRunner) addTxnPrograms(name string, txn *v2.PreEncodedTxInfo) {
+	add := func(kind string, program []byte) {
+		if len(program) == 0 {
+			return
+		}
+		hash := crypto.Hash(program)
+		if _, ok := r.programs[hash]; !ok {
+			r.programs[hash] = replayProgram{name: fmt.Sprintf("%s %s", name, kind), program: program}
+		}
+	}
+	add("logicsig", txn.Txn.Lsig.Logic)
+	add("approval", txn.Txn.Txn.ApprovalProgram)
+	add("clearstate", txn.Txn.Txn.ClearStateProgram)
+	if txn.Inners != nil {
+		for i := range *txn.Inners {
+			r.addTxnPrograms(fmt.Sprintf("%s/%d", name, i), &(*txn.Inners)[i])
+		}
+	}
+}
+
+func (r *ReplayRunner) addInitialState(app model.ApplicationInitialStates) error {
+	if app.AppGlobals != nil {
+		r.global[app.Id] = kvsToTealKeyValue(app.AppGlobals.Kvs)
+	}
+	if app.AppLocals != nil {
+		for _, local := range *app.AppLocals {
+			if local.Account == nil {
+				continue
+			}
+			addr, err := basics.UnmarshalChecksumAddress(*local.Account)
+			if err != nil {
+				return err
+			}
+			if r.locals[addr] == nil {
+				r.locals[addr] = make(map[basics.AppIndex]basics.TealKeyValue)
+			}
+			r.locals[addr][app.Id] = kvsToTealKeyValue(local.Kvs)
+		}
+	}
+	if app.AppBoxes != nil {
+		for _, kv := range app.AppBoxes.Kvs {
+			if kv.Value.Bytes != nil {
+				r.boxes[replayBoxKey{app.Id, string(kv.Key)}] = *kv.Value.Bytes
+			}
+		}
+	}
+	return nil
+}
+
+// RunAll replays the traces of all the transaction groups
+func (r *ReplayRunner) RunAll() error {
+	replayed := 0
+	for gi, group := range r.response.TxnGroups {
+		txnGroup := make([]transactions.SignedTxnWithAD, len(group.Txns))
+		for ti := range group.Txns {
+			txnGroup[ti].SignedTxn = group.Txns[ti].Txn.Txn
+		}
+		var failedAt []int
+		if group.FailedAt != nil {
+			failedAt = *group.FailedAt
+		}
+		failure := ""
+		if group.FailureMessage != nil {
+			failure = *group.FailureMessage
+		}
+
+		// logic sigs are evaluated before any app
+		for ti := range group.Txns {
+			trace := group.Txns[ti].TransactionTrace
+			if trace == nil || trace.LogicSigTrace == nil {
+				continue
+			}
+			run, err := r.makeRun(trace.LogicSigHash, *trace.LogicSigTrace, txnGroup, ti)
+			if err != nil {
+				return fmt.Errorf("group %d txn %d: %w", gi, ti, err)
+			}
+			if run == nil {
+				continue
+			}
+			if slices.Equal(failedAt, []int{ti}) && trace.ApprovalProgramTrace == nil && trace.ClearStateProgramTrace == nil {
+				run.err = failure
+			}
+			_, _ = r.replayApp(run, nil, nil, "")
+			replayed++
+		}
+
+		for ti := range group.Txns {
+			trace := group.Txns[ti].TransactionTrace
+			if trace == nil {
+				continue
+			}
+			n, err := r.replayTxn(&group.Txns[ti].Txn, trace, txnGroup, ti, []int{ti}, failedAt, failure)
+			if err != nil {
+				return fmt.Errorf("group %d txn %d: %w", gi, ti, err)
+			}
+			replayed += n
+		}
+	}
+	if replayed == 0 {
+		return fmt.Errorf("no program traces found in simulate response, simulate with --trace")
+	}
+	return nil
+}
+
+// replayTxn replays the app programs of a transaction, and of its inner transactions as they are
+// spawned. It returns the number of programs replayed.
+func (r *ReplayRunner) replayTxn(
+	txn *v2.PreEncodedTxInfo, trace *model.SimulationTransactionExecTrace,
+	txnGroup []transactions.SignedTxnWithAD, groupIndex int, path []int, failedAt []int, failure string,
+) (int, error) {
+	replayed := 0
+	var inners []v2.PreEncodedTxInfo
+	if txn.Inners != nil {
+		inners = *txn.Inners
+	}
+	var innerTraces []model.SimulationTransactionExecTrace
+	if trace.InnerTrace != nil {
+		innerTraces = *trace.InnerTrace
+	}
+	appIdx := txn.Txn.Txn.ApplicationID
+	if appIdx == 0 && txn.ApplicationIndex != nil {
+		appIdx = *txn.ApplicationIndex
+	}
+
+	programs := []struct {
+		hash  *[]byte
+		trace *[]model.SimulationOpcodeTraceUnit
+	}{
+		{trace.ApprovalProgramHash, trace.ApprovalProgramTrace},
+		{trace.ClearStateProgramHash, trace.ClearStateProgramTrace},
+	}
+	for _, p := range programs {
+		if p.trace == nil {
+			continue
+		}
+		run, err := r.makeRun(p.hash, *p.trace, txnGroup, groupIndex)
+		if err != nil {
+			return replayed, err
+		}
+		if run == nil {
+			continue
+		}
+		run.appIdx = appIdx
+		run.inners = inners
+		run.innerTraces = innerTraces
+		if slices.Equal(failedAt, path) {
+			run.err = failure
+		}
+		n, err := r.replayApp(run, path, failedAt, failure)
+		replayed += n + 1
+		if err != nil {
+			return replayed, err
+		}
+	}
+	return replayed, nil
+}
+
+// makeRun finds the program of a trace, or returns nil if it is unknown
+func (r *ReplayRunner) makeRun(hash *[]byte, trace []model.SimulationOpcodeTraceUnit, txnGroup []transactions.SignedTxnWithAD, groupIndex int) (*replayRun, error) {
+	if hash == nil {
+		return nil, fmt.Errorf("trace without program hash")
+	}
+	var digest crypto.Digest
+	if len(*hash) != len(digest) {
+		return nil, fmt.Errorf("invalid program hash %s", base64.StdEncoding.EncodeToString(*hash))
+	}
+	copy(digest[:], *hash)
+	program, ok := r.programs[digest]
+	if !ok {
+		log.Printf("Skipping the trace of unknown program %s: specify the program on the command line", digest)
+		return nil, nil
+	}
+	return &replayRun{
+		program:    &program,
+		trace:      trace,
+		txnGroup:   txnGroup,
+		groupIndex: groupIndex,
+	}, nil
+}
+
+// replayApp replays a program, as well as the inner transactions spawned by an app. It returns
+// the number of inner programs replayed.
+func (r *ReplayRunner) replayApp(run *replayRun, path []int, failedAt []int, failure string) (int, error) {
+	prog := run.program
+	states := makeAppState()
+	states.appIdx = run.appIdx
+	for app, tkv := range r.global {
+		states.global[app] = tkv.Clone()
+	}
+	for addr, local := range r.locals {
+		states.locals[addr] = make(map[basics.AppIndex]basics.TealKeyValue, len(local))
+		for app, tkv := range local {
+			states.locals[addr][app] = tkv.Clone()
+		}
+	}
+	r.debugger.SaveProgram(prog.name, prog.program, prog.source, prog.offsetToSource, states)
+
+	state := logic.MakeDebugState(prog.program, run.txnGroup, run.groupIndex, &r.proto)
+	lines := strings.Split(state.Disassembly, "\n")
+	state.Stack = []basics.TealValue{}
+	state.Scratch = make([]basics.TealValue, 256)
+	for i := range state.Scratch {
+		state.Scratch[i] = basics.TealValue{Type: basics.TealUintType}
+	}
+	state.CallStack = []logic.CallFrame{}
+	if len(run.trace) > 0 {
+		state.PC = run.trace[0].Pc
+		state.Line = state.PCToLine(state.PC)
+	}
+	state.Boxes = r.debugBoxes()
+	isApp := run.appIdx != 0
+	delta := replayDelta{txn: &run.txnGroup[run.groupIndex].Txn}
+	r.debugger.Register(state)
+
+	replayed := 0
+	var err error
+	for _, unit := range run.trace {
+		state.PC = unit.Pc
+		state.Line = state.PCToLine(unit.Pc)
+		if isApp {
+			state.EvalDelta = delta.evalDelta()
+		}
+		r.debugger.Update(state)
+
+		// the effects of the instruction, which may spawn inner transactions
+		if unit.SpawnedInners != nil && err == nil {
+			var n int
+			n, err = r.replayInners(run, *unit.SpawnedInners, path, failedAt, failure)
+			replayed += n
+		}
+
+		stack := slices.Clone(state.Stack)
+		if unit.StackPopCount != nil {
+			stack = stack[:max(0, len(stack)-*unit.StackPopCount)]
+		}
+		if unit.StackAdditions != nil {
+			for _, value := range *unit.StackAdditions {
+				stack = append(stack, avmToEncodedTealValue(value))
+			}
+		}
+		state.Stack = stack
+		if unit.ScratchChanges != nil {
+			state.Scratch = slices.Clone(state.Scratch)
+			for _, change := range *unit.ScratchChanges {
+				if change.Slot >= 0 && change.Slot < len(state.Scratch) {
+					state.Scratch[change.Slot] = avmToEncodedTealValue(change.NewValue)
+				}
+			}
+		}
+		if unit.StateChanges != nil {
+			for _, change := range *unit.StateChanges {
+				r.applyStateChange(run.appIdx, &delta, change)
+			}
+			state.Boxes = r.debugBoxes()
+		}
+
+		line := ""
+		if state.Line < len(lines) {
+			line = lines[state.Line]
+		}
+		switch op := strings.Fields(line); {
+		case len(op) > 0 && op[0] == "callsub":
+			state.CallStack = append(slices.Clone(state.CallStack), state.CallFrameAt(unit.Pc))
+		case len(op) > 0 && op[0] == "retsub" && len(state.CallStack) > 0:
+			state.CallStack = state.CallStack[:len(state.CallStack)-1]
+		}
+	}
+	if isApp {
+		state.EvalDelta = delta.evalDelta()
+	}
+	state.Error = run.err
+	r.debugger.Complete(state)
+	return replayed, err
+}
+
+// replayInners replays the inner transactions spawned by an instruction
+func (r *ReplayRunner) replayInners(run *replayRun, spawned []int, path []int, failedAt []int, failure string) (int, error) {
+	innerGroup := make([]transactions.SignedTxnWithAD, 0, len(spawned))
+	for _, i := range spawned {
+		if i < 0 || i >= len(run.inners) {
+			return 0, fmt.Errorf("invalid inner transaction index %d", i)
+		}
+		innerGroup = append(innerGroup, transactions.SignedTxnWithAD{SignedTxn: run.inners[i].Txn})
+	}
+	replayed := 0
+	for gi, i := range spawned {
+		if i >= len(run.innerTraces) {
+			continue
+		}
+		n, err := r.replayTxn(&run.inners[i], &run.innerTraces[i], innerGroup, gi, append(slices.Clone(path), i), failedAt, failure)
+		replayed += n
+		if err != nil {
+			return replayed, err
+		}
+	}
+	return replayed, nil
+}
+
+// applyStateChange updates the app state after a state change of the app
+func (r *ReplayRunner) applyStateChange(appIdx basics.AppIndex, delta *replayDelta, change model.ApplicationStateOperation) {
+	key := string(change.Key)
+	var value basics.TealValue
+	if change.NewValue != nil {
+		value = avmToTealValue(*change.NewValue)
+	}
+	deleted := change.Operation == "d"
+
+	switch change.AppStateType {
+	case "g":
+		tkv := r.global[appIdx]
+		if tkv == nil {
+			tkv = make(basics.TealKeyValue)
+			r.global[appIdx] = tkv
+		}
+		delta.global = setStateDelta(delta.global, key, value, deleted)
+		if deleted {
+			delete(tkv, key)
+		} else {
+			tkv[key] = value
+		}
+	case "l":
+		if change.Account == nil {
+			return
+		}
+		addr, err := basics.UnmarshalChecksumAddress(*change.Account)
+		if err != nil {
+			return
+		}
+		local := r.locals[addr]
+		if local == nil {
+			local = make(map[basics.AppIndex]basics.TealKeyValue)
+			r.locals[addr] = local
+		}
+		tkv := local[appIdx]
+		if tkv == nil {
+			tkv = make(basics.TealKeyValue)
+			local[appIdx] = tkv
+		}
+		delta.setLocal(addr, key, value, deleted)
+		if deleted {
+			delete(tkv, key)
+		} else {
+			tkv[key] = value
+		}
+	case "b":
+		boxKey := replayBoxKey{appIdx, key}
+		if deleted {
+			delete(r.boxes, boxKey)
+		} else if value.Type == basics.TealBytesType {
+			r.boxes[boxKey] = []byte(value.Bytes)
+		}
+	}
+}
+
+func (r *ReplayRunner) debugBoxes() []logic.DebugBox {
+	boxes := make([]logic.DebugBox, 0, len(r.boxes))
+	for key, value := range r.boxes {
+		boxes = append(boxes, logic.DebugBox{App: key.app, Name: []byte(key.name), Value: slices.Clone(value)})
+	}
+	slices.SortFunc(boxes, func(a, b logic.DebugBox) int {
+		if c := cmp.Compare(a.App, b.App); c != 0 {
+			return c
+		}
+		return bytes.Compare(a.Name, b.Name)
+	})
+	return boxes
+}
+
+// replayDelta accumulates the state changes of an app program, as an EvalDelta
+type replayDelta struct {
+	txn    *transactions.Transaction
+	global basics.StateDelta
+	local  map[uint64]basics.StateDelta
+	shared []basics.Address
+}
+
+func (d *replayDelta) setLocal(addr basics.Address, key string, value basics.TealValue, deleted bool) {
+	// local deltas are indexed by the sender, then the foreign accounts, then shared accounts
+	idx := -1
+	if addr == d.txn.Sender {
+		idx = 0
+	} else if i := slices.Index(d.txn.Accounts, addr); i >= 0 {
+		idx = i + 1
+	} else if i := slices.Index(d.shared, addr); i >= 0 {
+		idx = 1 + len(d.txn.Accounts) + i
+	} else {
+		d.shared = append(d.shared, addr)
+		idx = len(d.txn.Accounts) + len(d.shared)
+	}
+	if d.local == nil {
+		d.local = make(map[uint64]basics.StateDelta)
+	}
+	d.local[uint64(idx)] = setStateDelta(d.local[uint64(idx)], key, value, deleted)
+}
+
+// evalDelta returns a copy of the accumulated changes, since debug states are retained by frontends
+func (d *replayDelta) evalDelta() transactions.EvalDelta {
+	var ed transactions.EvalDelta
+	if len(d.global) > 0 {
+		ed.GlobalDelta = make(basics.StateDelta, len(d.global))
+		for key, vd := range d.global {
+			ed.GlobalDelta[key] = vd
+		}
+	}
+	if len(d.local) > 0 {
+		ed.LocalDeltas = make(map[uint64]basics.StateDelta, len(d.local))
+		for idx, sd := range d.local {
+			ed.LocalDeltas[idx] = make(basics.StateDelta, len(sd))
+			for key, vd := range sd {
+				ed.LocalDeltas[idx][key] = vd
+			}
+		}
+	}
+	ed.SharedAccts = slices.Clone(d.shared)
+	return ed
+}
+
+func setStateDelta(sd basics.StateDelta, key string, value basics.TealValue, deleted bool) basics.StateDelta {
+	if sd == nil {
+		sd = make(basics.StateDelta)
+	}
+	if deleted {
+		sd[key] = basics.ValueDelta{Action: basics.DeleteAction}
+	} else {
+		sd[key] = value.ToValueDelta()
+	}
+	return sd
+}
+
+func kvsToTealKeyValue(kvs []model.AvmKeyValue) basics.TealKeyValue {
+	tkv := make(basics.TealKeyValue, len(kvs))
+	for _, kv := range kvs {
+		tkv[string(kv.Key)] = avmToTealValue(kv.Value)
+	}
+	return tkv
+}
+
+// avmToTealValue converts a value of a simulate response to a TealValue with raw bytes
+func avmToTealValue(value model.AvmValue) basics.TealValue {
+	if value.Type == uint64(basics.TealUintType) {
+		tv := basics.TealValue{Type: basics.TealUintType}
+		if value.Uint != nil {
+			tv.Uint = *value.Uint
+		}
+		return tv
+	}
+	tv := basics.TealValue{Type: basics.TealBytesType}
+	if value.Bytes != nil {
+		tv.Bytes = string(*value.Bytes)
+	}
+	return tv
+}
+
+// avmToEncodedTealValue converts a value of a simulate response to a TealValue with base64
+// encoded bytes, as in DebugState
+func avmToEncodedTealValue(value model.AvmValue) basics.TealValue {
+	tv := avmToTealValue(value)
+	if tv.Type == basics.TealBytesType {
+		tv.Bytes = base64.StdEncoding.EncodeToString([]byte(tv.Bytes))
+	}
+	return tv
+}
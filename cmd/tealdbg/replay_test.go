@@ -0,0 +1,201 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	v2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// recordingDbgAdapter steps through executions, and records their states
+type recordingDbgAdapter struct {
+	debugger  Control
+	states    []logic.DebugState
+	completed logic.DebugState
+	appStates []AppState
+	done      chan struct{}
+}
+
+func (d *recordingDbgAdapter) SessionStarted(_ string, debugger Control, ch chan Notification) {
+	d.debugger = debugger
+	go func() {
+		for n := range ch {
+			switch n.Event {
+			case "completed":
+				d.completed = n.DebugState
+				d.appStates = append(d.appStates, debugger.GetStates(&n.DebugState))
+				d.done <- struct{}{}
+				return
+			case "updated":
+				d.states = append(d.states, n.DebugState)
+			}
+			debugger.Step()
+		}
+	}()
+}
+
+func (d *recordingDbgAdapter) SessionEnded(_ string) {}
+
+func (d *recordingDbgAdapter) WaitForCompletion() {}
+
+func (d *recordingDbgAdapter) URL() string {
+	return ""
+}
+
+func TestReplayRunner(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	source := `#pragma version 8
+int 7
+store 1
+callsub sub
+byte "k"
+int 7
+app_global_put
+int 1
+return
+sub:
+retsub`
+	ops, err := logic.AssembleString(source)
+	require.NoError(t, err)
+	linePC := make(map[int]int)
+	for pc, loc := range ops.OffsetToSource {
+		linePC[loc.Line+1] = pc
+	}
+
+	u := func(v uint64) model.AvmValue { return model.AvmValue{Type: uint64(basics.TealUintType), Uint: &v} }
+	b := func(v string) model.AvmValue {
+		bytes := []byte(v)
+		return model.AvmValue{Type: uint64(basics.TealBytesType), Bytes: &bytes}
+	}
+	one, two := 1, 2
+	seven := u(7)
+	trace := []model.SimulationOpcodeTraceUnit{
+		{Pc: linePC[2], StackAdditions: &[]model.AvmValue{u(7)}},
+		{Pc: linePC[3], StackPopCount: &one, ScratchChanges: &[]model.ScratchChange{{Slot: 1, NewValue: u(7)}}},
+		{Pc: linePC[4]},
+		{Pc: linePC[11]},
+		{Pc: linePC[5], StackAdditions: &[]model.AvmValue{b("k")}},
+		{Pc: linePC[6], StackAdditions: &[]model.AvmValue{u(7)}},
+		{Pc: linePC[7], StackPopCount: &two, StateChanges: &[]model.ApplicationStateOperation{
+			{AppStateType: "g", Key: []byte("k"), NewValue: &seven, Operation: "w"},
+		}},
+		{Pc: linePC[8], StackAdditions: &[]model.AvmValue{u(1)}},
+		{Pc: linePC[9], StackPopCount: &one},
+	}
+	hash := crypto.Hash(ops.Program)
+	hashBytes := hash[:]
+
+	var txn transactions.SignedTxn
+	txn.Txn.Type = protocol.ApplicationCallTx
+	txn.Txn.ApplicationID = 5
+	failedAt := []int{0}
+	failure := "transaction rejected by ApprovalProgram"
+	response := v2.PreEncodedSimulateResponse{
+		Version: 2,
+		TxnGroups: []v2.PreEncodedSimulateTxnGroupResult{{
+			FailedAt:       &failedAt,
+			FailureMessage: &failure,
+			Txns: []v2.PreEncodedSimulateTxnResult{{
+				Txn: v2.PreEncodedTxInfo{Txn: txn},
+				TransactionTrace: &model.SimulationTransactionExecTrace{
+					ApprovalProgramHash:  &hashBytes,
+					ApprovalProgramTrace: &trace,
+				},
+			}},
+		}},
+		InitialStates: &model.SimulateInitialStates{AppInitialStates: &[]model.ApplicationInitialStates{{
+			Id:         5,
+			AppGlobals: &model.ApplicationKVStorage{Kvs: []model.AvmKeyValue{{Key: []byte("n"), Value: u(3)}}},
+			AppBoxes:   &model.ApplicationKVStorage{Kvs: []model.AvmKeyValue{{Key: []byte("box"), Value: b("data")}}},
+		}}},
+	}
+
+	debugger := MakeDebugger()
+	da := &recordingDbgAdapter{done: make(chan struct{}, 1)}
+	debugger.AddAdapter(da)
+
+	r := MakeReplayRunner(debugger)
+	err = r.Setup(&DebugParams{
+		ProgramNames: []string{"app.teal"},
+		ProgramBlobs: [][]byte{[]byte(source)},
+		TraceBlob:    protocol.EncodeJSON(&response),
+	})
+	require.NoError(t, err)
+	err = r.RunAll()
+	require.NoError(t, err)
+	<-da.done
+
+	require.Len(t, da.states, len(trace))
+	for i, state := range da.states {
+		require.Equal(t, trace[i].Pc, state.PC)
+	}
+	enc := func(v string) basics.TealValue {
+		return basics.TealValue{Type: basics.TealBytesType, Bytes: base64.StdEncoding.EncodeToString([]byte(v))}
+	}
+	// before store 1
+	require.Equal(t, []basics.TealValue{{Type: basics.TealUintType, Uint: 7}}, da.states[1].Stack)
+	require.Equal(t, basics.TealValue{Type: basics.TealUintType}, da.states[1].Scratch[1])
+	// in the subroutine
+	require.Empty(t, da.states[2].CallStack)
+	require.Equal(t, []logic.CallFrame{{FrameLine: da.states[2].Line, LabelName: "label1"}}, da.states[3].CallStack)
+	require.Empty(t, da.states[4].CallStack)
+	require.Equal(t, basics.TealValue{Type: basics.TealUintType, Uint: 7}, da.states[4].Scratch[1])
+	// before app_global_put
+	require.Equal(t, []basics.TealValue{enc("k"), {Type: basics.TealUintType, Uint: 7}}, da.states[6].Stack)
+	require.Empty(t, da.states[6].GlobalDelta)
+	require.Equal(t, []logic.DebugBox{{App: 5, Name: []byte("box"), Value: []byte("data")}}, da.states[6].Boxes)
+
+	require.Equal(t, failure, da.completed.Error)
+	require.Equal(t, basics.StateDelta{"k": {Action: basics.SetUintAction, Uint: 7}}, da.completed.GlobalDelta)
+	require.Len(t, da.appStates, 1)
+	require.Equal(t, basics.AppIndex(5), da.appStates[0].appIdx)
+	require.Equal(t, basics.TealKeyValue{
+		"n": {Type: basics.TealUintType, Uint: 3},
+		"k": {Type: basics.TealUintType, Uint: 7},
+	}, da.appStates[0].global[5])
+}
+
+func TestReplayRunnerErrors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	r := MakeReplayRunner(MakeDebugger())
+	err := r.Setup(&DebugParams{TraceBlob: []byte("{")})
+	require.ErrorContains(t, err, "invalid simulate response")
+
+	// a trace without traced programs
+	response := v2.PreEncodedSimulateResponse{
+		Version:   2,
+		TxnGroups: []v2.PreEncodedSimulateTxnGroupResult{{Txns: []v2.PreEncodedSimulateTxnResult{{}}}},
+	}
+	r = MakeReplayRunner(MakeDebugger())
+	require.NoError(t, r.Setup(&DebugParams{TraceBlob: protocol.EncodeJSON(&response)}))
+	require.ErrorContains(t, r.RunAll(), "no program traces")
+}
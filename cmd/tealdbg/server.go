@@ -97,6 +97,13 @@ type DebugParams struct {
 	AppID            basics.AppIndex
 	Painless         bool
 	ListenForDrReq   bool
+	TraceBlob        []byte
+}
+
+// debugRunner runs or replays programs in the debugger
+type debugRunner interface {
+	Setup(dp *DebugParams) error
+	RunAll() error
 }
 
 // FrontendFactory interface for attaching debug frontends
@@ -148,7 +155,10 @@ func (ds *DebugServer) startRemote() error {
 // So that for ListenForDrReq case a new endpoint is created and incoming data is await first.
 // Then execution is set up and program(s) run with stage-by-stage sync with ListenForDrReq's handler.
 func (ds *DebugServer) startDebug() (err error) {
-	local := MakeLocalRunner(ds.debugger)
+	var local debugRunner = MakeLocalRunner(ds.debugger)
+	if len(ds.params.TraceBlob) != 0 {
+		local = MakeReplayRunner(ds.debugger)
+	}
 
 	if ds.params.ListenForDrReq {
 		path := "/spinoff"
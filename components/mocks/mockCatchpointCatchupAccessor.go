@@ -50,6 +50,21 @@ func (m *MockCatchpointCatchupAccessor) SetLabel(ctx context.Context, label stri
 	return nil
 }
 
+// GetCatchpointFile returns the local catchpoint file the catchup loads the ledger from, if any
+func (m *MockCatchpointCatchupAccessor) GetCatchpointFile(ctx context.Context) (path string, err error) {
+	return "", nil
+}
+
+// SetCatchpointFile set the local catchpoint file the catchup loads the ledger from
+func (m *MockCatchpointCatchupAccessor) SetCatchpointFile(ctx context.Context, path string) (err error) {
+	return nil
+}
+
+// GetKeptCatchpointFile returns the path of the catchpoint file kept from the last catchup that downloaded one
+func (m *MockCatchpointCatchupAccessor) GetKeptCatchpointFile() (path string) {
+	return ""
+}
+
 // ResetStagingBalances resets the current staging balances, preparing for a new set of balances to be added
 func (m *MockCatchpointCatchupAccessor) ResetStagingBalances(ctx context.Context, newCatchup bool) (err error) {
 	return nil
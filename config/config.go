@@ -75,6 +75,10 @@ const StateProofFileName = "stateproof.sqlite"
 // It is used for tracking participation key metadata.
 const ParticipationRegistryFilename = "partregistry.sqlite"
 
+// CatchpointImportDirName is the name of the directory, in the genesis directory, that the catchup API loads catchpoint
+// files from when CatchpointImportDir is not set.
+const CatchpointImportDirName = "catchpoint-import"
+
 // ConfigurableConsensusProtocolsFilename defines a set of consensus protocols that
 // are to be loaded from the data directory ( if present ), to override the
 // built-in supported consensus protocols.
@@ -43,7 +43,7 @@ type Local struct {
 	// Version tracks the current version of the defaults so we can migrate old -> new
 	// This is specifically important whenever we decide to change the default value
 	// for an existing parameter. This field tag must be updated any time we add a new version.
-	Version uint32 `version[0]:"0" version[1]:"1" version[2]:"2" version[3]:"3" version[4]:"4" version[5]:"5" version[6]:"6" version[7]:"7" version[8]:"8" version[9]:"9" version[10]:"10" version[11]:"11" version[12]:"12" version[13]:"13" version[14]:"14" version[15]:"15" version[16]:"16" version[17]:"17" version[18]:"18" version[19]:"19" version[20]:"20" version[21]:"21" version[22]:"22" version[23]:"23" version[24]:"24" version[25]:"25" version[26]:"26" version[27]:"27" version[28]:"28" version[29]:"29" version[30]:"30" version[31]:"31" version[32]:"32" version[33]:"33" version[34]:"34" version[35]:"35" version[36]:"36"`
+	Version uint32 `version[0]:"0" version[1]:"1" version[2]:"2" version[3]:"3" version[4]:"4" version[5]:"5" version[6]:"6" version[7]:"7" version[8]:"8" version[9]:"9" version[10]:"10" version[11]:"11" version[12]:"12" version[13]:"13" version[14]:"14" version[15]:"15" version[16]:"16" version[17]:"17" version[18]:"18" version[19]:"19" version[20]:"20" version[21]:"21" version[22]:"22" version[23]:"23" version[24]:"24" version[25]:"25" version[26]:"26" version[27]:"27" version[28]:"28" version[29]:"29" version[30]:"30" version[31]:"31" version[32]:"32" version[33]:"33" version[34]:"34" version[35]:"35" version[36]:"36" version[37]:"37"`
 
 	// Archival nodes retain a full copy of the block history. Non-Archival nodes will delete old blocks and only retain what's need to properly validate blockchain messages (the precise number of recent blocks depends on the consensus parameters. Currently the last 1321 blocks are required). This means that non-Archival nodes require significantly less storage than Archival nodes.  If setting this to true for the first time, the existing ledger may need to be deleted to get the historical values stored as the setting only affects current blocks forward. To do this, shutdown the node and delete all .sqlite files within the data/testnet-version directory, except the crash.sqlite file. Restart the node and wait for the node to sync.
 	Archival bool `version[0]:"false"`
@@ -112,6 +112,10 @@ type Local struct {
 	// For isolation, the node will create a subdirectory in this location, named by the genesis-id of the network.
 	// If not specified, the node will use the ColdDataDir.
 	CatchpointDir string `version[31]:""`
+	// CatchpointImportDir is an optional directory holding the catchpoint files the catchup API may load the ledger from.
+	// Catchpoint files outside of this directory are rejected.
+	// If not specified, the node will use the catchpoint-import subdirectory of the genesis directory in the runtime supplied datadir.
+	CatchpointImportDir string `version[37]:""`
 	// StateproofDir is an optional directory to persist state about observed and issued state proof messages.
 	// For isolation, the node will create a subdirectory in this location, named by the genesis-id of the network.
 	// If not specified, the node will use the HotDataDir.
@@ -400,6 +404,11 @@ type Local struct {
 	// 0 means don't store any, -1 mean unlimited and positive number suggest the maximum number of most recent catchpoint files to store.
 	CatchpointFileHistoryLength int `version[7]:"365"`
 
+	// EnableCatchpointDeltaFiles controls whether a node that stores catchpoint files also stores, next to each of them, a delta file
+	// describing the account, resource and KV changes since the previous catchpoint. Nodes serving the ledger share these delta files
+	// with nodes catching up from an older catchpoint.
+	EnableCatchpointDeltaFiles bool `version[37]:"false"`
+
 	// EnableGossipService enables the gossip network HTTP websockets endpoint. The functionality of this depends on NetAddress, which must also be provided.
 	// This functionality is required for serving gossip traffic.
 	EnableGossipService bool `version[33]:"true"`
@@ -422,9 +431,21 @@ type Local struct {
 	// CatchupGossipBlockFetchTimeoutSec controls how long the gossip query for fetching a block from a relay would take before giving up and trying another relay.
 	CatchupGossipBlockFetchTimeoutSec int `version[9]:"4"`
 
+	// CatchupLedgerDownloadParallelism controls how many chunks of a catchpoint file are downloaded concurrently, possibly from different peers,
+	// when the serving peers publish a chunk manifest for the catchpoint file. Setting it to 0 disables chunked downloads.
+	// Failed chunked downloads resume from the last verified chunk. The downloaded chunks are tracked in memory only, and
+	// the download starts over from the beginning when algod restarts.
+	CatchupLedgerDownloadParallelism int `version[37]:"4"`
+
 	// CatchupLedgerDownloadRetryAttempts controls the number of attempt the ledger fetching would be attempted before giving up catching up to the provided catchpoint.
 	CatchupLedgerDownloadRetryAttempts int `version[9]:"50"`
 
+	// CatchupMaxCatchpointDeltas is the longest chain of catchpoint delta files the catchpoint catchup would download and apply on top of
+	// the catchpoint file kept from this node's last catchpoint catchup, instead of downloading the full catchpoint file. Setting it to 0 disables delta catchup.
+	// When enabled, a catchup that downloads a full catchpoint file keeps a copy of it in the catchpoint directory once the catchpoint was verified,
+	// which takes as much disk space as the catchpoint file. Each applied delta file is verified by rebuilding the merkle trie of the staged balances.
+	CatchupMaxCatchpointDeltas int `version[37]:"0"`
+
 	// CatchupBlockDownloadRetryAttempts controls the number of attempts the block fetcher would make before giving up on a provided catchpoint.
 	CatchupBlockDownloadRetryAttempts int `version[9]:"1000"`
 
@@ -562,6 +583,15 @@ type Local struct {
 	// i.e. the ledger can answer account states questions for the range Latest-MaxAcctLookback...Latest
 	MaxAcctLookback uint64 `version[23]:"4"`
 
+	// StateHistoryRounds sets how many rounds beyond MaxAcctLookback the ledger keeps the previous
+	// values of modified accounts, resources and boxes, in a database next to the tracker database.
+	// This allows account state (and simulation) at those older rounds to be reconstructed, as long as
+	// their blocks are still stored. The history is kept across restarts, but it only covers the rounds
+	// the node has processed since it started keeping it: older rounds cannot be rebuilt from blocks.
+	// 0 means automatic: archival nodes keep about a week of rounds, other nodes none.
+	// -1 disables it, and a positive number sets the number of rounds to keep.
+	StateHistoryRounds int64 `version[37]:"0"`
+
 	// BlockHistoryLookback sets the max lookback range for block information.
 	// i.e. the block DB can return transaction IDs for questions for the range Latest-MaxBlockHistoryLookback...Latest
 	MaxBlockHistoryLookback uint64 `version[31]:"0"`
@@ -866,6 +896,14 @@ func (cfg *Local) ResolveLogPaths(rootDir string) (liveLog, archive string) {
 	return liveLog, archive
 }
 
+// ResolveCatchpointImportDir returns the directory the catchup API loads catchpoint files from, given the genesis directory
+func (cfg *Local) ResolveCatchpointImportDir(rootGenesisDir string) string {
+	if cfg.CatchpointImportDir != "" {
+		return cfg.CatchpointImportDir
+	}
+	return filepath.Join(rootGenesisDir, CatchpointImportDirName)
+}
+
 type logger interface {
 	Infof(format string, args ...interface{})
 }
@@ -20,7 +20,7 @@
 package config
 
 var defaultLocal = Local{
-	Version:                                    36,
+	Version:                                    37,
 	AccountUpdatesStatsInterval:                5000000000,
 	AccountsRebuildSynchronousMode:             1,
 	AgreementIncomingBundlesQueueLength:        15,
@@ -37,6 +37,7 @@ var defaultLocal = Local{
 	CadaverSizeTarget:                          0,
 	CatchpointDir:                              "",
 	CatchpointFileHistoryLength:                365,
+	CatchpointImportDir:                        "",
 	CatchpointInterval:                         10000,
 	CatchpointTracking:                         0,
 	CatchupBlockDownloadRetryAttempts:          1000,
@@ -44,7 +45,9 @@ var defaultLocal = Local{
 	CatchupFailurePeerRefreshRate:              10,
 	CatchupGossipBlockFetchTimeoutSec:          4,
 	CatchupHTTPBlockFetchTimeoutSec:            4,
+	CatchupLedgerDownloadParallelism:           4,
 	CatchupLedgerDownloadRetryAttempts:         50,
+	CatchupMaxCatchpointDeltas:                 0,
 	CatchupParallelBlocks:                      16,
 	ColdDataDir:                                "",
 	ConnectionsRateLimitingCount:               60,
@@ -64,6 +67,7 @@ var defaultLocal = Local{
 	EnableAgreementTimeMetrics:                 false,
 	EnableAssembleStats:                        false,
 	EnableBlockService:                         false,
+	EnableCatchpointDeltaFiles:                 false,
 	EnableDHTProviders:                         false,
 	EnableDeveloperAPI:                         false,
 	EnableExperimentalAPI:                      false,
@@ -139,6 +143,7 @@ var defaultLocal = Local{
 	RestReadTimeoutSeconds:                     15,
 	RestWriteTimeoutSeconds:                    120,
 	RunHosted:                                  false,
+	StateHistoryRounds:                         0,
 	StateproofDir:                              "",
 	StorageEngine:                              "sqlite",
 	SuggestedFeeBlockHistory:                   3,
@@ -0,0 +1,295 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package crypto
+
+// #cgo CFLAGS: -Wall -std=c99
+// #cgo darwin,amd64 CFLAGS: -I${SRCDIR}/libs/darwin/amd64/include
+// #cgo darwin,arm64 CFLAGS: -I${SRCDIR}/libs/darwin/arm64/include
+// #cgo linux,amd64 CFLAGS: -I${SRCDIR}/libs/linux/amd64/include
+// #cgo linux,arm64 CFLAGS: -I${SRCDIR}/libs/linux/arm64/include
+// #cgo linux,arm CFLAGS: -I${SRCDIR}/libs/linux/arm/include
+// #cgo linux,riscv64 CFLAGS: -I${SRCDIR}/libs/linux/riscv64/include
+// #cgo windows,amd64 CFLAGS: -I${SRCDIR}/libs/windows/amd64/include
+// #include "sodium.h"
+import "C"
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+// BIP32-Ed25519 hierarchical deterministic keys, as specified by ARC-0052:
+// keys are derived from a BIP39 seed along a path of indexes, so that the
+// same accounts may be recovered by any wallet implementing it, such as
+// hardware wallets. Derivation uses the Peikert amendment (g = 9) to the
+// original scheme of Khovratovich and Law.
+
+//msgp:ignore BIP32Ed25519Key ExtendedSecretKey ExtendedSignatureSecrets
+
+// BIP32HardenedOffset is added to an index to make it hardened
+const BIP32HardenedOffset = 1 << 31
+
+// bip32DerivationBits is the g of the Peikert amendment: the number of top
+// bits of zL dropped when deriving a child, which keeps kL below 2^255 for
+// any practical depth
+const bip32DerivationBits = 9
+
+// BIP32Ed25519Key is an extended private key: the scalar kL, the key kR
+// from which signature nonces are derived, and the chain code used to
+// derive children.
+type BIP32Ed25519Key struct {
+	kL        [32]byte
+	kR        [32]byte
+	chainCode [32]byte
+}
+
+// BIP32Ed25519KeyFromSeed computes the root key of a BIP39 seed
+func BIP32Ed25519KeyFromSeed(seed []byte) *BIP32Ed25519Key {
+	var k BIP32Ed25519Key
+	h := sha512.Sum512(seed)
+	// Rehash until the third highest bit of kL is clear
+	for h[31]&0x20 != 0 {
+		mac := hmac.New(sha512.New, h[:32])
+		mac.Write(h[32:])
+		copy(h[:], mac.Sum(nil))
+	}
+	copy(k.kL[:], h[:32])
+	copy(k.kR[:], h[32:])
+	k.kL[0] &= 0xf8
+	k.kL[31] &= 0x7f
+	k.kL[31] |= 0x40
+
+	k.chainCode = sha256.Sum256(append([]byte{0x01}, seed...))
+	return &k
+}
+
+// scalarBaseMult returns s times the base point, without clamping s
+func scalarBaseMult(s *[32]byte) (p PublicKey) {
+	C.crypto_scalarmult_ed25519_base_noclamp((*C.uchar)(&p[0]), (*C.uchar)(&s[0]))
+	return
+}
+
+// add256 returns a+b mod 2^256, of little-endian integers
+func add256(a, b *[32]byte) (res [32]byte) {
+	var carry uint64
+	for i := 0; i < 32; i += 8 {
+		var sum uint64
+		sum, carry = bits.Add64(binary.LittleEndian.Uint64(a[i:]), binary.LittleEndian.Uint64(b[i:]), carry)
+		binary.LittleEndian.PutUint64(res[i:], sum)
+	}
+	return
+}
+
+// mul256 returns the 512-bit product of little-endian integers
+func mul256(a, b *[32]byte) (res [64]byte) {
+	var x, y [4]uint64
+	for i := range x {
+		x[i] = binary.LittleEndian.Uint64(a[8*i:])
+		y[i] = binary.LittleEndian.Uint64(b[8*i:])
+	}
+	var z [8]uint64
+	for i := range x {
+		var carry uint64
+		for j := range y {
+			hi, lo := bits.Mul64(x[i], y[j])
+			var c uint64
+			lo, c = bits.Add64(lo, z[i+j], 0)
+			hi += c
+			lo, c = bits.Add64(lo, carry, 0)
+			hi += c
+			z[i+j] = lo
+			carry = hi
+		}
+		z[i+4] = carry
+	}
+	for i := range z {
+		binary.LittleEndian.PutUint64(res[8*i:], z[i])
+	}
+	return
+}
+
+// Child derives the child key with index. Indexes of BIP32HardenedOffset or
+// more are hardened: their public keys cannot be derived from the parent's.
+func (k *BIP32Ed25519Key) Child(index uint32) *BIP32Ed25519Key {
+	var data []byte
+	var zTag, ccTag byte
+	if index >= BIP32HardenedOffset {
+		data = append(append([]byte{0}, k.kL[:]...), k.kR[:]...)
+		zTag, ccTag = 0x00, 0x01
+	} else {
+		pk := scalarBaseMult(&k.kL)
+		data = append([]byte{0}, pk[:]...)
+		zTag, ccTag = 0x02, 0x03
+	}
+	data = binary.LittleEndian.AppendUint32(data, index)
+
+	data[0] = zTag
+	mac := hmac.New(sha512.New, k.chainCode[:])
+	mac.Write(data)
+	z := mac.Sum(nil)
+	data[0] = ccTag
+	mac = hmac.New(sha512.New, k.chainCode[:])
+	mac.Write(data)
+	cc := mac.Sum(nil)
+
+	// kL' = kL + 8 * trunc(zL), dropping the top g bits of zL
+	var zL, zR [32]byte
+	copy(zL[:], z[:32])
+	copy(zR[:], z[32:])
+	zL[31] = 0
+	zL[30] &= 0xff >> (bip32DerivationBits - 8)
+	var carry byte
+	for i := range zL {
+		next := zL[i] >> 5
+		zL[i] = zL[i]<<3 | carry
+		carry = next
+	}
+
+	child := &BIP32Ed25519Key{
+		kL: add256(&k.kL, &zL),
+		kR: add256(&k.kR, &zR),
+	}
+	copy(child.chainCode[:], cc[32:])
+	return child
+}
+
+// DerivePath derives the key at path, relative to k
+func (k *BIP32Ed25519Key) DerivePath(path []uint32) *BIP32Ed25519Key {
+	for _, index := range path {
+		k = k.Child(index)
+	}
+	return k
+}
+
+// PublicKey returns the public key of k
+func (k *BIP32Ed25519Key) PublicKey() PublicKey {
+	return scalarBaseMult(&k.kL)
+}
+
+// SecretKey returns the part of k needed to sign messages
+func (k *BIP32Ed25519Key) SecretKey() (sk ExtendedSecretKey) {
+	copy(sk[:32], k.kL[:])
+	copy(sk[32:], k.kR[:])
+	return
+}
+
+// ExtendedSecretKey is the secret part of a BIP32-Ed25519 key, kL followed
+// by kR. Unlike a PrivateKey, it is not derived from a seed.
+type ExtendedSecretKey [64]byte
+
+// ExtendedSignatureSecrets are used to sign messages with the key of a
+// BIP32-Ed25519 derivation path. The signatures are ordinary ed25519
+// signatures, verified with SignatureVerifier.
+type ExtendedSignatureSecrets struct {
+	SignatureVerifier
+	SK ExtendedSecretKey
+}
+
+// ExtendedSecretKeyToSignatureSecrets computes the public key of sk
+func ExtendedSecretKeyToSignatureSecrets(sk ExtendedSecretKey) *ExtendedSignatureSecrets {
+	var kL [32]byte
+	copy(kL[:], sk[:32])
+	return &ExtendedSignatureSecrets{
+		SignatureVerifier: scalarBaseMult(&kL),
+		SK:                sk,
+	}
+}
+
+// Sign produces a cryptographic Signature of a Hashable message, like
+// SignatureSecrets.Sign.
+func (s *ExtendedSignatureSecrets) Sign(message Hashable) Signature {
+	return s.SignBytes(HashRep(message))
+}
+
+// SignBytes signs a message directly, without first hashing.
+// Caller is responsible for domain separation.
+func (s *ExtendedSignatureSecrets) SignBytes(message []byte) (sig Signature) {
+	var kL [32]byte
+	copy(kL[:], s.SK[:32])
+
+	// r = H(kR || message) mod l
+	h := sha512.New()
+	h.Write(s.SK[32:])
+	h.Write(message)
+	var r [32]byte
+	C.crypto_core_ed25519_scalar_reduce((*C.uchar)(&r[0]), (*C.uchar)(&h.Sum(nil)[0]))
+	R := scalarBaseMult(&r)
+
+	// k = H(R || A || message) mod l
+	h.Reset()
+	h.Write(R[:])
+	h.Write(s.SignatureVerifier[:])
+	h.Write(message)
+	var k [32]byte
+	C.crypto_core_ed25519_scalar_reduce((*C.uchar)(&k[0]), (*C.uchar)(&h.Sum(nil)[0]))
+
+	// S = r + k * kL mod l
+	kkL := mul256(&k, &kL)
+	var kkLReduced, S [32]byte
+	C.crypto_core_ed25519_scalar_reduce((*C.uchar)(&kkLReduced[0]), (*C.uchar)(&kkL[0]))
+	C.crypto_core_ed25519_scalar_add((*C.uchar)(&S[0]), (*C.uchar)(&r[0]), (*C.uchar)(&kkLReduced[0]))
+
+	copy(sig[:32], R[:])
+	copy(sig[32:], S[:])
+	return
+}
+
+// ParseBIP32Path parses a derivation path such as m/44'/283'/0'/0/0, where
+// ' (or h) marks a hardened index
+func ParseBIP32Path(path string) ([]uint32, error) {
+	parts := strings.Split(path, "/")
+	if parts[0] != "m" {
+		return nil, fmt.Errorf("derivation path %s does not start with m", path)
+	}
+	indexes := make([]uint32, 0, len(parts)-1)
+	for _, part := range parts[1:] {
+		hardened := strings.HasSuffix(part, "'") || strings.HasSuffix(part, "h")
+		if hardened {
+			part = part[:len(part)-1]
+		}
+		index, err := strconv.ParseUint(part, 10, 31)
+		if err != nil {
+			return nil, fmt.Errorf("derivation path %s: bad index %s", path, part)
+		}
+		if hardened {
+			index += BIP32HardenedOffset
+		}
+		indexes = append(indexes, uint32(index))
+	}
+	return indexes, nil
+}
+
+// FormatBIP32Path formats a derivation path in the form parsed by
+// ParseBIP32Path
+func FormatBIP32Path(path []uint32) string {
+	var b strings.Builder
+	b.WriteString("m")
+	for _, index := range path {
+		if index >= BIP32HardenedOffset {
+			fmt.Fprintf(&b, "/%d'", index-BIP32HardenedOffset)
+		} else {
+			fmt.Fprintf(&b, "/%d", index)
+		}
+	}
+	return b.String()
+}
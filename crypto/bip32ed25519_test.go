@@ -0,0 +1,73 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+package crypto
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestBIP32Ed25519(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// Test vectors of ARC-0052, for the BIP39 mnemonic "salon zoo engage
+	// submit smile frost later decide wing sight chaos renew lizard rely
+	// canal coral scene hobby scare step bus leaf tobacco slice"
+	seed, err := hex.DecodeString("3aff2db416b895ec3cf9a4f8d1e970bc9819920e7bf44a5e350477af0ef557b1511b0986debf78dd38c7c520cd44ff7c7231618f958e21ef0250733a8c1915ea")
+	require.NoError(t, err)
+	root := BIP32Ed25519KeyFromSeed(seed)
+	rootSK := root.SecretKey()
+	require.Equal(t, "a8ba80028922d9fcfa055c78aede55b5c575bcd8d5a53168edf45f36d9ec8f4694592b4bc892907583e22669ecdf1b0409a9f3bd5549f2dd751b51360909cd05", hex.EncodeToString(rootSK[:]))
+
+	vectors := map[string]string{
+		"m/44'/283'/0'/0/0": "7bda7ac12627b2c259f1df6875d30c10b35f55b33ad2cc8ea2736eaa3ebcfab9",
+		"m/44'/283'/0'/0/1": "5bae8828f111064637ac5061bd63bc4fcfe4a833252305f25eeab9c64ecdf519",
+		"m/44'/283'/1'/0/0": "358d8c4382992849a764438e02b1c45c2ca4e86bbcfe10fd5b963f3610012bc9",
+		"m/44'/0'/0'/0/0":   "ff8b1863ef5e40d0a48c245f26a6dbdf5da94dc75a1851f51d8a04e547bd5f5a",
+	}
+	for path, pk := range vectors {
+		indexes, err := ParseBIP32Path(path)
+		require.NoError(t, err)
+		require.Equal(t, path, FormatBIP32Path(indexes))
+		key := root.DerivePath(indexes)
+		pub := key.PublicKey()
+		require.Equal(t, pk, hex.EncodeToString(pub[:]), path)
+
+		// Signatures are ordinary ed25519 signatures
+		secrets := ExtendedSecretKeyToSignatureSecrets(key.SecretKey())
+		require.Equal(t, pub, secrets.SignatureVerifier)
+		msg := TestingHashable{[]byte(path)}
+		sig := secrets.Sign(msg)
+		require.True(t, secrets.Verify(msg, sig))
+		require.False(t, secrets.Verify(TestingHashable{[]byte("other")}, sig))
+		require.True(t, secrets.VerifyBytes(nil, secrets.SignBytes(nil)))
+	}
+
+	_, err = ParseBIP32Path("44'/283'")
+	require.Error(t, err)
+	_, err = ParseBIP32Path("m/44'/x")
+	require.Error(t, err)
+	indexes, err := ParseBIP32Path("m/44h/2147483647")
+	require.NoError(t, err)
+	require.Equal(t, []uint32{44 + BIP32HardenedOffset, 1<<31 - 1}, indexes)
+	_, err = ParseBIP32Path("m/2147483648")
+	require.Error(t, err)
+}
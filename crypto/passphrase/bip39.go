@@ -0,0 +1,109 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package passphrase
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// BIP39 mnemonics use the same words list as ours, but encode the bits of
+// the entropy most significant first, followed by a checksum of len/32 bits.
+// They are used by hardware wallets, from which keys are derived by path.
+
+const (
+	bip39MinEntropyBytes = 16
+	bip39MaxEntropyBytes = 32
+	bip39SeedIterations  = 2048
+	bip39SeedLenBytes    = 64
+)
+
+var errBIP39EntropyLen = fmt.Errorf("BIP39 entropy must be a multiple of 4 bytes between %d and %d", bip39MinEntropyBytes, bip39MaxEntropyBytes)
+var errBIP39MnemonicLen = fmt.Errorf("BIP39 mnemonic must be 12, 15, 18, 21 or 24 words")
+
+// EntropyToBIP39Mnemonic encodes entropy of 16 to 32 bytes as a BIP39
+// mnemonic of 12 to 24 words
+func EntropyToBIP39Mnemonic(entropy []byte) (string, error) {
+	if len(entropy) < bip39MinEntropyBytes || len(entropy) > bip39MaxEntropyBytes || len(entropy)%4 != 0 {
+		return "", errBIP39EntropyLen
+	}
+
+	chk := sha256.Sum256(entropy)
+	data := append(append([]byte{}, entropy...), chk[0])
+	numWords := (len(entropy)*8 + len(entropy)/4) / bitsPerWord
+	words := make([]string, numWords)
+	for i := range words {
+		words[i] = wordlist[bip39Bits(data, i*bitsPerWord)]
+	}
+	return strings.Join(words, " "), nil
+}
+
+// BIP39MnemonicToEntropy decodes a BIP39 mnemonic, checking its checksum
+func BIP39MnemonicToEntropy(mnemonic string) ([]byte, error) {
+	words := strings.Fields(mnemonic)
+	if len(words) < 12 || len(words) > 24 || len(words)%3 != 0 {
+		return nil, errBIP39MnemonicLen
+	}
+
+	numBits := len(words) * bitsPerWord
+	data := make([]byte, (numBits+7)/8)
+	for i, w := range words {
+		index := indexOf(wordlist, w)
+		if index == -1 {
+			return nil, fmt.Errorf("%s is not in the words list", w)
+		}
+		for b := 0; b < bitsPerWord; b++ {
+			if index&(1<<(bitsPerWord-1-b)) != 0 {
+				pos := i*bitsPerWord + b
+				data[pos/8] |= 0x80 >> (pos % 8)
+			}
+		}
+	}
+
+	checksumBits := numBits / 33
+	entropy := data[:(numBits-checksumBits)/8]
+	chk := sha256.Sum256(entropy)
+	if data[len(entropy)]>>(8-checksumBits) != chk[0]>>(8-checksumBits) {
+		return nil, errWrongChecksum
+	}
+	return entropy, nil
+}
+
+// BIP39Seed computes the seed of a BIP39 mnemonic, from which keys are
+// derived. The mnemonic is not checked; the password may be empty, and is
+// used as given, without Unicode normalization.
+func BIP39Seed(mnemonic string, password string) []byte {
+	sentence := strings.Join(strings.Fields(mnemonic), " ")
+	return pbkdf2.Key([]byte(sentence), []byte("mnemonic"+password), bip39SeedIterations, bip39SeedLenBytes, sha512.New)
+}
+
+// bip39Bits returns the 11 bits of data starting at bit pos, most
+// significant first
+func bip39Bits(data []byte, pos int) int {
+	var res int
+	for b := pos; b < pos+bitsPerWord; b++ {
+		res <<= 1
+		if data[b/8]&(0x80>>(b%8)) != 0 {
+			res |= 1
+		}
+	}
+	return res
+}
@@ -0,0 +1,83 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+package passphrase
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestBIP39(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// Test vectors of BIP39
+	vectors := []struct {
+		entropy  string
+		mnemonic string
+		seed     string
+	}{
+		{
+			"00000000000000000000000000000000",
+			"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+			"c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+		},
+		{
+			"0000000000000000000000000000000000000000000000000000000000000000",
+			"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon art",
+			"bda85446c68413707090a52022edd26a1c9462295029f2e60cd7c4f2bbd3097170af7a4d73245cafa9c3cca8d561a7c3de6f5d4a10be8ed2a5e608d68f92fcc8",
+		},
+	}
+	for _, v := range vectors {
+		entropy, err := hex.DecodeString(v.entropy)
+		require.NoError(t, err)
+		m, err := EntropyToBIP39Mnemonic(entropy)
+		require.NoError(t, err)
+		require.Equal(t, v.mnemonic, m)
+		require.Equal(t, v.seed, hex.EncodeToString(BIP39Seed(m, "TREZOR")))
+
+		recovered, err := BIP39MnemonicToEntropy("  " + strings.ReplaceAll(m, " ", "  ") + "\n")
+		require.NoError(t, err)
+		require.Equal(t, entropy, recovered)
+	}
+
+	for _, size := range []int{16, 20, 24, 28, 32} {
+		entropy := make([]byte, size)
+		_, err := rand.Read(entropy)
+		require.NoError(t, err)
+		m, err := EntropyToBIP39Mnemonic(entropy)
+		require.NoError(t, err)
+		require.Len(t, strings.Fields(m), size*3/4)
+		recovered, err := BIP39MnemonicToEntropy(m)
+		require.NoError(t, err)
+		require.Equal(t, entropy, recovered)
+	}
+
+	_, err := EntropyToBIP39Mnemonic(make([]byte, 31))
+	require.ErrorIs(t, err, errBIP39EntropyLen)
+	_, err = BIP39MnemonicToEntropy("abandon abandon abandon")
+	require.ErrorIs(t, err, errBIP39MnemonicLen)
+	_, err = BIP39MnemonicToEntropy(strings.Repeat("abandon ", 12))
+	require.ErrorIs(t, err, errWrongChecksum)
+	_, err = BIP39MnemonicToEntropy(strings.Repeat("abandon ", 11) + "aboot")
+	require.ErrorContains(t, err, "aboot is not in the words list")
+}
@@ -444,7 +444,8 @@ type pageParams struct {
 }
 
 type catchupParams struct {
-	Min uint64 `url:"min"`
+	Min  uint64 `url:"min"`
+	File string `url:"file,omitempty"`
 }
 
 // PendingTransactionsByAddr returns all the pending transactions for an addr.
@@ -485,12 +486,20 @@ func (client RestClient) ApplicationBoxes(appID basics.AppIndex, maxBoxNum uint6
 }
 
 type applicationBoxByNameParams struct {
-	Name string `url:"name"`
+	Name    string `url:"name"`
+	AbiType string `url:"abi-type,omitempty"`
 }
 
 // GetApplicationBoxByName gets the BoxResponse associated with the passed application ID and box name
 func (client RestClient) GetApplicationBoxByName(appID basics.AppIndex, name string) (response model.BoxResponse, err error) {
-	err = client.get(&response, fmt.Sprintf("/v2/applications/%d/box", appID), applicationBoxByNameParams{name})
+	err = client.get(&response, fmt.Sprintf("/v2/applications/%d/box", appID), applicationBoxByNameParams{Name: name})
+	return
+}
+
+// DecodeApplicationBoxByName gets the BoxResponse associated with the passed application ID and box name,
+// with the box value decoded as abiType
+func (client RestClient) DecodeApplicationBoxByName(appID basics.AppIndex, name string, abiType string) (response model.BoxResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/applications/%d/box", appID), applicationBoxByNameParams{Name: name, AbiType: abiType})
 	return
 }
 
@@ -656,6 +665,13 @@ func (client RestClient) Catchup(catchpointLabel string, minRounds uint64) (resp
 	return
 }
 
+// CatchupFromFile start catching up to the given catchpoint label, loading the ledger from a catchpoint file
+// on the node's file system rather than downloading it from peers.
+func (client RestClient) CatchupFromFile(catchpointLabel string, minRounds uint64, catchpointFile string) (response model.CatchpointStartResponse, err error) {
+	err = client.submitForm(&response, fmt.Sprintf("/v2/catchup/%s", catchpointLabel), catchupParams{Min: minRounds, File: catchpointFile}, nil, "POST", false, true, false)
+	return
+}
+
 // GetGoRoutines gets a dump of the goroutines from pprof
 // Not supported
 func (client RestClient) GetGoRoutines(ctx context.Context) (goRoutines string, err error) {
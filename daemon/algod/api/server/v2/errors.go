@@ -22,9 +22,12 @@ var (
 	errAccountAppDoesNotExist                  = "account application info not found"
 	errAccountAssetDoesNotExist                = "account asset info not found"
 	errBoxDoesNotExist                         = "box not found"
+	errFailedDecodingBox                       = "failed to decode the box as %s: %v"
 	errFailedLookingUpLedger                   = "failed to retrieve information from the ledger"
 	errFailedLookingUpTransactionPool          = "failed to retrieve information from the transaction pool"
 	errFailedRetrievingStateDelta              = "failed retrieving State Delta: %v"
+	errFailedRetrievingStreamStart             = "failed retrieving round %d to start streaming from: %v"
+	errStreamRoundNotResumable                 = "the state delta of round %d is no longer held, the oldest round a stream can resume from is %d"
 	errFailedRetrievingNodeStatus              = "failed retrieving node status"
 	errFailedRetrievingLatestBlockHeaderStatus = "failed retrieving latest block header"
 	errFailedRetrievingTimeStampOffset         = "failed retrieving timestamp offset from node: %v"
@@ -32,6 +35,7 @@ var (
 	errFailedRetrievingSyncRound               = "failed retrieving sync round from ledger"
 	errFailedSettingSyncRound                  = "failed to set sync round on the ledger"
 	errFailedParsingFormatOption               = "failed to parse the format option"
+	errFailedParsingLastEventID                = "failed to parse the Last-Event-ID header"
 	errFailedToParseAddress                    = "failed to parse the address"
 	errFailedToParseExclude                    = "failed to parse exclude"
 	errFailedToEncodeResponse                  = "failed to encode response"
@@ -45,8 +49,13 @@ var (
 	errFailedToAbortCatchup                    = "failed to abort catchup : %v"
 	errFailedToStartCatchup                    = "failed to start catchup : %v"
 	errCatchpointWouldNotInitialize            = "the node has already been initialized"
+	errCatchpointFileNotAbsolute               = "the catchpoint file path must be absolute"
+	errFailedToOpenCatchpointFile              = "failed to open catchpoint file : %v"
+	errCatchpointFileOutsideImportDir          = "the catchpoint file must be in the catchpoint import directory %s"
 	errOperationNotAvailableDuringCatchup      = "operation not available during catchup"
 	errRESTPayloadZeroLength                   = "payload was of zero length"
 	errRoundGreaterThanTheLatest               = "given round is greater than the latest round"
+	errSimulationSessionsNotEnabled            = "simulation sessions were not enabled in the configuration file by setting the EnableDeveloperAPI to true"
+	errSimulationSessionDoesNotExist           = "simulation session not found"
 	errFailedRetrievingTracer                  = "failed retrieving the expected tracer from ledger"
 )
@@ -1,6 +1,6 @@
 // Package data provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version (devel) DO NOT EDIT.
 package data
 
 import (
@@ -31,6 +31,9 @@ type ServerInterface interface {
 	// Given a round, tells the ledger to keep that round in its cache.
 	// (POST /v2/ledger/sync/{round})
 	SetSyncRound(ctx echo.Context, round basics.Round) error
+	// Stream the committed transactions matching a filter.
+	// (GET /v2/stream/transactions)
+	StreamTransactions(ctx echo.Context, params StreamTransactionsParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -78,6 +81,82 @@ func (w *ServerInterfaceWrapper) SetSyncRound(ctx echo.Context) error {
 	return err
 }
 
+// StreamTransactions converts echo context to params.
+func (w *ServerInterfaceWrapper) StreamTransactions(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamTransactionsParams
+	// ------------- Optional query parameter "round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	// ------------- Optional query parameter "address" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "address", ctx.QueryParams(), &params.Address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// ------------- Optional query parameter "address-role" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "address-role", ctx.QueryParams(), &params.AddressRole)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address-role: %s", err))
+	}
+
+	// ------------- Optional query parameter "application-id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "application-id", ctx.QueryParams(), &params.ApplicationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+	}
+
+	// ------------- Optional query parameter "asset-id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "asset-id", ctx.QueryParams(), &params.AssetId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
+	}
+
+	// ------------- Optional query parameter "tx-type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tx-type", ctx.QueryParams(), &params.TxType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tx-type: %s", err))
+	}
+
+	// ------------- Optional query parameter "note-prefix" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "note-prefix", ctx.QueryParams(), &params.NotePrefix)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter note-prefix: %s", err))
+	}
+
+	// ------------- Optional query parameter "log-contains" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "log-contains", ctx.QueryParams(), &params.LogContains)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter log-contains: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StreamTransactions(ctx, params)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -109,231 +188,274 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.DELETE(baseURL+"/v2/ledger/sync", wrapper.UnsetSyncRound, m...)
 	router.GET(baseURL+"/v2/ledger/sync", wrapper.GetSyncRound, m...)
 	router.POST(baseURL+"/v2/ledger/sync/:round", wrapper.SetSyncRound, m...)
+	router.GET(baseURL+"/v2/stream/transactions", wrapper.StreamTransactions, m...)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9a5MbN5LgX0FwN0KWjmRLsuwZ62Jir2350WvZUqjbnttV62ywKkliugjUACg2aV3/",
-	"9wskHoWqQpFFNiXbF/NJahYeiUQikcjn+1EmVqXgwLUaPX8/KqmkK9Ag8S+a5xIU/jcHlUlWaib46Pno",
-	"nBOaZaLimpTVrGAZuYHtdDQeMfO1pHo5Go84XcHoeRhkPJLwz4pJyEfPtaxgPFLZElbUTqs1SNP37fnk",
-	"vx9Pvnj3/rO/3o3GI70tzRhKS8YXo/FoM1mIiftxRhXL1PTcjX+37ysty4Jl1CxhwvL0ouomhOXANZsz",
-	"kH0La463a30rxtmqWo2ePw5LYlzDAmTPmsryguew6VtU9JkqBbp3PebjgJX4MU66BjPozlU0GmRUZ8tS",
-	"MK4TKyH4ldjPySVE3XctYi7kiup2+4j8kPaejJ88vvu3QIpPxp99miZGWiyEpDyfhHG/CuOSS9vu7oCG",
-	"/msbAV8JPmeLSoIit0vQS5BEL4FIUKXgCoiY/QMyTZgi/3n56kciJPkBlKILeE2zGwI8EznkU3IxJ1xo",
-	"UkqxZjnkY5LDnFaFVkQL7Bno458VyG2NXQdXjEnghhbejv6hBB+NRyu1KGl2M3rXRtPd3XhUsBVLrOoH",
-	"ujEURXi1moEkYm4W5MGRoCvJ+wCyI8bw7CTJinH9+bM2Hda/ruimC96VrHhGNeQRgFpSrmhmWiCUOVNl",
-	"QbeI2hXd/O3x2AGuCC0KUgLPGV8QveGqbylm7pMthMMmgeirJRDzhZR0ARGep+QnBUhJ+FWLG+CBOshs",
-	"i59KCWsmKhU69awDp04sJKIDKSqeYlQEPzg09/Ao2/eUDOoNjni3+5tiC/epDfUlW1xtSyBzVpj7kvyj",
-	"UjoQcKVw25dAVAmZ4b05McMY5Cu24FRXEp5f80fmLzIhl5rynMrc/LKyP/1QFZpdsoX5qbA/vRQLll2y",
-	"Rc8OBFhT51Rht5X9x4yXPqp6k7xLXgpxU5XxgrL4LBhauXjRRxl2zH7SSDPI8yA34P64sa42Fy/6WOru",
-	"HnoTNrIHyF7cldQ0vIGtBAMtzeb4z2aOpEXn8reRFS9Mb13OU6g15O/YNQpU51Z+Oq+FiDfus/maCa7B",
-	"XoWRmHGGzPb5+1hykqIEqZkdlJblpBAZLSZKU40j/buE+ej56N/OakHvzHZXZ9HkL02vS+xkLmMJhvFN",
-	"aFkeMMZrIzyiqNVz0A0fskd9LiS5XbJsSfSSKcK43USUuwynKWBNuZ6ODjrJdzF3eOuAqLfCXpJ2K1oM",
-	"qHcviG04A4W074TeB6ohKSLGCWKcUJ6TRSFm4YdPzsuyRi5+Py9Li6oxYXMCDO9z2DCl1UPEDK0PWTzP",
-	"xYsp+TYe+5YVBRG82JIZuHsHcjOm5duOjzsB3CAW11CP+EAR3Gkhp2bXPBqMXHYKYkSpcikKcwXuJSPT",
-	"+DvXNqZA8/ugzn966ovR3k93KNE7pCI12V/qhxv5pEVUXZrCHoaaztt9j6MoM8oOWlIXNYJPTVf4C9Ow",
-	"UnuJJIIoIjS3PVRKuvUS1AQloS4F/aTAEk9JF4wjtGMjkHOyojd2PwTi3RACqCBpWzKz4tUt08ta5Aqo",
-	"n3beF39uQk7tOTEbTpmRjUnBlDbCEG6mIksoUOCkQbEQU9FRRDOAFnYsIsB8K2lpydx9sXIc44SG95eF",
-	"9Z43+cBLNglzrLao8Y5QHc3M9zLcJCRW4dCE4ctCZDffUbU8weGf+bG6xwKnIUugOUiypGqZOFMt2q5H",
-	"G0LfpiHSLJlFU03DEl+KhTrBEgtxCFcry69oUZipu9ystVoceNBBLgpiGhNYMW0ewIzjCViwNXDLeqbk",
-	"a5otjTBBMloU41ovIcpJAWsoiJCEcQ5yTPSS6vrw48j+oYTnSIHhgxpItBqn05iSqyVImAuJD1UJZEXx",
-	"clqZ51FZNPsE5qroClqyE16WotIGxujlcvHCrw7WwJEnhaER/LBGfPDHg0/N3O4TzsyFXRyVgIoWxrOi",
-	"ymv8BX7RANq0rq9aXk8hZI6KHqrNb0ySTEg7hL383eTmP0Bl3dlS5yelhIkbQtI1SEULs7rWoh4G8j3V",
-	"6dxzMnOqaXQyHRWmX3SWc2A/FApBJrQbr/A/tCDmsxFwDCXV1MNQTkGZJuwH3tkGVXYm08DwLS3IyurN",
-	"SEmzm4Og/KqePM1mBp28r62qzm2hW0TYoasNy9WptgkH69ur5gmxOh/Pjjpiyk6mE801BAFXoiSWfbRA",
-	"sJwCR7MIEZuTX2tfik0Kpi/FpnOliQ2cZCfMOIOZ/Zdi88JBJuR+zOPYQ5BuFsjpChTebg0ziJmlVlWf",
-	"z4Q8TpromCZqBTyhZtRImBq3kIRNq3LizmZCPW4btAYiQb20WwhoD5/CWAMLl5p+ACwoM+opsNAc6NRY",
-	"EKuSFXAC0l8mhbgZVfDpU3L53flnT57+8vSzzw1JllIsJF2R2VaDIp84PR9RelvAw+TDCaWL9OifP/MG",
-	"kea4qXGUqGQGK1p2h7KGFvswts2IadfFWhPNuOoA4CCOCOZqs2gnb2y/u/HoBcyqxSVobR7Br6WYn5wb",
-	"dmZIQYeNXpfSCBaqaZRy0tJZbpqcwUZLelZiS+C5Nb2ZdTBl3oCr2UmIqm/j83qWnDiM5rD3UBy6TfU0",
-	"23ir5FZWp9B8gJRCJq/gUgotMlFMjJzHREJ38dq1IK6F366y/buFltxSRczcaACreN6jotAbPvz+skNf",
-	"bXiNm503mF1vYnVu3iH70kR+/QopQU70hhOkzobmZC7FilCSY0eUNb4FbeUvtoJLTVflq/n8NDpSgQMl",
-	"VDxsBcrMRGwLI/0oyATP1V5tjrcGtpDpphqCsza2vC1L90Pl0HS55RmqkU5xlvu1X87UR9SWZ5EqzMBY",
-	"QL5o0OoHVXn1YcpC8UAlIDWYeomf0SLwAgpNvxHyqhZ3v5WiKk/OzttzDl0OdYtxNofc9PUaZcYXBTQk",
-	"9YWBfZpa4++yoK+C0sGuAaFHYn3JFksdvS9fS/EB7tDkLClA8YNVLhWmT1fF9KPIDfPRlTqB6FkPVnNE",
-	"Q7cxH6QzUWlCCRc54OZXKi2U9njtmIOaVVIC17Gci/oMpsgMDHVltDKrrUqiRep+qTtOaGZP6ARRo3rc",
-	"HIKrhm1lp1vSNRBaSKD5lswAOBEzs+jaywEXSRUpjezsxDonEg/ltw1gSykyUAryidNn74XXt7P3j96B",
-	"PFwNriLMQpQgcyo/zApu1nuBv4HtZE2Lyojn3/+sHv5RFqGFpsWeLcA2qY1oq++6S7kHTLuIuA1RTMpW",
-	"W2hPghGxDdMpQEMfsu+Pvd7tb4PZIYIPhMA1SPSo+aBHy0/yAYgywP+BD9YHWUJVTowY2Kt+MJKr2W9O",
-	"ufCy4Z4ZwgQFVXqy70oxjRp6E7PUiIunbhEcuEeefEmVRjGQMJ6j/tZehTiPlS3NFKMDncpwyt7XmJn0",
-	"Z/8Q606bmeudq0qFV5mqylJIDXlqeWiz7p3rR9iEucQ8Gjs8/bQglYJ9I/chMBrf4dEpAvAPqoOF2tm8",
-	"u4tDrwMjvmwPxXIDvhpHu2C89K0ixMdOtT0wMlXvgSU3plr0NhOiAIoqU6VFWRoOpScVD/36MHhpW5/r",
-	"n+q2XZK0ZiArqeQCFJqYXHsH+a1FukJb15Iq4uDw/gmo8LIucl2YzbGeKMYzmOw6L/gINq3ig3PUca/K",
-	"haQ5THIo6DbhbWE/E/v5QMLwYyOB1PoDoWEyQ2timkbqM+H9TY+bVeBUKiV4E/xCMnPOzTOqJjXX+/hJ",
-	"c8BpU3zTEeuDMAuCkaQDPx4iy9JTYkS8+9dCG7JyRIercbfSPdfSg70w6wdBII47qRUB7dn/C5SbOwhg",
-	"J51/C6pv4fXUp1p2j/of7/bGhdm6ylq3TfKK6OXLexhjHw/qsUW8plKzjJX4XP0etid/vbcnSPpKkBw0",
-	"ZQXkJPpgX/Jl3J9YN+T2mMe95gepW7vgd/StieV4z6wm8DewRbXJaxvREGmrTqGOSIxqLlzKCQLqvebN",
-	"iyduAhua6WJrBFu9hC25BQlEVTPrtdI1oWlRTuIB0jFT/TM6g3zSHL7TQ+ASh4qWl/I8tK+t3fBdtZ5c",
-	"DXS4V1YpRJHQf7ZPfAcZSQgGuQuRUphdZ7QotkSHsBlPSQ0g3QWB3hhBnnmgGmjGFZD/EhXJKMcXbqUh",
-	"CGlCouSDwrKZwYibYU7nqlpjCApYgX3N45dHj9oLf/TI7TlTZA631uWGY8M2Oh49QlXca6F043CdQNtt",
-	"jttF4tJBW6W5ZN2rrc1T9ju5uZGH7OTr1uDBwGnOlFKOcM3y780AWidzM2TtMY0Mc/DDcQeZ75ouYZ11",
-	"475fslVVUH0KQyWsaTERa5CS5bCXk7uJmeBfr2nxKnS7G49gA5mh0QwmGUYJDhwLrkwfG1hoxmGcmQNs",
-	"A0eGAgQXttel7bTnpV37LbPVCnJGNRRbUkrIwEbJGSlVhaVOiQ2ZyJaUL/AFJEW1cK7Odhxk+JWymjBZ",
-	"8c4Qh4piesMnaMJQyTA1NFv6aEsjhAE1L9u2/cM+1m5pAMVeRoMu7Wh72vagpMl0POp9+Bt8r+uHv8Vb",
-	"M2T0WGNiQz6MkFZDM9B6hvg0slIXifE2msNniOHDWGnqoVNQdieOnMLrj31+4ZdVWRbbEwhJdiAioZSg",
-	"8EqL1YDKfhVz8gPLpDgvFiLceWqrNKy6xhvb9Zee4/rmmBew4AXjMFkJDokn/Sv8+gN+HKx2tNdwz4go",
-	"EB00YPvh00BCawHNyYeQ9H03CUmmffbblk71jZCnsrLbAQe/KQZYrve6dbgpj7Wv06JImKSt+qHDRdQ4",
-	"OIUzSahSImMoKF7kauy8z60V27q1t9D/OoRGneAAt8dt2V6jMCyryIeiJJRkBUM1v+BKyyrT15yipi9a",
-	"asJZ0CsH+tXCX/kmaT10Qk3shrrmFB1Fg/4v6Rg0h4Qe6hsArx1W1WIBSrceWHOAa+5aMU4qzjTOtTLH",
-	"ZWLPSwkSPfamtuWKbsnc0IQW5DeQgswq3XxyrCqlidKsKJwh2ExDxPyaU00KoEqTHxi/2uBw3o/EH1kO",
-	"+lbIm4CF6XDGtQAOiqlJ2tPxW/sVg0ocTpYuwARjLexn7/Fc54YYmbU3klb8n0/+4/nb88l/08lvjydf",
-	"/I+zd++f3T181Pnx6d3f/vZ/mz99eve3h//x76nt87CngsEd5Bcv3Bv94gU+xKI4kTbsfwSDzIrxSZIo",
-	"Y4eiFi2STzBfhiO4h029n17CNdcbbghvTQuWG150MvJpX1OdA22PWIvKGhvXUuN5BBz4HLoHqyIJTtXi",
-	"rx9EnmtPsNPhJt7yVoyB44zq5AC6gVNwtedMudU++PbrK3LmCEE9QGJxQ0epBRIvGBfB2PDyMbsUB3Zd",
-	"82v+Aub4HhT8+TXPqaZn9jSdVQrkl7SgPIPpQpDnPijyBdX0mneuod4EUlFQc5RBKsUp6Cq9luvrt7RY",
-	"iOvrdx0/hK5s5aaKuag7Z101mZ9yYuQGUemJS+IykXBLZcoW4lN8uGho7L0TDiuTiMoqsXySGDf+dCiU",
-	"ZanayR66KCrLwqAoIlXl8hWYbSVKixA4Zpi5i701NPCjcE4lkt76J2+lQJFfV7R8y7h+RybX1ePHn2II",
-	"Xp3i4FfHAw3dbksY/PDtTUbRfu/iwq1cjk7lk5IuUjaT6+u3GmiJFIICxwpfmkVBsFsjPNBHAuBQ9QJC",
-	"LPIBW2IhOziuF5d7aXv5tF7pReEn3NRm7PS9djCKij96A/dE1tNKLyeGIyRXpcwx8HvlEwzQhblyvAeB",
-	"Ygt8AKilqMySgWRLyG5cZitYlXo7bnT3ji7uLvYMhynUGbngwDkz+MsoNwNWZU6dIEP5tp3iRtlgCBz0",
-	"DdzA9krY7tOB2cGibHRRihXVd3SRdqO71pBvfJDdGO3Nd35XPkbUpSPBuEtPFs8DXfg+/UfbCgAnONYp",
-	"omjk+ehDBJUJRFji70HBEQs1492L9FPLYzwDrtkaJlCwBZsVCTb9965dw8NqqFJCBmzto3rDgIqwOTGv",
-	"o5m9jt2LSVK+AHOpm4tYKFqg0/40aehH6XAJVOoZUL1TX8vjNBMeOhTIbzFoGpUmY7ME2Jj9ZhqVIBxu",
-	"zQMP3962jXMknh7lTmXXBPmRoPrudZD09JhHhEN4Ip+dv+/DnoT3gvNPi6kTQbbfVwaHCyluzW4aAIVP",
-	"3YgJXqJ7qlJ0AUOvo4apaGBKjIYFCAfZJ/0k5R0xb4s1HRlj4CJs94nBS5I7gPli2AOaAVoujn5ua0J0",
-	"VoVXvNh6pM4KFKiDg6glHSobdja+OAzYNBsDyWth1QPWxFp89JdU+aOfjyOOfqS0+PukktmVP+8i8r6j",
-	"upsdz1/TbdY+tvqcGRDBTQ+fRc+nzvP58kbjg3LfjUcuxCG1d4KjFJ1DAQuLE9vY01mdn6neTQPHq/kc",
-	"md4k5cgXKSMjycTNAeYh9ogQqzEng0dInYIIbLSs48DkRxEfdr44BEju8ktRPzbeXdHfkA4WtN74RkoW",
-	"pbn1WY/VKvMsxaW3qEWeloszDkMYHxPDSde0MJzUBZ7Wg3RyteHbp5WZzfl2POx7Ew08aG6NKJ0ctEor",
-	"zxyzvljw9stIvwoOWsNMbCY2Mjr5tJptZuZMJOMVME47dXht5rwHiszEBn2K8IazDu4HQ9cPmQcscgPZ",
-	"MIVUjv36xEYL3mGA7BbkU9SskPScXi2QXZ8kexwwPeJ0H9l9EqXQOxFILQVmnQbcaXT26lma0lZXEqmv",
-	"23HIDhvC1FKspu9wJneyB6Nd5Wkz1913dbrD/uRo/qx+lCR/XaXcffIy2s6lzbV4SFrGNjk0gNiB1ddt",
-	"ITaJ1qbjUhOvEdZSLMkw+q6xq4s2BQWgJmDSkKsnNymz9PX1WwUoM1z6bpGeE3eP8u3DyBtOwoIpDbVx",
-	"wTu5fHzbD6oTzWNLzPtXp0s5N+t7I0QQNKw5Fjs2lvnRV4Cu63MmlZ6gZSa5BNPoG4WatG9M07Qg3PS3",
-	"Y8qaeg6WgxGiG9hOclZUaVJ2IH3/wkD0Y7i5VDXDi5Jx6200w1T4SQfdA2yTCI917N6JoJcWQS/px8DP",
-	"sINlmhqYpKG85vR/kiPW4oW7OEuCllPE1N3QXpTu4LVRLH2X0UZCdOR2Md1l8+mcy9yPvdcby0f09wkR",
-	"dqTkWqKMiOkAQrFYQO4zvbmgUJv1yuXTKwRf1LkEze870gdOic3ih0n4duTvc+7p0Oec3ignglUxktDH",
-	"jxmEvI6uw9yDOMkCuM3cMjq83kiRRFzsGI8tIs3ox+XtHbf5pOvwVctduPbptXsYNhu3pwCau2eVAr++",
-	"3Ye2u10OdeM+p+NGitjdBwwHRIpjWkUCTIdoejg3LUuWb1qGPzvq9AiSGCjudTPBt3CGbMkNtgc/Tcfi",
-	"PbV6HpjbEds7Y8cZPvPPzCPT+jM7j1xzNmjmsg3klURrUsNbuJtPPzw0B679+58vtZB0Ac4iOLEg3WsI",
-	"XM4haIhS0iuimXWQztl8DrElTB1jxWkA17F35AMIu4cEu+ay8LbcSZ9dIttDW/UK9iM0TU8JSunzubjq",
-	"2iP9wyPSrYXLJtq4I4yKyYQC38N28jMtKvMSYlLVvqnOQNi81g+gifXqe9jiyHtdPg1ge3YFVXFvACk0",
-	"ZV0Jn1SUJfyBalRfwDdwYwsP2Knz9C6daGtcKY3+o1HfUI16Es2lfLhjU7vIGEiH7NVl2uvEnC1obkub",
-	"0PdtEcv3yz7REySeiqH3xjGXXMi0sde7DGjhCR8XO7obj+7n75G6J92Ie3bidbiak7uA3pjW/t9w+jpw",
-	"Q2hZSrGmxcT5yfQJHVKsndCBzb1bzUd+X6VPxdXX5y9fO/DvxqOsAConQdXRuypsV/5pVmVLcOy+hmw6",
-	"dqfbtaqwaPNDyuzYk+YWU6+3tGmdWje131R0UJ1nzTztKb6XbzoXL7vEHa5eUAZPr9oibR29ms5ddE1Z",
-	"4Q2/HtqhWna73GHVlZJ8Ih7g3k5ikfffvcfqjRO4vn679pit7SnWUSqkxE/40qkjPZ07vCZ9Vmta38Mh",
-	"cZ2vMJNp+t3FXZ5TZIzO4YyeXA78RsjGReWiGpMOax9OQDSPCYvHtFH+ylnhO2LhlFgR8tfFr4Y3PHoU",
-	"H/xHj8bk18J9iADE32fud3xHPXqUNAwnVX2GZaEmj9MVPAxxEb0b8XHVEBxuh4kL5+tVkJFFPxkGCrWe",
-	"Zx7dtw57t5I5fObulxwKMD9Nh6gq4k236I6BGXKCLvuiEoPz88qW81RE8HYMPkbJGtLCq8dV8LB29u4R",
-	"4tUK7c4TVbAs7fTDZ8qwJG5dek1jgo0H25DNHBXr8SvnFYtGN83UUSbP1kKiWZMIV8lMwDV+Z8KxgIqz",
-	"f1YQlfXFm7h1OfunEI7aEbDT+kU3cLtq8OiYgr/3NxF6rdouhdFOk+uLYAb0iEjVmTow3iGescP8d8Qq",
-	"OIry1ycGti2d6/Beytr5zttdBNqZgT37dBbX/geSK4dpN/PFkJ1majKX4jdIyw5oJEyk7vDWbYYK+N+A",
-	"p3xU24wseA7UBavr2fcRyHDdQh+p3FuX4BcdquYdc4Wn+cRhG32g0iDa7361gUqnF3eb0PdQjR1PmoE0",
-	"PcwMD2zkFo61fLy7G+X2hNq8Fo3Is/Q5jwNFz+z49Tl3MHeCawt6O6OpQkfmvWhgira/4ZinBfGd/Qap",
-	"kJrBzk6iWIbQltlkfyXI2nrUTZV85NvPTjv41Vc/8pDi4ufd2PqqFEokhqn4LeXoR4j9LAd0vRVYPwzT",
-	"61ZITPCp0j6EOWRslVSGX1+/zbOu51fOFsyWFK8UEDrXLs+jG8gWlbdU5Kp5h1wkDjUXc/J4XJ9Zvxs5",
-	"WzPFZgVgiye2xYwqvKCDT0ToYpYHXC8VNn86oPmy4rmEXC+VRawSJLzPUfQMnrAz0LcAnDzGdk++IJ+g",
-	"w7Bia3iYvmCcsDZ6/uSL8a7K2YhxLBK/i8nnyOV9IEOastGr2o5h2KobNR2ZMJcAv0H/fbLjfNmuQ04X",
-	"tnRX0P7TtaKcGoSkYFrtgcn2xf1FV44WXri1zoDSUmwJ0+n5QVPDsXqiyQ1DtGCQTKxWTK+cp6gSK0Nh",
-	"dRlyO6kfDuvr+TJoHi7/EV2wy8Qb/3d4btFVT4QjetX/iPb2GK1jQm3G1oLV8Re+Qi258JmpsS5cKAdn",
-	"cWPmMktHeRXDMeaklIxr1BpVej75q3m+S5oZhjjtA3cy+/xZor5aswQRPwzwj453CQrkOo162UP2Xspx",
-	"fcknXPDJynCU/GGd0iE6lb2+4mn/3j63456h7y1dm3EnvQRYNQiQRtz8XqTIdwx4T+IM6zmIQg9e2Uen",
-	"1UqmCYZWZod+evPSSSIrIVOVLmoG4KQSCVoyWGN8aXqTzJj33AtZDNqF+0D/+3q3ebE0Et386U4+FiKr",
-	"cuKdFtIqGUn/5x/q/Pho3LZxuy3tpZAJPa3TOH5kt9TD9IVtG7p1B8RvPZgbjDYcpYuVnnAPG88R+vwe",
-	"/l5tkOyeN1SlT34l0rzjUdZ/9AiBfvRo7ETlX582P1v2/ujRcJfZtL7Q/JpAzXF3TTt7pemb2uovRUJ7",
-	"56t4Br8xl6okoWFN3mXmSp25McakWSrx48sdp4lXPNgNOX2APGrwcxs3vzN/xc2sI2D6+UOzemySfPLw",
-	"PYqhoORLsRlKRK1ry9PTHwBFPSgZqBXElXSq4yY9Jfa6+URka0adQSHMSzUugDXYa+VPtAsGNeMde1Gx",
-	"Iv+5tkK3biZJebZMOpXPTMdf7DMgahBpMLIl5RyKZG/7Wv7Fv6oT7/5/iJ5hV4ynP7ULMVvYW5DWYDWB",
-	"8FP68Q2umC7MBDGKmgm5QoqTYiFygvPUlUtq1titaJ6qJJuI8cdhV5V2XsmYPMEVFJmzAt1o0/ZwbDmR",
-	"VPdwVSz770tcmXGwCr+yagk7OkhC2QqvbUVXZQF4CNcg6QK7Cg6t7pixDUeOypIQVZpP2BKTvwiiK8mJ",
-	"mM+jZQDXTEKxHZOSKmUHeWyWBRuce/T8yePHj4cZGRFfA9Zu8eoX/qpe3JMzbGK/uMpftmDCQeAfA/1d",
-	"TXWHbH6XuFz51X9WoHSKxeIHG5CNFmJzr9vSq6FM8JR8i/nJDKE3SgSgUtRnWG7mBK3KQtB8jEmhr74+",
-	"f0nsrLaPBEQdln5doAaweUSSRp7hOVJ9/rWe3FXDx9mdOsesWulJKMqayqRoWtS1ZFnL+wl1gzF2puSF",
-	"VcsGxx47CcHU4nIFeVQD1qoBkDjMf7Sm2RL1ndPRTpVyTzWg4SWMPQeszUVR3GsomIUc3CzDVTG2RYzH",
-	"ROglyFumAPNOwBqaCRtDtlOnkPcJHJurlRXnlnCmB0ivoTzWobvggbOir/evSELW2od72/7qTB5Y5PzQ",
-	"Ys+X2Csdt9OqHN3ye7AlMza+6MaU/OCMHRnlgrMMi02kRHBMxTjMrDqgLkfa3qlG7iwnjmGyXnUIUHdY",
-	"7K1g7VmmQ1zXqSH6avbbEo79U8PGFQFcgFaOB0I+9uXjnYGOcQWuAJqhr5ijCplw/UqGxQQXkhO6pI9H",
-	"mE2tR9f6jfn2o9PNY86YG8ZR5+aQ6l6C1sBWKIZ2dk6YJgsByq22GRem3po+06sNRxDeTV+KBcsu2QLH",
-	"sK6IBinWC7g71Ln3CXY+uKbtV6atq10Qfm641NlJ/brfJVmICvufqrnei/6U75d3pImQG8aPR9tBjDtd",
-	"/fFeNmQIa/T8gxLv8w7ZhPL1zVG+Nk9WS2/YgtjI3WTaYMYTYLxk3Bt803mwsuRdghuDp7mnn8ok1fbR",
-	"MYjjXQEtesJhMKjeegzcd6h2JQaDElyjn6N/G+vK+z1sJTSoXxeUb4k/FIa6I6HkK1oEZ/hEHX2Uzpww",
-	"Zp2FW5X1U2zFsPWJD81toGtvIGjojtVQDr2n+rKNzqp8AXpC8zyVd+5L/Erwqw8ohA1kVSgCFuJMm+na",
-	"u9TmJsoEV9Vqx1y+wT2ny5miSsFqViRcb1+Ej5CHHcZEVLMt/puqgNW/M87p/eDob+/hnh9Wo6AbzZ6S",
-	"ng1NTxRbTIZjAu+U+6Ojnvo4Qq/7n5TSfeD3HyKuu8Xl4j1K8bevzcURp+nu+PjbqyVk0UZ/eoHffT6w",
-	"kMm1yZXwKuvUeUOPDNy8xJa1gPcNk4CvadGTcSG22tj71Voy+vIuZL1pRah22es0JTVPGKLC6M//ZT2w",
-	"W5ahrnmzz8faulh/SOOJw8dOpPdbGr9v2BWt11vNUHrticeZ/GoiONTm50oxdPWltChENpgzuGHOTaf+",
-	"VL1itXKZ7xNeeeuVyOOzEHtzAaQZm3VYToRW4MM2+Q2fVskv8jY9WkM/EohmaNYyRKNbwtgGZnrwPDB2",
-	"6niiSGXrMEu+YQUWh/rPy1c/jvo3MtqB7pa61NlJFXbfxoRItTZ5LEQDHzt4gOBFWv+telTqmBsqfRpc",
-	"deLkh2+sgnAISDZP0iGtXw4dvEMAC2GrQqXqZnSz04zq7fDIj6ih3l7LUWLqSFFFu9pS4u1jlZ51ExIK",
-	"kQ4qTNqQkYYUd0rVEXIvBa+BtReNy0dniyt16jJ1GOiLIcJhBx9349FFfpD4lKpFNbKjpBjsS7ZY6i8L",
-	"kd18BzQHaeuJpJ6TtprICswzVC1Zie+fUihW1wMuzGAukfcSh5sODc25WoLLCuOTBHTG8g7Ua8g01oeu",
-	"3UAlwHA/hzK9RAOBNyhik9/BFUQC5FDq5U5hyTp3l3pZlw0FF3nGFJmBM12sgY8Jm8K0HayW10mhSAF0",
-	"7pWwUgg9oK5uCFtCNMZAp+irU6N5txjYyfkWpTS0pXSnw4uwnIeYABtoeUtVnTmqlUZhcLj2fA4ZJrzf",
-	"mX7v70vgUT62sVfdISzzKBsfC+GCWLLhpBrtGtZdifB2ghrVpPqQkPYlxLiB7QNFGjSUrAgcImyPyQCP",
-	"yLF2XF9UoM+04RwjmQr0hAjyfvAuAX9dY+mYIgBRdsojwfA0bq6nOmPlcdB4ieYIMEzX6b2K9tfp8FAw",
-	"7cvu162u3v9SfoHF7JVzKqUh3XysTyIX3XLMty5dPSZaDNZCn7gelP/NJ2i1sxTsxlWoQYRZ2+wtlblv",
-	"cZI0efbeZGmg52FmVgdGdb18DvXLsRGKWSGMADTpCwxtRioFF94Hyvpa10nLEOo5SAl5sAkWQsFECx9m",
-	"dUDyTxc+uQN71sv8KLy1PPoPCBm2K+qtofCmLiSB5SAp1kygzvk8xgqRsKIGehkVd0irQfft0Ff2u88p",
-	"4sv77Vav9uE9nIv9FbJ96B1THczHp2tOnHBwMPdqJCI5QjPLOAc58UbcdmkH3kyTiXmV8yqzokp8NoP2",
-	"enDasR3cLKnUzLqrbD2hoqwcN7A9s2ofX3Xc73gMtJUhLehRQukWUZxUV61ScC9OAt7vm76zFKKY9FgG",
-	"L7r1KNqH4YZlN4CJWUNkipGCHzSPjZmEfIIGqeAzcrvc+moLZQkc8odTQs65jQ707iPNCqStyfkDvWv+",
-	"Dc6aV7bCjNNAT695OswKK73Ie3I/P8wOntfHmxQYfnnP+e0gR8yuN7zPR+4WS8I06wRPh6o3uv4dLREq",
-	"Ij8LRUqAurSG4K+QJSTeUQSzs0RphNA/gBJnQCaqECkv/GMyyJih0piKJ0OANPABz9UaCjd4EgHOyW5P",
-	"Vlb32ecdFXMiofbNODYBq8tpapm46lONtGcOszQ541xIiGdEP1ObqDlEtmGeY/zPjGlJ5faYNKlNVKXU",
-	"UL1Y3ustGRwl64XUzpJdHBaFuJ0gW5uE6kopdYBpp5rXtq9TWvczR30GkdslVU5E3JIlzUkmpIQs7pEO",
-	"8bZQrYSESSHQCzPl2DHX5pGwwrhOTgqxIKLMRA62EFqagvrmqjinKHtB5MqWRIGlHUwZYPtEdDxwSnP7",
-	"WvPsBOW1vYU2/OZfmT42fUWd/s4uemJdBHriC0C5dHcOQ7ZxF14kHJuRqa2UTYvIc7ZBugGZOvJzomUF",
-	"Y+JatKvwu4NPJZAVU8qCEmjplhUFZo9gm8ihIfgDpVHbIztfoB/0mqHDWzOTiBWpS3M7hvQrMQ+4jDOy",
-	"Eb2Uoloso/oAAU7/dJeVe9jHo/ykKvRJxBBRM8UzshJKu2exHalecu0C+kkmuJaiKJqKPCvnL5zR9we6",
-	"Oc8y/VKImxnNbh7iI5wLHVaaj31Khbbvbj2TbOVgHPZS0Bs+QfJQ+9Os23bo1eroeTDvbHG/juFhnyY/",
-	"AvPdfua6365x3l1Ye11NPpt+C51zQrVYsSx93P5c3q+9Pqsp7pXMtGirENssNNgM+UB8jwV3JuSeXTQD",
-	"p8kyqufE8Qjn1oGcyPwXxfj2uGQOjgf13KFdvuMErEnWKwa2AEBIbSIEXUlbujgW0gLDEQubOAWdUtqA",
-	"Drxw0PfvfrCZEU4OlIZ7AdXxRg4AfmI1GGObEdN6Ns/Exn9/WKfMPAr4u91U3mAefU6VlzVpSetW6RNZ",
-	"9XCEdAGCnR6IV5gEYzbUDzGUoh94+UcA9HsmNmAY5J94KBhzygrIJ6kqxRdBBzaOnusuxjIa3ddztJw8",
-	"o5WvBGzGriS4xEpW+pdNc2JJDSmJ0LyrEec5bMDGaP0GUtg6vuPInAWFLfPb0iiIclLAGhoOmy7bU4VS",
-	"KFuD76tCZ5IDlGjxbSvaUp6IcZXAlvbFrX0S+bINwW5SHWMRa3eK7NG1JDVDGz6xx0QNPUoGojXLK9rA",
-	"nzpU5GjqEs1RTqCq83yY+Cfm0Gl+siO88QOc+/4pUcZj4t0wPnQwC0qjbhcD2uuZXKm+U8/TjslxKrNg",
-	"KMLZ8mDXtiRe8w1V0lver9Xsknz9Ehu4T0zwCLFfbyBDqcY9hSB3j6Eey4nLgYTUzgFy+2AwXRLa/CVw",
-	"wkVU8/iWqvCKqbO6+h/sxNiIcffQPsJGX/sP339nCQ5GVCvZYrpEaSDr++n4f5eTuPMg9o6XohEFLpR3",
-	"h2rMU7d7dmADURU54WY/jeyPNYLdLea4+JjMKj9QUYhbW8Q4fqK+AG/PtdTnTUxOLGfhWvZ+0mOXcLit",
-	"BWFRhMiKbomQ+I95kP6zogWbb5HPWPB9N6KW1JCQMyBbLwrnd20m3i1ejT1gXhEj/FR23WzomNFwWzNK",
-	"BLS5yH3ZNkFW9AbibUAHEcs/M20Yp6pmqNQwV3ZrO7tYcIv36ZlWNI+VAJhodtvgDj7huen9P+uw1Xgq",
-	"n/+xLGjmS1a74nNNPoNV7T1x6SWsdoc5d/maJ4FQKb8mWunTZORHaFMPZF2pmJ++4lgNsDslwDt1we61",
-	"jIFK4VaNox0B4oOWcupdOE0MZ2dJcanffYuLKx9/nN1JZojuW8YQ8P9Au9Jwr+hEtqUrqMfrscXSP8Iu",
-	"NBLxJGC1avCZ2EwkzNU+RxqrB5+JTQ2wCrpbxjMJVFm/o4tX7tlaJ0Bm3DyjrdduMKuGUXKYM16zWsbL",
-	"SideQZgHmW8jhMXWBERrj22uT8YwouiaFq/WICXL+zbOnB5bGjgu0uMtKK5vQgESbuTuAEzVL0CMp671",
-	"83Ezc/3bAoPWd1ZpynMq87g54yQDaaQGcku36nhTVbA67DNW0UgWamYLicxWSNoWkGLrrM33NCQFAOkJ",
-	"LUoDLEHopJ2wAlnFkBY9hp8uDH8KS9CKbiaFWGDUb8+BcHmu0XRoH5CCoxLdSnfD1u3nUew32D0NliJx",
-	"jEgLnHXIFLvP/SvcSnyE/sSZ3nnyrYazHYZtPZ3twfRI5Ys6PMMSS/c8piLnXWKmOHrei6o+TYmnPYg2",
-	"MekS3dGq9+wi+le4tAuxCn14scqmC0cqPt/qFSaob1A7AjBA1XEFNHMeYl1FXEdRYZEydtkNDtTTWe2+",
-	"v5d6wENFinJnvTltcNAx4xxS4XN3PoNJKcpJNsS31VYryp2RwUHahLGHPiITQs+6g9+NCvW7GjnRGoW8",
-	"Di1y2ltIbJ+trMx2qQz6lEw9HL1pwBBz5GV4hK1qDWOtgipm7B/n3tjdVKIFJkEokZBVEpXMt3S7v/Bj",
-	"T/b5y+/OP3vy9Jenn31OTAOSswWouqZBq3Bi7ZrIeFtr9HGdETvL0+lN8NlCLOK89dKHvYVNcWfNcltV",
-	"JyPulI08RDuduABSwbndEnlH7RWOU4dF/LG2K7XIk+9YCgUffs+kKIp0TZkgVyXML6ndigww5gVSglRM",
-	"acMIm/ZTpmunbLVE5SJmDV/b3FCCZ+C1z44KmO7x5UotpM+nF/kZ5mJwNicCm7JwvMraiXaty73TrH4P",
-	"hUZ0t5kBKUXpRHs2JymIMGZLVhD06k5tivr0yE03MFvrsJsiROf8nia9c+5ewmJOdnP7Ziluneb0ZhMT",
-	"4oU/lEeQZp91oz/PyDGcpDYM/GH4RyJxysm4Rljuh+AVyffBjqjw847XREgaMgi0boKMBHkgAD3x0I2g",
-	"1SjILspNLq2NAa0R3vzcFj9+qM3SeyNTEBLfYQ94cSxz3S4EUzhwfufE3j8EpERLeddHCY3l7wuP9qw3",
-	"XCTRFjmlidagLFsSXbEwCohXX4U4855XSSccXQqhiXmZFkUijN3qcfBMxYRjngRyTYuPzzW+YVLpc8QH",
-	"5G/6A7fisOUYyRaV6uQJOV/SQWBFIcofBSr+GmPr/w5mZ5O3o5vFGf47dyCqhGhhvb3nwQIOnNzimNax",
-	"68nnZObK/ZQSMqbaDgW3XqQJ8bYg2dz518JGt2N/710m6Geh73Ec5t4fiPwYGdmC54CDuT7qvzNz6uEA",
-	"ydOSItUOoSTwl+J1cVH1PdfOPUvDHJfKKUrceGAqp265+KHLw3Xg5VUp6K5z8K3fwG3iwq/XNjRX2eAK",
-	"M9fXb/VsSEKxdDUY0x1znJ2kLMz9i8J8lARnFpVuDAdJkrBqkXtf9pqWv2SUp6G5i0bc7ykgv7ToN6Ph",
-	"o2BecTteKICKseKerYv5OHgxCG66PSfX/BFRS+rfFu7Pp599PhqPgFcrs/j6+2g8cl/fpV5q+SYZV1on",
-	"0un4iLpqAg8UKel2SDD73tQ5SfzWmYI+vkijNJul33TfmT3Dh6sLQLjgyOqRvdgb1OXP+VcCoJ3E0Dqs",
-	"4cRYkqzTA4Wt2Jcp6Oe+tPg29XtPtY8W961YsddJrlGI5W48WtgkZVid5BdXq+7jbruHoCdfoFv6fdKA",
-	"WcQk1tqYPJoqSuo2oCCL65aokIGR11klmd5eGvx7tTv75SaVDOrbkJ7J5fwKFngn+2pxA9z7mNXJnCrl",
-	"petvBS1Q+rSOAdzInKKYkq9thRB3Lf7twewv8Olfn+WPP33yl9lfH3/2OINnn33x+DH94hl98sWnT+Dp",
-	"Xz979hiezD//YvY0f/rs6ezZ02eff/ZF9umzJ7Nnn3/xlweG0g3IFlBf+ef56H9PzouFmJy/vphcGWBr",
-	"nNCSfQ9mb1DDNscEhYjUDK9YWFFWjJ77n/6XvyinmVjVw/tfR64e5Gipdamen53d3t5O4y5nC8yBMtGi",
-	"ypZnfh7MZdl4r7y+CHFB1vcPd7S2OeGmhvx+5tubry+vyPnri2lNMKPno8fTx9MnmE+xBE5LNno++hR/",
-	"wtOzxH0/wyzaZ8oV4zkLoaN34863srSlesynRUgDav5aAi2QRZo/VqAly/wnCTTfuv+rW7pYgJxixJj9",
-	"af30zL89zt67vDJ3u76dxd5oZ+8byXnyPT29P9W+Jmfvfbn/3QM2Srk7P9eow0BAdzU7m2HdvaFNIV5d",
-	"/1JQ2lBn7/GN3vv7mbuv0x9RjWJP2pkXQnpa2lwi6Y8NFL7XG7OQ3cOZNtF4GdXZsirP3uN/8NBEK7J5",
-	"vM/0hp+h28nZ+wYi3OcOIpq/193jFph+1gMn5nOF3jG7Pp+9t/9GE8GmBMnM2xNTnLlfbVbLMyxzu+3+",
-	"vOXOSaKAVCqwn7gCq2Pz9Ym2PKsjcQMfuch948stz/wj2fthI3d4+vixnf4Z/mfk6ju2smKdufM8svf5",
-	"XlVvI3M28t6Wlj/Aa+ONjUCMMDz5eDBccOt7bZixvTTuxqPPPiYWLriRb2hBsKWd/tOPuAkg1ywDcgWr",
-	"UkgqWbElP/HgPh6V6U9R4A0Xt9xDbiSOarWicotS80qsQRFXrikiTiLByE72rYLCcE3DeOVRw0fejspq",
-	"VrBsNLZ50t+htKZTgotXPXdn8mr3evDmqfh275kYvgtNeXhHGq5BcB6fus/OnEgp3Nl6TxZtnw4LxYPU",
-	"3o3+xSP+xSNOyCN0JXnv6Y2uNsx0CaWLuM9otoRdrKJ7kUZ3/6gUqRQ4lzv4iKuL1sdGLptspPZdHj1/",
-	"2w1Nd9SMWoGpf8sYQb1+asjAkPy5RkeNaD8HV8FrW1H6v737QwgFX1HuT3qDFqwHBZUFAxnog/JuEbt/",
-	"8Yf/b/iDLc5J7b6OiYaiUDFX0AK5glXAuVzJ3DoBDOQQjazXtQTe+PnMKztSD9dmy/eNP5uPMbWsdC5u",
-	"o1nQTGgt492niflYqfbfZ7eU6clcSJc2mc41yG5nDbQ4c6X5Wr/W9W46X7CIT/RjHPee/PWMujdK6hty",
-	"wb6OnUd06qt7J/Y08gEX/nOtqotVX8iBg9Lr7TvD5RTItWfOtSbn+dkZxu8thdJno7vx+5aWJ/74LhCW",
-	"r1Y+KiVbY/mjd4bHCskWjNNi4lQhdenR0dPp49Hd/wsAAP//auagyh4NAQA=",
+	"H4sIAAAAAAAC/+y9+5PbttIo+K+gdG+VY6+ksR0n34lvnbo7ifOYGydxeSb59m7sTSASkvANBfAA4IwU",
+	"r//3re4GSJAEJUozdpKt85PHIh6NRqPR6Oe7SaY3pVZCOTt5/m5ScsM3wgmD/+MLOXO7UsDfubCZkaWT",
+	"Wk2eT84VO//ygsHHKbNVtmbcsgefVFK5z59NeZ4bYe3DB1OmDeOKnf/yQ7ft+S8/XDoj1erBlDnNcpHp",
+	"XDC3Fmyht+yGF5Vg3M7Z1Vr4j7n/VVpmhKuMEjmM5D/O8ON8Mp1IAPBflTC7yXSi+EZMnjcrmU5sthYb",
+	"DkuipU0sgjF5/3468YAn18uzTFfKsbJaFDJj12JXT1Zyt47m8oNMJ0b8q5JG5JPnzlQinrrkzgkDfX89",
+	"n/3fj2dfvH332T/eT6YdmKaT7Wyl/R5MFtzKzM7P/fjvD33lZVnIjMMSZjJPL6ppwmQulJNLKczQwtrj",
+	"7VvfRiq5qTaT54/rJUnlxEqYgTWV5YXKxXby/uBnbq1wg+uBjyNWEsa41zXAoHtX0WqQcZetSy2VS6yE",
+	"4VdGn5NLiLrvW8RSmw133fYR+SHtPZk+efz+v9Wk+GT62adpYuTFShuu8lk97lf1uOzSH6QjGoavXQR8",
+	"pdVSriojLLtdC7cWBlmDEbbUygqmF/8lMsekZf/r8qcfgcv8IKzlK/GKZ9dMKGQJc3axZEo7Vhp9I3OR",
+	"T1kulrwqnGVOY88hfuHhijEpFNDCr5P/slpNppONXZU8u568nSb4SCE3MrGqH/gWKIqparMQhuklLCiA",
+	"QzxtCCAaMcG90iRJjHjyfujXDd/2wbsylcq4E3kEoDNcWZ5BC4Qyl7Ys+A5Ru+Hbfz6eesAt40XBSqFy",
+	"qVbMbZUdWgrMfW8LUWKbQDRcGvCFlXwlIjzP2c+WLhn86vS1UDV1sMUOP5VG3Ehd2brTwDpw6v33idGV",
+	"SjEqhh88mgd4FPW9Twb1Gkd8v/+bFdYOXhjMyk1V0HXhGx5mttGI+1bTx56VqwHx41KurnalYEtZOGEs",
+	"+6/KuvosVRYpcC2YLUUGkOUofAAdWLlS3FVGPH+jHsH/2IxdOq5ybnL4ZUM//VAVTl7KFfxU0E8v9Upm",
+	"l3I1QAw1rCmWYbHbhv6B8dJcw22TWH+p9XVVxgvK4mMJZHvxYohIacxhPKd59XktwiCp+LGuthcvJu9P",
+	"6eG29UYOADmIu5JDw2uxMwKg5dkS/9kukcr50vwxIUkHertyOZlO1osUfuE4+usDBbxzkufOG6Hmtf8M",
+	"XzOtnKCrORJ7zpD5P38XS3JGl8I4SYPyspwVOuPFzDrucKT/bsRy8nzy384aSfuMutuzaPKX0OsSO4Fw",
+	"YAQw4hkvyyPGeAXSO4p+A4wH+CJ+Yktt2O1aZmvm1tIyqWgn8UAD5yvEDVduPjmKs7yPz/evHohmK+jS",
+	"pq3oMJbBvWDUcCEsHgAvhD+wLckVMc4Q44yrnK0Kvah/+OS8LBvk4vfzsiRUTZlcMiFRvhBbaZ19iJjh",
+	"zUmL57l4MWffxmPfyqJgWhU7thDNo0Qu/T3i7xX/IADE4hqaER9YhjutzRx2LaDBWuHugxhRyl3rAq7k",
+	"g2QEjb/zbWMKhN9Hdf7bU1+M9mG6g1bMIxWpiX5pXs7skw5R9WkKewA1nXf7nkZRMMoeWrIXDYLvm67w",
+	"F+nExh4kkgiiiND89nBj+C5IdDOUzPoU9LMVRDwlX0mF0E7hgaDYhl/TfmjEOxCCsLXkT2SGg7Jb6daN",
+	"CFijft577/y9CTm15ww2nEtlGWeFtA4kItxMy9aiQAGY14qOmIpOIpoRtLBnETXMt4aXROb+CwlzUjFe",
+	"vwcJ1jve5CMv2STMzeeYBhCqk5n5QYabhMSiAqQNw5eFzq6/43Z9D4d/EcbqHwuchq0Fz4Vha27XiTPV",
+	"oe1mtDH0DQ2RZtkimmpeL/GlXtl7WGKhj+FqZfkVLwqYus/NOqvFgUcd5KJg0JiJjXTwIJcKT8BK3ghF",
+	"rGfOvuagSi1LlvGimDZ6El3OCnEjCqYNk0oJM2VuzV1z+HHk8FrCc2QF8EEnWLQar2NBDawRS23w4WwE",
+	"23C8nDbwRiqLdp+auVq+ER3ZCS9LXTlhWs+XixdhdeJGKORJ9dAIfr1GVEDEg8/Zef0JZ1aaFseNQMWP",
+	"VFlR5Q3+an7RAhpaN1etaqbQJkfFE3fwmzQs04aGoMvfTw5/CG6azkSdn5RGzPwQht8IY3kBq+ss6mFN",
+	"vvd1Og+czJw7Hp1MT4XpZx1xDuyHQqEwCW3LT/gHLxh8BgEHKKmhHolyCso09X7gnQ2oopmggRUO9ndD",
+	"ejwGyrWjoPyqmTzNZkadvK9Jdei30C+i3qGrrcztfW0TDja0V+0TQjqowI56YspephPNNQYBV7pkxD46",
+	"IBCnwNEIIXp779fal3qbgulLve1daXor7mUn9Jb+GMXsv9TbFx4ybQ5jHsceg3RYoOIbYfF2a5llYJZG",
+	"dX6+0OY0aaJnKmkMAozDqJEwNe0gCZtW5cyfzYS6nhp0BmK1jmm/ENAdPoWxFhYuHf8AWLCOR8DfAQvt",
+	"ge4bC3pTykLcA+mvk0Lcglvx6VN2+d35Z0+e/vb0s8+BJEujV4Zv2GLnhGWfeGUfs25XiIfJhxNKF+nR",
+	"P38WDDTtcVPjWF2ZTGx42R+KDD/0MKZmDNr1sdZGM666BnAURxRwtRHa2Wvq9346eSEW1epSOAeP4FdG",
+	"L++dG/ZmSEGHjV6VBgQL2zaSeWnpLIcmZ2LrDD8rsaVQOdI8rkNabq3YLO6FqIY2Pm9myZnHaC4OHopj",
+	"t6mZZhdvldmZ6j40H8IYbZJXcGm005kuZiDnSZ3QXbzyLZhvEbar7P5O0LJbbhnMjQa5SuUDKgqwtI2+",
+	"v2joq61qcLP3BqP1Jlbn5x2zL23kN6+QUpiZ2yqG1NnSnCyN3jDOcuyIssa3wpH8JTfi0vFN+dNyeT86",
+	"Uo0DJVQ8ciMszMSoBZOKWZFplduD2pxgnewg0081BmddbAWDlhuGyqPpcqcyVCPdx1ke1n550yOzO5VF",
+	"qjCAsRD5SpiDSLonldcQpgiKBzYBKWDqJX5Gi8ALUTj+jTZXjbj7rdFVee/svDvn2OVwvxhvc8ihb9Ao",
+	"S7UqREtSXwHs89Qa/5QFfVUrHWgNCD0S60u5WrvoffnK6A9whyZnSQGKH0i5VECfvorpR50D83GVvQfR",
+	"sxms4YhAtzEf5AtdOcaZ0rnAza9sWigd8CKCg5pVxgjlYjkX9RnSsoUA6sp4BasFA7NO3S9NxxnP6ITO",
+	"EDU2PWHjOkKtaLo1vxGMF0bwHJRHQjG9gEU3Xhe4SG5ZyY0LYp0Xicfy2xawpdGZsBYsWKQ2PghvaEf3",
+	"j9uDPFwNrqKehVnNltx8mBVc3xwE/lrsyAPSsk++/8U+/KsswmnHiwNbgG1SG9FV3/WXcgeY9hFxF6KY",
+	"lElbSCeBOY0vg0I4MYTsu2NvcPu7YPaI4AMh8EYYdKv5oEcrTPIBiLKG/wMfrA+yhKqcgRg4qH4AyRX2",
+	"W3Glg2x4YIZ6goJbNzt0pUCjeNEWlhpx8dQtggMPyJMvuXUoBjKpctTf0lWI82AfnGJypJMbTjn4GoNJ",
+	"fwkPsf60mVZWKFvZ+lVmq7LUxok8tTy0WQ/O9aPY1nPpZTR2/fRzmlVWHBp5CIHR+B6PtBLCHXe1hdrb",
+	"vPuLQ68DEF92x2K5BV+Do30wXoZWEeJjJ98BGKVt9oDITdoOvS20LgRHlal1uiyBQ7lZpep+Qxi8pNbn",
+	"7uembZ8kyQyEc7JcC4smJt/eQ35LSLdo61pzyzwcwT8BFV7kJ9eHGY71zEqVidm+84KPYGgVH5yTjntV",
+	"rgzPxSwXBd8lvC3oM6PPRxJGGBsJpNEfaCdmC7QmpmmkORPB//W0WTVOleDuP2qGX1gG5xyeUQ2p+d6n",
+	"T5oLnDbFNz2xPqhnQTCSdBDGQ2QRPSVGxLv/RjsgK2pEq/G30h3XMoC9etYPgkAcd9YoArqz/29h/dyh",
+	"zf3OvxN2aOHN1Pe17AH1P97trQuzc5V1bpvkFTHIlw8wxiEeNGCLeMWNk5ks8bn6vdjd++u9O0HSV4Ll",
+	"wnEJeuXoA73ky7g/I1/k7pinveZHqVv74Pf0rYnlBM+sNvDXYodqk1cUYRFpq+5DHZEYlUmLpkgANLjO",
+	"w4snbiK2PHPFjnEUOHbsVhjBbLUgr5W+Cc3pchYPkI7hGp7RG+ST5vC9HgKXOFS0vJTnIb229sN31Xly",
+	"tdDhX1ml1kVC/9k98T1kJCEY5S7ESg27LnlR7Jirw3gCJbWA9BdEsQvg+mspRjOugP1vXbGMK3zhVk7U",
+	"Qpo2KPlAX5xB2mhO76raYEgUYiPoNY9fHj3qLvzRI7/n0rKluCWXG4UNu+h49AhVca+0da3DdQ/abjhu",
+	"F4lLB22VcMn6V1uXpxx2cvMjj9nJV53Bw6R4pjCMJiz/zgygczK3Y9Ye08g4Bz+3Hbnyq7ZLWG/duO+X",
+	"FH50H4ZKccOLmb4RxshcHOTkl3Xc09c3vPip7vZ+OhFbkQGNZmKWYdTiyLHEFfShQEcYRyrpZAgcGQuQ",
+	"uKBel9TpwEu78VuWm43IJXei2LHSiEzkZDiRNgrxmjMclmVrrlb4AjK6WnlXZxoHGX5lSRMGVsvuEMeK",
+	"Ym6rZmjCsMmwOTRbhuhPEMIEh5dt1/5Bj7VbXoMi8taVMXJ7uvagpMl0Ohl8+AO+b5qHP+GtHcJ6qjGx",
+	"JR9GSGugGWk9Q3yCrNRHYryNzeGDFzxF9H1YEyPsQa0ACuyAJqasABTC2YU62vLal5N6oeYWjn0Vf6Tx",
+	"+dIJw6Q7ml73hUsCkE10ZHcNSWN+sO+mByOTVGwEZm4/pqaRhZihWI9fRamz9XykniBpm522wzobwEfx",
+	"+rXwxkykvH5QKdEbtPgwVsFm6BR4/YmjIITm41AcAui3it09COU0EDOiNMIC/C21s6Wvesl+kJnR58VK",
+	"1zKW3VknNn1jIXX9beDUvT5F46JVIZWYbbQSCRXST/j1B/w4Ws1NYt/AiCiAHzVg96HdQkJnAe3Jx9Dy",
+	"XTcJSaZ713Qt6/Ybbe7Lq4MGHP2GHeEpcdCNyE95qj8HuNj3XSBI3dXn/9M6CEEaxq3VmUR+f5HbKZ1W",
+	"7zVBYRQd9L+qQ/Hu4QB3x+3Y+qOwPzIciaJknGWFRLOSVtaZKnNvFEfNcrTUhHNqUEYNmyG+Ck3Sdo+E",
+	"WcIP9UZxdEyu9c3Ju2spEnrPb4QI1ghbrVbCus6DfinEG+VbScUqJR3OtYHjMqPzUgqDHqJzagnxJ0ug",
+	"CafZH8Jotqhc+4m7qaxj1oFRgxwPYBqml28Ud6wQ3Dr2gwQ3OBgu+C2FI6uEu9XmusbCfDzjWgklrLSz",
+	"tGftt/QVg5g8TtY+oAn+9p2Dh32TG2UCa28lbfl/PvmfzyFZC5/98Xj2xf9x9vbds/cPH/V+fPr+n//8",
+	"f9s/ffr+nw//539PbV+AXeaDkF+88Dqhixf48I/ikrqw/xUMgBupZkmijB3YOrTIPsF8MZ7gHrb1zG4t",
+	"3ihwWXQaUlHJnLt7JJ/uNdU70HTEOlTW2riO2jgg4Mjn9x1YFUtwqg5//SDyXHeCvQ5e8ZZ3Ylo8Z7T3",
+	"DqAfOAVXd86UG/eDb7++YmeeEOwDJBY/dJTKIvFipg9trzLYpTiQ8I16o16IJeoftHr+RuXc8TM6TWeV",
+	"FeZLXnCViflKs+chCPcFd/yN6l1DgwnUoiD6KINailPwTXotb978CnrdN2/e9vxe+rKVnyrmov6c9dWy",
+	"YcoZyA26cjOfxGhmxC03KdtbyCtDG0W998JBMomuSGnqx2d+/PlYKMvSdpOL9FFUlgWgKCJV6/NjwLYy",
+	"63QdqChtHesNNPCj9k5Mht8GFUtlhWW/b3j5q1TuLZu9qR4//lSwVkqN3z0PBLrdlWK0omUw+UlXv4IL",
+	"J7kcgxhmJV+lbHRv3vzqBC+RQlDg2OD7sigYdotxUkee4FDNAgI+jtkSguzoOHJc7iX1Cmnt0ovCT7ip",
+	"7Vj9O+1glIXh5A08kMmBV249A46QXJWFYxD2yvMNxldcKhs8Vqxc4QPArnUFSwZVpMiufWY3sSndbtrq",
+	"rpetuzgwHGlRR+mDUZcS8JdxBQNWZR60QVztunmVLAXf4KCvxbXYXWnqPh+ZHS/Kxhil9LFDRxdpN7pr",
+	"gXzjg+zH6G6+9/MLMck+/Q3G+QayeF7TRegzfLRJALiHY50iilZemSFEcJNABHYYQsEJC4Xx7kT6qeVJ",
+	"lQnl5I2YiUKu5KJIsOn/7NvRAqxAlUZkQt4EbV89oAXTmnSWLeg69i8mw9VKMI6OM6W2vED94DzpWILS",
+	"4Vpw4xaCu732ARWnNQnQQX92CyeLlCaY3lVsYb+lQyWIErci929vauMd1+cnue/RmkR+IqihexOUPz/l",
+	"EeERnsjnGO77ek/q94L3h4yp82pdf98ADldG38JuAoA6pC7FhELRPVVZvhJjr6OWaXJkCpaWxREHOST9",
+	"JOUd8FdoizU9GWPkIqj7DPCS5A4CvgB7QLNTx6U2zE0ma2/F+glSD3ikLgoUqGuHZCIdblp2XbU6Dtg0",
+	"GxNGNcJqAKyNtfjor7kNRz+fRhz9RGnxz0ldtC9p40Xk7cldPyVjuKa7rH1K+pyFYFpBj5C6MeRrDEka",
+	"J9OjEi5OJ8SZknunFUrRuSjEinBCjQOdNfnAmt0EOH5aLpHpzVKOo5EyMpJM/BwCHmKPGCONORs9QuoU",
+	"RGCjJwcOzH7U8WFXq2OAVD6fGQ9j490V/V+k7VkU/QFSsi7h1pcDVtIssBSfTqUReTou9TgMk2rKgJPe",
+	"8EIoFwKdm0F6uQHx7dPJBOh9iR4OvYlGHjS/RpROjlol9jhpfbHgHZaRfhUctYaF3s4oEj/5tFpsF3Am",
+	"kvEx0Ct5eClT4wOLWd3Bhw1vOAqoOBq6YcgCYA1ImHkP8IP9hsRGAu84QPYL8ilqtuyTWqxuyG5Ikj0N",
+	"mAFxeojsPolSNt4TSB0FZpMG32t0DupZ2tJWXxJprttpY4UOYZEpVjN0OJM7OYDRvvK0nVvxuya95nAy",
+	"Pt/o4ySV7Cvl7pIHlDojIPaoNKBdcmgBsQerr7pCbBKtrVYdvEZYS7EkJlXC2NVHmxWFQE3ArCVXz67F",
+	"Lq3QECgzXIZukZ4Td4+r3cPI+9KIlbRONMaF4FT18W0/qE6Ex5ZeDq/OlWYJ63utdS1oYEeGHVvL/Ogr",
+	"wFCJpTTgJw+WmeQSoNE3FjVp30DTtCDc2mwmLZl6jpaDESIIHsxlUaVJ2YP0/QuA6Mf65rLVAi9Kqci7",
+	"bYGlIJIO4UfYJhEeCiTYi6CXhKCX/GPgZ9zBgqYAkwHKa0//NzliHV64j7MkaDlFTP0NHUTpHl4b5W7o",
+	"M9pIiI7cLub7bD69c5mHsQ96Y4UMEkNCBI2UXEuUgTPtSahXKwjBo8RaPgiZqzoFI+OFVqsmdyX8vidd",
+	"5RxqAVif9HFPvkgfDiGGgiFa5XSwKkwS+qgZQd5Ec2KuS5xkJRRlCpocX2+n0KsDgRjYItKMflze3gvT",
+	"SLqqX3Xc0xsfctrDerNxewrBc/+ssiKsb/+h7W+XR910yMm9lZJ4/wHDAZHipLORANMjmgHOzctS5tuO",
+	"4Y9GnZ9AEiPFvX7lgQ7OkC35wQ7gp+3IfqBW1QPLvLu8N3ac4TP/DB6Z5D/vPcDhbPDMZ7fIK4PWpJZ3",
+	"er9+Q/3QHLn273+5dNrwlfAWwRmBdKchcDnHoCEqgWCZk+SQn8vlUsSWMHuKFacFXM/ekY8g7AES7JvL",
+	"6rflXvrsE9kB2mpWcBihaXpKUMqQz8VV3x7p28a6tfqyiTbuBKNiMoHF92I3+wVL5ZVcGtv4pnoDYfta",
+	"P4Imbjbfix2OfNDlEwA7sCuoinstkEJT1pX6k42y0j+wMcboDdzawiN26jy9S/e0Nb50y/DRaG6oeEWd",
+	"pXy4Y9O4yACkY/bqMu11AmdLtLelS+iHtmgoeiLqFD9B4qkkem+ccsnVmV0OepcJXgTCx8VO3k8nd/P3",
+	"SN2TfsQDO/GqvpqTu4DemGT/bzl9HbkhvITKGbyYeT+ZIaHD6BsvdGDz4Fbzkd9X6VNx9fX5y1cefHA8",
+	"KAQ3s1rVMbgqbFf+bVZFJV/2X0OU/t/rdkkVFm1+naI99qS5xVT/HW1ar7ZS4zfVjBc8a5ZpT/GDfNO7",
+	"eNES97h6ibL29Gos0ti549zFb7gsguE3QDtWy07LHVfNK8kn4gHu7CQWef/deazBOAHQuATMNvYUcpSq",
+	"SzAkfOnsiZ7OPV6TPqsNrR/gkLjOnzBzbvrdpXxeXWSM3uGM37sc+I02rYvKR9EmHdY+nIAIjwnCY9oo",
+	"f+Wt8D2xcM5IhPx99TuTlj16FB/8R4+m7PfCf4gAxN8X/nd8Rz161Aea7t40y0JNnuIb8bCOixjciI+r",
+	"hlDidpy4cH6zqWVkPUyGNYWS51lA963H3q2RHp+5/wUs7fDTfIyqIt50QncMzJgTdDkUlVg7P2+onK1l",
+	"WnWYBUVlA2nh1eMrxpCdvX+EVLVBu/PMFjJLO/2ohQWWpMilFxozbDzahgxzVHLAr1xVMhodmtmTTJ6d",
+	"hUSzJhFuk5mnG/wutGcBlZL/qlqxxHATdy7n8BTCUXsCdlq/6AfuVs2enFLw+u4mwqBV26cw2mtyfVGb",
+	"AQMiUnXNjox3iGfsMf89sQqeosL1iYFta+86fJCy9r7z9hdB92bgwD69xXX4geRrsNJmvhiz09LOlkb/",
+	"IdKyAxoJE6liPCD4YMPeKR/VLiOrPQeagu3N7IcIZLxuYYhU7qxLCIuuqzSecoWn+cRxG32k0iDa72G1",
+	"gU2ns59O4kOehps+snYgzQAzwwMbuYVj7ajg7sYVnVDKo9KKPEuf86iFPaPxm3PuYe7uelbw2wXPrtPv",
+	"RYAp2v6WY57TLHQOG2TrVCA0O4tiGeq2kpJLlsI01qN+au4T33407ehXX/PIg46t592UfFUKqxPDVOqW",
+	"KyeCLwtxQN/bCvLDgF632mBCWZv2IcxFJjdJZfibN7/mWd/zK5crSSX1Kyt8Zg/yisSBGGWtRSry1ezr",
+	"3DceNRdL9njanNmwG7m8kRZc+rHFE2qx4BYv6Nonou4CyxPKrS02fzqi+bpSuRG5W1tCrNWsfp+j6Fl7",
+	"wi6EuxVCscfY7skX7BN0GLbyRjxMXzBeWJs8f/LFdF/leMT4kleF28fkc+TyIZAhTdnoVU1jAFv1o6Yj",
+	"E5ZGiD/E8H2y53xR1zGnC1v6K+jw6dpwxQEhKZg2B2Civri/6MrRwYvCRrmwzuhdO+tMNL9wHDjWQDQ5",
+	"MEQCg2V6s5Fu4z1Frd4AhTW172nSMBzlziH6qOEKH9EFu0y88f+E5xbfpOmBo1f9j2hvj9E6ZZwyBBey",
+	"ib8IFZHZRciEjnUI6/KDhBuYC5aO8ipsIZa8ksqh1qhyy9k/4PlueAYMcT4E7mzx+bNEPb92ySt1HOAf",
+	"He9GWGFu0qg3A2QfpBzfF4Lo1Wwjgfk/bFI6RKdy0Fc8Oa0bcjseGPrO0jWMOxskwKpFgDzi5nciRbVn",
+	"wDsSZ72eoyj06JV9dFqtTJpgeAU79PPrl14S2WiTqqzSMAAvlRjhjBQ3Ih/cJBjzjnthilG7cBfo/1zv",
+	"tiCWRqJbON3Jx0JkVU680+q0SiDp//JDU48BjdsUt9vRXmqT0NN6jeNHdks9Tl/YtaGTOyB+G8DcaLTh",
+	"KH2sDIR74M9Nnz/D36sLEu15S1X65Hdm4B2Psv6jRwg0aEyp6e9P25+JvT96NN5lNq0vhF8TqDntruns",
+	"OPZNbTUUxn3+bqBqbO035lOV9Lc5F8hUGv11NzWKoAfZkvGFRERg8kzMEkICIyjeCa9+LMr57OP4MLEW",
+	"FOScs6uqLATV1EbPkKASkabOBDwlBa7/zA29iiLeB+vxb0k/FD0VpfHFVFF+nbNztcNZCbIpE5uFyD1s",
+	"0s47GwsZW+av+e0PvsBq9HVmr2U5076O9QxfiMJMnjtTiUFZNKAFvk47S/j4Ytv9hHse7cWdJqgWxXRx",
+	"8ydfT7iZTQDRMHttF3tOnr68/h6FoHD2pd72z2CaiDq3fqCnvwCKBlAyUqmKK+kVs046mhz0korIFkZd",
+	"CHDXtq16daOdfv5GuwCome7Zi0oW+S+NEb9zsRuusnXSJ38BHX+jV1TUIFIAgalaiSLZm5QNvwWlREJt",
+	"8l96YNiNVOlPnYV72DuQNmC1gQhThvEBV9IVMEGMonY+szpDTLHSOcN5mkJDDWucTxKI75dl7tETDbup",
+	"nHfqxtwTvv7PUhbw14A7AbacGe4GuKrxWXPrEcWNAHMlXtI0ujCMyw1KPZZDbTo8hDfC8BV21Up0umPC",
+	"Oxw5qiLEbAmfsCXmztHMVUZB5dloGUI5aUSxm7KSW0uDPIZliS3OPXn+5PHjx+NstIivEWsnvIaF/9Qs",
+	"7skZNqEvvlAf1Tc5CvxToH/fUN0xm98nLl8t+V+VsC7FYvEDxbNDZ7zXqVJyXdV7zr7F9G5A6K2KHgBN",
+	"kx27lVK1KgvN8ynmcAcXM0azUh8jEHVYqXkF8HeOSNJGNj7FbEhfN5D6a/w4+zMPUZrs2Z4c2y+xRVP6",
+	"WXacx1C1GmNnzl6QVrv2i6JJGFYCMBuRR9m6SYuCxAF/OMezNTTQ88lejfxA8a7xFccDB2ysbVHY8E34",
+	"SIK106HoONUcnzINKv5bCUmw19yJG9HOdxnACPaMkP+yvVpTKUWEMz9Ceq2r2R27CwE4HLd2T0lC1tmH",
+	"O5tOm0QoujKZOLY2+yX2Soc9dQq9d9xGqMLNNtTImbMfvK0o40ormWFtmJQIDu+ikVbpEWV00uZiO/Fn",
+	"OXEMk+Xl6/h+j8XBgvPTSQtxfZ+Q6CvsNxEO/ddhBQF4xK6Es54HinyK+j1ZCG/flMoKX68Q6CvmqNok",
+	"POeSUUW1B849evRPJ5iMbkBV/Q18+9GbNuDssmtJBQI8Uv1LkOyThZXopqCYdGylhfWrbYfV2V+hz/xq",
+	"qxCEt/OXeiWzS7nCMciTE5BCTtT9oc6DS7V3YYa2X0FbX2qk/rnlkUiThnW/TbIQW+9/7xOUxxhCf8p1",
+	"LvghRcitx49H20OMeyMl8F4GMoRiFMw6UeJ93iMbYUzq4fk1lbAAesMWjAKfU0gppEqA8VKqYC9PpxHL",
+	"kncJbgye5oF+NjPcZesWkzrkLz0QTYQ5CbLr+xiqs8GIElxjmGN4G6+2yld9GWArdYPmdcHVjoVDAdQd",
+	"CSUQpVz7pqMw1Vbrg3TmhTHytaZAZS/epdkKsPVZiGxuoetgHG3dHYsXHXtPDSVrXVT5SjhI+5lK2/cl",
+	"fmX4NcRjQgGlqq7ZV4fptrPd96nNT5RpZavNnrlCgztOl0vLrRWbRZHwXH5RfxR5vcNAaWAig39TBeuG",
+	"d8bHDBwdPB8CBPLjSjz0kwGkpGeg6ZmVq9l4TOCdcnd0NFOfRuhN/3ul9BA3/5cIi+9wuXiPUvzta7g4",
+	"4iznvRAJulrqJOQYjhAU5PT8rBPhdmwNnIi2N6ffvMSWdYAPDZOA3/BiIGFFbPSi+zUYLNJpK7LBrCzc",
+	"+eR/jrOGJ4xRYQynTyMH9o5hrW8dHnJRJw/1D2l78vjYi/RhQ+33LbMsOQ02DGXQHHuaxbQhgmNNpr6S",
+	"RV9fyotCZ6M5gx/mHDoNZzrWm40vHJBwarzZ6Dw+C7EznBBpxibz5M/+YZv8hk+r5Bdzmx6tpR+piWZs",
+	"0jdEo1/ClOJaA3gBGJq6WzPMK888Ztk3shDBqDgZ3shoB/pb6jOPJ1XYQxtTB/p1yWOlW/jYwwO0KtL6",
+	"bzugUsfUWunT4IuJJz98Y91YkCjN1DGtX44dvEcAK01FtVJlR/rJfSbNdgTkR9TQbC9xlJg6UlTRLVaV",
+	"ePtgi4g1eXVJb7QBBUhLRhpTGytVhsm/FIIGli4an86PalP1ylr1GOiLMcJhDx/vp5OL/CjxKVXKa0Kj",
+	"pBjsS7lauy9B4/2d4LkwVI4l9ZykYiwbsNkbu5Ylvn9KbWVTvruAwXwe9DUONx8b2YS1F+FTnWOhN1bw",
+	"P78RmcNy7o0XrRFivJtImV4iQBAMitjkT/CkMULkonTrvcIS+caXbt1U+RU+cA8srsKbLm6EmjI5F/Nu",
+	"rF/e5NRiheDLoIQ1WrsRZbDrqC9EYwx0ir56JdX3i4G9lHlRRkiqfD0fX8PmvA6poDhVdJEJibc6WShG",
+	"R7svlyLDegF7sxf+51qoKJ3dNKjuEJZllMxQ1tGWWPHiXjXaDawFPxHUgn8USIfyiVyL3QPLWjSULOBd",
+	"ByifkkAfkUN23FCTYci04f1Kpa3pCREUwgiou2hKVJ1SQyFK7nkiGIHGGY8Tfp4GTZBoTgADuh456WA2",
+	"QRRMh5IjvqK8w9FVPvxSfiEcl4X1Prm8ztYf65NANd6tnn7rs/1jnsraWhjy/gsbfgv5bWmWQl6LuGox",
+	"2mYhJXJocS9ZBrEZk2mgl/XMsokr63v5HOuXQwGeWaFBAJoNxdW2A71qD+gHllzVm5xvCPVSGCPy2iZY",
+	"aCtmTgfPwiNypxJw+7Bn0Un/JLx1AiKOiLimFQ2WoHjd1OHAapocS05w77sfY4UZseEAvYlqY6TVoId2",
+	"6Cv6HlKyhOqI+9WrQ3ivz8XhgvYhclHaHubj07VkXjg4mnu18ricoJmVSgkzC0bcbmUM1c4yimmp8yoj",
+	"USU+m7X2enTWtj3cLKnUzPqr7DyhoqQm12J3Rmofn96k3vEYaJIhCfQoH3eHKO5VV21TcK/uBbw/N/tp",
+	"qXUxG7AMXvTLeXQPw7UEby4Gl1UI7AEp+EH72MAk7BM0SNU+I7frXShWUZZCifzhnLFzRcGVwX2kXcC1",
+	"M7l64PbNv8VZ84oK9HgN9PyNSkepYaEcc0fuF4bZw/OGeJMVKr/z/DTICbO7rRrykbvFijrtMsvzseqN",
+	"vn9HR4SKyI+gSAlQl2QI/gpZQuIdxTC5TZSFCf0DOPMGZGYLnQhiOCkBDwyVxlQ8GQLkhBrxXG2g8IMn",
+	"EeCd7Dy3+ulGGCPzBCrCF0qrboPHdJ3r0ifeHpG4dujRuicdqdNM+/lPzCw1mKa2V4Cnvi7IL1W4KVqT",
+	"1KoFkVRwRSshvI/SqCuhRnZZUuqvgO2UzTuuQjGUnKKJJXeaGVEWPKNSd057yS0IeXGFJO3q4j3Hgx5l",
+	"LdkH/mAlugt0ar2R6L3kQe4WGfGdp22W9AEMSZ5G9h6M7l71fWWEsyzUQRjIzsY6Kdb654R9jxQH1xY3",
+	"AjdpIxR8Ejm7FqL0YUXBYbApTJTw4MoPRSqclIV0KINvbE2jI/NBEvX6lU0HM/bupdE9DK3JqxOK34zg",
+	"YgOvih//BnmUTsyYFHJonJwiqcmM5LG3bxO/1NvhvXsd8w0fS0hXEkbE9PZvStwQIXZtxn3K6RmO85nf",
+	"W6DPvpi9lH5+2D59TMAbKIA0pRrxWWD0NpT+c6MmHjq0g9FBYcMPpNX3n0PieL1kRjTeoadm0PdJ6ekZ",
+	"aYeMM92Z61nab7OlNiKeESNdqNJGnZoAOBvDPxbSGW52p+S5b6MqxTcHsXwwXqMO1WgW0oRr9HFYFPp2",
+	"hg+rWV0eMyWtQDvbVhyEQvNNP+Y0plyqAz+49fLLjq15zjJtjMjiHukcPQTVRhsxg4oqyQx8L+XSWVbI",
+	"jXSWofC3YrqEU0CVbNMUNDRXpYC+81lNk4MoINqBlfo+ER2PnBLe/+QgNkON0Wqs8HYFfSj/WJO/mBY9",
+	"IyfFAc4nrM9X7DFEjfvwIuFQSs2uWTitpFvKLdKNMDYpKjoDTMq3wNFbJFSLSxtpLYFS09KtLApM/yW3",
+	"DT8QtUdyGrWlLhFT+zayBosSXnY3sWYOi90AKghsW2WZELkPQLfhKUzPbWz3wDITAlV9DPq0zqdphH8J",
+	"+jGl9cFPwMVfEwe2bIA604sfUF22JPZ2HjzswUojMlEnD4wZ4GWcT5i5tdHVah1Vt6o3KVhOTOXtKvEo",
+	"P9sKQ0IwwQlM8YxttHXeKkEjNfvdROB8kmnljC6Kth2V1Kwr73P3A9+eZ5l7qfU15LN7+D+wjSctlLuD",
+	"LWktrdNm1x0W1/gdfUMdrJ3WNpZbIa7xqiIQtWLcZGsolupnabKNPcTkKJigLvC/OuWzLuAO8YOURnsC",
+	"sxIIA7mjdRzjPgBgknLwKlfa1TuWT8NU3RCwBmOmkwl9ZCFl1MGGB/rop2TrWWVD+ANSsz1cNYnaMdeg",
+	"6+i3rL/yeo4whx4iEZhvD1+1h/1szlMsor2u9q2b1s2fK8ad3sgszXz/XtFYgzFUA9Qz5D5FR9dpVlJt",
+	"QsWcLuuikM3FRezIy3DhlvAczdBGzlk9HbEijl4GXaa3N8p06AkapSjzyg/gIm0VT7fgflxI6Xg9Tkff",
+	"NxB1ERWI2gd6BFWcfN1+EM3YQHnlFkTwbAnvwqOBiJ+eRwnXsXyVOp7Uwyc6xWYoqcSSdh3ygfJdn5iE",
+	"AkadGJ35i9y7vqOs5PWWsjcuWwruenNHUn5CMqKI/REzI4iUZA/kEfjLi42Zti6E/tc63lCTIZy65OON",
+	"XTiWa0HKS88nsHd3YfSCICzl6ZV4dfosG1T6H15QSyVfyzd6RRoSDEHoQjZSuMdIr7vBBiPcO1BO3Amo",
+	"XuxpDeAnxDGmxM9IwMXjS98fNvUlTgL+wHltXc1DIXSX0T2BTeqszwP3bbpa3954syvMGLkYG3Vmg0/o",
+	"yIdWBMBwHFoLhlHRaMeCseQQrDzjbuCZgR4P08g46zVt0ejSvxBwFpZxejqAcyGXRWWEz0JMmhbTdh4t",
+	"uVsH4Rea9/2fQCnnM3n9IYxG/Vg+jZwXfWqwrv1Yl7NC3IhWeB7RMr7zrJU3IvS1dWeWC1Gif2/XrSIV",
+	"dxbhsXsn+rXPosilMdhNGt8JsbRT7IBlPaV4rN/QB4GKsrn1H97oiGkqUb94CS7/bIZNWVSOSWcTb/BM",
+	"V0WOd8WieVuTYaweqGYr4XvUf4k+bOjlFymj4DU2Z19vywK94m/Xu/m+9ecDPjR3WjUpFfvbVa+mjwzp",
+	"IoUlMhZ/AoJgOgV2JuQKTVfx/6wVPq8Kylxw8AHf9eGfs/PwZ5y9HXuQxjgUL0YdB5AMTO9NkkHHUQ/H",
+	"Mq6UJokXNBfNGubt7DA0F94POVvzGxFS7jXaHyM2+iakRWjwePGCFCUg/VWuY7rd/zACayXPd7WOcqWo",
+	"+wd6D9FrmK4bO/ZKgpN9I/OKt/iQPVYAbntgwZWYAK+nVJoFKhs7zc80wuswwHnon3pwB0y8HXefH32V",
+	"p1G37yI/GM9d2aHbU6XDueP8+bV7Lc6W19EAdFU0Z8aW/FYN+4L1rw7UHkfS/IidklpFqP16K7JXvn9L",
+	"G33yaPhu8upgkXuF8ICDSPAIARZAVjzSlaxUwqcSGLjSDV9Ax7KgzGxKE4UfaGJsJJU3Npzgb9JEcd+d",
+	"UhgOxmynYkhyZ5tjcjdPyz/lZO892IPjpWjECp9QbY95MJyWSB9/62UGs0GNF14rXrr0ggxJHDQQ6NXR",
+	"g6b1Sn0hglc9UV9w9KUVhVIbqFIidE+H7+w6TwfEnmiD/yjt2L8qXsjlDvkWgR+6MbvmQELejZ9iWXz0",
+	"O0y8/9kzDYAFY5QOU9G65dgxo+F2MEoENAjY3iKKtSeuRbwNGKZD/DhzwIhttUDDDojSne3sY8EvPuQY",
+	"3/A8tgVgtaRdizvoSAz5H03ysHiqUMQEvQ3ysHmWbzoeo/hIqYnLrcXmGDXgVUQCoVVEtLUNKD/Bonwk",
+	"60rpAIdc51pgD+gl72sZIw3jnULde9L0jVrKfe/C/WTSOtZTsLW4jtfgR9idZJmzoWWMAf8vtCst16mR",
+	"eup4PdjkY+xCKx1yAlZyBVjo7cyIpT0UzoStAfgGYFubcKWCV6Al9ezFT16d1FTxkgpeoRQ7XTu316Pk",
+	"YilVw2qlKiuXeO6iLUTtIoTFHhWI1gEP6SEZA0TRG17ssShdoRs8RgN0Kk0HLxLfN6m/9nvYH0DaRjOD",
+	"We0aH4W4GVz/uVwuhaEIZuu4yrnJ4+ZSsUwYxyUEMezs6e46jYvDAYcdHslC7ZytkesOkjYBUuyil/Md",
+	"nGlqAPk9etWM8Ia5WgtP/W1PmFqvMuDx0YPhb+ENs+FbcKDC3GsDB8IXa0P3KWzGtELTMUl349Yd5rHy",
+	"D7F/GizH4BmR0zjruCnu2bHHK3WXVREcERJePNL0/HeOtFBIrX5COsMX8s9Kur1sicwi3Ux9FAxPXCOy",
+	"bNcZPIiS+8yizNKTle0Ei0GODo7p4WCIiMKSUfM9U9wAiWEIjs/MGdvdjjDstqJ8EtefV6LMULli9+To",
+	"aMyWiGvrtW69oMiuVoaQMvUJMI9U7pNJMFyaA+BRPIBnRO1p6xguGOcY1/z9KS9npS5n2ZjwZ+89TAAE",
+	"SNswDtBHZHccWHcdmmVrd6mYGtvBGEd6JQyX6j/kvlJm+/QZUqtXX70aMqjjHVNz5prg/F5yhxy2cwj7",
+	"pzfTdmBfqDZdbJLHGbRtTZvekLV0B6VDzJYVgwz6tAOM4EheM0wznX1ALHiwpyN2habbuzUJX4ZQqyBA",
+	"275X77gvIn1Nx5uSLLt5+d35Z0+e/vb0s88ZNGC5XIlmTA/qx8/0U2Z21FbHz6KaeDAizD8wUMtmfKal",
+	"Y8wbrdOXYm7VwujKSTX4CGgaRECC9DMAIRXf6x3YI4G+rKcdBH6A+qn4CGB+P/lfClS2nec3XGUjQgUw",
+	"Q4UPq8a8LyiB89ipyNKQ/XNA3qyHeAm1opluKCaQO/L+hXoXR0VBZ3rEhL4ZzAgGRIqBgaVF5RGW2kDE",
+	"9JTZEkyC+O7Dhkrceojn7Gt4COB/SBoOg/WGQdWkX9PTzwIAB1eWjmj0WB21zWP2F/2Kj9nRfZkvKI7c",
+	"+iIOGEUMQmkcdMS0Qb+LaeyfbEJxBUy/xH6KvaEBt+iMGZzIvXEZHb3BG9r2/bf7HtsP6RxbB6+kDPbT",
+	"khaXXbXH8mKUawrjNjXLuGUQBsa4ba0URRyh/iyv6v2viz5PSe6fN/FrvOUa9kcWqugq6Vx1w8/GlLQQ",
+	"jYvJUJCfDuR2OUW4QXBC3qI6WqHFy8UuTMtei6wy6LVDi+dGeN6dMw0POthycSPMDhPv+nb3It2Qo5Ff",
+	"g1524Bwj9SDip4H/HxR7Boy7Y8Wffa6ceDDaTlHesBV2IB4k8ZKSinFm6s245btkJB/Waph5AI43b3cE",
+	"P0wfJLhpUpLd17C4wjDQgBM/qnn1kh3aoMa7LYVLUI8m8BmSx5wggAz7AAznMb8zyt4fQ7lXwQ0hpbVr",
+	"O4/qJR5YwJx3x0BKrc3tPTptO17UupYTqPMUeT0M0rwx/D4CCB3PgI8r0PeW59Kb4DfWIy74wIcEs/Wm",
+	"eP5CSqsopUJr9ScQb1ePlqDa1JE/Za9wnCYB4V9ru1KLvPcdS6Hgw+8ZxOQtfC2WAc1uwvU1tVuR8yuw",
+	"0VIYK60TynV816Vr0p/ZNTqQYHnzG2FqOSG6GJnYSjcQfJlayFD2LORn8Il511omyCkVeBX56O5bl7fF",
+	"kQ8HGgaCBF27roIYnYKo557qXWNQYosSYtXMllJjpQiRbsIB0ht1DSJh9C/BBKf/4Hdh7cE2fBOewkka",
+	"56+/DP9IlCi5N65RL/dD8IqkILEn//p5L2KlLs8xCrR+KYoEeSAAA5nHW+mho3S2URF1Q35k+JrZ1MXC",
+	"2+LHD433/cEckAhJ6HAAvDhreNOuTlvowfmTS2g3FdSjpbwdooTW8g8lIg+st75Ioi3y5kDnhCW2pPti",
+	"YZR63n5VZ3QfMO70Er8brR3GkxdFImE82erxTMWEg7Xib3jx8bnGN9JYd474EPnrYUVRnCA8RjKh0t57",
+	"6cuXfBRYBf+4UMEr6Eao/xSws8nb0c/incV7dyCa/XlBWU3qx/mNUOwWx6R4kSefs4WkxEmlEZm0XSf0",
+	"2yDS1JmthQGvS5wCSlJ2smzfOcvVL9rd4TgsQywW+zFypKy9wz3MzVH/k5nTAAdInpYUqfYIJYG/FK+D",
+	"EoTD9ZFa1851K71bP8Eds04bcc9Fk6ISiUcWTYpXhiUsRy8P14GXV2VFf52jb/0WbhMXfrO2sVXB+sgd",
+	"Lt3lFmNKd9EPqe5YTYwQAo3mDEFlvz/5nTxZ8DQ9eoQTPHo09U1/f9r+DMf50aPxppk/sZQYodKP4SFJ",
+	"ElYjch+qE9OJVY0qIrR3EcT99E6gHhvScOklPQqWlaLxAhv2cXaerevltPZU16iVf87eqEfMrnl4W/j/",
+	"Pv3s88l0IlS1gcU33yfTif/6NvVSy7fJDM5NyZpefK43mj2wrOS7MWnjDxapSeK3qcnz8UUa6+Qi/ab7",
+	"DvYMH64+gvBCIatH9kI3qK9U8+9SO3uJoXNY6xNDJNkU4qm34lBNnl+GCtBTkfVQYT7yoEtw30oWBwOh",
+	"voRGYTbIyU/lwH4DKH9bfP7s42dnDxAMVObzS79LwS1CTGKtrcmjqaLyaR5Vjaag5cMVb04/Qfh7su5X",
+	"RrrdJeA/qN3lb9epskvf1oWQfHWt2svay75OXwsV4oiaskmVDdL1t5oXKH2S87cSzGldQGg435SF98Vj",
+	"/3yw+A/x6T+e5Y8/ffIfi388/uxxJp599sXjx/yLZ/zJF58+EU//8dmzx+LJ8vMvFk/zp8+eLp49ffb5",
+	"Z19knz57snj2+Rf/8QAoHUAmQEMWzeeT/2t2Xqz07PzVxewKgG1wwksJtabev0cN21KTy5FyPMMrVmy4",
+	"LCbPw0//Z7go55neNMOHX+FGNNB87Vxpn5+d3d7ezuMuZyusNjJzusrWZ2Ge99MOxs9fXdTZZcgiiDva",
+	"uO7NJw0pnOO3119fXrHzVxfzhmAmzyeP54/nT2B8XQrFSzl5PvkUf8LTs8Z9P8N61WdWOHgN2bM6ReL7",
+	"ae9bCeYp/2lVF9yE/60FL9za/2cjnJFZ+IRh4P5ve8tXK2HmGPtNP908PQtvj7N3PsP0+33fzuKIo7N3",
+	"rTI4+YGeIWbmUJOzdyH77v4BY/XomY9ljDqMBHRfs7OF3h7RVMSrG14KeR+dvcM3+uDvZ/6+Tn9ENQqd",
+	"tLMghAy0pKod6Y8tFL5zW1jI/uGgTTRexl22rsqzd/gHHppoRVQx+8xt1Rl6np+9k3n/cw8R7d+b7nEL",
+	"LPQagNPLpRXuwOezd/RvNJHYlsJIeHvyovmV6kee2aosi13/553yHhKFSBXd+llZQTo26sCgQ5OssOYj",
+	"F3lofLlTWXgkh1hb5A5PHz+m6Z/hHxOfJKxTf+rMn+cJ3ecHVb2tGtXIezta/hpeSskIAjHC8OTjwXCh",
+	"KL4WmDFdGu+nk88+JhYulBNG8YJhS5r+04+4CcLcyEywK7EpteFGFjv2s6pDhOnawgSZKQq8VvpWBcjR",
+	"S3Sz4WaHUjM491m2kQpjVBriZEZYuDkoIRsIww0N45XHgY/8OimrRSGzyZQqkr9Fac2lBJegeu7PFNTu",
+	"zeDtU/HtwTMxfhdGu/2NgvP0Ink0c6J4b2/rA1l0fToIigepvZv8m0f8m0fcI49wlVGDpze62qRtkvjC",
+	"IyRbi32son+RRnf/pEz6SV7u4SNa7WUjl2020sSnTp7/2k8L6KkZtQLz8JYBQb15apiaIYVzjY4a0X56",
+	"ICfPHx/pQDv87e1fQij4iqtw0lu0QB4U3BQyuDmD3kW1nsRe9vk3f/j/CX/4VoJtjtO+TpkTlFKt5gpO",
+	"hxpAvPaHV+QEMJJDtOpLNxJ46+ezoOxIPVzbLd+1/tt+jNl15XJ9G80SwsLPvFO83fPp7J3/qzPo3nZ7",
+	"n9xHdx370D00MIWsjG8//t18YKT4DRp1ctwJ8k/oPxDhY2W7/z+75dKBFcWXieZLJ0yqsxF849+GvZ87",
+	"0KSF20tsGyr6bHw4SDv/AKbZ2WAAMKQgKpww07TPOOZOg+x/lllhoPwiplwUN5gQmrUzruOIwud7lI5Z",
+	"7qTFfJfk308zNWpCtoLTOmdf41ccE+w0CDrabVru7vCrzOnQPpfKGU41Ymf0dKYMhdwyOK7A+X2uqDqh",
+	"o2cJqZ60dOjvtor8+ey0dsqRiv2vy59+xLSXUnUq/bCNXZUQDswottkZHiQAHJx563kCuwYdCJAS+rUz",
+	"cYuktVXIg8RoY31iYuMs8+4yiMSwumY0Qid99EUbwPI9+xp+n128CErbWyrenmmlRAbaPFynKKwIE6Cx",
+	"v75TFxFZJeQaBPKqnTNxr3QThTWFMClbrxTDmoLA869KmF1f4mlupFEmzVPknGkvRzr4efrT0T0yN7q4",
+	"oXeRtHGdz9QamvLkzSp6vg7Jyen0uqjUYx0upXI4zhRIxeuCp9OEjdHGFU6aGtl7IAUHVtECtzaC4ryT",
+	"plBrwgR6DCJDUIE2Tc0xQmnHmyAFbIvlp7A7uqjY/s/jl1PnHgrFv5NwN5XdjoS4lUDpUIN3ycndduaN",
+	"gv3NLfmOqucbsZpMJzxb4j/bJQLEl+aPCQYwFNDblWBLXC/uSAC3GGymtBOB4XlWLm2XB5dGLOV2CKsw",
+	"xIyanHDQAmyxsw5FshGPhsJYIme8dmZrq0as6AKLrhJDsGKWGBrgSK7wVVMH4TYqMBjeZ1HqRLjM4Fh5",
+	"B81X4I4e161T2kWFiuJaN9BzCHLPfFPU4xP6+psyRRZvD2rSnNi6M7zTZnQ9tB8j3QH7pjN/q3Q9H7wk",
+	"k9ZSHZj0Ti+gL3nOQuRw8vn5ISdPvj+fPX72EUFI3Puo2cXCuhgALAxrPQg/+6j7M+aB/CHn/1AvZJLQ",
+	"9j0PULSgzEYksY94FTvBC1wmxSjGv+bScmvFZtH/Ynamil636ddW/OsZ9yau1DcUM4c69mywqa/ezDjQ",
+	"KLwVw+fG0yP2nEARt/aZ+PUt8DZ6OpH02zgCPD87wxSfa23dGV7LbSeB+OPbegfeBYZbGnkD0MC37Uwb",
+	"uZIKKnCSJX3WGPufzh9P3v9/AwB6v8sZ9z8BAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -1,6 +1,6 @@
 // Package experimental provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version (devel) DO NOT EDIT.
 package experimental
 
 import (
@@ -131,231 +131,261 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9+3MbN5Pgv4LibpVjH4eyHTv7xVdf7SlxHto4sctSsrdr+RJwpkni0xCYD8BIZHz6",
-	"36/QeAxmBkMOKdlJqu4nWxw8Go1Go9HPD5NcrCvBgWs1efFhUlFJ16BB4l+0KCQo/G8BKpes0kzwyYvJ",
-	"KSc0z0XNNanqeclycgXb2WQ6YeZrRfVqMp1wuobJizDIdCLhnzWTUExeaFnDdKLyFaypnVZrkKbvu9Ps",
-	"vx9nX77/8Pxvt5PpRG8rM4bSkvHlZDrZZEuRuR/nVLFczU7d+Lf7vtKqKllOzRIyVqQX1TQhrACu2YKB",
-	"HFpYe7xd61szztb1evLicVgS4xqWIAfWVFVnvIDN0KKiz1Qp0IPrMR9HrMSPca9rMIPuXEWrQU51vqoE",
-	"4zqxEoJfif2cXELUfdciFkKuqe62j8gPae/J9Mnj238JpPhk+vzzNDHScikk5UUWxv06jEvObbvbAxr6",
-	"r10EfC34gi1rCYrcrECvQBK9AiJBVYIrIGL+D8g1YYr8x/nrn4iQ5EdQii7hDc2vCPBcFFDMyNmCcKFJ",
-	"JcU1K6CYkgIWtC61Ilpgz0Af/6xBbhvsOrhiTAI3tPBu8g8l+GQ6WatlRfOryfsumm5vp5OSrVliVT/S",
-	"jaEowuv1HCQRC7MgD44EXUs+BJAdMYZnJ0nWjOsvnnXpsPl1TTd98C5kzXOqoYgA1JJyRXPTAqEsmKpK",
-	"ukXUrunm74+nDnBFaFmSCnjB+JLoDVdDSzFz39tCOGwSiL5YATFfSEWXEOF5Rn5WgJSEX7W4Ah6og8y3",
-	"+KmScM1ErUKngXXg1ImFRHQgRc1TjIrgB4fmAR5l+94ng3qLI97u/qbY0n3qQn3OlhfbCsiClea+JP+o",
-	"lQ4EXCvc9hUQVUFueG9BzDAG+YotOdW1hBeX/JH5i2TkXFNeUFmYX9b2px/rUrNztjQ/lfanV2LJ8nO2",
-	"HNiBAGvqnCrstrb/mPHSR1VvknfJKyGu6ipeUB6fBUMrZy+HKMOOOUwaaQZ5GuQG3B831sXm7OUQS93d",
-	"Q2/CRg4AOYi7ipqGV7CVYKCl+QL/2SyQtOhC/j6x4oXpratFCrWG/B27RoHq1MpPp40Q8dZ9Nl9zwTXY",
-	"qzASM06Q2b74EEtOUlQgNbOD0qrKSpHTMlOaahzpXyUsJi8m/3LSCHontrs6iSZ/ZXqdYydzGUswjC+j",
-	"VXXAGG+M8Iii1sBBN3zIHvWFkORmxfIV0SumCON2E1HuMpymhGvK9Wxy0Em+jbnDOwdEsxX2krRb0WFA",
-	"g3tBbMM5KKR9J/Q+UC1JETFOEOOE8oIsSzEPP3x2WlUNcvH7aVVZVE0JWxBgeJ/DhimtHiJmaHPI4nnO",
-	"Xs7Id/HYN6wsieDllszB3TtQmDEt33Z83AngBrG4hmbEB4rgTgs5M7vm0WDksvsgRpQqV6I0V+BeMjKN",
-	"v3dtYwo0v4/q/Jenvhjtw3SHEr1DKlKT/aV5uJHPOkTVpynsYajptNv3OIoyo+ygJXXWIPi+6Qp/YRrW",
-	"ai+RRBBFhOa2h0pJt16CylAS6lPQzwos8VR0yThCOzUCOSdremX3QyDeDSGACpK2JTMrXt0wvWpEroD6",
-	"We998dcm5NSeE7PhlBnZmJRMaSMM4WYqsoISBU4aFAsxFR1FNCNoYcciAsw3klaWzN0XK8cxTmh4f1lY",
-	"73iTj7xkkzDHaosG7wjV0cx8L8NNQmIVDm0YvipFfvU9Vat7OPxzP1b/WOA0ZAW0AElWVK0SZ6pD281o",
-	"Y+jbNESaJfNoqllY4iuxVPewxFIcwtWq6mtalmbqPjfrrBYHHnWQy5KYxgTWTJsHMON4ApbsGrhlPTPy",
-	"Dc1XRpggOS3LaaOXEFVWwjWUREjCOAc5JXpFdXP4cWT/UMJzpMDwQQ0kWo3TaczIxQokLITEh6oEsqZ4",
-	"Oa3N86gq230Cc1V0DR3ZCS9LUWsDY/RyOXvpVwfXwJEnhaER/LBGfPDHg8/M3O4TzsyFXRyVgIoWxvOy",
-	"Lhr8BX7RAtq0bq5a3kwhZIGKHqrNb0ySXEg7hL383eTmP0Bl09lS52eVhMwNIek1SEVLs7rOoh4G8r2v",
-	"07nnZBZU0+hkOipMv+gs58B+KBSCTGg3XuN/aEnMZyPgGEpqqIehnIIyTdgPvLMNquxMpoHhW1qQtdWb",
-	"kYrmVwdB+XUzeZrNjDp531hVndtCt4iwQxcbVqj72iYcbGiv2ifE6nw8O+qJKTuZTjTXGARciIpY9tEB",
-	"wXIKHM0iRGzu/Vr7SmxSMH0lNr0rTWzgXnbCjDOa2X8lNi8dZELuxzyOPQbpZoGcrkHh7dYyg5hZGlX1",
-	"6VzI46SJnmmiUcATakaNhKlpB0nYtK4ydzYT6nHboDMQCeql3UJAd/gUxlpYONf0I2BBmVHvAwvtge4b",
-	"C2JdsRLugfRXSSFuThV8/pScf3/6/MnTX58+/8KQZCXFUtI1mW81KPKZ0/MRpbclPEw+nFC6SI/+xTNv",
-	"EGmPmxpHiVrmsKZVfyhraLEPY9uMmHZ9rLXRjKsOAI7iiGCuNot28tb2u51OXsK8Xp6D1uYR/EaKxb1z",
-	"w94MKeiw0ZtKGsFCtY1STlo6KUyTE9hoSU8qbAm8sKY3sw6mzBtwPb8Xohra+KKZpSAOowXsPRSHblMz",
-	"zTbeKrmV9X1oPkBKIZNXcCWFFrkoMyPnMZHQXbxxLYhr4ber6v5uoSU3VBEzNxrAal4MqCj0ho+/v+zQ",
-	"Fxve4GbnDWbXm1idm3fMvrSR37xCKpCZ3nCC1NnSnCykWBNKCuyIssZ3oK38xdZwrum6er1Y3I+OVOBA",
-	"CRUPW4MyMxHbwkg/CnLBC7VXm+OtgR1kuqnG4KyLLW/L0sNQOTSdb3mOaqT7OMvD2i9n6iNqy/NIFWZg",
-	"LKFYtmj1o6q8hjBloXigEpAaTL3Cz2gReAmlpt8KedGIu99JUVf3zs67c45dDnWLcTaHwvT1GmXGlyW0",
-	"JPWlgX2WWuMfsqCvg9LBrgGhR2J9xZYrHb0v30jxEe7Q5CwpQPGDVS6Vpk9fxfSTKAzz0bW6B9GzGazh",
-	"iIZuYz5I56LWhBIuCsDNr1VaKB3w2jEHNa+lBK5jORf1GUyRORjqymltVltXRIvU/dJ0zGhuT2iGqFED",
-	"bg7BVcO2stOt6DUQWkqgxZbMATgRc7PoxssBF0kVqYzs7MQ6JxKP5bctYCspclAKiszps/fC69vZ+0fv",
-	"QB6uBlcRZiFKkAWVH2cFV9d7gb+CbXZNy9qI5z/8oh7+WRahhablni3ANqmN6Krv+ku5A0y7iLgLUUzK",
-	"VltoT4IRsQ3TKUHDELLvjr3B7e+C2SOCj4TAa5DoUfNRj5af5CMQZYD/Ix+sj7KEusqMGDiofjCSq9lv",
-	"TrnwsuGeGcIEJVU623elmEYtvYlZasTFU7cIDjwgT76iSqMYSBgvUH9rr0Kcx8qWZorJgU5lOOXga8xM",
-	"+ot/iPWnzc31zlWtwqtM1VUlpIYitTy0WQ/O9RNswlxiEY0dnn5akFrBvpGHEBiN7/DoFAH4B9XBQu1s",
-	"3v3FodeBEV+2h2K5BV+Do10wnvtWEeJjp9oBGJlq9sCSG1MdepsLUQJFlanSoqoMh9JZzUO/IQye29an",
-	"+uembZ8krRnISiqFAIUmJtfeQX5jka7Q1rWiijg4vH8CKrysi1wfZnOsM8V4Dtmu84KPYNMqPjhHHfe6",
-	"WkpaQFZASbcJbwv7mdjPBxKGHxsJpNEfCA3ZHK2JaRppzoT3Nz1uVoFTqZTgTfALyc05N8+ohtRc7+Mn",
-	"LQCnTfFNR6wPwiwIRpIO/HiILEtPiRHx7r8W2pCVIzpcjbuV7riWAeyFWT8KAnHcrFEEdGf/L1Bu7iCA",
-	"3ev8W1BDC2+mvq9lD6j/8W5vXZidq6xz2ySviEG+vIcxDvGgAVvEGyo1y1mFz9UfYHvvr/fuBElfCVKA",
-	"pqyEgkQf7Eu+ivsT64bcHfO41/wodWsf/J6+NbEc75nVBv4Ktqg2eWMjGiJt1X2oIxKjmguXcoKAeq95",
-	"8+KJm8CG5rrcGsFWr2BLbkACUfXceq30TWhaVFk8QDpmanhGZ5BPmsN3egic41DR8lKeh/a1tRu+i86T",
-	"q4UO98qqhCgT+s/uie8hIwnBKHchUgmz64yW5ZboEDbjKakFpLsg0BsjyDMPVAvNuALyX6ImOeX4wq01",
-	"BCFNSJR8UFg2MxhxM8zpXFUbDEEJa7Cvefzy6FF34Y8euT1niizgxrrccGzYRcejR6iKeyOUbh2ue9B2",
-	"m+N2lrh00FZpLln3auvylP1Obm7kMTv5pjN4MHCaM6WUI1yz/DszgM7J3IxZe0wj4xz8cNxR5ru2S1hv",
-	"3bjv52xdl1Tfh6ESrmmZiWuQkhWwl5O7iZng31zT8nXodjudwAZyQ6M5ZDlGCY4cCy5MHxtYaMZhnJkD",
-	"bANHxgIEZ7bXue2056Xd+C2z9RoKRjWUW1JJyMFGyRkpVYWlzogNmchXlC/xBSRFvXSuznYcZPi1spow",
-	"WfPeEIeKYnrDMzRhqGSYGpotfbSlEcKAmpdt1/5hH2s3NIBiL6NRl3a0PV17UNJkOp0MPvwNvq+bh7/F",
-	"Wztk9FhjYks+jJDWQDPSeob4NLJSH4nxNprDZ4jh41hpmqFTUPYnjpzCm49DfuHndVWV23sQkuxAREIl",
-	"QeGVFqsBlf0qFuRHlktxWi5FuPPUVmlY9403tuuvA8f17TEvYMFLxiFbCw6JJ/1r/PojfhytdrTX8MCI",
-	"KBAdNGD34dNCQmcB7cnHkPRdNwlJpnv2u5ZO9a2Q92VltwOOflOMsFzvdetwUx5rX6dlmTBJW/VDj4uo",
-	"aXAKZ5JQpUTOUFA8K9TUeZ9bK7Z1a++g/00IjbqHA9wdt2N7jcKwrCIfyopQkpcM1fyCKy3rXF9yipq+",
-	"aKkJZ0GvHBhWC3/tm6T10Ak1sRvqklN0FA36v6Rj0AISeqhvAbx2WNXLJSjdeWAtAC65a8U4qTnTONfa",
-	"HJfMnpcKJHrszWzLNd2ShaEJLcjvIAWZ17r95FjXShOlWVk6Q7CZhojFJaealECVJj8yfrHB4bwfiT+y",
-	"HPSNkFcBC7PxjGsJHBRTWdrT8Tv7FYNKHE5WLsAEYy3sZ+/x3OSGmJi1t5JW/J/P/v3Fu9Psv2n2++Ps",
-	"y/9x8v7Ds9uHj3o/Pr39+9//b/unz2///vDf/zW1fR72VDC4g/zspXujn73Eh1gUJ9KF/c9gkFkzniWJ",
-	"MnYo6tAi+QzzZTiCe9jW++kVXHK94YbwrmnJCsOL7o18utdU70DbI9ahstbGddR4HgEHPofuwKpIglN1",
-	"+OtHkee6E+x0uIm3vBNj4DijuncA3cApuLpzptxqH3z3zQU5cYSgHiCxuKGj1AKJF4yLYGx5+ZhdigO7",
-	"LvklfwkLfA8K/uKSF1TTE3uaTmoF8itaUp7DbCnICx8U+ZJqesl719BgAqkoqDnKIJXiFHSdXsvl5Tta",
-	"LsXl5fueH0JftnJTxVzUnbO+msxPmRm5QdQ6c0lcMgk3VKZsIT7Fh4uGxt474bAyiaitEssniXHjz8ZC",
-	"WVWqm+yhj6KqKg2KIlJVLl+B2VaitAiBY4aZu9hbQwM/CedUIumNf/LWChT5bU2rd4zr9yS7rB8//hxD",
-	"8JoUB785HmjodlvB6IfvYDKK7nsXF27lcnQqzyq6TNlMLi/faaAVUggKHGt8aZYlwW6t8EAfCYBDNQsI",
-	"scgHbImF7OC4Xlzuue3l03qlF4WfcFPbsdN32sEoKv7oDdwTWU9rvcoMR0iuSplj4PfKJxigS3PleA8C",
-	"xZb4AFArUZslA8lXkF+5zFawrvR22uruHV3cXewZDlOoM3LBgQtm8JdTbgasq4I6QYbybTfFjbLBEDjo",
-	"W7iC7YWw3Wcjs4NF2eiiFCtq6Ogi7UZ3rSHf+CC7Mbqb7/yufIyoS0eCcZeeLF4EuvB9ho+2FQDu4Vin",
-	"iKKV52MIEVQmEGGJfwAFRyzUjHcn0k8tj/EcuGbXkEHJlmxeJtj0f/btGh5WQ5UScmDXPqo3DKgIWxDz",
-	"Oprb69i9mCTlSzCXurmIhaIlOu3PkoZ+lA5XQKWeA9U79bU8TjPhoUOB/AaDplFpMjVLgI3Zb6ZRCcLh",
-	"xjzw8O1t2zhH4tlR7lR2TVAcCarv3gRJz455RDiEJ/LZ+fs+7El4Lzj/tJg6EWT7fW1wuJTixuymAVD4",
-	"1I2Y4CW6p2pFlzD2OmqZikamxGhZgHCQfdJPUt4Ri65Y05MxRi7Cds8MXpLcAcwXwx7QDNBxcfRzWxOi",
-	"syq85uXWI3VeokAdHEQt6VDZsrPx5WHAptkYSN4Iqx6wNtbio7+iyh/9Yhpx9COlxT8mlcyu/Hlnkfcd",
-	"1f3seP6a7rL2qdXnzIEIbnr4LHo+dZ7PlzeZHpT7bjpxIQ6pvRMcpegCSlhanNjGns6a/EzNbho4Xi8W",
-	"yPSylCNfpIyMJBM3B5iH2CNCrMacjB4hdQoisNGyjgOTn0R82PnyECC5yy9F/dh4d0V/QzpY0HrjGylZ",
-	"VObWZwNWq9yzFJfeohF5Oi7OOAxhfEoMJ72mpeGkLvC0GaSXqw3fPp3MbM634+HQm2jkQXNrROnkoFVa",
-	"eeaY9cWCt19G+lVw0BrmYpPZyOjk02q+mZszkYxXwDjt1OG1mfMeKDIXG/QpwhvOOrgfDN0wZB6wyA1k",
-	"wxRSOfYbEhsteIcBsluQT1GzQtJzerVAdkOS7HHADIjTQ2T3WZRC755A6igwmzTgTqOzV8/Slrb6kkhz",
-	"3U5DdtgQppZiNUOHM7mTAxjtK0/bue6+b9IdDidH82f1kyT56yvl7pKX0XaubK7FQ9IydsmhBcQOrL7p",
-	"CrFJtLYdl9p4jbCWYkmG0feNXX20KSgBNQFZS67OrlJm6cvLdwpQZjj33SI9J+4e5duHkTechCVTGhrj",
-	"gndy+fS2H1QnmseWWAyvTldyYdb3VoggaFhzLHZsLfOTrwBd1xdMKp2hZSa5BNPoW4WatG9N07Qg3Pa3",
-	"Y8qaeg6WgxGiK9hmBSvrNCk7kH54aSD6Kdxcqp7jRcm49TaaYyr8pIPuAbZJhMc6du9E0CuLoFf0U+Bn",
-	"3MEyTQ1M0lBee/q/yBHr8MJdnCVByyli6m/oIEp38Noolr7PaCMhOnK7mO2y+fTOZeHH3uuN5SP6h4QI",
-	"O1JyLVFGxHQAoVguofCZ3lxQqM165fLplYIvm1yC5vcd6QNnxGbxwyR8O/L3Ofd0GHJOb5UTwaoYSejj",
-	"xwxC3kTXYe5BnGQJ3GZumRxeb6RMIi52jMcWkWb00/L2ntt80nX4ouMu3Pj02j0Mm43bUwIt3LNKgV/f",
-	"7kPb3y6HuumQ03ErRezuA4YDIsUxrSIBpkc0A5ybVhUrNh3Dnx11dgRJjBT3+pngOzhDtuQG24OftmPx",
-	"nlo9D8ztiO2dseMEn/kn5pFp/ZmdR645GzR32QaKWqI1qeUt3M+nHx6aI9f+wy/nWki6BGcRzCxIdxoC",
-	"l3MIGqKU9IpoZh2kC7ZYQGwJU8dYcVrA9ewdxQjCHiDBvrksvC130mefyPbQVrOC/QhN01OCUoZ8Li76",
-	"9kj/8Ih0a+GyiTbuCKNiMqHAD7DNfqFlbV5CTKrGN9UZCNvX+gE0cb3+AbY48l6XTwPYnl1BVdxbQApN",
-	"WVfCJxVlCX+gWtUX8A3c2sIDduo0vUv3tDWulMbw0WhuqFY9ifZSPt6xaVxkDKRj9uo87XVizha0t6VL",
-	"6Pu2iBX7ZZ/oCRJPxdB745hLLmTa2OtdBrT0hI+LndxOJ3fz90jdk27EPTvxJlzNyV1Ab0xr/285fR24",
-	"IbSqpLimZeb8ZIaEDimundCBzb1bzSd+X6VPxcU3p6/eOPBvp5O8BCqzoOoYXBW2q/4yq7IlOHZfQzYd",
-	"u9PtWlVYtPkhZXbsSXODqdc72rRerZvGbyo6qM6zZpH2FN/LN52Ll13iDlcvqIKnV2ORto5ebecuek1Z",
-	"6Q2/HtqxWna73HHVlZJ8Ih7gzk5ikfffnccajBO4vHx37THb2FOso1RIiZ/wpVNHejr3eE36rDa0vodD",
-	"4jpfYybT9LuLuzynyBidwxm9dznwWyFbF5WLakw6rH08AdE8Jiwe00b5C2eF74mFM2JFyN+Wvxne8OhR",
-	"fPAfPZqS30r3IQIQf5+73/Ed9ehR0jCcVPUZloWaPE7X8DDERQxuxKdVQ3C4GScunF6vg4wshskwUKj1",
-	"PPPovnHYu5HM4bNwvxRQgvlpNkZVEW+6RXcMzJgTdD4UlRicn9e2nKcigndj8DFK1pAWXj2ugoe1s/eP",
-	"EK/XaHfOVMnytNMPnyvDkrh16TWNCTYebUM2c9RswK+c1ywa3TRTR5k8OwuJZk0iXCUzATf4nQvHAmrO",
-	"/llDVNYXb+LO5eyfQjhqT8BO6xfdwN2qwZNjCv7e3UTotWq7FEY7Ta4vgxnQIyJVZ+rAeId4xh7z3xGr",
-	"4CjKX58Y2LZyrsN7KWvnO293EWhnBvbs01lchx9Irhym3cyXY3aaqWwhxe+Qlh3QSJhI3eGt2wwV8L8D",
-	"T/modhlZ8BxoClY3s+8jkPG6hSFSubMuwS86VM075gpP84nDNvpApUG038NqA5VOL+42YeihGjuetANp",
-	"BpgZHtjILRxr+Xh3N8rtCbV5LVqRZ+lzHgeKntjxm3PuYO4F15b0Zk5ThY7Me9HAFG1/yzFPC+I7+w1S",
-	"ITWDnZ1EsQyhLbPJ/iqQjfWonyr5yLefnXb0q6955CHFxc+7qfVVKZVIDFPzG8rRjxD7WQ7oeiuwfhim",
-	"142QmOBTpX0IC8jZOqkMv7x8V+R9z6+CLZktKV4rIHShXZ5HN5AtKm+pyFXzDrlIHGrOFuTxtDmzfjcK",
-	"ds0Um5eALZ7YFnOq8IIOPhGhi1kecL1S2PzpiOarmhcSCr1SFrFKkPA+R9EzeMLOQd8AcPIY2z35knyG",
-	"DsOKXcPD9AXjhLXJiydfTndVzkaMY5H4XUy+QC7vAxnSlI1e1XYMw1bdqOnIhIUE+B2G75Md58t2HXO6",
-	"sKW7gvafrjXl1CAkBdN6D0y2L+4vunJ08MKtdQaUlmJLmE7PD5oajjUQTW4YogWD5GK9ZnrtPEWVWBsK",
-	"a8qQ20n9cFhfz5dB83D5j+iCXSXe+H/Ac4uuByIc0av+J7S3x2idEmoztpasib/wFWrJmc9MjXXhQjk4",
-	"ixszl1k6yqsYjrEglWRco9ao1ovsb+b5LmluGOJsCNxs/sWzRH21dgkifhjgnxzvEhTI6zTq5QDZeynH",
-	"9SWfccGzteEoxcMmpUN0Kgd9xdP+vUNuxwND31m6NuNmgwRYtwiQRtz8TqTIdwx4R+IM6zmIQg9e2Sen",
-	"1VqmCYbWZod+fvvKSSJrIVOVLhoG4KQSCVoyuMb40vQmmTHvuBeyHLULd4H+j/Vu82JpJLr50518LERW",
-	"5cQ7LaRVMpL+Lz82+fHRuG3jdjvaSyETelqncfzEbqmH6Qu7NnTrDojfBjA3Gm04Sh8rA+EeNp4j9Pkj",
-	"/L26INk9b6lKn/xGpHnHo6z/6BEC/ejR1InKvz1tf7bs/dGj8S6zaX2h+TWBmuPumm72StM3tdVfiYT2",
-	"zlfxDH5jLlVJQsOavMvMlTp3Y0xJu1Tip5c77ide8WA35PQB8qjBz13c/MH8FTeziYAZ5g/t6rFJ8inC",
-	"9yiGgpKvxGYsEXWuLU9PfwIUDaBkpFYQV9Krjpv0lNjr5hORrRl1DqUwL9W4ANZor5W/0C4Y1Ex37EXN",
-	"yuKXxgrduZkk5fkq6VQ+Nx1/tc+AqEGkwchXlHMok73ta/lX/6pOvPv/IQaGXTOe/tQtxGxh70DagNUG",
-	"wk/pxze4Yro0E8QoaifkCilOyqUoCM7TVC5pWGO/onmqkmwixh+HXdfaeSVj8gRXUGTBSnSjTdvDsWUm",
-	"qR7gqlj235e4MuNgFX5l1RJ2dJCEsjVe24quqxLwEF6DpEvsKjh0umPGNhw5KktCVGU+YUtM/iKIriUn",
-	"YrGIlgFcMwnldkoqqpQd5LFZFmxw7smLJ48fPx5nZER8jVi7xatf+OtmcU9OsIn94ip/2YIJB4F/DPS3",
-	"DdUdsvl94nLlV/9Zg9IpFosfbEA2WojNvW5Lr4YywTPyHeYnM4TeKhGASlGfYbmdE7SuSkGLKSaFvvjm",
-	"9BWxs9o+EhB1WPp1iRrA9hFJGnnG50j1+dcGcleNH2d36hyzaqWzUJQ1lUnRtGhqybKO9xPqBmPszMhL",
-	"q5YNjj12EoKpxeUaiqgGrFUDIHGY/2hN8xXqO2eTnSrlgWpA40sYew7YmIuiuNdQMAs5uFmGq2JsixhP",
-	"idArkDdMAeadgGtoJ2wM2U6dQt4ncGyvVtacW8KZHSC9hvJYh+6CB86Kvt6/IglZZx/ubPtrMnlgkfND",
-	"iz2fY6903E6ncnTH78GWzNj4ohsz8qMzduSUC85yLDaREsExFeM4s+qIuhxpe6eauLOcOIbJetUhQN1h",
-	"cbCCtWeZDnF9p4boq9lvSzj2Tw0bVwRwCVo5HgjF1JePdwY6xhW4AmiGvmKOKmTC9SsZFhNcSO7RJX06",
-	"wWxqA7rWb823n5xuHnPGXDGOOjeHVPcStAa2UjG0s3PCNFkKUG617bgw9c70mV1sOILwfvZKLFl+zpY4",
-	"hnVFNEixXsD9oU69T7DzwTVtvzZtXe2C8HPLpc5O6tf9PslCVNj/VM31QfSnfL+8I02E3DB+PNoOYtzp",
-	"6o/3siFDuEbPP6jwPu+RTShf3x7lG/NktfSGLYiN3E2mDWY8AcYrxr3BN50HK0/eJbgxeJoH+qlcUm0f",
-	"HaM43gXQciAcBoPqrcfAXYfqVmIwKME1+jmGt7GpvD/AVkKD5nVB+Zb4Q2GoOxJKvqZlcIZP1NFH6cwJ",
-	"Y9ZZuFNZP8VWDFvPfGhuC117A0FDd6yGcug9NZRtdF4XS9AZLYpU3rmv8CvBrz6gEDaQ16EIWIgzbadr",
-	"71ObmygXXNXrHXP5BnecrmCKKgXreZlwvX0ZPkIRdhgTUc23+G+qAtbwzjin94Ojv72He3FYjYJ+NHtK",
-	"ejY0nSm2zMZjAu+Uu6Ojmfo4Qm/63yul+8DvP0Vcd4fLxXuU4m/fmIsjTtPd8/G3V0vIoo3+9AK/+3xg",
-	"IZNrmyvhVdar84YeGbh5iS3rAO8bJgG/puVAxoXYamPvV2vJGMq7kA+mFaHaZa/TlDQ8YYwKYzj/l/XA",
-	"7liG+ubNIR9r62L9MY0nDh87kT5safyhZVe0Xm8NQxm0Jx5n8muI4FCbnyvF0NeX0rIU+WjO4IY5NZ2G",
-	"U/WK9dplvk945V2vRRGfhdibCyDN2KzDciK0Ah+2yW/4tEp+kTfp0Vr6kUA0Y7OWIRrdEqY2MNOD54Gx",
-	"U8cTRSpbh1nyLSuxONR/nL/+aTK8kdEO9LfUpc5OqrCHNiZEqnXJYyla+NjBAwQv0/pvNaBSx9xQ6dPg",
-	"qhMnP3xrFYRjQLJ5kg5p/Wrs4D0CWApbFSpVN6OfnWbSbIdHfkQNzfZajhJTR4oqutWWEm8fq/RsmpBQ",
-	"iHRUYdKWjDSmuFOqjpB7KXgNrL1oXD46W1ypV5epx0BfjhEOe/i4nU7OioPEp1QtqokdJcVgX7HlSn9V",
-	"ivzqe6AFSFtPJPWctNVE1mCeoWrFKnz/VEKxph5waQZzibxXONxsbGjOxQpcVhifJKA3lnegvoZcY33o",
-	"xg1UAoz3c6jSSzQQeIMiNvkDXEEkQAGVXu0Ulqxzd6VXTdlQcJFnTJE5ONPFNfApYTOYdYPViiYpFCmB",
-	"LrwSVgqhR9TVDWFLiMYY6BR99Wo07xYDeznfopSGtpTubHwRltMQE2ADLW+oajJHddIojA7XXiwgx4T3",
-	"O9Pv/ecKeJSPbepVdwjLIsrGx0K4IJZsuFeNdgPrrkR4O0GNalJ9TEiHEmJcwfaBIi0aSlYEDhG2x2SA",
-	"R+RYO64vKjBk2nCOkUwFekIEeT94l4C/qbF0TBGAKDvlkWB4GjfXU5Ox8jhovERzBBim6+xORfubdHgo",
-	"mA5l9+tXVx9+Kb/EYvbKOZXSkG4+1ieRs3455huXrh4TLQZroU9cD8r/5hO02llKduUq1CDCrG32hsrC",
-	"t7iXNHn23mRpoBdhZtYERvW9fA71y7ERinkpjACUDQWGtiOVggvvA2V9rZukZQj1AqSEItgES6Eg08KH",
-	"WR2Q/NOFT+7AnvUyPwpvHY/+A0KG7YoGayi8bQpJYDlIijUTqHM+j7FCJKypgV5GxR3SatB9O/S1/e5z",
-	"ivjyfrvVq0N4D+dif4VsH3rHVA/z8elaECccHMy9WolIjtDMMs5BZt6I2y3twNtpMjGvclHnVlSJz2bQ",
-	"Xo9OO7aDmyWVmnl/lZ0nVJSV4wq2J1bt46uO+x2PgbYypAU9SijdIYp71VWrFNzLewHvj03fWQlRZgOW",
-	"wbN+PYruYbhi+RVgYtYQmWKk4AftY2MmIZ+hQSr4jNystr7aQlUBh+LhjJBTbqMDvftIuwJpZ3L+QO+a",
-	"f4OzFrWtMOM00LNLng6zwkov8o7czw+zg+cN8SYFhl/ecX47yBGz6w0f8pG7wZIw7TrBs7Hqjb5/R0eE",
-	"isjPQpESoM6tIfhrZAmJdxTB7CxRGiH0D6DEGZCJKkXKC/+YDDJmqDSm4skQIA18xHO1gcINnkSAc7Lb",
-	"k5XVffZ5R8WCSGh8M45NwOpymlomroZUI92ZwyxtzrgQEuIZ0c/UJmoOkW2Y5xj/M2daUrk9Jk1qG1Up",
-	"NdQglvd6SwZHyWYhjbNkH4dlKW4yZGtZqK6UUgeYdqp9bfs6pU0/c9TnELldUuVExC1Z0YLkQkrI4x7p",
-	"EG8L1VpIyEqBXpgpx46FNo+ENcZ1clKKJRFVLgqwhdDSFDQ0V805RdkLIle2JAos7WDKANsnouORU5rb",
-	"15pnM5TX9hba8Jt/YfrY9BVN+ju76My6CAzEF4By6e4chmzjPrxIODYjU1cpmxaRF2yDdAMydeQXRMsa",
-	"psS16FbhdwefSiBrppQFJdDSDStLzB7BNpFDQ/AHSqN2QHY+Qz/oa4YOb+1MIlakrsztGNKvxDzgPM7I",
-	"RvRKinq5iuoDBDj9013W7mEfj/KzqtEnEUNEzRTPyFoo7Z7FdqRmyY0L6Ge54FqKsmwr8qycv3RG3x/p",
-	"5jTP9SshruY0v3qIj3AudFhpMfUpFbq+u81MspODcdxLQW94huSh9qdZt+3Qq9XR82je2eF+PcPDPk1+",
-	"BOb7/cx1v13jtL+w7rrafDb9FjrlhGqxZnn6uP21vF8HfVZT3CuZadFWIbZZaLAZ8oH4HgvuTMg9+2gG",
-	"TpNlVE+J4xHOrQM5kfkvivHdcckCHA8auEP7fMcJWFk+KAZ2AEBIbSIEXUtbujgW0gLDEUubOAWdUrqA",
-	"jrxw0PfvbrCZEe4dKA13AqrnjRwA/MxqMKY2I6b1bJ6Ljf/+sEmZeRTwt7upvMU8hpwqzxvSktat0iey",
-	"GuAI6QIEOz0QLzAJxnysH2IoRT/y8o8AGPZMbMEwyj/xUDAWlJVQZKkqxWdBBzaNnusuxjIa3ddztJw8",
-	"p7WvBGzGriW4xEpW+pdtc2JFDSmJ0LyvEecFbMDGaP0OUtg6vtPInAWlLfPb0SiIKivhGloOmy7bU41S",
-	"KLsG31eFzqQAqNDi21W0pTwR4yqBHe2LW3sW+bKNwW5SHWMRa3eK7NG1JDVDG57ZY6LGHiUD0TUratrC",
-	"nzpU5GjrEs1RTqCq93zI/BNz7DQ/2xHe+gFOff+UKOMx8X4cHzqYBaVRt4sB7fVMrtXQqedpx+Q4lVkw",
-	"FOFsRbBrWxJv+Iaq6A0f1mr2Sb55iY3cJyZ4hNhvNpCjVOOeQlC4x9CA5cTlQEJq5wCFfTCYLglt/go4",
-	"4SKqeXxDVXjFNFld/Q92YmzEuHtoH2Gjb/yH776zBAcjqpNsMV2iNJD13XT8f8hJ3HkQB8dL0YgCF8q7",
-	"QzXmqds9O7CBqMuCcLOfRvbHGsHuFnNcfErmtR+oLMWNLWIcP1FfgrfnWurzJiYnlrNwLXs/6alLONzV",
-	"grAoQmRNt0RI/Mc8SP9Z05IttshnLPi+G1ErakjIGZCtF4XzuzYT7xavph4wr4gRfiq7bjZ2zGi4rRkl",
-	"Atpc5L5smyBregXxNqCDiOWfuTaMU9VzVGqYK7uznX0suMX79ExrWsRKAEw0u21xB5/w3PT+n03YajyV",
-	"z/9YlTT3Jatd8bk2n8Gq9p649ArWu8Oc+3zNk0ColN8QrfRpMoojtKkHsq5UzM9QcawW2L0S4L26YHda",
-	"xkilcKfG0Y4A8VFLue9duJ8Yzt6S4lK/+xYXVz7+NLuTzBA9tIwx4P+JdqXlXtGLbEtXUI/XY4ulf4Jd",
-	"aCXiScBq1eBzsckkLNQ+RxqrB5+LTQOwCrpbxnMJVFm/o7PX7tnaJEBm3DyjrdduMKuGUQpYMN6wWsar",
-	"WideQZgHmW8jhMXWBETrgG1uSMYwoug1LV9fg5SsGNo4c3psaeC4SI+3oLi+CQVIuJH7AzDVvAAxnrrR",
-	"z8fNzPVvCwxa31mlKS+oLOLmjJMcpJEayA3dquNNVcHqsM9YRSNZqJ0tJDJbIWlbQMqtszbf0ZAUAKT3",
-	"aFEaYQlCJ+2EFcgqhrQYMPz0YfhLWILWdJOVYolRvwMHwuW5RtOhfUAKjkp0K92NW7efR7HfYfc0WIrE",
-	"MSItcNYxU+w+969xK/ER+jNneufJtxrObhi29XS2B9MjlS+b8AxLLP3zmIqcd4mZ4uh5L6r6NCWe9iDa",
-	"xKRLdE+rPrCL6F/h0i7EKvTxxSrbLhyp+HyrV8hQ36B2BGCAauIKaO48xPqKuJ6iwiJl6rIbHKins9p9",
-	"fy8NgIeKFOXOenva4KBjxjmkwufufAZZJaosH+PbaqsVFc7I4CBtwzhAH5EJYWDdwe9GhfpdrZxorUJe",
-	"hxY5HSwkts9WVuW7VAZDSqYBjt42YIgF8jI8wla1hrFWQRUz9Y9zb+xuK9ECkyCUSMhriUrmG7rdX/hx",
-	"IPv8+fenz588/fXp8y+IaUAKtgTV1DToFE5sXBMZ72qNPq0zYm95Or0JPluIRZy3Xvqwt7Ap7qxZbqua",
-	"ZMS9spGHaKcTF0AqOLdfIu+ovcJxmrCIP9d2pRZ57zuWQsHH3zMpyjJdUybIVQnzS2q3IgOMeYFUIBVT",
-	"2jDCtv2U6cYpW61QuYhZw69tbijBc/DaZ0cFTA/4cqUWMuTTi/wMczE4mxOBTVU6XmXtRLvW5d5pVr+H",
-	"QiO628yBVKJyoj1bkBREGLMlawh6dac2RX165KYbmK112E0RonN+T5PeKXcvYbEgu7l9uxS3TnN6s4kJ",
-	"8cIfyiNIc8i6MZxn5BhO0hgG/jT8I5E45d64Rljux+AVyffBjqjw057XREgaMgq0foKMBHkgAAPx0K2g",
-	"1SjILspNLq2NAa0R3vzcFT9+bMzSeyNTEBLfYQ94cSxz0y4EUzhw/uDE3j8GpERLeT9ECa3l7wuP9qw3",
-	"XCTRFjmlidagLFsSfbEwCohXX4c484FXSS8cXQqhiXmZlmUijN3qcfBMxYRjngTympafnmt8y6TSp4gP",
-	"KN4OB27FYcsxki0q1b0n5HxFR4EVhSh/Eqj4G4yt/08wO5u8Hd0szvDfuwNRJURL6+29CBZw4OQGx7SO",
-	"XU++IHNX7qeSkDPVdSi48SJNiLcFyRbOvxY2uhv7e+cyQb8IfYfjsPD+QOSnyMgWPAcczM1R/4OZ0wAH",
-	"SJ6WFKn2CCWBvxSvi4uq77l27lga5rhUTlHixgNTOfXLxY9dHq4DL69aQX+do2/9Fm4TF36ztrG5ykZX",
-	"mLm8fKfnYxKKpavBmO6Y4+xeysLcvSjMJ0lwZlHpxnCQJAmrEbn3Za/p+EtGeRrau2jE/YEC8iuLfjMa",
-	"PgoWNbfjhQKoGCvu2bpYTIMXg+Cm2wtyyR8RtaL+beH+fPr8i8l0Arxem8U33yfTifv6PvVSKzbJuNIm",
-	"kU7PR9RVE3igSEW3Y4LZ96bOSeK3yRT06UUapdk8/ab73uwZPlxdAMIZR1aP7MXeoC5/zv9PALSTGDqH",
-	"NZwYS5JNeqCwFfsyBf0ylBbfpn4fqPbR4b41K/c6ybUKsdxOJ0ubpAyrk/zqatV92m33EAzkC3RLv0sa",
-	"MIuYxFpbk0dTRUndRhRkcd0SFTIw8jqvJdPbc4N/r3Znv16lkkF9F9IzuZxfwQLvZF8troB7H7MmmVOt",
-	"vHT9naAlSp/WMYAbmVOUM/KNrRDirsW/P5j/G3z+t2fF48+f/Nv8b4+fP87h2fMvHz+mXz6jT778/Ak8",
-	"/dvzZ4/hyeKLL+dPi6fPns6fPX32xfMv88+fPZk/++LLf3tgKN2AbAH1lX9eTP53dlouRXb65iy7MMA2",
-	"OKEV+wHM3qCGbYEJChGpOV6xsKasnLzwP/0vf1HOcrFuhve/Tlw9yMlK60q9ODm5ubmZxV1OlpgDJdOi",
-	"zlcnfh7MZdl6r7w5C3FB1vcPd7SxOeGmhvx+5tvbb84vyOmbs1lDMJMXk8ezx7MnmE+xAk4rNnkx+Rx/",
-	"wtOzwn0/wSzaJ8oV4zkJoaO30963qrKlesynZUgDav5aAS2RRZo/1qAly/0nCbTYuv+rG7pcgpxhxJj9",
-	"6frpiX97nHxweWVud307ib3RTj60kvMUe3oGf6qkJ8MrIa7Qkca/hh6ojneYQW/YhrPCoN+2RLcnddYw",
-	"QkSx91SZvHiX0tg6v+2qnpcsN8L1zBOw2Z2IvkLOpYZ/oH5+Yvknms0DNzQc7nH25fsPz/92m3TU7vts",
-	"Nc6OO7921/Cj80Bo7jEXQYDxqhhPFVb0zxrktlkSugdN4gWMFHeSvybtwObtWrl6TQ6uGfnZOWHhV8u4",
-	"gqu7C4StJFwzUavQaWAJZojUCsLr9T2WMkafZqS5p48fe/binuoR7Z64IxFvadss2nNpPCTZS+xymHpn",
-	"mcVkiI/+sfhZ2dR8BpuMUxsuhHEEa3plDcLoKUykyxXgMOqCDxDJITDObYu/QT5iHca75TmzQCTyr/a5",
-	"9QAH8OEDsTq/ZNZY4Zw2V1Da7ES8yV9yO508O5BQdqrVW1nKE+D/SEsDMhQ+qZeF4Mmng+CMWy93c+3Z",
-	"6/l2Onn+KXFwxg3vpCXBlvZCxrj2xGHgV1zccN/SyFL1ek3lFiUlPWaPXYY69IDw7eyRsBc7Ncf73cRe",
-	"C1hIrQLJ1sCxfvHtvuvt5IPLtbbnMoxNeycuRiPqMPKS3dXsZI41Y8c2BRU1Hl4KvpTVyQc8oYO/n7i3",
-	"ZvojmgCslHjiH9ADLW0erPTHFgo/6I1ZyO7hTJtovJzqfFVXJx/wPyjwRSuyNShO9IafoMvkyYcWItzn",
-	"HiLavzfd4xaYOt0DJxYLhfLQrs8nH+y/0UQtwmyEqraA9E3U6OsV5FeT9LXYKdAT9SJWHqbzEgrLnJ6N",
-	"6MCFjjsddaDfogyjyOsfCFsQ6E7BlJ/hgHNrE1afYAX7bYNL//OW58kf+9vcyss78POJf46lROt2yw+t",
-	"P9tHTq1qXYibaBY0ZFjbXR8y87FW3b9PbijT2UJIl9iVLjTIfmcNtDxxxcM6vzYVOXpfsMxI9GMcmZv8",
-	"9YQ6VE8qoRJk+5beRErMU2xsJQRQ+iuBL5qh22mTzRlHCopvqEZ/YT/2zR29ewlrQG91YzjupyXD3EhS",
-	"0CKnyki0pCkV0H4s3CaP3aeWNr6iBfEppTLSyB6n7pXcWtqfQxJJspuXcA2loRgiJNnHe/5gWeb5488/",
-	"3fTnIK9ZDuQC1pWQVLJyS37mIejwaFb8LZK3pE4tHEje+pRLetOOY5TpTDrtGpY+5xIQvSEryovS5R4R",
-	"NdbzNbSJriIiclY0V5gv6VoJiQDYzMFQWPctNSPnwbkNXcVq/4IqLNmgDRZz9dtJKDq+WeeHEVeJecYY",
-	"frAEnjmOlM1FsXVFDCeS3uiNzSfSY3tWzhzgiT0pMPXVCToDjXy0i//c6EljvSMqRILG8d1781ZWIK+9",
-	"rqRRo704OcHgyZVQ+gSf+m0VW/zxfcCcLxU/qSS7xtpTiDQhmXnBlpnTQzV1XydPZ48nt/8vAAD//1hz",
-	"GYWbDgEA",
+	"H4sIAAAAAAAC/+y9e5PbtrIg/lVQurfKj5+ksR0798S3Tt3fJM5jNnbs8kxy9m7sTSASknCGAngAUCPF",
+	"O999C90ACJKgRGlkO6navzwW8Wg0Go1GPz+MMrkqpWDC6NHzD6OSKrpihin4H53xidmWzP6dM50pXhou",
+	"xej56FyQ868viP04JrrKloRqcu9+xYX58umY5rliWj+4NyZSESrI+S+v2m3Pf3l1aRQXi3tjYiTJWSZz",
+	"RsySkZnckDUtKkaonpKrJXMfc/cr10QxUynBcjuS+ziBj9PReMQtgP+qmNqOxiNBV2z0vF7JeKSzJVtR",
+	"uyRc2kgDGKPb2/HIAZ5cL80yWQlDympW8Ixcs22YrKRmGc3lBhmPFPtXxRXLR8+Nqlg8dUmNYcr2/fV8",
+	"8r8eTb56/+HZ325H4xZM49FmspBuD0Yzqnmmp+du/Nt9X2lZFjyjdgkTnqcXVTchPGfC8Dlnqm9hzfF2",
+	"rW/FBV9Vq9HzR2FJXBi2YKpnTWV5IXK2Gd3u/Uy1ZqZ3PfbjgJX4MU66BjvozlU0GmTUZMtScmESKyHw",
+	"leDn5BKi7rsWMZdqRU27fUR+QHuPx48f3f5bIMXH42dfpImRFgupqMgnYdxvwrjk0h2kAxr6r20EfCPF",
+	"nC8qxTS5WTKzZApYg2K6lEIzImf/ZJkhXJP/cfn6J8tlXjGt6YK9odk1YQJYwpRczImQhpRKrnnO8jHJ",
+	"2ZxWhdHESOjZxy8cXDEmmbC08Ovon1qK0Xi00ouSZtej9+MEHyn4iidW9YpuLEURUa1mTBE5twvy4CBP",
+	"6wMIR0xwrzRJIiMe3fb9uqKbLnhXqhIZNSyPADSKCk0z2wKgzLkuC7oF1K7o5u+Pxg5wTWhRkJKJnIsF",
+	"MRuh+5Zi5z7ZQgTbJBBtLw37hZR0wSI8T8nPGi8Z+GrkNROBOshsC59KxdZcVjp06lkHTL37PlGyEilG",
+	"ReCDQ3MPj8K+p2RQb2HE293fNNO698Igmq+qAq8L13A/s41G3LWaLvY0X/SIH5d8cbUtGZnzwjClyT8r",
+	"bcJZqjRQ4JIRXbLMQpaD8GHpQPOFoKZS7Pk78dD+j0zIpaEipyq3v6zwp1dVYfglX9ifCvzppVzw7JIv",
+	"eoghwJpiGRq6rfAfO16aa5hNEusvpbyuynhBWXwsLdlevOgjUhyzH89pXn0eRBggFTfW1ebixej2mB5m",
+	"EzayB8he3JXUNrxmW8UstDSbwz+bOVA5nas/Rijp2N6mnI/Go+UshV97HN31AQLeOcpz57VQ89Z9tl8z",
+	"KQzDqzkSe86A+T//EEtySpZMGY6D0rKcFDKjxUQbamCkf1dsPno++rezWtI+w+76LJr8pe11CZ2scKCY",
+	"ZcQTWpYHjPHGSu8g+vUwHssX4ROZS0VuljxbErPkmnCBOwkH2nK+gq2pMNPRQZzlNj7fvzog6q3ASxu3",
+	"osVYeveCYMMZ03AAnBB+TzckV8A4AYwTKnKyKOQs/HD/vCxr5ML387JEVI0JnxPGQb5gG66NfgCYofVJ",
+	"i+e5eDEl38dj3/CiIFIUWzJj9aOEz9094u4V9yCwiIU11CPe0wR2Wqqp3TWPBq2ZOQUxgpS7lIW9kveS",
+	"kW38g2sbU6D9fVDnvzz1xWjvpzvbijikAjXhL/XLmdxvEVWXpqCHpabzdt/jKMqOsoOW9EWN4FPTFfzC",
+	"DVvpvUQSQRQRmtseqhTdeoluApJZl4J+1gyJp6QLLgDasX0gCLKi17gfEvBuCYHpIPkjmcGg5IabZS0C",
+	"BtRPO++dvzYhp/ac2A2nXGhCScG1sRIRbKYmS1aAAEyDoiOmoqOIZgAt7FhEgPlG0RLJ3H1BYY4LQsN7",
+	"EGG9400+8JJNwlx/jmkAoDqame9luElINChAmjB8Xcjs+geqlyc4/DM/VvdYwDRkyWjOFFlSvUycqRZt",
+	"16MNoW/bEGiWzKKppmGJL+VCn2CJhTyEq5XlN7Qo7NRdbtZaLQw86CAXBbGNCVtxYx/kXMAJWPA1E8h6",
+	"puRbalWpZUkyWhTjWk8iy0nB1qwgUhEuBFNjYpbU1IcfRvavJThHmlk+aBiJVuN0LKCBVWwuFTycFSMr",
+	"CpfTyr6RyqLZJzBXTVesJTvBZSkrw1Tj+XLxwq+OrZkAnhSGBvDDGkEBEQ8+JefhE8wsJC6OKgaKHy6y",
+	"ospr/AV+0QDatq6vWlFPIVUOiidq7G9ckUwqHAIvfze5/YNRVXdG6rxfKjZxQyi6ZkrTwq6utagHgXxP",
+	"dTr3nMycGhqdTEeF6Wcdcg7oB0IhUwlty2v4gxbEfrYCjqWkmno4yCkg04T9gDvbogpnsg00M3Z/V6jH",
+	"I1a5dhCU39STp9nMoJP3LaoO3Ra6RYQdutrwXJ9qm2Cwvr1qnhDUQXl21BFTdjKdaK4hCLiSJUH20QIB",
+	"OQWMhgiRm5Nfa1/LTQqmr+Wmc6XJDTvJTsgN/jGI2X8tNy8cZFLtxzyMPQTpdoGCrpiG261hlrGz1Krz",
+	"85lUx0kTHVNJbRAg1I4aCVPjFpKgaVVO3NlMqOuxQWsgEnRMu4WA9vApjDWwcGnoR8CCNjQC/g5YaA50",
+	"aizIVckLdgLSXyaFuBnV7Isn5PKH82ePn/z25NmXliRLJReKrshsa5gm952yj2izLdiD5MMJpIv06F8+",
+	"9Qaa5ripcbSsVMZWtOwOhYYffBhjM2LbdbHWRDOsOgA4iCMye7Uh2slb7Hc7Hr1gs2pxyYyxj+A3Ss5P",
+	"zg07M6Sgg0ZvSmUFC900kjlp6Sy3Tc7Yxih6VkJLJnKgeVgH11RrtpqdhKj6Nj6vZ8mJw2jO9h6KQ7ep",
+	"nmYbb5XaquoUmg+mlFTJK7hU0shMFhMr53GZ0F28cS2Ia+G3q2z/jtCSG6qJnRsMcpXIe1QU1tI2+P7C",
+	"oa82osbNzhsM15tYnZt3yL40kV+/QkqmJmYjCFBnQ3MyV3JFKMmhI8ga3zOD8hdfsUtDV+Xr+fw0OlIJ",
+	"AyVUPHzFtJ2JYAvCBdEskyLXe7U53jrZQqabagjO2tjyBi3TD5VD0+VWZKBGOsVZ7td+OdMj0VuRRaow",
+	"C2PB8gVTe5F0IpVXH6YQins6AanF1Ev4DBaBF6ww9Duprmpx93slq/Lk7Lw959DlULcYZ3PIbV+vUeZi",
+	"UbCGpL6wsE9Ta/wsC/omKB1wDQA9EOtLvlia6H35RsmPcIcmZ0kBCh9QuVTYPl0V008yt8zHVPoEomc9",
+	"WM0RLd3GfJDOZGUIJULmDDa/0mmhtMeLyB7UrFKKCRPLuaDP4JrMmKWujFZ2tdbALFP3S91xQjM8oRNA",
+	"jU5PWLuOYCucbknXjNBCMZpb5RETRM7somuvC1gk1aSkynixzonEQ/ltA9hSyYxpbS1YqDbeC69vh/eP",
+	"2YE8WA2sIsxCtCRzqj7OCq7Xe4G/Zlv0gNTk/o+/6Ad/lkUYaWixZwugTWoj2uq77lLuANMuIm5DFJMy",
+	"agvxJBAj4WVQMMP6kH137PVufxvMDhF8JASumQK3mo96tPwkH4EoA/wf+WB9lCVU5cSKgb3qByu52v0W",
+	"VEgvG+6ZIUxQUG0m+64U2yhetLZLjbh46haBgXvkyZdUGxADCRc56G/xKoR5oA9MMTrQyQ2m7H2N2Ul/",
+	"8Q+x7rSZFJoJXenwKtNVWUplWJ5aHtise+f6iW3CXHIejR2efkaSSrN9I/chMBrf4RFXgrijJlionc27",
+	"uzjwOrDiy/ZQLDfgq3G0C8ZL3ypCfOzk2wMj1/UeILlx3aK3mZQFo6Ay1UaWpeVQZlKJ0K8Pg5fY+tz8",
+	"XLftkiSagWBOkkumwcTk2jvIbxDpGmxdS6qJg8P7J4DCC/3kujDbYz3RXGRssuu8wCPYtooPzlHHvSoX",
+	"iuZskrOCbhPeFviZ4OcDCcOPDQRS6w+kYZMZWBPTNFKfCe//etysEqZKcPefJIEvJLPn3D6jalJzvY+f",
+	"NGcwbYpvOmK9F2YBMJJ04McDZCE9JUaEu38tjSUrbISrcbfSHdfSg70w60dBIIw7qRUB7dn/m2k3t29z",
+	"2vm3TPctvJ76VMvuUf/D3d64MFtXWeu2SV4RvXx5D2Ps40E9tog3VBme8RKeqz+y7clf7+0Jkr4SJGeG",
+	"cqtXjj7gS76M+xP0RW6PedxrfpC6tQt+R9+aWI73zGoCf822oDZ5gxEWkbbqFOqIxKiEazBFWkC967x9",
+	"8cRN2IZmptgSCgLHltwwxYiuZui10jWhGVlO4gHSMVz9MzqDfNIcvtND4BKGipaX8jzE19Zu+K5aT64G",
+	"Otwrq5SySOg/2ye+g4wkBIPchUgp7a5zWhRbYkIYj6ekBpDugii2Hlx3LcVohhWQ/5YVyaiAF25lWBDS",
+	"pALJx/aFGbiO5nSuqjWGWMFWDF/z8OXhw/bCHz50e841mbMbdLkR0LCNjocPQRX3RmrTOFwn0Hbb43aR",
+	"uHTAVmkvWfdqa/OU/U5ubuQhO/mmNbifFM4UhNH45d+ZAbRO5mbI2mMaGebgZzYDV37VdAnrrBv2/RLD",
+	"j05hqGRrWkzkminFc7aXk1+GuKdv17R4HbrdjkdswzJLoxmbZBC1OHAsdmX7YKCjHYcLbrgPHBkKELvA",
+	"XpfYac9Lu/Zb5qsVyzk1rNiSUrGM5Wg44ToK8ZoSGJZkSyoW8AJSslo4V2ccBxh+pVETZq2W7SEOFcXM",
+	"RkzAhKGTYXNgtvTRn1YIY9S+bNv2D3ys3dAACssbV8bA7Wnbg5Im0/Go9+Fv8b2uH/6It2YI67HGxIZ8",
+	"GCGthmag9QzwaWWlLhLjbawPn33BY0TfxzUx2j0ICiDPDnBizAqAIZxtqKMtD76c2As0t/bYV/FHHJ/O",
+	"DVOEm4PpdVe4pAWyjo5sryFpzPf23fRgaJKKjcDE7MbUOLIQExDr4SsrZbacDtQTJG2z42ZYZw34IF6/",
+	"ZM6YCZTXDSpFerMtPo5VsB46BV534igIof7YF4dg9VvF9gRCOQ5EFCsV0xb+htpZ41c5J694puR5sZBB",
+	"xtJbbdiqayzErr/1nLq3x2hcpCi4YJOVFCyhQnoNX1/Bx8FqbhT7ekYEAfygAdsP7QYSWgtoTj6Elu+6",
+	"SUAy7bumbVnX30l1Kq8OHHDwG3aAp8ReNyI35bH+HNbFvusCgequLv8fhyAErgjVWmYc+P1Frsd4Wp3X",
+	"BIZRtND/JoTineAAt8dt2fqjsD80HLGiJJRkBQezkhTaqCoz7wQFzXK01IRzqldG9ZshvvFN0naPhFnC",
+	"DfVOUHBMDvrm5N01Zwm953eMeWuErhYLpk3rQT9n7J1wrbggleAG5lrZ4zLB81IyBR6iU2xp40/mliaM",
+	"JH8wJcmsMs0n7qrShmhjjRroeGCnIXL+TlBDCka1Ia+4dYOzw3m/JX9kBTM3Ul0HLEyHM64FE0xzPUl7",
+	"1n6PXyGIyeFk6QKa7N+us/ewr3OjjOzaG0lb/vf9/3puk7XQyR+PJl/9f2fvPzy9ffCw8+OT27///f80",
+	"f/ri9u8P/uvfU9vnYed5L+QXL5xO6OIFPPyjuKQ27H8GA+CKi0mSKGMHthYtkvuQL8YR3IOmntks2Tth",
+	"XRaNtKmoeE7NCcmnfU11DjQesRaVNTaupTb2CDjw+X0HVkUSnKrFXz+KPNeeYKeDV7zlrZgWxxn1yQF0",
+	"A6fgas+ZcuO+9/23V+TMEYK+B8Tiho5SWSRezPih6VVmdykOJHwn3okXbA76BymevxM5NfQMT9NZpZn6",
+	"mhZUZGy6kOS5D8J9QQ19JzrXUG8CtSiIPsqgluIUdJVey7t3v1q97rt37zt+L13Zyk0Vc1F3zrpqWT/l",
+	"xMoNsjITl8RootgNVSnbm88rgxuFvXfCgTKJrFBp6sYnbvzpUCjLUreTi3RRVJaFRVFEqtrlx7DbSrSR",
+	"IVCR6xDrbWngJ+mcmBS98SqWSjNNfl/R8lcuzHsyeVc9evQFI42UGr87HmjpdluywYqW3uQnbf0KLBzl",
+	"cghimJR0kbLRvXv3q2G0BAoBgWMF78uiINAtxkmIPIGh6gV4fByyJQjZwXHksNxL7OXT2qUXBZ9gU5ux",
+	"+nfawSgLw9EbuCeTA63McmI5QnJV2h4Dv1eObxC6oFxo77Gi+QIeAHopK7tkq4pk2bXL7MZWpdmOG93l",
+	"vHEXe4bDNegoXTDqnFv8ZVTYAasy99ogKrbtvEoag29g0Lfsmm2vJHafDsyOF2VjjFL66L6jC7Qb3bWW",
+	"fOOD7MZob77z8/MxyS79DcT5erJ4HujC9+k/2igAnOBYp4iikVemDxFUJRABHfpQcMRC7Xh3Iv3U8rjI",
+	"mDB8zSas4As+KxJs+h9dO5qH1VKlYhnja6/tCwNqa1rjRpMZXsfuxaSoWDBCwXGmlJoWoB+cJh1LQDpc",
+	"MqrMjFGz0z4g4rQmHjrbn9zYk4VKE0jvyjZ2v7kBJYhgNyx3b29s4xzXp0e57+GaWH4kqL57HZQ/PeYR",
+	"4RCeyOfo7/uwJ+G94PwhY+q8WobvK4vDhZI3djctgNKnLoWEQtE9VWm6YEOvo4ZpcmAKlobFEQbZJ/0k",
+	"5R3rr9AUazoyxsBFYPeJxUuSOzD7xbIHMDu1XGr93Giydlas1zb1gEPqrACBOjgkI+lQ1bDrisVhwKbZ",
+	"GFOiFlY9YE2sxUd/SbU/+vk44uhHSoufJ3XRrqSNF5G3JzXdlIz+mm6z9jHqc2aMSGF7+NSNPl+jT9I4",
+	"Gh+UcHE8Qs6U3DspQIrOWcEWiBNs7OmszgdW76aF4/V8DkxvknIcjZSRkWTi5mD2IfaQENSYk8EjpE5B",
+	"BDZ4csDA5CcZH3axOARI4fKZUT823F3R/1nanoXRH1ZKlqW99XmPlTTzLMWlU6lFnpZLPQxDuBgTy0nX",
+	"tGDC+EDnepBObkB4+7QyATpfogd9b6KBB82tEaSTg1YJPY5aXyx4+2WkXwUHrWEmNxOMxE8+rWabmT0T",
+	"yfgY2yt5eDFT4z0NWd2tDxvccBhQcTB0/ZB5wGqQIPOexQ/06xMbEbzDANktyKeoWZP7Qayuya5Pkj0O",
+	"mB5xuo/s7kcpG08EUkuBWafBdxqdvXqWprTVlUTq63ZcW6F9WGSK1fQdzuRO9mC0qzxt5lb8oU6v2Z+M",
+	"zzX6NEklu0q5u+QBxc4AiD4oDWibHBpA7MDqm7YQm0Rro1ULrxHWUiyJcJEwdnXRplnBQBMwacjVk2u2",
+	"TSs0GMgMl75bpOeE3aNi+yDyvlRswbVhtXHBO1V9etsPqBPtY0vO+1dnSjW363srZRA0oCOBjo1lfvIV",
+	"QKjEnCvrJ28tM8kl2EbfadCkfWebpgXhxmYTrtHUc7AcDBDZ4MGcF1WalB1IP76wEP0Ubi5dzeCi5AK9",
+	"22ZQCiLpEH6AbRLgwUCCnQh6iQh6ST8FfoYdLNvUwqQs5TWn/4scsRYv3MVZErScIqbuhvaidAevjXI3",
+	"dBltJERHbhfTXTafzrnM/dh7vbF8Bok+IQJHSq4lysCZ9iSUi4UNwcPEWi4ImYqQgpHQQopFnbvS/r4j",
+	"XeXU1gLQLunjjnyRLhyC9QVDNMrpQFWYJPRRM4S8juaEXJcwyYIJzBQ0OrzeTiEXewIxoEWkGf20vL0T",
+	"ppF0Vb9quafXPuS4h2GzYXsKRnP3rNLMr2/3oe1ul0PduM/JvZGSePcBgwGB4rjRkQDTIZoezk3Lkueb",
+	"luEPR50eQRIDxb1u5YEWzoAtucH24KfpyL6nVtU9TZy7vDN2nMEz/8w+MtF/3nmA27NBM5fdIq8UWJMa",
+	"3und+g3hoTlw7T/+cmmkogvmLIITBOlOQ8ByDkFDVAJBE8PRIT/n8zmLLWH6GCtOA7iOvSMfQNg9JNg1",
+	"l4W35U767BLZHtqqV7AfoWl6SlBKn8/FVdce6drGurVw2UQbd4RRMZnA4ke2nfwCpfJKypWufVOdgbB5",
+	"rR9AE+vVj2wLI+91+bSA7dkVUMW9ZUChKetK+KSjrPT3dIwxfAM3tvCAnTpP79KJtsaVbuk/GvUNFa+o",
+	"tZSPd2xqFxkL6ZC9ukx7ndizxZrb0ib0fVvUFz0RdYqfIPFUHLw3jrnkQmaXvd5ljBae8GGxo9vx6G7+",
+	"Hql70o24ZyfehKs5uQvgjYn2/4bT14EbQktbOYMWE+cn0yd0KLl2Qgc09241n/h9lT4VV9+ev3zjwLeO",
+	"BwWjahJUHb2rgnblX2ZVWPJl9zWE6f+dbhdVYdHmhxTtsSfNDaT6b2nTOrWVar+pejzvWTNPe4rv5ZvO",
+	"xQuXuMPVi5XB06u2SEPnlnMXXVNeeMOvh3aolh2XO6yaV5JPxAPc2Uks8v6781i9cQJW4+IxW9tT0FEq",
+	"lGBI+NLpIz2dO7wmfVZrWt/DIWGdryFzbvrdJVxeXWCMzuGMnlwO/E6qxkXlomiTDmsfT0C0jwnEY9oo",
+	"f+Ws8B2xcEpQhPx98Tvhmjx8GB/8hw/H5PfCfYgAhN9n7nd4Rz182AUa7940ywJNnqAr9iDERfRuxKdV",
+	"Qwh2M0xcOF+vgows+8kwUCh6nnl03zjs3Sju8Jm7X6yl3f40HaKqiDcd0R0DM+QEXfZFJQbn5xWWs9VE",
+	"ihazwKhsS1pw9biKMWhn7x4hUa3A7jzRBc/STj9ipi1LEujSaxsTaDzYhmznqHiPX7moeDS6baaPMnm2",
+	"FhLNmkS4TmaervE7k44FVIL/q2rEEtubuHU5+6cQjNoRsNP6RTdwu2r26JiC13c3EXqt2i6F0U6T64tg",
+	"BvSISNU1OzDeIZ6xw/x3xCo4ivLXJwS2LZ3r8F7K2vnO210E3ZmBPft0Ftf+B5KrwYqb+WLITnM9mSv5",
+	"B0vLDmAkTKSKcYDAgw16p3xU24wseA7UBdvr2fcRyHDdQh+p3FmX4BcdqjQec4Wn+cRhG32g0iDa7361",
+	"gU6nsx+P4kOehhs/kmYgTQ8zgwMbuYVD7Sjv7kYFnlDMo9KIPEuf86iFPsPx63PuYG7velbQmxnNrtPv",
+	"RQtTtP0Nxzwjie/sN0iHVCA4O4liGUJbjsklS6Zq61E3NfeRbz+cdvCrr37k2Y6N590YfVUKLRPDVOKG",
+	"CsO8LwtyQNdbM/TDsL1upIKEsjrtQ5izjK+SyvB3737Ns67nV84XHEvqV5q5zB7oFQkDEcxaC1TkqtmH",
+	"3DcONRdz8mhcn1m/Gzlfc21d+qHFY2wxoxou6OATEbrY5TFhlhqaPxnQfFmJXLHcLDUiVksS3ucgegZP",
+	"2BkzN4wJ8gjaPf6K3AeHYc3X7EH6gnHC2uj546/GuyrHA8bntCrMLiafA5f3gQxpygavahzDslU3ajoy",
+	"Ya4Y+4P13yc7zhd2HXK6oKW7gvafrhUV1CIkBdNqD0zYF/YXXDlaeBHQKGfaKLltZp2J5meGWo7VE01u",
+	"GSKCQTK5WnGzcp6iWq4shdW173FSPxzmzkH6CHD5j+CCXSbe+J/huUVXaXqg4FX/E9jbY7SOCcUMwQWv",
+	"4y98RWRy4TOhQx3CUH4QcWPnsksHedVuIZS84sKA1qgy88nf7PNd0cwyxGkfuJPZl08T9fyaJa/EYYB/",
+	"crwrpplap1GvesjeSzmurw2iF5MVt8z/QZ3SITqVvb7iyWlNn9txz9B3lq7tuJNeAqwaBEgjbn4nUhQ7",
+	"BrwjcYb1HEShB6/sk9NqpdIEQyu7Qz+/fekkkZVUqcoqNQNwUoliRnG2ZnnvJtkx77gXqhi0C3eB/vN6",
+	"t3mxNBLd/OlOPhYiq3LinRbSKllJ/5dXdT0GMG5j3G5LeylVQk/rNI6f2C31MH1h24aO7oDwrQdzg9EG",
+	"o3Sx0hPuAT/XfT6Hv1cbJNzzhqr08e9E2Xc8yPoPHwLQVmOKTX9/0vyM7P3hw+Eus2l9of01gZrj7prW",
+	"jkPf1FbbwrjPP/RUjQ1+Yy5VSXebcwZMpdZft1OjMHyQzQmdcUAEJM+ELCEoMFrFO+LVjYU5n10cHyTW",
+	"sgU5p+SqKguGNbXBM8SrRLgKmYDHqMB1n6nCV1HE++x63FvSDYVPRa5cMVWQX6fkXGxhVoRsTNhqxnIH",
+	"G9fT1sbajC3Tt/TmlSuwGn2d6GteTqSrYz2BFyJTo+dGVaxXFvVosV/HrSV8erHtNOGeB3txpwmqQTFt",
+	"3Hzm6wk2sw4g6mevzWLPydOXh+9RCAolX8tN9wymiah163t6+hOgqAclA5WqsJJOMeuko8leL6mIbO2o",
+	"M2bdtXWjXt1gp5+/0C5Y1Ix37EXFi/yX2ojfutgVFdky6ZM/sx1/w1dU1CBSAFlTtWBFsjcqG37zSomE",
+	"2uSfsmfYFRfpT62FO9hbkNZgNYHwU/rxLa64KewEMYqa+cxChphiIXMC89SFhmrWOB0lEN8ty9yhJxx2",
+	"VRnn1A25J1z9nzkv7F897gTQcqKo6eGqymXNDSOyNbPmSrikcXSmCOUrkHo0tbXp4BCumaIL6CoFa3WH",
+	"hHcwclRFiOjSfoKWkDtHElMpYSvPRstgwnDFiu2YlFRrHOSRXRbbwNyj548fPXo0zEYL+BqwdsSrX/jr",
+	"enGPz6AJfnGF+rC+yUHgHwP9bU11h2x+l7hcteR/VUybFIuFDxjPbjvDvY6VkkNV7yn5HtK7WUJvVPSw",
+	"0NTZsRspVauykDQfQw5362JGcFbsoxigDio1Lyz8rSOStJENTzHr09f1pP4aPs7uzEOYJnuyI8f2S2hR",
+	"l37mLecxUK3G2JmSF6jVDn5ROAmBSgBqxfIoWzdqUYA47B/G0GxpG8jpaKdGvqd41/CK454D1ta2KGx4",
+	"7T+iYG2kLzqONcfHRFoV/w23SbCX1LA1a+a79GB4e4bPf9lcraqEQMKZHiC9hmp2h+6CBw7GDe4pScha",
+	"+3Bn02mdCEVWKmOH1ma/hF7psKdWofeW2whWuNn4GjlT8srZijIqpOAZ1IZJieD2XTTQKj2gjE7aXKxH",
+	"7iwnjmGyvHyI73dY7C04Px41ENf1CYm+2v1GwsH/GqggYB+xC2a044EsH4N+jxfM2Te50MzVK7T0FXNU",
+	"qRKec8moouCBc0KP/vEIktH1qKq/s99+cqYNe3bJNccCAQ6p7iWI9slCc3BTEIQbspBMu9U2w+r0r7bP",
+	"9GojAIT305dywbNLvoAx0JPTIgWdqLtDnXuXaufCbNt+Y9u6UiPh54ZHIk7q1/0+yUJ02P/OJ1seow/9",
+	"Kdc574cUITeMH4+2gxh3RkrAvWzJ0BajINqwEu7zDtkwpVIPz2+xhIWlN2hBMPA5hZSCiwQYL7nw9vJ0",
+	"GrEseZfAxsBp7umnM0VNtmwwqX3+0j3RRJCTILs+xVCtDQaUwBr9HP3beLURrupLD1sJDerXBRVb4g+F",
+	"pe5IKLFRysE3HYSpplrfSmdOGENfawxUduJdmq1Ytj7xkc0NdO2Now3doXjRofdUX7LWWZUvmLFpP1Np",
+	"+76GrwS++nhMW0CpCjX7QphuM9t9l9rcRJkUulrtmMs3uON0OddUa7aaFQnP5RfhI8vDDltKsyYy+2+q",
+	"YF3/zriYgYOD532AQH5YiYduMoCU9GxpeqL5YjIcE3Cn3B0d9dTHEXrd/6SU7uPm/xRh8S0uF+9Rir99",
+	"ay+OOMt5J0QCr5aQhBzCEbyCHJ+fIRFuy9ZAkWg7c7rNS2xZC3jfMAn4mhY9CStioxfer95gkU5bkfVm",
+	"ZaHGJf8zlNQ8YYgKoz99GjqwtwxrXetwn4s6eqh/TNuTw8dOpPcban9smGXRabBmKL3m2OMspjURHGoy",
+	"dZUsuvpSWhQyG8wZ3DDntlN/pmO5WrnCAQmnxvVK5vFZiJ3hGEszNp4nf3YP2+Q3eFolv6ib9GgN/Ugg",
+	"mqFJ3wCNbgljjGv14HlgcOp2zTCnPHOYJd/xgnmj4qh/I6Md6G6pyzyeVGH3bUwI9GuTx0I28LGDB0hR",
+	"pPXfukelDqm10qfBFRNPfvhOm6EgYZqpQ1q/HDp4hwAWEotqpcqOdJP7jOrt8MiPqKHeXuQoMXWkqKJd",
+	"rCrx9oEWEWty6pLOaD0KkIaMNKQ2VqoMk3speA0sXjQunR/WpuqUteow0BdDhMMOPm7Ho4v8IPEpVcpr",
+	"hKOkGOxLvliar63G+wdGc6awHEvqOYnFWFbWZq/0kpfw/iml5nX57sIO5vKgL2G46dDIJqi9aD+FHAud",
+	"sbz/+ZplBsq51160irHhbiJleokWAm9QhCafwZNGMZaz0ix3CkvoG1+aZV3ll7nAPWtxZc50sWZiTPiU",
+	"TduxfnmdU4sUjM69ElZJaQaUwQ5RX4DGGOgUfXVKqu8WAzsp86KMkFj5ejq8hs15CKnAOFVwkfGJt1pZ",
+	"KAZHu8/nLIN6ATuzF/5jyUSUzm7sVXcAyzxKZshDtCVUvDipRruGtaBHglrQTwJpXz6Ra7a9p0mDhpIF",
+	"vEOA8jEJ9AE5aMf1NRn6TBvOr5TrQE+AIB9GgN1ZXaLqmBoKUXLPI8HwNE5onPDzOGi8RHMEGLbrgZP2",
+	"ZhMEwbQvOeIbzDscXeX9L+UXzFBeaOeTS0O2/lifZFXj7erpNy7bP+SpDNZCn/efaf+bz2+LsxT8msVV",
+	"i8E2a1Mi+xYnyTIIzQhPAz0PM/M6rqzr5XOoXw4GeGaFtALQpC+uthnoFTyg72l0Va9zvgHUc6YUy4NN",
+	"sJCaTYz0noUH5E5F4HZhT4OT/lF4awVEHBBxjSvqLUHxtq7DAdU0KZScoM53P8YKUWxFLfQqqo2RVoPu",
+	"26Fv8LtPyeKrI+5Wr/bhPZyL/QXtfeQi1x3Mx6drTpxwcDD3auRxOUIzy4VgauKNuO3KGKKZZRTSUudV",
+	"hqJKfDaD9npw1rYd3Cyp1My6q2w9oaKkJtdse4ZqH5feJOx4DDTKkAh6lI+7RRQn1VXrFNyLk4D3ebOf",
+	"llIWkx7L4EW3nEf7MFxz681F7GXlA3usFHyveWzsJOQ+GKSCz8jNcuuLVZQlEyx/MCXkXGBwpXcfaRZw",
+	"bU0u7pld829g1rzCAj1OAz19J9JRalAoR92R+/lhdvC8Pt6kmcjvPD8OcsTsZiP6fORuoKJOs8zydKh6",
+	"o+vf0RKhIvJDKFIC1CUagr8BlpB4RxFIbhNlYQL/AEqcAZnoQiaCGI5KwGOHSmMqngwAMkwMeK7WULjB",
+	"kwhwTnaOW71eM6V4nkCF/4Jp1bX3mA65Ll3i7QGJa/serTvSkRpJpJv/yMxSvWlqOwV4wnWBfqnMjMGa",
+	"JBYNiLiwV7RgzPkoDboSArLLElN/eWynbN5xFYq+5BR1LLmRRLGyoBmWujPSSW5eyIsrJEkTivccDnqU",
+	"tWQX+L2V6C7AqXXNwXvJgdwuMuI6j5ss6SMYkhyN7DwY7b3q+sowo4mvg9CTnY20Uqx1zwn5ESjOXltU",
+	"MdikFRP2E8vJNWOlCyvyDoN1YaKEB1e+L1LhqCykfRl8Y2saHpmPkqjXrWzcm7F3J43uYGh1Xh1f/GYA",
+	"F+t5Vfz0F8ijdGTGJJ9D4+gUSXVmJIe9XZv4tdz0793bmG+4WEK8kiAiprN/Y+SGALFpMu5jTk9/nM/0",
+	"ZIE+u2L2Uvr5fvv0IQFvVgEkMdWIywIjN770nxk0cd+h7Y0O8hu+J62+++wTx8s5Uaz2Dj02g75LSo/P",
+	"SN1nnGnPHGZpvs3mUrF4Roh0wUobITWB5WwE/phxo6jaHpPnvomqFN/sxfLeeI0QqlEvpA7X6OKwKOTN",
+	"BB5Wk1AeMyWt2Ha6qTjwhebrfsRISLkUAj+odvLLlixpTjKpFMviHukcPQjVSio2sRVVkhn4XvK50aTg",
+	"K240AeFvQWRpTwFWsk1TUN9clbD0nU8CTfaiAGnHrtT1ieh44JT2/Y8OYhPQGC2GCm9Xtg/mH6vzF+Oi",
+	"J+ik2MP5mHb5ih2GsHEXXiAcTKnZNgunlXRzvgG6YUonRUWjLJNyLWD0BgkFcWnFtUZQAi3d8KKA9F98",
+	"U/MDFjyS06gtZQmY2rWRASxMeNnexMAcZtseVCDYusoyxnIXgK79Uxif29DunibKB6q6GPRxyKepmHsJ",
+	"ujG5dsFPlou/RQ6sSQ91phffo7psSOzNPHjQg5SKZSwkD4wZ4GWcT5iYpZLVYhlVtwqb5C0nqnJ2lXiU",
+	"n3UFISGQ4MRO8ZSspDbOKoEj1ftdR+Dcz6QwShZF046KataF87l7RTfnWWZeSnlt89k9+E9o40gL5G5v",
+	"S1pybaTatoeFNf6A30AHq8fBxnLD2DVcVQiiFISqbGmLpbpZ6mxjDyA5CiSo8/wvpHyWhb1D3CClko7A",
+	"NLeEAdxRGwpxHxZglHLgKhfShB3Lx36qdghYjTHVyoQ+sJAy6GD9A33wU7LxrNI+/AGoWe+vmoTtiKnR",
+	"dfBb1l15HUeYfQ+RCMz3+6/a/X425ykW0VxX89ZN6+bPBaFGrniWZr5/rWis3hiqHurpc5/Co2skKbE2",
+	"oSBGlqEoZH1xITtyMpy/JRxHU7iRUxKmQ1ZEwcugzfR2Rpn2PUGjFGVO+WG5SFPF0y64HxdSOlyP09L3",
+	"9URdRAWidoEeQRUnX9cfRTPWU165AZF9tvh34cFAxE/Pg4TrWL5KHU/s4RKdQjOQVGJJO4R8gHzXJSYm",
+	"LKNOjE7cRe5c30FWcnpL3hmXzBk1nbkjKT8hGWHE/oCZAURMsmflEfuXExszqY0P/Q86Xl+TwZ+65OON",
+	"XBiSS4bKS8cnoHd7YfiCQCzl6ZU4dfok61X6719QQyUf5Bu5QA0JhCC0IRso3EOk191gsyOcHCjD7gRU",
+	"J/Y0AHgfOcYY+RkKuHB88fuDur7EUcDvOa+Nq7kvhO4yuiegScj63HPfpqv17Yw3u4KMkbOhUWfa+4QO",
+	"fGhFAPTHoTVgGBSNdigYc2qDlSfU9DwzwONhHBlnnaYtGp27FwLMQjKKTwfrXEh5USnmshCjpkU1nUdL",
+	"apZe+LXNu/5PVinnMnn9wZQE/Vg+jpwXXWqwtv1YlpOCrVkjPA9pGd55WvM183116Exyxkrw7227VaTi",
+	"ziI8tu9Et/ZJFLk0BLtJ4zsiFneK7LGspxSP4Q29F6gom1v34Q2OmKpi4cWLcLlns92UWWUINzrxBs9k",
+	"VeRwV8zqtzUaxsJAga3471H/OfiwgZdfpIyyr7Ep+XZTFuAVf7PcTnetP+/xobnTqlGp2N2usJouMriJ",
+	"FJbAWNwJ8ILp2LIzxhdguor/pzVzeVVA5rIH3+I7HP4pOfd/xtnboQdqjH3xYtBxWJKx0zuTpNdxhOFI",
+	"RoWQKPFazUW9hmkzOwzOBfdDTpZ0zXzKvVr7o9hKrn1ahBqPFy9QUWKlv8q0TLe7H0bWWknzbdBRLgR2",
+	"/0jvIXwN43Wjh15J9mSveV7RBh/ShwrATQ8seyUmwOsolSaeyoZO8zOO8NYPcO77px7cHhPvh93nB1/l",
+	"adTtusj3xnNXuu/2FOlw7jh/fnCvhdnyEA2AV0V9ZnRJb0S/L1j36gDtcSTND9gpLkWE2m83LHvj+je0",
+	"0UePBu8mpw5muVMI9ziIeI8QywLQioe6koVI+FRaBi5kzRfAscwrM+vSRP4HnBgaceGMDUf4m9RR3Hen",
+	"FAKDEd2qGJLc2fqY3M3T8rOc7J0Hu3e8FI1o5hKq7TAP+tMS6eNvnMygVqDxgmvFSZdOkEGJAweyenXw",
+	"oGm8Ul8w71WP1OcdfXFFvtQGqJQQ3eP+Ozvk6bCxJ1LBP0Ia8q+KFny+Bb6F4PtuRC+pJSHnxo+xLC76",
+	"3U68+9kz9oB5Y5T0U+G6+dAxo+G2dpQIaCtgO4so1J64ZvE2QJgO8uPMWEasqxkYdqwo3drOLhbc4n2O",
+	"8RXNY1sAVEvaNriDjMSQ/6yTh8VT+SIm4G2Q+83TdNXyGIVHSiAus2SrQ9SAVxEJ+FYR0QYbUH6ERflA",
+	"1pXSAfa5zjXA7tFLnmoZAw3jrULdO9L0DVrKqXfhNJm0DvUUbCyu5TX4CXYnWeasbxlDwP8T7UrDdWqg",
+	"njpeDzT5FLvQSIecgBVdAWZyM1FsrveFM0FrC3wNsA4mXC7sK1CjevbitVMn1VW8uLCvUIydDs7tYZSc",
+	"zbmoWS0XZWUSz12whYhthLDYowLQ2uMh3SdjWFF0TYsdFqUrcIOHaIBWpWnvReL6JvXXbg+7A3Bda2Yg",
+	"q13toxA3s9d/zudzpjCCWRsqcqryuDkXJGPKUG6DGLb6eHed2sVhj8MOjWShZs7WyHUHSBsBKbbRy/kO",
+	"zjQBQHpCr5oB3jBXS+aov+kJE/QqPR4fHRj+Et4wK7qxDlSQe63nQLhibeA+Bc2IFGA6Rulu2Lr9PJr/",
+	"wXZPA+UYHCMyEmYdNsWJHXucUndeFd4RIeHFw1XHf+dACwWX4jXQGbyQfxbc7GRLaBZpZ+rDYHjkGpFl",
+	"O2TwQEruMosyS09WNhMsejnaO6b7g8EiCktGzXdMcT0kBiE4LjNnbHc7wLDbiPJJXH9OiTIB5YrekaOj",
+	"NlsCrrXTunWCIttaGUTK2CXAPFC5jyZBf2n2gIfxAI4RNacNMVx2nENc83envJyUspxkQ8KfnfcwAuAh",
+	"bcLYQx+R3bFn3SE0Swd3qZgam8EYB3ol9Jfq3+e+Uma79BlcijffvOkzqMMdEzhzIDi3l9QAh20dwu7p",
+	"zaTu2ResTReb5GEGqRvTpjdkyc1e6RCyZcUgW33aHkZwIK/pp5nWPgAWHNjjAbuC0+3cmoQvg69V4KFt",
+	"3qt33BeWvqbjTUmW3bz84fzZ4ye/PXn2JbENSM4XrB7TgfrpM/2UmR601fGzKBAPRIS5BwZo2ZTLtHSI",
+	"eaNx+lLMrZopWRkueh8BdYMISCv99ECIxfc6B/ZAoC/DtL3A91A/Fh+xmN9N/pcMlG3n+ZqKbECoAGSo",
+	"cGHVkPcFJHAaOxVpHLJ7DtCbdR8vwVY40xpjAqlB719b7+KgKOhMDpjQNbMzWgMixsDYpUXlEeZS2Yjp",
+	"MdGlNQnCuw8aCnbjIJ6Sb+1DAP6D0rAfrDMMqCbdmp488wDsXVk6otFhddA2D9lf8Cs+ZEd3Zb7AOHLt",
+	"ijhAFLEVSuOgIyIV+F2MY/9k5YsrQPol8jr2hra4BWdM70TujMvg6G29oXXXf7vrsf0Az7E29pWU2f3U",
+	"qMUlV82xnBhl6sK4dc0yqokNAyNUN1YKIg4Tn8urevfrostTkvvnTPwSbrma/aGFKrpKWldd/7MxJS1E",
+	"40IyFOCnPbldjhFuAByftyhEKzR4Odv6aclbllUKvHZw8VQxx7tzIu2Dzm45WzO1hcS7rt1JpBt0NHJr",
+	"kPMWnEOkHkD82PP/vWJPj3F3qPizy5UTDkbTKcoZtvwOxIMkXlJcEEpU2Iwbuk1G8kGthokD4HDzdkvw",
+	"g/RBjKo6JdmphoUV+oF6nPhBzSvnZN8G1d5tKVxa9WgCnz55zBECSL8PQH8e8zuj7PYQyr3ybggprV3T",
+	"eVTO4cBazDl3DKDUYG7v0GnT8SLoWo6gzmPkdT9I/cZw+2hBaHkGfFqBvrM8k94Et7EOcd4H3ieYDZvi",
+	"+AsqraKUCo3VH0G8bT1agmpTR/6YvYJx6gSEf67tSi3y5DuWQsHH3zMbkzdztVh6NLsJ19fUbkXOr5aN",
+	"lkxprg0TpuW7zk2d/kwvwYEEypuvmQpyQnQxErbhpif4MrWQvuxZwM/sJ+JcawlDp1TLq9BHd9e6nC0O",
+	"fTjAMOAl6OC6asXoFEQd91TnGgMSW5QQKzBbTI2VIkS8CXtIb9A1CITRvQQTnP6j34XBg63/JjyGk9TO",
+	"X38a/pEoUXIyrhGW+zF4RVKQ2JF//bwTsRLKcwwCrVuKIkEeAEBP5vFGeugonW1URF2hHxm8ZlahWHhT",
+	"/HhVe9/vzQEJkPgOe8CLs4bX7ULaQgfOZy6hXVdQj5byvo8SGsvfl4jcs95wkURb5MyBxjCNbEl2xcIo",
+	"9bz+JmR07zHudBK/KykNxJMXRSJhPNrq4UzFhAO14te0+PRc4zuutDkHfLD8bb+iKE4QHiMZUalPXvry",
+	"JR0EVkE/LVT2FbRm4h/M7mzydnSzOGfxzh0IZn9aYFaT8DhfM0FuYEyMF3n8JZlxTJxUKpZx3XZCv/Ei",
+	"TchszZT1uoQpbEnKVpbtO2e5+kWaOxyHuY/FIj9FjpTBO9zBXB/1z8ycejhA8rSkSLVDKAn8pXidLUHY",
+	"Xx+pce1cN9K7dRPcEW2kYicumhSVSDywaFK8MihhOXh5sA64vCrNuuscfOs3cJu48Ou1Da0K1kVuf+ku",
+	"MxtSugt/SHWHamKIENtoSgBU8vvj39GTBU7Tw4cwwcOHY9f09yfNz/Y4P3w43DTzGUuJISrdGA6SJGHV",
+	"Ive+OjGtWNWoIkJzF624n94J0GPbNFxyjo+CeSVwPM+GXZydY+tyPg6e6hK08s/JO/GQ6CX1bwv33yfP",
+	"vhyNR0xUK7v4+vtoPHJf36deavkmmcG5LlnTic91RrN7mpR0OyRt/N4iNUn81jV5Pr1Iow2fpd90P9g9",
+	"g4eriyC8EMDqgb3gDeoq1fy/Ujs7iaF1WMOJQZKsC/GErdhXk+eXvgL0WGTdV5iPPOgS3Lfixd5AqK9t",
+	"Iz+bzcmP5cB+s1D+Nvvy6afPzu4h6KnM55Z+l4JbiJjEWhuTR1NF5dMcqmpNQcOHK96cboLwW7TuV4qb",
+	"7aXFv1e789+uU2WXvg+FkFx1reBl7WRfI6+Z8HFEddmkSnvp+ntJC5A+0flbMGKkLGxoOF2VhfPFI3+/",
+	"N/sP9sXfnuaPvnj8H7O/PXr2KGNPn3316BH96il9/NUXj9mTvz17+og9nn/51exJ/uTpk9nTJ0+/fPZV",
+	"9sXTx7OnX371H/cspVuQEVCfRfP56H9OzouFnJy/uZhcWWBrnNCS21pTt7egYZtLdDkShmZwxbIV5cXo",
+	"uf/p//cX5TSTq3p4/6u9EZVtvjSm1M/Pzm5ubqZxl7MFVBuZGFllyzM/z+24hfHzNxchuwxaBGFHa9e9",
+	"6agmhXP49vbbyyty/uZiWhPM6Pno0fTR9LEdX5ZM0JKPno++gJ/g9Cxh38+gXvWZZsa+hvRZSJF4O+58",
+	"K615yn1ahIKb9n9LRguzdP9ZMaN45j9BGLj7W9/QxYKpKcR+40/rJ2f+7XH2wWWYvt317SyOODr7EP1v",
+	"wvM9PUPMTNJb3Sa1g2CJKLd2MwLIojdsw0Vu0Y8tIbRFX9SMEFDszokePf81pbHFrqSsZgXPrHA99QRs",
+	"dyeir1DdqOYfoJ8fIf+0K6m5oeVwjyZfvf/w7G+3yWDcblxOHdC282t7Da+cl3l9j7moc0yvbiolwor+",
+	"VTG1rZcEISCjeAEDxZ3kr0mXCft2La3aoYbL5kZk9csWGVcIZ3Y5D0vF1lxWOnTqWYIdIrWC8Hp9Px6h",
+	"vlEjh33y6JFnL+6pHtHumTsS8ZY2zaKdsLVDyqrEYWWpd5ZdzATw0T0WP2vnmFDSBRcuQTTEiq/oNRqE",
+	"IRrUJ37zGHUB5oDkkG/DbYu/QVJX717HKAtLlFI6duCHbBUFW9ODCwGlPMNSlU673LqHA/gQ8VidX3A0",
+	"VrjAPJvtHENt60oht+PR0wMJZadavVEPPAH+K1pYkDHZiWcDTx89/nQQXAiMZLbXHl7Pt+PRs0+Jgwth",
+	"mBK0INASL2RI/Zk4DOJayBvhW4L/62pF1RYkJTNkj51PFXhA+HZ4JPBip/Z4/zrCa2FkI61KpviKCUOL",
+	"0fvbfdfb2QefOX73ZRib9s5cHH7UYeAlu6vZ2UxuDmjKdNS4fynoOXv2AU5o7+9n7q2Z/ggmAJQSz/wD",
+	"uqclVpxKf2yg8IPZ2IXsHs62icbLqMmWVXn2Af4AgS9aERjQ9JnZiDOImjr7wPPu5w4imr/X3eMWUKTc",
+	"Ayfnc83Mns9nH/DfaKIGYdZCVVNA+jZq9M2SZdej9LXYPGZxL4LyMKQoROb0dEAHIU3c6agD/RZkGE1e",
+	"/2gN/Kw9BdeNzInDzi2Whj7TVVkW2xqX/uetyJI/dre5UQG35+cz/xxLidbNlh8a/20eOb2sTC5voll8",
+	"4OqZc9vVOz6dfXB/tQbd2W7no+DgrkPZ2b6B0al+ePvh3HHPSDGniToZahhaULv0YT9Wuv3/sxvKjdXz",
+	"ukK2dG6YSnVWjK4cB+j8nIbGMFrA9YsOjPGvOddUa7aadb+oraoiwuoZOvr1jLpDMiqlTjCct/QmUj+f",
+	"Q2OU7Zg2X8t8u0Ou2ExmXMDZj2WLWvOEH7uGqttxQliF4Ftv8u+WboPMaErSPKMYPCCYuZHquvPMu00y",
+	"zE8tJ35Nc+IjHSaklhrPnX6jsbQ/hwyZvChe2LSTlmKIVGTfrfGZpdBnj774dNNfMrXmGSNXbFVKRRUv",
+	"tuRnEVICHX2JfgfkrahT6AeSx6BqW9YwppxmkH6d3R3fjnBAosIIjJgNWVKRF0yFfAslU5Y27firKCww",
+	"s8KHdqWVS6kAAKyuzHJ0vNNTchncEsHJr/Jv3xzJBqzndgg3CQWXRXRbGSAE2Aeo5QcLJiaOI01mMt9O",
+	"nE5B0RuzwRj3DtvDF0IPT+zI76mvTkTtaeRvIP+51nDHGmNQZQVd8a/vrZZDM7X2Wq5aAfr87AxSGy2l",
+	"NmegpGkqR+OP7wPmPnj1Sqn42kJzC0iTilvdQzFxGsRJreR8Mn00uv2/AwBE4h1i7zQBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -1,6 +1,6 @@
 // Package model provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version (devel) DO NOT EDIT.
 package model
 
 import (
@@ -46,6 +46,7 @@ const (
 	TxTypeAfrz   TxType = "afrz"
 	TxTypeAppl   TxType = "appl"
 	TxTypeAxfer  TxType = "axfer"
+	TxTypeHb     TxType = "hb"
 	TxTypeKeyreg TxType = "keyreg"
 	TxTypePay    TxType = "pay"
 	TxTypeStpf   TxType = "stpf"
@@ -117,6 +118,42 @@ const (
 	GetTransactionGroupLedgerStateDeltasForRoundParamsFormatMsgpack GetTransactionGroupLedgerStateDeltasForRoundParamsFormat = "msgpack"
 )
 
+// Defines values for SimulateSessionTransactionParamsFormat.
+const (
+	SimulateSessionTransactionParamsFormatJson    SimulateSessionTransactionParamsFormat = "json"
+	SimulateSessionTransactionParamsFormatMsgpack SimulateSessionTransactionParamsFormat = "msgpack"
+)
+
+// Defines values for StreamBlocksParamsFormat.
+const (
+	StreamBlocksParamsFormatJson    StreamBlocksParamsFormat = "json"
+	StreamBlocksParamsFormatMsgpack StreamBlocksParamsFormat = "msgpack"
+)
+
+// Defines values for StreamTransactionsParamsAddressRole.
+const (
+	StreamTransactionsParamsAddressRoleReceiver StreamTransactionsParamsAddressRole = "receiver"
+	StreamTransactionsParamsAddressRoleSender   StreamTransactionsParamsAddressRole = "sender"
+)
+
+// Defines values for StreamTransactionsParamsTxType.
+const (
+	StreamTransactionsParamsTxTypeAcfg   StreamTransactionsParamsTxType = "acfg"
+	StreamTransactionsParamsTxTypeAfrz   StreamTransactionsParamsTxType = "afrz"
+	StreamTransactionsParamsTxTypeAppl   StreamTransactionsParamsTxType = "appl"
+	StreamTransactionsParamsTxTypeAxfer  StreamTransactionsParamsTxType = "axfer"
+	StreamTransactionsParamsTxTypeHb     StreamTransactionsParamsTxType = "hb"
+	StreamTransactionsParamsTxTypeKeyreg StreamTransactionsParamsTxType = "keyreg"
+	StreamTransactionsParamsTxTypePay    StreamTransactionsParamsTxType = "pay"
+	StreamTransactionsParamsTxTypeStpf   StreamTransactionsParamsTxType = "stpf"
+)
+
+// Defines values for StreamTransactionsParamsFormat.
+const (
+	StreamTransactionsParamsFormatJson    StreamTransactionsParamsFormat = "json"
+	StreamTransactionsParamsFormatMsgpack StreamTransactionsParamsFormat = "msgpack"
+)
+
 // Defines values for GetPendingTransactionsParamsFormat.
 const (
 	GetPendingTransactionsParamsFormatJson    GetPendingTransactionsParamsFormat = "json"
@@ -523,6 +560,9 @@ type AvmValue struct {
 
 // Box Box name and its content.
 type Box struct {
+	// DecodedValue Present if abi-type was given. The box value decoded as that type, in JSON. Tuples are arrays of their elements, byte arrays are base64 encoded and addresses are in their string form. Any JSON value, embedded as is.
+	DecodedValue json.RawMessage `json:"decoded-value,omitempty"`
+
 	// Name The box name, base64 encoded
 	Name []byte `json:"name"`
 
@@ -791,6 +831,54 @@ type ScratchChange struct {
 	Slot int `json:"slot"`
 }
 
+// SimulateAccountOverride Overrides parts of an account's state during simulation.
+type SimulateAccountOverride struct {
+	// Address The address of the account to override.
+	Address string `json:"address"`
+
+	// AppLocals Application local state keys to set, opting the account in if needed.
+	AppLocals *[]SimulateAppStateOverride `json:"app-locals,omitempty"`
+
+	// AssetHoldings Asset holdings to replace, or to create if the account is not opted in.
+	AssetHoldings *[]SimulateAssetHoldingOverride `json:"asset-holdings,omitempty"`
+
+	// Balance If provided, replaces the account's balance, in microalgos.
+	Balance *uint64 `json:"balance,omitempty"`
+}
+
+// SimulateAppStateOverride Sets keys in an application's global or local state during simulation. Keys that are not mentioned keep their current values.
+type SimulateAppStateOverride struct {
+	// AppId Application ID.
+	AppId basics.AppIndex `json:"app-id"`
+
+	// Kvs Key-value pairs to set.
+	Kvs []AvmKeyValue `json:"kvs"`
+}
+
+// SimulateAssetHoldingOverride Overrides an asset holding during simulation.
+type SimulateAssetHoldingOverride struct {
+	// Amount Number of units held.
+	Amount uint64 `json:"amount"`
+
+	// AssetId Asset ID of the holding.
+	AssetId basics.AssetIndex `json:"asset-id"`
+
+	// IsFrozen Whether the holding is frozen.
+	IsFrozen *bool `json:"is-frozen,omitempty"`
+}
+
+// SimulateBoxOverride Replaces the contents of a box during simulation, creating it if needed.
+type SimulateBoxOverride struct {
+	// AppId Application ID which this box belongs to.
+	AppId basics.AppIndex `json:"app-id"`
+
+	// Name The box name, base64 encoded.
+	Name []byte `json:"name"`
+
+	// Value The box value, base64 encoded. If omitted, the box is deleted.
+	Value *[]byte `json:"value,omitempty"`
+}
+
 // SimulateInitialStates Initial states of resources that were accessed during simulation.
 type SimulateInitialStates struct {
 	// AppInitialStates The initial states of accessed application before simulation. The order of this array is arbitrary.
@@ -817,9 +905,15 @@ type SimulateRequest struct {
 	// FixSigners If true, signers for transactions that are missing signatures will be fixed during evaluation.
 	FixSigners *bool `json:"fix-signers,omitempty"`
 
-	// Round If provided, specifies the round preceding the simulation. State changes through this round will be used to run this simulation. Usually only the 4 most recent rounds will be available (controlled by the node config value MaxAcctLookback). If not specified, defaults to the latest available round.
+	// PopulateResources If true, the unnamed resources accessed by each transaction group that succeeds are assigned to the group's reference arrays, and the rewritten group is returned. Requires allow-unnamed-resources.
+	PopulateResources *bool `json:"populate-resources,omitempty"`
+
+	// Round If provided, specifies the round preceding the simulation. State changes through this round will be used to run this simulation. Usually only the 4 most recent rounds will be available (controlled by the node config value MaxAcctLookback); nodes that keep a state history (controlled by StateHistoryRounds, about a week of rounds on archival nodes by default) can also simulate against older rounds processed since they started keeping it. If not specified, defaults to the latest available round.
 	Round *basics.Round `json:"round,omitempty"`
 
+	// StateOverrides Ledger state to patch on top of the simulation round before evaluating the request. Overrides only affect the simulation.
+	StateOverrides *SimulateStateOverrides `json:"state-overrides,omitempty"`
+
 	// TxnGroups The transaction groups to simulate.
 	TxnGroups []SimulateRequestTransactionGroup `json:"txn-groups"`
 }
@@ -830,11 +924,26 @@ type SimulateRequestTransactionGroup struct {
 	Txns []json.RawMessage `json:"txns"`
 }
 
+// SimulateStateOverrides Ledger state to patch on top of the simulation round before evaluating the request. Overrides only affect the simulation.
+type SimulateStateOverrides struct {
+	// Accounts Overrides of account balances, asset holdings and application local states.
+	Accounts *[]SimulateAccountOverride `json:"accounts,omitempty"`
+
+	// AppGlobals Overrides of application global states.
+	AppGlobals *[]SimulateAppStateOverride `json:"app-globals,omitempty"`
+
+	// Boxes Overrides of box contents.
+	Boxes *[]SimulateBoxOverride `json:"boxes,omitempty"`
+}
+
 // SimulateTraceConfig An object that configures simulation execution trace.
 type SimulateTraceConfig struct {
 	// Enable A boolean option for opting in execution trace features simulation endpoint.
 	Enable *bool `json:"enable,omitempty"`
 
+	// Profile A boolean option enabling returning opcode cost profiles of the programs evaluated during simulation. It does not require the execution trace to be enabled.
+	Profile *bool `json:"profile,omitempty"`
+
 	// ScratchChange A boolean option enabling returning scratch slot changes together with execution trace during simulation.
 	ScratchChange *bool `json:"scratch-change,omitempty"`
 
@@ -859,6 +968,12 @@ type SimulateTransactionGroupResult struct {
 	// FailureMessage If present, indicates that the transaction group failed and specifies why that happened
 	FailureMessage *string `json:"failure-message,omitempty"`
 
+	// PopulateFailureMessage Present if populate-resources is true and the group succeeded, but its unnamed resources could not be assigned, or the group with the assigned resources fails when simulated again. Explains why.
+	PopulateFailureMessage *string `json:"populate-failure-message,omitempty"`
+
+	// PopulatedTxns Present if populate-resources is true and the group succeeded. The transaction group with the unnamed resources it accessed added to the accounts, foreign apps, foreign assets and boxes of its app calls. App calls that create and delete an app are appended if the group's app calls cannot hold all resources. Transactions that changed have their signatures removed, and the group ID is recomputed.
+	PopulatedTxns *[]json.RawMessage `json:"populated-txns,omitempty"`
+
 	// TxnResults Simulation result for individual transactions
 	TxnResults []SimulateTransactionResult `json:"txn-results"`
 
@@ -871,6 +986,9 @@ type SimulateTransactionResult struct {
 	// AppBudgetConsumed Budget used during execution of an app call transaction. This value includes budged used by inner app calls spawned by this transaction.
 	AppBudgetConsumed *int `json:"app-budget-consumed,omitempty"`
 
+	// ExecProfile The opcode cost profiles of the programs evaluated for a transaction, containing the profiles of inner transactions in a recursive way.
+	ExecProfile *SimulationTransactionExecProfile `json:"exec-profile,omitempty"`
+
 	// ExecTrace The execution trace of calling an app or a logic sig, containing the inner app call trace in a recursive way.
 	ExecTrace *SimulationTransactionExecTrace `json:"exec-trace,omitempty"`
 
@@ -930,6 +1048,9 @@ type SimulationEvalOverrides struct {
 
 	// MaxLogSize The maximum byte number to log during simulation
 	MaxLogSize *int `json:"max-log-size,omitempty"`
+
+	// PopulateResources If true, the unnamed resources accessed by successful groups are assigned to their reference arrays.
+	PopulateResources *bool `json:"populate-resources,omitempty"`
 }
 
 // SimulationOpcodeTraceUnit The set of trace information and effect from evaluating a single opcode.
@@ -953,6 +1074,78 @@ type SimulationOpcodeTraceUnit struct {
 	StateChanges *[]ApplicationStateOperation `json:"state-changes,omitempty"`
 }
 
+// SimulationPCProfile The evaluations of the opcode at one program counter.
+type SimulationPCProfile struct {
+	// Cost The total opcode cost of those evaluations.
+	Cost int `json:"cost"`
+
+	// Hits The number of times the opcode was evaluated.
+	Hits int `json:"hits"`
+
+	// Pc The program counter of the opcode.
+	Pc int `json:"pc"`
+}
+
+// SimulationProgramProfile The opcode cost profile of one program evaluation.
+type SimulationProgramProfile struct {
+	// Cost The total opcode cost of the evaluation.
+	Cost int `json:"cost"`
+
+	// Hash SHA512_256 hash digest of the program.
+	Hash []byte `json:"hash"`
+
+	// Pcs The program counters that were evaluated, in increasing order.
+	Pcs []SimulationPCProfile `json:"pcs"`
+
+	// Subroutines The subroutines that were called, in increasing order of program counter.
+	Subroutines *[]SimulationSubroutineProfile `json:"subroutines,omitempty"`
+}
+
+// SimulationSessionAdvanceRequest Request to add empty blocks to a simulation session.
+type SimulationSessionAdvanceRequest struct {
+	// Rounds The number of rounds to advance, at most 1000.
+	Rounds uint64 `json:"rounds"`
+
+	// Seconds The number of seconds to move the block timestamp forward, spread over the new rounds. Each round can move the timestamp forward by at most 25 seconds.
+	Seconds *int64 `json:"seconds,omitempty"`
+}
+
+// SimulationSessionRequest Request to start a simulation session.
+type SimulationSessionRequest struct {
+	// Round The round the session starts from. If omitted or zero, the latest round is used. Older rounds can only be used if the node keeps a state history (StateHistoryRounds) that still covers them. The node keeps the state of this round for as long as the session is open.
+	Round *basics.Round `json:"round,omitempty"`
+
+	// StateOverrides Ledger state to patch on top of the simulation round before evaluating the request. Overrides only affect the simulation.
+	StateOverrides *SimulateStateOverrides `json:"state-overrides,omitempty"`
+}
+
+// SimulationSubroutineProfile The calls to one subroutine of a program.
+type SimulationSubroutineProfile struct {
+	// Calls The number of times the subroutine was called.
+	Calls int `json:"calls"`
+
+	// Cost The total opcode cost of those calls, including the subroutines they called. Recursive calls are counted once for every active call.
+	Cost int `json:"cost"`
+
+	// Pc The program counter of the first opcode of the subroutine.
+	Pc int `json:"pc"`
+}
+
+// SimulationTransactionExecProfile The opcode cost profiles of the programs evaluated for a transaction, containing the profiles of inner transactions in a recursive way.
+type SimulationTransactionExecProfile struct {
+	// ApprovalProgramProfile The opcode cost profile of one program evaluation.
+	ApprovalProgramProfile *SimulationProgramProfile `json:"approval-program-profile,omitempty"`
+
+	// ClearStateProgramProfile The opcode cost profile of one program evaluation.
+	ClearStateProgramProfile *SimulationProgramProfile `json:"clear-state-program-profile,omitempty"`
+
+	// InnerProfiles An array of SimulationTransactionExecProfile representing the profiles of any inner transactions executed.
+	InnerProfiles *[]SimulationTransactionExecProfile `json:"inner-profiles,omitempty"`
+
+	// LogicSigProfile The opcode cost profile of one program evaluation.
+	LogicSigProfile *SimulationProgramProfile `json:"logic-sig-profile,omitempty"`
+}
+
 // SimulationTransactionExecTrace The execution trace of calling an app or a logic sig, containing the inner app call trace in a recursive way.
 type SimulationTransactionExecTrace struct {
 	// ApprovalProgramHash SHA512_256 hash digest of the approval program executed in transaction.
@@ -1069,6 +1262,9 @@ type Version struct {
 	Versions       []string     `json:"versions"`
 }
 
+// AbiType defines model for abi-type.
+type AbiType = string
+
 // Address defines model for address.
 type Address = basics.Address
 
@@ -1096,6 +1292,9 @@ type Next = string
 // Round defines model for round.
 type Round = basics.Round
 
+// SessionId defines model for session-id.
+type SessionId = string
+
 // SigType defines model for sig-type.
 type SigType string
 
@@ -1386,6 +1585,18 @@ type SimulateResponse struct {
 	Version uint64 `json:"version"`
 }
 
+// SimulationSessionResponse defines model for SimulationSessionResponse.
+type SimulationSessionResponse struct {
+	// Round The latest round of the session. The next transaction groups simulated in the session are evaluated in the round after it.
+	Round basics.Round `json:"round"`
+
+	// SessionId The identifier of the session.
+	SessionId string `json:"session-id"`
+
+	// Timestamp The block timestamp of the latest round of the session, in seconds since the epoch.
+	Timestamp int64 `json:"timestamp"`
+}
+
 // StateProofResponse Represents a state proof and its corresponding message
 type StateProofResponse = StateProof
 
@@ -1497,6 +1708,9 @@ type GetPendingTransactionsByAddressParamsFormat string
 type GetApplicationBoxByNameParams struct {
 	// Name A box name, in the goal app call arg form 'encoding:value'. For ints, use the form 'int:1234'. For raw bytes, use the form 'b64:A=='. For printable strings, use the form 'str:hello'. For addresses, use the form 'addr:XYZ...'.
 	Name string `form:"name" json:"name"`
+
+	// AbiType An ABI type, such as '(uint64,address)', or an AVM type, such as 'AVMString', to decode the box value as. The decoded value is returned as decoded-value.
+	AbiType *string `form:"abi-type,omitempty" json:"abi-type,omitempty"`
 }
 
 // GetApplicationBoxesParams defines parameters for GetApplicationBoxes.
@@ -1538,6 +1752,9 @@ type GetTransactionProofParamsFormat string
 type StartCatchupParams struct {
 	// Min Specify the minimum number of blocks which the ledger must be advanced by in order to start the catchup. This is useful for simplifying tools which support fast catchup, they can run the catchup unconditionally and the node will skip the catchup if it is not needed.
 	Min *basics.Round `form:"min,omitempty" json:"min,omitempty"`
+
+	// File Path to a catchpoint file on the node's file system to load the ledger from, rather than downloading the catchpoint file from peers. The path must be absolute, and name a file in the node's catchpoint import directory (see CatchpointImportDir). The blocks are still fetched from peers.
+	File *string `form:"file,omitempty" json:"file,omitempty"`
 }
 
 // GetLedgerStateDeltaForTransactionGroupParams defines parameters for GetLedgerStateDeltaForTransactionGroup.
@@ -1584,6 +1801,73 @@ type ShutdownNodeParams struct {
 	Timeout *int `form:"timeout,omitempty" json:"timeout,omitempty"`
 }
 
+// SimulationSessionApplicationBoxByNameParams defines parameters for SimulationSessionApplicationBoxByName.
+type SimulationSessionApplicationBoxByNameParams struct {
+	// Name A box name, in the goal app call arg form 'encoding:value'. For ints, use the form 'int:1234'. For raw bytes, use the form 'b64:A=='. For printable strings, use the form 'str:hello'. For addresses, use the form 'addr:XYZ...'.
+	Name string `form:"name" json:"name"`
+
+	// AbiType An ABI type, such as '(uint64,address)', or an AVM type, such as 'AVMString', to decode the box value as. The decoded value is returned as decoded-value.
+	AbiType *string `form:"abi-type,omitempty" json:"abi-type,omitempty"`
+}
+
+// SimulateSessionTransactionParams defines parameters for SimulateSessionTransaction.
+type SimulateSessionTransactionParams struct {
+	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
+	Format *SimulateSessionTransactionParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// SimulateSessionTransactionParamsFormat defines parameters for SimulateSessionTransaction.
+type SimulateSessionTransactionParamsFormat string
+
+// StreamBlocksParams defines parameters for StreamBlocks.
+type StreamBlocksParams struct {
+	// Round The round to start streaming from.
+	Round *basics.Round `form:"round,omitempty" json:"round,omitempty"`
+
+	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
+	Format *StreamBlocksParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// StreamBlocksParamsFormat defines parameters for StreamBlocks.
+type StreamBlocksParamsFormat string
+
+// StreamTransactionsParams defines parameters for StreamTransactions.
+type StreamTransactionsParams struct {
+	// Round The round to start streaming from.
+	Round *basics.Round `form:"round,omitempty" json:"round,omitempty"`
+
+	// Address Only include transactions involving this account.
+	Address *string `form:"address,omitempty" json:"address,omitempty"`
+
+	// AddressRole Only match the address as the sender, or as a receiver, of the transactions. Requires address.
+	AddressRole *StreamTransactionsParamsAddressRole `form:"address-role,omitempty" json:"address-role,omitempty"`
+
+	// ApplicationId Only include transactions calling or creating this application.
+	ApplicationId *basics.AppIndex `form:"application-id,omitempty" json:"application-id,omitempty"`
+
+	// AssetId Only include transactions of this asset.
+	AssetId *basics.AssetIndex              `form:"asset-id,omitempty" json:"asset-id,omitempty"`
+	TxType  *StreamTransactionsParamsTxType `form:"tx-type,omitempty" json:"tx-type,omitempty"`
+
+	// NotePrefix Only include transactions whose note starts with this base64 encoded prefix.
+	NotePrefix *string `form:"note-prefix,omitempty" json:"note-prefix,omitempty"`
+
+	// LogContains Only include application calls which logged a message containing these base64 encoded bytes.
+	LogContains *string `form:"log-contains,omitempty" json:"log-contains,omitempty"`
+
+	// Format Configures whether the response object is JSON or MessagePack encoded. If not provided, defaults to JSON.
+	Format *StreamTransactionsParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// StreamTransactionsParamsAddressRole defines parameters for StreamTransactions.
+type StreamTransactionsParamsAddressRole string
+
+// StreamTransactionsParamsTxType defines parameters for StreamTransactions.
+type StreamTransactionsParamsTxType string
+
+// StreamTransactionsParamsFormat defines parameters for StreamTransactions.
+type StreamTransactionsParamsFormat string
+
 // TealCompileTextBody defines parameters for TealCompile.
 type TealCompileTextBody = openapi_types.File
 
@@ -1623,6 +1907,15 @@ type SimulateTransactionParams struct {
 // SimulateTransactionParamsFormat defines parameters for SimulateTransaction.
 type SimulateTransactionParamsFormat string
 
+// StartSimulationSessionJSONRequestBody defines body for StartSimulationSession for application/json ContentType.
+type StartSimulationSessionJSONRequestBody = SimulationSessionRequest
+
+// AdvanceSimulationSessionJSONRequestBody defines body for AdvanceSimulationSession for application/json ContentType.
+type AdvanceSimulationSessionJSONRequestBody = SimulationSessionAdvanceRequest
+
+// SimulateSessionTransactionJSONRequestBody defines body for SimulateSessionTransaction for application/json ContentType.
+type SimulateSessionTransactionJSONRequestBody = SimulateRequest
+
 // TealCompileTextRequestBody defines body for TealCompile for text/plain ContentType.
 type TealCompileTextRequestBody = TealCompileTextBody
 
@@ -1,6 +1,6 @@
 // Package private provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version (devel) DO NOT EDIT.
 package private
 
 import (
@@ -119,6 +119,13 @@ func (w *ServerInterfaceWrapper) StartCatchup(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min: %s", err))
 	}
 
+	// ------------- Optional query parameter "file" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "file", ctx.QueryParams(), &params.File)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter file: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.StartCatchup(ctx, catchpoint, params)
 	return err
@@ -184,231 +191,264 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9a3Mbt5LoX0Fxt8qPJSnZsbMnvnVqr2InOdo4sctSsnfX8k3AmSaJoyEwB8BIZHz1",
-	"32+h8RjMDIYcUrST1O4nWxw8Go1Go9HPj6NMrErBgWs1evFxVFJJV6BB4l80zyUo/G8OKpOs1Ezw0YvR",
-	"GSc0y0TFNSmrWcEycg2b6Wg8YuZrSfVyNB5xuoLRizDIeCThHxWTkI9eaFnBeKSyJayonVZrkKbv+7PJ",
-	"f51Ovvrw8flf7kbjkd6UZgylJeOL0Xi0nizExP04o4planrmxr/b9ZWWZcEyapYwYXl6UXUTwnLgms0Z",
-	"yL6FNcfbtr4V42xVrUYvTsOSGNewANmzprI85zms+xYVfaZKge5dj/k4YCV+jKOuwQy6dRWNBhnV2bIU",
-	"jOvESgh+JfZzcglR922LmAu5orrdPiI/pL0n4yend/8USPHJ+PkXaWKkxUJIyvNJGPdlGJdc2HZ3ezT0",
-	"X9sIeCn4nC0qCYrcLkEvQRK9BCJBlYIrIGL2d8g0YYr8+8WbH4mQ5AdQii7gLc2uCfBM5JBPyfmccKFJ",
-	"KcUNyyEfkxzmtCq0Ilpgz0Af/6hAbmrsOrhiTAI3tPB+9Hcl+Gg8WqlFSbPr0Yc2mu7uxqOCrVhiVT/Q",
-	"taEowqvVDCQRc7MgD44EXUneB5AdMYZnK0lWjOsvn7XpsP51Rddd8C5lxTOqIY8A1JJyRTPTAqHMmSoL",
-	"ukHUruj6r6djB7gitChICTxnfEH0mqu+pZi5j7YQDusEoi+XQMwXUtIFRHiekp8UICXhVy2ugQfqILMN",
-	"fiol3DBRqdCpZx04dWIhER1IUfEUoyL4waG5h0fZvsdkUO9wxLvt3xRbuE9tqC/Y4nJTApmzwtyX5O+V",
-	"0oGAK4XbvgSiSsgM782JGcYgX7EFp7qS8OKKPzZ/kQm50JTnVObml5X96Yeq0OyCLcxPhf3ptViw7IIt",
-	"enYgwJo6pwq7rew/Zrz0UdXr5F3yWojrqowXlMVnwdDK+as+yrBj9pNGmkGeBbkB98eNdbk+f9XHUrf3",
-	"0OuwkT1A9uKupKbhNWwkGGhpNsd/1nMkLTqXv42seGF663KeQq0hf8euUaA6s/LTWS1EvHOfzddMcA32",
-	"KozEjBNkti8+xpKTFCVIzeygtCwnhchoMVGaahzpnyXMRy9G/3RSC3ontrs6iSZ/bXpdYCdzGUswjG9C",
-	"y3KPMd4a4RFFrZ6DbviQPepzIcntkmVLopdMEcbtJqLcZThNATeU6+lor5N8F3OH9w6IeivsJWm3osWA",
-	"eveC2IYzUEj7Tuh9oBqSImKcIMYJ5TlZFGIWfnh4VpY1cvH7WVlaVI0JmxNgeJ/DmimtHiFmaH3I4nnO",
-	"X03Jd/HYt6woiODFhszA3TuQmzEt33Z83AngBrG4hnrEB4rgTgs5Nbvm0WDksmMQI0qVS1GYK3AnGZnG",
-	"f3NtYwo0vw/q/Kenvhjt/XSHEr1DKlKT/aV+uJGHLaLq0hT2MNR01u57GEWZUbbQkjqvEXxsusJfmIaV",
-	"2kkkEUQRobntoVLSjZegJigJdSnoJwWWeEq6YByhHRuBnJMVvbb7IRDvhhBABUnbkpkVr26ZXtYiV0D9",
-	"tPO++HMTcmrPidlwyoxsTAqmtBGGcDMVWUKBAicNioWYig4imgG0sGURAeZbSUtL5u6LleMYJzS8vyys",
-	"97zJB16ySZhjtUWNd4TqYGa+k+EmIbEKhyYMXxciu/4bVcsjHP6ZH6t7LHAasgSagyRLqpaJM9Wi7Xq0",
-	"IfRtGiLNklk01TQs8bVYqCMssRD7cLWyfEmLwkzd5Wat1eLAgw5yURDTmMCKafMAZhxPwILdALesZ0q+",
-	"odnSCBMko0UxrvUSopwUcAMFEZIwzkGOiV5SXR9+HNk/lPAcKTB8UAOJVuN0GlNyuQQJcyHxoSqBrChe",
-	"TivzPCqLZp/AXBVdQUt2wstSVNrAGL1czl/51cENcORJYWgEP6wRH/zx4FMzt/uEM3NhF0cloKKF8ayo",
-	"8hp/gV80gDat66uW11MImaOih2rzG5MkE9IOYS9/N7n5D1BZd7bU+bCUMHFDSHoDUtHCrK61qEeBfI91",
-	"OneczJxqGp1MR4XpF53lHNgPhUKQCe3GG/wPLYj5bAQcQ0k19TCUU1CmCfuBd7ZBlZ3JNDB8Swuysnoz",
-	"UtLsei8oX9aTp9nMoJP3jVXVuS10iwg7dLlmuTrWNuFgfXvVPCFW5+PZUUdM2cp0ormGIOBSlMSyjxYI",
-	"llPgaBYhYn30a+1rsU7B9LVYd640sYaj7IQZZzCz/1qsXznIhNyNeRx7CNLNAjldgcLbrWEGMbPUquqz",
-	"mZCHSRMd00StgCfUjBoJU+MWkrBpVU7c2Uyox22D1kAkqJe2CwHt4VMYa2DhQtNPgAVlRj0GFpoDHRsL",
-	"YlWyAo5A+sukEDejCr54Si7+dvb8ydNfnj7/0pBkKcVC0hWZbTQo8tDp+YjSmwIeJR9OKF2kR//ymTeI",
-	"NMdNjaNEJTNY0bI7lDW02IexbUZMuy7WmmjGVQcAB3FEMFebRTt5Z/vdjUevYFYtLkBr8wh+K8X86Nyw",
-	"M0MKOmz0tpRGsFBNo5STlk5y0+QE1lrSkxJbAs+t6c2sgynzBlzNjkJUfRuf17PkxGE0h52HYt9tqqfZ",
-	"xFslN7I6huYDpBQyeQWXUmiRiWJi5DwmErqLt64FcS38dpXt3y205JYqYuZGA1jF8x4VhV7z4feXHfpy",
-	"zWvcbL3B7HoTq3PzDtmXJvLrV0gJcqLXnCB1NjQncylWhJIcO6Ks8R1oK3+xFVxouirfzOfH0ZEKHCih",
-	"4mErUGYmYlsY6UdBJniudmpzvDWwhUw31RCctbHlbVm6HyqHposNz1CNdIyz3K/9cqY+ojY8i1RhBsYC",
-	"8kWDVj+pyqsPUxaKByoBqcHUa/yMFoFXUGj6rZCXtbj7nRRVeXR23p5z6HKoW4yzOeSmr9coM74ooCGp",
-	"Lwzs09Qaf5cFvQxKB7sGhB6J9TVbLHX0vnwrxSe4Q5OzpADFD1a5VJg+XRXTjyI3zEdX6giiZz1YzREN",
-	"3cZ8kM5EpQklXOSAm1+ptFDa47VjDmpWSQlcx3Iu6jOYIjMw1JXRyqy2KokWqful7jihmT2hE0SN6nFz",
-	"CK4atpWdbklvgNBCAs03ZAbAiZiZRddeDrhIqkhpZGcn1jmReCi/bQBbSpGBUpBPnD57J7y+nb1/9Bbk",
-	"4WpwFWEWogSZU/lpVnB9sxP4a9hMbmhRGfH8+5/Voz/KIrTQtNixBdgmtRFt9V13KfeAaRsRtyGKSdlq",
-	"C+1JMCK2YToFaOhD9v2x17v9bTA7RPCJEHgDEj1qPunR8pN8AqIM8H/ig/VJllCVEyMG9qofjORq9ptT",
-	"LrxsuGOGMEFBlZ7sulJMo4bexCw14uKpWwQH7pEnX1OlUQwkjOeov7VXIc5jZUszxWhPpzKcsvc1Zib9",
-	"2T/EutNm5nrnqlLhVaaqshRSQ55aHtqse+f6EdZhLjGPxg5PPy1IpWDXyH0IjMZ3eHSKAPyD6mChdjbv",
-	"7uLQ68CIL5t9sdyAr8bRNhgvfKsI8bFTbQ+MTNV7YMmNqRa9zYQogKLKVGlRloZD6UnFQ78+DF7Y1mf6",
-	"p7ptlyStGchKKrkAhSYm195BfmuRrtDWtaSKODi8fwIqvKyLXBdmc6wnivEMJtvOCz6CTav44Bx03Kty",
-	"IWkOkxwKukl4W9jPxH7ekzD82Eggtf5AaJjM0JqYppH6THh/08NmFTiVSgneBL+QzJxz84yqSc31PnzS",
-	"HHDaFN90xPogzIJgJOnAj4fIsvSUGBHv/huhDVk5osPVuFvpnmvpwV6Y9ZMgEMed1IqA9uz/CcrNHQSw",
-	"o86/AdW38HrqYy27R/2Pd3vjwmxdZa3bJnlF9PLlHYyxjwf12CLeUqlZxkp8rn4Pm6O/3tsTJH0lSA6a",
-	"sgJyEn2wL/ky7k+sG3J7zMNe84PUrV3wO/rWxHK8Z1YT+GvYoNrkrY1oiLRVx1BHJEY1Fy7lBAH1XvPm",
-	"xRM3gTXNdLExgq1ewobcggSiqpn1Wuma0LQoJ/EA6Zip/hmdQT5pDt/qIXCBQ0XLS3ke2tfWdvguW0+u",
-	"BjrcK6sUokjoP9snvoOMJASD3IVIKcyuM1oUG6JD2IynpAaQ7oJAb4wgzzxQDTTjCsh/iopklOMLt9IQ",
-	"hDQhUfJBYdnMYMTNMKdzVa0xBAWswL7m8cvjx+2FP37s9pwpModb63LDsWEbHY8foyrurVC6cbiOoO02",
-	"x+08cemgrdJcsu7V1uYpu53c3MhDdvJta/Bg4DRnSilHuGb592YArZO5HrL2mEaGOfjhuIPMd02XsM66",
-	"cd8v2KoqqD6GoRJuaDERNyAly2EnJ3cTM8G/uaHFm9DtbjyCNWSGRjOYZBglOHAsuDR9bGChGYdxZg6w",
-	"DRwZChCc214XttOOl3btt8xWK8gZ1VBsSCkhAxslZ6RUFZY6JTZkIltSvsAXkBTVwrk623GQ4VfKasJk",
-	"xTtD7CuK6TWfoAlDJcPU0Gzpoy2NEAbUvGzb9g/7WLulARR7GQ26tKPtaduDkibT8aj34W/wfVM//C3e",
-	"miGjhxoTG/JhhLQamoHWM8SnkZW6SIy30Rw+QwyfxkpTD52Csjtx5BRef+zzC7+oyrLYHEFIsgMRCaUE",
-	"hVdarAZU9quYkx9YJsVZsRDhzlMbpWHVNd7Yrr/0HNd3h7yABS8Yh8lKcEg86d/g1x/w42C1o72Ge0ZE",
-	"gWivAdsPnwYSWgtoTj6EpO+7SUgy7bPftnSqb4U8lpXdDjj4TTHAcr3TrcNNeah9nRZFwiRt1Q8dLqLG",
-	"wSmcSUKVEhlDQfE8V2PnfW6t2NatvYX+tyE06ggHuD1uy/YahWFZRT4UJaEkKxiq+QVXWlaZvuIUNX3R",
-	"UhPOgl450K8WfumbpPXQCTWxG+qKU3QUDfq/pGPQHBJ6qG8BvHZYVYsFKN16YM0BrrhrxTipONM418oc",
-	"l4k9LyVI9Nib2pYruiFzQxNakN9ACjKrdPPJsaqUJkqzonCGYDMNEfMrTjUpgCpNfmD8co3DeT8Sf2Q5",
-	"6FshrwMWpsMZ1wI4KKYmaU/H7+xXDCpxOFm6ABOMtbCfvcdznRtiZNbeSFrxfx/+24v3Z5P/opPfTidf",
-	"/cvJh4/P7h497vz49O6vf/1/zZ++uPvro3/759T2edhTweAO8vNX7o1+/gofYlGcSBv2P4JBZsX4JEmU",
-	"sUNRixbJQ8yX4QjuUVPvp5dwxfWaG8K7oQXLDS86Gvm0r6nOgbZHrEVljY1rqfE8AvZ8Dt2DVZEEp2rx",
-	"108iz7Un2OpwE295K8bAcUZ1dADdwCm42nOm3GoffPfNJTlxhKAeILG4oaPUAokXjItgbHj5mF2KA7uu",
-	"+BV/BXN8Dwr+4ornVNMTe5pOKgXya1pQnsF0IcgLHxT5imp6xTvXUG8CqSioOcogleIUdJVey9XVe1os",
-	"xNXVh44fQle2clPFXNSds66azE85MXKDqPTEJXGZSLilMmUL8Sk+XDQ09t4Kh5VJRGWVWD5JjBt/OhTK",
-	"slTtZA9dFJVlYVAUkapy+QrMthKlRQgcM8zcxd4aGvhROKcSSW/9k7dSoMivK1q+Z1x/IJOr6vT0CwzB",
-	"q1Mc/Op4oKHbTQmDH769ySja711cuJXL0al8UtJFymZydfVeAy2RQlDgWOFLsygIdmuEB/pIAByqXkCI",
-	"Rd5jSyxke8f14nIvbC+f1iu9KPyEm9qMnb7XDkZR8Qdv4I7Ielrp5cRwhOSqlDkGfq98ggG6MFeO9yBQ",
-	"bIEPALUUlVkykGwJ2bXLbAWrUm/Gje7e0cXdxZ7hMIU6IxccOGcGfxnlZsCqzKkTZCjftFPcKBsMgYO+",
-	"g2vYXArbfTowO1iUjS5KsaL6ji7SbnTXGvKND7Ibo735zu/Kx4i6dCQYd+nJ4kWgC9+n/2hbAeAIxzpF",
-	"FI08H32IoDKBCEv8PSg4YKFmvHuRfmp5jGfANbuBCRRswWZFgk3/R9eu4WE1VCkhA3bjo3rDgIqwOTGv",
-	"o5m9jt2LSVK+AHOpm4tYKFqg0/40aehH6XAJVOoZUL1VX8vjNBMeOhTIbzFoGpUmY7MEWJv9ZhqVIBxu",
-	"zQMP3962jXMknh7kTmXXBPmBoPrudZD09JBHhEN4Ip+dv+/DnoT3gvNPi6kTQbbfVwaHCyluzW4aAIVP",
-	"3YgJXqJ7qlJ0AUOvo4apaGBKjIYFCAfZJf0k5R0xb4s1HRlj4CJs94nBS5I7gPli2AOaAVoujn5ua0J0",
-	"VoU3vNh4pM4KFKiDg6glHSobdja+2A/YNBsDyWth1QPWxFp89JdU+aOfjyOOfqC0+PukktmWP+888r6j",
-	"upsdz1/TbdY+tvqcGRDBTQ+fRc+nzvP58kbjvXLfjUcuxCG1d4KjFJ1DAQuLE9vY01mdn6neTQPHm/kc",
-	"md4k5cgXKSMjycTNAeYh9pgQqzEng0dInYIIbLSs48DkRxEfdr7YB0ju8ktRPzbeXdHfkA4WtN74RkoW",
-	"pbn1WY/VKvMsxaW3qEWeloszDkMYHxPDSW9oYTipCzytB+nkasO3Tyszm/PteNT3Jhp40NwaUTrZa5VW",
-	"njlkfbHg7ZeRfhXstYaZWE9sZHTyaTVbz8yZSMYrYJx26vDazHkPFJmJNfoU4Q1nHdz3hq4fMg9Y5Aay",
-	"ZgqpHPv1iY0WvP0A2S7Ip6hZIek5vVoguz5J9jBgesTpPrJ7GKXQOxJILQVmnQbcaXR26lma0lZXEqmv",
-	"23HIDhvC1FKspu9wJneyB6Nd5Wkz193f6nSH/cnR/Fn9LEn+ukq5++RltJ1Lm2txn7SMbXJoALEFq2/b",
-	"QmwSrU3HpSZeI6ylWJJh9F1jVxdtCgpATcCkIVdPrlNm6aur9wpQZrjw3SI9J+4e5ZtHkTechAVTGmrj",
-	"gndy+fy2H1QnmseWmPevTpdybtb3ToggaFhzLHZsLPOzrwBd1+dMKj1By0xyCabRtwo1ad+apmlBuOlv",
-	"x5Q19ewtByNE17CZ5Kyo0qTsQPr+lYHox3BzqWqGFyXj1ttohqnwkw66e9gmER7r2L0VQa8tgl7Tz4Gf",
-	"YQfLNDUwSUN5zen/JEesxQu3cZYELaeIqbuhvSjdwmujWPouo42E6MjtYrrN5tM5l7kfe6c3lo/o7xMi",
-	"7EjJtUQZEdMBhGKxgNxnenNBoTbrlcunVwi+qHMJmt+3pA+cEpvFD5Pwbcnf59zToc85vVFOBKtiJKGP",
-	"HzMIeR1dh7kHcZIFcJu5ZbR/vZEiibjYMR5bRJrRz8vbO27zSdfhy5a7cO3Ta/cwbDZuTwE0d88qBX59",
-	"2w9td7sc6sZ9TseNFLHbDxgOiBTHtIoEmA7R9HBuWpYsX7cMf3bU6QEkMVDc62aCb+EM2ZIbbAd+mo7F",
-	"O2r1PDC3I7Z3xo4TfOafmEem9Wd2HrnmbNDMZRvIK4nWpIa3cDeffnhoDlz79z9faCHpApxFcGJButcQ",
-	"uJx90BClpFdEM+sgnbP5HGJLmDrEitMArmPvyAcQdg8Jds1l4W25lT67RLaDtuoV7EZomp4SlNLnc3HZ",
-	"tUf6h0ekWwuXTbRxBxgVkwkFvofN5GdaVOYlxKSqfVOdgbB5re9BEzer72GDI+90+TSA7dgVVMW9A6TQ",
-	"lHUlfFJRlvAHqlF9Ad/AjS3cY6fO0rt0pK1xpTT6j0Z9QzXqSTSX8umOTe0iYyAdslcXaa8Tc7aguS1t",
-	"Qt+1RSzfLftET5B4KobeG4dcciHTxk7vMqCFJ3xc7OhuPLqfv0fqnnQj7tiJt+FqTu4CemNa+3/D6WvP",
-	"DaFlKcUNLSbOT6ZP6JDixgkd2Ny71Xzm91X6VFx+c/b6rQP/bjzKCqByElQdvavCduWfZlW2BMf2a8im",
-	"Y3e6XasKizY/pMyOPWluMfV6S5vWqXVT+01FB9V51szTnuI7+aZz8bJL3OLqBWXw9Kot0tbRq+ncRW8o",
-	"K7zh10M7VMtulzusulKST8QD3NtJLPL+u/dYvXECV1fvbzxma3uKdZQKKfETvnTqQE/nDq9Jn9Wa1ndw",
-	"SFznG8xkmn53cZfnFBmjczijR5cDvxWycVG5qMakw9qnExDNY8LiMW2Uv3RW+I5YOCVWhPx18avhDY8f",
-	"xwf/8eMx+bVwHyIA8feZ+x3fUY8fJw3DSVWfYVmoyeN0BY9CXETvRnxeNQSH22HiwtnNKsjIop8MA4Va",
-	"zzOP7luHvVvJHD5z90sOBZifpkNUFfGmW3THwAw5QRd9UYnB+Xlly3kqIng7Bh+jZA1p4dXjKnhYO3v3",
-	"CPFqhXbniSpYlnb64TNlWBK3Lr2mMcHGg23IZo6K9fiV84pFo5tm6iCTZ2sh0axJhKtkJuAavzPhWEDF",
-	"2T8qiMr64k3cupz9UwhH7QjYaf2iG7hdNXh0SMHf+5sIvVZtm8Joq8n1VTADekSk6kztGe8Qz9hh/lti",
-	"FRxF+esTA9uWznV4J2VtfedtLwLtzMCefTqLa/8DyZXDtJv5ashOMzWZS/EbpGUHNBImUnd46zZDBfxv",
-	"wFM+qm1GFjwH6oLV9ey7CGS4bqGPVO6tS/CLDlXzDrnC03xiv43eU2kQ7Xe/2kCl04u7Teh7qMaOJ81A",
-	"mh5mhgc2cgvHWj7e3Y1ye0JtXotG5Fn6nMeBoid2/PqcO5g7wbUFvZ3RVKEj8140MEXb33DM04L4zn6D",
-	"VEjNYGcnUSxDaMtssr8SZG096qZKPvDtZ6cd/OqrH3lIcfHzbmx9VQolEsNU/JZy9CPEfpYDut4KrB+G",
-	"6XUrJCb4VGkfwhwytkoqw6+u3udZ1/MrZwtmS4pXCgida5fn0Q1ki8pbKnLVvEMuEoea8zk5Hddn1u9G",
-	"zm6YYrMCsMUT22JGFV7QwScidDHLA66XCps/HdB8WfFcQq6XyiJWCRLe5yh6Bk/YGehbAE5Osd2Tr8hD",
-	"dBhW7AYepS8YJ6yNXjz5arytcjZiHIvEb2PyOXJ5H8iQpmz0qrZjGLbqRk1HJswlwG/Qf59sOV+265DT",
-	"hS3dFbT7dK0opwYhKZhWO2CyfXF/0ZWjhRdurTOgtBQbwnR6ftDUcKyeaHLDEC0YJBOrFdMr5ymqxMpQ",
-	"WF2G3E7qh8P6er4MmofLf0QX7DLxxv8dnlt01RPhiF71P6K9PUbrmFCbsbVgdfyFr1BLzn1maqwLF8rB",
-	"WdyYuczSUV7FcIw5KSXjGrVGlZ5P/mKe75JmhiFO+8CdzL58lqiv1ixBxPcD/LPjXYICeZNGvewhey/l",
-	"uL7kIRd8sjIcJX9Up3SITmWvr3jav7fP7bhn6HtL12bcSS8BVg0CpBE3vxcp8i0D3pM4w3r2otC9V/bZ",
-	"abWSaYKhldmhn969dpLISshUpYuaATipRIKWDG4wvjS9SWbMe+6FLAbtwn2g/32927xYGolu/nQnHwuR",
-	"VTnxTgtplYyk//MPdX58NG7buN2W9lLIhJ7WaRw/s1vqfvrCtg3dugPitx7MDUYbjtLFSk+4h43nCH1+",
-	"D3+vNkh2zxuq0ie/Emne8SjrP36MQD9+PHai8q9Pm58te3/8eLjLbFpfaH5NoOawu6advdL0TW311yKh",
-	"vfNVPIPfmEtVktCwJu8yc6XO3Bhj0iyV+PnljuPEK+7thpw+QB41+LmNm9+Zv+Jm1hEw/fyhWT02ST55",
-	"+B7FUFDytVgPJaLWteXp6Q+Aoh6UDNQK4ko61XGTnhI73XwisjWjzqAQ5qUaF8Aa7LXyJ9oFg5rxlr2o",
-	"WJH/XFuhWzeTpDxbJp3KZ6bjL/YZEDWINBjZknIORbK3fS3/4l/ViXf/30XPsCvG05/ahZgt7C1Ia7Ca",
-	"QPgp/fgGV0wXZoIYRc2EXCHFSbEQOcF56solNWvsVjRPVZJNxPjjsKtKO69kTJ7gCorMWYFutGl7OLac",
-	"SKp7uCqW/fclrsw4WIVfWbWEHR0koWyF17aiq7IAPIQ3IOkCuwoOre6YsQ1HjsqSEFWaT9gSk78IoivJ",
-	"iZjPo2UA10xCsRmTkiplBzk1y4I1zj168eT09HSYkRHxNWDtFq9+4W/qxT05wSb2i6v8ZQsm7AX+IdDf",
-	"1VS3z+Z3icuVX/1HBUqnWCx+sAHZaCE297otvRrKBE/Jd5ifzBB6o0QAKkV9huVmTtCqLATNx5gU+vKb",
-	"s9fEzmr7SEDUYenXBWoAm0ckaeQZniPV51/ryV01fJztqXPMqpWehKKsqUyKpkVdS5a1vJ9QNxhjZ0pe",
-	"WbVscOyxkxBMLS5XkEc1YK0aAInD/Edrmi1R3zkdbVUp91QDGl7C2HPA2lwUxb2GglnIwc0yXBVjW8R4",
-	"TIRegrxlCjDvBNxAM2FjyHbqFPI+gWNztbLi3BLOdA/pNZTH2ncXPHBW9PX+FUnIWvtwb9tfnckDi5zv",
-	"W+z5Anul43ZalaNbfg+2ZMbaF92Ykh+csSOjXHCWYbGJlAiOqRiHmVUH1OVI2zvVyJ3lxDFM1qsOAeoO",
-	"i70VrD3LdIjrOjVEX81+W8Kxf2pYuyKAC9DK8UDIx758vDPQMa7AFUAz9BVzVCETrl/JsJjgQnJEl/Tx",
-	"CLOp9ehavzXffnS6ecwZc8046twcUt1L0BrYCsXQzs4J02QhQLnVNuPC1HvTZ3q55gjCh+lrsWDZBVvg",
-	"GNYV0SDFegF3hzrzPsHOB9e0fWnautoF4eeGS52d1K/7Q5KFqLD/qZrrvehP+X55R5oIuWH8eLQtxLjV",
-	"1R/vZUOGcIOef1Difd4hm1C+vjnKN+bJaukNWxAbuZtMG8x4AozXjHuDbzoPVpa8S3Bj8DT39FOZpNo+",
-	"OgZxvEugRU84DAbVW4+B+w7VrsRgUIJr9HP0b2Ndeb+HrYQG9euC8g3xh8JQdySUvKRFcIZP1NFH6cwJ",
-	"Y9ZZuFVZP8VWDFuf+NDcBrp2BoKG7lgNZd97qi/b6KzKF6AnNM9Teee+xq8Ev/qAQlhDVoUiYCHOtJmu",
-	"vUttbqJMcFWttszlG9xzupwpqhSsZkXC9fZV+Ah52GFMRDXb4L+pClj9O+Oc3veO/vYe7vl+NQq60ewp",
-	"6dnQ9ESxxWQ4JvBOuT866qkPI/S6/1Ep3Qd+/yHiultcLt6jFH/7xlwccZrujo+/vVpCFm30pxf43ecD",
-	"C5lcm1wJr7JOnTf0yMDNS2xZC3jfMAn4DS16Mi7EVht7v1pLRl/ehaw3rQjVLnudpqTmCUNUGP35v6wH",
-	"dssy1DVv9vlYWxfrT2k8cfjYivR+S+P3Dbui9XqrGUqvPfEwk19NBPva/Fwphq6+lBaFyAZzBjfMmenU",
-	"n6pXrFYu833CK+9mJfL4LMTeXABpxmYdlhOhFfiwTX7Dp1Xyi7xNj9bQjwSiGZq1DNHoljC2gZkePA+M",
-	"nTqeKFLZOsySb1mBxaH+/eLNj6P+jYx2oLulLnV2UoXdtzEhUq1NHgvRwMcWHiB4kdZ/qx6VOuaGSp8G",
-	"V504+eFbqyAcApLNk7RP69dDB+8QwELYqlCpuhnd7DSjejs88iNqqLfXcpSYOlJU0a62lHj7WKVn3YSE",
-	"QqSDCpM2ZKQhxZ1SdYTcS8FrYO1F4/LR2eJKnbpMHQb6aohw2MHH3Xh0nu8lPqVqUY3sKCkG+5otlvrr",
-	"QmTXfwOag7T1RFLPSVtNZAXmGaqWrMT3TykUq+sBF2Ywl8h7icNNh4bmXC7BZYXxSQI6Y3kH6hvINNaH",
-	"rt1AJcBwP4cyvUQDgTcoYpPfwRVEAuRQ6uVWYck6d5d6WZcNBRd5xhSZgTNd3AAfEzaFaTtYLa+TQpEC",
-	"6NwrYaUQekBd3RC2hGiMgU7RV6dG83YxsJPzLUppaEvpTocXYTkLMQE20PKWqjpzVCuNwuBw7fkcMkx4",
-	"vzX93n8sgUf52MZedYewzKNsfCyEC2LJhqNqtGtYtyXC2wpqVJPqU0LalxDjGjYPFGnQULIicIiwPSQD",
-	"PCLH2nF9UYE+04ZzjGQq0BMiyPvBuwT8dY2lQ4oARNkpDwTD07i5nuqMlYdB4yWaA8AwXaf3Ktpfp8ND",
-	"wbQvu1+3unr/S/kVFrNXzqmUhnTzsT6JnHfLMd+6dPWYaDFYC33ielD+N5+g1c5SsGtXoQYRZm2zt1Tm",
-	"vsVR0uTZe5OlgZ6HmVkdGNX18tnXL8dGKGaFMALQpC8wtBmpFFx4Hyjra10nLUOo5yAl5MEmWAgFEy18",
-	"mNUeyT9d+OQW7Fkv84Pw1vLo3yNk2K6ot4bCu7qQBJaDpFgzgTrn8xgrRMKKGuhlVNwhrQbdtUMv7Xef",
-	"U8SX99uuXu3DezgXuytk+9A7pjqYj0/XnDjhYG/u1UhEcoBmlnEOcuKNuO3SDryZJhPzKudVZkWV+GwG",
-	"7fXgtGNbuFlSqZl1V9l6QkVZOa5hc2LVPr7quN/xGGgrQ1rQo4TSLaI4qq5apeBeHAW83zd9ZylEMemx",
-	"DJ5361G0D8M1y64BE7OGyBQjBT9oHhszCXmIBqngM3K73PhqC2UJHPJHU0LOuI0O9O4jzQqkrcn5A71t",
-	"/jXOmle2wozTQE+veDrMCiu9yHtyPz/MFp7Xx5sUGH55z/ntIAfMrte8z0fuFkvCNOsET4eqN7r+HS0R",
-	"KiI/C0VKgLqwhuCXyBIS7yiC2VmiNELoH0CJMyATVYiUF/4hGWTMUGlMxZMhQBr4gOdqDYUbPIkA52S3",
-	"Iyur++zzjoo5kVD7ZhyagNXlNLVMXPWpRtozh1manHEuJMQzop+pTdQcItswzzH+Z8a0pHJzSJrUJqpS",
-	"aqheLO/0lgyOkvVCamfJLg6LQtxOkK1NQnWllDrAtFPNa9vXKa37maM+g8jtkionIm7IkuYkE1JCFvdI",
-	"h3hbqFZCwqQQ6IWZcuyYa/NIWGFcJyeFWBBRZiIHWwgtTUF9c1WcU5S9IHJlS6LA0g6mDLB9IjoeOKW5",
-	"fa15doLy2s5CG37zL00fm76iTn9nFz2xLgI98QWgXLo7hyHbuAsvEo7NyNRWyqZF5DlbI92ATB35OdGy",
-	"gjFxLdpV+N3BpxLIiillQQm0dMuKArNHsHXk0BD8gdKo7ZGdz9EP+oahw1szk4gVqUtzO4b0KzEPuIgz",
-	"shG9lKJaLKP6AAFO/3SXlXvYx6P8pCr0ScQQUTPFM7ISSrtnsR2pXnLtAvowE1xLURRNRZ6V8xfO6PsD",
-	"XZ9lmX4txPWMZteP8BHOhQ4rzcc+pULbd7eeSbZyMA57Keg1nyB5qN1p1m079Gp19DyYd7a4X8fwsEuT",
-	"H4H5YTdz3W3XOOsurL2uJp9Nv4XOOKFarFiWPm5/Lu/XXp/VFPdKZlq0VYhtFhpshnwgvseCOxNyzy6a",
-	"gdNkGdUz4niEc+tATmT+i2J8e1wyB8eDeu7QLt9xAtYk6xUDWwAgpDYRgq6kLV0cC2mB4YiFTZyCTilt",
-	"QAdeOOj7dz/YzAhHB0rDvYDqeCMHAB9aDcbYZsS0ns0zsfbfH9UpMw8C/m47lTeYR59T5UVNWtK6VfpE",
-	"Vj0cIV2AYKsH4iUmwZgN9UMMpegHXv4RAP2eiQ0YBvkn7gvGnLIC8kmqSvF50IGNo+e6i7GMRvf1HC0n",
-	"z2jlKwGbsSsJLrGSlf5l05xYUkNKIjTvasR5DmuwMVq/gRS2ju84MmdBYcv8tjQKopwUcAMNh02X7alC",
-	"KZTdgO+rQmeSA5Ro8W0r2lKeiHGVwJb2xa19EvmyDcFuUh1jEWt3iuzQtSQ1Q2s+scdEDT1KBqIblle0",
-	"gT+1r8jR1CWao5xAVef5MPFPzKHT/GRHeOcHOPP9U6KMx8SHYXxobxaURt02BrTTM7lSfaeepx2T41Rm",
-	"wVCEs+XBrm1JvOYbqqS3vF+r2SX5+iU2cJ+Y4BFiv1lDhlKNewpB7h5DPZYTlwMJqZ0D5PbBYLoktPlL",
-	"4ISLqObxLVXhFVNndfU/2ImxEePuoX2Ajb72H77/zhIcjKhWssV0idJA1vfT8f8uJ3HrQewdL0UjClwo",
-	"7xbVmKdu9+zABqIqcsLNfhrZH2sEu1vMcfExmVV+oKIQt7aIcfxEfQXenmupz5uYnFjOwrXs/aTHLuFw",
-	"WwvCogiRFd0QIfEf8yD9R0ULNt8gn7Hg+25ELakhIWdAtl4Uzu/aTLxdvBp7wLwiRvip7LrZ0DGj4TZm",
-	"lAhoc5H7sm2CrOg1xNuADiKWf2baME5VzVCpYa7s1nZ2seAW79MzrWgeKwEw0eymwR18wnPT+3/VYavx",
-	"VD7/Y1nQzJesdsXnmnwGq9p74tJLWG0Pc+7yNU8CoVJ+TbTSp8nID9Cm7sm6UjE/fcWxGmB3SoB36oLd",
-	"axkDlcKtGkdbAsQHLeXYu3CcGM7OkuJSv7sWF1c+/jy7k8wQ3beMIeD/gXal4V7RiWxLV1CP12OLpX+G",
-	"XWgk4knAatXgM7GeSJirXY40Vg8+E+saYBV0t4xnEqiyfkfnb9yztU6AzLh5Rluv3WBWDaPkMGe8ZrWM",
-	"l5VOvIIwDzLfRAiLrQmI1h7bXJ+MYUTRG1q8uQEpWd63ceb02NLAcZEeb0FxfRMKkHAjdwdgqn4BYjx1",
-	"rZ+Pm5nr3xYYtL6zSlOeU5nHzRknGUgjNZBbulGHm6qC1WGXsYpGslAzW0hktkLStoAUG2dtvqchKQBI",
-	"j2hRGmAJQifthBXIKoa06DH8dGH4U1iCVnQ9KcQCo357DoTLc42mQ/uAFByV6Fa6G7ZuP49iv8H2abAU",
-	"iWNEWuCsQ6bYfu7f4FbiI/QnzvTWk281nO0wbOvpbA+mRypf1OEZlli65zEVOe8SM8XR815U9WlKPO1B",
-	"tIlJl+iOVr1nF9G/wqVdiFXow4tVNl04UvH5Vq8wQX2D2hKAAaqOK6CZ8xDrKuI6igqLlLHLbrCnns5q",
-	"9/291AMeKlKUO+vNaYODjhlnnwqf2/MZTEpRTrIhvq22WlHujAwO0iaMPfQRmRB61h38blSo39XIidYo",
-	"5LVvkdPeQmK7bGVltk1l0Kdk6uHoTQOGmCMvwyNsVWsYaxVUMWP/OPfG7qYSLTAJQomErJKoZL6lm92F",
-	"H3uyz1/87ez5k6e/PH3+JTENSM4WoOqaBq3CibVrIuNtrdHndUbsLE+nN8FnC7GI89ZLH/YWNsWdNctt",
-	"VZ2MuFM2ch/tdOICSAXndkvkHbRXOE4dFvHH2q7UIo++YykUfPo9k6Io0jVlglyVML+kdisywJgXSAlS",
-	"MaUNI2zaT5munbLVEpWLmDX8xuaGEjwDr312VMB0jy9XaiF9Pr3IzzAXg7M5EViXheNV1k60bV3unWb1",
-	"eyg0orvNDEgpSifaszlJQYQxW7KCoFd3alPUp0duuoHZWofdFCE65/c06Z1x9xIWc7Kd2zdLces0pzeb",
-	"mBAv/KE8gDT7rBv9eUYO4SS1YeAPwz8SiVOOxjXCcj8Fr0i+D7ZEhZ91vCZC0pBBoHUTZCTIAwHoiYdu",
-	"BK1GQXZRbnJpbQxojfDm57b48UNtlt4ZmYKQ+A47wItjmet2IZjCgfM7J/b+ISAlWsqHPkpoLH9XeLRn",
-	"veEiibbIKU20BmXZkuiKhVFAvHoZ4sx7XiWdcHQphCbmZVoUiTB2q8fBMxUTjnkSyBtafH6u8S2TSp8h",
-	"PiB/1x+4FYctx0i2qFRHT8j5mg4CKwpR/ixQ8bcYW/8fYHY2eTu6WZzhv3MHokqIFtbbex4s4MDJLY5p",
-	"HbuefElmrtxPKSFjqu1QcOtFmhBvC5LNnX8trHU79vfeZYJ+Fvoex2Hu/YHIj5GRLXgOOJjro/47M6ce",
-	"DpA8LSlS7RBKAn8pXhcXVd9x7dyzNMxhqZyixI17pnLqlosfujxcB15elYLuOgff+g3cJi78em1Dc5UN",
-	"rjBzdfVez4YkFEtXgzHdMcfZUcrC3L8ozGdJcGZR6cZwkCQJqxa5d2WvaflLRnkamrtoxP2eAvJLi34z",
-	"Gj4K5hW344UCqBgr7tm6mI+DF4PgptsLcsUfE7Wk/m3h/nz6/MvReAS8WpnF199H45H7+iH1UsvXybjS",
-	"OpFOx0fUVRN4oEhJN0OC2Xemzknit84U9PlFGqXZLP2m+5vZM3y4ugCEc46sHtmLvUFd/pz/SQC0lRha",
-	"hzWcGEuSdXqgsBW7MgX93JcW36Z+76n20eK+FSt2Osk1CrHcjUcLm6QMq5P84mrVfd5t9xD05At0S79P",
-	"GjCLmMRaG5NHU0VJ3QYUZHHdEhUyMPI6qyTTmwuDf692Z79cp5JBfRfSM7mcX8EC72RfLa6Bex+zOplT",
-	"pbx0/Z2gBUqf1jGAG5lTFFPyja0Q4q7Fvz6Y/St88Zdn+ekXT/519pfT56cZPHv+1ekp/eoZffLVF0/g",
-	"6V+ePzuFJ/Mvv5o9zZ8+ezp79vTZl8+/yr549mT27Muv/vWBoXQDsgXUV/55Mfo/k7NiISZnb88nlwbY",
-	"Gie0ZN+D2RvUsM0xQSEiNcMrFlaUFaMX/qf/7S/KaSZW9fD+15GrBzlaal2qFycnt7e307jLyQJzoEy0",
-	"qLLliZ8Hc1k23itvz0NckPX9wx2tbU64qSG/n/n27puLS3L29nxaE8zoxeh0ejp9gvkUS+C0ZKMXoy/w",
-	"Jzw9S9z3E8yifaJcMZ6TOnQ0ae1/h2Ey/kkvF5CThyEI8F+Cv4d65GMJ5y4L5d+VJcawivMcicvVTR9h",
-	"3Vd0AEWwnp6e+r1w75pIvDzBiLMXH0eWf6TS4XaQelkDnISsrjrdXfRP/JqLW04w5a89QNVqReXGrqCB",
-	"jWhw3Ca6UGiak+wGMzOa3m2cl6UrgdSHcqyq2TzlvjMSSKiPY06YLZvjChmpFMq75Zfuif2tKaA7kyV2",
-	"Bxu9NTD7NGchbbK7CR3O0NPEIiycEaus7CB6PCqrBDq/wWA+tQ1n46hkj4VGFHnAeAejb6v/Jhg1pLsI",
-	"6X/NX0ugBYpG5o+VIdTMf5JA8437v7qliwXIqVun+enm6YnXOZx8dPmk7rZ9O4m9UE8+NpJy5Tt6ej/K",
-	"XU1OPro8VTsGjM0iJ86/PeowENBtzU5mWG9zaFOIV9e/FKR5dfIRdXO9v584OT39EdWn9oY98Y+PnpY2",
-	"h1D6YwOFH/XaLGT7cKZNNF5GdbasypOP+B8k2zt72gtIJcizBb0oqZuPCdOEzoTUyv5quIEvPs9U1LJz",
-	"5M9Mr5cWArxNvVPi6MX7bswpDkT8SCiimPu3liAaM9VCIhphI6YQROBG+1oQfn86+erDxyfjJ6d3/2QE",
-	"Xffn8y/uBkbsvAzjkosgxQ5s+OGeHK+js60XaTcpMLDuI8PRQn9Moduq1kAkIGNHPenW8Im0zKbLsyPy",
-	"+GZ1gQR//5rmxGdtwbmffL65z7mNSzGCqhWo78aj559z9efckDwtvEh2oPB2Zg9/zBSI2+yU8DYeccGj",
-	"fLh8YcUMkcqc08NvlKYH8JsL0+t/+E2jYcc3AGN/rbXF1QKMVCz2MgmFcMFnDveaQJrfUJ75ANA6Igv3",
-	"y0rejjCC236lYF4VPitSWThFlXnc+olUVZaG48ypCpTlwsDMg9kmdQlDk4pngluHS4y4824jmJwFXU/U",
-	"NSsbXdjcUBXmgPPRn1O/6f+oQG7qXV8x8/LtvJmGpWTp//YpGb/F/hEYf3OgIzP+p3sy3z//iv97X3XP",
-	"Tv/y+SDwGdgu2QpEpf+sV+2FvffuddU6yd/W5jrRa36CoSQnHxuPHPe588hp/l53j1tgSRn/8BDzuUKF",
-	"zLbPJx/tv9FEsC5BshVwjWnL3a/2vjkxN0Kx6f684Vnyx+46Ggn5e34+8XrY1Nu62fJj48/me1EtK52L",
-	"W1sZJinl4KVLC7KinC5supGgujS3pxugrhVA3pThenNZBgjFEr+i0rVu2YbNudQjwWcI78HgObpgHCdA",
-	"Nw6chc5NVxpd+64Sd1fzeOEg+1Hk0JWoUteng7FxhYajcJqIsPlwHJ1mxHjv9jso6G5iPay6ZGQ+Vqr9",
-	"98ktZdrIXS79PmK021kDLU5cidfWr3XdtM4XLAYX/RjnT0n+ekKb56KpdzFb1texo5RJfXV6h55GPnDP",
-	"f65NPrEJBcklGE/efzC7rkDeeEqqLQIvTk4wDnwplD5B+bVpLYg/fggb/dGTn99w8209EZItGKfFxKnW",
-	"6hLWo6fT09Hd/w8AAP//E+Why2YTAQA=",
+	"H4sIAAAAAAAC/+y9e5PbtpI4+lVQ2q3yYyXN2LGzJ946tXcS5zE3TuzyTLJ3b+ybQCQk4QwF8ACgRorv",
+	"fPdfdeNBkAQlSiM7Se3+ZY+IR6PRaDT6+WGUyVUpBRNGj158GJVU0RUzTOFfdMYnZlsy+H/OdKZ4abgU",
+	"oxejC0Euvrwk8HFMdJUtCdXkwcOKC/P5szHNc8W0fvRgTKQiVJCLn39ot734+Ycro7hYPBgTI0nOMpkz",
+	"YpaMzOSGrGlRMUL1lFwvmfuYu1+5JoqZSgmWw0ju4wQ/TkfjEQcA/1kxtR2NR4Ku2OhFvZLxSGdLtqKw",
+	"JLu0kUYwRnd345EDPLlemmWyEoaU1azgGblh2zBZSc0ymssNMh4p9s+KK5aPXhhVsXjqkhrDFPT95WLy",
+	"/55Pvnj/4fnf7kbjFkzj0WaykG4PRjOqeaanF278u31faVkWPKOwhAnP04uqmxCeM2H4nDPVt7DmeLvW",
+	"t+KCr6rV6MV5WBIXhi2Y6llTWV6KnG1Gd3s/U62Z6V0PfBywEj/GSdcAg+5cRaNBRk22LCUXJrESgl+J",
+	"/ZxcQtR91yLmUq2oabePyA9p78n4yfndvwRSfDJ+/lmaGGmxkIqKfBLG/SqMS67cQTqgof/aRsBXUsz5",
+	"olJMk9slM0umkDUopkspNCNy9g+WGcI1+b+vXv8IXOYHpjVdsDc0uyFMIEuYkss5EdKQUsk1z1k+Jjmb",
+	"06owmhiJPfv4hYMrxiQTQAu/jP6hpRiNRyu9KGl2M3o/TvCRgq94YlU/0A1QFBHVasYUkXNYkAfH8rQ+",
+	"gOyICe6VJknLiEd3fb+u6KYL3rWqREYNyyMAjaJC0wxaIJQ512VBt4jaFd38/XzsANeEFgUpmci5WBCz",
+	"EbpvKTD3yRYi2CaBaLg04Asp6YJFeJ6Sn7S9ZPCrkTdMBOogsy1+KhVbc1np0KlnHTj17vtEyUqkGBXB",
+	"Dw7NPTzK9j0lg3qLI97t/qaZ1r0XBtF8VRX2unAN9zPbaMRdq+liT/NFj/hxxRfX25KROS8MU5r8o9Im",
+	"nKVKIwUuGdElywCyHIUPoAPNF4KaSrEX78Rj+ItMyJWhIqcqh19W9qcfqsLwK76Anwr70yu54NkVX/QQ",
+	"Q4A1xTI0dlvZf2C8NNcwmyTWX0l5U5XxgrL4WALZXr7sI1I7Zj+e07z6IogwSCpurOvN5cvR3TE9zCZs",
+	"ZA+QvbgrKTS8YVvFAFqazfGfzRypnM7V7yMr6UBvU85H49FylsIvHEd3faCAd2HluYtaqHnrPsPXTArD",
+	"7NUciT1nyPxffIglOSVLpgy3g9KynBQyo8VEG2pwpH9VbD56MfqXs1rSPrPd9Vk0+SvodYWdQDhQDBjx",
+	"hJblAWO8AekdRb8exgN8ET+RuVTkdsmzJTFLrgkXdifxQAPnK9iaCjMdHcRZ7uLz/YsDot4Ke2nbrWgx",
+	"lt69ILbhjGk8AE4If6AbkitinCDGCRU5WRRyFn54eFGWNXLx+0VZWlSNCZ8TxlG+YBuujX6EmKH1SYvn",
+	"uXw5Jd/GY9/yoiBSFFsyY/WjhM/dPeLuFfcgAMTiGuoRH2iCOy3VFHbNo0FrZk5BjCjlLmUBV/JeMoLG",
+	"37m2MQXC74M6/+WpL0Z7P91BK+KQitRkf6lfzuRhi6i6NIU9gJou2n2PoygYZQct6csawaemK/yFG7bS",
+	"e4kkgigiNLc9VCm69RLdBCWzLgX9pJklnpIuuEBox/BAEGRFb+x+SMQ7EALTQfK3ZIaDkltulrUIGFA/",
+	"7bx3/tqEnNpzAhtOudCEkoJrAxIRbqYmS1agAEyDoiOmoqOIZgAt7FhEgPlW0dKSuftihTkuCA3vQQvr",
+	"PW/ygZdsEub6c0wDCNXRzHwvw01ColEB0oThy0JmN99RvTzB4Z/5sbrHAqchS0ZzpsiS6mXiTLVoux5t",
+	"CH1DQ6RZMoummoYlvpILfYIlFvIQrlaWX9GigKm73Ky1Whx40EEuCgKNCVtxAw9yLvAELPiaCct6puRr",
+	"CqrUsiQZLYpxrSeR5aRga1YQqQgXgqkxMUtq6sOPI/vXEp4jzYAPGkai1TgdC2pgFZtLhQ9nxciK4uW0",
+	"gjdSWTT7BOaq6Yq1ZCe8LGVlmGo8Xy5f+tWxNRPIk8LQCH5YIyog4sGn5CJ8wpmFtIujiqHih4usqPIa",
+	"f4FfNICG1vVVK+oppMpR8UQN/MYVyaSyQ9jL300O/2FU1Z0tdT4sFZu4IRRdM6VpAatrLepRIN9Tnc49",
+	"JzOnhkYn01Fh+llnOQf2Q6GQqYS25TX+hxYEPoOAA5RUUw9HOQVlmrAfeGcDquxM0EAzA/u7sno8Asq1",
+	"g6D8qp48zWYGnbyvrerQbaFbRNih6w3P9am2CQfr26vmCbE6KM+OOmLKTqYTzTUEAdeyJJZ9tECwnAJH",
+	"swiRm5Nfa1/KTQqmL+Wmc6XJDTvJTsiN/c8gZv+l3Lx0kEm1H/M49hCkwwIFXTGNt1vDLAOz1Krzi5lU",
+	"x0kTHVNJbRAgFEaNhKlxC0nYtCon7mwm1PW2QWsgEnRMu4WA9vApjDWwcGXoR8CCNjQC/h5YaA50aizI",
+	"VckLdgLSXyaFuBnV7LOn5Oq7i+dPnv769PnnQJKlkgtFV2S2NUyTh07ZR7TZFuxR8uGE0kV69M+feQNN",
+	"c9zUOFpWKmMrWnaHsoYf+zC2zQi062KtiWZcdQBwEEdkcLVZtJO3tt/dePSSzarFFTMGHsFvlJyfnBt2",
+	"ZkhBh43elAoEC900kjlp6SyHJmdsYxQ9K7ElEznSPK6Da6o1W81OQlR9G5/Xs+TEYTRnew/FodtUT7ON",
+	"t0ptVXUKzQdTSqrkFVwqaWQmiwnIeVwmdBdvXAviWvjtKtu/W2jJLdUE5kaDXCXyHhUFWNoG31926OuN",
+	"qHGz8waz602szs07ZF+ayK9fISVTE7MRBKmzoTmZK7kilOTYEWWNb5mx8hdfsStDV+Xr+fw0OlKJAyVU",
+	"PHzFNMxEbAvCBdEskyLXe7U53jrZQqabagjO2tjyBi3TD5VD09VWZKhGOsVZ7td+OdMj0VuRRaowgLFg",
+	"+YKpvUg6kcqrD1MWigc6ASlg6hV+RovAS1YY+o1U17W4+62SVXlydt6ec+hyqFuMsznk0NdrlLlYFKwh",
+	"qS8A9mlqjX/Igr4KSge7BoQeifUVXyxN9L58o+RHuEOTs6QAxQ9WuVRAn66K6UeZA/MxlT6B6FkPVnNE",
+	"oNuYD9KZrAyhRMic4eZXOi2U9ngRwUHNKqWYMLGci/oMrsmMAXVltILVgoFZpu6XuuOEZvaEThA1Oj1h",
+	"7TpiW9nplnTNCC0Uozkoj5ggcgaLrr0ucJFUk5Iq48U6JxIP5bcNYEslM6Y1WLCs2ngvvL6dvX/MDuTh",
+	"anAVYRaiJZlT9XFWcLPeC/wN21oPSE0efv+zfvRnWYSRhhZ7tgDbpDairb7rLuUeMO0i4jZEMSlbbaE9",
+	"CcRIfBkUzLA+ZN8fe73b3wazQwQfCYFrptCt5qMeLT/JRyDKAP9HPlgfZQlVOQExsFf9AJIr7LegQnrZ",
+	"cM8MYYKCajPZd6VAo3jRGpYacfHULYID98iTr6g2KAYSLnLU39qrEOfBPjjF6EAnN5yy9zUGk/7sH2Ld",
+	"aTMpNBO60uFVpquylMqwPLU8tFn3zvUj24S55DwaOzz9jCSVZvtG7kNgNL7Do12JxR01wULtbN7dxaHX",
+	"AYgv20Ox3ICvxtEuGK98qwjxsZNvD4xc13tgyY3rFr3NpCwYRZWpNrIsgUOZSSVCvz4MXtnWF+anum2X",
+	"JK0ZCOckuWQaTUyuvYP81iJdo61rSTVxcHj/BFR4WT+5LsxwrCeai4xNdp0XfARDq/jgHHXcq3KhaM4m",
+	"OSvoNuFtYT8T+/lAwvBjI4HU+gNp2GSG1sQ0jdRnwvu/HjerxKkS3P1HSfALyeCcwzOqJjXX+/hJc4bT",
+	"pvimI9YHYRYEI0kHfjxElqWnxIh496+lAbKyjexq3K10z7X0YC/M+lEQiONOakVAe/b/ZtrN7ducdv4t",
+	"030Lr6c+1bJ71P94tzcuzNZV1rptkldEL1/ewxj7eFCPLeINVYZnvMTn6vdse/LXe3uCpK8EyZmhHPTK",
+	"0Qf7ki/j/sT6IrfHPO41P0jd2gW/o29NLMd7ZjWBv2FbVJu8sREWkbbqFOqIxKiEazRFAqDedR5ePHET",
+	"tqGZKbaEosCxJbdMMaKrmfVa6ZrQjCwn8QDpGK7+GZ1BPmkO3+khcIVDRctLeR7a19Zu+K5bT64GOtwr",
+	"q5SySOg/2ye+g4wkBIPchUgpYdc5LYotMSGMx1NSA0h3QRRbD667lmI04wrIf8uKZFTgC7cyLAhpUqHk",
+	"A31xBq6jOZ2rao0hVrAVs695/PL4cXvhjx+7PeeazNmtdbkR2LCNjsePURX3RmrTOFwn0HbDcbtMXDpo",
+	"q4RL1r3a2jxlv5ObG3nITr5pDe4nxTOFYTR++fdmAK2TuRmy9phGhjn4mc3AlV83XcI668Z9v7LhR6cw",
+	"VLI1LSZyzZTiOdvLya9C3NPXa1q8Dt3uxiO2YRnQaMYmGUYtDhyLXUMfG+gI43DBDfeBI0MBYpe215Xt",
+	"tOelXfst89WK5ZwaVmxJqVjGcms44ToK8ZoSHJZkSyoW+AJSslo4V2c7DjL8SltNGFgt20McKoqZjZig",
+	"CUMnw+bQbOmjP0EIYxRetm37h32s3dIACssbV8bA7Wnbg5Im0/Go9+EP+F7XD3+Lt2YI67HGxIZ8GCGt",
+	"hmag9QzxCbJSF4nxNtaHD17wNqLv45oYYQ+CAsizAzuxzQpgQzjbUEdbHnw5bS/U3MKxr+KPdnw6N0wR",
+	"bg6m113hkgBkHR3ZXkPSmO/tu+nBrEkqNgITsxtT48hCTFCsx6+slNlyOlBPkLTNjpthnTXgg3j9kjlj",
+	"JlJeN6jU0hu0+DhWwXroFHjdiaMghPpjXxwC6LeK7QmEcjsQUaxUTAP8DbWztl/lnPzAMyUvioUMMpbe",
+	"asNWXWOh7fprz6l7e4zGRYqCCzZZScESKqTX+PUH/DhYzW3Fvp4RUQA/aMD2Q7uBhNYCmpMPoeX7bhKS",
+	"TPuuaVvW9TdSncqrww44+A07wFNirxuRm/JYfw5wse+6QFh1V5f/j0MQAleEai0zjvz+Mtdje1qd14QN",
+	"o2ih/00IxTvBAW6P27L1R2F/1nDEipJQkhUczUpSaKOqzLwTFDXL0VITzqleGdVvhvjKN0nbPRJmCTfU",
+	"O0HRMTnom5N315wl9J7fMOatEbpaLJg2rQf9nLF3wrXiglSCG5xrBcdlYs9LyRR6iE5tS4g/mQNNGEl+",
+	"Z0qSWWWaT9xVpQ3RBowa1vEApiFy/k5QQwpGtSE/cHCDg+G835I/soKZW6luAhamwxnXggmmuZ6kPWu/",
+	"tV8xiMnhZOkCmuD/rrP3sK9zo4xg7Y2kLf/fw/98Acla6OT388kX/3b2/sOzu0ePOz8+vfv73///5k+f",
+	"3f390X/+a2r7POw874X88qXTCV2+xId/FJfUhv3PYABccTFJEmXswNaiRfIQ88U4gnvU1DObJXsnwGXR",
+	"SEhFxXNqTkg+7Wuqc6DtEWtRWWPjWmpjj4ADn9/3YFUkwala/PWjyHPtCXY6eMVb3oppcZxRnxxAN3AK",
+	"rvacKTfuB99+fU3OHCHoB0gsbugolUXixWw/NL3KYJfiQMJ34p14yeaof5DixTuRU0PP7Gk6qzRTX9KC",
+	"ioxNF5K88EG4L6mh70TnGupNoBYF0UcZ1FKcgq7Sa3n37hfQ6757977j99KVrdxUMRd156yrlvVTTkBu",
+	"kJWZuCRGE8VuqUrZ3nxeGbtRtvdOOKxMIiurNHXjEzf+dCiUZanbyUW6KCrLAlAUkap2+TFgW4k2MgQq",
+	"ch1ivYEGfpTOiUnRW69iqTTT5LcVLX/hwrwnk3fV+flnjDRSavzmeCDQ7bZkgxUtvclP2voVXLiVyzGI",
+	"YVLSRcpG9+7dL4bREikEBY4Vvi+LgmC3GCch8gSHqhfg8XHIlljIDo4jx+Ve2V4+rV16UfgJN7UZq3+v",
+	"HYyyMBy9gXsyOdDKLCfAEZKr0nAM/F45vkHognKhvceK5gt8AOilrGDJoIpk2Y3L7MZWpdmOG93lvHEX",
+	"e4bDNeooXTDqnAP+MipgwKrMvTaIim07r5K2wTc46Ft2w7bX0nafDsyOF2VjjFL66L6ji7Qb3bVAvvFB",
+	"dmO0N9/5+fmYZJf+BuN8PVm8CHTh+/QfbSsAnOBYp4iikVemDxFUJRCBHfpQcMRCYbx7kX5qeVxkTBi+",
+	"ZhNW8AWfFQk2/V9dO5qHFahSsYzxtdf2hQE1mNa40WRmr2P3YlJULBih6DhTSk0L1A9Ok44lKB0uGVVm",
+	"xqjZaR8QcVoTDx30J7dwsqzSBNO7sg3sNzeoBBHsluXu7W3bOMf16VHue3ZNLD8SVN+9DsqfHvOIcAhP",
+	"5HP0933Yk/BecP6QMXVeL8P3FeBwoeQt7CYAKH3qUkwoFN1TlaYLNvQ6apgmB6ZgaVgccZB90k9S3gF/",
+	"haZY05ExBi7Cdp8AXpLcgcEXYA9odmq51Pq5rcnaWbFeQ+oBh9RZgQJ1cEi2pENVw64rFocBm2ZjTIla",
+	"WPWANbEWH/0l1f7o5+OIox8pLf4xqYt2JW28jLw9qemmZPTXdJu1j60+Z8aIFNDDp270+Rp9ksbR+KCE",
+	"i+OR5UzJvZMCpeicFWxhcWIbezqr84HVuwlwvJ7PkelNUo6jkTIykkzcHAweYo8JsRpzMniE1CmIwEZP",
+	"DhyY/Cjjwy4WhwApXD4z6sfGuyv6m6XtWTb6A6RkWcKtz3uspJlnKS6dSi3ytFzqcRjCxZgAJ13Tggnj",
+	"A53rQTq5AfHt08oE6HyJHvW9iQYeNLdGlE4OWiX2OGp9seDtl5F+FRy0hpncTGwkfvJpNdvM4Ewk42Og",
+	"V/Lw2kyNDzRmdQcfNrzhbEDFwdD1Q+YBq0HCzHuAH+zXJzZa8A4DZLcgn6JmTR4Gsbomuz5J9jhgesTp",
+	"PrJ7GKVsPBFILQVmnQbfaXT26lma0lZXEqmv23FthfZhkSlW03c4kzvZg9Gu8rSZW/G7Or1mfzI+1+jT",
+	"JJXsKuXukwfUdkZA9EFpQNvk0ABiB1bftIXYJFobrVp4jbCWYkmEi4Sxq4s2zQqGmoBJQ66e3LBtWqHB",
+	"UGa48t0iPSfuHhXbR5H3pWILrg2rjQveqerT235QnQiPLTnvX50p1RzW91bKIGhgR4IdG8v85CvAUIk5",
+	"V+AnD5aZ5BKg0TcaNWnfQNO0INzYbMK1NfUcLAcjRBA8mPOiSpOyA+n7lwDRj+Hm0tUML0ourHfbDEtB",
+	"JB3CD7BNIjw2kGAngl5ZBL2inwI/ww4WNAWYFFBec/q/yBFr8cJdnCVByyli6m5oL0p38Nood0OX0UZC",
+	"dOR2Md1l8+mcy9yPvdcby2eQ6BMi7EjJtUQZONOehHKxgBA8m1jLBSFTEVIwElpIsahzV8LvO9JVTqEW",
+	"gHZJH3fki3ThEKwvGKJRTgerwiShj5pZyOtoTsx1iZMsmLCZgkaH19sp5GJPIAa2iDSjn5a3d8I0kq7q",
+	"1y339NqH3O5h2GzcnoLR3D2rNPPr231ou9vlUDfuc3JvpCTefcBwQKQ4bnQkwHSIpodz07Lk+aZl+LOj",
+	"To8giYHiXrfyQAtnyJbcYHvw03Rk31Or6oEmzl3eGTvO8Jl/Bo9M6z/vPMDhbNDMZbfIK4XWpIZ3erd+",
+	"Q3hoDlz79z9fGanogjmL4MSCdK8hcDmHoCEqgaCJ4dYhP+fzOYstYfoYK04DuI69Ix9A2D0k2DWXhbfl",
+	"TvrsEtke2qpXsB+haXpKUEqfz8V11x7p2sa6tXDZRBt3hFExmcDie7ad/Iyl8krKla59U52BsHmtH0AT",
+	"69X3bIsj73X5BMD27Aqq4t4ypNCUdSV80lFW+gc6xph9Aze28ICdukjv0om2xpVu6T8a9Q0Vr6i1lI93",
+	"bGoXGYB0yF5dpb1O4Gyx5ra0CX3fFvVFT0Sd4idIPBVH741jLrmQ2WWvdxmjhSd8XOzobjy6n79H6p50",
+	"I+7ZiTfhak7uAnpjWvt/w+nrwA2hJVTOoMXE+cn0CR1Krp3Qgc29W80nfl+lT8X11xev3jjwwfGgYFRN",
+	"gqqjd1XYrvzLrMqWfNl9Ddn0/063a1Vh0eaHFO2xJ80tpvpvadM6tZVqv6l6PO9ZM097iu/lm87Fyy5x",
+	"h6sXK4OnV22Rxs4t5y66przwhl8P7VAtu13usGpeST4RD3BvJ7HI++/eY/XGCYDGxWO2tqdYR6lQgiHh",
+	"S6eP9HTu8Jr0Wa1pfQ+HxHW+xsy56XeXcHl1kTE6hzN6cjnwG6kaF5WLok06rH08AREeExaPaaP8tbPC",
+	"d8TCKbEi5G+L3wjX5PHj+OA/fjwmvxXuQwQg/j5zv+M76vHjLtD27k2zLNTkCbpij0JcRO9GfFo1hGC3",
+	"w8SFi/UqyMiynwwDhVrPM4/uW4e9W8UdPnP3C1ja4afpEFVFvOkW3TEwQ07QVV9UYnB+XtlytppI0WIW",
+	"NiobSAuvHlcxxtrZu0dIVCu0O090wbO004+YaWBJwrr0QmOCjQfbkGGOivf4lYuKR6NDM32UybO1kGjW",
+	"JMJ1MvN0jd+ZdCygEvyfVSOWGG7i1uXsn0I4akfATusX3cDtqtmjYwpe399E6LVquxRGO02uL4MZ0CMi",
+	"VdfswHiHeMYO898Rq+Aoyl+fGNi2dK7Deylr5ztvdxF0Zwb27NNZXPsfSK4Gq93Ml0N2muvJXMnfWVp2",
+	"QCNhIlWMAwQfbNg75aPaZmTBc6Au2F7Pvo9AhusW+kjl3roEv+hQpfGYKzzNJw7b6AOVBtF+96sNdDqd",
+	"/XgUH/I03PYjaQbS9DAzPLCRWzjWjvLublTYE2rzqDQiz9LnPGqhz+z49Tl3MLd3PSvo7YxmN+n3IsAU",
+	"bX/DMc9I4jv7DdIhFYidnUSxDKEtt8klS6Zq61E3NfeRbz877eBXX/3Ig46N593Y+qoUWiaGqcQtFYZ5",
+	"XxbLAV1vzawfBvS6lQoTyuq0D2HOMr5KKsPfvfslz7qeXzlfcFtSv9LMZfawXpE4ELFZa5GKXDX7kPvG",
+	"oeZyTs7H9Zn1u5HzNdfg0o8tntgWM6rxgg4+EaELLI8Js9TY/OmA5stK5IrlZqktYrUk4X2OomfwhJ0x",
+	"c8uYIOfY7skX5CE6DGu+Zo/SF4wT1kYvnnwx3lU5HjE+p1VhdjH5HLm8D2RIUzZ6VdsxgK26UdORCXPF",
+	"2O+s/z7Zcb5s1yGnC1u6K2j/6VpRQQEhKZhWe2CyfXF/0ZWjhReBjXKmjZLbZtaZaH5mKHCsnmhyYIgW",
+	"DJLJ1YqblfMU1XIFFFbXvreT+uFs7hxLHwEu/xFdsMvEG/8PeG7RVZoeKHrV/4j29hitY0JthuCC1/EX",
+	"viIyufSZ0LEOYSg/aHEDc8HSUV6FLcSSV1wY1BpVZj75GzzfFc2AIU77wJ3MPn+WqOfXLHklDgP8k+Nd",
+	"Mc3UOo161UP2XspxfSGIXkxWHJj/ozqlQ3Qqe33Fk9OaPrfjnqHvLV3DuJNeAqwaBEgjbn4vUhQ7Brwn",
+	"cYb1HEShB6/sk9NqpdIEQyvYoZ/evnKSyEqqVGWVmgE4qUQxozhbs7x3k2DMe+6FKgbtwn2g/2O927xY",
+	"Golu/nQnHwuRVTnxTgtplUDS//mHuh4DGrdt3G5LeylVQk/rNI6f2C31MH1h24Zu3QHxWw/mBqMNR+li",
+	"pSfcA3+u+/wR/l5tkOyeN1SlT34jCt7xKOs/foxAg8bUNv3tafOzZe+PHw93mU3rC+HXBGqOu2taO459",
+	"U1sNhXFffOipGhv8xlyqku425wyZSq2/bqdGYfZBNid0xhERmDwTs4RYgREU7xavbiyb89nF8WFiLSjI",
+	"OSXXVVkwW1MbPUO8SoSrkAl4bBW47jNV9lUU8T5Yj3tLuqHsU5ErV0wV5dcpuRBbnNVCNiZsNWO5g43r",
+	"aWtjIWPL9C29/cEVWI2+TvQNLyfS1bGe4AuRqdELoyrWK4t6tMDXcWsJn15sO02458Fe3GmCalBMGzd/",
+	"8PWEm1kHEPWz12ax5+Tpy8P3KASFki/lpnsG00TUuvU9Pf0JUNSDkoFKVVxJp5h10tFkr5dURLYw6oyB",
+	"u7Zu1Ksb7PTzF9oFQM14x15UvMh/ro34rYtdUZEtkz75M+j4q31FRQ0iBRCYqgUrkr2tsuFXr5RIqE3+",
+	"IXuGXXGR/tRauIO9BWkNVhMIP6UfH3DFTQETxChq5jMLGWKKhcwJzlMXGqpZ43SUQHy3LHOHnuywq8o4",
+	"p27MPeHq/8x5Af/rcSfAlhNFTQ9XVS5rbhiRrRmYK/GStqMzRShfodSjKdSmw0O4ZoousKsUrNUdE97h",
+	"yFEVIaJL+IQtMXeOJKZSAirPRstgwnDFiu2YlFRrO8g5LIttcO7Riyfn5+fDbLSIrwFrt3j1C39dL+7J",
+	"GTaxX1yhPlvf5CDwj4H+rqa6Qza/S1yuWvI/K6ZNisXiBxvPDp3xXreVkkNV7yn5FtO7AaE3KnoANHV2",
+	"7EZK1aosJM3HmMMdXMyIndX2UQxRh5WaFwB/64gkbWTDU8z69HU9qb+Gj7M785BNkz3ZkWP7FbaoSz/z",
+	"lvMYqlZj7EzJS6vVDn5RdhKClQDUiuVRtm6rRUHigP8YQ7MlNJDT0U6NfE/xruEVxz0HrK1tUdjw2n+0",
+	"grWRvui4rTk+JhJU/LcckmAvqWFr1sx36cHw9gyf/7K5WlUJYQlneoD0GqrZHboLHjgcN7inJCFr7cO9",
+	"Tad1IhRZqYwdWpv9Cnulw55ahd5bbiO2ws3G18iZkh+crSijQgqeYW2YlAgO76KBVukBZXTS5mI9cmc5",
+	"cQyT5eVDfL/DYm/B+fGogbiuT0j0FfbbEo7902AFAXjELpjRjgeyfIz6PV4wZ9/kQjNXrxDoK+aoUiU8",
+	"55JRRcED54Qe/eMRJqPrUVV/A99+dKYNOLvkhtsCAQ6p7iVo7ZOF5uimIAg3ZCGZdqtthtXpX6DP9Hoj",
+	"EIT301dywbMrvsAxrCcnIMU6UXeHuvAu1c6FGdp+BW1dqZHwc8Mj0U7q1/0+yUJ02P/OJyiP0Yf+lOuc",
+	"90OKkBvGj0fbQYw7IyXwXgYyhGIURBtW4n3eIRumVOrh+bUtYQH0hi2IDXxOIaXgIgHGKy68vTydRixL",
+	"3iW4MXiae/rpTFGTLRtMap+/dE80EeYkyG5OMVRrgxEluEY/R/82Xm+Eq/rSw1ZCg/p1QcWW+EMB1B0J",
+	"JRClHHzTUZhqqvVBOnPCmPW1toHKTrxLsxVg6xMf2dxA19442tAdixcdek/1JWudVfmCGUj7mUrb9yV+",
+	"JfjVx2NCAaUq1OwLYbrNbPddanMTZVLoarVjLt/gntPlXFOt2WpWJDyXX4aPLA87DJQGJjL4N1Wwrn9n",
+	"XMzAwcHzPkAgP6zEQzcZQEp6BpqeaL6YDMcE3in3R0c99XGEXvc/KaX7uPk/RVh8i8vFe5Tib1/DxRFn",
+	"Oe+ESNirJSQhx3AEryC3z8+QCLdla6CWaDtzus1LbFkLeN8wCfiaFj0JK2Kjl71fvcEinbYi683KQo1L",
+	"/mcoqXnCEBVGf/o068DeMqx1rcN9LurWQ/1j2p4cPnYivd9Q+33DLGudBmuG0muOPc5iWhPBoSZTV8mi",
+	"qy+lRSGzwZzBDXMBnfozHcvVyhUOSDg1rlcyj89C7AzHWJqx8Tz5s3vYJr/h0yr5Rd2mR2voRwLRDE36",
+	"hmh0SxjbuFYPngfGTt2uGeaUZw6z5BteMG9UHPVvZLQD3S11mceTKuy+jQmBfm3yWMgGPnbwACmKtP5b",
+	"96jUMbVW+jS4YuLJD99oMxQkm2bqkNavhg7eIYCFtEW1UmVHusl9RvV2eORH1FBvr+UoMXWkqKJdrCrx",
+	"9sEWEWty6pLOaD0KkIaMNKQ2VqoMk3speA2svWhcOj9bm6pT1qrDQF8OEQ47+Lgbjy7zg8SnVCmvkR0l",
+	"xWBf8cXSfAka7+8YzZmy5VhSz0lbjGUFNnull7zE908pNa/LdxcwmMuDvsThpkMjm7D2InwKORY6Y3n/",
+	"8zXLDJZzr71oFWPD3UTK9BIBAm9QxCZ/gCeNYixnpVnuFJasb3xplnWVX+YC98DiypzpYs3EmPApm7Zj",
+	"/fI6pxYpGJ17JayS0gwogx2ivhCNMdAp+uqUVN8tBnZS5kUZIW3l6+nwGjYXIaTCxqmii4xPvNXKQjE4",
+	"2n0+ZxnWC9iZvfC/lkxE6ezGXnWHsMyjZIY8RFtixYuTarRrWAt6JKgF/SSQ9uUTuWHbB5o0aChZwDsE",
+	"KB+TQB+RY+24viZDn2nD+ZVyHegJEeTDCGx3VpeoOqaGQpTc80gwPI0TGif8PA4aL9EcAQZ0PXDS3myC",
+	"KJj2JUd8Y/MOR1d5/0v5JTOUF9r55NKQrT/WJ4FqvF09/dZl+8c8lcFa6PP+M+1/8/lt7SwFv2Fx1WK0",
+	"zUJKZN/iJFkGsRnhaaDnYWZex5V1vXwO9cuxAZ5ZIUEAmvTF1TYDvYIH9ANtXdXrnG8I9ZwpxfJgEyyk",
+	"ZhMjvWfhAblTLXC7sKfRSf8ovLUCIg6IuLYr6i1B8bauw4HVNCmWnKDOdz/GClFsRQF6FdXGSKtB9+3Q",
+	"V/a7T8niqyPuVq/24T2ci/0F7X3kItcdzMena06ccHAw92rkcTlCM8uFYGrijbjtyhiimWUU01LnVWZF",
+	"lfhsBu314KxtO7hZUqmZdVfZekJFSU1u2PbMqn1cepOw4zHQVoa0oEf5uFtEcVJdtU7BvTgJeH9s9tNS",
+	"ymLSYxm87JbzaB+GGw7eXAQuKx/YA1Lwg+axgUnIQzRIBZ+R2+XWF6soSyZY/mhKyIWwwZXefaRZwLU1",
+	"uXhgds2/wVnzyhbocRro6TuRjlLDQjnqntzPD7OD5/XxJs1Efu/57SBHzG42os9H7hYr6jTLLE+Hqje6",
+	"/h0tESoiPwtFSoC6sobgr5AlJN5RBJPbRFmY0D+AEmdAJrqQiSCGoxLwwFBpTMWTIUCGiQHP1RoKN3gS",
+	"Ac7JznGr12umFM8TqPBfbFp17T2mQ65Ll3h7QOLavkfrjnSkRhLp5j8ys1RvmtpOAZ5wXVi/VGbGaE0S",
+	"iwZEXMAVLRhzPkqDroSA7LK0qb88tlM277gKRV9yijqW3EiiWFnQzJa6M9JJbl7IiyskSROK9xwOepS1",
+	"ZBf4vZXoLtGpdc3Re8mB3C4y4jqPmyzpIxiSHI3sPBjtver6yjCjia+D0JOdjbRSrHXPCfkeKQ6uLaoY",
+	"btKKCfjEcnLDWOnCirzDYF2YKOHBle+LVDgqC2lfBt/YmmaPzEdJ1OtWNu7N2LuTRncwtDqvji9+M4CL",
+	"9bwqfvwL5FE6MmOSz6FxdIqkOjOSw96uTfxSbvr37m3MN1wsob2SMCKms39jyw0RYtNk3Mecnv44n+nJ",
+	"An12xeyl9PP99ulDAt5AASRtqhGXBUZufOk/M2jivkPbGx3kN3xPWn332SeOl3OiWO0demwGfZeU3j4j",
+	"dZ9xpj1zmKX5NptLxeIZMdLFVtoIqQmAsxH8z4wbRdX2mDz3TVSl+GYvlvfGa4RQjXohdbhGF4dFIW8n",
+	"+LCahPKYKWkF2umm4sAXmq/7ESMx5VII/KDayS9bsqQ5yaRSLIt7pHP0WKhWUrEJVFRJZuB7xedGk4Kv",
+	"uNEEhb8FkSWcAlvJNk1BfXNVAug7nwSa7EWBpR1YqesT0fHAKeH9bx3EJqgxWgwV3q6hj80/Vucvtoue",
+	"WCfFHs7HtMtX7DBkG3fhRcKxKTXbZuG0km7ON0g3TOmkqGgUMCnXAkdvkFAQl1ZcawtKoKVbXhSY/otv",
+	"an7AgkdyGrWlLBFTuzYygGUTXrY3MTCH2bYHFRZsXWUZY7kLQNf+KWyf29jugSbKB6q6GPRxyKepmHsJ",
+	"ujG5dsFPwMXfWg6sSQ91phffo7psSOzNPHjYg5SKZSwkD4wZ4FWcT5iYpZLVYhlVtwqb5C0nqnJ2lXiU",
+	"n3SFISGY4ASmeEZWUhtnlbAj1ftdR+A8zKQwShZF045q1awL53P3A91cZJl5JeUN5LN79B/YxpEWyt3e",
+	"lrTk2ki1bQ+La/zOfkMdrB4HG8stYzd4VVkQpSBUZUsolupmqbONPcLkKJigzvO/kPJZFnCHuEFKJR2B",
+	"aQ6EgdxRG4pxHwCwlXLwKhfShB3Lx36qdghYjTHVyoQ+sJAy6mD9A33wU7LxrNI+/AGpWe+vmmTbEVOj",
+	"6+C3rLvyOo4w+x4iEZjv91+1+/1sLlIsormu5q2b1s1fCEKNXPEszXz/WtFYvTFUPdTT5z5lj66RpLS1",
+	"CQUxsgxFIeuLy7IjJ8P5W8JxNGU3ckrCdJYVUfQyaDO9nVGmfU/QKEWZU34AF2mqeNoF9+NCSofrcVr6",
+	"vp6oi6hA1C7QI6ji5Ov6o2jGesorNyCCZ4t/Fx4MRPz0PEi4juWr1PG0PVyiU2yGkkosaYeQD5TvusTE",
+	"BDDqxOjEXeTO9R1lJae35J1xyZxR05k7kvITkpGN2B8wM4Jok+yBPAL/c2JjJrXxof9Bx+trMvhTl3y8",
+	"kUtDcsms8tLxCezdXph9QVgs5emVOHX6JOtV+u9fUEMlH+QbubAaEgxBaEM2ULjHSK/7wQYjnBwow+4F",
+	"VCf2NAD40HKMseVnVsDF42u/P6rrSxwF/J7z2ria+0LorqJ7ApuErM899226Wt/OeLNrzBg5Gxp1pr1P",
+	"6MCHVgRAfxxaA4ZB0WiHgjGnEKw8oabnmYEeD+PIOOs0bdHo3L0QcBaSUft0AOdCyotKMZeF2GpaVNN5",
+	"tKRm6YVfaN71fwKlnMvk9TtTEvVj+ThyXnSpwdr2Y1lOCrZmjfA8S8v4ztOar5nvq0NnkjNWon9v260i",
+	"FXcW4bF9J7q1T6LIpSHYTRrfLWLtTpE9lvWU4jG8ofcCFWVz6z680RFTVSy8eC1c7tkMmzKrDOFGJ97g",
+	"mayKHO+KWf22toaxMFBgK/571H+OPmzo5Rcpo+A1NiVfb8oCveJvl9vprvXnPT4091q1VSp2tyusposM",
+	"biKFJTIWdwK8YDoGdsb4Ak1X8V9aM5dXBWUuOPiA73D4p+TC/zfO3o49rMbYFy9GHQeQDEzvTJJexxGG",
+	"IxkVQlqJFzQX9Rqmzewwdi68H3KypGvmU+7V2h/FVnLt0yLUeLx8aRUlIP1VpmW63f0wAmslzbdBR7kQ",
+	"tvtHeg/Z17C9bvTQKwlO9prnFW3wIX2oANz0wIIrMQFeR6k08VQ2dJqf7Ahv/QAXvn/qwe0x8X7YfX7w",
+	"VZ5G3a6LfG88d6X7bk+RDueO8+cH91qcLQ/RAPaqqM+MLumt6PcF614dqD2OpPkBO8WliFD79YZlb1z/",
+	"hjb66NHw3eTUwSx3CuEeBxHvEQIswFrxrK5kIRI+lcDAhaz5AjqWeWVmXZrI/2AnxkZcOGPDEf4mdRT3",
+	"/SmF4GBEtyqGJHe2Pib387T8Q072zoPdO16KRjRzCdV2mAf9aYn08bdOZlAr1HjhteKkSyfIWInDDgR6",
+	"dfSgabxSXzLvVW+pzzv62hX5UhuoUrLoHvff2SFPB8SeSIX/CGnIPyta8PkW+ZYF33cjekmBhJwbv41l",
+	"cdHvMPHuZ8/YA+aNUdJPZdfNh44ZDbeFUSKgQcB2FlGsPXHD4m3AMB3LjzMDjFhXMzTsgCjd2s4uFtzi",
+	"fY7xFc1jWwBWS9o2uIOMxJD/qJOHxVP5IibobZD7zdN01fIYxUdKIC6zZKtD1IDXEQn4VhHRBhtQfoRF",
+	"+UDWldIB9rnONcDu0UueahkDDeOtQt070vQNWsqpd+E0mbQO9RRsLK7lNfgJdidZ5qxvGUPA/xPtSsN1",
+	"aqCeOl4PNvkUu9BIh5yA1boCzORmothc7wtnwtYAfA2wDiZcLuAVqK169vK1UyfVVby4gFeojZ0Ozu1h",
+	"lJzNuahZLRdlZRLPXbSFiG2EsNijAtHa4yHdJ2OAKLqmxQ6L0jW6wWM0QKvStPcicX2T+mu3h90BuK41",
+	"M5jVrvZRiJvB9Z/z+ZwpG8GsDRU5VXncnAuSMWUohyCGrT7eXad2cdjjsEMjWaiZszVy3UHStoAU2+jl",
+	"fA9nmgAgPaFXzQBvmOslc9Tf9IQJepUej48ODH8Jb5gV3YADFeZe6zkQrlgbuk9hMyIFmo6tdDds3X4e",
+	"zX9nu6fBcgyOERmJsw6b4sSOPU6pO68K74iQ8OLhquO/c6CFgkvxGukMX8g/CW52siVrFmln6rPB8JZr",
+	"RJbtkMHDUnKXWZRZerKymWDRy9HeMd0fDBZRWDJqvmOK6yExDMFxmTlju9sBht1GlE/i+nNKlAkqV/SO",
+	"HB212RJxrZ3WrRMU2dbKWKSMXQLMA5X71iToL80e8Gw8gGNEzWlDDBeMc4hr/u6Ul5NSlpNsSPiz8x62",
+	"AHhImzD20Edkd+xZdwjN0sFdKqbGZjDGgV4J/aX697mvlNkufQaX4s1Xb/oM6njHBM4cCM7tJTXIYVuH",
+	"sHt6M6l79sXWpotN8jiD1I1p0xuy5GavdIjZsmKQQZ+2hxEcyGv6aaa1D4gFB/Z4wK7Y6XZuTcKXwdcq",
+	"8NA279V77gtLX9PxpiTLbl59d/H8ydNfnz7/nEADkvMFq8d0oH76TD9lpgdtdfwsCsSDEWHugYFaNuUy",
+	"LR1i3micvhRzq2ZKVoaL3kdA3SACEqSfHght8b3OgT0Q6KswbS/wPdRvi48A5neT/xVDZdtFvqYiGxAq",
+	"gBkqXFg15n1BCZzGTkXaDtk9B9abdR8vsa3sTGsbE0iN9f6FehcHRUFncsCErhnMCAZEGwMDS4vKI8yl",
+	"gojpMdElmATx3YcNBbt1EE/J1/AQwD+sNOwH6wyDqkm3pqfPPQB7V5aOaHRYHbTNQ/YX/YoP2dFdmS9s",
+	"HLl2RRwwihiE0jjoCBTd4Hcxjv2TlS+ugOmXyOvYGxpwi86Y3oncGZfR0Ru8oXXXf7vrsf3InmNt4JWU",
+	"wX5qq8Ul182xnBhl6sK4dc0yqgmEgRGqGytFEYeJP8qrevfrostTkvvnTPwSb7ma/VkLVXSVtK66/mdj",
+	"SlqIxsVkKMhPe3K7HCPcIDg+b1GIVmjwcrb105K3LKsUeu3YxcP7zvLunEh40MGWszVTW0y869qdRLqx",
+	"jkZuDXLegnOI1IOIH3v+v1fs6THuDhV/drly4sFoOkU5w5bfgXiQxEuKC0KJCptxS7fJSD6s1TBxABxu",
+	"3m4Jfpg+iFFVpyQ71bC4Qj9QjxM/qnnlnOzboNq7LYVLUI8m8OmTxxwhgPT7APTnMb83yu4Oodxr74aQ",
+	"0to1nUflHA8sYM65YyClBnN7h06bjhdB13IEdR4jr/tB6jeG20cAoeUZ8GkF+s7yTHoT3MY6xHkfeJ9g",
+	"NmyK4y9WaRWlVGis/gjibevRElSbOvLH7BWOUycg/HNtV2qRJ9+xFAo+/p5BTN7M1WLp0ewmXF9TuxU5",
+	"vwIbLZnSXBsmTMt3nZs6/ZleogMJljdfMxXkhOhiJGzDTU/wZWohfdmzkJ/BJ+JcawmzTqnAq6yP7q51",
+	"OVuc9eFAw4CXoIPrKojRKYg67qnONQYltighVmC2NjVWihDtTdhDeoOuQSSM7iWY4PQf/S4MHmz9N+Ex",
+	"nKR2/vrT8I9EiZKTcY2w3I/BK5KCxI786xediJVQnmMQaN1SFAnyQAB6Mo830kNH6WyjIurK+pHha2YV",
+	"ioU3xY8fau/7vTkgERLfYQ94cdbwul1IW+jA+YNLaNcV1KOlvO+jhMby9yUi96w3XCTRFjlzoDFMW7Yk",
+	"u2JhlHpefxUyuvcYdzqJ35WUBuPJiyKRMN7a6vFMxYSDteLXtPj0XOMbrrS5QHyw/G2/oihOEB4j2aJS",
+	"n7z05Ss6CKyCflqo4BW0ZuK/GOxs8nZ0szhn8c4diGZ/WtisJuFxvmaC3OKYNl7kyedkxm3ipFKxjOu2",
+	"E/qtF2lCZmumwOsSp4CSlK0s2/fOcvWzNPc4DnMfi0V+jBwpg3e4g7k+6n8wc+rhAMnTkiLVDqEk8Jfi",
+	"dVCCsL8+UuPauWmkd+smuCPaSMVOXDQpKpF4YNGkeGVYwnLw8nAdeHlVmnXXOfjWb+A2ceHXaxtaFayL",
+	"3P7SXWY2pHSX/SHVHauJWYRAoylBUMlvT36znix4mh4/xgkePx67pr89bX6G4/z48XDTzB9YSsyi0o3h",
+	"IEkSVi1y76sT04pVjSoiNHcRxP30TqAeG9Jwybl9FMwrYcfzbNjF2Tm2Lufj4KkuUSv/grwTj4leUv+2",
+	"cH8+ff75aDxiolrB4uvvo/HIfX2feqnlm2QG57pkTSc+1xnNHmhS0u2QtPF7i9Qk8VvX5Pn0Io02fJZ+",
+	"030He4YPVxdBeCmQ1SN7sTeoq1Tzv6V2dhJD67CGE2NJsi7EE7ZiX02en/sK0Nsi677CfORBl+C+FS/2",
+	"BkJ9CY38bJCT35YD+xWg/HX2+bNPn53dQ9BTmc8t/T4FtyxiEmttTB5NFZVPc6iqNQUNH654c7oJwu+s",
+	"db9S3GyvAP9e7c5/vUmVXfo2FEJy1bWCl7WTfY28YcLHEdVlkyrtpetvJS1Q+rTO34IRI2UBoeF0VRbO",
+	"F4/8/cHs39lnf3uWn3/25N9nfzt/fp6xZ8+/OD+nXzyjT7747Al7+rfnz87Zk/nnX8ye5k+fPZ09e/rs",
+	"8+dfZJ89ezJ79vkX//4AKB1AtoD6LJovRv/P5KJYyMnFm8vJNQBb44SWHGpN3d2hhm0urcuRMDTDK5at",
+	"KC9GL/xP/5e/KKeZXNXD+1/hRlTQfGlMqV+cnd3e3k7jLmcLrDYyMbLKlmd+nrtxC+MXby5DdhlrEcQd",
+	"rV33pqOaFC7w29uvr67JxZvLaU0woxej8+n59AmML0smaMlHL0af4U94epa472dYr/pMMwOvIX1Wp0hM",
+	"enS/xRQl/kmvINT2YciT9m/Bp18/8mnj5q7eI4R7A3RhFZc5EpdxCYDGI6uc0ZYcn56f+71w75pIvDyD",
+	"weA3yz9ShWfvxgkpwQGchAw74Dq6i/5J3Ah5KwgW17UHqFqtqNraFTSwEQ2O20QXGj0cFV9jDUTo3cY5",
+	"mGvmu1CuOFuz5in3nZFAUHqwtrmcrCrDNsG2mUL5S5j+yg0ApsP7Yn9nseXOZIndwUZvAGZfUMzD429C",
+	"hzOMJrAIC2cEd6SL6PGorBLo/BqTHeldOLNpEFRE6pBiwWO8g9E31f8QjALpLkKhXfhryWhhlu6PFRBq",
+	"5j9h+gf3f31LFwumpm6d8NP66ZnXOZx9cJnl73Z9O4sQBj/Xf014vqenj5Xb1+Tsg8+6vXvA2Cxy5mKY",
+	"ow4DAd3V7GwmNwc0ZfHq+peCNK/PPqBurvf3Myenpz+i+tTesGf+8dHT0lbrSX9soPCD2cBCdg8HbaLx",
+	"MmqyZVWefcD/INne2dNesFQpum856PMoqZuPMdfLTCqj7a/ADWwySfRVq1t2jvwF9PrKQoC3qQ88G734",
+	"pZvvCwcifiQUUeD+rSWIxky1kIhG2IgpBBG40b4WhH85n3zx/sOT8ZPzu38BQdf9+fyzu4FZGb4K45Kr",
+	"IMUObPj+nhyvo7OtF2k3KTCwlOsc7kR/6iS3Va2BSEDGbs1je/hEAWTo8uyEPL5Zxz/B37+kOfEuqTj3",
+	"k08396WwuQdAULUC9d149PxTrv5SAMnTwotkRwpvF/bwx0yBuM1OCW/jkZAiqjwrFlbMSLpX9vAb58l7",
+	"IL+5gl7/y28aDTu+AZh3zVpbVlxgAGStYnEO974UBfM1ur0m0DnMu6RBddYN3C/s4AkjhGZXmkFEo8v+",
+	"XxZOUQWPWz+RrsoSOM6c6kBZY+85K1z+7jA0qUQmhY1bw6wq3m0EXarR9UTf8LLRhc8JD3WKogJLgJF/",
+	"Vkxt611fcTEad99Mw/yrd3zr6B5d2sDGsbKBPyIs54G2P+mtNmxlo1NpHu8MqMPGRFFXRYUKkstbAa28",
+	"YqE9PPQgJWNKW090IPp6g2daFpVhVrgHlBAa3oARVNGgfIWbl3OFFrQteagZIzUtXuL3l1w9svM5GqOK",
+	"Od/4OTPZkuUxYD17M7eF7/sftB/zerU0foLrtTnQia/XpwdecX/9Ff/PFiienf/t00HgVk6u+YrJyvxV",
+	"BZorK13cS6Bx7yt039JnZiPOMGb/7EPjKek+d56Szd/r7nGL9UrmzD/v5Hyumdnz+eyD/TeaiG1KpviK",
+	"CUOL+ld7d5zBvVtsuz9vRZb8sbuOslH9Pf3zmdd2pzQYzZYfGn82X+V6WRm42mCr07Ikija0ICsq6MIm",
+	"1A0KYiOJHyBcY1PyugxChMvXRygGD8nK1Bp8YmTw8a09s2CE2j93wQVOgM4yOAudQ1faDQPs6nevHGQ/",
+	"ypx15dbURehgbNyF4SicJ2J53p9Gcxwx3rvDDopP4nLmQtgiHUXn09kH978WBexst1NRdnDXoeqpfQNb",
+	"eXl4++Harj0jNVK11p0MNcx6E3YPM3ysdPvvs1vKDbwxJshrJkjXqc6K0ZXjR52f09AYRgu8B2wwT/xr",
+	"zjXVmq1m3S9qq6qI1/QMHf16RpscrfEND1tfx47SMvXV6eV6Gvlt8p9rk2hsYsSDHoyLv7yH86qZWnse",
+	"UFvMXpydYS68pdTmDJ8VTWta/PF9OKIfPOPwRxW+bSZS8QUXUKrOqp4ntVXs6fR8dPd/BgB1deAFIDsB",
+	"AA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -1,6 +1,6 @@
 // Package public provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version (devel) DO NOT EDIT.
 package public
 
 import (
@@ -79,6 +79,27 @@ type ServerInterface interface {
 	// Get the current supply reported by the ledger.
 	// (GET /v2/ledger/supply)
 	GetSupply(ctx echo.Context) error
+	// Starts a simulation session.
+	// (POST /v2/simulate/sessions)
+	StartSimulationSession(ctx echo.Context) error
+	// Ends a simulation session.
+	// (DELETE /v2/simulate/sessions/{session-id})
+	EndSimulationSession(ctx echo.Context, sessionId string) error
+	// Get account information in a simulation session.
+	// (GET /v2/simulate/sessions/{session-id}/accounts/{address})
+	SimulationSessionAccountInformation(ctx echo.Context, sessionId string, address basics.Address) error
+	// Get account information about a given app in a simulation session.
+	// (GET /v2/simulate/sessions/{session-id}/accounts/{address}/applications/{application-id})
+	SimulationSessionAccountApplicationInformation(ctx echo.Context, sessionId string, address basics.Address, applicationId basics.AppIndex) error
+	// Advances the round and timestamp of a simulation session.
+	// (POST /v2/simulate/sessions/{session-id}/advance)
+	AdvanceSimulationSession(ctx echo.Context, sessionId string) error
+	// Get box information for a given application in a simulation session.
+	// (GET /v2/simulate/sessions/{session-id}/applications/{application-id}/box)
+	SimulationSessionApplicationBoxByName(ctx echo.Context, sessionId string, applicationId basics.AppIndex, params SimulationSessionApplicationBoxByNameParams) error
+	// Simulates transaction groups in a simulation session.
+	// (POST /v2/simulate/sessions/{session-id}/transactions)
+	SimulateSessionTransaction(ctx echo.Context, sessionId string, params SimulateSessionTransactionParams) error
 	// Get a state proof that covers a given round
 	// (GET /v2/stateproofs/{round})
 	GetStateProof(ctx echo.Context, round basics.Round) error
@@ -88,6 +109,9 @@ type ServerInterface interface {
 	// Gets the node status after waiting for a round after the given round.
 	// (GET /v2/status/wait-for-block-after/{round})
 	WaitForBlock(ctx echo.Context, round basics.Round) error
+	// Stream committed blocks with their ledger state deltas.
+	// (GET /v2/stream/blocks)
+	StreamBlocks(ctx echo.Context, params StreamBlocksParams) error
 	// Compile TEAL source code to binary, produce its hash
 	// (POST /v2/teal/compile)
 	TealCompile(ctx echo.Context, params TealCompileParams) error
@@ -254,6 +278,13 @@ func (w *ServerInterfaceWrapper) GetApplicationBoxByName(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
 	}
 
+	// ------------- Optional query parameter "abi-type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "abi-type", ctx.QueryParams(), &params.AbiType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter abi-type: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetApplicationBoxByName(ctx, applicationId, params)
 	return err
@@ -573,6 +604,182 @@ func (w *ServerInterfaceWrapper) GetSupply(ctx echo.Context) error {
 	return err
 }
 
+// StartSimulationSession converts echo context to params.
+func (w *ServerInterfaceWrapper) StartSimulationSession(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StartSimulationSession(ctx)
+	return err
+}
+
+// EndSimulationSession converts echo context to params.
+func (w *ServerInterfaceWrapper) EndSimulationSession(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "session-id" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "session-id", ctx.Param("session-id"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter session-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EndSimulationSession(ctx, sessionId)
+	return err
+}
+
+// SimulationSessionAccountInformation converts echo context to params.
+func (w *ServerInterfaceWrapper) SimulationSessionAccountInformation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "session-id" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "session-id", ctx.Param("session-id"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter session-id: %s", err))
+	}
+
+	// ------------- Path parameter "address" -------------
+	var address basics.Address
+
+	err = runtime.BindStyledParameterWithOptions("simple", "address", ctx.Param("address"), &address, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SimulationSessionAccountInformation(ctx, sessionId, address)
+	return err
+}
+
+// SimulationSessionAccountApplicationInformation converts echo context to params.
+func (w *ServerInterfaceWrapper) SimulationSessionAccountApplicationInformation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "session-id" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "session-id", ctx.Param("session-id"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter session-id: %s", err))
+	}
+
+	// ------------- Path parameter "address" -------------
+	var address basics.Address
+
+	err = runtime.BindStyledParameterWithOptions("simple", "address", ctx.Param("address"), &address, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// ------------- Path parameter "application-id" -------------
+	var applicationId basics.AppIndex
+
+	err = runtime.BindStyledParameterWithOptions("simple", "application-id", ctx.Param("application-id"), &applicationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SimulationSessionAccountApplicationInformation(ctx, sessionId, address, applicationId)
+	return err
+}
+
+// AdvanceSimulationSession converts echo context to params.
+func (w *ServerInterfaceWrapper) AdvanceSimulationSession(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "session-id" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "session-id", ctx.Param("session-id"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter session-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AdvanceSimulationSession(ctx, sessionId)
+	return err
+}
+
+// SimulationSessionApplicationBoxByName converts echo context to params.
+func (w *ServerInterfaceWrapper) SimulationSessionApplicationBoxByName(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "session-id" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "session-id", ctx.Param("session-id"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter session-id: %s", err))
+	}
+
+	// ------------- Path parameter "application-id" -------------
+	var applicationId basics.AppIndex
+
+	err = runtime.BindStyledParameterWithOptions("simple", "application-id", ctx.Param("application-id"), &applicationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SimulationSessionApplicationBoxByNameParams
+	// ------------- Required query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "name", ctx.QueryParams(), &params.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Optional query parameter "abi-type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "abi-type", ctx.QueryParams(), &params.AbiType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter abi-type: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SimulationSessionApplicationBoxByName(ctx, sessionId, applicationId, params)
+	return err
+}
+
+// SimulateSessionTransaction converts echo context to params.
+func (w *ServerInterfaceWrapper) SimulateSessionTransaction(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "session-id" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "session-id", ctx.Param("session-id"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter session-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SimulateSessionTransactionParams
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SimulateSessionTransaction(ctx, sessionId, params)
+	return err
+}
+
 // GetStateProof converts echo context to params.
 func (w *ServerInterfaceWrapper) GetStateProof(ctx echo.Context) error {
 	var err error
@@ -620,6 +827,33 @@ func (w *ServerInterfaceWrapper) WaitForBlock(ctx echo.Context) error {
 	return err
 }
 
+// StreamBlocks converts echo context to params.
+func (w *ServerInterfaceWrapper) StreamBlocks(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamBlocksParams
+	// ------------- Optional query parameter "round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StreamBlocks(ctx, params)
+	return err
+}
+
 // TealCompile converts echo context to params.
 func (w *ServerInterfaceWrapper) TealCompile(ctx echo.Context) error {
 	var err error
@@ -740,9 +974,17 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/v2/devmode/blocks/offset", wrapper.GetBlockTimeStampOffset, m...)
 	router.POST(baseURL+"/v2/devmode/blocks/offset/:offset", wrapper.SetBlockTimeStampOffset, m...)
 	router.GET(baseURL+"/v2/ledger/supply", wrapper.GetSupply, m...)
+	router.POST(baseURL+"/v2/simulate/sessions", wrapper.StartSimulationSession, m...)
+	router.DELETE(baseURL+"/v2/simulate/sessions/:session-id", wrapper.EndSimulationSession, m...)
+	router.GET(baseURL+"/v2/simulate/sessions/:session-id/accounts/:address", wrapper.SimulationSessionAccountInformation, m...)
+	router.GET(baseURL+"/v2/simulate/sessions/:session-id/accounts/:address/applications/:application-id", wrapper.SimulationSessionAccountApplicationInformation, m...)
+	router.POST(baseURL+"/v2/simulate/sessions/:session-id/advance", wrapper.AdvanceSimulationSession, m...)
+	router.GET(baseURL+"/v2/simulate/sessions/:session-id/applications/:application-id/box", wrapper.SimulationSessionApplicationBoxByName, m...)
+	router.POST(baseURL+"/v2/simulate/sessions/:session-id/transactions", wrapper.SimulateSessionTransaction, m...)
 	router.GET(baseURL+"/v2/stateproofs/:round", wrapper.GetStateProof, m...)
 	router.GET(baseURL+"/v2/status", wrapper.GetStatus, m...)
 	router.GET(baseURL+"/v2/status/wait-for-block-after/:round", wrapper.WaitForBlock, m...)
+	router.GET(baseURL+"/v2/stream/blocks", wrapper.StreamBlocks, m...)
 	router.POST(baseURL+"/v2/teal/compile", wrapper.TealCompile, m...)
 	router.POST(baseURL+"/v2/teal/disassemble", wrapper.TealDisassemble, m...)
 	router.POST(baseURL+"/v2/teal/dryrun", wrapper.TealDryrun, m...)
@@ -754,305 +996,359 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9a5PbNpMo/FdQOlvly0oa23GyT/xWat+JnctsHNvlmWTPbuyTQGRLwg4F8AHAGSk+",
-	"/u+n0LgQJEGJmtH4kswne0QSaDQa3Y2+vhtlYlUKDlyr0ZN3o5JKugINEv+ieS5B4X9zUJlkpWaCj56M",
-	"jjmhWSYqrklZzQqWkXPYTEfjETNPS6qXo/GI0xWMnoRBxiMJ/6yYhHz0RMsKxiOVLWFF7bRagzTf/nY8",
-	"+e8Hk6/fvvvyH+9H45HelGYMpSXji9F4tJ4sxMT9OKOKZWp67MZ/v+spLcuCZdQsYcLy9KLqVwjLgWs2",
-	"ZyD7FtYcb9v6VoyzVbUaPXkQlsS4hgXInjWV5QnPYd23qOgxVQp073rMwwEr8WMcdA1m0K2raLyQUZ0t",
-	"S8G4TqyE4FNiHyeXEH2+bRFzIVdUt9+PyA9p7+H44YP3/yuQ4sPxl1+kiZEWCyEpzydh3KdhXHJq33u/",
-	"x4v+aRsBTwWfs0UlQZHLJeglSKKXQCSoUnAFRMz+BzJNmCL/cfryBRGS/AxK0QW8otk5AZ6JHPIpOZkT",
-	"LjQppbhgOeRjksOcVoVWRAv8MtDHPyuQmxq7Dq4Yk8ANLfw2+h8l+Gg8WqlFSbPz0ds2mt6/H48KtmKJ",
-	"Vf1M14aiCK9WM5BEzM2CPDgSdCV5H0B2xBierSRZMa6/etymw/rXFV13wTuTFc+ohjwCUEvKFc3MGwhl",
-	"zlRZ0A2idkXX3zwYO8AVoUVBSuA54wui11z1LcXMfbCFcFgnEH22BGKekJIuIMLzlPyiACkJn2pxDjxQ",
-	"B5lt8FEp4YKJSoWPetaBUycWEtGBFBVPMSqCDxyae3iU/faQDOo1jvh++zPFFu5RG+pTtjjblEDmrDDy",
-	"kvxPpXQg4Erhti+BqBIyw3tzYoYxyFdswamuJDx5w++bv8iEnGrKcypz88vK/vRzVWh2yhbmp8L+9Fws",
-	"WHbKFj07EGBNnVOFn63sP2a89FHV66QseS7EeVXGC8ris2Bo5eRZH2XYMftJI80gj4PegPvjxjpbnzzr",
-	"Y6nbv9DrsJE9QPbirqTmxXPYSDDQ0myO/6znSFp0Lv8cWfXCfK3LeQq1hvwdu0aF6tjqT8e1EvHaPTZP",
-	"M8E1WFEYqRlHyGyfvIs1JylKkJrZQWlZTgqR0WKiNNU40r9ImI+ejP7XUa3oHdnP1VE0+XPz1Sl+ZISx",
-	"BMP4JrQs9xjjlVEeUdXqOeiGD9mjPheSXC5ZtiR6yRRh3G4i6l2G0xRwQbmejvY6ye9j7vCbA6LeCisk",
-	"7Va0GFDvXhD74gwU0r5Teu+ohqaIGCeIcUJ5ThaFmIUf7h6XZY1cfH5clhZVY8LmBBjKc1gzpdU9xAyt",
-	"D1k8z8mzKfkhHvuSFQURvNiQGTi5A7kZ0/Jtx8edAm4Qi2uoR7yjCO60kFOzax4NRi87BDGiVrkUhRGB",
-	"O8nIvPyjezemQPP7oI8/e+qL0d5Pd6jRO6QiNdlf6osbudsiqi5N4ReGmo7b316NoswoW2hJndQIPjRd",
-	"4S9Mw0rtJJIIoojQ3PZQKenGa1AT1IS6FPSLAks8JV0wjtCOjULOyYqe2/0QiHdDCKCCpm3JzKpXl0wv",
-	"a5UroH7auV983oSc2nNiNpwyoxuTgiltlCHcTEWWUKDCSYNhIaaiKxHNAFrYsogA86WkpSVz98TqcYwT",
-	"Gu5fFtZrSvKBQjYJc2y2qPGOUF2Zme9kuElIrMGhCcO3hcjOf6RqeYDDP/NjdY8FTkOWQHOQZEnVMnGm",
-	"WrRdjzaEvs2LSLNkFk01DUt8LhbqAEssxD5crSyf0qIwU3e5WWu1OPCgg1wUxLxMYMW0uQAzjidgwS6A",
-	"W9YzJd/RbGmUCZLRohjXdglRTgq4gIIISRjnIMdEL6muDz+O7C9KeI4UGD6ogUSrcTaNKTlbgoS5kHhR",
-	"lUBWFIXTylyPyqL5TWCuiq6gpTuhsBSVNjBGN5eTZ351cAEceVIYGsEPa8QLfzz41MztHuHMXNjFUQlo",
-	"aGE8K6q8xl/gFw2gzdu1qOX1FELmaOih2vzGJMmEtENY4e8mN/8BKuuPLXXeLSVM3BCSXoBUtDCray3q",
-	"XiDfQ53OHSczp5pGJ9NRYfpGZzkHfodKIciEdeMl/ocWxDw2Co6hpJp6GOopqNOE/UCZbVBlZzIvGL6l",
-	"BVlZuxkpaXa+F5RP68nTbGbQyfvOmurcFrpFhB06W7NcHWqbcLC+vWqeEGvz8eyoo6ZsZTrRXEMQcCZK",
-	"YtlHCwTLKXA0ixCxPrhY+1asUzB9K9YdkSbWcJCdMOMMZvbfivUzB5mQuzGPYw9BulkgpytQKN0abhAz",
-	"S22qPp4JeTVtouOaqA3whJpRI2Vq3EISvlqVE3c2E+Zx+0JrIBLMS9uVgPbwKYw1sHCq6Q1gQZlRD4GF",
-	"5kCHxoJYlayAA5D+MqnEzaiCLx6R0x+Pv3z46PdHX35lSLKUYiHpisw2GhS56+x8ROlNAfeSFyfULtKj",
-	"f/XYO0Sa46bGUaKSGaxo2R3KOlrsxdi+Rsx7Xaw10YyrDgAO4ohgRJtFO3ltv3s/Hj2DWbU4Ba3NJfiV",
-	"FPODc8PODCno8KVXpTSKhWo6pZy2dJSbV45grSU9KvFN4Ll1vZl1MGXugKvZQYiqb+PzepacOIzmsPNQ",
-	"7LtN9TSbeKvkRlaHsHyAlEImRXAphRaZKCZGz2MiYbt45d4g7g2/XWX7dwstuaSKmLnRAVbxvMdEodd8",
-	"uPyyQ5+teY2brRLMrjexOjfvkH1pIr++hZQgJ3rNCVJnw3Iyl2JFKMnxQ9Q1fgBt9S+2glNNV+XL+fww",
-	"NlKBAyVMPGwFysxE7BtG+1GQCZ6rndYc7w1sIdNNNQRnbWx5X5buh8qh6XTDMzQjHeIs91u/nKuPqA3P",
-	"IlOYgbGAfNGg1Rs1efVhykJxRyUgNZh6jo/RI/AMCk2/F/KsVnd/kKIqD87O23MOXQ51i3E+h9x86y3K",
-	"jC8KaGjqCwP7NLXGj7Kgp8HoYNeA0COxPmeLpY7ul6+kuAEZmpwlBSg+sMalwnzTNTG9ELlhPrpSB1A9",
-	"68FqjmjoNuaDdCYqTSjhIgfc/EqlldKeqB1zULNKSuA61nPRnsEUmYGhroxWZrVVSbRIyZf6wwnN7Amd",
-	"IGpUT5hDCNWwb9nplvQCCC0k0HxDZgCciJlZdB3lgIukipRGd3ZqnVOJh/LbBrClFBkoBfnE2bN3wuvf",
-	"s/JHb0EergZXEWYhSpA5lTezgvOLncCfw2ZyQYvKqOc//arufSqL0ELTYscW4DupjWib77pLuQZM24i4",
-	"DVFMytZaaE+CUbEN0ylAQx+yr4+93u1vg9khghtC4AVIjKi50aPlJ7kBogzw3/DBupElVOXEqIG95gej",
-	"uZr95pQLrxvumCFMUFClJ7tEinmpYTcxS424eEqK4MA9+uRzqjSqgYTxHO23VhTiPFa3NFOM9gwqwyl7",
-	"b2Nm0l/9Raw7bWbEO1eVCrcyVZWlkBry1PLQZ9071wtYh7nEPBo7XP20IJWCXSP3ITAa3+HRGQLwD6qD",
-	"h9r5vLuLw6gDo75s9sVyA74aR9tgPPVvRYiPg2p7YGSq3gNLbky16G0mRAEUTaZKi7I0HEpPKh6+68Pg",
-	"qX37WP9Sv9slSesGsppKLkChi8m97yC/tEhX6OtaUkUcHD4+AQ1eNkSuC7M51hPFeAaTbecFL8Hmrfjg",
-	"XOm4V+VC0hwmORR0k4i2sI+JfbwnYfixkUBq+4HQMJmhNzFNI/WZ8PGmV5tV4FQqpXgTfEIyc87NNaom",
-	"Nff11SfNAadN8U1HrHfCLAhGkg78eIgsS0+JEVH2XwhtyMoRHa7GSaVrrqUHe2HWG0EgjjupDQHt2f8L",
-	"lJs7KGAHnX8Dqm/h9dSHWnaP+R9le0NgtkRZS9okRUQvX97BGPt4UI8v4hWVmmWsxOvqT7A5+O29PUEy",
-	"VoLkoCkrICfRA3uTL+PviQ1Dbo95tdv8IHNrF/yOvTWxHB+Z1QT+HDZoNnllMxoia9UhzBGJUY3ApZwg",
-	"oD5q3tx44ldgTTNdbIxiq5ewIZcggahqZqNWui40LcpJPEA6Z6p/RueQT7rDt0YInOJQ0fJSkYf2trUd",
-	"vrPWlauBDnfLKoUoEvbP9onvICMJwaBwIVIKs+uMFsWG6JA24ympAaQTEBiNEfSZO6qBZlwB+S9RkYxy",
-	"vOFWGoKSJiRqPqgsmxmMuhnmdKGqNYaggBXY2zw+uX+/vfD7992eM0XmcGlDbji+2EbH/ftoinsllG4c",
-	"rgNYu81xO0kIHfRVGiHrbm1tnrI7yM2NPGQnX7UGDw5Oc6aUcoRrln9tBtA6mesha49pZFiAH447yH3X",
-	"DAnrrBv3/ZStqoLqQzgq4YIWE3EBUrIcdnJyNzET/LsLWrwMn70fj2ANmaHRDCYZZgkOHAvOzDc2sdCM",
-	"wzgzB9gmjgwFCE7sV6f2ox037Tpuma1WkDOqodiQUkIGNkvOaKkqLHVKbMpEtqR8gTcgKaqFC3W24yDD",
-	"r5S1hMmKd4bYVxXTaz5BF4ZKpqmh29JnWxolDKi52bb9H/aydkkDKFYYDRLa0fa0/UFJl+l41HvxN/i+",
-	"qC/+Fm/NlNGrOhMb+mGEtBqagd4zxKfRlbpIjLfRHD5DDDfjpamHTkHZnTgKCq8f9sWFn1ZlWWwOoCTZ",
-	"gYiEUoJCkRabAZV9KubkZ5ZJcVwsRJB5aqM0rLrOG/vp7z3H9fVVbsCCF4zDZCU4JK70L/Hpz/hwsNnR",
-	"iuGeEVEh2mvA9sWngYTWApqTDyHp624Skkz77Lc9nep7IQ/lZbcDDr5TDPBc7wzrcFNe1b9OiyLhkrbm",
-	"hw4XUeMQFM4koUqJjKGieJKrsYs+t15sG9beQv+rkBp1gAPcHrfle43SsKwhH4qSUJIVDM38gistq0y/",
-	"4RQtfdFSE8GC3jjQbxZ+6l9J26ETZmI31BtOMVA02P+SgUFzSNihvgfw1mFVLRagdOuCNQd4w91bjJOK",
-	"M41zrcxxmdjzUoLEiL2pfXNFN2RuaEIL8idIQWaVbl45VpXSRGlWFM4RbKYhYv6GU00KoEqTnxk/W+Nw",
-	"Po7EH1kO+lLI84CF6XDGtQAOiqlJOtLxB/sUk0ocTpYuwQRzLexjH/Fc14YYmbU3ilb8n7v//uS348l/",
-	"08mfDyZf/+vR23eP39+73/nx0ftvvvm/zZ++eP/NvX//l9T2edhTyeAO8pNn7o5+8gwvYlGeSBv2T8Eh",
-	"s2J8kiTKOKCoRYvkLtbLcAR3r2n300t4w/WaG8K7oAXLDS86GPm0xVTnQNsj1qKyxsa1zHgeAXteh67B",
-	"qkiCU7X4643oc+0JtgbcxFveyjFwnFEdHEA3cAqu9pypsNo7P3x3Ro4cIag7SCxu6Ki0QOIG4zIYG1E+",
-	"ZpfixK43/A1/BnO8Dwr+5A3PqaZH9jQdVQrkt7SgPIPpQpAnPinyGdX0De+Iod4CUlFSc1RBKsUp6Cq9",
-	"ljdvfqPFQrx587YTh9DVrdxUMRd156xrJvNTTozeICo9cUVcJhIuqUz5QnyJD5cNjV9vhcPqJKKyRixf",
-	"JMaNPx0KZVmqdrGHLorKsjAoikhVuXoFZluJ0iIkjhlm7nJvDQ28EC6oRNJLf+WtFCjyx4qWvzGu35LJ",
-	"m+rBgy8wBa8ucfCH44GGbjclDL749hajaN93ceFWL8eg8klJFymfyZs3v2mgJVIIKhwrvGkWBcHPGumB",
-	"PhMAh6oXEHKR99gSC9neeb243FP7lS/rlV4UPsJNbeZOX2sHo6z4K2/gjsx6WunlxHCE5KqUOQZ+r3yB",
-	"AbowIsdHECi2wAuAWorKLBlItoTs3FW2glWpN+PG5z7Qxcliz3CYQpuRSw6cM4O/jHIzYFXm1CkylG/a",
-	"JW6UTYbAQV/DOWzOhP18OrA6WFSNLiqxovqOLtJuJGsN+cYH2Y3R3nwXd+VzRF05Esy79GTxJNCF/6b/",
-	"aFsF4ADHOkUUjToffYigMoEIS/w9KLjCQs141yL91PIYz4BrdgETKNiCzYoEm/7Prl/Dw2qoUkIG7MJn",
-	"9YYBFWFzYm5HMyuO3Y1JUr4AI9SNIBaKFhi0P006+lE7XAKVegZUb7XX8rjMhIcOFfJLTJpGo8nYLAHW",
-	"Zr+ZRiMIh0tzwcO7t33HBRJPrxROZdcE+RVB9Z/XSdLTq1wiHMIT9ey8vA97Eu4LLj4tpk4E2T5fGRwu",
-	"pLg0u2kAFL50IxZ4ieRUpegChoqjhqtoYEmMhgcIB9ml/ST1HTFvqzUdHWPgIuznE4OXJHcA88SwB3QD",
-	"tEIc/dzWhei8Ci95sfFInRWoUIcAUUs6VDb8bHyxH7BpNgaS18qqB6yJtfjoL6nyRz8fRxz9itrixykl",
-	"s61+3kkUfUd1tzqeF9Nt1j629pwZEMHNF76Kni+d5+vljcZ71b4bj1yKQ2rvBEctOocCFhYn9mVPZ3V9",
-	"pno3DRwv53NkepNUIF9kjIw0EzcHmIvYfUKsxZwMHiF1CiKw0bOOA5MXIj7sfLEPkNzVl6J+bJRd0d+Q",
-	"Tha00fhGSxalkfqsx2uVeZbiylvUKk8rxBmHIYyPieGkF7QwnNQlntaDdGq14d2nVZnNxXbc67sTDTxo",
-	"bo2oney1SqvPXGV9seLtl5G+Fey1hplYT2xmdPJqNVvPzJlI5itgnnbq8NrKeXcUmYk1xhShhLMB7ntD",
-	"1w+ZBywKA1kzhVSO3/WpjRa8/QDZrsinqFkh6Tm7WiC7Pk32asD0qNN9ZHc3KqF3IJBaBsy6DLiz6Oy0",
-	"szS1ra4mUovbcagOG9LUUqym73Amd7IHo13jabPW3Y91ucP+4mj+rH6QIn9do9x16jLaj0tba3Gfsoxt",
-	"cmgAsQWrr9pKbBKtzcClJl4jrKVYkmH0XWdXF20KCkBLwKShV0/OU27pN29+U4A6w6n/LLJz4u5RvrkX",
-	"RcNJWDCloXYu+CCXD+/7QXOiuWyJef/qdCnnZn2vhQiKhnXH4oeNZX7wFWDo+pxJpSfomUkuwbz0vUJL",
-	"2vfm1bQi3Iy3Y8q6evbWgxGic9hMclZUaVJ2IP30zED0IkguVc1QUDJuo41mWAo/GaC7h28S4bGB3VsR",
-	"9Nwi6Dn9EPgZdrDMqwYmaSivOf1ncsRavHAbZ0nQcoqYuhvai9ItvDbKpe8y2kiJjsIuptt8Pp1zmfux",
-	"d0Zj+Yz+PiXCjpRcS1QRMZ1AKBYLyH2lN5cUaqteuXp6heCLupag+X1L+cApsVX8sAjflvp9Ljwd+oLT",
-	"G+1EsCtGEvr4MoOQ19l1WHsQJ1kAt5VbRvv3GymSiIsD4/GNyDL6YXl7J2w+GTp81goXrmN67R6Gzcbt",
-	"KYDm7lqlwK9v+6HtbpdD3bgv6LhRInb7AcMBkeKYVpEC0yGaHs5Ny5Ll65bjz446vQJJDFT3upXgWzhD",
-	"tuQG24GfZmDxjl49d4x0xPeds+MIr/lH5pJp45ldRK45GzRz1QbySqI3qREt3K2nHy6aA9f+06+nWki6",
-	"AOcRnFiQrjUELmcfNEQl6RXRzAZI52w+h9gTpq7ixWkA1/F35AMIu4cEu+6ycLfcSp9dIttBW/UKdiM0",
-	"TU8JSumLuTjr+iP9xSOyrQVhE23cFZyKyYICP8Fm8istKnMTYlLVsanOQdgU63vQxMXqJ9jgyDtDPg1g",
-	"O3YFTXGvASk05V0Jj1RUJfyOanRfwDtwYwv32Knj9C4daGtcK43+o1FLqEY/ieZSbu7Y1CEyBtIhe3Wa",
-	"jjoxZwua29Im9F1bxPLduk90BYmnYhi9cRUhFypt7IwuA1p4wsfFjt6PR9eL90jJSTfijp14FURzchcw",
-	"GtP6/xtBX3tuCC1LKS5oMXFxMn1KhxQXTunA131YzQe+X6VPxdl3x89fOfDfj0dZAVROgqmjd1X4XvnZ",
-	"rMq24Nguhmw5dmfbtaawaPNDyew4kuYSS6+3rGmdXjd13FR0UF1kzTwdKb6Tb7oQL7vELaFeUIZIr9oj",
-	"bQO9msFd9IKywjt+PbRDrex2ucO6KyX5RDzAtYPEoui/a4/Vmyfw5s1vFx6ztT/FBkqFkviJWDp1xUjn",
-	"Dq9Jn9Wa1ndwSFznS6xkmr53cVfnFBmjCzijB9cDvxeyIahcVmMyYO3mFERzmbB4TDvlz5wXvqMWTolV",
-	"If9Y/GF4w/378cG/f39M/ijcgwhA/H3mfsd71P37Scdw0tRnWBZa8jhdwb2QF9G7ER/WDMHhcpi6cHyx",
-	"Cjqy6CfDQKE28syj+9Jh71Iyh8/c/ZJDAean6RBTRbzpFt0xMENO0GlfVmIIfl7Zdp6KCN7OwccsWUNa",
-	"KHpcBw/rZ+8eIV6t0O88UQXL0kE/fKYMS+I2pNe8TPDlwT5kM0fFeuLKecWi0c1r6kouz9ZColmTCFfJ",
-	"SsA1fmfCsYCKs39WELX1RUncEs7+KoSjdhTstH3RDdzuGjy6SsPf67sIvVVtm8Foq8v1WXADekSk+kzt",
-	"me8Qz9hh/ltyFRxFefGJiW1LFzq8k7K23vO2N4F2bmDPPp3Htf+C5Nph2s18NmSnmZrMpfgT0roDOgkT",
-	"pTu8d5uhAf5P4KkY1TYjC5EDdcPqevZdBDLcttBHKte2JfhFh655VxHhaT6x30bvaTSI9rvfbKDS5cXd",
-	"JvRdVOPAk2YiTQ8zwwMbhYVjLx8f7ka5PaG2rkUj8yx9zuNE0SM7fn3OHcyd5NqCXs5oqtGRuS8amKLt",
-	"bwTmaUH8x36DVCjNYGcnUS5DeJfZYn8lyNp71C2VfMW7n5128K2vvuQhxcXXu7GNVSmUSAxT8UvKMY4Q",
-	"v7Mc0H2twMZhmK8uhcQCnyodQ5hDxlZJY/ibN7/lWTfyK2cLZluKVwoInWtX59ENZJvKWypy3bxDLRKH",
-	"mpM5eTCuz6zfjZxdMMVmBeAbD+0bM6pQQIeYiPCJWR5wvVT4+qMBry8rnkvI9VJZxCpBwv0cVc8QCTsD",
-	"fQnAyQN87+HX5C4GDCt2AffSAsYpa6MnD78eb+ucjRjHJvHbmHyOXN4nMqQpG6Oq7RiGrbpR05kJcwnw",
-	"J/TLky3ny3465HThm04E7T5dK8qpQUgKptUOmOy3uL8YytHCC7feGVBaig1hOj0/aGo4Vk82uWGIFgyS",
-	"idWK6ZWLFFViZSisbkNuJ/XDYX893wbNw+UfYgh2mbjjf4TrFl31ZDhiVP0L9LfHaB0Taiu2FqzOv/Ad",
-	"asmJr0yNfeFCOziLGzOXWTrqq5iOMSelZFyj1ajS88k/zPVd0swwxGkfuJPZV48T/dWaLYj4foB/cLxL",
-	"UCAv0qiXPWTvtRz3LbnLBZ+sDEfJ79UlHaJT2Rsrno7v7Qs77hn62tq1GXfSS4BVgwBpxM2vRYp8y4DX",
-	"JM6wnr0odO+VfXBarWSaYGhlduiX18+dJrISMtXpomYATiuRoCWDC8wvTW+SGfOaeyGLQbtwHeg/bnSb",
-	"V0sj1c2f7uRlIfIqJ+5poayS0fR//bmuj4/ObZu327JeCpmw0zqL4wcOS93PXtj2odtwQHzWg7nBaMNR",
-	"uljpSfew+Rzhm48R79UGye55w1T68A8izT0edf379xHo+/fHTlX+41HzsWXv9+8PD5lN2wvNrwnUXE3W",
-	"tKtXmm9TW/2tSFjvfBfPEDfmSpUkLKxJWWZE6syNMSbNVokfXu84TL7i3mHI6QPkUYOP27j5yPwVN7PO",
-	"gOnnD83usUnyycPzKIeCkm/FeigRtcSWp6dPAEU9KBloFcSVdLrjJiMldob5RGRrRp1BIcxNNW6ANThq",
-	"5TPaBYOa8Za9qFiR/1p7oVuSSVKeLZNB5TPz4e/2GhC9EFkwsiXlHIrk1/a2/Lu/VSfu/f8jeoZdMZ5+",
-	"1G7EbGFvQVqD1QTCT+nHN7hiujATxChqFuQKJU6KhcgJzlN3LqlZY7ejeaqTbCLHH4ddVdpFJWPxBNdQ",
-	"ZM4KDKNN+8PxzYmkuoerYtt/3+LKjINd+JU1S9jRQRLKVii2FV2VBeAhvABJF/ip4ND6HCu24chRWxKi",
-	"SvMI38TiL4LoSnIi5vNoGcA1k1BsxqSkStlBHphlwRrnHj15+ODBg2FORsTXgLVbvPqFv6wX9/AIX7FP",
-	"XOcv2zBhL/CvAv37mur22fwucbn2q/+sQOkUi8UHNiEbPcRGrtvWq6FN8JT8gPXJDKE3WgSgUdRXWG7W",
-	"BK3KQtB8jEWhz747fk7srPYbCYg6bP26QAtg84gknTzDa6T6+ms9tauGj7O9dI5ZtdKT0JQ1VUnRvFH3",
-	"kmWt6Ce0DcbYmZJn1iwbAnvsJARLi8sV5FEPWGsGQOIw/9GaZku0d05HW03KPd2Ahrcw9hywdhdFea+h",
-	"YRZycLMM18XYNjEeE6GXIC+ZAqw7ARfQLNgYqp06g7wv4Nhcraw4t4Qz3UN7De2x9t0FD5xVfX18RRKy",
-	"1j5c2/dXV/LAJuf7Nns+xa/SeTutztGtuAfbMmPtm25Myc/O2ZFRLjjLsNlESgXHUozD3KoD+nKk/Z1q",
-	"5M5y4hgm+1WHBHWHxd4O1p5lOsR1gxqip2a/LeHYPzWsXRPABWjleCDkY98+3jnoGFfgGqAZ+oo5qpCJ",
-	"0K9kWkwIITlgSPp4hNXUemyt35tnL5xtHmvGnDOONjeHVHcTtA62QjH0s3PCNFkIUG61zbww9Zv5Znq2",
-	"5gjC2+lzsWDZKVvgGDYU0SDFRgF3hzr2McEuBte8+9S863oXhJ8bIXV2Ur/ut0kWosL+p3qu96I/Ffvl",
-	"A2ki5Ibx49G2EOPWUH+Uy4YM4QIj/6BEed4hm9C+vjnKd+bKaukN3yA2czdZNpjxBBjPGfcO33QdrCwp",
-	"S3Bj8DT3fKcySbW9dAzieGdAi550GEyqtxED1x2q3YnBoATX6Ofo38a6834PWwkv1LcLyjfEHwpD3ZFS",
-	"8pQWIRg+0UcftTOnjNlg4VZn/RRbMWx94lNzG+jamQgaPsduKPvKqb5qo7MqX4Ce0DxP1Z37Fp8SfOoT",
-	"CmENWRWagIU802a59i61uYkywVW12jKXf+Ga0+VMUaVgNSsSobfPwkPIww5jIarZBv9NdcDq3xkX9L53",
-	"9rePcM/361HQzWZPac+GpieKLSbDMYEy5froqKe+GqHX3x+U0n3i9yeR193icvEepfjbd0ZwxGW6OzH+",
-	"VrSEKtoYTy/wua8HFiq5NrkSirJOnzeMyMDNS2xZC3j/YhLwC1r0VFyIvTZWvlpPRl/dhay3rAjVrnqd",
-	"pqTmCUNMGP31v2wEdssz1HVv9sVY2xDrm3SeOHxsRXq/p/Gnhl/RRr3VDKXXn3g1l19NBPv6/Fwrhq69",
-	"lBaFyAZzBjfMsfmov1SvWK1c5ftEVN7FSuTxWYijuQDSjM0GLCdSK/Bim3yGV6vkE3mZHq1hHwlEM7Rq",
-	"GaLRLWFsEzM9eB4YO3U8UWSydZgl37MCm0P9x+nLF6P+jYx2oLulrnR20oTdtzEhU61NHgvRwMcWHiB4",
-	"kbZ/qx6TOtaGSp8G1504+eB7ayAcApKtk7TP28+HDt4hgIWwXaFSfTO61WlG9XZ45EfUUG+v5SgxdaSo",
-	"ot1tKXH3sUbP+hUSGpEOakza0JGGNHdK9RFyNwVvgbWCxtWjs82VOn2ZOgz02RDlsIOP9+PRSb6X+pTq",
-	"RTWyo6QY7HO2WOpvC5Gd/wg0B2n7iaSuk7abyArMNVQtWYn3n1IoVvcDLsxgrpD3EoebDk3NOVuCqwrj",
-	"iwR0xvIB1BeQaewPXYeBSoDhcQ5leokGAu9QxFc+QiiIBMih1MutypIN7i71sm4bCi7zjCkyA+e6uAA+",
-	"JmwK03ayWl4XhSIF0Lk3wkoh9IC+uiFtCdEYA52ir06P5u1qYKfmW1TS0LbSnQ5vwnIccgJsouUlVXXl",
-	"qFYZhcHp2vM5ZFjwfmv5vf9cAo/qsY296Q5hmUfV+FhIF8SWDQe1aNewbiuEtxXUqCfVTULaVxDjHDZ3",
-	"FGnQULIjcMiwvUoFeESO9eP6pgJ9rg0XGMlUoCdEkI+DdwX46x5LV2kCEFWnvCIYnsaNeKorVl4NGq/R",
-	"XAEM8+n0Wk3763J4qJj2Vffrdlfvvyk/w2b2ygWV0lBuPrYnkZNuO+ZLV64eCy0Gb6EvXA/K/+YLtNpZ",
-	"CnbuOtQgwqxv9pLK3L9xkDJ5Vm6yNNDzMDOrE6O6UT77xuXYDMWsEEYBmvQlhjYzlUII7x1lY63romUI",
-	"9RykhDz4BAuhYKKFT7Pao/inS5/cgj0bZX4lvLUi+vdIGbYr6u2h8LpuJIHtICn2TKAu+DzGCpGwogZ6",
-	"GTV3SJtBd+3QU/vc1xTx7f22m1f78B7Oxe4O2T71jqkO5uPTNSdOOdibezUKkVzBMss4BznxTtx2awfe",
-	"LJOJdZXzKrOqSnw2g/V6cNmxLdwsadTMuqtsXaGiqhznsDmyZh/fddzveAy01SEt6FFB6RZRHNRWrVJw",
-	"Lw4C3sct31kKUUx6PIMn3X4U7cNwzrJzwMKsITPFaMF3msfGTELuokMqxIxcLje+20JZAof83pSQY26z",
-	"A334SLMDaWtyfkdvm3+Ns+aV7TDjLNDTNzydZoWdXuQ1uZ8fZgvP6+NNCgy/vOb8dpArzK7XvC9G7hJb",
-	"wjT7BE+Hmje68R0tFSoiPwtFSoE6tY7gp8gSEvcogtVZojJCGB9AiXMgE1WIVBT+VSrImKHSmIonQ4A0",
-	"8AHX1RoKN3gSAS7IbkdVVvfY1x0VcyKhjs24agFWV9PUMnHVZxppzxxmaXLGuZAQz4hxprZQc8hswzrH",
-	"+J8Z05LKzVXKpDZRlTJD9WJ5Z7RkCJSsF1IHS3ZxWBTicoJsbRK6K6XMAeY91RTbvk9p/Z056jOIwi6p",
-	"cirihixpTjIhJWTxF+kUbwvVSkiYFAKjMFOBHXNtLgkrzOvkpBALIspM5GAboaUpqG+uinOKuhdEoWxJ",
-	"FFjawZIB9puIjgdOaaSvdc9OUF/b2WjDb/6Z+caWr6jL39lFT2yIQE9+AShX7s5hyL7chRcJx1Zkahtl",
-	"0yrynK2RbkCmjvycaFnBmLg32l343cGnEsiKKWVBCbR0yYoCq0ewdRTQEOKB0qjt0Z1PMA76gmHAW7OS",
-	"iFWpSyMdQ/mVmAecxhXZiF5KUS2WUX+AAKe/usvKXezjUX5RFcYkYoqomeIxWQml3bXYjlQvuQ4BvZsJ",
-	"rqUoiqYhz+r5C+f0/Zmuj7NMPxfifEaz83t4CedCh5XmY19SoR27W88kWzUYh90U9JpPkDzU7jLr9j2M",
-	"anX0PJh3trhfx/Gwy5Ifgfl2N3Pd7dc47i6sva4mn03fhY45oVqsWJY+bp9X9GtvzGqKeyUrLdouxLYK",
-	"Db6GfCCWYyGcCblnF83AabKN6jFxPMKFdSAnMv9FNb49LpmD40E9MrTLd5yCNcl61cAWAAipLYSgK2lb",
-	"F8dKWmA4YmELp2BQShvQgQIHY/+uB5sZ4eBAabgWUJ1o5ADgXWvBGNuKmDayeSbW/vm9umTmlYB/v53K",
-	"G8yjL6jytCYtacMqfSGrHo6QbkCwNQLxDItgzIbGIYZW9AOFfwRAf2RiA4ZB8Yn7gjGnrIB8kupSfBJs",
-	"YOPouu5yLKPRfT9Hy8kzWvlOwGbsSoIrrGS1f9l0J5bUkJIIr3ct4jyHNdgcrT9BCtvHdxy5s6CwbX5b",
-	"FgVRTgq4gEbApqv2VKEWyi7Af6vCxyQHKNHj2za0pSIR4y6BLeuLW/skimUbgt2kOcYi1u4U2WFrSVqG",
-	"1nxij4kaepQMRBcsr2gDf2pflaNpSzRHOYGqzvVh4q+YQ6f5xY7w2g9w7L9PqTIeE2+H8aG9WVAaddsY",
-	"0M7I5Er1nXqeDkyOS5kFRxHOlge/tiXxmm+okl7yfqtml+Trm9jAfWKCR4j9bg0ZajXuKgS5uwz1eE5c",
-	"DSSkdg6Q2wuD+SRhzV8CJ1xEPY8vqQq3mLqqq//BTowvMe4u2lfw0dfxw9ffWYKDEdUqtphuURrI+no2",
-	"/o9yErcexN7xUjSiwKXybjGNeep21w58QVRFTrjZT6P7Y49gJ8UcFx+TWeUHKgpxaZsYx1fUZ+D9uZb6",
-	"vIvJqeUsiGUfJz12BYfbVhAWZYis6IYIif+YC+k/K1qw+Qb5jAXff0bUkhoScg5kG0Xh4q7NxNvVq7EH",
-	"zBtihJ/KrpsNHTMabmNGiYA2gty3bRNkRc8h3gYMELH8M9OGcapqhkYNI7Jb29nFglu8L8+0onlsBMBC",
-	"s5sGd/AFz83X/1+dthpP5es/lgXNfMtq13yuyWewq70nLr2E1fY05y5f8yQQOuXXRCt9mYz8CtbUPVlX",
-	"KuenrzlWA+xOC/BOX7BrLWOgUbjV42hLgvigpRx6Fw6Tw9lZUtzqd9fi4s7HH2Z3khWi+5YxBPxPaFca",
-	"4RWdzLZ0B/V4PbZZ+gfYhUYhngSs1gw+E+uJhLnaFUhj7eAzsa4BVsF2y3gmgSobd3Ty0l1b6wLIjJtr",
-	"tI3aDW7VMEoOc8ZrVst4WenELQjrIPNNhLDYm4Bo7fHN9ekYRhW9oMXLC5CS5X0bZ06PbQ0cN+nxHhT3",
-	"bcIAEiRydwCm6hsg5lPX9vn4NSP+bYNBGzurNOU5lXn8OuMkA2m0BnJJN+rqrqrgddjlrKKRLtSsFhK5",
-	"rZC0LSDFxnmbr+lICgDSA3qUBniCMEg74QWyhiEtehw/XRg+C0/Qiq4nhVhg1m/PgXB1rtF1aC+QgqMR",
-	"3Wp3w9bt51HsT9g+DbYicYxIC5x1yBTbz/1L3Eq8hP7Cmd568q2Fs52GbSOd7cH0SOWLOj3DEkv3PKYy",
-	"511hpjh73quqvkyJpz2INjEZEt2xqvfsIsZXuLILsQl9eLPKZghHKj/f2hUmaG9QWxIwQNV5BTRzEWJd",
-	"Q1zHUGGRMnbVDfa001nrvpdLPeChIUW5s96cNgTomHH26fC5vZ7BpBTlJBsS22q7FeXOyeAgbcLYQx+R",
-	"C6Fn3SHuRoX+XY2aaI1GXvs2Oe1tJLbLV1Zm20wGfUamHo7edGCIOfIyPMLWtIa5VsEUM/aXc+/sbhrR",
-	"ApMglEjIKolG5ku62d34saf6/OmPx18+fPT7oy+/IuYFkrMFqLqnQatxYh2ayHjbavRhgxE7y9PpTfDV",
-	"QizivPfSp72FTXFnzXJbVRcj7rSN3Mc6nRAAqeTcbou8K+0VjlOnRXxa25Va5MF3LIWCm98zKYoi3VMm",
-	"6FUJ90tqtyIHjLmBlCAVU9owwqb/lOk6KFst0biIVcMvbG0owTPw1mdHBUz3xHKlFtIX04v8DGsxOJ8T",
-	"gXVZOF5l/UTb1uXuada+h0ojhtvMgJSidKo9m5MURJizJSsIdnVnNkV7ehSmG5itDdhNEaILfk+T3jF3",
-	"N2ExJ9u5fbMVt05zerOJCfXCH8orkGafd6O/zshVOEntGPhk+EeicMrBuEZY7k3wiuT9YEtW+HEnaiIU",
-	"DRkEWrdARoI8EICefOhG0mqUZBfVJpfWx4DeCO9+bqsfP9du6Z2ZKQiJ/2AHeHEuc/1eSKZw4Hzkwt4/",
-	"B6RES3nbRwmN5e9Kj/asNwiSaIuc0URrUJYtia5aGCXEq6chz7znVtJJR5dCaGJupkWRSGO3dhw8UzHh",
-	"mCuBvKDFh+ca3zOp9DHiA/LX/YlbcdpyjGSLSnXwgpzP6SCwohTlDwIVf4W59f8JZmeT0tHN4hz/HRmI",
-	"JiFa2GjvefCAAyeXOKYN7Hr4FZm5dj+lhIypdkDBpVdpQr4tSDZ38bWw1u3c32u3CfpV6Gsch7mPByIv",
-	"IidbiBxwMNdH/SMzpx4OkDwtKVLtEEoCfyleFzdV3yF2rtka5mqlnKLCjXuWcuq2ix+6PFwHCq9KQXed",
-	"g6V+A7cJgV+vbWitssEdZt68+U3PhhQUS3eDMZ9jjbODtIW5flOYD1LgzKLSjeEgSRJWrXLvql7TipeM",
-	"6jQ0d9Go+z0N5JcW/WY0vBTMK27HCw1QMVfcs3UxH4coBsHNZ0/IG36fqCX1dwv356MvvxqNR8CrlVl8",
-	"/Xw0Hrmnb1M3tXydzCutC+l0YkRdN4E7ipR0MySZfWfpnCR+60pBH16lUZrN0ne6H82e4cXVJSCccGT1",
-	"yF6sBHX1c24LAG0lhtZhDSfGkmRdHihsxa5KQb/2lcW3pd97un20uG/Fip1Bco1GLO/Ho4UtUobdSX53",
-	"veo+7LZ7CHrqBbqlX6cMmEVMYq2NyaOpoqJuAxqyuM8SHTIw8zqrJNObU4N/b3Znv5+nikH9EMozuZpf",
-	"wQPvdF8tzoH7GLO6mFOlvHb9g6AFap82MIAbnVMUU/Kd7RDixOI3d2b/Bl/843H+4IuH/zb7x4MvH2Tw",
-	"+MuvHzygXz+mD7/+4iE8+seXjx/Aw/lXX88e5Y8eP5o9fvT4qy+/zr54/HD2+Kuv/+2OoXQDsgXUd/55",
-	"Mvrfk+NiISbHr04mZwbYGie0ZD+B2Ru0sM2xQCEiNUMRCyvKitET/9P/7wXlNBOrenj/68j1gxwttS7V",
-	"k6Ojy8vLafzJ0QJroEy0qLLlkZ8Ha1k27iuvTkJekI39wx2tfU64qaG+n3n2+rvTM3L86mRaE8zoyejB",
-	"9MH0IdZTLIHTko2ejL7An/D0LHHfj7CK9pFyzXiOQuro+3HnWVnaVj3m0SKUATV/LYEWyCLNHyvQkmX+",
-	"kQSab9z/1SVdLEBOMWPM/nTx6MjfPY7euboy7w1gyWAD25Ul6r3hg5/LalawzGiorloWep1sUo+KG+I7",
-	"f1ylxmRGC8oz8IkDPMewSFt2xWg5AeEnuUG0/f6kZnaIRh+NMnryW8oq2wFv6onU7EBEQ6GuUs0j0AY/",
-	"sjwSXeOB4xku9mDy9dt3X/7jfTIYuxuXVQc0bn2aLEWmAPs6/0GL4g9rAYc1hs63gufGfUGP47pcD35Q",
-	"o22MxubwNPq8fqfZnOQPLjj8EdD4zwrkpsajA2wU480rcLQozIuCQ0Jv6y79aZ0seOnajMdxylEE83+c",
-	"vnxBhCTOFvaKZuchUdInzdaJwnHOrPmybylO4KVW4jIuV2pRNsvvh9W8xT7KCCge80cPHnje5uwEEa6P",
-	"3HmMZhrUbMi6M8MoHpwrDNTlgfbR61A8W9LSnuNjn+5gVH7nULYvTQ11Pz7gQpslvq+93PZwnUV/S3Mi",
-	"XSUGXMrDz3YpJ9yGrhtZZmXu+/Hoy894b0644b20IPimFdp4jrtC6hd+zsUl928afatarajcoDalg1Bo",
-	"d8mjC4VRHCgrLKeKim/yxejt+16JeRTHaB+9a5Ssy68lT61bt9FOcreI7ZEDOJZNonU/3D0uSwxRPw3P",
-	"j8vyleH9CgOXgCHnhTVTWt2bkh/irxveWAuJdcY2cpgcjnwBzWZwTtQ6PCnvG/VV/lai/7hpumQ5cM3m",
-	"zFYQTq2jQXNblzO4VVsi1n/741shHlNNJ68yqk23bw5JaOLhlLWJ6zs7cAx7pA/YZ/l6dUwtEMn66jvl",
-	"yC1a90drn4IXLSXoenUf6A8jVHzd+CADG8LuBkXOZ66u/kwLQ0LRclu9/E6e3aqxfys1NtRwXli9siwP",
-	"oNj6JLhdrxy9c0WGD6HvoplikKYbW0Cib6M8pbstjnNvSo7b71yNrbjKzTt1WJuU97fTXm1J6Z16q6Oa",
-	"w2qsjTzIXS/caq396lWcyrtPZm1DpzK/D/r4r6um3uJxL73ULGK3RnoF5t/RNp2ouTGh8JfUMh3SbvXL",
-	"v7V+GRo/XEvDjJMcjly1mkjfvJZhtW04ZTrokc1+IRHTw7JUWLfFHuFxndBlWIzNVHE5Kmrsr77oXLe3",
-	"YrtZ487FuKsg/gDxDfzbzcmzIbrh52YVvFFnWP1lUpykN/mmmXLStfT6w7iWhjG5xw8efzgI4l14ITT5",
-	"3oeOf/kh9+CQvDFNVvvywm2s7Wgm1rvYG2/xt1AR1Rz+BrMLNbHH0XPztg3+uYtFImZUwVeP/f3l3pR8",
-	"616ty065cMmFMBzPJxdTubAfGaZpkEHu+D+f4Ph3puR7TJnXaowRy5iLhy8yrp88fPTFY/eKpJc2ILj9",
-	"3uyrx0+Ov/nGvVZKxjWGi9hrT+d1peWTJRSFcB84YdMd1zx48r//67+n0+mdnfxZrL/dvDB89S/IpMep",
-	"Wr2Bkvq2/TPf7dTlm9sN7t+CDxnr8a1YJ8WJWN+Ks48mzgz2/xJibNYkI3c1DsbjRje8A4o1e0z2EWxj",
-	"J8gwgTBIpSl5IVx/1Kqg0tYmw+LviiwqKinXAPnUUypmfytbgjUrGJatkUSBvAA5USz0X6gkhAJapYQL",
-	"zNiqy5M3INgtMTBh468vLX6m6yigfhYUBy0c7tAcuqJrgv2yNFGgx7aI6Jp88w15MK4vZkVhBpgEDKe4",
-	"9IquRwmmvCtdI/XrYQ2mgb6HVsF75vAo5O6YdRx7iBmt1txCMeb6mvR3Fxaf7a3DHgy3sQdi1nv77mrf",
-	"XGxMce1Dt5pRrC6psXWAqsqy2NRF441i6bW2NFc1Mwy1kHwunqcbtYygsyB1G2/v1S1HuLWGXIsvtQlq",
-	"Tx6EyZfq6B0aKGIG1GECmJi4kwE4x5ZVR3rOvnQ56Yc7+KEewpZnvZWeQgexuC4GuYvpFFirDSu0brDk",
-	"o8SSqmyOpaHu+Rb0rpsCltypI/LTypMdfmImTSlRUUecW894v6KHtNjtnxBvYE5tCZ4hnUuj+gro8wWZ",
-	"OIov8T+0iEkgNAzz9YyRmAI9uJ701gRiE2JdQpEvDFLSRmf+3VA+rSfv6qiIlkO4zG8RvB+COyz+O1fv",
-	"yPIUt4i/QpKOv9BPyAtRF5ex/P4v6ZK+Sf3kphf0QnCwsRfmMmBp8dbNHpSnWuj7WmT2Sld37ryqInXk",
-	"6z1s1aZ+tJUIPlON6gZE+o/JKhkNqWMQO91ZMKkebQiz9mU4aEMFnH7Mu9lH4a+f4IXtY3CwD8NybL0e",
-	"x3ecmsAPy4Sw3J8l5qNQLKePIz03L0d62itXMuVvyp22EUwaVQnCCaWIaKL04vRveJyfurZq2hemsuUm",
-	"FeMZECVWgLcKo8a7rhUWwn98OAg1W0FORIU1M6OM9I/McL588MWHm/4U5AXLgJzBqhSSSlZsyC88tE+7",
-	"DgNUhLo9j23o3cNBGEe3YLMsaRbXPrwGXxSLLW5QZ+2vCyu78lSi0iBtSd1Wl0zW4dspKzoyjOdm6luV",
-	"D7/22zC0NcRTWhSIv12+Ohx4UMR7UdgNhhXTum40FUtg8h3NlmGzx7XtLTQT9h1Jxq0a1jiy6yxry3Uo",
-	"MBuvgUSriSwcIGEusEskSPDGxVVVaFYWzW9Ct23sPpiIRLPEGlfAO3nmV2fd6mJeD90maN+/xA0+NXO7",
-	"RzgzF3ZxVAIy89gAGtskpw2gbR9OH8ofdU90PSBdeWQmW/Wq66insgQq648tw7hbSpi4ISS9AKkont7W",
-	"ou7dqvOfhjq/dg0SPhFlPunqvS7zv7psakTkv9Nrlr/frbt3io7+ddw0Z62ioSfP4qwpEarueb2iZzEG",
-	"kXsmav7raEClrJuuwJp0IdXVLbuumGGlWm+9S4MZSudsbbvn9ZX0/dCip84ciw86EW2V4KOKIP2xRNCk",
-	"JYOaaPl4Eglb4Iyj8J1SCi0yUdiovaoshdShILCaDrqIQZ+Ya9zD+mtRX0OUrVmudhrBz/Ct2ytRbQU/",
-	"83hLmcGb51dtae+9M6KxnmvIXelMlMTed1ogfFRGd6tjpxhcy2L+uRvMdS/pHdh+nlGdLavy6B3+B6sQ",
-	"v6/TYbGrkzrSa36EfXyP3m2N2UQeW0BuiBE/bZi8Ol2Bk5GXz/HzuvnU90JG+sgP5rvdrLOJtHFbC7A9",
-	"iTG4M8FUb0ZtvtU2+1wLrQ2/vkM9MWLnvIZqD1En00C7UUszX8DB9jFOkPBtAMintaDa3zJnPCc02sbW",
-	"pVrImhHcsM/lphf9MVw4Hz7q5cvP+Jy9EJqcrMoCVsA15NeLgCZtDuelx1Zxu59i4ER/N0y6K/Njie8z",
-	"RYIuslPA/4Usd7cy/pOS8U+DWyom0FuJ/flIbOkP4a1w/vSF8xef7WpuMPpjoLC+ghetKaDrO/qeorqj",
-	"JjjrVsuksM0Bh5fy9irV90L6Vpy38v0vl49k93hwLMsQq84u662b8hDJPp8U9MNsE0WRsE70HeFxCJdh",
-	"WD5RZAxbLp3kauzicqxBw53vW5Xok1aJor2+1YhuzRWfmbmiR/9xloKiGKKC7KsaXaxEDt47K+ZzV8m4",
-	"Ty9q9tQ05Kk0XZXEfjntjW09Yys4NW++tFMcVMTWYLfcki3wDLIUZILn6qrdY91UVxVO6LHqh+qDu0jD",
-	"tnhYXAmg6ZXp+HVU2bBDHqS9IwobpPpazg4ZOVwQQ5XTA9Dy0Tv7L9rlSqESqzn1VN3ZmLtuW2xxajtu",
-	"A0DyCjVTW+XafyXm5IGtUV1xTDheMtdHHWMEtdwY7dUXwJNAC5I1Eg0DHN3jdNp7nLbeHM5Sq+tZU/pa",
-	"Iepje+17xZXKPrXSwX/64EflKeXucHRRqQWhhMOCanYBPspgeltV6crC0NU02sIqx4TmuT239SbABcgN",
-	"UdVMGVWJN9NG7qjmydqDtcC6BMmMhKdF7fO3t4wjWzJpWyzTqX3jmjKvxbVsoSbZbLbuBbMr4yTm5GeW",
-	"SXFcLESIRlYbpWHV6UjuPv29pzGBt1DsZTEQvGAcJivBUy20X+LTn/HhYJaBZar6RjwzD/casCXem0ho",
-	"LaA5+RAV4Lqb9ImwkGsF6LRWK6EU0tywZ7awjj1Ee55Hf/I2POsexw3PImecexgNFPfYbvx85OPFGx23",
-	"k2++a/zp6rO5N9Wy0rm4jGZBO4SNyxxSTQkvALcptr1EHOEndebC00SX5Pphf6Pkv2nSrXMpxSmVLmXt",
-	"AqRqXTJvM2//Upm3g/d9Ly5thqzULk5XqcMqRi9EDnbcOtvSHP1UvxQuciDKA9HSh0KYZ7pLk5dr9XsW",
-	"b0yRGWB9TVotlppUJdGiG/c4jiaY0Myy5om9j6UnjMr42lsbTrekF0BoIYHm5g4NnIiZWXQtYXGRVGFF",
-	"Zp+85oJZh6tdEbClFBkoBfnEN43ZBa9/z6bL6S3Iw9XgKsIsRAkyp/JmVnB+sRP4c9hM8PauyN2fflX3",
-	"PpVFWF10+xbYmq6JjWgn5XaXcg2YthFxG6KYlG0OsD0JmB0nVmUBLj8ugezrY693+9tgdojghhB4AZLN",
-	"2Q0fLT/JDRBlgP+GD9aNLKEqJ0bP6ML91D49YyvUGDnlwhtsd8wQJiio0pNdIsW8FC9amaVGXDwlRXDg",
-	"njv7c6o06uOE8RyrFlpRiPPYm4OZYt9bPU5plAN7lUpM+qt9mJo2M2Keq0oRN4LPXYM8tTwO6y1zvYB1",
-	"mAtLgPixQ3KctbTuGrkPgdH4Do9Ryx5CdWjQCMQMl1gc2oGpM//sheUGfDWOtsF46t+KEB+HX/TAyFS9",
-	"B5bcsBdATG+h9Ox4pLQoS8Oh9KTi4bs+DJ7at4/1L/W7XZK0xR2sppILUHFOo4P80iJdoQ19SRVxcJAV",
-	"PXdpjwvXcbcLsznWEywkNNl2XtCqbt6KD86VjntVLiTNYZJDQRN2ql/sY2If70kYfmwkEE/okwuhYTLD",
-	"GiFpGqnPhLyKKS/MKnAqlVK8CT4hmTnncyEjUnNfX33SHHDaFN90xHonzIJgJOnAj4fIsvTUY0Q0Yxiy",
-	"ckSHq3FS6Zpr6cFemPVGEIjjTmoLUHv2/wLl5g4K2EHn34DqW3g99aGW3bbpxrK9ITBboqwlbZIiopcv",
-	"72CMfTwoZUX+LN1G7SC6G8z7bFrRozv89Cr2iaNLyvRkLqS9t0zoXIPcmc3xn5T5uAznZNLC1SAiOILT",
-	"Edw4KLXipn+OY1kQiJN/hkRcrScjlCl5SFaMV9o+EZUe26LWEmi2NHek2LxuR8LW0K6MkoQFlXmBvYHn",
-	"QREQ0pZl0i1lBoFOpMg2jTZm3d8L+ZkX/H97a3G6tTjdWpxuLU63Fqdbi9OtxenW4nRrcbq1ON1anG4t",
-	"TrcWp1uL09/V4vSxKrNNvIbma59ywSftYOrbWOq/VKH/IHu9AQytT5eUIQuMCqP026X2MPRpoAXigBXQ",
-	"nwdig87Pvjt+TpSoZAYkMxAyTsqCmksXrHVoeD6jCr567DOVrS5AV2S2MWzFKAzmhS8ekdMfj33t3qXr",
-	"JNR89+6xDTUlSm8KuOea2QHPrULuu9oBN0h3Te2oFz++MbprE88KzKFR5Dt8+xlcQCFKkLagKra07Fr0",
-	"zoAWTx1udhj0/tNM7kLt/zCj/TFuGDUd2la09Nciv1aqCLUJ2+RZlML9x5wWCv7oy+K2461oub0b5lvL",
-	"fUHpb0W+aZ0Qs2tHuIHNsxEa+80Yp3KTKEzXTZZqk4YWhl05wuoaMd8fNMltmex/1SWzXRSWupnYRgTp",
-	"0fuoPDVOvWGdoWye/7xFJ6NUinosSpe2DZoDcFAtUkyosntCXtvvPm7lUYTIHbGamX8ygcbNNwPTwHfN",
-	"rcixns81l8gjPnl68eyPDWHnVQaEaUUcxQ0QL0YjNCMtgE8cA5rMRL6ZNNjXqCGFcqaoUrCa7ZZEMf/E",
-	"ExeEj3myXU59HDHyLFrcNp4cE8164hhwD3feaBjMmwO2cETHniOM3zSL7mOjMQjE8aeUba3F+/ZlevU0",
-	"m1vGd8v4otPY0ggYd0182kxkeoOMT25kxft53ndryCoDXHyS76LfA72qsNYNJ3oOs2qxMLeFrpsVGxnh",
-	"eEzwj8QK7XKHcsH9KMgO/tqnwVy3xkV7uC53icpO3PXFYO/hdlC+QY/QqqR8Y3YD80gmiq2qwuLQtgI/",
-	"LKO1fQtSVe1r62SfBf+VN0pGxmgnapu/W7SQS6qI3V/IScVzl6zYKae/5sPLJNmhz9a8ZtNbSyLZ9SZW",
-	"5+YdIiL8LjeLUihSgpzoNbcHqnGY0DtGiT25H7V8/63Y+HBiw5a0gB4G2+0IUjOEA0kPGfE1FB9R16s6",
-	"p7bRC4s2M4Ebz9Ci0Z+FFrfwsW8eNDaoM3wzRKg2tzh/MxQloSQrGHqjBVdaVpl+wyk6pKKFTbvhQ96G",
-	"3c/7nvpX0u7ShDfTDfWGUwwiC26qJA+cQ8Jd8j2AZ7GqWixAGT4aE9Ac4A13bzFOKm5uYWJOViyTYmKz",
-	"4s35MrrL1L65ohsyx4JIgvwJUpCZkfrRrltbstKsKFy8kpmGiPkbTjUpgCpNfmaGA5vhfOGVEFII+lLI",
-	"84CF6XC3/gI4KKYmaWvND/Yp9hR3OPFWQbRw2sd1f532NajuqPB/7v77k9+OJ/9NJ38+mHz9r0dv3z1+",
-	"f+9+58dH77/55v82f/ri/Tf3/v1fUtvnYWd5L+QnzzAwEavCF0zFbTHbsH8KcQMrxidJojxbAnFxhW1a",
-	"JHex5KQjuHtN95RewhtupKUWBCUE1Qckn7YbqXOg7RFrUVlj41reJo+AQXfIg7AqkuBUt76bv1CqeEQH",
-	"3nOKG2/7grT2fk8/TUNuA3Z47ZPq9qnrgtnzkruFNCxtrXpa7o2zBshbnSCff2nbw19IPRoPdiXtDthl",
-	"V83mn4g3v+FjQgvBF7a2q7miCtwnxstKY5bATVoB4YIWE3EBUrIc1MCVMsG/u6DFy/DZ+/EI1pBNtKQZ",
-	"TKxZYijWzsw3lk7NOIwzzWgxwav5UIDgxH51aj/aIb/PQogaW60gZ1RDsSGlhAxyW/eQKVIbBaa2EAvJ",
-	"lpQvUNRLUS2W9jU7ziVICH1SzT28PcS+uoBe84mtmdkF/9i14o4LjgPNloleWCj7LmkABfJGm72B29Oo",
-	"iNxnBBiPehV5g++LOgzR4q3Jga6qdTT0hwhpNTSHqCt9e0huD8nf7ZCkKsQiPuctk4pFYryNN2x7u+ki",
-	"yR/QlPdRKqjfNij5qzco8WxJEUokbdxx0j0zqSJMk0ssrzYDYuRdhS4E14jUGQkw3TM66q5wsHJtS7Ml",
-	"ZdzV5grJKgiHuXKvVkxr38f7Rqyvlpmh2dWgA7JKMr3BWxEt2e/nYP7/1lwrFMgLf2GqZDF6MlpqXT45",
-	"OipERoulUPoI+4TUz1Tr4dsA/zt/1ykluzD3t/cItpBswbiR0Zd0sQBZ2zlHj6YPRu//XwAAAP//d/EP",
-	"a0PJAQA=",
+	"H4sIAAAAAAAC/+y9e5PbtrIg/lVQ2lvlx5U0tuPknngrdX+TOI/5xUlcnknO3j3OJhAJSbimAB4AnJFO",
+	"1t99C90ACJKgRGk0fiT6y2MRj0aj0Wj0849RJlelFEwYPXr2x6ikiq6YYQr+R2d8YjYls3/nTGeKl4ZL",
+	"MXo2Ohfk/MsLYj+Oia6yJaGa3LtfcWE+ezqmea6Y1g/ujYlUhApy/ssP7bbnv/xwaRQXi3tjYiTJWSZz",
+	"RsySkZlck2taVIxQPSVXS+Y+5u5XroliplKC5XYk93ECH6ej8YhbAP9ZMbUZjUeCrtjoWb2S8UhnS7ai",
+	"dkm4tJEGMEZv345HDvDkemmWyUoYUlazgmfkDduEyUpqltFcbpDxSLF/VlyxfPTMqIrFU5fUGKZs33+c",
+	"T/73o8nnv/7x6d/ejsYtmMaj9WQh3R6MZlTzTE/P3fhvd32lZVnwjNolTHieXlTdhPCcCcPnnKm+hTXH",
+	"27a+FRd8Va1Gzx6FJXFh2IKpnjWV5YXI2Xr0dudnqjUzveuxHwesxI9x1DXYQbeuotEgoyZblpILk1gJ",
+	"ga8EPyeXEHXftoi5VCtq2u0j8gPaezx+/Ojt/wik+Hj86SdpYqTFQioq8kkY96swLrl0B2mPhv5rGwFf",
+	"STHni0oxTW6WzCyZAtagmC6l0IzI2X+zzBCuyf9/+dOPlsv8wLSmC/aSZm8IE8ASpuRiToQ0pFTymucs",
+	"H5OczWlVGE2MhJ59/MLBFWOSCUsL/xj9t5ZiNB6t9KKk2ZvRr+MEHyn4iidW9QNdW4oiolrNmCJybhfk",
+	"wUGe1gcQjpjgXmmSREY8etv364quu+BdqUpk1LA8AtAoKjTNbAuAMue6LOgGULui6y8ejR3gmtCiICUT",
+	"ORcLYtZC9y3Fzn20hQi2TiDaXhr2CynpgkV4npKfNV4y8NXIN0wE6iCzDXwqFbvmstKhU886YOrt94mS",
+	"lUgxKgIfHJp7eBT2PSaDegUjvt3+TTOtey8MovmqKvC6cA13M9toxG2r6WJP80WP+HHJF1ebkpE5LwxT",
+	"mvx3pU04S5UGClwyokuWWchyED4sHWi+ENRUij17LR7a/5EJuTRU5FTl9pcV/vRDVRh+yRf2pwJ/eiEX",
+	"PLvkix5iCLCmWIaGbiv8x46X5hpmncT6CynfVGW8oCw+lpZsL573ESmO2Y/nNK8+DyIMkIob62p98Xz0",
+	"9pAeZh02sgfIXtyV1DZ8wzaKWWhpNod/1nOgcjpX/xqhpGN7m3I+Go+WsxR+7XF01wcIeOcoz53XQs0r",
+	"99l+zaQwDK/mSOw5A+b/7I9YklOyZMpwHJSW5aSQGS0m2lADI/2bYvPRs9H/OKsl7TPsrs+iyV/YXpfQ",
+	"yQoHillGPKFluccYL630DqJfD+OxfBE+kblU5GbJsyUxS64JF7iTcKAt5yvYNRVmOtqLs7yNz/c/HBD1",
+	"VuCljVvRYiy9e0Gw4YxpOABOCL+nG5IrYJwAxgkVOVkUchZ+uH9eljVy4ft5WSKqxoTPCeMgX7A110Y/",
+	"AMzQ+qTF81w8n5Jv47FveFEQKYoNmbH6UcLn7h5x94p7EFjEwhrqEe9pAjst1dTumkeD1swcgxhByl3K",
+	"wl7JO8nINv7OtY0p0P4+qPNHT30x2vvpzrYiDqlATfhL/XIm91tE1aUp6GGp6bzd9zCKsqNsoSV9USP4",
+	"2HQFv3DDVnonkUQQRYTmtocqRTdeopuAZNaloJ81Q+Ip6YILgHZsHwiCrOgb3A8JeLeEwHSQ/JHMYFBy",
+	"w82yFgED6qed987HTcipPSd2wykXmlBScG2sRASbqcmSFSAA06DoiKnoIKIZQAtbFhFgvlG0RDJ3X1CY",
+	"44LQ8B5EWG95kw+8ZJMw159jGgCoDmbmOxluEhINCpAmDF8WMnvzHdXLIxz+mR+reyxgGrJkNGeKLKle",
+	"Js5Ui7br0YbQt20INEtm0VTTsMQXcqGPsMRC7sPVyvIrWhR26i43a60WBh50kIuC2MaErbixD3Iu4AQs",
+	"+DUTyHqm5GtqVallSTJaFONaTyLLScGuWUGkIlwIpsbELKmpDz+M7F9LcI40s3zQMBKtxulYQAOr2Fwq",
+	"eDgrRlYULqeVfSOVRbNPYK6arlhLdoLLUlaGqcbz5eK5Xx27ZgJ4UhgawA9rBAVEPPiUnIdPMLOQuDiq",
+	"GCh+uMiKKq/xF/hFA2jbur5qRT2FVDkonqixv3FFMqlwCLz83eT2D0ZV3Rmp836p2MQNoeg1U5oWdnWt",
+	"RT0I5Hus07njZObU0OhkOipMP+uQc0A/EAqZSmhbfoI/aEHsZyvgWEqqqYeDnAIyTdgPuLMtqnAm20Az",
+	"Y/d3hXo8YpVre0H5VT15ms0MOnlfo+rQbaFbRNihqzXP9bG2CQbr26vmCUEdlGdHHTFlK9OJ5hqCgCtZ",
+	"EmQfLRCQU8BoiBC5Pvq19qVcp2D6Uq47V5pcs6PshFzjH4OY/Zdy/dxBJtVuzMPYQ5BuFyjoimm43Rpm",
+	"GTtLrTo/n0l1mDTRMZXUBgFC7aiRMDVuIQmaVuXEnc2Euh4btAYiQce0XQhoD5/CWAMLl4beARa0oRHw",
+	"t8BCc6BjY0GuSl6wI5D+MinEzahmnzwhl9+df/r4yW9PPv3MkmSp5ELRFZltDNPkvlP2EW02BXuQfDiB",
+	"dJEe/bOn3kDTHDc1jpaVytiKlt2h0PCDD2NsRmy7LtaaaIZVBwAHcURmrzZEO3mF/d6OR8/ZrFpcMmPs",
+	"I/ilkvOjc8PODCnooNHLUlnBQjeNZE5aOsttkzO2NoqeldCSiRxoHtbBNdWarWZHIaq+jc/rWXLiMJqz",
+	"nYdi322qp9nEW6U2qjqG5oMpJVXyCi6VNDKTxcTKeVwmdBcvXQviWvjtKtu/I7Tkhmpi5waDXCXyHhWF",
+	"tbQNvr9w6Ku1qHGz9QbD9SZW5+Ydsi9N5NevkJKpiVkLAtTZ0JzMlVwRSnLoCLLGt8yg/MVX7NLQVfnT",
+	"fH4cHamEgRIqHr5i2s5EsAXhgmiWSZHrndocb51sIdNNNQRnbWx5g5bph8qh6XIjMlAjHeMs92u/nOmR",
+	"6I3IIlWYhbFg+YKpnUg6ksqrD1MIxT2dgNRi6gV8BovAc1YY+o1UV7W4+62SVXl0dt6ec+hyqFuMsznk",
+	"tq/XKHOxKFhDUl9Y2KepNb6XBX0VlA64BoAeiPUFXyxN9L58qeQd3KHJWVKAwgdULhW2T1fF9KPMLfMx",
+	"lT6C6FkPVnNES7cxH6QzWRlCiZA5g82vdFoo7fEisgc1q5RiwsRyLugzuCYzZqkro5VdrTUwy9T9Unec",
+	"0AxP6ARQo9MT1q4j2AqnW9JrRmihGM2t8ogJImd20bXXBSySalJSZbxY50Tiofy2AWypZMa0thYsVBvv",
+	"hNe3w/vHbEEerAZWEWYhWpI5VXezgjfXO4F/wzboAanJ/e9/0Q8+lEUYaWixYwugTWoj2uq77lJuAdM2",
+	"Im5DFJMyagvxJBAj4WVQMMP6kH177PVufxvMDhHcEQKvmQK3mjs9Wn6SOyDKAP8dH6w7WUJVTqwY2Kt+",
+	"sJKr3W9BhfSy4Y4ZwgQF1Way60qxjeJFa7vUiIunbhEYuEeefEG1ATGQcJGD/havQpgH+sAUoz2d3GDK",
+	"3teYnfQX/xDrTptJoZnQlQ6vMl2VpVSG5anlgc26d64f2TrMJefR2OHpZySpNNs1ch8Co/EdHnEliDtq",
+	"goXa2by7iwOvAyu+bPbFcgO+GkfbYLz0rSLEx06+PTByXe8BkhvXLXqbSVkwCipTbWRZWg5lJpUI/fow",
+	"eImtz83PddsuSaIZCOYkuWQaTEyuvYP8BpGuwda1pJo4OLx/Aii80E+uC7M91hPNRcYm284LPIJtq/jg",
+	"HHTcq3KhaM4mOSvoJuFtgZ8Jft6TMPzYQCC1/kAaNpmBNTFNI/WZ8P6vh80qYaoEd/9REvhCMnvO7TOq",
+	"JjXX+/BJcwbTpvimI9Z7YRYAI0kHfjxAFtJTYkS4+6+lsWSFjXA17la65Vp6sBdmvRMEwriTWhHQnv2/",
+	"mHZz+zbHnX/DdN/C66mPtewe9T/c7Y0Ls3WVtW6b5BXRy5d3MMY+HtRji3hJleEZL+G5+j3bHP313p4g",
+	"6StBcmYot3rl6AO+5Mu4P0Ff5PaYh73mB6lbu+B39K2J5XjPrCbwb9gG1CYvMcIi0lYdQx2RGJVwDaZI",
+	"C6h3nbcvnrgJW9PMFBtCQeDYkBumGNHVDL1WuiY0I8tJPEA6hqt/RmeQT5rDt3oIXMJQ0fJSnof42toO",
+	"31XrydVAh3tllVIWCf1n+8R3kJGEYJC7ECml3XVOi2JDTAjj8ZTUANJdEMXGg+uupRjNsALyX7IiGRXw",
+	"wq0MC0KaVCD52L4wA9fRnM5VtcYQK9iK4Wsevjx82F74w4duz7kmc3aDLjcCGrbR8fAhqOJeSm0ah+sI",
+	"2m573C4Slw7YKu0l615tbZ6y28nNjTxkJ1+2BveTwpmCMBq//FszgNbJXA9Ze0wjwxz8zHrgyq+aLmGd",
+	"dcO+X2L40TEMleyaFhN5zZTiOdvJyS9D3NPX17T4KXR7Ox6xNcssjWZskkHU4sCx2JXtg4GOdhwuuOE+",
+	"cGQoQOwCe11ipx0v7dpvma9WLOfUsGJDSsUylqPhhOsoxGtKYFiSLalYwAtIyWrhXJ1xHGD4lUZNmLVa",
+	"tofYVxQzazEBE4ZOhs2B2dJHf1ohjFH7sm3bP/CxdkMDKCxvXBkDt6dtD0qaTMej3oe/xfd1/fBHvDVD",
+	"WA81JjbkwwhpNTQDrWeATysrdZEYb2N9+OwLHiP67tbEaPcgKIA8O8CJMSsAhnC2oY62PPhyYi/Q3Npj",
+	"X8UfcXw6N0wRbvam123hkhbIOjqyvYakMd/bd9ODoUkqNgITsx1T48hCTECsh6+slNlyOlBPkLTNjpth",
+	"nTXgg3j9kjljJlBeN6gU6c22uBurYD10CrzuxFEQQv2xLw7B6reKzRGEchyIKFYqpi38DbWzxq9yTn7g",
+	"mZLnxUIGGUtvtGGrrrEQu/7Wc+peHaJxkaLggk1WUrCECukn+PoDfBys5kaxr2dEEMD3GrD90G4gobWA",
+	"5uRDaPm2mwQk075r2pZ1/Y1Ux/LqwAEHv2EHeErsdCNyUx7qz2Fd7LsuEKju6vL/cQhC4IpQrWXGgd9f",
+	"5HqMp9V5TWAYRQv9L0Mo3hEOcHvclq0/CvtDwxErSkJJVnAwK0mhjaoy81pQ0CxHS004p3plVL8Z4ivf",
+	"JG33SJgl3FCvBQXH5KBvTt5dc5bQe37DmLdG6GqxYNq0HvRzxl4L14oLUgluYK6VPS4TPC8lU+AhOsWW",
+	"Nv5kbmnCSPIvpiSZVab5xF1V2hBtrFEDHQ/sNETOXwtqSMGoNuQHbt3g7HDeb8kfWcHMjVRvAhamwxnX",
+	"ggmmuZ6kPWu/xa8QxORwsnQBTfZv19l72Ne5UUZ27Y2kLf/n/n8+s8la6ORfjyaf//vZr388ffvgYefH",
+	"J2+/+OL/Nn/65O0XD/7z31Lb52HneS/kF8+dTujiOTz8o7ikNuwfggFwxcUkSZSxA1uLFsl9yBfjCO5B",
+	"U89sluy1sC6LRtpUVDyn5ojk076mOgcaj1iLyhob11IbewTs+fy+BasiCU7V4q93Is+1J9jq4BVveSum",
+	"xXFGfXQA3cApuNpzpty473379RU5c4Sg7wGxuKGjVBaJFzN+aHqV2V2KAwlfi9fiOZuD/kGKZ69FTg09",
+	"w9N0VmmmvqQFFRmbLiR55oNwn1NDX4vONdSbQC0Koo8yqKU4BV2l1/L69T+sXvf16187fi9d2cpNFXNR",
+	"d866alk/5cTKDbIyE5fEaKLYDVUp25vPK4Mbhb23woEyiaxQaerGJ2786VAoy1K3k4t0UVSWhUVRRKra",
+	"5cew20q0kSFQkesQ621p4EfpnJgUvfEqlkozTX5f0fIfXJhfyeR19ejRJ4w0Umr87nigpdtNyQYrWnqT",
+	"n7T1K7BwlMshiGFS0kXKRvf69T8MoyVQCAgcK3hfFgWBbjFOQuQJDFUvwONjny1ByPaOI4flXmIvn9Yu",
+	"vSj4BJvajNW/1Q5GWRgO3sAdmRxoZZYTyxGSq9L2GPi9cnyD0AXlQnuPFc0X8ADQS1nZJVtVJMveuMxu",
+	"bFWazbjRXc4bd7FnOFyDjtIFo865xV9GhR2wKnOvDaJi086rpDH4BgZ9xd6wzZXE7tOB2fGibIxRSh/d",
+	"d3SBdqO71pJvfJDdGO3Nd35+PibZpb+BOF9PFs8CXfg+/UcbBYAjHOsUUTTyyvQhgqoEIqBDHwoOWKgd",
+	"71akn1oeFxkThl+zCSv4gs+KBJv+e9eO5mG1VKlYxvi11/aFAbU1rXGjyQyvY/diUlQsGKHgOFNKTQvQ",
+	"D06TjiUgHS4ZVWbGqNlqHxBxWhMPne1PbuzJQqUJpHdla7vf3IASRLAblru3N7ZxjuvTg9z3cE0sPxBU",
+	"370Oyp8e8ohwCE/kc/T3fdiT8F5w/pAxdV4tw/eVxeFCyRu7mxZA6VOXQkKh6J6qNF2woddRwzQ5MAVL",
+	"w+IIg+ySfpLyjvVXaIo1HRlj4CKw+8TiJckdmP1i2QOYnVoutX5uNFk7K9ZPNvWAQ+qsAIE6OCQj6VDV",
+	"sOuKxX7AptkYU6IWVj1gTazFR39JtT/6+Tji6AdKi+8nddG2pI0XkbcnNd2UjP6abrP2MepzZoxIYXv4",
+	"1I0+X6NP0jga75VwcTxCzpTcOylAis5ZwRaIE2zs6azOB1bvpoXjp/kcmN4k5TgaKSMjycTNwexD7CEh",
+	"qDEng0dInYIIbPDkgIHJjzI+7GKxD5DC5TOjfmy4u6L/s7Q9C6M/rJQsS3vr8x4raeZZikunUos8LZd6",
+	"GIZwMSaWk17TggnjA53rQTq5AeHt08oE6HyJHvS9iQYeNLdGkE72WiX0OGh9seDtl5F+Fey1hplcTzAS",
+	"P/m0mq1n9kwk42Nsr+ThxUyN9zRkdbc+bHDDYUDF3tD1Q+YBq0GCzHsWP9CvT2xE8PYDZLsgn6JmTe4H",
+	"sbomuz5J9jBgesTpPrK7H6VsPBJILQVmnQbfaXR26lma0lZXEqmv23FthfZhkSlW03c4kzvZg9Gu8rSZ",
+	"W/G7Or1mfzI+1+jdJJXsKuVukwcUOwMgeq80oG1yaACxBasv20JsEq2NVi28RlhLsSTCRcLY1UWbZgUD",
+	"TcCkIVdP3rBNWqHBQGa49N0iPSfsHhWbB5H3pWILrg2rjQveqerd235AnWgfW3LevzpTqrld3yspg6AB",
+	"HQl0bCzzna8AQiXmXFk/eWuZSS7BNvpGgybtG9s0LQg3NptwjaaeveVggMgGD+a8qNKk7ED6/rmF6Mdw",
+	"c+lqBhclF+jdNoNSEEmH8D1skwAPBhJsRdALRNAL+i7wM+xg2aYWJmUprzn9R3LEWrxwG2dJ0HKKmLob",
+	"2ovSLbw2yt3QZbSREB25XUy32Xw65zL3Y+/0xvIZJPqECBwpuZYoA2fak1AuFjYEDxNruSBkKkIKRkIL",
+	"KRZ17kr7+5Z0lVNbC0C7pI9b8kW6cAjWFwzRKKcDVWGS0EfNEPI6mhNyXcIkCyYwU9Bo/3o7hVzsCMSA",
+	"FpFm9N3y9k6YRtJV/arlnl77kOMehs2G7SkYzd2zSjO/vu2HtrtdDnXjPif3Rkri7QcMBgSK40ZHAkyH",
+	"aHo4Ny1Lnq9bhj8cdXoASQwU97qVB1o4A7bkBtuBn6Yj+45aVfc0ce7yzthxBs/8M/vIRP955wFuzwbN",
+	"XHaLvFJgTWp4p3frN4SH5sC1f//LpZGKLpizCE4QpFsNAcvZBw1RCQRNDEeH/JzP5yy2hOlDrDgN4Dr2",
+	"jnwAYfeQYNdcFt6WW+mzS2Q7aKtewW6EpukpQSl9PhdXXXukaxvr1sJlE23cAUbFZAKL79lm8guUyisp",
+	"V7r2TXUGwua1vgdNXK++ZxsYeafLpwVsx66AKu4VAwpNWVfCJx1lpb+nY4zhG7ixhXvs1Hl6l460Na50",
+	"S//RqG+oeEWtpdzdsaldZCykQ/bqMu11Ys8Wa25Lm9B3bVFf9ETUKX6CxFNx8N445JILmV12epcxWnjC",
+	"h8WO3o5Ht/P3SN2TbsQdO/EyXM3JXQBvTLT/N5y+9twQWtrKGbSYOD+ZPqFDyWsndEBz71bzjt9X6VNx",
+	"9fX5i5cOfOt4UDCqJkHV0bsqaFd+NKvCki/bryFM/+90u6gKizY/pGiPPWluINV/S5vWqa1U+03V43nP",
+	"mnnaU3wn33QuXrjELa5erAyeXrVFGjq3nLvoNeWFN/x6aIdq2XG5w6p5JflEPMCtncQi779bj9UbJ2A1",
+	"Lh6ztT0FHaVCCYaEL50+0NO5w2vSZ7Wm9R0cEtb5E2TOTb+7hMurC4zROZzRo8uB30jVuKhcFG3SYe3u",
+	"BET7mEA8po3yV84K3xELpwRFyN8XvxOuycOH8cF/+HBMfi/chwhA+H3mfod31MOHXaDx7k2zLNDkCbpi",
+	"D0JcRO9GvFs1hGA3w8SF8+tVkJFlPxkGCkXPM4/uG4e9G8UdPnP3i7W025+mQ1QV8aYjumNghpygy76o",
+	"xOD8vMJytppI0WIWGJVtSQuuHlcxBu3s3SMkqhXYnSe64Fna6UfMtGVJAl16bWMCjQfbkO0cFe/xKxcV",
+	"j0a3zfRBJs/WQqJZkwjXyczTNX5n0rGASvB/Vo1YYnsTty5n/xSCUTsCdlq/6AZuV80eHVLw+vYmQq9V",
+	"26Yw2mpyfR7MgB4Rqbpme8Y7xDN2mP+WWAVHUf76hMC2pXMd3klZW99524ugOzOwZ5/O4tr/QHI1WHEz",
+	"nw/Zaa4ncyX/xdKyAxgJE6liHCDwYIPeKR/VNiMLngN1wfZ69l0EMly30Ecqt9Yl+EWHKo2HXOFpPrHf",
+	"Ru+pNIj2u19toNPp7Mej+JCn4caPpBlI08PM4MBGbuFQO8q7u1GBJxTzqDQiz9LnPGqhz3D8+pw7mNu7",
+	"nhX0ZkazN+n3ooUp2v6GY56RxHf2G6RDKhCcnUSxDKEtx+SSJVO19aibmvvAtx9OO/jVVz/ybMfG826M",
+	"viqFlolhKnFDhWHelwU5oOutGfph2F43UkFCWZ32IcxZxldJZfjr1//Is67nV84XHEvqV5q5zB7oFQkD",
+	"EcxaC1TkqtmH3DcONRdz8mhcn1m/Gzm/5tq69EOLx9hiRjVc0MEnInSxy2PCLDU0fzKg+bISuWK5WWpE",
+	"rJYkvM9B9AyesDNmbhgT5BG0e/w5uQ8Ow5pfswfpC8YJa6Nnjz8fb6scDxif06ow25h8DlzeBzKkKRu8",
+	"qnEMy1bdqOnIhLli7F+s/z7Zcr6w65DTBS3dFbT7dK2ooBYhKZhWO2DCvrC/4MrRwouARjnTRslNM+tM",
+	"ND8z1HKsnmhyyxARDJLJ1YqblfMU1XJlKayufY+T+uEwdw7SR4DLfwQX7DLxxn8Pzy26StMDBa/6H8He",
+	"HqN1TChmCC54HX/hKyKTC58JHeoQhvKDiBs7l106yKt2C6HkFRcGtEaVmU/+Zp/vimaWIU77wJ3MPnua",
+	"qOfXLHkl9gP8neNdMc3UdRr1qofsvZTj+togejFZccv8H9QpHaJT2esrnpzW9Lkd9wx9a+najjvpJcCq",
+	"QYA04ua3IkWxZcBbEmdYz14UuvfK3jmtVipNMLSyO/TzqxdOEllJlaqsUjMAJ5UoZhRn1yzv3SQ75i33",
+	"QhWDduE20L9f7zYvlkaimz/dycdCZFVOvNNCWiUr6f/yQ12PAYzbGLfb0l5KldDTOo3jO3ZL3U9f2Lah",
+	"ozsgfOvB3GC0wShdrPSEe8DPdZ/34e/VBgn3vKEqffw7UfYdD7L+w4cAtNWYYtPfnzQ/I3t/+HC4y2xa",
+	"X2h/TaDmsLumtePQN7XVtjDusz96qsYGvzGXqqS7zTkDplLrr9upURg+yOaEzjggApJnQpYQFBit4h3x",
+	"6sbCnM8ujg8Sa9mCnFNyVZUFw5ra4BniVSJchUzAY1Tgus9U4aso4n12Pe4t6YbCpyJXrpgqyK9Tci42",
+	"MCtCNiZsNWO5g43raWtjbcaW6St684MrsBp9neg3vJxIV8d6Ai9EpkbPjKpYryzq0WK/jltLePdi23HC",
+	"Pff24k4TVINi2rh5z9cTbGYdQNTPXpvFnpOnLw/foxAUSr6U6+4ZTBNR69b39PQBoKgHJQOVqrCSTjHr",
+	"pKPJTi+piGztqDNm3bV1o17dYKefj2gXLGrGW/ai4kX+S23Eb13siopsmfTJn9mOv+ErKmoQKYCsqVqw",
+	"ItkblQ2/eaVEQm3y37Jn2BUX6U+thTvYW5DWYDWB8FP68S2uuCnsBDGKmvnMQoaYYiFzAvPUhYZq1jgd",
+	"JRDfLcvcoSccdlUZ59QNuSdc/Z85L+xfPe4E0HKiqOnhqsplzQ0jsmtmzZVwSePoTBHKVyD1aGpr08Eh",
+	"vGaKLqCrFKzVHRLewchRFSGiS/sJWkLuHElMpYStPBstgwnDFSs2Y1JSrXGQR3ZZbA1zj549fvTo0TAb",
+	"LeBrwNoRr37hP9WLe3wGTfCLK9SH9U32Av8Q6N/WVLfP5neJy1VL/mfFtEmxWPiA8ey2M9zrWCk5VPWe",
+	"km8hvZsl9EZFDwtNnR27kVK1KgtJ8zHkcLcuZgRnxT6KAeqgUvPCwt86Ikkb2fAUsz59XU/qr+HjbM88",
+	"hGmyJ1tybL+AFnXpZ95yHgPVaoydKXmOWu3gF4WTEKgEoFYsj7J1oxYFiMP+YQzNlraBnI62auR7incN",
+	"rzjuOWBtbYvChq/9RxSsjfRFx7Hm+JhIq+K/4TYJ9pIads2a+S49GN6e4fNfNlerKiGQcKZ7SK+hmt2+",
+	"u+CBg3GDe0oSstY+3Np0WidCkZXK2L612S+hVzrsqVXoveU2ghVu1r5GzpT84GxFGRVS8Axqw6REcPsu",
+	"GmiVHlBGJ20u1iN3lhPHMFlePsT3Oyz2FpwfjxqI6/qERF/tfiPh4H8NVBCwj9gFM9rxQJaPQb/HC+bs",
+	"m1xo5uoVWvqKOapUCc+5ZFRR8MA5okf/eATJ6HpU1d/Ybz8604Y9u+QNxwIBDqnuJYj2yUJzcFMQhBuy",
+	"kEy71TbD6vQ/bJ/p1VoACL9OX8gFzy75AsZAT06LFHSi7g517l2qnQuzbfuVbetKjYSfGx6JOKlf969J",
+	"FqLD/nc+2fIYfehPuc55P6QIuWH8eLQtxLg1UgLuZUuGthgF0YaVcJ93yIYplXp4fo0lLCy9QQuCgc8p",
+	"pBRcJMB4wYW3l6fTiGXJuwQ2Bk5zTz+dKWqyZYNJ7fKX7okmgpwE2ZtjDNXaYEAJrNHP0b+NV2vhqr70",
+	"sJXQoH5dULEh/lBY6o6EEhulHHzTQZhqqvWtdOaEMfS1xkBlJ96l2Ypl6xMf2dxA18442tAdihfte0/1",
+	"JWudVfmCGZv2M5W270v4SuCrj8e0BZSqULMvhOk2s913qc1NlEmhq9WWuXyDW06Xc021ZqtZkfBcfh4+",
+	"sjzssKU0ayKz/6YK1vXvjIsZ2Dt43gcI5PuVeOgmA0hJz5amJ5ovJsMxAXfK7dFRT30Yodf9j0rpPm7+",
+	"gwiLb3G5eI9S/O1re3HEWc47IRJ4tYQk5BCO4BXk+PwMiXBbtgaKRNuZ021eYstawPuGScCvadGTsCI2",
+	"euH96g0W6bQVWW9WFmpc8j9DSc0Thqgw+tOnoQN7y7DWtQ73uaijh/pd2p4cPrYivd9Q+33DLItOgzVD",
+	"6TXHHmYxrYlgX5Opq2TR1ZfSopDZYM7ghjm3nfozHcvVyhUOSDg1Xq9kHp+F2BmOsTRj43nyZ/ewTX6D",
+	"p1Xyi7pJj9bQjwSiGZr0DdDoljDGuFYPngcGp27XDHPKM4dZ8g0vmDcqjvo3MtqB7pa6zONJFXbfxoRA",
+	"vzZ5LGQDH1t4gBRFWv+te1TqkForfRpcMfHkh2+0GQoSppnap/WLoYN3CGAhsahWquxIN7nPqN4Oj/yI",
+	"GurtRY4SU0eKKtrFqhJvH2gRsSanLumM1qMAachIQ2pjpcowuZeC18DiRePS+WFtqk5Zqw4DfT5EOOzg",
+	"4+14dJHvJT6lSnmNcJQUg33BF0vzpdV4f8dozhSWY0k9J7EYy8ra7JVe8hLeP6XUvC7fXdjBXB70JQw3",
+	"HRrZBLUX7aeQY6Ezlvc/v2aZgXLutRetYmy4m0iZXqKFwBsUocl78KRRjOWsNMutwhL6xpdmWVf5ZS5w",
+	"z1pcmTNdXDMxJnzKpu1Yv7zOqUUKRudeCaukNAPKYIeoL0BjDHSKvjol1beLgZ2UeVFGSKx8PR1ew+Y8",
+	"hFRgnCq4yPjEW60sFIOj3edzlkG9gK3ZC/++ZCJKZzf2qjuAZR4lM+Qh2hIqXhxVo13DWtADQS3oO4G0",
+	"L5/IG7a5p0mDhpIFvEOA8iEJ9AE5aMf1NRn6TBvOr5TrQE+AIB9GgN1ZXaLqkBoKUXLPA8HwNE5onPDz",
+	"MGi8RHMAGLbrnpP2ZhMEwbQvOeJLzDscXeX9L+XnzFBeaOeTS0O2/lifZFXj7erpNy7bP+SpDNZCn/ef",
+	"af+bz2+LsxT8DYurFoNt1qZE9i2OkmUQmhGeBnoeZuZ1XFnXy2dfvxwM8MwKaQWgSV9cbTPQK3hA39Po",
+	"ql7nfAOo50wplgebYCE1mxjpPQv3yJ2KwG3DngYn/YPw1gqI2CPiGlfUW4LiVV2HA6ppUig5QZ3vfowV",
+	"otiKWuhVVBsjrQbdtUNf4XefksVXR9yuXu3DezgXuwva+8hFrjuYj0/XnDjhYG/u1cjjcoBmlgvB1MQb",
+	"cduVMUQzyyikpc6rDEWV+GwG7fXgrG1buFlSqZl1V9l6QkVJTd6wzRmqfVx6k7DjMdAoQyLoUT7uFlEc",
+	"VVetU3AvjgLe+81+WkpZTHosgxfdch7tw/CGW28uYi8rH9hjpeB7zWNjJyH3wSAVfEZulhtfrKIsmWD5",
+	"gykh5wKDK737SLOAa2tycc9sm38Ns+YVFuhxGujpa5GOUoNCOeqW3M8Ps4Xn9fEmzUR+6/lxkANmN2vR",
+	"5yN3AxV1mmWWp0PVG13/jpYIFZEfQpESoC7REPwVsITEO4pAcpsoCxP4B1DiDMhEFzIRxHBQAh47VBpT",
+	"8WQAkGFiwHO1hsINnkSAc7Jz3Oqna6YUzxOo8F8wrbr2HtMh16VLvD0gcW3fo3VLOlIjiXTzH5hZqjdN",
+	"bacAT7gu0C+VmTFYk8SiAREX9ooWjDkfpUFXQkB2WWLqL4/tlM07rkLRl5yijiU3kihWFjTDUndGOsnN",
+	"C3lxhSRpQvGe/UGPspZsA7+3Et0FOLVec/BeciC3i4y4zuMmS7oDQ5Kjka0Ho71XXV8ZZjTxdRB6srOR",
+	"Voq17jkh3wPF2WuLKgabtGLCfmI5ecNY6cKKvMNgXZgo4cGV74pUOCgLaV8G39iahkfmThL1upWNezP2",
+	"bqXRLQytzqvji98M4GI9r4ofP4I8SgdmTPI5NA5OkVRnRnLY27aJX8p1/969ivmGiyXEKwkiYjr7N0Zu",
+	"CBCbJuM+5PT0x/lMjxbosy1mL6Wf77dP7xPwZhVAElONuCwwcu1L/5lBE/cd2t7oIL/hO9Lqu88+cbyc",
+	"E8Vq79BDM+i7pPT4jNR9xpn2zGGW5ttsLhWLZ4RIF6y0EVITWM5G4I8ZN4qqzSF57puoSvHNXizvjNcI",
+	"oRr1QupwjS4Oi0LeTOBhNQnlMVPSim2nm4oDX2i+7keMhJRLIfCDaie/bMiS5iSTSrEs7pHO0YNQraRi",
+	"E1tRJZmB7wWfG00KvuJGExD+FkSW9hRgJds0BfXNVQlL3/kk0GQvCpB27Epdn4iOB05p3//oIDYBjdFi",
+	"qPB2Zftg/rE6fzEueoJOij2cj2mXr9hhCBt34QXCwZSabbNwWkk352ugG6Z0UlQ0yjIp1wJGb5BQEJdW",
+	"XGsEJdDSDS8KSP/F1zU/YMEjOY3aUpaAqW0bGcDChJftTQzMYbbpQQWCrassYyx3AejaP4XxuQ3t7mmi",
+	"fKCqi0Efh3yairmXoBuTaxf8ZLn4K+TAmvRQZ3rxParLhsTezIMHPUipWMZC8sCYAV7G+YSJWSpZLZZR",
+	"dauwSd5yoipnV4lH+VlXEBICCU7sFE/JSmrjrBI4Ur3fdQTO/UwKo2RRNO2oqGZdOJ+7H+j6PMvMCynf",
+	"2Hx2D/4ntHGkBXK3tyUtuTZSbdrDwhq/w2+gg9XjYGO5YewNXFUIohSEqmxpi6W6WepsYw8gOQokqPP8",
+	"L6R8loW9Q9wgpZKOwDS3hAHcURsKcR8WYJRy4CoX0oQdy8d+qnYIWI0x1cqEPrCQMuhg/QN98FOy8azS",
+	"PvwBqFnvrpqE7Yip0bX3W9ZdeR1HmF0PkQjMX3dftbv9bM5TLKK5ruatm9bNnwtCjVzxLM18P65orN4Y",
+	"qh7q6XOfwqNrJCmxNqEgRpahKGR9cSE7cjKcvyUcR1O4kVMSpkNWRMHLoM30tkaZ9j1BoxRlTvlhuUhT",
+	"xdMuuB8XUtpfj9PS9/VEXUQForaBHkEVJ1/Xd6IZ6ymv3IDIPlv8u3BvIOKn517CdSxfpY4n9nCJTqEZ",
+	"SCqxpB1CPkC+6xITE5ZRJ0Yn7iJ3ru8gKzm9Je+MS+aMms7ckZSfkIwwYn/AzAAiJtmz8oj9y4mNmdTG",
+	"h/4HHa+vyeBPXfLxRi4MySVD5aXjE9C7vTB8QSCW8vRKnDp9kvUq/XcvqKGSD/KNXKCGBEIQ2pANFO4h",
+	"0ut2sNkRjg6UYbcCqhN7GgC8jxxjjPwMBVw4vvj9QV1f4iDgd5zXxtXcF0J3Gd0T0CRkfe65b9PV+rbG",
+	"m11BxsjZ0Kgz7X1CBz60IgD649AaMAyKRtsXjDm1wcoTanqeGeDxMI6Ms07TFo3O3QsBZiEZxaeDdS6k",
+	"vKgUc1mIUdOims6jJTVLL/za5l3/J6uUc5m8/sWUBP1YPo6cF11qsLb9WJaTgl2zRnge0jK887Tm18z3",
+	"1aEzyRkrwb+37VaRijuL8Ni+E93aJ1Hk0hDsJo3viFjcKbLDsp5SPIY39E6gomxu3Yc3OGKqioUXL8Ll",
+	"ns12U2aVIdzoxBs8k1WRw10xq9/WaBgLAwW24r9H/efgwwZefpEyyr7GpuTrdVmAV/zNcjPdtv68x4fm",
+	"VqtGpWJ3u8JqusjgJlJYAmNxJ8ALpmPLzhhfgOkq/p/WzOVVAZnLHnyL73D4p+Tc/xlnb4ceqDH2xYtB",
+	"x2FJxk7vTJJexxGGIxkVQqLEazUX9RqmzewwOBfcDzlZ0mvmU+7V2h/FVvLap0Wo8XjxHBUlVvqrTMt0",
+	"u/1hZK2VNN8EHeVCYPc7eg/haxivGz30SrIn+5rnFW3wIb2vANz0wLJXYgK8jlJp4qls6DQ/4wiv/ADn",
+	"vn/qwe0x8euw+3zvqzyNum0X+c547kr33Z4iHc4d588P7rUwWx6iAfCqqM+MLumN6PcF614doD2OpPkB",
+	"O8WliFD79ZplL13/hjb64NHg3eTUwSx3CuEeBxHvEWJZAFrxUFeyEAmfSsvAhaz5AjiWeWVmXZrI/4AT",
+	"QyMunLHhAH+TOor79pRCYDCiWxVDkjtbH5PbeVq+l5O99WD3jpeiEc1cQrUt5kF/WiJ9/I2TGdQKNF5w",
+	"rTjp0gkyKHHgQFavDh40jVfqc+a96pH6vKMvrsiX2gCVEqJ73H9nhzwdNvZEKvhHSEP+WdGCzzfAtxB8",
+	"343oJbUk5Nz4MZbFRb/bibc/e8YeMG+Mkn4qXDcfOmY03MaOEgFtBWxnEYXaE29YvA0QpoP8ODOWEetq",
+	"BoYdK0q3trOLBbd4n2N8RfPYFgDVkjYN7iAjMeR/1snD4ql8ERPwNsj95mm6anmMwiMlEJdZstU+asCr",
+	"iAR8q4hogw0oP8CivCfrSukA+1znGmD36CWPtYyBhvFWoe4tafoGLeXYu3CcTFr7ego2FtfyGnwHu5Ms",
+	"c9a3jCHgf0C70nCdGqinjtcDTd7FLjTSISdgRVeAmVxPFJvrXeFM0NoCXwOsgwmXC/sK1KievfjJqZPq",
+	"Kl5c2Fcoxk4H5/YwSs7mXNSslouyMonnLthCxCZCWOxRAWjt8ZDukzGsKHpNiy0WpStwg4dogFalae9F",
+	"4vom9dduD7sDcF1rZiCrXe2jEDez13/O53OmMIJZGypyqvK4ORckY8pQboMYNvpwd53axWGHww6NZKFm",
+	"ztbIdQdIGwEpNtHL+RbONAFAekSvmgHeMFdL5qi/6QkT9Co9Hh8dGD4Kb5gVXVsHKsi91nMgXLE2cJ+C",
+	"ZkQKMB2jdDds3X4ezf/Ftk8D5RgcIzISZh02xZEde5xSd14V3hEh4cXDVcd/Z08LBZfiJ6AzeCH/LLjZ",
+	"ypbQLNLO1IfB8Mg1Ist2yOCBlNxlFmWWnqxsJlj0crR3TPcHg0UUloya75jiekgMQnBcZs7Y7raHYbcR",
+	"5ZO4/pwSZQLKFb0lR0dttgRca6d16wRFtrUyiJSxS4C5p3IfTYL+0uwBD+MBHCNqThtiuOw4+7jmb095",
+	"OSllOcmGhD8772EEwEPahLGHPiK7Y8+6Q2iWDu5SMTU2gzH29EroL9W/y32lzLbpM7gUL7962WdQhzsm",
+	"cOZAcG4vqQEO2zqE3dObSd2zL1ibLjbJwwxSN6ZNb8iSm53SIWTLikG2+rQdjGBPXtNPM619ACw4sMcD",
+	"dgWn27o1CV8GX6vAQ9u8V2+5Lyx9Tcebkiy7efnd+aePn/z25NPPiG1Acr5g9ZgO1Hef6afM9KCtjp9F",
+	"gXggIsw9MEDLplympX3MG43Tl2Ju1UzJynDR+wioG0RAWumnB0Isvtc5sHsCfRmm7QW+h/qx+IjF/Hby",
+	"v2SgbDvPr6nIBoQKQIYKF1YNeV9AAqexU5HGIbvnAL1Zd/ESbIUzXWNMIDXo/WvrXewVBZ3JARO6ZnZG",
+	"a0DEGBi7tKg8wlwqGzE9Jrq0JkF490FDwW4cxFPytX0IwH9QGvaDdYYB1aRb05NPPQA7V5aOaHRYHbTN",
+	"Q/YX/Ir32dFtmS8wjly7Ig4QRWyF0jjoiEgFfhfj2D9Z+eIKkH6J/BR7Q1vcgjOmdyJ3xmVw9Lbe0Lrr",
+	"v9312H6A51gb+0rK7H5q1OKSq+ZYTowydWHcumYZ1cSGgRGqGysFEYeJ9+VVvf110eUpyf1zJn4Jt1zN",
+	"/tBCFV0lrauu/9mYkhaicSEZCvDTntwuhwg3AI7PWxSiFRq8nG38tOQVyyoFXju4eKqY4905kfZBZ7ec",
+	"XTO1gcS7rt1RpBt0NHJrkPMWnEOkHkD82PP/nWJPj3F3qPizzZUTDkbTKcoZtvwOxIMkXlJcEEpU2Iwb",
+	"uklG8kGthokDYH/zdkvwg/RBjKo6JdmxhoUV+oF6nPhBzSvnZNcG1d5tKVxa9WgCnz55zAECSL8PQH8e",
+	"81uj7O0+lHvl3RBSWrum86icw4G1mHPuGECpwdzeodOm40XQtRxAnYfI636Q+o3h9tGC0PIMeLcCfWd5",
+	"Jr0JbmMd4rwPvE8wGzbF8RdUWkUpFRqrP4B423q0BNWmjvwhewXj1AkIP6ztSi3y6DuWQsHd75mNyZu5",
+	"Wiw9mt2E62tqtyLnV8tGS6Y014YJ0/Jd56ZOf6aX4EAC5c2vmQpyQnQxErbmpif4MrWQvuxZwM/sJ+Jc",
+	"awlDp1TLq9BHd9u6nC0OfTjAMOAl6OC6asXoFEQd91TnGgMSW5QQKzBbADPpJos3YQ/pDboGgTC6l2CC",
+	"09/5XRg82PpvwkM4Se389cHwj0SJkqNxjbDcu+AVSUFiS/71807ESijPMQi0bimKBHkAAD2ZxxvpoaN0",
+	"tlERdYV+ZPCaWYVi4U3x44fa+35nDkiAxHfYAV6cNbxuF9IWOnDecwntuoJ6tJRf+yihsfxdicg96w0X",
+	"SbRFzhxoDNPIlmRXLIxSz+uvQkb3HuNOJ/G7ktJAPHlRJBLGo60ezlRMOFAr/poW755rfMOVNueAD5a/",
+	"6lcUxQnCYyQjKvXRS1++oIPAKui7hcq+gq6Z+DuzO5u8Hd0szlm8cweC2Z8WmNUkPM5tqd0bGBPjRR5/",
+	"RmYcEyeVimVct53Qb7xIEzJbM2W9LmEKW5KylWX71lmufpHmFsdh7mOxyI+RI2XwDncw10f9PTOnHg6Q",
+	"PC0pUu0QSgJ/KV5nSxD210dqXDtvGundugnuiDZSsSMXTYpKJO5ZNCleGZSwHLw8WAdcXpVm3XUOvvUb",
+	"uE1c+PXahlYF6yK3v3SXmQ0p3YU/pLpDNTFEiG00JQAq+f3x7+jJAqfp4UOY4OHDsWv6+5PmZ3ucHz4c",
+	"bpp5j6XEEJVuDAdJkrBqkXtXnZhWrGpUEaG5i1bcT+8E6LFtGi45x0fBvBI4nmfDLs7OsXU5HwdPdQla",
+	"+WfktXhI9JL6t4X775NPPxuNR0xUK7v4+vtoPHJff0291PJ1MoNzXbKmE5/rjGb3NCnpZkja+J1FapL4",
+	"rWvyvHuRRhs+S7/pvrN7Bg9XF0F4IYDVA3vBG9RVqjmV2tlKDK3DGk4MkmRdiCdsxa6aPL/0FaDHIuu+",
+	"wnzkQZfgvhUvdgZCfWkb+dlsTn4sB/abhfK32WdP3312dg9BT2U+t/TbFNxCxCTW2pg8mioqn+ZQVWsK",
+	"Gj5c8eZ0E4S/Ret+pbjZXFr8e7U7/+1NquzSt6EQkquuFbysnexr5BsmfBxRXTap0l66/lbSAqRPdP4W",
+	"jBgpCxsaTldl4XzxyBf3Zv/BPvnb0/zRJ4//Y/a3R58+ytjTTz9/9Ih+/pQ+/vyTx+zJ3z59+og9nn/2",
+	"+exJ/uTpk9nTJ08/+/Tz7JOnj2dPP/v8P+5ZSrcgI6A+i+az0f+anBcLOTl/eTG5ssDWOKElt7Wm3r4F",
+	"DdtcosuRMDSDK5atKC9Gz/xP/5+/KKeZXNXD+1/tjahs86UxpX52dnZzczONu5wtoNrIxMgqW575ed6O",
+	"Wxg/f3kRssugRRB2tHbdm45qUjiHb6++vrwi5y8vpjXBjJ6NHk0fTR/b8WXJBC356NnoE/gJTs8S9v0M",
+	"6lWfaWbsa0ifhRSJb8edb6U1T7lPi1Bw0/5vyWhhlu4/K2YUz/wnCAN3f+sbulgwNYXYb/zp+smZf3uc",
+	"/eEyTL+1gCUdyr/l9lFGfY6FLAS4ltWs4JmVUF1dqgwj4k2lRDtftjbUVHrsU0f5pA0ih9A3TLarR+NR",
+	"QPhFbhGN/S9qZgdodGdBj579I6WV7YA39URqdyCioVDBqOYRoIMfIY+0qKg5nuVijyaf//rHp397mwy4",
+	"7cbe1EFrW78mi35pBt4tv9Oi+B014GwN4datAKlxX2DbuC6MAx1qtI1B2Ry+Rt3rNjZQtM7E97uQgv0e",
+	"0PjPiqlNjUcH2CjGmxfgaFHYhlKwhNzWXfpXdcqpmyiXc6icXEep2lqmRCridGEvreY/ThEspIlyQsZp",
+	"BW3PvqW4Cy+1Epc7YaUXZbPQfVjNr+ORBxSO+ZNHjzxvc3qCCNdn7jxGMw0o1QI3STyKB+eAgbo8ED+9",
+	"CmWqFXX+RO4LivzOoIyNppa6nx5xoc1i2rdebnu4zqK/pLnPnodLefzRLuVCYHiyvcvwzn07Hn36Ee/N",
+	"hTBMCVoQaImXNpzj7iX1s3gj5I3wLcFHdrWiagPSlImKYjTkZkMXGpzh4a5AThWVuRSL0a9ve2/Ms2j1",
+	"9uf6fxOe3+o+7SRSvHi++4rtuQdgrDjlIbl/XpYQhnwZvp+X5UvL+zXEfzAOnJetuTb6wZR8G/duWGN9",
+	"Pl/v01gnrkAc+VKVzRgHuHrQ5pq87xt5xP9SV/95U3XJcyaMzWul+tbRoLmty1lxYePTRs8e7Z//f/vn",
+	"0yUeU00nF09UBW7fPAFwQO0GOGFtQstyjzHwSG/Jm107PtsnT1QyIg7Qg2xUBbumexf6S3l+pyuZ77xH",
+	"TmjdH619Al60lCDrYcMZe1eXiq/QHu7AZu2fu7tyPnJx9QdaWBKKlitVC3knMfYvJcaGTP4LlCvL8giC",
+	"rU90sqvJ2R++ZNIR5F1XJGqApNsohVX3jXJR3G9xnAdTct5ucxhbcTWSd8qwmHjlLye9ApJ3y611oa0j",
+	"SqyNXDe7Gpyk1n7xKk7XtE/2pIZMZX8f1PnPK6ae8LiXXGoXsVsiPYD5d6RNd9Xc2aXwp5QyHdJO8uVf",
+	"Wr7U3lfmVhJmHORw5jKSRvLmrRSrbcUpN0GOjD81mF6IuHVHeFwHdFkWg5EqPnh07J++9pN7FeNmjTsP",
+	"466A+C2LX+Bfbi6eD5ENPzat4J0aw+qeyeskvcl3zZSTpqVX78a0NIzJPX309N1BEO/Cj9KQb7zr+Kfv",
+	"cg+OyRvTZLUvL9zG2s5mcr2LvYkWfwvVaLAMb8TsQvnDcfTdtkbnn/uQCLBZXffBlHzpmtaphZ275ELS",
+	"og4upmqBnSzTtMgg9/x/n8H496bkG8g8ZvQYPJbtGNiQC/Ps8ZNPnromit6gQ3C73eyzp8/Ov/jCNSsV",
+	"FwbcRfDZ02mujXq2ZEUhXQd32XTHtR+e/a//+t/T6fTeTv4s119ufrR89U/IpMepOkmBkvq2/SPf7dTj",
+	"W+AG92/BTs+Vc0HOv7wAD4kx0VW2JFSTe/fRT3TsgHtwDyq8UEHOf/mh3fb8lx8uYfh7Y2IkyRkmxouL",
+	"YROqMd8LfvQnOSqsakdyHyfBIT21YjrjE+ep2r/Ku7zEv5Tr5KUp1zF3PV3a7/TSttj/U1zWsyYZOQVA",
+	"UJHHQTPHvLyZ3vf6HrujC2GS4e6dkh8lQSCqgirM0QaFEjVZVFRRYZivosy0iatBZQWHHKeKaKaumZpo",
+	"HgoKV4qFVNClYte2YVQArwHB7nuR6b/EnfgDXUdhA7MgHhnpcAdK3xVd282BcsbMjLEcxpp88QV5NK6f",
+	"nzbDsVxPAoZTnHlF1ymmvCsoJfXrcdXCgb6H5nN/7vAo1W7PfBh7iLKwlk9DmaL6MfhXvyw+2rcVHgy3",
+	"sUdi1ntbKGsLZKwygh93KItQYsbMbroqy2JTlyW04rOXTdNc1c4wVA/0sdjX7lT/Y+dJ6hzae3XiCCed",
+	"z634Upug9uRBmHL27A9Qw8QMqMMEIPxyJwNw5jsUR3rOvnKR98c7+CHrw5ZvvfmswJZW56h1AW33IWgE",
+	"MtLJuYvAtTJTxpRlbBk17AEUFJmFOoOQWKiOO0gLTzj8xE6aEqKiigYn+3+/oAe02K0EGG9gTjHRUFNe",
+	"S0e2R1kkwLLNVOIo/gR/2KjFmgQc0uvKPEBMgR7gveMVPRj268KmfPqT0pUTGAzlV/XkXRm1kA3sH+4Y",
+	"cELwfgjusPiv8bg5nuIW8WcIRfIP+gn5UdYpdJDf/ykN73cpn9z1gn6UgqGHiX0MIC2enAmC8FRf+j7j",
+	"Gj7pQEC5lSB15rNabJWmvnNFBT5OieoOrvTvkrlAGreORex0Z1qoerQhzNonG6ENEXD6Pt9m74W/foAP",
+	"tvfBwd4Ny8GsRFJFP0lxXCYESQ2RmM9CSqA+jvTCNo7kNMzR9JflTtsIJo2qBOGEhEs0kWBy+hc8zl+5",
+	"AuHGp98CsiSai4wRLVcMXhVWjHf1FxHCv707CA23XqWygsygUdz9e2Y4nz765N1Nf8nUNc8YuWKrUiqq",
+	"eLEhP4tQCPw2DFBjsRM5b+jQu4eDcAFmwWby1SzO8HgLvigXW8ygTttfp492SbhkZZjCxMGNMgahIH3E",
+	"t1NadGAYL+zUJ5EPevttGFpH8CtaFIC/XbY6GHiQX39R4AYzVzqpu5OuCpXf7HGte5PlpGDXrCC+fOW4",
+	"lakbRlZQyd8l59bMbrxhJFpNpOFgis2lApcaxbxycVUVhpdFsw+kng119BP+dkiscZ6/i+d+dWhWl/N6",
+	"6DZBG9kYfErOwyeYWUhcHFUMmHmsAI11ktMG0FTFAQuingILvPkk0Fy1snLXvl1lyaiqOyPDuF8qNnFD",
+	"KHrNlKZFXQgnLOrBSZz/MMT5tSsD8YEI80lT722Z/+F3UyPu4A+ztn47O2X3TmrVP4+Z5qqVGvXieRwb",
+	"JkNuQS9X9CzGInLPcNR/Hw3IB3bXeWaTJqQ6h2fXFDMsIe3JujSYoXTO1rZ3Xl/i4nd99dTxcfFBJ7It",
+	"ErzXK8i8ryto0rqDmmh5fzcSsy3j6oqlkkZmsoAzZd12pDIh7bGeDnqIsb5rrvEO68+4fYurbM1zvVMJ",
+	"fgWtTk+iWgt+5fGWUoM3z69OlcEfmmu4nmvIW+lKlgTfOy0Q3iujO8nYKQbX0ph/7Apz00t6R9afZ9Rk",
+	"y6o8+wP+gFzLb+ugX6hdpc/MWpwtlLTNtvpsAo8tWG6JEbo2VF7xSmC0pOflC+hel9j6RqpIHvnW9tvN",
+	"OptIG7elAJidXDxPM9W7EZtP0mafaaG14bc3qCdG7JxXf5bjeq2BdqPCbY6CrWK+YCkSPjmAfFgLqu0t",
+	"cy5yQqNtbD2qpaoZwR3bXO560e/DhPPuvV4+/YjPmXW9vrBlHlZMGJbfzgOatDmcvz22Xrf7CQbu6u+6",
+	"SXfv/PjG95EiQRbZecH/iTR3pzv+g7rjvwpmqZhATzf2x3NjK38IT5fzh385f/LRruYOvT8GXtYHWNGa",
+	"F3T9Rt/zqu6ICU671VIpbDPAwaO8vUr9jVS+4Ojpfv/TxSPhHg/2ZRmi1dmlvXVTHiPY54OCfphuwvrt",
+	"dLQTfUd4HNxlOCSJlBmHwlIXuR7j8XYKDXe+TyLRBy0SRXt9kohO6oqPTF3RI/84TUFRDBFB9hWNrlcy",
+	"Z946K+dzl6+5Ty5qVg615KkNXZUEe057fVuv+Ipd2pY/4RRHvWJrsFtmyRZ4FlmaZVLk+tAauW6qQy8n",
+	"izzTD9U7N5GGbfGwuBRA04Pp+FWUv7FDHqS9IxrKwPqM1Q4ZObsmliqnR6Dlsz/wX9DLlVInVnPJTBpc",
+	"ct9tC6bgxnEbAJKXIJliLm/fS87JI8zEXQkNVkqufQY8kROjNsTIkOZPMRvU3Ag0DHB0j9Nl73Ha+nK4",
+	"Sq2uZ03pZ4Wsj+2t3xUHpX1qhYN//86PyldUuMPRRaWRhBLBFtTwa+a9DKanrEoHX4Yup9EWVjkmNM/x",
+	"3NabwK6Z2hBdzbQVlUQzbOSebp6sPVgLW5dMcXvD06K2+eMr4wxTJm3zZbrEFre881pcC8YkqllS3l/M",
+	"CJNlRT/wTElb8zl4I+uNNmzVqbvuuv7WU37Bayj20hhIUXDBJispUoXCf4KvP8DHwSwD0lT1jXhlP+41",
+	"YOt6byKhtYDm5ENEgNtu0gfCQm7loNNarWKlVMZmv8DEOniI9jyP/uRtRNY9jhuRRcY49zEaSIqen8+8",
+	"v3ijrniy5R+N/7r8bK6lXlYmlzfRLJqvqsKOq5nG0vz9woihCgLhXB8uBXG9wIVJlj7+oBGHdB4avWHM",
+	"1T1G7Ue2pGKB+QbNkqVeEB46CJ3hZky0JNpyUlo4+eAeuZHKQBi86+OK99ddpWCEzo3T80mzZGpKLt1y",
+	"ibDDWfGCz+NdxwIoipGc64wqqE0CY3zyiKy4qAzDmCL7/q00A+8kyOqSs4IZn0KUK6bJ18Lqn59bRzDL",
+	"1NCF2MuURkI6J3+2hIxyiobEWC1By+7DZdgDtxIn/zBtvpT55minsjNPSMKWkNhDGRwj7RYrA0EomBu9",
+	"3nZ5zZTiOWb6tJBtpg74Wnh7e9RH2JayPZZEtHFgO0J09IoZoQVbm52kGfUComFWpo4/4vhIQXzf+j/j",
+	"kRt7wntWUectbK+h69U2HgVRIz0YCiaxfEPMdkyNI+HHBUXbr6yU2XLnWtO3nb/lopXHgA/y/K0pbp7k",
+	"WtNTFsNTePjtDYRb7sWBsoM9EJnM2YKJiWPik5nMNxNvOAs8t+fSPvujPidvEXp7C3XX8Rxvs35om1fN",
+	"1yJPXTQ7TIHdkXcnVm2c8+HlAga9yK8i7nxDdX2l/xVTWdT76WWgjz+j6Nciv9X5G3auEuW2blObdXc5",
+	"Vnt5VXpMZrSg9k51mY9FbtkVoSsAhlCTvJ6T6MCyfBpGirZVkyUrwrPHg9qOkk9Iom3e4AoO7lOe9V1y",
+	"i/GfoDjsneZ/RlxsKSUZDAc3irq3nPuC4cRg8qlzyZ6yRJ9Y+90UT3T+D++a4d+yguLOUt2tEiY7C3ZH",
+	"7V29RHjwtIslkvvnZfnCNrgM38/LEgvbjkmrrKt+sN+l8m0802F1Xut1bCsB3nffROnRT1fPO65L/ler",
+	"n9mqRF2WEzh4E6D+PQps1qexUZS6LPcY46MvTF0vZXd56rtide0S1g0WfIfM7SQbnWSjd1JYuizfkbSU",
+	"X9tnar8B6TzPNWGr0my8cwc4J3ThGpOVvIaqbEY3ZRCwJATN9FyqG6oSD9NzBOUj0F29A4uNQ8YWw813",
+	"8obMqYLtwMZtG8LJOnOyzpysM6cb8iO7IR3r0/Epb9wgcn6bi/G4dpsPvYT5fkqBv3y58+49fEjx8/eu",
+	"oziVXj+VXj+VXj+VXj/JNR9nJfZ38/aP0z9v8SB1g+jUk63pQjpMyLiYO796GMPynYyxXFvDibWoKGZZ",
+	"KOYzbjwCNcRE3OBjKvJP9N+92DjbgPeo82QFbSqeIwdYSIns8vBLRdg/K1r4Cbe+yuysbY9Ib3OnRSFv",
+	"tpjcmSPlKIL5wxYlPv6o+zvT1ESamVtGhHYHTD6748Nq8ebP9pjQQooFhp1TsUFfacJFWRl911ogq5SZ",
+	"hIMwXMn19TUtfgrd3o5HbM2yiVE0YxN0pB6KtSvbB+nUjsMFN9wbdoYCxC6w1yV2ejseFVSbyU7zDF+t",
+	"WM6pYcWGlIplLMeQTK6jUzsllw2/ebNUslo4iw6Oc8MUCyncVSU6Q+yr2zJrMUEGndDnuiohcS4UeDh2",
+	"uDsWybihARRkbYPyNkTb00jW8Arm7qZsGI+umQJlbxLf7iNyY5AyGxzo0ODbaJsbSKuhOUbKi9MhOR2S",
+	"v9ohSQWvAz7nrfzvTgaMtvHPU7f4lNzlQ8s29h5flae8cB9E2Me2x+ztHt172xXsjYfFNRK5Xrsx37b5",
+	"qU5q/2Va4ydFT+Frwhm6/tjvD/0XrZzq8oLGdTFd3UF7+bcyBZ3Kp/6pyqcO3vf9dJIQH7OL01X6uNkt",
+	"fpQ5w3Hrkpn26Kc8sCCcW3sgWkktQq2OtAzukxPU7RBvXJMZsw+fjFa2/Kx9OMiUI0ndcUIzZM0T9LtK",
+	"T+iLbc2Dd5adbkmvGaGFYjS3Xo9MEDmzi67TJMAiqSZ2l7yS01UkGZ47IwK2VDJjWltvWOd/vwte3w5r",
+	"HpotyIPVwCrCLERLcH66kxW8ud4J/Bu2QbOWJve//0U/+FAWgQlFtm8BtEltRLuyancpt4BpGxG3IYpJ",
+	"GQu54kmAEofSJsczrAfCI2Cvd/vbYHaI4I4QeM2U1fbf7dHyk9wBUQb47/hg3ckSqnJi5Ywu3F/hV5s5",
+	"ze63oEL6rHvD3POcBm3XlWIbxYvWdqkRF0/dIttUcy+oNiCPEy5ynuFjyM8DfWCKfdVpMGWvfspO+gt+",
+	"TE2bSaGZ0JUOSixXgJDlqeVZB9L+uX5k6zCXnEdjhwqHmC5v18h9CIzGd3hsuKqZELLhPF27i4OIBOpy",
+	"eO2F5QZ8NY62wXjpW0WIj615PTByXe8BkhvXLXqbSVkwCs8TbWRZWg5lJpUI/foweImtz83PddsuSTrP",
+	"NTsnySXTcWFKB/kNIh1jo5dUEwcHWdE3rnblwgVhdWG2x3oCrrWTrapsmxrRtooPzkHHvSoXiuZskrOC",
+	"JpKN/YyfCX7ekzD82EAgntAn19KwyQwKvadppD4T6pB8bGFWCVMluPuPksAXklGNevSa1FzvwyfNGUyb",
+	"4puOWO+FWQCMJB348QBZSE89meDsGJassBGuxt1Kt1xLD/bCrHeCQBh3UmuA2rP/F9Nubt/muPNvmO5b",
+	"eD31sZbdTswX3+3jppGjcZW1bpvkFdHLl3cwxj4elDKofJSZh9o+GXfoWtVMhRi94aeH6CfObig3k7lU",
+	"+G6ZQJjJzpJcf6fcJ9f2IR+QLI1ZYdSO4GQENw7cWrFzt+NYCIL33LYkAm5MChwsKXnsctnBF1mZMeaw",
+	"U9ZAyfIGGtxIsV+mYguq8oJpSOHnBQGp4HblpiXMhAx8W4uS2HV/IxVkEv7raqdPGqeTxumkcTppnE4a",
+	"p5PG6aRxOmmcThqnk8bppHE6aZxOGqeTxumj1Ti9n4hHMpl4Cc1HawkpJu2KOKd03H8id6NaBRYUYKB9",
+	"spo4l2fAV7fv10vtpehTjK7O6ldiUqV3Ca00ev9DW/sedJGDRkbFJ8bEyAUKORCLBWmQOnVDx4Rqopm6",
+	"ZmqimTA2FFIYPSVfQ0gk/A/kiki+0zAWzyFCEl0UoxhIqklODSVU+GgFX4HUwUt9HUsy56ywgZYuMo9w",
+	"AeF3EAvORSt1BHFevVOCOLB7oF3WdNPG/dj/5hPX2VBIX6DOijGTr+3KJhfPXfEkr7PMpBAsszsDULBC",
+	"Mz8UiImhNMWM1Yh3aYQsqUBet6Us8rhGiava6V5h7u6M9mNJUbTbMNPczZzrN2MnTVPF6ufCD3R9nmXm",
+	"hZRvZja2MRICqSErqQ2hZM5ufMqeqMKJa4oykR1xBRVRKEECrAvWAXItEhTT1YrBNsKz3gr4XOTyxm6F",
+	"JWaxiIZz38ic8gLLmxBKnj56Spg9Z45G/GS4tDktCgvRklvR0hAmct9TYDckRJsQxCzJCoM6NeRjgCll",
+	"kUfpveKVONjhDU3dexk02ImKKLbTl3j8BtSbaxUp0YEo7Vx9EaT+1qvZn4spDa/uU83qA2tWG7Y2Z0Am",
+	"E9yLPU0/555svPrNs8J0BNCO6e42ZucuJx/o7n2XIPTUACLwpCE2vJgp0rjkP32n+zNE6LnL+e+uCAny",
+	"zXAFOTV0VEK8K0How+ScVrIH99EwWgBKeLElByRmqrr6+vwF0bJSmb2Kc9BBlgW1VxRbm7EzMbalCFCI",
+	"0BWm2sHcVlSzT56Qy+/OP3385Lcnn35m72O4Kptt77scxkSbTcEe2Eufa3tXoVaSa7w2GVTqylGgoP4N",
+	"3ijHRea8YEQz01fXy6gqcUNdMVp85XCz44L6u53clQb73Y72+7hh2XVoW9GQK8OvlWpCkY2T5xFj/31O",
+	"C81+7+PtON6Klqm0OUHTsD0HApwY2MDmUQk35IwLqjZdpWWCgbRJIwhsFnn5HWchsOTTJdoume2isJR6",
+	"FuOA06P3UXlqnHrDOkPh7T9v0ckoFXke6xNg1QHAQdkboTQw7gmpg4zf3yOfAETuiNXM/YOJtmqVYfJM",
+	"A9oKaTzr+VgTEnnEJ08vnP2xJey8yhi8nhzFHSEWEicbvY1voZxrqjVbzXbfRDH/dCnc3OVjlonlNO6p",
+	"93ONPI8WNzQvzXriGHAPd94YNpg3B2zBiI49Rxi/axbdx0ZjEIjjTykDY4v37cv06mk2J8Z3YnzRaWxJ",
+	"BFw4nV6biUzvkPGpjapEP8/7es0yKF0bn+T74PwBrmVWTRZ7EuZsVi0WkIm942tml8ZgPJeN+z2wQlzu",
+	"3WTnwsGPlpurPVyXu9SvKnJfKswk8AC2g4oNuMWsSio2Tnlq2CTKL5BTQ6ej4zJa0N4l9C/jkbe79rsx",
+	"vHQtYou8u2qbvyNaIOsN7i/LSSVyV3a7PbFZw4tzWE4cHPpqLfqS4LRuAlxvYnVu3iFXhN9l3ITaXbNk",
+	"amLWAg9UM20jqDcJntxTzvO/yLXxElWwPQwW7VMmyRDuKIVIj04n+vWMNmvaN76VWImoLxQ/4m2uZtFR",
+	"HaQ7wzf9pGt1i3O6Y0VJKMkKDi55Umijqsy8FpgUNFrYtOtD7Q35/bzvK98k7TOWcOlyQ70WaGkKvjpJ",
+	"HjhnCZ+RbxjzLFZXiwXTYIWKCGjO2GvhWnFBKsENzLXimZKT82IhgUmB7DLFliu6AfMOMZL8iylJZlWj",
+	"1obL7K6NdQhDp207DZHz14IaUjCwdnHLge1wlqgbcRXM3Ej1JmBhOty3ccEE01xP0tqab/Hrd1QvPU68",
+	"VtD+7TqjgtRO2n4G1ZXU/s/9/3xmq6nRyb8eTT7/97Nf/3j69sHDzo9P3n7xxf9t/vTJ2y8e/Oe/pbbP",
+	"w87zXsgvnlu4KWTwL7iOCpV0YP8QnCdXXEySRGltAS64ok2L5D4YohzBPWj66Jgley3sbWkkgRuCmiOS",
+	"T9uXpnOg8Yi1qKyxcS2XG4+AQW/Io7AqkuBUJweWP1G+nIgOvPsYbLyVFDp7v58Rp3lvM2GTaPbd6vj1",
+	"7A+zxuqmqUY+WWX86kznrN4jWfUpXfQpXfQpXfQpE+4pE+4pXfTpkJwOySld9Cld9Cml8l8npTKFmmrx",
+	"cZUqcXrBn53cQI7ZWVzYVQrn9w1KAnTyro86xjBW2rm1Z0vKhfOLCxG7rnRP7VC3T2DAftpXZGagdrXo",
+	"YFmluNnAq4iW/Lc3zP79q31WoLc/PpgqVYyejZbGlM/OzqAi+lJqcwbew/U33fr4a4D/D//WKRW/hjL0",
+	"ALZUfMGFvaNv6GLBVK3nHD2ZPhq9/X8DAHz2mYqNMQIA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
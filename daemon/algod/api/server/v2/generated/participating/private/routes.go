@@ -1,6 +1,6 @@
 // Package private provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version (devel) DO NOT EDIT.
 package private
 
 import (
@@ -249,235 +249,265 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9a3MbN5Mo/FdQ3K3yZUlKduxs4ree2leJc9HGiV2Wkj27lk8CzjRJPBoCEwAjkfHx",
-	"fz+FxmUwMxhySNF2UiefbHFwaTQajUZf340ysSoFB67V6Nm7UUklXYEGiX/RPJeg8L85qEyyUjPBR89G",
-	"Z5zQLBMV16SsZgXLyDVspqPxiJmvJdXL0XjE6QpGz8Ig45GE3ysmIR8907KC8UhlS1hRO63WIE3fN2eT",
-	"/zmdfPn23dMv3o/GI70pzRhKS8YXo/FoPVmIiftxRhXL1PTMjf9+11dalgXLqFnChOXpRdVNCMuBazZn",
-	"IPsW1hxv2/pWjLNVtRo9Ow1LYlzDAmTPmsrynOew7ltU9JkqBbp3PebjgJX4MY66BjPo1lU0GmRUZ8tS",
-	"MK4TKyH4ldjPySVE3bctYi7kiup2+4j8kPYejR+dvv+XQIqPxk8/SxMjLRZCUp5Pwrhfh3HJhW33fo+G",
-	"/msbAV8LPmeLSoIit0vQS5BEL4FIUKXgCoiY/RMyTZgi/3nx8iciJPkRlKILeEWzawI8EznkU3I+J1xo",
-	"Ukpxw3LIxySHOa0KrYgW2DPQx+8VyE2NXQdXjEnghhbejP6pBB+NRyu1KGl2PXrbRtP79+NRwVYssaof",
-	"6dpQFOHVagaSiLlZkAdHgq4k7wPIjhjDs5UkK8b150/adFj/uqLrLniXsuIZ1ZBHAGpJuaKZaYFQ5kyV",
-	"Bd0gald0/Y/TsQNcEVoUpASeM74ges1V31LM3EdbCId1AtGXSyDmCynpAiI8T8nPCpCS8KsW18ADdZDZ",
-	"Bj+VEm6YqFTo1LMOnDqxkIgOpKh4ilER/ODQ3MOjbN9jMqjXOOL77d8UW7hPbagv2OJyUwKZs8Lcl+Sf",
-	"ldKBgCuF274EokrIDO/NiRnGIF+xBae6kvDsij80f5EJudCU51Tm5peV/enHqtDsgi3MT4X96YVYsOyC",
-	"LXp2IMCaOqcKu63sP2a89FHV6+Rd8kKI66qMF5TFZ8HQyvnzPsqwY/aTRppBngW5AffHjXW5Pn/ex1K3",
-	"99DrsJE9QPbirqSm4TVsJBhoaTbHf9ZzJC06l3+MrHhheutynkKtIX/HrlGgOrPy01ktRLx2n83XTHAN",
-	"9iqMxIwTZLbP3sWSkxQlSM3soLQsJ4XIaDFRmmoc6V8lzEfPRv9yUgt6J7a7Ookmf2F6XWAncxlLMIxv",
-	"QstyjzFeGeERRa2eg274kD3qcyHJ7ZJlS6KXTBHG7Sai3GU4TQE3lOvpaK+T/D7mDm8cEPVW2EvSbkWL",
-	"AfXuBbENZ6CQ9p3Qe081JEXEOEGME8pzsijELPxw/6wsa+Ti97OytKgaEzYnwPA+hzVTWj1AzND6kMXz",
-	"nD+fku/isW9ZURDBiw2Zgbt3IDdjWr7t+LgTwA1icQ31iPcUwZ0Wcmp2zaPByGXHIEaUKpeiMFfgTjIy",
-	"jb93bWMKNL8P6vyXp74Y7f10hxK9QypSk/2lfriR+y2i6tIU9jDUdNbuexhFmVG20JI6rxF8bLrCX5iG",
-	"ldpJJBFEEaG57aFS0o2XoCYoCXUp6GcFlnhKumAcoR0bgZyTFb22+yEQ74YQQAVJ25KZFa9umV7WIldA",
-	"/bTzvvhrE3Jqz4nZcMqMbEwKprQRhnAzFVlCgQInDYqFmIoOIpoBtLBlEQHmW0lLS+bui5XjGCc0vL8s",
-	"rHe8yQdeskmYY7VFjXeE6mBmvpPhJiGxCocmDF8VIrv+nqrlEQ7/zI/VPRY4DVkCzUGSJVXLxJlq0XY9",
-	"2hD6Ng2RZsksmmoalvhCLNQRlliIfbhaWX5Ni8JM3eVmrdXiwIMOclEQ05jAimnzAGYcT8CC3QC3rGdK",
-	"vqHZ0ggTJKNFMa71EqKcFHADBRGSMM5BjoleUl0ffhzZP5TwHCkwfFADiVbjdBpTcrkECXMh8aEqgawo",
-	"Xk4r8zwqi2afwFwVXUFLdsLLUlTawBi9XM6f+9XBDXDkSWFoBD+sER/88eBTM7f7hDNzYRdHJaCihfGs",
-	"qPIaf4FfNIA2reurltdTCJmjoodq8xuTJBPSDmEvfze5+Q9QWXe21Hm/lDBxQ0h6A1LRwqyutagHgXyP",
-	"dTp3nMycahqdTEeF6Red5RzYD4VCkAntxkv8Dy2I+WwEHENJNfUwlFNQpgn7gXe2QZWdyTQwfEsLsrJ6",
-	"M1LS7HovKL+uJ0+zmUEn7xurqnNb6BYRduhyzXJ1rG3Cwfr2qnlCrM7Hs6OOmLKV6URzDUHApSiJZR8t",
-	"ECynwNEsQsT66NfaV2Kdgukrse5caWINR9kJM85gZv+VWD93kAm5G/M49hCkmwVyugKFt1vDDGJmqVXV",
-	"ZzMhD5MmOqaJWgFPqBk1EqbGLSRh06qcuLOZUI/bBq2BSFAvbRcC2sOnMNbAwoWmHwALyox6DCw0Bzo2",
-	"FsSqZAUcgfSXSSFuRhV89phcfH/29NHjXx8//dyQZCnFQtIVmW00KHLf6fmI0psCHiQfTihdpEf//Ik3",
-	"iDTHTY2jRCUzWNGyO5Q1tNiHsW1GTLsu1ppoxlUHAAdxRDBXm0U7eW37vR+PnsOsWlyA1uYR/EqK+dG5",
-	"YWeGFHTY6FUpjWChmkYpJy2d5KbJCay1pCcltgSeW9ObWQdT5g24mh2FqPo2Pq9nyYnDaA47D8W+21RP",
-	"s4m3Sm5kdQzNB0gpZPIKLqXQIhPFxMh5TCR0F69cC+Ja+O0q279baMktVcTMjQawiuc9Kgq95sPvLzv0",
-	"5ZrXuNl6g9n1Jlbn5h2yL03k16+QEuRErzlB6mxoTuZSrAglOXZEWeM70Fb+Yiu40HRVvpzPj6MjFThQ",
-	"QsXDVqDMTMS2MNKPgkzwXO3U5nhrYAuZbqohOGtjy9uydD9UDk0XG56hGukYZ7lf++VMfURteBapwgyM",
-	"BeSLBq1+UJVXH6YsFPdUAlKDqRf4GS0Cz6HQ9FshL2tx9zspqvLo7Lw959DlULcYZ3PITV+vUWZ8UUBD",
-	"Ul8Y2KepNX6SBX0dlA52DQg9EusLtljq6H35SooPcIcmZ0kBih+scqkwfboqpp9EbpiPrtQRRM96sJoj",
-	"GrqN+SCdiUoTSrjIATe/UmmhtMdrxxzUrJISuI7lXNRnMEVmYKgro5VZbVUSLVL3S91xQjN7QieIGtXj",
-	"5hBcNWwrO92S3gChhQSab8gMgBMxM4uuvRxwkVSR0sjOTqxzIvFQftsAtpQiA6Ugnzh99k54fTt7/+gt",
-	"yMPV4CrCLEQJMqfyw6zg+mYn8NewmdzQojLi+Q+/qAd/lkVooWmxYwuwTWoj2uq77lLuANM2Im5DFJOy",
-	"1Rbak2BEbMN0CtDQh+y7Y693+9tgdojgAyHwBiR61HzQo+Un+QBEGeD/wAfrgyyhKidGDOxVPxjJ1ew3",
-	"p1x42XDHDGGCgio92XWlmEYNvYlZasTFU7cIDtwjT76gSqMYSBjPUX9rr0Kcx8qWZorRnk5lOGXva8xM",
-	"+ot/iHWnzcz1zlWlwqtMVWUppIY8tTy0WffO9ROsw1xiHo0dnn5akErBrpH7EBiN7/DoFAH4B9XBQu1s",
-	"3t3FodeBEV82+2K5AV+No20wXvhWEeJjp9oeGJmq98CSG1MtepsJUQBFlanSoiwNh9KTiod+fRi8sK3P",
-	"9M912y5JWjOQlVRyAQpNTK69g/zWIl2hrWtJFXFweP8EVHhZF7kuzOZYTxTjGUy2nRd8BJtW8cE56LhX",
-	"5ULSHCY5FHST8Lawn4n9vCdh+LGRQGr9gdAwmaE1MU0j9Znw/qaHzSpwKpUSvAl+IZk55+YZVZOa6334",
-	"pDngtCm+6Yj1XpgFwUjSgR8PkWXpKTEi3v03QhuyckSHq3G30h3X0oO9MOsHQSCOO6kVAe3Z/xuUmzsI",
-	"YEedfwOqb+H11Mdado/6H+/2xoXZuspat03yiujlyzsYYx8P6rFFvKJSs4yV+Fz9ATZHf723J0j6SpAc",
-	"NGUF5CT6YF/yZdyfWDfk9piHveYHqVu74Hf0rYnleM+sJvDXsEG1ySsb0RBpq46hjkiMai5cygkC6r3m",
-	"zYsnbgJrmuliYwRbvYQNuQUJRFUz67XSNaFpUU7iAdIxU/0zOoN80hy+1UPgAoeKlpfyPLSvre3wXbae",
-	"XA10uFdWKUSR0H+2T3wHGUkIBrkLkVKYXWe0KDZEh7AZT0kNIN0Fgd4YQZ65pxpoxhWQ/xYVySjHF26l",
-	"IQhpQqLkg8KymcGIm2FO56paYwgKWIF9zeOXhw/bC3/40O05U2QOt9blhmPDNjoePkRV3CuhdONwHUHb",
-	"bY7beeLSQVuluWTdq63NU3Y7ubmRh+zkq9bgwcBpzpRSjnDN8u/MAFoncz1k7TGNDHPww3EHme+aLmGd",
-	"deO+X7BVVVB9DEMl3NBiIm5ASpbDTk7uJmaCf3NDi5eh2/vxCNaQGRrNYJJhlODAseDS9LGBhWYcxpk5",
-	"wDZwZChAcG57XdhOO17atd8yW60gZ1RDsSGlhAxslJyRUlVY6pTYkIlsSfkCX0BSVAvn6mzHQYZfKasJ",
-	"kxXvDLGvKKbXfIImDJUMU0OzpY+2NEIYUPOybds/7GPtlgZQ7GU06NKOtqdtD0qaTMej3oe/wfdN/fC3",
-	"eGuGjB5qTGzIhxHSamgGWs8Qn0ZW6iIx3kZz+AwxfBgrTT10CsruxJFTeP2xzy/8oirLYnMEIckORCSU",
-	"EhReabEaUNmvYk5+ZJkUZ8VChDtPbZSGVdd4Y7v+2nNcXx/yAha8YBwmK8Eh8aR/iV9/xI+D1Y72Gu4Z",
-	"EQWivQZsP3waSGgtoDn5EJK+6yYhybTPftvSqb4V8lhWdjvg4DfFAMv1TrcON+Wh9nVaFAmTtFU/dLiI",
-	"GgencCYJVUpkDAXF81yNnfe5tWJbt/YW+l+F0KgjHOD2uC3baxSGZRX5UJSEkqxgqOYXXGlZZfqKU9T0",
-	"RUtNOAt65UC/Wvhr3ySth06oid1QV5yio2jQ/yUdg+aQ0EN9C+C1w6paLEDp1gNrDnDFXSvGScWZxrlW",
-	"5rhM7HkpQaLH3tS2XNENmRua0IL8AVKQWaWbT45VpTRRmhWFMwSbaYiYX3GqSQFUafIj45drHM77kfgj",
-	"y0HfCnkdsDAdzrgWwEExNUl7On5nv2JQicPJ0gWYYKyF/ew9nuvcECOz9kbSiv99/z+evTmb/A+d/HE6",
-	"+fLfTt6+e/L+wcPOj4/f/+Mf/6f502fv//HgP/41tX0e9lQwuIP8/Ll7o58/x4dYFCfShv3PYJBZMT5J",
-	"EmXsUNSiRXIf82U4gnvQ1PvpJVxxveaG8G5owXLDi45GPu1rqnOg7RFrUVlj41pqPI+APZ9Dd2BVJMGp",
-	"Wvz1g8hz7Qm2OtzEW96KMXCcUR0dQDdwCq72nCm32nvffXNJThwhqHtILG7oKLVA4gXjIhgbXj5ml+LA",
-	"rit+xZ/DHN+Dgj+74jnV9MSeppNKgfyKFpRnMF0I8swHRT6nml7xzjXUm0AqCmqOMkilOAVdpddydfWG",
-	"FgtxdfW244fQla3cVDEXdeesqybzU06M3CAqPXFJXCYSbqlM2UJ8ig8XDY29t8JhZRJRWSWWTxLjxp8O",
-	"hbIsVTvZQxdFZVkYFEWkqly+ArOtRGkRAscMM3ext4YGfhLOqUTSW//krRQo8tuKlm8Y12/J5Ko6Pf0M",
-	"Q/DqFAe/OR5o6HZTwuCHb28yivZ7Fxdu5XJ0Kp+UdJGymVxdvdFAS6QQFDhW+NIsCoLdGuGBPhIAh6oX",
-	"EGKR99gSC9necb243Avby6f1Si8KP+GmNmOn77SDUVT8wRu4I7KeVno5MRwhuSpljoHfK59ggC7MleM9",
-	"CBRb4ANALUVllgwkW0J27TJbwarUm3Gju3d0cXexZzhMoc7IBQfOmcFfRrkZsCpz6gQZyjftFDfKBkPg",
-	"oK/hGjaXwnafDswOFmWji1KsqL6ji7Qb3bWGfOOD7MZob77zu/Ixoi4dCcZderJ4FujC9+k/2lYAOMKx",
-	"ThFFI89HHyKoTCDCEn8PCg5YqBnvTqSfWh7jGXDNbmACBVuwWZFg0//VtWt4WA1VSsiA3fio3jCgImxO",
-	"zOtoZq9j92KSlC/AXOrmIhaKFui0P00a+lE6XAKVegZUb9XX8jjNhIcOBfJbDJpGpcnYLAHWZr+ZRiUI",
-	"h1vzwMO3t23jHImnB7lT2TVBfiCovnsdJD095BHhEJ7IZ+fv+7An4b3g/NNi6kSQ7feVweFCiluzmwZA",
-	"4VM3YoKX6J6qFF3A0OuoYSoamBKjYQHCQXZJP0l5R8zbYk1Hxhi4CNt9YvCS5A5gvhj2gGaAloujn9ua",
-	"EJ1V4SUvNh6pswIF6uAgakmHyoadjS/2AzbNxkDyWlj1gDWxFh/9JVX+6OfjiKMfKC1+mlQy2/LnnUfe",
-	"d1R3s+P5a7rN2sdWnzMDIrjp4bPo+dR5Pl/eaLxX7rvxyIU4pPZOcJSicyhgYXFiG3s6q/Mz1btp4Hg5",
-	"nyPTm6Qc+SJlZCSZuDnAPMQeEmI15mTwCKlTEIGNlnUcmPwk4sPOF/sAyV1+KerHxrsr+hvSwYLWG99I",
-	"yaI0tz7rsVplnqW49Ba1yNNyccZhCONjYjjpDS0MJ3WBp/UgnVxt+PZpZWZzvh0P+t5EAw+aWyNKJ3ut",
-	"0sozh6wvFrz9MtKvgr3WMBPriY2MTj6tZuuZORPJeAWM004dXps5754iM7FGnyK84ayD+97Q9UPmAYvc",
-	"QNZMIZVjvz6x0YK3HyDbBfkUNSskPadXC2TXJ8keBkyPON1HdvejFHpHAqmlwKzTgDuNzk49S1Pa6koi",
-	"9XU7DtlhQ5haitX0Hc7kTvZgtKs8bea6+75Od9ifHM2f1Y+S5K+rlLtLXkbbubS5FvdJy9gmhwYQW7D6",
-	"qi3EJtHadFxq4jXCWoolGUbfNXZ10aagANQETBpy9eQ6ZZa+unqjAGWGC98t0nPi7lG+eRB5w0lYMKWh",
-	"Ni54J5ePb/tBdaJ5bIl5/+p0Kedmfa+FCIKGNcdix8YyP/oK0HV9zqTSE7TMJJdgGn2rUJP2rWmaFoSb",
-	"/nZMWVPP3nIwQnQNm0nOiipNyg6kH54biH4KN5eqZnhRMm69jWaYCj/poLuHbRLhsY7dWxH0wiLoBf0Y",
-	"+Bl2sExTA5M0lNec/i9yxFq8cBtnSdByipi6G9qL0i28Noql7zLaSIiO3C6m22w+nXOZ+7F3emP5iP4+",
-	"IcKOlFxLlBExHUAoFgvIfaY3FxRqs165fHqF4Is6l6D5fUv6wCmxWfwwCd+W/H3OPR36nNMb5USwKkYS",
-	"+vgxg5DX0XWYexAnWQC3mVtG+9cbKZKIix3jsUWkGf24vL3jNp90Hb5suQvXPr12D8Nm4/YUQHP3rFLg",
-	"17f90Ha3y6Fu3Od03EgRu/2A4YBIcUyrSIDpEE0P56ZlyfJ1y/BnR50eQBIDxb1uJvgWzpAtucF24Kfp",
-	"WLyjVs89cztie2fsOMFn/ol5ZFp/ZueRa84GzVy2gbySaE1qeAt38+mHh+bAtf/wy4UWki7AWQQnFqQ7",
-	"DYHL2QcNUUp6RTSzDtI5m88htoSpQ6w4DeA69o58AGH3kGDXXBbellvps0tkO2irXsFuhKbpKUEpfT4X",
-	"l117pH94RLq1cNlEG3eAUTGZUOAH2Ex+oUVlXkJMqto31RkIm9f6HjRxs/oBNjjyTpdPA9iOXUFV3GtA",
-	"Ck1ZV8InFWUJv6ca1RfwDdzYwj126iy9S0faGldKo/9o1DdUo55Ecykf7tjULjIG0iF7dZH2OjFnC5rb",
-	"0ib0XVvE8t2yT/QEiadi6L1xyCUXMm3s9C4DWnjCx8WO3o9Hd/P3SN2TbsQdO/EqXM3JXUBvTGv/bzh9",
-	"7bkhtCyluKHFxPnJ9AkdUtw4oQObe7eaj/y+Sp+Ky2/OXrxy4L8fj7ICqJwEVUfvqrBd+ZdZlS3Bsf0a",
-	"sunYnW7XqsKizQ8ps2NPmltMvd7SpnVq3dR+U9FBdZ4187Sn+E6+6Vy87BK3uHpBGTy9aou0dfRqOnfR",
-	"G8oKb/j10A7VstvlDquulOQT8QB3dhKLvP/uPFZvnMDV1Zsbj9nanmIdpUJK/IQvnTrQ07nDa9Jntab1",
-	"HRwS1/kSM5mm313c5TlFxugczujR5cBvhWxcVC6qMemw9uEERPOYsHhMG+UvnRW+IxZOiRUhf1v8ZnjD",
-	"w4fxwX/4cEx+K9yHCED8feZ+x3fUw4dJw3BS1WdYFmryOF3BgxAX0bsRH1cNweF2mLhwdrMKMrLoJ8NA",
-	"odbzzKP71mHvVjKHz9z9kkMB5qfpEFVFvOkW3TEwQ07QRV9UYnB+XtlynooI3o7BxyhZQ1p49bgKHtbO",
-	"3j1CvFqh3XmiCpalnX74TBmWxK1Lr2lMsPFgG7KZo2I9fuW8YtHoppk6yOTZWkg0axLhKpkJuMbvTDgW",
-	"UHH2ewVRWV+8iVuXs38K4agdATutX3QDt6sGjw4p+Ht3E6HXqm1TGG01uT4PZkCPiFSdqT3jHeIZO8x/",
-	"S6yCoyh/fWJg29K5Du+krK3vvO1FoJ0Z2LNPZ3HtfyC5cph2M58P2WmmJnMp/oC07IBGwkTqDm/dZqiA",
-	"/wN4yke1zciC50BdsLqefReBDNct9JHKnXUJftGhat4hV3iaT+y30XsqDaL97lcbqHR6cbcJfQ/V2PGk",
-	"GUjTw8zwwEZu4VjLx7u7UW5PqM1r0Yg8S5/zOFD0xI5fn3MHcye4tqC3M5oqdGTeiwamaPsbjnlaEN/Z",
-	"b5AKqRns7CSKZQhtmU32V4KsrUfdVMkHvv3stINfffUjDykuft6Nra9KoURimIrfUo5+hNjPckDXW4H1",
-	"wzC9boXEBJ8q7UOYQ8ZWSWX41dWbPOt6fuVswWxJ8UoBoXPt8jy6gWxReUtFrpp3yEXiUHM+J6fj+sz6",
-	"3cjZDVNsVgC2eGRbzKjCCzr4RIQuZnnA9VJh88cDmi8rnkvI9VJZxCpBwvscRc/gCTsDfQvAySm2e/Ql",
-	"uY8Ow4rdwIP0BeOEtdGzR1+Ot1XORoxjkfhtTD5HLu8DGdKUjV7VdgzDVt2o6ciEuQT4A/rvky3ny3Yd",
-	"crqwpbuCdp+uFeXUICQF02oHTLYv7i+6crTwwq11BpSWYkOYTs8PmhqO1RNNbhiiBYNkYrVieuU8RZVY",
-	"GQqry5DbSf1wWF/Pl0HzcPmP6IJdJt74n+C5RVc9EY7oVf8T2ttjtI4JtRlbC1bHX/gKteTcZ6bGunCh",
-	"HJzFjZnLLB3lVQzHmJNSMq5Ra1Tp+eQL83yXNDMMcdoH7mT2+ZNEfbVmCSK+H+AfHe8SFMibNOplD9l7",
-	"Kcf1Jfe54JOV4Sj5gzqlQ3Qqe33F0/69fW7HPUPfWbo24056CbBqECCNuPmdSJFvGfCOxBnWsxeF7r2y",
-	"j06rlUwTDK3MDv38+oWTRFZCpipd1AzASSUStGRwg/Gl6U0yY95xL2QxaBfuAv2n9W7zYmkkuvnTnXws",
-	"RFblxDstpFUykv4vP9b58dG4beN2W9pLIRN6Wqdx/MhuqfvpC9s2dOsOiN96MDcYbThKFys94R42niP0",
-	"+RT+Xm2Q7J43VKWPfiPSvONR1n/4EIF++HDsROXfHjc/W/b+8OFwl9m0vtD8mkDNYXdNO3ul6Zva6q9E",
-	"Qnvnq3gGvzGXqiShYU3eZeZKnbkxxqRZKvHjyx3HiVfc2w05fYA8avBzGzefmL/iZtYRMP38oVk9Nkk+",
-	"efgexVBQ8pVYDyWi1rXl6elPgKIelAzUCuJKOtVxk54SO918IrI1o86gEOalGhfAGuy18hfaBYOa8Za9",
-	"qFiR/1JboVs3k6Q8Wyadymem46/2GRA1iDQY2ZJyDkWyt30t/+pf1Yl3/z9Fz7ArxtOf2oWYLewtSGuw",
-	"mkD4Kf34BldMF2aCGEXNhFwhxUmxEDnBeerKJTVr7FY0T1WSTcT447CrSjuvZEye4AqKzFmBbrRpezi2",
-	"nEiqe7gqlv33Ja7MOFiFX1m1hB0dJKFshde2oquyADyENyDpArsKDq3umLENR47KkhBVmk/YEpO/CKIr",
-	"yYmYz6NlANdMQrEZk5IqZQc5NcuCNc49evbo9PR0mJER8TVg7RavfuEv68U9OsEm9our/GULJuwF/iHQ",
-	"v6+pbp/N7xKXK7/6ewVKp1gsfrAB2WghNve6Lb0aygRPyXeYn8wQeqNEACpFfYblZk7QqiwEzceYFPry",
-	"m7MXxM5q+0hA1GHp1wVqAJtHJGnkGZ4j1edf68ldNXyc7alzzKqVnoSirKlMiqZFXUuWtbyfUDcYY2dK",
-	"nlu1bHDssZMQTC0uV5BHNWCtGgCJw/xHa5otUd85HW1VKfdUAxpewthzwNpcFMW9hoJZyMHNMlwVY1vE",
-	"eEyEXoK8ZQow7wTcQDNhY8h26hTyPoFjc7Wy4twSznQP6TWUx9p3FzxwVvT1/hVJyFr7cGfbX53JA4uc",
-	"71vs+QJ7peN2WpWjW34PtmTG2hfdmJIfnbEjo1xwlmGxiZQIjqkYh5lVB9TlSNs71cid5cQxTNarDgHq",
-	"Dou9Faw9y3SI6zo1RF/NflvCsX9qWLsigAvQyvFAyMe+fLwz0DGuwBVAM/QVc1QhE65fybCY4EJyRJf0",
-	"8QizqfXoWr81335yunnMGXPNOOrcHFLdS9Aa2ArF0M7OCdNkIUC51TbjwtQb02d6ueYIwtvpC7Fg2QVb",
-	"4BjWFdEgxXoBd4c68z7BzgfXtP3atHW1C8LPDZc6O6lf99skC1Fh/1M113vRn/L98o40EXLD+PFoW4hx",
-	"q6s/3suGDOEGPf+gxPu8QzahfH1zlG/Mk9XSG7YgNnI3mTaY8QQYLxj3Bt90HqwseZfgxuBp7umnMkm1",
-	"fXQM4niXQIuecBgMqrceA3cdql2JwaAE1+jn6N/GuvJ+D1sJDerXBeUb4g+Foe5IKPmaFsEZPlFHH6Uz",
-	"J4xZZ+FWZf0UWzFsfeJDcxvo2hkIGrpjNZR976m+bKOzKl+AntA8T+Wd+wq/EvzqAwphDVkVioCFONNm",
-	"uvYutbmJMsFVtdoyl29wx+lypqhSsJoVCdfb5+Ej5GGHMRHVbIP/pipg9e+Mc3rfO/rbe7jn+9Uo6Eaz",
-	"p6RnQ9MTxRaT4ZjAO+Xu6KinPozQ6/5HpXQf+P2niOtucbl4j1L87RtzccRpujs+/vZqCVm00Z9e4Hef",
-	"Dyxkcm1yJbzKOnXe0CMDNy+xZS3gfcMk4De06Mm4EFtt7P1qLRl9eRey3rQiVLvsdZqSmicMUWH05/+y",
-	"Htgty1DXvNnnY21drD+k8cThYyvS+y2NPzTsitbrrWYovfbEw0x+NRHsa/NzpRi6+lJaFCIbzBncMGem",
-	"U3+qXrFaucz3Ca+8m5XI47MQe3MBpBmbdVhOhFbgwzb5DZ9WyS/yNj1aQz8SiGZo1jJEo1vC2AZmevA8",
-	"MHbqeKJIZeswS75lBRaH+s+Llz+N+jcy2oHulrrU2UkVdt/GhEi1NnksRAMfW3iA4EVa/616VOqYGyp9",
-	"Glx14uSHb62CcAhINk/SPq1fDB28QwALYatCpepmdLPTjOrt8MiPqKHeXstRYupIUUW72lLi7WOVnnUT",
-	"EgqRDipM2pCRhhR3StURci8Fr4G1F43LR2eLK3XqMnUY6PMhwmEHH+/Ho/N8L/EpVYtqZEdJMdgXbLHU",
-	"XxUiu/4eaA7S1hNJPSdtNZEVmGeoWrIS3z+lUKyuB1yYwVwi7yUONx0amnO5BJcVxicJ6IzlHahvINNY",
-	"H7p2A5UAw/0cyvQSDQTeoIhNPoEriATIodTLrcKSde4u9bIuGwou8owpMgNnurgBPiZsCtN2sFpeJ4Ui",
-	"BdC5V8JKIfSAurohbAnRGAOdoq9OjebtYmAn51uU0tCW0p0OL8JyFmICbKDlLVV15qhWGoXB4drzOWSY",
-	"8H5r+r3/WgKP8rGNveoOYZlH2fhYCBfEkg1H1WjXsG5LhLcV1Kgm1YeEtC8hxjVs7inSoKFkReAQYXtI",
-	"BnhEjrXj+qICfaYN5xjJVKAnRJD3g3cJ+OsaS4cUAYiyUx4Ihqdxcz3VGSsPg8ZLNAeAYbpO71S0v06H",
-	"h4JpX3a/bnX1/pfycyxmr5xTKQ3p5mN9EjnvlmO+denqMdFisBb6xPWg/G8+QaudpWDXrkINIszaZm+p",
-	"zH2Lo6TJs/cmSwM9DzOzOjCq6+Wzr1+OjVDMCmEEoElfYGgzUim48N5T1te6TlqGUM9BSsiDTbAQCiZa",
-	"+DCrPZJ/uvDJLdizXuYH4a3l0b9HyLBdUW8Nhdd1IQksB0mxZgJ1zucxVoiEFTXQy6i4Q1oNumuHvrbf",
-	"fU4RX95vu3q1D+/hXOyukO1D75jqYD4+XXPihIO9uVcjEckBmlnGOciJN+K2SzvwZppMzKucV5kVVeKz",
-	"GbTXg9OObeFmSaVm1l1l6wkVZeW4hs2JVfv4quN+x2OgrQxpQY8SSreI4qi6apWCe3EU8D5t+s5SiGLS",
-	"Yxk879ajaB+Ga5ZdAyZmDZEpRgq+1zw2ZhJyHw1SwWfkdrnx1RbKEjjkD6aEnHEbHejdR5oVSFuT83t6",
-	"2/xrnDWvbIUZp4GeXvF0mBVWepF35H5+mC08r483KTD88o7z20EOmF2veZ+P3C2WhGnWCZ4OVW90/Tta",
-	"IlREfhaKlAB1YQ3BXyNLSLyjCGZnidIIoX8AJc6ATFQhUl74h2SQMUOlMRVPhgBp4AOeqzUUbvAkApyT",
-	"3Y6srO6zzzsq5kRC7ZtxaAJWl9PUMnHVpxppzxxmaXLGuZAQz4h+pjZRc4hswzzH+J8Z05LKzSFpUpuo",
-	"SqmherG801syOErWC6mdJbs4LApxO0G2NgnVlVLqANNONa9tX6e07meO+gwit0uqnIi4IUuak0xICVnc",
-	"Ix3ibaFaCQmTQqAXZsqxY67NI2GFcZ2cFGJBRJmJHGwhtDQF9c1VcU5R9oLIlS2JAks7mDLA9onoeOCU",
-	"5va15tkJyms7C234zb80fWz6ijr9nV30xLoI9MQXgHLp7hyGbOMuvEg4NiNTWymbFpHnbI10AzJ15OdE",
-	"ywrGxLVoV+F3B59KICumlAUl0NItKwrMHsHWkUND8AdKo7ZHdj5HP+gbhg5vzUwiVqQuze0Y0q/EPOAi",
-	"zshG9FKKarGM6gMEOP3TXVbuYR+P8rOq0CcRQ0TNFE/ISijtnsV2pHrJtQvo/UxwLUVRNBV5Vs5fOKPv",
-	"j3R9lmX6hRDXM5pdP8BHOBc6rDQf+5QKbd/deibZysE47KWg13yC5KF2p1m37dCr1dHzYN7Z4n4dw8Mu",
-	"TX4E5tvdzHW3XeOsu7D2upp8Nv0WOuOEarFiWfq4/bW8X3t9VlPcK5lp0VYhtllosBnygfgeC+5MyD27",
-	"aAZOk2VUz4jjEc6tAzmR+S+K8e1xyRwcD+q5Q7t8xwlYk6xXDGwBgJDaRAi6krZ0cSykBYYjFjZxCjql",
-	"tAEdeOGg79/dYDMjHB0oDXcCquONHAC8bzUYY5sR03o2z8Taf39Qp8w8CPj326m8wTz6nCovatKS1q3S",
-	"J7Lq4QjpAgRbPRAvMQnGbKgfYihFP/DyjwDo90xswDDIP3FfMOaUFZBPUlWKz4MObBw9112MZTS6r+do",
-	"OXlGK18J2IxdSXCJlaz0L5vmxJIaUhKheVcjznNYg43R+gOksHV8x5E5Cwpb5relURDlpIAbaDhsumxP",
-	"FUqh7AZ8XxU6kxygRItvW9GW8kSMqwS2tC9u7ZPIl20IdpPqGItYu1Nkh64lqRla84k9JmroUTIQ3bC8",
-	"og38qX1FjqYu0RzlBKo6z4eJf2IOneZnO8JrP8CZ758SZTwm3g7jQ3uzoDTqtjGgnZ7Jleo79TztmByn",
-	"MguGIpwtD3ZtS+I131AlveX9Ws0uydcvsYH7xASPEPvNGjKUatxTCHL3GOqxnLgcSEjtHCC3DwbTJaHN",
-	"XwInXEQ1j2+pCq+YOqur/8FOjI0Ydw/tA2z0tf/w3XeW4GBEtZItpkuUBrK+m47/k5zErQexd7wUjShw",
-	"obxbVGOeut2zAxuIqsgJN/tpZH+sEexuMcfFx2RW+YGKQtzaIsbxE/U5eHuupT5vYnJiOQvXsveTHruE",
-	"w20tCIsiRFZ0Q4TEf8yD9PeKFmy+QT5jwffdiFpSQ0LOgGy9KJzftZl4u3g19oB5RYzwU9l1s6FjRsNt",
-	"zCgR0OYi92XbBFnRa4i3AR1ELP/MtGGcqpqhUsNc2a3t7GLBLd6nZ1rRPFYCYKLZTYM7+ITnpvf/V4et",
-	"xlP5/I9lQTNfstoVn2vyGaxq74lLL2G1Pcy5y9c8CYRK+TXRSp8mIz9Am7on60rF/PQVx2qA3SkB3qkL",
-	"dqdlDFQKt2ocbQkQH7SUY+/CcWI4O0uKS/3uWlxc+fjj7E4yQ3TfMoaA/yfalYZ7RSeyLV1BPV6PLZb+",
-	"EXahkYgnAatVg8/EeiJhrnY50lg9+Eysa4BV0N0ynkmgyvodnb90z9Y6ATLj5hltvXaDWTWMksOc8ZrV",
-	"Ml5WOvEKwjzIfBMhLLYmIFp7bHN9MoYRRW9o8fIGpGR538aZ02NLA8dFerwFxfVNKEDCjdwdgKn6BYjx",
-	"1LV+Pm5mrn9bYND6zipNeU5lHjdnnGQgjdRAbulGHW6qClaHXcYqGslCzWwhkdkKSdsCUmyctfmOhqQA",
-	"ID2iRWmAJQidtBNWIKsY0qLH8NOF4S9hCVrR9aQQC4z67TkQLs81mg7tA1JwVKJb6W7Yuv08iv0B26fB",
-	"UiSOEWmBsw6ZYvu5f4lbiY/QnznTW0++1XC2w7Ctp7M9mB6pfFGHZ1hi6Z7HVOS8S8wUR897UdWnKfG0",
-	"B9EmJl2iO1r1nl1E/wqXdiFWoQ8vVtl04UjF51u9wgT1DWpLAAaoOq6AZs5DrKuI6ygqLFLGLrvBnno6",
-	"q93391IPeKhIUe6sN6cNDjpmnH0qfG7PZzApRTnJhvi22mpFuTMyOEibMPbQR2RC6Fl38LtRoX5XIyda",
-	"o5DXvkVOewuJ7bKVldk2lUGfkqmHozcNGGKOvAyPsFWtYaxVUMWM/ePcG7ubSrTAJAglErJKopL5lm52",
-	"F37syT5/8f3Z00ePf3389HNiGpCcLUDVNQ1ahRNr10TG21qjj+uM2FmeTm+CzxZiEeetlz7sLWyKO2uW",
-	"26o6GXGnbOQ+2unEBZAKzu2WyDtor3CcOiziz7VdqUUefcdSKPjweyZFUaRrygS5KmF+Se1WZIAxL5AS",
-	"pGJKG0bYtJ8yXTtlqyUqFzFr+I3NDSV4Bl777KiA6R5frtRC+nx6kZ9hLgZncyKwLgvHq6ydaNu63DvN",
-	"6vdQaER3mxmQUpROtGdzkoIIY7ZkBUGv7tSmqE+P3HQDs7UOuylCdM7vadI74+4lLOZkO7dvluLWaU5v",
-	"NjEhXvhDeQBp9lk3+vOMHMJJasPAn4Z/JBKnHI1rhOV+CF6RfB9siQo/63hNhKQhg0DrJshIkAcC0BMP",
-	"3QhajYLsotzk0toY0Brhzc9t8ePH2iy9MzIFIfEddoAXxzLX7UIwhQPnEyf2/jEgJVrK2z5KaCx/V3i0",
-	"Z73hIom2yClNtAZl2ZLoioVRQLz6OsSZ97xKOuHoUghNzMu0KBJh7FaPg2cqJhzzJJA3tPj4XONbJpU+",
-	"Q3xA/ro/cCsOW46RbFGpjp6Q8wUdBFYUovxRoOKvMLb+v8DsbPJ2dLM4w3/nDkSVEC2st/c8WMCBk1sc",
-	"0zp2PfqczFy5n1JCxlTboeDWizQh3hYkmzv/WljrduzvncsE/SL0HY7D3PsDkZ8iI1vwHHAw10f9EzOn",
-	"Hg6QPC0pUu0QSgJ/KV4XF1Xfce3csTTMYamcosSNe6Zy6paLH7o8XAdeXpWC7joH3/oN3CYu/HptQ3OV",
-	"Da4wc3X1Rs+GJBRLV4Mx3THH2VHKwty9KMxHSXBmUenGcJAkCasWuXdlr2n5S0Z5Gpq7aMT9ngLyS4t+",
-	"Mxo+CuYVt+OFAqgYK+7ZupiPgxeD4KbbM3LFHxK1pP5t4f58/PTz0XgEvFqZxdffR+OR+/o29VLL18m4",
-	"0jqRTsdH1FUTuKdISTdDgtl3ps5J4rfOFPTxRRql2Sz9pvve7Bk+XF0AwjlHVo/sxd6gLn/O3wmAthJD",
-	"67CGE2NJsk4PFLZiV6agX/rS4tvU7z3VPlrct2LFTie5RiGW9+PRwiYpw+okv7padR932z0EPfkC3dLv",
-	"kgbMIiax1sbk0VRRUrcBBVlct0SFDIy8zirJ9ObC4N+r3dmv16lkUN+F9Ewu51ewwDvZV4tr4N7HrE7m",
-	"VCkvXX8naIHSp3UM4EbmFMWUfGMrhLhr8R/3Zv8On33xJD/97NG/z744fXqawZOnX56e0i+f0EdffvYI",
-	"Hn/x9MkpPJp//uXscf74yePZk8dPPn/6ZfbZk0ezJ59/+e/3DKUbkC2gvvLPs9H/mpwVCzE5e3U+uTTA",
-	"1jihJfsBzN6ghm2OCQoRqRlesbCirBg98z/9//6inGZiVQ/vfx25epCjpdalenZycnt7O427nCwwB8pE",
-	"iypbnvh5MJdl473y6jzEBVnfP9zR2uaEmxry+5lvr7+5uCRnr86nNcGMno1Op6fTR5hPsQROSzZ6NvoM",
-	"f8LTs8R9P8Es2ifKFeM5qUNHk9b+1xgm45/0cgE5uR+CAP8t+HuoBz6WcO6yUP5TWWIMqzjPkbhc3fQR",
-	"1n1FB1AE6/Hpqd8L966JxMsTjDh79m5k+UcqHW4HqZc1wEnI6qrT3UX/zK+5uOUEU/7aA1StVlRu7Aoa",
-	"2IgGx22iC4WmOcluMDOj6d3GeVm6Ekh9KMeqms1T7jsjgYT6OOaE2bI5rpCRSqG8W37pjtjfmgK6M1li",
-	"d7DRKwOzT3MW0ia7m9DhDD1NLMLCGbHKyg6ix6OySqDzGwzmU9twNo5K9lhoRJEHjHcw+qr6fwSjhnQX",
-	"If2v+WsJtEDRyPyxMoSa+U8SaL5x/1e3dLEAOXXrND/dPD7xOoeTdy6f1Ptt305iL9STd42kXPmOnt6P",
-	"cleTk3cuT9WOAWOzyInzb486DAR0W7OTGdbbHNoU4tX1LwVpXp28Q91c7+8nTk5Pf0T1qb1hT/zjo6el",
-	"zSGU/thA4Tu9NgvZPpxpE42XUZ0tq/LkHf4HyTZakc3ff6LX/ATdzU7eNRDhPncQ0fy97h63wLTTHjgx",
-	"nytk2ts+n7yz/0YTwboEyVbAbclx96vNZnuC5a033Z83PEv+2F1HI2nnjsscs8Qq74PZzPWZvD7aCUTV",
-	"XZndsCRc7bSlXQG7K0ltW9n78ejJEblysx5AApivaE58nhWc+9HHm/uc20gSI1paERghePLxIGhsH/kB",
-	"NuQnocm3Xof/9GPuxDk3L0daeIHuQNFv2PFpX6NG9g7N+MIKKsLm3mketbM87xC9fUOC0l8JvF37MLZS",
-	"i9L5dtRIq5/QjJsljIeJzd0MwDYFpBckuMhhFD9utazg/R15QssrlEp9nrA2oUUVg8uc1aYBajIxbdtn",
-	"zo6cyLS+g4TPn/tJ65isv3nK3zwl8JSnp599vOkvQN6wDMglrEohqWTFhvzMQ7DfwTzuLM+TOcCbR38n",
-	"jxuP1pNM5LAAPnEMbDIT+cbV3Rs1JrgGqy3rCDInXrvUeDH0cE+vt0pJK3UQyejZm5QzlQupLqtZwTKz",
-	"4KnXLZVULyPVT0iH3OR+45iTBUXlm7PJ/5xOvnz77ukX75Mx1N1wqjoOcevXRC0ZkrOiCllp9K1wWR+6",
-	"l1SkwdGCqN8lXmZ4uJnekFvGc3H7IGDg9wrw7nAo8NOMxqmbZkvRi26xxdqZwYDcAbQPAvSC2LoFg6xg",
-	"/Q4GW751698etoaCfqolvP3QmreQ4PQ/L17+FMV9W/2KdZ7EqGN7YDHISwoMXrql6D1vy09/bTVfxQbz",
-	"F2iqK9UobDv9+/b9+8a7+433XUjVb+vWaiw52WWa0Q04HSTmJ2+0d40/nbZmZENXUqnyze+EkgVWJ+9e",
-	"y7MNOX/eebPbbu2L8KsNNm3dhYlLrg3iVj7VZgc97GWbIGcWshA6BPDYRf0tWv8tWt/puT748Ax5sSf1",
-	"ad/hwLTzCh378v+NIEksd4EuAh1QhmjdPunxPcrGdzV6KQ2eLcsBOYk+2DxAbTT/zSL+ZhF3YxHfQeIw",
-	"4ql1TCNBdPtp+IYyDExalzfc0b3U4ZtXBZVR8oRdivszHDH9AP4gXONjqymTuLJaSoyxYja4ILGBx9Vc",
-	"/s3y/mZ5fx2Wd7ab0TQFkzvr+q5hs6Jl0PCpZaVzcRv5BSAsNjCoa9m0D//23ye3lOnJXEhXNY7ONchu",
-	"Zw20QGQzTOcc/1qX++58wRrm0Y9x2s/krye0aaptugsY1tvXseNLkPrqzOU9jXy+Gf+59lSMPf+Q7Qef",
-	"vzdvDctWIG/8jVA7sj07OcH0ZUuh9AlqvJpObvHHt4E83oV7xJHJe6QLIdmCcVpMnEfIpHZWezw9Hb3/",
-	"vwEAAP//CeiNZR0iAQA=",
+	"H4sIAAAAAAAC/+y9e5PbtpIo/lVQ2q3yYyWN7djZE2+d2t84zmM2TuzyTLK/vbFvApGQhDMUwAOAM6Pj",
+	"O9/9FroBECRBitLI9knd/OWxiEej0Wg0+vlhkslNKQUTRk+ef5iUVNENM0zB/+iCz8y2ZPbvnOlM8dJw",
+	"KSbPJ6eCnL44I/bjlOgqWxOqyb37FRfmy6dTmueKaf3g3pRIRaggp7/82G57+suP50Zxsbo3JUaSnGUy",
+	"Z8SsGVnIG3JFi4oRqufkYs3cx9z9yjVRzFRKsNyO5D7O4ON8Mp1wC+DfK6a2k+lE0A2bPK9XMp3obM02",
+	"1C4JlzbRAMbk9nY6cYAn10uzTFbCkLJaFDwjl2wbJiupWUdzuUGmE8X+XnHF8slzoyoWT11SY5iyfX89",
+	"nf2vR7Ov3n949pfbybQF03RyM1tJtweTBdU80/NTN/7trq+0LAueUbuEGc/Ti6qbEJ4zYfiSM9W3sOZ4",
+	"Q+vbcME31Wby/FFYEheGrZjqWVNZnomc3Uxud36mWjPTux77ccRK/BhHXYMddHAVjQYZNdm6lFyYxEoI",
+	"fCX4ObmEqPvQIpZSbahpt4/ID2jv8fTxo9t/CaT4ePrsizQx0mIlFRX5LIz7dRiXnLuDtEdD/7WNgK+l",
+	"WPJVpZgm12tm1kwBa1BMl1JoRuTibywzhGvyX+evf7Jc5kemNV2xNzS7JEwAS5iTsyUR0pBSySues3xK",
+	"crakVWE0MRJ69vELB1eMSSYsLfw6+ZuWYjKdbPSqpNnl5P00wUcKvuGJVf1IbyxFEVFtFkwRubQL8uAg",
+	"T+sDCEdMcK80SSIjntz2/bqhN13wLlQlMmpYHgFoFBWaZrYFQJlzXRZ0C6jd0Ju/Ppo6wDWhRUFKJnIu",
+	"VsTcCN23FDv30RYi2E0C0fbSsF9ISVcswvOc/KzxkoGvRl4yEaiDLLbwqVTsistKh04964Cph+8TJSuR",
+	"YlQEPjg09/Ao7HtMBvUWRrwd/qaZ1r0XBtF8UxV4XbiGu5ltNOLQarrY03zVI36c89XFtmRkyQvDlCZ/",
+	"q7QJZ6nSQIFrRnTJMgtZDsKHpQPNV4KaSrHn78RD+z8yI+eGipyq3P6ywZ9+rArDz/nK/lTgT6/kimfn",
+	"fNVDDAHWFMvQ0G2D/9jx0lzD3CSx/krKy6qMF5TFx9KS7dnLPiLFMfvxnObVp0GEAVJxY13cnL2c3B7S",
+	"w9yEjewBshd3JbUNL9lWMQstzZbwz80SqJwu1T8mKOnY3qZcTqaT9SKFX3sc3fUBAt4pynOntVDz1n22",
+	"XzMpDMOrORJ7ToD5P/8QS3JKlkwZjoPSspwVMqPFTBtqYKR/VWw5eT75l5Na0j7B7vokmvyV7XUOnaxw",
+	"oJhlxDNalnuM8cZK7yD69TAeyxfhE1lKRa7XPFsTs+aacIE7CQfacr6CXVFh5pO9OMttfL5/dUDUW4GX",
+	"Nm5Fi7H07gXBhgum4QA4IfyebkiugHECGCdU5GRVyEX44f5pWdbIhe+nZYmomhK+JIyDfMFuuDb6AWCG",
+	"1ictnufs5Zx8F499zYuCSFFsyYLVjxK+dPeIu1fcg8AiFtZQj3hPE9hpqeZ21zwatGbmGMQIUu5aFvZK",
+	"3klGtvH3rm1Mgfb3UZ3/8NQXo72f7mwr4pAK1IS/1C9ncr9FVF2agh6Wmk7bfQ+jKDvKAC3psxrBx6Yr",
+	"+IUbttE7iSSCKCI0tz1UKbr1Et0MJLMuBf2sGRJPSVdcALRT+0AQZEMvcT8k4N0SAtNB8kcyg0HJNTfr",
+	"WgQMqJ933jt/bEJO7TmxG0650ISSgmtjJSLYTE3WrAABmAZFR0xFBxHNCFoYWESA+VrREsncfUFhjgtC",
+	"w3sQYb3jTT7ykk3CXH+OaQCgOpiZ72S4SUg0KECaMLwoZHb5PdXrIxz+hR+reyxgGrJmNGeKrKleJ85U",
+	"i7br0cbQt20INEsW0VTzsMRXcqWPsMRC7sPVyvJrWhR26i43a60WBh51kIuC2MaEbbixD3Iu4ASs+BUT",
+	"yHrm5BtqVallSTJaFNNaTyLLWcGuWEGkIlwIpqbErKmpDz+M7F9LcI40s3zQMBKtxulYQAOr2FIqeDgr",
+	"RjYULqeNfSOVRbNPYK6ablhLdoLLUlaGqcbz5eylXx27YgJ4UhgawA9rBAVEPPicnIZPMLOQuDiqGCh+",
+	"uMiKKq/xF/hFA2jbur5qRT2FVDkonqixv3FFMqlwCLz83eT2D0ZV3Rmp836p2MwNoegVU5oWdnWtRT0I",
+	"5Hus07njZObU0OhkOipMP+uQc0A/EAqZSmhbXsMftCD2sxVwLCXV1MNBTgGZJuwH3NkWVTiTbaCZsfu7",
+	"QT0escq1vaD8up48zWZGnbxvUHXottAtIuzQxQ3P9bG2CQbr26vmCUEdlGdHHTFlkOlEc41BwIUsCbKP",
+	"FgjIKWA0RIi8Ofq19kLepGB6IW86V5q8YUfZCXmDf4xi9i/kzUsHmVS7MQ9jj0G6XaCgG6bhdmuYZews",
+	"ter8dCHVYdJEx1RSGwQItaNGwtS0hSRoWpUzdzYT6nps0BqIBB3TsBDQHj6FsQYWzg39CFjQhkbA3wEL",
+	"zYGOjQW5KXnBjkD666QQt6CaffGEnH9/+uzxk9+ePPvSkmSp5ErRDVlsDdPkvlP2EW22BXuQfDiBdJEe",
+	"/cun3kDTHDc1jpaVytiGlt2h0PCDD2NsRmy7LtaaaIZVBwBHcURmrzZEO3mL/W6nk5dsUa3OmTH2EfxG",
+	"yeXRuWFnhhR00OhNqaxgoZtGMictneS2yQm7MYqelNCSiRxoHtbBNdWabRZHIaq+jc/rWXLiMJqznYdi",
+	"322qp9nGW6W2qjqG5oMpJVXyCi6VNDKTxczKeVwmdBdvXAviWvjtKtu/I7Tkmmpi5waDXCXyHhWFtbSN",
+	"vr9w6IsbUeNm8AbD9SZW5+Ydsy9N5NevkJKpmbkRBKizoTlZKrkhlOTQEWSN75hB+Ytv2Lmhm/L1cnkc",
+	"HamEgRIqHr5h2s5EsAXhgmiWSZHrndocb51sIdNNNQZnbWx5g5bph8qh6XwrMlAjHeMs92u/nOmR6K3I",
+	"IlWYhbFg+YqpnUg6ksqrD1MIxT2dgNRi6hV8BovAS1YY+q1UF7W4+52SVXl0dt6ec+xyqFuMsznktq/X",
+	"KHOxKlhDUl9Z2OepNX6WBX0dlA64BoAeiPUVX61N9L58o+RHuEOTs6QAhQ+oXCpsn66K6SeZW+ZjKn0E",
+	"0bMerOaIlm5jPkgXsjKEEiFzBptf6bRQ2uNFZA9qVinFhInlXNBncE0WzFJXRiu7Wmtglqn7pe44oxme",
+	"0BmgRqcnrF1HsBVOt6ZXjNBCMZpb5RETRC7somuvC1gk1aSkynixzonEY/ltA9hSyYxpbS1YqDbeCa9v",
+	"h/ePGUAerAZWEWYhWpIlVR9nBZdXO4G/ZFv0gNTk/g+/6Af/LIsw0tBixxZAm9RGtNV33aXcAaYhIm5D",
+	"FJMyagvxJBAj4WVQMMP6kH137PVufxvMDhF8JAReMQVuNR/1aPlJPgJRBvg/8sH6KEuoypkVA3vVD1Zy",
+	"tfstqJBeNtwxQ5igoNrMdl0ptlG8aG2XGnHx1C0CA/fIk6+oNiAGEi5y0N/iVQjzQB+YYrKnkxtM2fsa",
+	"s5P+4h9i3WkzKTQTutLhVaarspTKsDy1PLBZ9871E7sJc8llNHZ4+hlJKs12jdyHwGh8h0dcCeKOmmCh",
+	"djbv7uLA68CKL9t9sdyAr8bREIznvlWE+NjJtwdGrus9QHLjukVvCykLRkFlqo0sS8uhzKwSoV8fBs+x",
+	"9an5uW7bJUk0A8GcJJdMg4nJtXeQXyPSNdi61lQTB4f3TwCFF/rJdWG2x3qmucjYbOi8wCPYtooPzkHH",
+	"vSpXiuZslrOCbhPeFviZ4Oc9CcOPDQRS6w+kYbMFWBPTNFKfCe//etisEqZKcPefJIEvJLPn3D6jalJz",
+	"vQ+fNGcwbYpvOmK9F2YBMJJ04McDZCE9JUaEu/9KGktW2AhX426lO66lB3th1o+CQBh3VisC2rP/D9Nu",
+	"bt/muPNvme5beD31sZbdo/6Hu71xYbaustZtk7wievnyDsbYx4N6bBFvqDI84yU8V39g26O/3tsTJH0l",
+	"SM4M5VavHH3Al3wZ9yfoi9we87DX/Ch1axf8jr41sRzvmdUE/pJtQW3yBiMsIm3VMdQRiVEJ12CKtIB6",
+	"13n74ombsBuamWJLKAgcW3LNFCO6WqDXSteEZmQ5iwdIx3D1z+gM8klz+KCHwDkMFS0v5XmIr61h+C5a",
+	"T64GOtwrq5SySOg/2ye+g4wkBKPchUgp7a5zWhRbYkIYj6ekBpDugii2Hlx3LcVohhWQ/5EVyaiAF25l",
+	"WBDSpALJx/aFGbiO5nSuqjWGWME2DF/z8OXhw/bCHz50e841WbJrdLkR0LCNjocPQRX3RmrTOFxH0Hbb",
+	"43aWuHTAVmkvWfdqa/OU3U5ubuQxO/mmNbifFM4UhNH45d+ZAbRO5s2Ytcc0Ms7Bz9yMXPlF0yWss27Y",
+	"93MMPzqGoZJd0WImr5hSPGc7Ofl5iHv65ooWr0O32+mE3bDM0mjGZhlELY4ci13YPhjoaMfhghvuA0fG",
+	"AsTOsNc5dtrx0q79lvlmw3JODSu2pFQsYzkaTriOQrzmBIYl2ZqKFbyAlKxWztUZxwGGX2nUhFmrZXuI",
+	"fUUxcyNmYMLQybA5MFv66E8rhDFqX7Zt+wc+1q5pAIXljStj5Pa07UFJk+l00vvwt/i+qh/+iLdmCOuh",
+	"xsSGfBghrYZmpPUM8GllpS4S422sD599wWNE38c1Mdo9CAogzw5wYswKgCGcbaijLQ++nNgLNLf22Ffx",
+	"RxyfLg1ThJu96XUoXNICWUdHtteQNOZ7+256MDRJxUZgYoYxNY0sxATEevjKSpmt5yP1BEnb7LQZ1lkD",
+	"PorXr5kzZgLldYNKkd5si49jFayHToHXnTgKQqg/9sUhWP1WsT2CUI4DEcVKxbSFv6F21vhVLsmPPFPy",
+	"tFjJIGPprTZs0zUWYtffek7d20M0LlIUXLDZRgqWUCG9hq8/wsfRam4U+3pGBAF8rwHbD+0GEloLaE4+",
+	"hpbvuklAMu27pm1Z199KdSyvDhxw9Bt2hKfETjciN+Wh/hzWxb7rAoHqri7/n4YgBK4I1VpmHPj9Wa6n",
+	"eFqd1wSGUbTQ/yaE4h3hALfHbdn6o7A/NByxoiSUZAUHs5IU2qgqM+8EBc1ytNSEc6pXRvWbIb72TdJ2",
+	"j4RZwg31TlBwTA765uTdtWQJvee3jHlrhK5WK6ZN60G/ZOydcK24IJXgBuba2OMyw/NSMgUeonNsaeNP",
+	"lpYmjCT/YEqSRWWaT9xNpQ3Rxho10PHATkPk8p2ghhSMakN+5NYNzg7n/Zb8kRXMXEt1GbAwH8+4Vkww",
+	"zfUs7Vn7HX6FICaHk7ULaLJ/u87ew77OjTKxa28kbfnf9//zuU3WQmf/eDT76t9O3n94evvgYefHJ7d/",
+	"/ev/af70xe1fH/znv6a2z8PO817Iz146ndDZS3j4R3FJbdj/GQyAGy5mSaKMHdhatEjuQ74YR3APmnpm",
+	"s2bvhHVZNNKmouI5NUckn/Y11TnQeMRaVNbYuJba2CNgz+f3HVgVSXCqFn/9KPJce4JBB694y1sxLY4z",
+	"6qMD6AZOwdWeM+XGfe+7by7IiSMEfQ+IxQ0dpbJIvJjxQ9OrzO5SHEj4TrwTL9kS9A9SPH8ncmroCZ6m",
+	"k0oz9YIWVGRsvpLkuQ/CfUkNfSc611BvArUoiD7KoJbiFHSTXsu7d79ave67d+87fi9d2cpNFXNRd866",
+	"alk/5czKDbIyM5fEaKbYNVUp25vPK4Mbhb0H4UCZRFaoNHXjEzf+fCyUZanbyUW6KCrLwqIoIlXt8mPY",
+	"bSXayBCoyHWI9bY08JN0TkyKXnsVS6WZJr9vaPkrF+Y9mb2rHj36gpFGSo3fHQ+0dLst2WhFS2/yk7Z+",
+	"BRaOcjkEMcxKukrZ6N69+9UwWgKFgMCxgfdlURDoFuMkRJ7AUPUCPD722RKEbO84cljuOfbyae3Si4JP",
+	"sKnNWP077WCUheHgDdyRyYFWZj2zHCG5Km2Pgd8rxzcIXVEutPdY0XwFDwC9lpVdslVFsuzSZXZjm9Js",
+	"p43uctm4iz3D4Rp0lC4Ydckt/jIq7IBVmXttEBXbdl4ljcE3MOhbdsm2FxK7z0dmx4uyMUYpfXTf0QXa",
+	"je5aS77xQXZjtDff+fn5mGSX/gbifD1ZPA904fv0H20UAI5wrFNE0cgr04cIqhKIgA59KDhgoXa8O5F+",
+	"anlcZEwYfsVmrOArvigSbPq/u3Y0D6ulSsUyxq+8ti8MqK1pjRtNFngduxeTomLFCAXHmVJqWoB+cJ50",
+	"LAHpcM2oMgtGzaB9QMRpTTx0tj+5ticLlSaQ3pXd2P3mBpQggl2z3L29sY1zXJ8f5L6Ha2L5gaD67nVQ",
+	"/vyQR4RDeCKfo7/vw56E94Lzh4yp82Idvm8sDldKXtvdtABKn7oUEgpF91Sl6YqNvY4apsmRKVgaFkcY",
+	"ZJf0k5R3rL9CU6zpyBgjF4HdZxYvSe7A7BfLHsDs1HKp9XOjydpZsV7b1AMOqYsCBOrgkIykQ1XDritW",
+	"+wGbZmNMiVpY9YA1sRYf/TXV/ujn04ijHygtfp7URUNJG88ib09quikZ/TXdZu1T1OcsGJHC9vCpG32+",
+	"Rp+kcTLdK+HidIKcKbl3UoAUnbOCrRAn2NjTWZ0PrN5NC8fr5RKY3izlOBopIyPJxM3B7EPsISGoMSej",
+	"R0idgghs8OSAgclPMj7sYrUPkMLlM6N+bLi7ov+ztD0Loz+slCxLe+vzHitp5lmKS6dSizwtl3oYhnAx",
+	"JZaTXtGCCeMDnetBOrkB4e3TygTofIke9L2JRh40t0aQTvZaJfQ4aH2x4O2XkX4V7LWGhbyZYSR+8mm1",
+	"uFnYM5GMj7G9kocXMzXe05DV3fqwwQ2HARV7Q9cPmQesBgky71n8QL8+sRHB2w+QYUE+Rc2a3A9idU12",
+	"fZLsYcD0iNN9ZHc/Stl4JJBaCsw6Db7T6OzUszSlra4kUl+309oK7cMiU6ym73Amd7IHo13laTO34vd1",
+	"es3+ZHyu0adJKtlVyt0lDyh2BkD0XmlA2+TQAGIAq2/aQmwSrY1WLbxGWEuxJMJFwtjVRZtmBQNNwKwh",
+	"V88u2Tat0GAgM5z7bpGeE3aPiu2DyPtSsRXXhtXGBe9U9eltP6BOtI8tuexfnSnV0q7vrZRB0ICOBDo2",
+	"lvnJVwChEkuurJ+8tcwkl2AbfatBk/atbZoWhBubTbhGU8/ecjBAZIMHc15UaVJ2IP3w0kL0U7i5dLWA",
+	"i5IL9G5bQCmIpEP4HrZJgAcDCQYR9AoR9Ip+CvyMO1i2qYVJWcprTv8HOWItXjjEWRK0nCKm7ob2onSA",
+	"10a5G7qMNhKiI7eL+ZDNp3Mucz/2Tm8sn0GiT4jAkZJriTJwpj0J5WplQ/AwsZYLQqYipGAktJBiVeeu",
+	"tL8PpKuc21oA2iV9HMgX6cIhWF8wRKOcDlSFSUIfNUPI62hOyHUJk6yYwExBk/3r7RRytSMQA1pEmtFP",
+	"y9s7YRpJV/WLlnt67UOOexg2G7anYDR3zyrN/PqGD213uxzqpn1O7o2UxMMHDAYEiuNGRwJMh2h6ODct",
+	"S57ftAx/OOr8AJIYKe51Kw+0cAZsyQ22Az9NR/YdtaruaeLc5Z2x4wSe+Sf2kYn+884D3J4NmrnsFnml",
+	"wJrU8E7v1m8ID82Ra//hl3MjFV0xZxGcIUh3GgKWsw8aohIImhiODvk5Xy5ZbAnTh1hxGsB17B35CMLu",
+	"IcGuuSy8LQfps0tkO2irXsFuhKbpKUEpfT4XF117pGsb69bCZRNt3AFGxWQCix/YdvYLlMorKVe69k11",
+	"BsLmtb4HTVxtfmBbGHmny6cFbMeugCruLQMKTVlXwicdZaW/p2OM4Ru4sYV77NRpepeOtDWudEv/0ahv",
+	"qHhFraV8vGNTu8hYSMfs1Xna68SeLdbcljah79qivuiJqFP8BImn4uC9ccglFzK77PQuY7TwhA+LndxO",
+	"J3fz90jdk27EHTvxJlzNyV0Ab0y0/zecvvbcEFrayhm0mDk/mT6hQ8krJ3RAc+9W84nfV+lTcfHN6as3",
+	"DnzreFAwqmZB1dG7KmhX/mFWhSVfhq8hTP/vdLuoCos2P6Rojz1priHVf0ub1qmtVPtN1eN5z5pl2lN8",
+	"J990Ll64xAFXL1YGT6/aIg2dW85d9Irywht+PbRjtey43HHVvJJ8Ih7gzk5ikfffncfqjROwGheP2dqe",
+	"go5SoQRDwpdOH+jp3OE16bNa0/oODgnrfA2Zc9PvLuHy6gJjdA5n9Ohy4LdSNS4qF0WbdFj7eAKifUwg",
+	"HtNG+Qtnhe+IhXOCIuTvq98J1+Thw/jgP3w4Jb8X7kMEIPy+cL/DO+rhwy7QePemWRZo8gTdsAchLqJ3",
+	"Iz6tGkKw63HiwunVJsjIsp8MA4Wi55lH97XD3rXiDp+5+8Va2u1P8zGqinjTEd0xMGNO0HlfVGJwft5g",
+	"OVtNpGgxC4zKtqQFV4+rGIN29u4REtUG7M4zXfAs7fQjFtqyJIEuvbYxgcajbch2jor3+JWLikej22b6",
+	"IJNnayHRrEmE62Tm6Rq/C+lYQCX436tGLLG9iVuXs38KwagdATutX3QDt6tmTw4peH13E6HXqg0pjAZN",
+	"ri+DGdAjIlXXbM94h3jGDvMfiFVwFOWvTwhsWzvX4Z2UNfjOGy6C7szAnn06i2v/A8nVYMXNfDlmp7me",
+	"LZX8B0vLDmAkTKSKcYDAgw16p3xU24wseA7UBdvr2XcRyHjdQh+p3FmX4BcdqjQecoWn+cR+G72n0iDa",
+	"7361gU6ns59O4kOehhs/kmYgTQ8zgwMbuYVD7Sjv7kYFnlDMo9KIPEuf86iFPsHx63PuYG7velbQ6wXN",
+	"LtPvRQtTtP0Nxzwjie/sN0iHVCA4O4liGUJbjsklS6Zq61E3NfeBbz+cdvSrr37k2Y6N590UfVUKLRPD",
+	"VOKaCsO8LwtyQNdbM/TDsL2upYKEsjrtQ5izjG+SyvB3737Ns67nV85XHEvqV5q5zB7oFQkDEcxaC1Tk",
+	"qtmH3DcONWdL8mhan1m/Gzm/4tq69EOLx9hiQTVc0MEnInSxy2PCrDU0fzKi+boSuWK5WWtErJYkvM9B",
+	"9AyesAtmrhkT5BG0e/wVuQ8Ow5pfsQfpC8YJa5Pnj7+aDlWOB4wvaVWYISafA5f3gQxpygavahzDslU3",
+	"ajoyYakY+wfrv08Gzhd2HXO6oKW7gnafrg0V1CIkBdNmB0zYF/YXXDlaeBHQKGfaKLltZp2J5meGWo7V",
+	"E01uGSKCQTK52XCzcZ6iWm4shdW173FSPxzmzkH6CHD5j+CCXSbe+J/huUU3aXqg4FX/E9jbY7ROCcUM",
+	"wQWv4y98RWRy5jOhQx3CUH4QcWPnsksHedVuIZS84sKA1qgyy9lf7PNd0cwyxHkfuLPFl08T9fyaJa/E",
+	"foB/crwrppm6SqNe9ZC9l3JcXxtEL2Ybbpn/gzqlQ3Qqe33Fk9OaPrfjnqHvLF3bcWe9BFg1CJBG3PxO",
+	"pCgGBrwjcYb17EWhe6/sk9NqpdIEQyu7Qz+/feUkkY1UqcoqNQNwUoliRnF2xfLeTbJj3nEvVDFqF+4C",
+	"/ef1bvNiaSS6+dOdfCxEVuXEOy2kVbKS/i8/1vUYwLiNcbst7aVUCT2t0zh+YrfU/fSFbRs6ugPCtx7M",
+	"jUYbjNLFSk+4B/xc9/kc/l5tkHDPG6rSx78TZd/xIOs/fAhAW40pNv39SfMzsveHD8e7zKb1hfbXBGoO",
+	"u2taOw59U1ttC+M+/9BTNTb4jblUJd1tzhkwlVp/3U6NwvBBtiR0wQERkDwTsoSgwGgV74hXNxbmfHZx",
+	"fJBYyxbknJOLqiwY1tQGzxCvEuEqZAKeogLXfaYKX0UR77PrcW9JNxQ+FblyxVRBfp2TU7GFWRGyKWGb",
+	"BcsdbFzPWxtrM7bM39LrH12B1ejrTF/yciZdHesZvBCZmjw3qmK9sqhHi/06bS3h04ttxwn33NuLO01Q",
+	"DYpp4+YzX0+wmXUAUT97bRZ7Tp6+PHyPQlAoeSFvumcwTUStW9/T0z8BinpQMlKpCivpFLNOOprs9JKK",
+	"yNaOumDWXVs36tWNdvr5A+2CRc10YC8qXuS/1Eb81sWuqMjWSZ/8he34G76iogaRAsiaqgUrkr1R2fCb",
+	"V0ok1CZ/kz3DbrhIf2ot3MHegrQGqwmEn9KPb3HFTWEniFHUzGcWMsQUK5kTmKcuNFSzxvkkgfhuWeYO",
+	"PeGwm8o4p27IPeHq/yx5Yf/qcSeAljNFTQ9XVS5rbhiRXTFrroRLGkdnilC+AalHU1ubDg7hFVN0BV2l",
+	"YK3ukPAORo6qCBFd2k/QEnLnSGIqJWzl2WgZTBiuWLGdkpJqjYM8sstiNzD35PnjR48ejbPRAr5GrB3x",
+	"6hf+ul7c4xNogl9coT6sb7IX+IdAf1tT3T6b3yUuVy357xXTJsVi4QPGs9vOcK9jpeRQ1XtOvoP0bpbQ",
+	"GxU9LDR1duxGStWqLCTNp5DD3bqYEZwV+ygGqINKzSsLf+uIJG1k41PM+vR1Pam/xo8znHkI02TPBnJs",
+	"v4IWdeln3nIeA9VqjJ05eYla7eAXhZMQqASgNiyPsnWjFgWIw/5hDM3WtoGcTwY18j3Fu8ZXHPccsLa2",
+	"RWHDV/4jCtZG+qLjWHN8SqRV8V9zmwR7TQ27Ys18lx4Mb8/w+S+bq1WVEEg48z2k11DNbt9d8MDBuME9",
+	"JQlZax/ubDqtE6HISmVs39rs59ArHfbUKvTechvBCjc3vkbOnPzobEUZFVLwDGrDpERw+y4aaZUeUUYn",
+	"bS7WE3eWE8cwWV4+xPc7LPYWnJ9OGojr+oREX+1+I+Hgfw1UELCP2BUz2vFAlk9Bv8cL5uybXGjm6hVa",
+	"+oo5qlQJz7lkVFHwwDmiR/90AsnoelTV39pvPznThj275JJjgQCHVPcSRPtkoTm4KQjCDVlJpt1qm2F1",
+	"+lfbZ35xIwCE9/NXcsWzc76CMdCT0yIFnai7Q516l2rnwmzbfm3bulIj4eeGRyJO6tf9PslCdNj/zidb",
+	"HqMP/SnXOe+HFCE3jB+PNkCMg5EScC9bMrTFKIg2rIT7vEM2TKnUw/MbLGFh6Q1aEAx8TiGl4CIBxisu",
+	"vL08nUYsS94lsDFwmnv66UxRk60bTGqXv3RPNBHkJMgujzFUa4MBJbBGP0f/Nl7cCFf1pYethAb164KK",
+	"LfGHwlJ3JJTYKOXgmw7CVFOtb6UzJ4yhrzUGKjvxLs1WLFuf+cjmBrp2xtGG7lC8aN97qi9Z66LKV8zY",
+	"tJ+ptH0v4CuBrz4e0xZQqkLNvhCm28x236U2N1Emha42A3P5BnecLueaas02iyLhufwyfGR52GFLadZE",
+	"Zv9NFazr3xkXM7B38LwPEMj3K/HQTQaQkp4tTc80X83GYwLulLujo576MEKv+x+V0n3c/D9FWHyLy8V7",
+	"lOJv39iLI85y3gmRwKslJCGHcASvIMfnZ0iE27I1UCTazpxu8xJb1gLeN0wCfkWLnoQVsdEL71dvsEin",
+	"rch6s7JQ45L/GUpqnjBGhdGfPg0d2FuGta51uM9FHT3UP6btyeFjEOn9htofGmZZdBqsGUqvOfYwi2lN",
+	"BPuaTF0li66+lBaFzEZzBjfMqe3Un+lYbjaucEDCqfFqI/P4LMTOcIylGRvPkz+7h23yGzytkl/UdXq0",
+	"hn4kEM3YpG+ARreEKca1evA8MDh1u2aYU545zJJvecG8UXHSv5HRDnS31GUeT6qw+zYmBPq1yWMlG/gY",
+	"4AFSFGn9t+5RqUNqrfRpcMXEkx++1WYsSJhmap/Wr8YO3iGAlcSiWqmyI93kPpN6OzzyI2qotxc5Skwd",
+	"KapoF6tKvH2gRcSanLqkM1qPAqQhI42pjZUqw+ReCl4DixeNS+eHtak6Za06DPTlGOGwg4/b6eQs30t8",
+	"SpXymuAoKQb7iq/W5oXVeH/PaM4UlmNJPSexGMvG2uyVXvMS3j+l1Lwu313YwVwe9DUMNx8b2QS1F+2n",
+	"kGOhM5b3P79imYFy7rUXrWJsvJtImV6ihcAbFKHJZ/CkUYzlrDTrQWEJfeNLs66r/DIXuGctrsyZLq6Y",
+	"mBI+Z/N2rF9e59QiBaNLr4RVUpoRZbBD1BegMQY6RV+dkurDYmAnZV6UERIrX8/H17A5DSEVGKcKLjI+",
+	"8VYrC8XoaPflkmVQL2Awe+F/r5mI0tlNveoOYFlGyQx5iLaEihdH1WjXsBb0QFAL+kkg7csncsm29zRp",
+	"0FCygHcIUD4kgT4gB+24viZDn2nD+ZVyHegJEOTDCLA7q0tUHVJDIUrueSAYnsYJjRN+HgaNl2gOAMN2",
+	"3XPS3myCIJj2JUd8g3mHo6u8/6X8khnKC+18cmnI1h/rk6xqvF09/dpl+4c8lcFa6PP+M+1/8/ltcZaC",
+	"X7K4ajHYZm1KZN/iKFkGoRnhaaCXYWZex5V1vXz29cvBAM+skFYAmvXF1TYDvYIH9D2Nrup1zjeAesmU",
+	"YnmwCRZSs5mR3rNwj9ypCNwQ9jQ46R+Et1ZAxB4R17ii3hIUb+s6HFBNk0LJCep892OsEMU21EKvotoY",
+	"aTXorh36Gr/7lCy+OuKwerUP7+Fc7C5o7yMXue5gPj5dS+KEg725VyOPywGaWS4EUzNvxG1XxhDNLKOQ",
+	"ljqvMhRV4rMZtNejs7YNcLOkUjPrrrL1hIqSmlyy7QmqfVx6k7DjMdAoQyLoUT7uFlEcVVetU3CvjgLe",
+	"581+WkpZzHosg2fdch7tw3DJrTcXsZeVD+yxUvC95rGxk5D7YJAKPiPX660vVlGWTLD8wZyQU4HBld59",
+	"pFnAtTW5uGeG5r+BWfMKC/Q4DfT8nUhHqUGhHHVH7ueHGeB5fbxJM5HfeX4c5IDZzY3o85G7hoo6zTLL",
+	"87Hqja5/R0uEisgPoUgJUOdoCP4aWELiHUUguU2UhQn8AyhxBmSiC5kIYjgoAY8dKo2peDIAyDAx4rla",
+	"Q+EGTyLAOdk5bvX6iinF8wQq/BdMq669x3TIdekSb49IXNv3aB1IR2okkW7+AzNL9aap7RTgCdcF+qUy",
+	"MwVrklg1IOLCXtGCMeejNOpKCMguS0z95bGdsnnHVSj6klPUseRGEsXKgmZY6s5IJ7l5IS+ukCRNKN6z",
+	"P+hR1pIh8Hsr0Z2BU+sVB+8lB3K7yIjrPG2ypI9gSHI0Mngw2nvV9ZVhRhNfB6EnOxtppVjrnhPyA1Cc",
+	"vbaoYrBJGybsJ5aTS8ZKF1bkHQbrwkQJD658V6TCQVlI+zL4xtY0PDIfJVGvW9m0N2PvII0OMLQ6r44v",
+	"fjOCi/W8Kn76A+RROjBjks+hcXCKpDozksPe0Ca+kDf9e/c25hsulhCvJIiI6ezfFLkhQGyajPuQ09Mf",
+	"5zM/WqDPUMxeSj/fb5/eJ+DNKoAkphpxWWDkjS/9Z0ZN3Hdoe6OD/IbvSKvvPvvE8XJJFKu9Qw/NoO+S",
+	"0uMzUvcZZ9ozh1mab7OlVCyeESJdsNJGSE1gORuBPxbcKKq2h+S5b6IqxTd7sbwzXiOEatQLqcM1ujgs",
+	"Cnk9g4fVLJTHTEkrtp1uKg58ofm6HzESUi6FwA+qnfyyJWuak0wqxbK4RzpHD0K1kYrNbEWVZAa+V3xp",
+	"NCn4hhtNQPhbEVnaU4CVbNMU1DdXJSx957NAk70oQNqxK3V9IjoeOaV9/6OD2Aw0RquxwtuF7YP5x+r8",
+	"xbjoGTop9nA+pl2+YochbNyFFwgHU2q2zcJpJd2S3wDdMKWToqJRlkm5FjB6g4SCuLThWiMogZaueVFA",
+	"+i9+U/MDFjyS06gtZQmYGtrIABYmvGxvYmAOi20PKhBsXWUZY7kLQNf+KYzPbWh3TxPlA1VdDPo05NNU",
+	"zL0E3Zhcu+Any8XfIgfWpIc604vvUV02JPZmHjzoQUrFMhaSB8YM8DzOJ0zMWslqtY6qW4VN8pYTVTm7",
+	"SjzKz7qCkBBIcGKneEo2UhtnlcCR6v2uI3DuZ1IYJYuiaUdFNevK+dz9SG9Os8y8kvLS5rN78B/QxpEW",
+	"yN3elrTm2ki1bQ8La/wev4EOVk+DjeWasUu4qhBEKQhV2doWS3Wz1NnGHkByFEhQ5/lfSPksC3uHuEFK",
+	"JR2BaW4JA7ijNhTiPizAKOXAVS6kCTuWT/1U7RCwGmOqlQl9ZCFl0MH6B/rop2TjWaV9+ANQs95dNQnb",
+	"EVOja++3rLvyOo4wux4iEZjvd1+1u/1sTlMsormu5q2b1s2fCkKN3PAszXz/WNFYvTFUPdTT5z6FR9dI",
+	"UmJtQkGMLENRyPriQnbkZDh/SziOpnAj5yRMh6yIgpdBm+kNRpn2PUGjFGVO+WG5SFPF0y64HxdS2l+P",
+	"09L39URdRAWihkCPoIqTr+uPohnrKa/cgMg+W/y7cG8g4qfnXsJ1LF+ljif2cIlOoRlIKrGkHUI+QL7r",
+	"EhMTllEnRifuIneu7yArOb0l74xLloyaztyRlJ+QjDBif8TMACIm2bPyiP3LiY2Z1MaH/gcdr6/J4E9d",
+	"8vFGzgzJJUPlpeMT0Lu9MHxBIJby9EqcOn2W9Sr9dy+ooZIP8o1coYYEQhDakI0U7iHS626w2RGODpRh",
+	"dwKqE3saALyPHGOK/AwFXDi++P1BXV/iIOB3nNfG1dwXQnce3RPQJGR97rlv09X6BuPNLiBj5GJs1Jn2",
+	"PqEjH1oRAP1xaA0YRkWj7QvGktpg5Rk1Pc8M8HiYRsZZp2mLRufuhQCzkIzi08E6F1JeVIq5LMSoaVFN",
+	"59GSmrUXfm3zrv+TVcq5TF7/YEqCfiyfRs6LLjVY234sy1nBrlgjPA9pGd55WvMr5vvq0JnkjJXg39t2",
+	"q0jFnUV4bN+Jbu2zKHJpDHaTxndELO4U2WFZTykewxt6J1BRNrfuwxscMVXFwosX4XLPZrspi8oQbnTi",
+	"DZ7JqsjhrljUb2s0jIWBAlvx36P+S/BhAy+/SBllX2Nz8s1NWYBX/PV6Ox9af97jQ3OnVaNSsbtdYTVd",
+	"ZHATKSyBsbgT4AXTqWVnjK/AdBX/T2vm8qqAzGUPvsV3OPxzcur/jLO3Qw/UGPvixaDjsCRjp3cmSa/j",
+	"CMORjAohUeK1mot6DfNmdhicC+6HnKzpFfMp92rtj2IbeeXTItR4PHuJihIr/VWmZbodfhhZayXNt0FH",
+	"uRLY/SO9h/A1jNeNHnsl2ZN9xfOKNviQ3lcAbnpg2SsxAV5HqTTzVDZ2mp9xhLd+gFPfP/Xg9ph4P+4+",
+	"3/sqT6Nu6CLfGc9d6b7bU6TDueP8+cG9FmbLQzQAXhX1mdElvRb9vmDdqwO0x5E0P2KnuBQRar+5Ydkb",
+	"17+hjT54NHg3OXUwy51CuMdBxHuEWBaAVjzUlaxEwqfSMnAha74AjmVemVmXJvI/4MTQiAtnbDjA36SO",
+	"4r47pRAYjOhWxZDkztbH5G6elp/lZA8e7N7xUjSimUuoNmAe9Kcl0sdfO5lBbUDjBdeKky6dIIMSBw5k",
+	"9ergQdN4pb5k3qseqc87+uKKfKkNUCkhuqf9d3bI02FjT6SCf4Q05O8VLfhyC3wLwffdiF5TS0LOjR9j",
+	"WVz0u514+Nkz9YB5Y5T0U+G6+dgxo+G2dpQIaCtgO4so1J64ZPE2QJgO8uPMWEasqwUYdqwo3drOLhbc",
+	"4n2O8Q3NY1sAVEvaNriDjMSQ/6iTh8VT+SIm4G2Q+83TdNPyGIVHSiAus2abfdSAFxEJ+FYR0QYbUH6A",
+	"RXlP1pXSAfa5zjXA7tFLHmsZIw3jrULdA2n6Ri3l2LtwnExa+3oKNhbX8hr8BLuTLHPWt4wx4P8T7UrD",
+	"dWqknjpeDzT5FLvQSIecgBVdARbyZqbYUu8KZ4LWFvgaYB1MuFzYV6BG9ezZa6dOqqt4cWFfoRg7HZzb",
+	"wyg5W3JRs1ouysoknrtgCxHbCGGxRwWgtcdDuk/GsKLoFS0GLEoX4AYP0QCtStPei8T1Teqv3R52B+C6",
+	"1sxAVrvaRyFuZq//nC+XTGEEszZU5FTlcXMuSMaUodwGMWz14e46tYvDDocdGslCzZytkesOkDYCUmyj",
+	"l/MdnGkCgPSIXjUjvGEu1sxRf9MTJuhVejw+OjD8IbxhNvTGOlBB7rWeA+GKtYH7FDQjUoDpGKW7cev2",
+	"82j+DzY8DZRjcIzISJh13BRHduxxSt1lVXhHhIQXD1cd/509LRRcitdAZ/BC/llwM8iW0CzSztSHwfDI",
+	"NSLLdsjggZTcZRZllp6sbCZY9HK0d0z3B4NFFJaMmu+Y4npIDEJwXGbO2O62h2G3EeWTuP6cEmUGyhU9",
+	"kKOjNlsCrrXTunWCIttaGUTK1CXA3FO5jyZBf2n2gIfxAI4RNacNMVx2nH1c84dTXs5KWc6yMeHPznsY",
+	"AfCQNmHsoY/I7tiz7hCapYO7VEyNzWCMPb0S+kv173JfKbMhfQaX4s3Xb/oM6nDHBM4cCM7tJTXAYVuH",
+	"sHt6M6l79gVr08UmeZhB6sa06Q1Zc7NTOoRsWTHIVp+2gxHsyWv6aaa1D4AFB/Z0xK7gdINbk/Bl8LUK",
+	"PLTNe/WO+8LS13S8Kcmym+ffnz57/OS3J8++JLYByfmK1WM6UD99pp8y06O2On4WBeKBiDD3wAAtm3KZ",
+	"lvYxbzROX4q5VQslK8NF7yOgbhABaaWfHgix+F7nwO4J9HmYthf4HurH4iMW88Pkf85A2XaaX1GRjQgV",
+	"gAwVLqwa8r6ABE5jpyKNQ3bPAXqz7uIl2ApnusKYQGrQ+9fWu9grCjqTIyZ0zeyM1oCIMTB2aVF5hKVU",
+	"NmJ6SnRpTYLw7oOGgl07iOfkG/sQgP+gNOwH6wwDqkm3pifPPAA7V5aOaHRYHbXNY/YX/Ir32dGhzBcY",
+	"R65dEQeIIrZCaRx0RKQCv4tp7J+sfHEFSL9EXsfe0Ba34IzpncidcRkcva03tO76b3c9th/gOdbGvpIy",
+	"u58atbjkojmWE6NMXRi3rllGNbFhYITqxkpBxGHic3lVD78uujwluX/OxC/hlqvZH1qooqukddX1PxtT",
+	"0kI0LiRDAX7ak9vlEOEGwPF5i0K0QoOXs62flrxlWaXAawcXTxVzvDsn0j7o7JazK6a2kHjXtTuKdIOO",
+	"Rm4NctmCc4zUA4ifev6/U+zpMe6OFX+GXDnhYDSdopxhy+9APEjiJcUFoUSFzbim22QkH9RqmDkA9jdv",
+	"twQ/SB/EqKpTkh1rWFihH6jHiR/UvHJJdm1Q7d2WwqVVjybw6ZPHHCCA9PsA9OcxvzPKbveh3AvvhpDS",
+	"2jWdR+USDqzFnHPHAEoN5vYOnTYdL4Ku5QDqPERe94PUbwy3jxaElmfApxXoO8sz6U1wG+sQ533gfYLZ",
+	"sCmOv6DSKkqp0Fj9AcTb1qMlqDZ15A/ZKxinTkD4z7VdqUUefcdSKPj4e2Zj8hauFkuPZjfh+prarcj5",
+	"1bLRkinNtWHCtHzXuanTn+k1OJBAefMrpoKcEF2MhN1w0xN8mVpIX/Ys4Gf2E3GutYShU6rlVeijO7Qu",
+	"Z4tDHw4wDHgJOriuWjE6BVHHPdW5xoDEFiXECswWU2OlCBFvwh7SG3UNAmF0L8EEp//od2HwYOu/CQ/h",
+	"JLXz1z8N/0iUKDka1wjL/Ri8IilIDORfP+1ErITyHKNA65aiSJAHANCTebyRHjpKZxsVUVfoRwavmU0o",
+	"Ft4UP36sve935oAESHyHHeDFWcPrdiFtoQPnM5fQriuoR0t530cJjeXvSkTuWW+4SKItcuZAY5hGtiS7",
+	"YmGUel5/HTK69xh3OonflZQG4smLIpEwHm31cKZiwuHCMHVFi0/PNb7lSptTwAfL3/YriuIE4TGSEZX6",
+	"6KUvX9FRYBX000JlX0FXTPw3szubvB3dLM5ZvHMHgtmfFpjVJDzOr5gg1zAmxos8/pIsOCZOKhXLuG47",
+	"oV97kSZktmbKel3CFLYkZSvL9p2zXP0izR2Ow9LHYpGfIkfK4B3uYK6P+mdmTj0cIHlaUqTaIZQE/lK8",
+	"zpYg7K+P1Lh2Lhvp3boJ7og2UrEjF02KSiTuWTQpXhmUsBy9PFgHXF6VZt11jr71G7hNXPj12sZWBUtU",
+	"Xu8t3WUWY0p34Q+p7lBNDBFiG80JgEp+f/w7erLAaXr4ECZ4+HDqmv7+pPnZHueHD8ebZj5jKTFEpRvD",
+	"QZIkrFrk3lUnphWrGlVEaO6iFffTOwF6bJuGSy7xUbCsBI7n2bCLs3NsXS6nwVNdglb+OXknHlqPeP+2",
+	"cP998uzLyXTCRLWxi6+/T6YT9/V96qWW3yQzONclazrxuc5odk+Tkm7HpI3fWaQmid+6Js+nF2m04Yv0",
+	"m+57u2fwcHURhGcCWD2wF7xBXaWaP0vtDBJD67CGE4MkWRfiCVuxqybPL30F6LHIuq8wH3nQJbhvxYud",
+	"gVAvbCM/m83Jj+XAfrNQ/rb48umnz87uIeipzOeWfpeCW4iYxFobk0dTReXTHKpqTUHDhyvenG6C8Fu0",
+	"7leKm+25xb9Xu/PfLlNll74LhZBcda3gZe1kXyMvmfBxRHXZpEp76fo7SQuQPtH5WzBipCxsaDjdlIXz",
+	"xSN/vbf4d/bFX57mj754/O+Lvzx69ihjT5999egR/eopffzVF4/Zk788e/qIPV5++dXiSf7k6ZPF0ydP",
+	"v3z2VfbF08eLp19+9e/3LKVbkBFQn0Xz+eT/n50WKzk7fXM2u7DA1jihJbe1pm5vQcO2lOhyJAzN4Ipl",
+	"G8qLyXP/0//nL8p5Jjf18P5XeyMq23xtTKmfn5xcX1/P4y4nK6g2MjOyytYnfp7baQvjp2/OQnYZtAjC",
+	"jtaue/NJTQqn8O3tN+cX5PTN2bwmmMnzyaP5o/ljO74smaAlnzyffAE/welZw76fQL3qE82MfQ3pkzpF",
+	"YtKj+y2kKPFPemVDbe+HPGn/Fnz69QOfNm7p6j3acG8LXVjFWQ7EZVwCoOkElTMayfHJo0d+L9y7JhIv",
+	"T+xg9jfkH6nCs7fThJTgAE5CBh1gHd1F/ywuhbwWBIrr4gGqNhuqtriCBjaiwWGb6EqDh6PiV1AD0fZu",
+	"47y0Zr8hlCvOrljzlPvOQCAgPaBtLiebyrCbYNtMofylnf7cDWBNh3fF/mCx5c5kid2BRm8szL6gmIfH",
+	"34QOZxBNgAgLZwR2pIvo6aSsEuj8BpId6SGcYRoEFZG6TbHgMd7B6Jvq/xGMWtJdhUK79n9rRguzdv/Z",
+	"WELN/CdI/+D+1td0tWJq7tZpf7p6cuJ1DicfXGb526FvJxHC7M/1/2Y839HTx8rtanLywWfdHh4wNouc",
+	"uBjmqMNIQIeanSzkzR5NWby6/qUAzeuTD6Cb6/39xMnp6Y+gPsUb9sQ/PnpaYrWe9McGCj+YG7uQ4eFs",
+	"m2i8zMYoVOXJB/gDyDZaEVbKPzE34gQiTk4+8Lz7uYOI5u9197gFFHj2wMnlUjOz4/PJB/w3mojdlEzx",
+	"DROGFvWvWDf2RFdlWWy7P29Flvyxu45GecwdlznUY9U+zq5ZVTN5fbRLdeq7Mrtx5a5asyYE7K4kNbSy",
+	"2+nk6RG5crPyfgKYFzQn3okU5n786eY+E5gtwIqWKAIDBE8/HQSN7bPVO8hP0pBvvQ7/2afciTNhmBK0",
+	"8ALdgaLfuOPTvkank6iZWKGg4hw0m0ftNM87RI9vSKbNC5lvBzC20avS+XbUSKuf0FzYJUzHic2dZXlX",
+	"didICJmzSfy4Napit3fkCa3gOqrMWcLaBBZVCBxwVpsGqMkSsO3QIxw5UdN8BwnXFUTqvBt/8pQ/eUrg",
+	"Kc8effHppj9n6opnjFywTSkVVbzYkp9FSOhyMI87zfNkte3m0d/J46zCMpM5WzExcwxstpD5duZUKI0J",
+	"LhlqyzqCzInXLjVeDD3c0+utUtJKnShg8vzXlDOVS5tVVouCZ3bBc69bsoqTSPUTCg83ud805mRBUWmV",
+	"j49mX73/8Owvt8k8Wd2UGXWumcGviTpTJOdFFXIbm2vpMm52L6lIg2Mk0X9XcJnB4eZmS665yOX1g4CB",
+	"v1dMbWsU+Gkm09RN02d2uu3C/G3kzGBB7gDaBwF4QQxuwSgrWL+DwcC3Th51etgaCvq5lvD+Y2veQinR",
+	"/zp//VOU28vX3wgJLvHAWkS5og3gJulqNczJ16j5KraQo85QU2F2Lc/j5n/evn/eeHe/8b4LRfFFTrjQ",
+	"xvqMJZhmdAPOR4n5yRvtQ+O/TlszwQwAqaL0BWSJJStunZO61/JiS85edt7s2K19Eb7Ynr3s3oWJS64N",
+	"4iCfarODHvYyJMjZhaykCXkQcFF/itZ/itZ3eq6PPjxjXuxJfdp3MDDtvEKn7q5r5pqBokfgItABZYzW",
+	"7bMe36NsfFejl9Lg5cxgeEn0wUXSttD8J4v4k0XcjUV8xxKHEU6tYxoJottPwzeWYWD294Y7upc6fPOq",
+	"oCpKkLdLcX8KI6YfwB+Fa3xqNWUSV3keYqw4BhckNvC4mss/Wd6fLO+Pw/JOdzOapmByZ13fJdtuaBk0",
+	"fHpdmVxeR34BPivmicsJogc+nXxwfzUN3cPtBj0P9u461t6/a2DM2DO+/Xj3gR0jNWpf1J0sRQBBJOzk",
+	"qH5p///kmnJjnchnYP6e0aVhKtVZMbpxJvLOz2loDKMFHBPMjhD/mnNNtWabRfeL2qoqIqyeoaNfT2jT",
+	"yN74BpdmX8eOF0jqq3N06Gnkt8l/rn1MY59NuLCDt+av7+1lq5m68nd57YL4/OQEkouvpTYnoKtsuifG",
+	"H9+Hg/0hSADugN/CiZaKr7iwtb/Rl2dWuxk+mT+a3P7fAQAPvu17cUgBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -1,6 +1,6 @@
 // Package public provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version (devel) DO NOT EDIT.
 package public
 
 import (
@@ -178,237 +178,268 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9e3Mbt5Io/lXw426VYy1JyY6TPfGvUnuVOA9t7NgVKdmH5ZuAM00SR0NgDoChyPj6",
-	"u99C4zGYGQw5lGg72Zu/bHHwaDQajUY/344ysSoFB67V6OnbUUklXYEGiX/RPJeg8L85qEyyUjPBR09H",
-	"55zQLBMV16SsZgXLyA1sp6PxiJmvJdXL0XjE6QpGT8Mg45GEf1RMQj56qmUF45HKlrCidlqtQZq+r88n",
-	"/302+eLN28/+9m40HultacZQWjK+GI1Hm8lCTNyPM6pYpqbnbvx3+77SsixYRs0SJixPL6puQlgOXLM5",
-	"A9m3sOZ4u9a3YpytqtXo6VlYEuMaFiB71lSWFzyHTd+ios9UKdC96zEfB6zEj3HUNZhBd66i0SCjOluW",
-	"gnGdWAnBr8R+Ti4h6r5rEXMhV1S320fkh7T3aPzo7N0/BVJ8NP7s0zQx0mIhJOX5JIz7dRiXXNp27w5o",
-	"6L+2EfC14HO2qCQocrsEvQRJ9BKIBFUKroCI2d8h04Qp8u+XL38kQpIXoBRdwCua3RDgmcghn5KLOeFC",
-	"k1KKNcshH5Mc5rQqtCJaYM9AH/+oQG5r7Dq4YkwCN7TwevR3JfhoPFqpRUmzm9GbNprevRuPCrZiiVW9",
-	"oBtDUYRXqxlIIuZmQR4cCbqSvA8gO2IMz06SrBjXnz9p02H964puuuBdyYpnVEMeAagl5YpmpgVCmTNV",
-	"FnSLqF3RzZdnYwe4IrQoSAk8Z3xB9IarvqWYuY+2EA6bBKKvlkDMF1LSBUR4npKfFSAl4VctboAH6iCz",
-	"LX4qJayZqFTo1LMOnDqxkIgOpKh4ilER/ODQ3MOjbN9jMqifcMR3u78ptnCf2lBfssXVtgQyZ4W5L8nf",
-	"K6UDAVcKt30JRJWQGd6bEzOMQb5iC051JeHpNT8xf5EJudSU51Tm5peV/elFVWh2yRbmp8L+9FwsWHbJ",
-	"Fj07EGBNnVOF3Vb2HzNe+qjqTfIueS7ETVXGC8ris2Bo5eJZH2XYMftJI80gz4PcgPvjxrraXDzrY6m7",
-	"e+hN2MgeIHtxV1LT8Aa2Egy0NJvjP5s5khady99HVrwwvXU5T6HWkL9j1yhQnVv56bwWIn5yn83XTHAN",
-	"9iqMxIxTZLZP38aSkxQlSM3soLQsJ4XIaDFRmmoc6Z8lzEdPR/90Wgt6p7a7Oo0mf256XWIncxlLMIxv",
-	"QsvygDFeGeERRa2eg274kD3qcyHJ7ZJlS6KXTBHG7Sai3GU4TQFryvV0dNBJfhdzh9cOiHor7CVpt6LF",
-	"gHr3gtiGM1BI+07ofaAakiJinCDGCeU5WRRiFn745Lwsa+Ti9/OytKgaEzYnwPA+hw1TWj1EzND6kMXz",
-	"XDybku/isW9ZURDBiy2Zgbt3IDdjWr7t+LgTwA1icQ31iA8UwZ0Wcmp2zaPByGXHIEaUKpeiMFfgXjIy",
-	"jb93bWMKNL8P6vynp74Y7f10hxK9QypSk/2lfriRT1pE1aUp7GGo6bzd924UZUbZQUvqokbwsekKf2Ea",
-	"VmovkUQQRYTmtodKSbdegpqgJNSloJ8VWOIp6YJxhHZsBHJOVvTG7odAvBtCABUkbUtmVry6ZXpZi1wB",
-	"9dPO++LPTcipPSdmwykzsjEpmNJGGMLNVGQJBQqcNCgWYiq6E9EMoIUdiwgw30paWjJ3X6wcxzih4f1l",
-	"Yb3nTT7wkk3CHKstarwjVHdm5nsZbhISq3BowvBVIbKb76laHuHwz/xY3WOB05Al0BwkWVK1TJypFm3X",
-	"ow2hb9MQaZbMoqmmYYnPxUIdYYmFOISrleXXtCjM1F1u1lotDjzoIBcFMY0JrJg2D2DG8QQs2Bq4ZT1T",
-	"8g3NlkaYIBktinGtlxDlpIA1FERIwjgHOSZ6SXV9+HFk/1DCc6TA8EENJFqN02lMydUSJMyFxIeqBLKi",
-	"eDmtzPOoLJp9AnNVdAUt2QkvS1FpA2P0crl45lcHa+DIk8LQCH5YIz7448GnZm73CWfmwi6OSkBFC+NZ",
-	"UeU1/gK/aABtWtdXLa+nEDJHRQ/V5jcmSSakHcJe/m5y8x+gsu5sqfOTUsLEDSHpGqSihVlda1EPA/ke",
-	"63TuOZk51TQ6mY4K0y86yzmwHwqFIBPajZf4H1oQ89kIOIaSauphKKegTBP2A+9sgyo7k2lg+JYWZGX1",
-	"ZqSk2c1BUH5dT55mM4NO3jdWVee20C0i7NDVhuXqWNuEg/XtVfOEWJ2PZ0cdMWUn04nmGoKAK1ESyz5a",
-	"IFhOgaNZhIjN0a+1r8QmBdNXYtO50sQGjrITZpzBzP4rsXnmIBNyP+Zx7CFINwvkdAUKb7eGGcTMUquq",
-	"z2dC3k2a6JgmagU8oWbUSJgat5CETaty4s5mQj1uG7QGIkG9tFsIaA+fwlgDC5eavgcsKDPqMbDQHOjY",
-	"WBCrkhVwBNJfJoW4GVXw6WNy+f35Z48e//r4s88NSZZSLCRdkdlWgyKfOD0fUXpbwMPkwwmli/Tonz/x",
-	"BpHmuKlxlKhkBitadoeyhhb7MLbNiGnXxVoTzbjqAOAgjgjmarNoJz/Zfu/Go2cwqxaXoLV5BL+SYn50",
-	"btiZIQUdNnpVSiNYqKZRyklLp7lpcgobLelpiS2B59b0ZtbBlHkDrmZHIaq+jc/rWXLiMJrD3kNx6DbV",
-	"02zjrZJbWR1D8wFSCpm8gksptMhEMTFyHhMJ3cUr14K4Fn67yvbvFlpySxUxc6MBrOJ5j4pCb/jw+8sO",
-	"fbXhNW523mB2vYnVuXmH7EsT+fUrpAQ50RtOkDobmpO5FCtCSY4dUdb4DrSVv9gKLjVdlS/n8+PoSAUO",
-	"lFDxsBUoMxOxLYz0oyATPFd7tTneGthCpptqCM7a2PK2LN0PlUPT5ZZnqEY6xlnu1345Ux9RW55FqjAD",
-	"YwH5okGr71Xl1YcpC8UDlYDUYOo5fkaLwDMoNP1WyKta3P1Oiqo8Ojtvzzl0OdQtxtkcctPXa5QZXxTQ",
-	"kNQXBvZpao0fZUFfB6WDXQNCj8T6nC2WOnpfvpLiPdyhyVlSgOIHq1wqTJ+uiulHkRvmoyt1BNGzHqzm",
-	"iIZuYz5IZ6LShBIucsDNr1RaKO3x2jEHNaukBK5jORf1GUyRGRjqymhlVluVRIvU/VJ3nNDMntAJokb1",
-	"uDkEVw3byk63pGsgtJBA8y2ZAXAiZmbRtZcDLpIqUhrZ2Yl1TiQeym8bwJZSZKAU5BOnz94Lr29n7x+9",
-	"A3m4GlxFmIUoQeZUvp8V3Kz3An8D28maFpURz3/4RT38oyxCC02LPVuAbVIb0VbfdZdyD5h2EXEbopiU",
-	"rbbQngQjYhumU4CGPmTfH3u9298Gs0ME7wmBa5DoUfNej5af5D0QZYD/PR+s97KEqpwYMbBX/WAkV7Pf",
-	"nHLhZcM9M4QJCqr0ZN+VYho19CZmqREXT90iOHCPPPmcKo1iIGE8R/2tvQpxHitbmilGBzqV4ZS9rzEz",
-	"6S/+IdadNjPXO1eVCq8yVZWlkBry1PLQZt0714+wCXOJeTR2ePppQSoF+0buQ2A0vsOjUwTgH1QHC7Wz",
-	"eXcXh14HRnzZHorlBnw1jnbBeOlbRYiPnWp7YGSq3gNLbky16G0mRAEUVaZKi7I0HEpPKh769WHw0rY+",
-	"1z/Xbbskac1AVlLJBSg0Mbn2DvJbi3SFtq4lVcTB4f0TUOFlXeS6MJtjPVGMZzDZdV7wEWxaxQfnTse9",
-	"KheS5jDJoaDbhLeF/Uzs5wMJw4+NBFLrD4SGyQytiWkaqc+E9ze926wCp1IpwZvgF5KZc26eUTWpud53",
-	"nzQHnDbFNx2xPgizIBhJOvDjIbIsPSVGxLt/LbQhK0d0uBp3K91zLT3YC7O+FwTiuJNaEdCe/b9AubmD",
-	"AHbU+beg+hZeT32sZfeo//Fub1yYrausddskr4hevryHMfbxoB5bxCsqNctYic/VH2B79Nd7e4KkrwTJ",
-	"QVNWQE6iD/YlX8b9iXVDbo95t9f8IHVrF/yOvjWxHO+Z1QT+BraoNnllIxoibdUx1BGJUc2FSzlBQL3X",
-	"vHnxxE1gQzNdbI1gq5ewJbcggahqZr1WuiY0LcpJPEA6Zqp/RmeQT5rDd3oIXOJQ0fJSnof2tbUbvqvW",
-	"k6uBDvfKKoUoEvrP9onvICMJwSB3IVIKs+uMFsWW6BA24ympAaS7INAbI8gzD1QDzbgC8l+iIhnl+MKt",
-	"NAQhTUiUfFBYNjMYcTPM6VxVawxBASuwr3n8cnLSXvjJidtzpsgcbq3LDceGbXScnKAq7pVQunG4jqDt",
-	"NsftInHpoK3SXLLu1dbmKfud3NzIQ3byVWvwYOA0Z0opR7hm+fdmAK2TuRmy9phGhjn44biDzHdNl7DO",
-	"unHfL9mqKqg+hqES1rSYiDVIyXLYy8ndxEzwb9a0eBm6vRuPYAOZodEMJhlGCQ4cC65MHxtYaMZhnJkD",
-	"bANHhgIEF7bXpe2056Vd+y2z1QpyRjUUW1JKyMBGyRkpVYWlTokNmciWlC/wBSRFtXCuznYcZPiVspow",
-	"WfHOEIeKYnrDJ2jCUMkwNTRb+mhLI4QBNS/btv3DPtZuaQDFXkaDLu1oe9r2oKTJdDzqffgbfK/rh7/F",
-	"WzNk9K7GxIZ8GCGthmag9QzxaWSlLhLjbTSHzxDD+7HS1EOnoOxOHDmF1x/7/MIvq7IstkcQkuxAREIp",
-	"QeGVFqsBlf0q5uQFy6Q4LxYi3HlqqzSsusYb2/XXnuP6011ewIIXjMNkJTgknvQv8esL/DhY7Wiv4Z4R",
-	"USA6aMD2w6eBhNYCmpMPIen7bhKSTPvsty2d6lshj2VltwMOflMMsFzvdetwU97Vvk6LImGStuqHDhdR",
-	"4+AUziShSomMoaB4kaux8z63Vmzr1t5C/6sQGnWEA9wet2V7jcKwrCIfipJQkhUM1fyCKy2rTF9zipq+",
-	"aKkJZ0GvHOhXC3/tm6T10Ak1sRvqmlN0FA36v6Rj0BwSeqhvAbx2WFWLBSjdemDNAa65a8U4qTjTONfK",
-	"HJeJPS8lSPTYm9qWK7olc0MTWpDfQQoyq3TzybGqlCZKs6JwhmAzDRHza041KYAqTV4wfrXB4bwfiT+y",
-	"HPStkDcBC9PhjGsBHBRTk7Sn43f2KwaVOJwsXYAJxlrYz97juc4NMTJrbySt+N+f/NvT1+eT/6aT388m",
-	"X/zL6Zu3T949POn8+Pjdl1/+n+ZPn7778uG//XNq+zzsqWBwB/nFM/dGv3iGD7EoTqQN+x/BILNifJIk",
-	"ytihqEWL5BPMl+EI7mFT76eXcM31hhvCW9OC5YYXHY182tdU50DbI9aissbGtdR4HgEHPofuwapIglO1",
-	"+Ot7kefaE+x0uIm3vBVj4DijOjqAbuAUXO05U261D7775oqcOkJQD5BY3NBRaoHEC8ZFMDa8fMwuxYFd",
-	"1/yaP4M5vgcFf3rNc6rpqT1Np5UC+RUtKM9guhDkqQ+KfEY1veada6g3gVQU1BxlkEpxCrpKr+X6+jUt",
-	"FuL6+k3HD6ErW7mpYi7qzllXTeannBi5QVR64pK4TCTcUpmyhfgUHy4aGnvvhMPKJKKySiyfJMaNPx0K",
-	"ZVmqdrKHLorKsjAoikhVuXwFZluJ0iIEjhlm7mJvDQ38KJxTiaS3/slbKVDktxUtXzOu35DJdXV29imG",
-	"4NUpDn5zPNDQ7baEwQ/f3mQU7fcuLtzK5ehUPinpImUzub5+rYGWSCEocKzwpVkUBLs1wgN9JAAOVS8g",
-	"xCIfsCUWsoPjenG5l7aXT+uVXhR+wk1txk7fawejqPg7b+CeyHpa6eXEcITkqpQ5Bn6vfIIBujBXjvcg",
-	"UGyBDwC1FJVZMpBsCdmNy2wFq1Jvx43u3tHF3cWe4TCFOiMXHDhnBn8Z5WbAqsypE2Qo37ZT3CgbDIGD",
-	"/gQ3sL0Stvt0YHawKBtdlGJF9R1dpN3orjXkGx9kN0Z7853flY8RdelIMO7Sk8XTQBe+T//RtgLAEY51",
-	"iigaeT76EEFlAhGW+HtQcIeFmvHuRfqp5TGeAddsDRMo2ILNigSb/o+uXcPDaqhSQgZs7aN6w4CKsDkx",
-	"r6OZvY7di0lSvgBzqZuLWChaoNP+NGnoR+lwCVTqGVC9U1/L4zQTHjoUyG8xaBqVJmOzBNiY/WYalSAc",
-	"bs0DD9/eto1zJJ7eyZ3KrgnyO4Lqu9dB0tO7PCIcwhP57Px9H/YkvBecf1pMnQiy/b4yOFxIcWt20wAo",
-	"fOpGTPAS3VOVogsYeh01TEUDU2I0LEA4yD7pJynviHlbrOnIGAMXYbtPDF6S3AHMF8Me0AzQcnH0c1sT",
-	"orMqvOTF1iN1VqBAHRxELelQ2bCz8cVhwKbZGEheC6sesCbW4qO/pMof/XwccfQ7SosfJ5XMrvx5F5H3",
-	"HdXd7Hj+mm6z9rHV58yACG56+Cx6PnWez5c3Gh+U+248ciEOqb0THKXoHApYWJzYxp7O6vxM9W4aOF7O",
-	"58j0JilHvkgZGUkmbg4wD7ETQqzGnAweIXUKIrDRso4Dkx9FfNj54hAgucsvRf3YeHdFf0M6WNB64xsp",
-	"WZTm1mc9VqvMsxSX3qIWeVouzjgMYXxMDCdd08JwUhd4Wg/SydWGb59WZjbn2/Gw70008KC5NaJ0ctAq",
-	"rTxzl/XFgrdfRvpVcNAaZmIzsZHRyafVbDMzZyIZr4Bx2qnDazPnPVBkJjboU4Q3nHVwPxi6fsg8YJEb",
-	"yIYppHLs1yc2WvAOA2S3IJ+iZoWk5/Rqgez6JNm7AdMjTveR3SdRCr0jgdRSYNZpwJ1GZ6+epSltdSWR",
-	"+rodh+ywIUwtxWr6DmdyJ3sw2lWeNnPdfV+nO+xPjubP6gdJ8tdVyt0nL6PtXNpci4ekZWyTQwOIHVh9",
-	"1RZik2htOi418RphLcWSDKPvGru6aFNQAGoCJg25enKTMktfX79WgDLDpe8W6Tlx9yjfPoy84SQsmNJQ",
-	"Gxe8k8uHt/2gOtE8tsS8f3W6lHOzvp+ECIKGNcdix8YyP/gK0HV9zqTSE7TMJJdgGn2rUJP2rWmaFoSb",
-	"/nZMWVPPwXIwQnQD20nOiipNyg6kH54ZiH4MN5eqZnhRMm69jWaYCj/poHuAbRLhsY7dOxH03CLoOf0Q",
-	"+Bl2sExTA5M0lNec/k9yxFq8cBdnSdByipi6G9qL0h28Noql7zLaSIiO3C6mu2w+nXOZ+7H3emP5iP4+",
-	"IcKOlFxLlBExHUAoFgvIfaY3FxRqs165fHqF4Is6l6D5fUf6wCmxWfwwCd+O/H3OPR36nNMb5USwKkYS",
-	"+vgxg5DX0XWYexAnWQC3mVtGh9cbKZKIix3jsUWkGf2wvL3jNp90Hb5quQvXPr12D8Nm4/YUQHP3rFLg",
-	"17f70Ha3y6Fu3Od03EgRu/uA4YBIcUyrSIDpEE0P56ZlyfJNy/BnR53egSQGinvdTPAtnCFbcoPtwU/T",
-	"sXhPrZ4H5nbE9s7YcYrP/FPzyLT+zM4j15wNmrlsA3kl0ZrU8Bbu5tMPD82Ba//hl0stJF2AswhOLEj3",
-	"GgKXcwgaopT0imhmHaRzNp9DbAlTd7HiNIDr2DvyAYTdQ4Jdc1l4W+6kzy6R7aGtegX7EZqmpwSl9Plc",
-	"XHXtkf7hEenWwmUTbdwdjIrJhAI/wHbyCy0q8xJiUtW+qc5A2LzWD6CJ9eoH2OLIe10+DWB7dgVVcT8B",
-	"UmjKuhI+qShL+APVqL6Ab+DGFh6wU+fpXTrS1rhSGv1Ho76hGvUkmkt5f8emdpExkA7Zq8u014k5W9Dc",
-	"ljah79silu+XfaInSDwVQ++Nu1xyIdPGXu8yoIUnfFzs6N14dD9/j9Q96UbcsxOvwtWc3AX0xrT2/4bT",
-	"14EbQstSijUtJs5Ppk/okGLthA5s7t1qPvD7Kn0qrr45f/7Kgf9uPMoKoHISVB29q8J25Z9mVbYEx+5r",
-	"yKZjd7pdqwqLNj+kzI49aW4x9XpLm9apdVP7TUUH1XnWzNOe4nv5pnPxskvc4eoFZfD0qi3S1tGr6dxF",
-	"15QV3vDroR2qZbfLHVZdKckn4gHu7SQWef/de6zeOIHr69drj9nanmIdpUJK/IQvnbqjp3OH16TPak3r",
-	"ezgkrvMlZjJNv7u4y3OKjNE5nNGjy4HfCtm4qFxUY9Jh7f0JiOYxYfGYNspfOSt8RyycEitC/rb4zfCG",
-	"k5P44J+cjMlvhfsQAYi/z9zv+I46OUkahpOqPsOyUJPH6QoehriI3o34sGoIDrfDxIXz9SrIyKKfDAOF",
-	"Ws8zj+5bh71byRw+c/dLDgWYn6ZDVBXxplt0x8AMOUGXfVGJwfl5Zct5KiJ4OwYfo2QNaeHV4yp4WDt7",
-	"9wjxaoV254kqWJZ2+uEzZVgSty69pjHBxoNtyGaOivX4lfOKRaObZupOJs/WQqJZkwhXyUzANX5nwrGA",
-	"irN/VBCV9cWbuHU5+6cQjtoRsNP6RTdwu2rw6C4Ff+9vIvRatV0Ko50m12fBDOgRkaozdWC8Qzxjh/nv",
-	"iFVwFOWvTwxsWzrX4b2UtfOdt7sItDMDe/bpLK79DyRXDtNu5rMhO83UZC7F75CWHdBImEjd4a3bDBXw",
-	"vwNP+ai2GVnwHKgLVtez7yOQ4bqFPlK5ty7BLzpUzbvLFZ7mE4dt9IFKg2i/+9UGKp1e3G1C30M1djxp",
-	"BtL0MDM8sJFbONby8e5ulNsTavNaNCLP0uc8DhQ9tePX59zB3AmuLejtjKYKHZn3ooEp2v6GY54WxHf2",
-	"G6RCagY7O4liGUJbZpP9lSBr61E3VfId33522sGvvvqRhxQXP+/G1lelUCIxTMVvKUc/QuxnOaDrrcD6",
-	"YZhet0Jigk+V9iHMIWOrpDL8+vp1nnU9v3K2YLakeKWA0Ll2eR7dQLaovKUiV8075CJxqLmYk7NxfWb9",
-	"buRszRSbFYAtHtkWM6rwgg4+EaGLWR5wvVTY/PGA5suK5xJyvVQWsUqQ8D5H0TN4ws5A3wJwcobtHn1B",
-	"PkGHYcXW8DB9wThhbfT00RfjXZWzEeNYJH4Xk8+Ry/tAhjRlo1e1HcOwVTdqOjJhLgF+h/77ZMf5sl2H",
-	"nC5s6a6g/adrRTk1CEnBtNoDk+2L+4uuHC28cGudAaWl2BKm0/ODpoZj9USTG4ZowSCZWK2YXjlPUSVW",
-	"hsLqMuR2Uj8c1tfzZdA8XP4jumCXiTf+R3hu0VVPhCN61f+I9vYYrWNCbcbWgtXxF75CLbnwmamxLlwo",
-	"B2dxY+YyS0d5FcMx5qSUjGvUGlV6Pvmbeb5LmhmGOO0DdzL7/EmivlqzBBE/DPAPjncJCuQ6jXrZQ/Ze",
-	"ynF9ySdc8MnKcJT8YZ3SITqVvb7iaf/ePrfjnqHvLV2bcSe9BFg1CJBG3PxepMh3DHhP4gzrOYhCD17Z",
-	"B6fVSqYJhlZmh37+6bmTRFZCpipd1AzASSUStGSwxvjS9CaZMe+5F7IYtAv3gf7jerd5sTQS3fzpTj4W",
-	"Iqty4p0W0ioZSf+XF3V+fDRu27jdlvZSyISe1mkcP7Bb6mH6wrYN3boD4rcezA1GG47SxUpPuIeN5wh9",
-	"Poa/Vxsku+cNVemj34g073iU9U9OEOiTk7ETlX973Pxs2fvJyXCX2bS+0PyaQM3d7pp29krTN7XVX4mE",
-	"9s5X8Qx+Yy5VSULDmrzLzJU6c2OMSbNU4oeXO44Tr3iwG3L6AHnU4Oc2bj4yf8XNrCNg+vlDs3psknzy",
-	"8D2KoaDkK7EZSkSta8vT0x8ART0oGagVxJV0quMmPSX2uvlEZGtGnUEhzEs1LoA12GvlT7QLBjXjHXtR",
-	"sSL/pbZCt24mSXm2TDqVz0zHX+0zIGoQaTCyJeUcimRv+1r+1b+qE+/+v4ueYVeMpz+1CzFb2FuQ1mA1",
-	"gfBT+vENrpguzAQxipoJuUKKk2IhcoLz1JVLatbYrWieqiSbiPHHYVeVdl7JmDzBFRSZswLdaNP2cGw5",
-	"kVT3cFUs++9LXJlxsAq/smoJOzpIQtkKr21FV2UBeAjXIOkCuwoOre6YsQ1HjsqSEFWaT9gSk78IoivJ",
-	"iZjPo2UA10xCsR2TkiplBzkzy4INzj16+ujs7GyYkRHxNWDtFq9+4S/rxT06xSb2i6v8ZQsmHAT+XaB/",
-	"V1PdIZvfJS5XfvUfFSidYrH4wQZko4XY3Ou29GooEzwl32F+MkPojRIBqBT1GZabOUGrshA0H2NS6Ktv",
-	"zp8TO6vtIwFRh6VfF6gBbB6RpJFneI5Un3+tJ3fV8HF2p84xq1Z6EoqypjIpmhZ1LVnW8n5C3WCMnSl5",
-	"ZtWywbHHTkIwtbhcQR7VgLVqACQO8x+tabZEfed0tFOl3FMNaHgJY88Ba3NRFPcaCmYhBzfLcFWMbRHj",
-	"MRF6CfKWKcC8E7CGZsLGkO3UKeR9AsfmamXFuSWc6QHSayiPdegueOCs6Ov9K5KQtfbh3ra/OpMHFjk/",
-	"tNjzJfZKx+20Kke3/B5syYyNL7oxJS+csSOjXHCWYbGJlAiOqRiHmVUH1OVI2zvVyJ3lxDFM1qsOAeoO",
-	"i70VrD3LdIjrOjVEX81+W8Kxf2rYuCKAC9DK8UDIx758vDPQMa7AFUAz9BVzVCETrl/JsJjgQnJEl/Tx",
-	"CLOp9ehavzXffnS6ecwZc8M46twcUt1L0BrYCsXQzs4J02QhQLnVNuPC1GvTZ3q14QjCm+lzsWDZJVvg",
-	"GNYV0SDFegF3hzr3PsHOB9e0/dq0dbULws8Nlzo7qV/3myQLUWH/UzXXe9Gf8v3yjjQRcsP48Wg7iHGn",
-	"qz/ey4YMYY2ef1Difd4hm1C+vjnKN+bJaukNWxAbuZtMG8x4AoznjHuDbzoPVpa8S3Bj8DT39FOZpNo+",
-	"OgZxvCugRU84DAbVW4+B+w7VrsRgUIJr9HP0b2Ndeb+HrYQG9euC8i3xh8JQdySUfE2L4AyfqKOP0pkT",
-	"xqyzcKuyfoqtGLY+8aG5DXTtDQQN3bEayqH3VF+20VmVL0BPaJ6n8s59hV8JfvUBhbCBrApFwEKcaTNd",
-	"e5fa3ESZ4Kpa7ZjLN7jndDlTVClYzYqE6+2z8BHysMOYiGq2xX9TFbD6d8Y5vR8c/e093PPDahR0o9lT",
-	"0rOh6Ylii8lwTOCdcn901FPfjdDr/keldB/4/YeI625xuXiPUvztG3NxxGm6Oz7+9moJWbTRn17gd58P",
-	"LGRybXIlvMo6dd7QIwM3L7FlLeB9wyTga1r0ZFyIrTb2frWWjL68C1lvWhGqXfY6TUnNE4aoMPrzf1kP",
-	"7JZlqGve7POxti7W79N44vCxE+n9lsYfGnZF6/VWM5Ree+LdTH41ERxq83OlGLr6UloUIhvMGdww56ZT",
-	"f6pesVq5zPcJr7z1SuTxWYi9uQDSjM06LCdCK/Bhm/yGT6vkF3mbHq2hHwlEMzRrGaLRLWFsAzM9eB4Y",
-	"O3U8UaSydZgl37ICi0P9++XLH0f9GxntQHdLXerspAq7b2NCpFqbPBaigY8dPEDwIq3/Vj0qdcwNlT4N",
-	"rjpx8sO3VkE4BCSbJ+mQ1s+HDt4hgIWwVaFSdTO62WlG9XZ45EfUUG+v5SgxdaSool1tKfH2sUrPugkJ",
-	"hUgHFSZtyEhDijul6gi5l4LXwNqLxuWjs8WVOnWZOgz02RDhsIOPd+PRRX6Q+JSqRTWyo6QY7HO2WOqv",
-	"CpHdfA80B2nriaSek7aayArMM1QtWYnvn1IoVtcDLsxgLpH3EoebDg3NuVqCywrjkwR0xvIO1GvINNaH",
-	"rt1AJcBwP4cyvUQDgTcoYpOP4AoiAXIo9XKnsGSdu0u9rMuGgos8Y4rMwJku1sDHhE1h2g5Wy+ukUKQA",
-	"OvdKWCmEHlBXN4QtIRpjoFP01anRvFsM7OR8i1Ia2lK60+FFWM5DTIANtLylqs4c1UqjMDhcez6HDBPe",
-	"70y/9x9L4FE+trFX3SEs8ygbHwvhgliy4aga7RrWXYnwdoIa1aR6n5D2JcS4ge0DRRo0lKwIHCJs75IB",
-	"HpFj7bi+qECfacM5RjIV6AkR5P3gXQL+usbSXYoARNkp7wiGp3FzPdUZK+8GjZdo7gCG6Tq9V9H+Oh0e",
-	"CqZ92f261dX7X8rPsJi9ck6lNKSbj/VJ5KJbjvnWpavHRIvBWugT14Pyv/kErXaWgt24CjWIMGubvaUy",
-	"9y2OkibP3pssDfQ8zMzqwKiul8+hfjk2QjErhBGAJn2Boc1IpeDC+0BZX+s6aRlCPQcpIQ82wUIomGjh",
-	"w6wOSP7pwid3YM96md8Jby2P/gNChu2Kemso/FQXksBykBRrJlDnfB5jhUhYUQO9jIo7pNWg+3boa/vd",
-	"5xTx5f12q1f78B7Oxf4K2T70jqkO5uPTNSdOODiYezUSkdxBM8s4BznxRtx2aQfeTJOJeZXzKrOiSnw2",
-	"g/Z6cNqxHdwsqdTMuqtsPaGirBw3sD21ah9fddzveAy0lSEt6FFC6RZRHFVXrVJwL44C3sdN31kKUUx6",
-	"LIMX3XoU7cNww7IbwMSsITLFSMEPmsfGTEI+QYNU8Bm5XW59tYWyBA75wykh59xGB3r3kWYF0tbk/IHe",
-	"Nf8GZ80rW2HGaaCn1zwdZoWVXuQ9uZ8fZgfP6+NNCgy/vOf8dpA7zK43vM9H7hZLwjTrBE+Hqje6/h0t",
-	"ESoiPwtFSoC6tIbgr5ElJN5RBLOzRGmE0D+AEmdAJqoQKS/8u2SQMUOlMRVPhgBp4AOeqzUUbvAkApyT",
-	"3Z6srO6zzzsq5kRC7Ztx1wSsLqepZeKqTzXSnjnM0uSMcyEhnhH9TG2i5hDZhnmO8T8zpiWV27ukSW2i",
-	"KqWG6sXyXm/J4ChZL6R2luzisCjE7QTZ2iRUV0qpA0w71by2fZ3Sup856jOI3C6pciLilixpTjIhJWRx",
-	"j3SIt4VqJSRMCoFemCnHjrk2j4QVxnVyUogFEWUmcrCF0NIU1DdXxTlF2QsiV7YkCiztYMoA2yei44FT",
-	"mtvXmmcnKK/tLbThN//K9LHpK+r0d3bRE+si0BNfAMqlu3MYso278CLh2IxMbaVsWkSesw3SDcjUkZ8T",
-	"LSsYE9eiXYXfHXwqgayYUhaUQEu3rCgwewTbRA4NwR8ojdoe2fkC/aDXDB3emplErEhdmtsxpF+JecBl",
-	"nJGN6KUU1WIZ1QcIcPqnu6zcwz4e5WdVoU8ihoiaKZ6QlVDaPYvtSPWSaxfQTzLBtRRF0VTkWTl/4Yy+",
-	"L+jmPMv0cyFuZjS7eYiPcC50WGk+9ikV2r679UyylYNx2EtBb/gEyUPtT7Nu26FXq6Pnwbyzxf06hod9",
-	"mvwIzDf7met+u8Z5d2HtdTX5bPotdM4J1WLFsvRx+3N5v/b6rKa4VzLToq1CbLPQYDPkA/E9FtyZkHt2",
-	"0QycJsuonhPHI5xbB3Ii818U49vjkjk4HtRzh3b5jhOwJlmvGNgCACG1iRB0JW3p4lhICwxHLGziFHRK",
-	"aQM68MJB37/7wWZGODpQGu4FVMcbOQD4idVgjG1GTOvZPBMb//1hnTLzTsC/203lDebR51R5WZOWtG6V",
-	"PpFVD0dIFyDY6YF4hUkwZkP9EEMp+oGXfwRAv2diA4ZB/omHgjGnrIB8kqpSfBF0YOPoue5iLKPRfT1H",
-	"y8kzWvlKwGbsSoJLrGSlf9k0J5bUkJIIzbsacZ7DBmyM1u8gha3jO47MWVDYMr8tjYIoJwWsoeGw6bI9",
-	"VSiFsjX4vip0JjlAiRbftqIt5YkYVwlsaV/c2ieRL9sQ7CbVMRaxdqfIHl1LUjO04RN7TNTQo2QgWrO8",
-	"og38qUNFjqYu0RzlBKo6z4eJf2IOneZnO8JPfoBz3z8lynhMvBnGhw5mQWnU7WJAez2TK9V36nnaMTlO",
-	"ZRYMRThbHuzalsRrvqFKesv7tZpdkq9fYgP3iQkeIfabDWQo1binEOTuMdRjOXE5kJDaOUBuHwymS0Kb",
-	"vwROuIhqHt9SFV4xdVZX/4OdGBsx7h7ad7DR1/7D999ZgoMR1Uq2mC5RGsj6fjr+j3ISdx7E3vFSNKLA",
-	"hfLuUI156nbPDmwgqiIn3Oynkf2xRrC7xRwXH5NZ5QcqCnFrixjHT9Rn4O25lvq8icmJ5Sxcy95PeuwS",
-	"Dre1ICyKEFnRLRES/zEP0n9UtGDzLfIZC77vRtSSGhJyBmTrReH8rs3Eu8WrsQfMK2KEn8qumw0dMxpu",
-	"a0aJgDYXuS/bJsiK3kC8DeggYvlnpg3jVNUMlRrmym5tZxcLbvE+PdOK5rESABPNbhvcwSc8N73//zps",
-	"NZ7K538sC5r5ktWu+FyTz2BVe09cegmr3WHOXb7mSSBUyq+JVvo0GfkdtKkHsq5UzE9fcawG2J0S4J26",
-	"YPdaxkClcKvG0Y4A8UFLOfYuHCeGs7OkuNTvvsXFlY8/zO4kM0T3LWMI+H+gXWm4V3Qi29IV1OP12GLp",
-	"H2AXGol4ErBaNfhMbCYS5mqfI43Vg8/EpgZYBd0t45kEqqzf0cVL92ytEyAzbp7R1ms3mFXDKDnMGa9Z",
-	"LeNlpROvIMyDzLcRwmJrAqK1xzbXJ2MYUXRNi5drkJLlfRtnTo8tDRwX6fEWFNc3oQAJN3J3AKbqFyDG",
-	"U9f6+biZuf5tgUHrO6s05TmVedyccZKBNFIDuaVbdXdTVbA67DNW0UgWamYLicxWSNoWkGLrrM33NCQF",
-	"AOkRLUoDLEHopJ2wAlnFkBY9hp8uDH8KS9CKbiaFWGDUb8+BcHmu0XRoH5CCoxLdSnfD1u3nUex32D0N",
-	"liJxjEgLnHXIFLvP/UvcSnyE/syZ3nnyrYazHYZtPZ3twfRI5Ys6PMMSS/c8piLnXWKmOHrei6o+TYmn",
-	"PYg2MekS3dGq9+wi+le4tAuxCn14scqmC0cqPt/qFSaob1A7AjBA1XEFNHMeYl1FXEdRYZEydtkNDtTT",
-	"We2+v5d6wENFinJnvTltcNAx4xxS4XN3PoNJKcpJNsS31VYryp2RwUHahLGHPiITQs+6g9+NCvW7GjnR",
-	"GoW8Di1y2ltIbJ+trMx2qQz6lEw9HL1pwBBz5GV4hK1qDWOtgipm7B/n3tjdVKIFJkEokZBVEpXMt3S7",
-	"v/BjT/b5y+/PP3v0+NfHn31OTAOSswWouqZBq3Bi7ZrIeFtr9GGdETvL0+lN8NlCLOK89dKHvYVNcWfN",
-	"cltVJyPulI08RDuduABSwbndEnl32iscpw6L+GNtV2qRR9+xFAre/55JURTpmjJBrkqYX1K7FRlgzAuk",
-	"BKmY0oYRNu2nTNdO2WqJykXMGr62uaEEz8Brnx0VMN3jy5VaSJ9PL/IzzMXgbE4ENmXheJW1E+1al3un",
-	"Wf0eCo3objMDUorSifZsTlIQYcyWrCDo1Z3aFPXpkZtuYLbWYTdFiM75PU1659y9hMWc7Ob2zVLcOs3p",
-	"zSYmxAt/KO9Amn3Wjf48I3fhJLVh4A/DPxKJU47GNcJy3wevSL4PdkSFn3e8JkLSkEGgdRNkJMgDAeiJ",
-	"h24ErUZBdlFucmltDGiN8ObntvjxojZL741MQUh8hz3gxbHMdbsQTOHA+ciJvV8EpERLedNHCY3l7wuP",
-	"9qw3XCTRFjmlidagLFsSXbEwCohXX4c4855XSSccXQqhiXmZFkUijN3qcfBMxYRjngRyTYsPzzW+ZVLp",
-	"c8QH5D/1B27FYcsxki0q1dETcj6ng8CKQpQ/CFT8FcbW/weYnU3ejm4WZ/jv3IGoEqKF9faeBws4cHKL",
-	"Y1rHrkefk5kr91NKyJhqOxTcepEmxNuCZHPnXwsb3Y79vXeZoF+EvsdxmHt/IPJjZGQLngMO5vqof2Tm",
-	"1MMBkqclRaodQkngL8Xr4qLqe66de5aGuVsqpyhx44GpnLrl4ocuD9eBl1eloLvOwbd+A7eJC79e29Bc",
-	"ZYMrzFxfv9azIQnF0tVgTHfMcXaUsjD3LwrzQRKcWVS6MRwkScKqRe592Wta/pJRnobmLhpxv6eA/NKi",
-	"34yGj4J5xe14oQAqxop7ti7m4+DFILjp9pRc8xOiltS/Ldyfjz/7fDQeAa9WZvH199F45L6+Sb3U8k0y",
-	"rrROpNPxEXXVBB4oUtLtkGD2valzkvitMwV9eJFGaTZLv+m+N3uGD1cXgHDBkdUje7E3qMuf81cCoJ3E",
-	"0Dqs4cRYkqzTA4Wt2Jcp6Je+tPg29XtPtY8W961YsddJrlGI5d14tLBJyrA6ya+uVt2H3XYPQU++QLf0",
-	"+6QBs4hJrLUxeTRVlNRtQEEW1y1RIQMjr7NKMr29NPj3anf2600qGdR3IT2Ty/kVLPBO9tXiBrj3MauT",
-	"OVXKS9ffCVqg9GkdA7iROUUxJd/YCiHuWvzywexf4dO/PcnPPn30r7O/nX12lsGTz744O6NfPKGPvvj0",
-	"ETz+22dPzuDR/PMvZo/zx08ez548fvL5Z19knz55NHvy+Rf/+sBQugHZAuor/zwd/efkvFiIyfmri8mV",
-	"AbbGCS3ZD2D2BjVsc0xQiEjN8IqFFWXF6Kn/6X/5i3KaiVU9vP915OpBjpZal+rp6ent7e007nK6wBwo",
-	"Ey2qbHnq58Fclo33yquLEBdkff9wR2ubE25qyO9nvv30zeUVOX91Ma0JZvR0dDY9mz7CfIolcFqy0dPR",
-	"p/gTnp4l7vspZtE+Va4Yz2kIHX037nwrS1uqx3xahDSg5q8l0AJZpPljBVqyzH+SQPOt+7+6pYsFyClG",
-	"jNmf1o9P/dvj9K3LK/Nu17fT2Bvt9G0jOU++p6f3p9rX5PStL/e/e8BGKXfn52qQmnSU+A60S6NnNZaJ",
-	"PEdon3Sjj4nCujvmp1IyYc7r2IgLOaA3EbrUSqwvomXFM+tiYqcAjv99cf6f6Gbz4vw/yZfkbOyCnBSq",
-	"QVLT21QRgdAucgt21zdafbU9D5mgapec0dPXKdW0c1Avq1nBMvOKmPqTasgwOkhhyJpRoiFiZC8K9A8I",
-	"bN+w8rPJF2/efva3d0mP9K5zWu3VufNrR8IM+I0yJzXcTIQv5I74XtHNl33Y3riAGTPuPyqQ23r5K2pu",
-	"6XqpAyXA5K+JlJk+8vHW1UyPna4jd+x/v3z5IxGSOMXeK5rdhKhPHwFcRz3HAcCmZ9/a3O0dL8/L1S58",
-	"dKUWZbOWQNADvMGi0Ago8qzHZ2eeUTulR8QFTh1ziWZqicZdakYPwsiG0k3/oQhsaKaLLaEqcuFCd2xf",
-	"wL0VmyvKSSNCaKfVpjuj25JkeNWhGUgSpXCEpsUe+K5axa4b6HDvltLc5PtTfnSQkYQgme423lpPI3/t",
-	"7v+M3e2KPqQU5kwzDDip7zV/ZzaAdAJvsfXg9qRhmpL/EhUKqObpUWkILFBIZGfhVrbmWjeny0IXvdLq",
-	"mEj8cnLSXvjJSe2RPIdbZLKUY8M2Ok5OpmannhzIynaa0BoVCQadnUOG62zWC7oJihRKuOATDguq2RpI",
-	"5E315OzRn3aFF9wG4BiJ3L4c3o1Hn/2Jt+yCG+GJFgRb2tV8+qddzSXINcuAXMGqFJJKVmzJzzxEONmX",
-	"FconXfb3M7/h4pZ7RJhHcbVaUbl1kjoNPKfiUSnCnfynk/+tluaRi9KFQjc9lIOt4Ozz3vLF6M07/9AY",
-	"+MDZ1ex0hvW6hzaF+FXU/wRCLaU6fYu2vd7fT52eL/0Rza/2hX7qlZc9LW0OwvTHxtPrrd6YhewezrSJ",
-	"xsuozpZVefoW/4OP7WhFtv7Pqd7wU3RXP33bQIT73EFE8/e6e9wCy1Z44MR8rvCxuOvz6Vv7bzQRbEqQ",
-	"zFxHmBrZ/Wqz4Z+qqiyLbffnLc+SP3bX0Uj63fPzqdf1pN7tzZZvG382aUotK52L22gWtJJax4AuZOZj",
-	"pdp/n95Spo2Q5LJG07kG2e2sgRanrjJh69e63E/nC9Ywin5siVWlsInTms/mn+jtVSPIXdosQF8J1Ib0",
-	"MdzNZMY4cqGYS9a6T/ux+0Tq8EasH7/VtdNJQgbVgsykoHlGlTZ/1GVGmu/vd/d8f7WTFl0k/AYQTNRp",
-	"dG0khp9M99qHcdwhQma0L+TimZ+wjqx974JZB6KvaE58pr0JeUELs+GQk3Mn/jew8b6Fqo8vBX1kseWD",
-	"yRlf+cOnCMW0o40HokxnA4vq8A4RKswr0jCABfCJY0GTmci3ruLpSNJbvbHJh9rM7ZQ2b4ymtpNKulJ9",
-	"H4+gCv1j6z/3qT3/Uhn+pTL8S6n0l8rwr939S2V4dJXhXwq1vxRq/08q1A7RoqUEUqco6pdL2Rq4Dbtp",
-	"vRBpXWoosPhmAkWmg/TWiIXHqkZMTwm5wuxX1NwSsAZJC5JRZaUrl6lthc7mmIYR8qfXfNKAxDpwm4k/",
-	"qf9r3eyvq7OzT4GcPWz3UZoVRcybu31RMsZPNkjuS3I9uh51RpKwEmvIrZdaXGfC9to77P8Xxn3ZKWiD",
-	"qTwwvZjP1khUNZ+zjFmUF4IvCF2IOnoE01VzgV9AGuCsbyNheuyi7ZhL8WB3pVUOoynjdyWAi3oL93o4",
-	"tMgl7dtgCO9Ax4Z/Sak8/pLSBzK7XQn97stId47d4ap/cZUPwVU+Ol/5s5tzIyXk/0gx88nZkz/tgmKV",
-	"9Y9Ck2997Ns9xDGXKzlLFly8q6Dls115xWDtJx37HeMtGjyOX78xF4ECufYXbO1G+/T0FJMnLoXSp6ik",
-	"arrYxh/fBJjf+tuplGxtoHmHelAh2YJxWkycH+qkdpV9PD0bvfu/AQAA///38T8KmyYBAA==",
+	"H4sIAAAAAAAC/+y9/XMbt5IA+K/guFtlW0tSsuNkX7yV2pPjfOhiJy5Lye5e7EvAGZDE0xCYB2Ak8vn0",
+	"v1+hG8BgZjDkkKLtZC8/Webgo9FoNBr9+X6UyVUpBRNGj569H5VU0RUzTMH/6IxPzKZk9u+c6Uzx0nAp",
+	"Rs9G54KcP78g9uOY6CpbEqrJg4cVF+aLp2Oa54pp/ejBmEhFqCDnv7xqtz3/5dWlUVwsHoyJkSRnmcwZ",
+	"MUtGZnJNbmhRMUL1lFwtmfuYu1+5JoqZSgmW25Hcxwl8nI7GI24B/EfF1GY0Hgm6YqNn9UrGI50t2Yra",
+	"JeHSRhrAGN3djUcO8OR6aZbJShhSVrOCZ+SabcJkJTXLaC43yHik2D8qrlg+emZUxeKpS2oMU7bvr+eT",
+	"//ts8uW795//7W40bsE0Hq0nC+n2YDSjmmd6eu7Gv9v1lZZlwTNqlzDheXpRdRPCcyYMn3Om+hbWHG/b",
+	"+lZc8FW1Gj07C0viwrAFUz1rKssLkbP16G7nZ6o1M73rsR8HrMSPcdQ12EG3rqLRIKMmW5aSC5NYCYGv",
+	"BD8nlxB137aIuVQratrtI/ID2ns8fnx29y+BFB+PP/8sTYy0WEhFRT4J434dxiWX7iDt0dB/bSPgaynm",
+	"fFEppsntkpklU8AaFNOlFJoROfs7ywzhmvxflz/9aLnMK6Y1XbDXNLsmTABLmJKLORHSkFLJG56zfExy",
+	"NqdVYTQxEnr28QsHV4xJJiwt/Dr6u5ZiNB6t9KKk2fXo3TjBRwq+4olVvaJrS1FEVKsZU0TO7YI8OMjT",
+	"+gDCERPcK02SyIhHd32/rui6C96VqkRGDcsjAI2iQtPMtgAoc67Lgm4AtSu6/ups7ADXhBYFKZnIuVgQ",
+	"sxa6byl27qMtRLB1AtH20rBfSEkXLMLzlPys8ZKBr0ZeMxGog8w28KlU7IbLSodOPeuAqbffJ0pWIsWo",
+	"CHxwaO7hUdj3mAzqDYx4t/2bZlr3XhhE81VV4HXhGu5mttGI21bTxZ7mix7x45IvrjYlI3NeGKY0+Xul",
+	"TThLlQYKXDKiS5ZZyHIQPiwdaL4Q1FSKPXsrTuz/yIRcGipyqnL7ywp/elUVhl/yhf2pwJ9eygXPLvmi",
+	"hxgCrCmWoaHbCv+x46W5hlknsf5SyuuqjBeUxcfSku3Fiz4ixTH78Zzm1edBhAFScWNdrS9ejO4O6WHW",
+	"YSN7gOzFXUltw2u2UcxCS7M5/LOeA5XTufrnCCUd29uU89F4tJyl8GuPo7s+QMA7R3nuvBZq3rjP9msm",
+	"hWF4NUdizykw/2fvY0lOyZIpw3FQWpaTQma0mGhDDYz0r4rNR89G/3JaS9qn2F2fRpO/tL0uoZMVDhSz",
+	"jHhCy3KPMV5b6R1Evx7GY/kifCJzqcjtkmdLYpZcEy5wJ+FAW85XsBsqzHS0F2e5i8/3rw6Ieivw0sat",
+	"aDGW3r0g2HDGNBwAJ4Q/0A3JFTBOAOOEipwsCjkLPzw8L8saufD9vCwRVWPC54RxkC/YmmujHwFmaH3S",
+	"4nkuXkzJd/HYt7woiBTFhsxY/Sjhc3ePuHvFPQgsYmEN9YgPNIGdlmpqd82jQWtmjkGMIOUuZWGv5J1k",
+	"ZBt/79rGFGh/H9T5T099Mdr76c62Ig6pQE34S/1yJg9bRNWlKehhqem83fcwirKjbKElfVEj+Nh0Bb9w",
+	"w1Z6J5FEEEWE5raHKkU3XqKbgGTWpaCfNUPiKemCC4B2bB8IgqzoNe6HBLxbQmA6SP5IZjAoueVmWYuA",
+	"AfXTznvnz03IqT0ndsMpF5pQUnBtrEQEm6nJkhUgANOg6Iip6CCiGUALWxYRYL5VtEQyd19QmOOC0PAe",
+	"RFjveZMPvGSTMNefYxoAqA5m5jsZbhISDQqQJgzPC5ldf0/18giHf+bH6h4LmIYsGc2ZIkuql4kz1aLt",
+	"erQh9G0bAs2SWTTVNCzxpVzoIyyxkPtwtbL8mhaFnbrLzVqrhYEHHeSiILYxYStu7IOcCzgBC37DBLKe",
+	"KfmGWlVqWZKMFsW41pPIclKwG1YQqQgXgqkxMUtq6sMPI/vXEpwjzSwfNIxEq3E6FtDAKjaXCh7OipEV",
+	"hctpZd9IZdHsE5irpivWkp3gspSVYarxfLl44VfHbpgAnhSGBvDDGkEBEQ8+JefhE8wsJC6OKgaKHy6y",
+	"ospr/AV+0QDatq6vWlFPIVUOiidq7G9ckUwqHAIvfze5/YNRVXdG6nxYKjZxQyh6w5SmhV1da1GPAvke",
+	"63TuOJk5NTQ6mY4K08865BzQD4RCphLalp/gD1oQ+9kKOJaSaurhIKeATBP2A+5siyqcyTbQzNj9XaEe",
+	"j1jl2l5Qfl1PnmYzg07eN6g6dFvoFhF26GrNc32sbYLB+vaqeUJQB+XZUUdM2cp0ormGIOBKlgTZRwsE",
+	"5BQwGiJEro9+rT2X6xRMz+W6c6XJNTvKTsg1/jGI2T+X6xcOMql2Yx7GHoJ0u0BBV0zD7dYwy9hZatX5",
+	"+Uyqw6SJjqmkNggQakeNhKlxC0nQtCon7mwm1PXYoDUQCTqm7UJAe/gUxhpYuDT0A2BBGxoBfw8sNAc6",
+	"NhbkquQFOwLpL5NC3Ixq9tkTcvn9+eePn/z25PMvLEmWSi4UXZHZxjBNHjplH9FmU7BHyYcTSBfp0b94",
+	"6g00zXFT42hZqYytaNkdCg0/+DDGZsS262KtiWZYdQBwEEdk9mpDtJM32O9uPHrBZtXikhljH8GvlZwf",
+	"nRt2ZkhBB41el8oKFrppJHPS0mlum5yytVH0tISWTORA87AOrqnWbDU7ClH1bXxez5ITh9Gc7TwU+25T",
+	"Pc0m3iq1UdUxNB9MKamSV3CppJGZLCZWzuMyobt47VoQ18JvV9n+HaElt1QTOzcY5CqR96gorKVt8P2F",
+	"Q1+tRY2brTcYrjexOjfvkH1pIr9+hZRMTcxaEKDOhuZkruSKUJJDR5A1vmMG5S++YpeGrsqf5vPj6Egl",
+	"DJRQ8fAV03Ymgi0IF0SzTIpc79TmeOtkC5luqiE4a2PLG7RMP1QOTZcbkYEa6RhnuV/75UyPRG9EFqnC",
+	"LIwFyxdM7UTSkVRefZhCKB7oBKQWUy/hM1gEXrDC0G+luqrF3e+UrMqjs/P2nEOXQ91inM0ht329RpmL",
+	"RcEakvrCwj5NrfGTLOjroHTANQD0QKwv+WJpovflayU/wB2anCUFKHxA5VJh+3RVTD/K3DIfU+kjiJ71",
+	"YDVHtHQb80E6k5UhlAiZM9j8SqeF0h4vIntQs0opJkws54I+g2syY5a6MlrZ1VoDs0zdL3XHCc3whE4A",
+	"NTo9Ye06gq1wuiW9YYQWitHcKo+YIHJmF117XcAiqSYlVcaLdU4kHspvG8CWSmZMa2vBQrXxTnh9O7x/",
+	"zBbkwWpgFWEWoiWZU/VhVnB9sxP4a7ZBD0hNHv7wi370R1mEkYYWO7YA2qQ2oq2+6y7lHjBtI+I2RDEp",
+	"o7YQTwIxEl4GBTOsD9n3x17v9rfB7BDBB0LgDVPgVvNBj5af5AMQZYD/Ax+sD7KEqpxYMbBX/WAlV7vf",
+	"ggrpZcMdM4QJCqrNZNeVYhvFi9Z2qREXT90iMHCPPPmSagNiIOEiB/0tXoUwD/SBKUZ7OrnBlL2vMTvp",
+	"L/4h1p02k0IzoSsdXmW6KkupDMtTywObde9cP7J1mEvOo7HD089IUmm2a+Q+BEbjOzziShB31AQLtbN5",
+	"dxcHXgdWfNnsi+UGfDWOtsF46VtFiI+dfHtg5LreAyQ3rlv0NpOyYBRUptrIsrQcykwqEfr1YfASW5+b",
+	"n+u2XZJEMxDMSXLJNJiYXHsH+S0iXYOta0k1cXB4/wRQeKGfXBdme6wnmouMTbadF3gE21bxwTnouFfl",
+	"QtGcTXJW0E3C2wI/E/y8J2H4sYFAav2BNGwyA2timkbqM+H9Xw+bVcJUCe7+oyTwhWT2nNtnVE1qrvfh",
+	"k+YMpk3xTUesD8IsAEaSDvx4gCykp8SIcPffSGPJChvhatytdM+19GAvzPpBEAjjTmpFQHv2/2Haze3b",
+	"HHf+DdN9C6+nPtaye9T/cLc3LszWVda6bZJXRC9f3sEY+3hQjy3iNVWGZ7yE5+oPbHP013t7gqSvBMmZ",
+	"odzqlaMP+JIv4/4EfZHbYx72mh+kbu2C39G3JpbjPbOawF+zDahNXmOERaStOoY6IjEq4RpMkRZQ7zpv",
+	"XzxxE7ammSk2hILAsSG3TDGiqxl6rXRNaEaWk3iAdAxX/4zOIJ80h2/1ELiEoaLlpTwP8bW1Hb6r1pOr",
+	"gQ73yiqlLBL6z/aJ7yAjCcEgdyFSSrvrnBbFhpgQxuMpqQGkuyCKjQfXXUsxmmEF5H9kRTIq4IVbGRaE",
+	"NKlA8rF9YQauozmdq2qNIVawFcPXPHw5OWkv/OTE7TnXZM5u0eVGQMM2Ok5OQBX3WmrTOFxH0Hbb43aR",
+	"uHTAVmkvWfdqa/OU3U5ubuQhO/m6NbifFM4UhNH45d+bAbRO5nrI2mMaGebgZ9YDV37VdAnrrBv2/RLD",
+	"j45hqGQ3tJjIG6YUz9lOTn4Z4p6+uaHFT6Hb3XjE1iyzNJqxSQZRiwPHYle2DwY62nG44Ib7wJGhALEL",
+	"7HWJnXa8tGu/Zb5asZxTw4oNKRXLWI6GE66jEK8pgWFJtqRiAS8gJauFc3XGcYDhVxo1YdZq2R5iX1HM",
+	"rMUETBg6GTYHZksf/WmFMEbty7Zt/8DH2i0NoLC8cWUM3J62PShpMh2Peh/+Ft839cMf8dYMYT3UmNiQ",
+	"DyOk1dAMtJ4BPq2s1EVivI314bMveIzo+7AmRrsHQQHk2QFOjFkBMISzDXW05cGXE3uB5tYe+yr+iOPT",
+	"uWGKcLM3vW4Ll7RA1tGR7TUkjfnevpseDE1SsRGYmO2YGkcWYgJiPXxlpcyW04F6gqRtdtwM66wBH8Tr",
+	"l8wZM4HyukGlSG+2xYexCtZDp8DrThwFIdQf++IQrH6r2BxBKMeBiGKlYtrC31A7a/wq5+QVz5Q8LxYy",
+	"yFh6ow1bdY2F2PW3nlP35hCNixQFF2yykoIlVEg/wddX8HGwmhvFvp4RQQDfa8D2Q7uBhNYCmpMPoeX7",
+	"bhKQTPuuaVvW9bdSHcurAwcc/IYd4Cmx043ITXmoP4d1se+6QKC6q8v/xyEIgStCtZYZB35/kesxnlbn",
+	"NYFhFC30vw6heEc4wO1xW7b+KOwPDUesKAklWcHBrCSFNqrKzFtBQbMcLTXhnOqVUf1miK99k7TdI2GW",
+	"cEO9FRQck4O+OXl3zVlC7/ktY94aoavFgmnTetDPGXsrXCsuSCW4gblW9rhM8LyUTIGH6BRb2viTuaUJ",
+	"I8k/mZJkVpnmE3dVaUO0sUYNdDyw0xA5fyuoIQWj2pBX3LrB2eG835I/soKZW6muAxamwxnXggmmuZ6k",
+	"PWu/w68QxORwsnQBTfZv19l72Ne5UUZ27Y2kLf/Pw/98ZpO10Mk/zyZf/tvpu/dP7x6ddH58cvfVV/9v",
+	"86fP7r569J//mto+DzvPeyG/eOF0Qhcv4OEfxSW1Yf8jGABXXEySRBk7sLVokTyEfDGO4B419cxmyd4K",
+	"67JopE1FxXNqjkg+7Wuqc6DxiLWorLFxLbWxR8Cez+97sCqS4FQt/vpB5Ln2BFsdvOItb8W0OM6ojw6g",
+	"GzgFV3vOlBv3g+++uSKnjhD0AyAWN3SUyiLxYsYPTa8yu0txIOFb8Va8YHPQP0jx7K3IqaGneJpOK83U",
+	"c1pQkbHpQpJnPgj3BTX0rehcQ70J1KIg+iiDWopT0FV6LW/f/mr1um/fvuv4vXRlKzdVzEXdOeuqZf2U",
+	"Eys3yMpMXBKjiWK3VKVsbz6vDG4U9t4KB8okskKlqRufuPGnQ6EsS91OLtJFUVkWFkURqWqXH8NuK9FG",
+	"hkBFrkOst6WBH6VzYlL01qtYKs00+X1Fy1+5MO/I5G11dvYZI42UGr87HmjpdlOywYqW3uQnbf0KLBzl",
+	"cghimJR0kbLRvX37q2G0BAoBgWMF78uiINAtxkmIPIGh6gV4fOyzJQjZ3nHksNxL7OXT2qUXBZ9gU5ux",
+	"+vfawSgLw8EbuCOTA63McmI5QnJV2h4Dv1eObxC6oFxo77Gi+QIeAHopK7tkq4pk2bXL7MZWpdmMG93l",
+	"vHEXe4bDNegoXTDqnFv8ZVTYAasy99ogKjbtvEoag29g0Dfsmm2uJHafDsyOF2VjjFL66L6jC7Qb3bWW",
+	"fOOD7MZob77z8/MxyS79DcT5erJ4FujC9+k/2igAHOFYp4iikVemDxFUJRABHfpQcMBC7Xj3Iv3U8rjI",
+	"mDD8hk1YwRd8ViTY9H917WgeVkuVimWM33htXxhQW9MaN5rM8Dp2LyZFxYIRCo4zpdS0AP3gNOlYAtLh",
+	"klFlZoyarfYBEac18dDZ/uTWnixUmkB6V7a2+80NKEEEu2W5e3tjG+e4Pj3IfQ/XxPIDQfXd66D86SGP",
+	"CIfwRD5Hf9+HPQnvBecPGVPn1TJ8X1kcLpS8tbtpAZQ+dSkkFIruqUrTBRt6HTVMkwNTsDQsjjDILukn",
+	"Ke9Yf4WmWNORMQYuArtPLF6S3IHZL5Y9gNmp5VLr50aTtbNi/WRTDzikzgoQqINDMpIOVQ27rljsB2ya",
+	"jTElamHVA9bEWnz0l1T7o5+PI45+oLT4aVIXbUvaeBF5e1LTTcnor+k2ax+jPmfGiBS2h0/d6PM1+iSN",
+	"o/FeCRfHI+RMyb2TAqTonBVsgTjBxp7O6nxg9W5aOH6az4HpTVKOo5EyMpJM3BzMPsROCEGNORk8QuoU",
+	"RGCDJwcMTH6U8WEXi32AFC6fGfVjw90V/Z+l7VkY/WGlZFnaW5/3WEkzz1JcOpVa5Gm51MMwhIsxsZz0",
+	"hhZMGB/oXA/SyQ0Ib59WJkDnS/So70008KC5NYJ0stcqocdB64sFb7+M9KtgrzXM5HqCkfjJp9VsPbNn",
+	"IhkfY3slDy9manygIau79WGDGw4DKvaGrh8yD1gNEmTes/iBfn1iI4K3HyDbBfkUNWvyMIjVNdn1SbKH",
+	"AdMjTveR3cMoZeORQGopMOs0+E6js1PP0pS2upJIfd2Oayu0D4tMsZq+w5ncyR6MdpWnzdyK39fpNfuT",
+	"8blGHyepZFcpd588oNgZANF7pQFtk0MDiC1Yfd0WYpNobbRq4TXCWoolES4Sxq4u2jQrGGgCJg25enLN",
+	"NmmFBgOZ4dJ3i/ScsHtUbB5F3peKLbg2rDYueKeqj2/7AXWifWzJef/qTKnmdn1vpAyCBnQk0LGxzI++",
+	"AgiVmHNl/eStZSa5BNvoWw2atG9t07Qg3NhswjWaevaWgwEiGzyY86JKk7ID6YcXFqIfw82lqxlclFyg",
+	"d9sMSkEkHcL3sE0CPBhIsBVBLxFBL+nHwM+wg2WbWpiUpbzm9H+SI9bihds4S4KWU8TU3dBelG7htVHu",
+	"hi6jjYToyO1ius3m0zmXuR97pzeWzyDRJ0TgSMm1RBk4056EcrGwIXiYWMsFIVMRUjASWkixqHNX2t+3",
+	"pKuc2loA2iV93JIv0oVDsL5giEY5HagKk4Q+aoaQ19GckOsSJlkwgZmCRvvX2ynkYkcgBrSINKMfl7d3",
+	"wjSSrupXLff02occ9zBsNmxPwWjunlWa+fVtP7Td7XKoG/c5uTdSEm8/YDAgUBw3OhJgOkTTw7lpWfJ8",
+	"3TL84ajTA0hioLjXrTzQwhmwJTfYDvw0Hdl31Kp6oIlzl3fGjlN45p/aRyb6zzsPcHs2aOayW+SVAmtS",
+	"wzu9W78hPDQHrv2HXy6NVHTBnEVwgiDdawhYzj5oiEogaGI4OuTnfD5nsSVMH2LFaQDXsXfkAwi7hwS7",
+	"5rLwttxKn10i20Fb9Qp2IzRNTwlK6fO5uOraI13bWLcWLpto4w4wKiYTWPzANpNfoFReSbnStW+qMxA2",
+	"r/U9aOJm9QPbwMg7XT4tYDt2BVRxbxhQaMq6Ej7pKCv9Ax1jDN/AjS3cY6fO07t0pK1xpVv6j0Z9Q8Ur",
+	"ai3lwx2b2kXGQjpkry7TXif2bLHmtrQJfdcW9UVPRJ3iJ0g8FQfvjUMuuZDZZad3GaOFJ3xY7OhuPLqf",
+	"v0fqnnQj7tiJ1+FqTu4CeGOi/b/h9LXnhtDSVs6gxcT5yfQJHUreOKEDmnu3mo/8vkqfiqtvzl++duBb",
+	"x4OCUTUJqo7eVUG78k+zKiz5sv0awvT/TreLqrBo80OK9tiT5hZS/be0aZ3aSrXfVD2e96yZpz3Fd/JN",
+	"5+KFS9zi6sXK4OlVW6Shc8u5i95QXnjDr4d2qJYdlzusmleST8QD3NtJLPL+u/dYvXECVuPiMVvbU9BR",
+	"KpRgSPjS6QM9nTu8Jn1Wa1rfwSFhnT9B5tz0u0u4vLrAGJ3DGT26HPitVI2LykXRJh3WPpyAaB8TiMe0",
+	"Uf7KWeE7YuGUoAj5++J3wjU5OYkP/snJmPxeuA8RgPD7zP0O76iTky7QePemWRZo8gRdsUchLqJ3Iz6u",
+	"GkKw22HiwvnNKsjIsp8MA4Wi55lH963D3q3iDp+5+8Va2u1P0yGqinjTEd0xMENO0GVfVGJwfl5hOVtN",
+	"pGgxC4zKtqQFV4+rGIN29u4REtUK7M4TXfAs7fQjZtqyJIEuvbYxgcaDbch2jor3+JWLikej22b6IJNn",
+	"ayHRrEmE62Tm6Rq/M+lYQCX4P6pGLLG9iVuXs38KwagdATutX3QDt6tmjw4peH1/E6HXqm1TGG01ub4I",
+	"ZkCPiFRdsz3jHeIZO8x/S6yCoyh/fUJg29K5Du+krK3vvO1F0J0Z2LNPZ3HtfyC5Gqy4mS+G7DTXk7mS",
+	"/2Rp2QGMhIlUMQ4QeLBB75SPapuRBc+BumB7PfsuAhmuW+gjlXvrEvyiQ5XGQ67wNJ/Yb6P3VBpE+92v",
+	"NtDpdPbjUXzI03DjR9IMpOlhZnBgI7dwqB3l3d2owBOKeVQakWfpcx610Kc4fn3OHcztXc8Kejuj2XX6",
+	"vWhhira/4ZhnJPGd/QbpkAoEZydRLENoyzG5ZMlUbT3qpuY+8O2H0w5+9dWPPNux8bwbo69KoWVimErc",
+	"UmGY92VBDuh6a4Z+GLbXrVSQUFanfQhzlvFVUhn+9u2vedb1/Mr5gmNJ/Uozl9kDvSJhIIJZa4GKXDX7",
+	"kPvGoeZiTs7G9Zn1u5HzG66tSz+0eIwtZlTDBR18IkIXuzwmzFJD8ycDmi8rkSuWm6VGxGpJwvscRM/g",
+	"CTtj5pYxQc6g3eMvyUNwGNb8hj1KXzBOWBs9e/zleFvleMD4nFaF2cbkc+DyPpAhTdngVY1jWLbqRk1H",
+	"JswVY/9k/ffJlvOFXYecLmjprqDdp2tFBbUIScG02gET9oX9BVeOFl4ENMqZNkpumllnovmZoZZj9UST",
+	"W4aIYJBMrlbcrJynqJYrS2F17Xuc1A+HuXOQPgJc/iO4YJeJN/4neG7RVZoeKHjV/wj29hitY0IxQ3DB",
+	"6/gLXxGZXPhM6FCHMJQfRNzYuezSQV61Wwglr7gwoDWqzHzyN/t8VzSzDHHaB+5k9sXTRD2/ZskrsR/g",
+	"Hx3vimmmbtKoVz1k76Uc19cG0YvJilvm/6hO6RCdyl5f8eS0ps/tuGfoe0vXdtxJLwFWDQKkETe/FymK",
+	"LQPekzjDevai0L1X9tFptVJpgqGV3aGf37x0kshKqlRllZoBOKlEMaM4u2F57ybZMe+5F6oYtAv3gf7T",
+	"erd5sTQS3fzpTj4WIqty4p0W0ipZSf+XV3U9BjBuY9xuS3spVUJP6zSOH9ktdT99YduGju6A8K0Hc4PR",
+	"BqN0sdIT7gE/130+hb9XGyTc84aq9PHvRNl3PMj6JycAtNWYYtPfnzQ/I3s/ORnuMpvWF9pfE6g57K5p",
+	"7Tj0TW21LYz77H1P1djgN+ZSlXS3OWfAVGr9dTs1CsMH2ZzQGQdEQPJMyBKCAqNVvCNe3ViY89nF8UFi",
+	"LVuQc0quqrJgWFMbPEO8SoSrkAl4jApc95kqfBVFvM+ux70l3VD4VOTKFVMF+XVKzsUGZkXIxoStZix3",
+	"sHE9bW2szdgyfUNvX7kCq9HXib7m5US6OtYTeCEyNXpmVMV6ZVGPFvt13FrCxxfbjhPuubcXd5qgGhTT",
+	"xs0nvp5gM+sAon722iz2nDx9efgehaBQ8lyuu2cwTUStW9/T0x8ART0oGahUhZV0ilknHU12eklFZGtH",
+	"nTHrrq0b9eoGO/38iXbBoma8ZS8qXuS/1Eb81sWuqMiWSZ/8me34G76iogaRAsiaqgUrkr1R2fCbV0ok",
+	"1CZ/lz3DrrhIf2ot3MHegrQGqwmEn9KPb3HFTWEniFHUzGcWMsQUC5kTmKcuNFSzxukogfhuWeYOPeGw",
+	"q8o4p27IPeHq/8x5Yf/qcSeAlhNFTQ9XVS5rbhiR3TBrroRLGkdnilC+AqlHU1ubDg7hDVN0AV2lYK3u",
+	"kPAORo6qCBFd2k/QEnLnSGIqJWzl2WgZTBiuWLEZk5JqjYOc2WWxNcw9evb47OxsmI0W8DVg7YhXv/Cf",
+	"6sU9PoUm+MUV6sP6JnuBfwj0dzXV7bP5XeJy1ZL/UTFtUiwWPmA8u+0M9zpWSg5VvafkO0jvZgm9UdHD",
+	"QlNnx26kVK3KQtJ8DDncrYsZwVmxj2KAOqjUvLDwt45I0kY2PMWsT1/Xk/pr+DjbMw9hmuzJlhzbL6FF",
+	"XfqZt5zHQLUaY2dKXqBWO/hF4SQEKgGoFcujbN2oRQHisH8YQ7OlbSCno60a+Z7iXcMrjnsOWFvborDh",
+	"G/8RBWsjfdFxrDk+JtKq+G+5TYK9pIbdsGa+Sw+Gt2f4/JfN1apKCCSc6R7Sa6hmt+8ueOBg3OCekoSs",
+	"tQ/3Np3WiVBkpTK2b232S+iVDntqFXpvuY1ghZu1r5EzJa+crSijQgqeQW2YlAhu30UDrdIDyuikzcV6",
+	"5M5y4hgmy8uH+H6Hxd6C8+NRA3Fdn5Doq91vJBz8r4EKAvYRu2BGOx7I8jHo93jBnH2TC81cvUJLXzFH",
+	"lSrhOZeMKgoeOEf06B+PIBldj6r6W/vtR2fasGeXXHMsEOCQ6l6CaJ8sNAc3BUG4IQvJtFttM6xO/2r7",
+	"TK/WAkB4N30pFzy75AsYAz05LVLQibo71Ll3qXYuzLbt17atKzUSfm54JOKkft3vkixEh/3vfLLlMfrQ",
+	"n3Kd835IEXLD+PFoW4hxa6QE3MuWDG0xCqINK+E+75ANUyr18PwGS1hYeoMWBAOfU0gpuEiA8ZILby9P",
+	"pxHLkncJbAyc5p5+OlPUZMsGk9rlL90TTQQ5CbLrYwzV2mBACazRz9G/jVdr4aq+9LCV0KB+XVCxIf5Q",
+	"WOqOhBIbpRx800GYaqr1rXTmhDH0tcZAZSfepdmKZesTH9ncQNfOONrQHYoX7XtP9SVrnVX5ghmb9jOV",
+	"tu85fCXw1cdj2gJKVajZF8J0m9nuu9TmJsqk0NVqy1y+wT2ny7mmWrPVrEh4Lr8IH1kedthSmjWR2X9T",
+	"Bev6d8bFDOwdPO8DBPL9Sjx0kwGkpGdL0xPNF5PhmIA75f7oqKc+jNDr/keldB83/4cIi29xuXiPUvzt",
+	"G3txxFnOOyESeLWEJOQQjuAV5Pj8DIlwW7YGikTbmdNtXmLLWsD7hknAb2jRk7AiNnrh/eoNFum0FVlv",
+	"VhZqXPI/Q0nNE4aoMPrTp6EDe8uw1rUO97moo4f6h7Q9OXxsRXq/ofaHhlkWnQZrhtJrjj3MYloTwb4m",
+	"U1fJoqsvpUUhs8GcwQ1zbjv1ZzqWq5UrHJBwarxZyTw+C7EzHGNpxsbz5M/uYZv8Bk+r5Bd1mx6toR8J",
+	"RDM06Rug0S1hjHGtHjwPDE7drhnmlGcOs+RbXjBvVBz1b2S0A90tdZnHkyrsvo0JgX5t8ljIBj628AAp",
+	"irT+W/eo1CG1Vvo0uGLiyQ/fajMUJEwztU/rl0MH7xDAQmJRrVTZkW5yn1G9HR75ETXU24scJaaOFFW0",
+	"i1Ul3j7QImJNTl3SGa1HAdKQkYbUxkqVYXIvBa+BxYvGpfPD2lSdslYdBvpiiHDYwcfdeHSR7yU+pUp5",
+	"jXCUFIN9yRdL89xqvL9nNGcKy7GknpNYjGVlbfZKL3kJ759Sal6X7y7sYC4P+hKGmw6NbILai/ZTyLHQ",
+	"Gcv7n9+wzEA599qLVjE23E2kTC/RQuANitDkE3jSKMZyVprlVmEJfeNLs6yr/DIXuGctrsyZLm6YGBM+",
+	"ZdN2rF9e59QiBaNzr4RVUpoBZbBD1BegMQY6RV+dkurbxcBOyrwoIyRWvp4Or2FzHkIqME4VXGR84q1W",
+	"ForB0e7zOcugXsDW7IX/tWQiSmc39qo7gGUeJTPkIdoSKl4cVaNdw1rQA0Et6EeBtC+fyDXbPNCkQUPJ",
+	"At4hQPmQBPqAHLTj+poMfaYN51fKdaAnQJAPI8DurC5RdUgNhSi554FgeBonNE74eRg0XqI5AAzbdc9J",
+	"e7MJgmDalxzxNeYdjq7y/pfyC2YoL7TzyaUhW3+sT7Kq8Xb19FuX7R/yVAZroc/7z7T/zee3xVkKfs3i",
+	"qsVgm7UpkX2Lo2QZhGaEp4Geh5l5HVfW9fLZ1y8HAzyzQloBaNIXV9sM9Aoe0A80uqrXOd8A6jlTiuXB",
+	"JlhIzSZGes/CPXKnInDbsKfBSf8gvLUCIvaIuMYV9ZageFPX4YBqmhRKTlDnux9jhSi2ohZ6FdXGSKtB",
+	"d+3Q1/jdp2Tx1RG3q1f78B7Oxe6C9j5ykesO5uPTNSdOONibezXyuBygmeVCMDXxRtx2ZQzRzDIKaanz",
+	"KkNRJT6bQXs9OGvbFm6WVGpm3VW2nlBRUpNrtjlFtY9LbxJ2PAYaZUgEPcrH3SKKo+qqdQruxVHA+7TZ",
+	"T0spi0mPZfCiW86jfRiuufXmIvay8oE9Vgp+0Dw2dhLyEAxSwWfkdrnxxSrKkgmWP5oSci4wuNK7jzQL",
+	"uLYmFw/MtvnXMGteYYEep4GevhXpKDUolKPuyf38MFt4Xh9v0kzk954fBzlgdrMWfT5yt1BRp1lmeTpU",
+	"vdH172iJUBH5IRQpAeoSDcFfA0tIvKMIJLeJsjCBfwAlzoBMdCETQQwHJeCxQ6UxFU8GABkmBjxXayjc",
+	"4EkEOCc7x61+umFK8TyBCv8F06pr7zEdcl26xNsDEtf2PVq3pCM1kkg3/4GZpXrT1HYK8ITrAv1SmRmD",
+	"NUksGhBxYa9owZjzURp0JQRklyWm/vLYTtm84yoUfckp6lhyI4liZUEzLHVnpJPcvJAXV0iSJhTv2R/0",
+	"KGvJNvB7K9FdgFPrDQfvJQdyu8iI6zxusqQPYEhyNLL1YLT3qusrw4wmvg5CT3Y20kqx1j0n5AegOHtt",
+	"UcVgk1ZM2E8sJ9eMlS6syDsM1oWJEh5c+a5IhYOykPZl8I2taXhkPkiiXreycW/G3q00uoWh1Xl1fPGb",
+	"AVys51Xx458gj9KBGZN8Do2DUyTVmZEc9rZt4nO57t+7NzHfcLGEeCVBRExn/8bIDQFi02Tch5ye/jif",
+	"6dECfbbF7KX08/326X0C3qwCSGKqEZcFRq596T8zaOK+Q9sbHeQ3fEdafffZJ46Xc6JY7R16aAZ9l5Qe",
+	"n5G6zzjTnjnM0nybzaVi8YwQ6YKVNkJqAsvZCPwx40ZRtTkkz30TVSm+2YvlnfEaIVSjXkgdrtHFYVHI",
+	"2wk8rCahPGZKWrHtdFNx4AvN1/2IkZByKQR+UO3klw1Z0pxkUimWxT3SOXoQqpVUbGIrqiQz8L3kc6NJ",
+	"wVfcaALC34LI0p4CrGSbpqC+uSph6TufBJrsRQHSjl2p6xPR8cAp7fsfHcQmoDFaDBXermwfzD9W5y/G",
+	"RU/QSbGH8zHt8hU7DGHjLrxAOJhSs20WTivp5nwNdMOUToqKRlkm5VrA6A0SCuLSimuNoARauuVFAem/",
+	"+LrmByx4JKdRW8oSMLVtIwNYmPCyvYmBOcw2PahAsHWVZYzlLgBd+6cwPreh3QNNlA9UdTHo45BPUzH3",
+	"EnRjcu2CnywXf4McWJMe6kwvvkd12ZDYm3nwoAcpFctYSB4YM8DLOJ8wMUslq8Uyqm4VNslbTlTl7Crx",
+	"KD/rCkJCIMGJneIpWUltnFUCR6r3u47AeZhJYZQsiqYdFdWsC+dz94quz7PMvJTy2uaze/Qf0MaRFsjd",
+	"3pa05NpItWkPC2v8Hr+BDlaPg43llrFruKoQRCkIVdnSFkt1s9TZxh5BchRIUOf5X0j5LAt7h7hBSiUd",
+	"gWluCQO4ozYU4j4swCjlwFUupAk7lo/9VO0QsBpjqpUJfWAhZdDB+gf64Kdk41mlffgDULPeXTUJ2xFT",
+	"o2vvt6y78jqOMLseIhGY73Zftbv9bM5TLKK5ruatm9bNnwtCjVzxLM18/1zRWL0xVD3U0+c+hUfXSFJi",
+	"bUJBjCxDUcj64kJ25GQ4f0s4jqZwI6ckTIesiIKXQZvpbY0y7XuCRinKnPLDcpGmiqddcD8upLS/Hqel",
+	"7+uJuogKRG0DPYIqTr6uP4hmrKe8cgMi+2zx78K9gYifnnsJ17F8lTqe2MMlOoVmIKnEknYI+QD5rktM",
+	"TFhGnRiduIvcub6DrOT0lrwzLpkzajpzR1J+QjLCiP0BMwOImGTPyiP2Lyc2ZlIbH/ofdLy+JoM/dcnH",
+	"G7kwJJcMlZeOT0Dv9sLwBYFYytMrcer0Sdar9N+9oIZKPsg3coEaEghBaEM2ULiHSK/7wWZHODpQht0L",
+	"qE7saQDwIXKMMfIzFHDh+OL3R3V9iYOA33FeG1dzXwjdZXRPQJOQ9bnnvk1X69sab3YFGSNnQ6POtPcJ",
+	"HfjQigDoj0NrwDAoGm1fMObUBitPqOl5ZoDHwzgyzjpNWzQ6dy8EmIVkFJ8O1rmQ8qJSzGUhRk2LajqP",
+	"ltQsvfBrm3f9n6xSzmXy+idTEvRj+ThyXnSpwdr2Y1lOCnbDGuF5SMvwztOa3zDfV4fOJGesBP/etltF",
+	"Ku4swmP7TnRrn0SRS0OwmzS+I2Jxp8gOy3pK8Rje0DuBirK5dR/e4IipKhZevAiXezbbTZlVhnCjE2/w",
+	"TFZFDnfFrH5bo2EsDBTYiv8e9Z+DDxt4+UXKKPsam5Jv1mUBXvG3y8102/rzHh+ae60alYrd7Qqr6SKD",
+	"m0hhCYzFnQAvmI4tO2N8Aaar+H9aM5dXBWQue/AtvsPhn5Jz/2ecvR16oMbYFy8GHYclGTu9M0l6HUcY",
+	"jmRUCIkSr9Vc1GuYNrPD4FxwP+RkSW+YT7lXa38UW8kbnxahxuPFC1SUWOmvMi3T7faHkbVW0nwTdJQL",
+	"gd0/0HsIX8N43eihV5I92Tc8r2iDD+l9BeCmB5a9EhPgdZRKE09lQ6f5GUd44wc49/1TD26PiXfD7vO9",
+	"r/I06rZd5DvjuSvdd3uKdDh3nD8/uNfCbHmIBsCroj4zuqS3ot8XrHt1gPY4kuYH7BSXIkLtN2uWvXb9",
+	"G9rog0eDd5NTB7PcKYR7HES8R4hlAWjFQ13JQiR8Ki0DF7LmC+BY5pWZdWki/wNODI24cMaGA/xN6iju",
+	"+1MKgcGIblUMSe5sfUzu52n5SU721oPdO16KRjRzCdW2mAf9aYn08bdOZlAr0HjBteKkSyfIoMSBA1m9",
+	"OnjQNF6pL5j3qkfq846+uCJfagNUSojucf+dHfJ02NgTqeAfIQ35R0ULPt8A30LwfTeil9SSkHPjx1gW",
+	"F/1uJ97+7Bl7wLwxSvqpcN186JjRcBs7SgS0FbCdRRRqT1yzeBsgTAf5cWYsI9bVDAw7VpRubWcXC27x",
+	"Psf4iuaxLQCqJW0a3EFGYsh/1MnD4ql8ERPwNsj95mm6anmMwiMlEJdZstU+asCriAR8q4hogw0oP8Ci",
+	"vCfrSukA+1znGmD36CWPtYyBhvFWoe4tafoGLeXYu3CcTFr7ego2FtfyGvwIu5Msc9a3jCHg/4F2peE6",
+	"NVBPHa8HmnyMXWikQ07Aiq4AM7meKDbXu8KZoLUFvgZYBxMuF/YVqFE9e/GTUyfVVby4sK9QjJ0Ozu1h",
+	"lJzNuahZLRdlZRLPXbCFiE2EsNijAtDa4yHdJ2NYUfSGFlssSlfgBg/RAK1K096LxPVN6q/dHnYH4LrW",
+	"zEBWu9pHIW5mr/+cz+dMYQSzNlTkVOVxcy5IxpSh3AYxbPTh7jq1i8MOhx0ayULNnK2R6w6QNgJSbKKX",
+	"8z2caQKA9IheNQO8Ya6WzFF/0xMm6FV6PD46MPwpvGFWdG0dqCD3Ws+BcMXawH0KmhEpwHSM0t2wdft5",
+	"NP8n2z4NlGNwjMhImHXYFEd27HFK3XlVeEeEhBcPVx3/nT0tFFyKn4DO4IX8s+BmK1tCs0g7Ux8GwyPX",
+	"iCzbIYMHUnKXWZRZerKymWDRy9HeMd0fDBZRWDJqvmOK6yExCMFxmTlju9seht1GlE/i+nNKlAkoV/SW",
+	"HB212RJwrZ3WrRMU2dbKIFLGLgHmnsp9NAn6S7MHPIwHcIyoOW2I4bLj7OOavz3l5aSU5SQbEv7svIcR",
+	"AA9pE8Ye+ojsjj3rDqFZOrhLxdTYDMbY0yuhv1T/LveVMtumz+BSvP76dZ9BHe6YwJkDwbm9pAY4bOsQ",
+	"dk9vJnXPvmBtutgkDzNI3Zg2vSFLbnZKh5AtKwbZ6tN2MII9eU0/zbT2AbDgwB4P2BWcbuvWJHwZfK0C",
+	"D23zXr3nvrD0NR1vSrLs5uX3558/fvLbk8+/ILYByfmC1WM6UD9+pp8y04O2On4WBeKBiDD3wAAtm3KZ",
+	"lvYxbzROX4q5VTMlK8NF7yOgbhABaaWfHgix+F7nwO4J9GWYthf4HurH4iMW89vJ/5KBsu08v6EiGxAq",
+	"ABkqXFg15H0BCZzGTkUah+yeA/Rm3cVLsBXOdIMxgdSg96+td7FXFHQmB0zomtkZrQERY2Ds0qLyCHOp",
+	"bMT0mOjSmgTh3QcNBbt1EE/JN/YhAP9BadgP1hkGVJNuTU8+9wDsXFk6otFhddA2D9lf8CveZ0e3Zb7A",
+	"OHLtijhAFLEVSuOgIyIV+F2MY/9k5YsrQPol8lPsDW1xC86Y3oncGZfB0dt6Q+uu/3bXY/sRnmNt7Csp",
+	"s/upUYtLrppjOTHK1IVx65plVBMbBkaobqwURBwmPpVX9fbXRZenJPfPmfgl3HI1+0MLVXSVtK66/mdj",
+	"SlqIxoVkKMBPe3K7HCLcADg+b1GIVmjwcrbx05I3LKsUeO3g4qlijnfnRIoMY6TYDVMbSLzr2h1FukFH",
+	"I7cGOW/BOUTqAcSPPf/fKfb0GHeHij/bXDnhYDSdopxhy+9APEjiJcUFoUSFzbilm2QkH9RqmDgA9jdv",
+	"twQ/SB/EqKpTkh1rWFihH6jHiR/UvHJOdm1Q7d2WwqVVjybw6ZPHHCCA9PsA9OcxvzfK7vah3CvvhpDS",
+	"2jWdR+UcDqzFnHPHAEoN5vYOnTYdL4Ku5QDqPERe94PUbwy3jxaElmfAxxXoO8sz6U1wG+sQ533gfYLZ",
+	"sCmOv6DSKkqp0Fj9AcTb1qMlqDZ15A/ZKxinTkD4x9qu1CKPvmMpFHz4PbMxeTNXi6VHs5twfU3tVuT8",
+	"atloyZTm2jBhWr7r3NTpz/QSHEigvPkNU0FOiC5Gwtbc9ARfphbSlz0L+Jn9RJxrLWHolGp5FfrobluX",
+	"s8WhDwcYBrwEHVxXrRidgqjjnupcY0BiixJiBWaLqbFShIg3YQ/pDboGgTC6l2CC03/wuzB4sPXfhIdw",
+	"ktr56w/DPxIlSo7GNcJyPwSvSAoSW/Kvn3ciVkJ5jkGgdUtRJMgDAOjJPN5IDx2ls42KqCv0I4PXzCoU",
+	"C2+KH69q7/udOSABEt9hB3hx1vC6XUhb6MD5xCW06wrq0VLe9VFCY/m7EpF71hsukmiLnDnQGKaRLcmu",
+	"WBilntdfh4zuPcadTuJ3JaWBePKiSCSMR1s9nKmYcLgwTN3Q4uNzjW+50uYc8MHyN/2KojhBeIxkRKU+",
+	"eunLl3QQWAX9uFDZV9ANE//F7M4mb0c3i3MW79yBYPanBWY1CY/zGybILYwJ9EEef0FmHBMnlYplXLed",
+	"0G+9SBMyWzNlvS5hCrY27Szb985y9Ys09zgOcx+LRX6MHCmDd7iDuT7qn5g59XCA5GlJkWqHUBL4S/E6",
+	"W4Kwvz5S49q5bqR36ya4I9pIxY5cNCkqkbhn0aR4ZVDCcvDyYB1weVWaddc5+NZv4DZx4ddrG1oVrIvc",
+	"/tJdZjakdBf+kOoO1cQQIbbRlACo5PfHv6MnC5ymkxOY4ORk7Jr+/qT52R7nk5PhpplPWEoMUenGcJAk",
+	"CasWuXfViWnFqkYVEZq7aMX99E6AHntTgphsW5F5JXA8z4ZdnJ1j63I+Dp7qErTyz8hbcUL0kvq3hfvv",
+	"k8+/GI1HTNjy3b+O6u+j8ch9fZd6qeXrZAbnumRNJz7XGc0eaFLSzZC08TuL1CTxW9fk+fgijTZ8ln7T",
+	"fW/3DB6uLoLwQgCrB/aCN6irVPNXqZ2txNA6rOHEIEnWhXjCVuyqyfNLXwF6LLLuK8xHHnQJ7lvxYmcg",
+	"1HPbyM9mc/JjObDfLJS/zb54+vGzs3sIeirzuaXfp+AWIiax1sbk0VRR+TSHqlpT0PDhijenmyD8Dq37",
+	"leJmc2nx79Xu/LfrVNml70IhJFddK3hZO9nXyGsmfBxRXTap0l66/k7SAqRPdP4WjBgpCxsaTldl4Xzx",
+	"yFcPZv/OPvvb0/zss8f/Pvvb2ednGXv6+ZdnZ/TLp/Txl589Zk/+9vnTM/Z4/sWXsyf5k6dPZk+fPP3i",
+	"8y+zz54+nj394st/f2Ap3YKMgPosms9G/z05LxZycv76YnJlga1xQktua03d3YGGbS7R5UgYmsEVy1aU",
+	"F6Nn/qf/01+U00yu6uH9r/ZGVLb50phSPzs9vb29ncZdThdQbWRiZJUtT/08d+MWxs9fX4TsMmgRhB2t",
+	"Xfemo5oUzuHbm28ur8j564tpTTCjZ6Oz6dn0sR1flkzQko+ejT6Dn+D0LGHfT6Fe9almxr6G9GlIkXg3",
+	"7nwrrXnKfVqEgpv2f0tGC7N0/1kxo3jmP0EYuPtb39LFgqkpxH7jTzdPTv3b4/S9yzB9t+3baRxxdPo+",
+	"+t+E5zt6+piZXU1O3/vsu9sHjNWjpy6W0SI16Qz/HTOuYB1qLBMVhcDN040+JloqV7aiVFza8wruUjlr",
+	"ukuNiVGVyODQOd0KE/Dnq/P/Bm+RV+f/Tb4iZ2OXYAbyHCanx6IMgdAucgS7G/+qn2/OQ82lOuxi9OzX",
+	"lGoaEUfKalbwzL4ipv6kWjKMDlIYsmaUYIgY4UVhcVqzfcvKzyZfvnv/+d/uklHH3QCkOnJv69eOhBnw",
+	"W1/ZjV0zkuRclwXdAL5XdP1VH7bXQofV/6NialMvf0XXo3ipAyXA5K+J4pQ+f9ZtlJg6lIGuQ25tYVYi",
+	"FXGKvdfWjBHnOxbSRAku4xyJtmff2tztHS/Py9UuEcRKL8pm1f6gB3g3HnlAgWc9OTvzjNopPSIucOqY",
+	"SzRTSzTuUrNdN41sKN1CG9b6QTNb04vqKEwHQm6NSeXGNrKcxANst9p0Z3Rbkkxts2+tj+7DGhx/dsCH",
+	"+Y16SN69W0p7k+92sekgIwlBsrBsvLWeRv7a3f8du9sVfUgp7ZnmkFSgvtf8ndkA0gm8xcaD21PwaEr+",
+	"R1YgoLo8NoEFSgXsLNzKXEdzetfI+pVW56OCLycn7YWfnNRRp3N2C0yWCmjYRsfJydTu1NM9WdlWE1qj",
+	"9v+gs7PPcJ3NekXXQZFCiZBiItiCgnNf5M339Ozxn3aFFwKTLFiJHF8Od+PR53/iLbsQhilBCwItcTWf",
+	"/WlXc8nUDc8YuWKrUiqqeLEhP4uQxQJfViCfdNnfz+JayFvhEQGBDKsVVRsnqdPAcyoRCg3u4D9txhNJ",
+	"88BF6UJDtBPIwSg4+wqzYjF6d+cfGgMfONuanc7keo+mLH4V9T+BMGrh9D3Y9np/P3V6vvRHML/iC/3U",
+	"Ky97WmK1v/THxtPrvVnbhWwfzraJxsuoyZZVefoe/oDHdrQicF7Qp2YtTiFi9fQ9z7ufO4ho/l53j1vc",
+	"rGTOPHByPtfM7Ph8+h7/jSZi65Ipbq8jWtS/Yt35U12VZbHp/rwRWfLH7joa5bV7fj71up7Uu73Z8n3j",
+	"v02a0svK5PI2msVHxZ+6mAC95dPpe/dXa9Ct7bZqHPbuOvS87hoYI3aGtx9+/HeM1JITfSdDDYMTlaBz",
+	"+7HS7f+f3lJurKjqqmTTuWEq1VkxunIk3vk5DY1htIA7Ar2j419zrqnWbDXrflEbVUWE1Rp6VLq4iKbC",
+	"4w29vWqktHMJzZ/LfLPlqlxPZlzA/RHfb7XWGj92H7d344Q9CwL3vbtQ4vVgJJkpSfOMYuCRYOZWquuO",
+	"5uTuni/ndiL9i4THB4AJ2qiudcveBNOdln0Yd8jzINqXqIJXnffqg4vUHYie05z4ALAJeUULu+EsJ+fu",
+	"4dbAxocWhz+9/PqJBc6PJiE+94dPEwqlWRtPe5WuUIEuNXBQh4iD9v1vGcCCiYljQZOZzDcTp1JT9Nas",
+	"MSFGm7md0uZd3/gG6lrd9/EISuw/tuZ6l8L6L2XvX8rev9SBfyl7/9rdv5S9R1f2/qUK/UsV+v9LVeg+",
+	"+s+UQOpUfP1yKb9hohk6716I1BU+LDY1i29G2HMTpLdGMjioSsjNlNi0FgrTZGt2wxQtSEY1cyUvIK/F",
+	"CsIEXN2JZ2/FpAEJut7biR/Wf2KAxNvq7OwzRs4etftgdo2IN3f7gmQMnzC88SvydvR21BnJFXdA/0Jo",
+	"7kIPsdfOYf+PMO5PqrO5K+qSf/saJ0RX8znPOKIcU3wsZB33Y/k2ERK+MGWBQ69Uwo0vP8FdAkbcFYiQ",
+	"hLjIhIzflQAu6i3c6ZvSIpe0V4olvD1dUv4tpfL4S0ofyOy2pdu/LyPdOvbd+C+u8gm4yifnK392Q3yk",
+	"hPxfKWY+PXv6p11QrLL+URryrY9avIc45iqMZSlN2MGCljcSecVg7eEee4zDLRp8xX99Zy8CzdSNv2Br",
+	"B+hnp6dQ2mAptTkFJVXTOTr++C7A/N7fTqXiNxaaO9CDSsUXXNBi4jyIJ7WT85Pp2eju/xsAo5s27e9M",
+	"AQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
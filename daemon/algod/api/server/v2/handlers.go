@@ -26,6 +26,7 @@ import (
 	"math"
 	"net/http"
 	"os"
+	"path/filepath"
 	"runtime"
 	"strconv"
 	"strings"
@@ -47,10 +48,12 @@ import (
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
 	specv2 "github.com/algorand/go-algorand/daemon/algod/api/spec/v2"
 	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/appspec"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger/blockstream"
 	"github.com/algorand/go-algorand/ledger/eval"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/ledger/simulation"
@@ -120,6 +123,7 @@ type LedgerForAPI interface {
 	Block(rnd basics.Round) (blk bookkeeping.Block, err error)
 	TxnsFrom(id basics.Address, r basics.Round) ([]transactions.Transaction, error)
 	GetStateDeltaForRound(rnd basics.Round) (ledgercore.StateDelta, error)
+	LatestTrackerCommitted() basics.Round
 	GetTracer() logic.EvalTracer
 }
 
@@ -135,7 +139,8 @@ type NodeInterface interface {
 	GetPendingTransaction(txID transactions.Txid) (res node.TxnWithStatus, found bool)
 	GetPendingTxnsFromPool() ([]transactions.SignedTxn, error)
 	SuggestedFee() basics.MicroAlgos
-	StartCatchup(catchpoint string) error
+	StartCatchup(catchpoint string, catchpointFile string) error
+	CatchpointImportDir() string
 	AbortCatchup(catchpoint string) error
 	Config() config.Local
 	InstallParticipationKey(partKeyBinary []byte) (account.ParticipationID, error)
@@ -148,6 +153,8 @@ type NodeInterface interface {
 	UnsetSyncRound()
 	GetBlockTimeStampOffset() (*int64, error)
 	SetBlockTimeStampOffset(int64) error
+	SimulationSessions() *simulation.SessionRegistry
+	BlockStream() *blockstream.Stream
 }
 
 func convertParticipationRecord(record account.ParticipationRecord) model.ParticipationKey {
@@ -499,6 +506,16 @@ func (v2 *Handlers) basicAccountInformation(ctx echo.Context, addr basics.Addres
 		return internalError(ctx, err, fmt.Sprintf("could not retrieve consensus information for last round (%d)", lastRound), v2.Log)
 	}
 
+	account, err := basicAccount(addr, record, lastRound, amountWithoutPendingRewards, &consensus)
+	if err != nil {
+		return internalError(ctx, err, errInternalFailure, v2.Log)
+	}
+	response := model.AccountResponse(account)
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// basicAccount converts account data to the API model, without resources (assets or apps).
+func basicAccount(addr basics.Address, record ledgercore.AccountData, lastRound basics.Round, amountWithoutPendingRewards basics.MicroAlgos, consensus *config.ConsensusParams) (model.Account, error) {
 	var apiParticipation *model.AccountParticipation
 	if !record.VoteID.IsEmpty() {
 		apiParticipation = &model.AccountParticipation{
@@ -516,10 +533,10 @@ func (v2 *Handlers) basicAccountInformation(ctx echo.Context, addr basics.Addres
 
 	pendingRewards, overflowed := basics.OSubA(record.MicroAlgos, amountWithoutPendingRewards)
 	if overflowed {
-		return internalError(ctx, errors.New("overflow on pending reward calculation"), errInternalFailure, v2.Log)
+		return model.Account{}, errors.New("overflow on pending reward calculation")
 	}
 
-	account := model.Account{
+	return model.Account{
 		SigType:                     nil,
 		Round:                       lastRound,
 		Address:                     addr.String(),
@@ -543,12 +560,10 @@ func (v2 *Handlers) basicAccountInformation(ctx echo.Context, addr basics.Addres
 		AppsTotalExtraPages: omitEmpty(uint64(record.TotalExtraAppPages)),
 		TotalBoxes:          omitEmpty(record.TotalBoxes),
 		TotalBoxBytes:       omitEmpty(record.TotalBoxBytes),
-		MinBalance:          record.MinBalance(&consensus).Raw,
+		MinBalance:          record.MinBalance(consensus).Raw,
 		LastProposed:        omitEmpty(record.LastProposed),
 		LastHeartbeat:       omitEmpty(record.LastHeartbeat),
-	}
-	response := model.AccountResponse(account)
-	return ctx.JSON(http.StatusOK, response)
+	}, nil
 }
 
 // AccountAssetInformation gets account information about a given asset.
@@ -629,6 +644,11 @@ func (v2 *Handlers) AccountApplicationInformation(ctx echo.Context, address basi
 	}
 
 	// prepare JSON response
+	response := accountApplicationResponse(address, applicationID, record, lastRound)
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func accountApplicationResponse(address basics.Address, applicationID basics.AppIndex, record ledgercore.AppResource, lastRound basics.Round) model.AccountApplicationResponse {
 	response := model.AccountApplicationResponse{Round: lastRound}
 
 	if record.AppParams != nil {
@@ -648,7 +668,7 @@ func (v2 *Handlers) AccountApplicationInformation(ctx echo.Context, address basi
 		}
 	}
 
-	return ctx.JSON(http.StatusOK, response)
+	return response
 }
 
 // BlockResponseJSON is used to embed the block in JSON responses.
@@ -1223,6 +1243,7 @@ type PreEncodedSimulateTxnResult struct {
 	AppBudgetConsumed        *int                                    `codec:"app-budget-consumed,omitempty"`
 	LogicSigBudgetConsumed   *int                                    `codec:"logic-sig-budget-consumed,omitempty"`
 	TransactionTrace         *model.SimulationTransactionExecTrace   `codec:"exec-trace,omitempty"`
+	TransactionProfile       *model.SimulationTransactionExecProfile `codec:"exec-profile,omitempty"`
 	UnnamedResourcesAccessed *model.SimulateUnnamedResourcesAccessed `codec:"unnamed-resources-accessed,omitempty"`
 	FixedSigner              *string                                 `codec:"fixed-signer,omitempty"`
 }
@@ -1234,6 +1255,8 @@ type PreEncodedSimulateTxnGroupResult struct {
 	FailedAt                 *[]int                                  `codec:"failed-at,omitempty"`
 	FailureMessage           *string                                 `codec:"failure-message,omitempty"`
 	UnnamedResourcesAccessed *model.SimulateUnnamedResourcesAccessed `codec:"unnamed-resources-accessed,omitempty"`
+	PopulatedTxns            []transactions.SignedTxn                `codec:"populated-txns,omitempty"`
+	PopulateFailureMessage   *string                                 `codec:"populate-failure-message,omitempty"`
 	Txns                     []PreEncodedSimulateTxnResult           `codec:"txn-results"`
 }
 
@@ -1262,6 +1285,8 @@ type PreEncodedSimulateRequest struct {
 	ExtraOpcodeBudget     int                                         `codec:"extra-opcode-budget,omitempty"`
 	ExecTraceConfig       simulation.ExecTraceConfig                  `codec:"exec-trace-config,omitempty"`
 	FixSigners            bool                                        `codec:"fix-signers,omitempty"`
+	PopulateResources     bool                                        `codec:"populate-resources,omitempty"`
+	StateOverrides        *model.SimulateStateOverrides               `codec:"state-overrides,omitempty"`
 }
 
 // SimulateTransaction simulates broadcasting a raw transaction to the network, returning relevant simulation results.
@@ -1277,12 +1302,24 @@ func (v2 *Handlers) SimulateTransaction(ctx echo.Context, params model.SimulateT
 	}
 	proto := config.Consensus[stat.LastVersion]
 
-	requestBuffer := new(bytes.Buffer)
-	requestBodyReader := http.MaxBytesReader(nil, ctx.Request().Body, MaxTealDryrunBytes)
-	_, err = requestBuffer.ReadFrom(requestBodyReader)
+	request, err := decodeSimulateRequest(ctx.Request().Body, proto.MaxTxGroupSize)
 	if err != nil {
 		return badRequest(ctx, err, err.Error(), v2.Log)
 	}
+
+	// Simulate transaction
+	simulationResult, err := v2.Node.Simulate(request)
+	return v2.simulationResponse(ctx, simulationResult, err, (*string)(params.Format))
+}
+
+// decodeSimulateRequest reads a msgpack or JSON encoded simulate request.
+func decodeSimulateRequest(body io.Reader, maxTxGroupSize int) (simulation.Request, error) {
+	requestBuffer := new(bytes.Buffer)
+	requestBodyReader := http.MaxBytesReader(nil, io.NopCloser(body), MaxTealDryrunBytes)
+	_, err := requestBuffer.ReadFrom(requestBodyReader)
+	if err != nil {
+		return simulation.Request{}, err
+	}
 	requestData := requestBuffer.Bytes()
 
 	var simulateRequest PreEncodedSimulateRequest
@@ -1290,23 +1327,24 @@ func (v2 *Handlers) SimulateTransaction(ctx echo.Context, params model.SimulateT
 	if err != nil {
 		err = decode(protocol.JSONStrictHandle, requestData, &simulateRequest)
 		if err != nil {
-			return badRequest(ctx, err, err.Error(), v2.Log)
+			return simulation.Request{}, err
 		}
 	}
 
 	for _, txgroup := range simulateRequest.TxnGroups {
 		if len(txgroup.Txns) == 0 {
-			err = errors.New("empty txgroup")
-			return badRequest(ctx, err, err.Error(), v2.Log)
+			return simulation.Request{}, errors.New("empty txgroup")
 		}
-		if len(txgroup.Txns) > proto.MaxTxGroupSize {
-			err = fmt.Errorf("transaction group size %d exceeds protocol max %d", len(txgroup.Txns), proto.MaxTxGroupSize)
-			return badRequest(ctx, err, err.Error(), v2.Log)
+		if len(txgroup.Txns) > maxTxGroupSize {
+			return simulation.Request{}, fmt.Errorf("transaction group size %d exceeds protocol max %d", len(txgroup.Txns), maxTxGroupSize)
 		}
 	}
 
-	// Simulate transaction
-	simulationResult, err := v2.Node.Simulate(convertSimulationRequest(simulateRequest))
+	return convertSimulationRequest(simulateRequest)
+}
+
+// simulationResponse writes the result of a simulation, or its error, in the requested format.
+func (v2 *Handlers) simulationResponse(ctx echo.Context, simulationResult simulation.Result, err error, format *string) error {
 	if err != nil {
 		var invalidTxErr simulation.InvalidRequestError
 		switch {
@@ -1319,7 +1357,7 @@ func (v2 *Handlers) SimulateTransaction(ctx echo.Context, params model.SimulateT
 
 	response := convertSimulationResult(simulationResult)
 
-	handle, contentType, err := getCodecHandle((*string)(params.Format))
+	handle, contentType, err := getCodecHandle(format)
 	if err != nil {
 		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
 	}
@@ -1331,6 +1369,236 @@ func (v2 *Handlers) SimulateTransaction(ctx echo.Context, params model.SimulateT
 	return ctx.Blob(http.StatusOK, contentType, responseData)
 }
 
+// simulationSession returns the simulation session with the given ID.
+func (v2 *Handlers) simulationSession(sessionID string) (*simulation.Session, error) {
+	if !v2.Node.Config().EnableDeveloperAPI {
+		return nil, errors.New(errSimulationSessionsNotEnabled)
+	}
+	session, ok := v2.Node.SimulationSessions().Get(sessionID)
+	if !ok {
+		return nil, errors.New(errSimulationSessionDoesNotExist)
+	}
+	return session, nil
+}
+
+// simulationSessionResponse writes the latest round of a simulation session.
+func (v2 *Handlers) simulationSessionResponse(ctx echo.Context, sessionID string, session *simulation.Session) error {
+	hdr, err := session.BlockHdr()
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+	response := model.SimulationSessionResponse{
+		SessionId: sessionID,
+		Round:     hdr.Round,
+		Timestamp: hdr.TimeStamp,
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// StartSimulationSession starts a simulation session.
+// (POST /v2/simulate/sessions)
+func (v2 *Handlers) StartSimulationSession(ctx echo.Context) error {
+	if !v2.Node.Config().EnableDeveloperAPI {
+		err := errors.New(errSimulationSessionsNotEnabled)
+		return notFound(ctx, err, err.Error(), v2.Log)
+	}
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		// node is currently catching up to the requested catchpoint.
+		return serviceUnavailable(ctx, fmt.Errorf("StartSimulationSession failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+
+	buf := new(bytes.Buffer)
+	_, err = buf.ReadFrom(http.MaxBytesReader(nil, ctx.Request().Body, MaxTealDryrunBytes))
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	var req model.SimulationSessionRequest
+	err = decode(protocol.JSONStrictHandle, buf.Bytes(), &req)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	var overrides *simulation.StateOverrides
+	if req.StateOverrides != nil {
+		converted, convErr := convertStateOverrides(*req.StateOverrides)
+		if convErr != nil {
+			return badRequest(ctx, convErr, convErr.Error(), v2.Log)
+		}
+		overrides = &converted
+	}
+
+	id, session, err := v2.Node.SimulationSessions().Start(nilToZero(req.Round), overrides)
+	if err != nil {
+		var invalidTxErr simulation.InvalidRequestError
+		switch {
+		case errors.As(err, &invalidTxErr):
+			return badRequest(ctx, invalidTxErr, invalidTxErr.Error(), v2.Log)
+		case errors.Is(err, simulation.ErrTooManySessions):
+			return serviceUnavailable(ctx, err, err.Error(), v2.Log)
+		default:
+			return internalError(ctx, err, err.Error(), v2.Log)
+		}
+	}
+	return v2.simulationSessionResponse(ctx, id, session)
+}
+
+// EndSimulationSession discards a simulation session.
+// (DELETE /v2/simulate/sessions/{session-id})
+func (v2 *Handlers) EndSimulationSession(ctx echo.Context, sessionID string) error {
+	if !v2.Node.Config().EnableDeveloperAPI {
+		err := errors.New(errSimulationSessionsNotEnabled)
+		return notFound(ctx, err, err.Error(), v2.Log)
+	}
+	if !v2.Node.SimulationSessions().End(sessionID) {
+		return notFound(ctx, errors.New(errSimulationSessionDoesNotExist), errSimulationSessionDoesNotExist, v2.Log)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// SimulateSessionTransaction simulates transaction groups in a simulation session, adding them
+// to the session if they all succeed.
+// (POST /v2/simulate/sessions/{session-id}/transactions)
+func (v2 *Handlers) SimulateSessionTransaction(ctx echo.Context, sessionID string, params model.SimulateSessionTransactionParams) error {
+	session, err := v2.simulationSession(sessionID)
+	if err != nil {
+		return notFound(ctx, err, err.Error(), v2.Log)
+	}
+	hdr, err := session.BlockHdr()
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+
+	request, err := decodeSimulateRequest(ctx.Request().Body, config.Consensus[hdr.CurrentProtocol].MaxTxGroupSize)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	simulationResult, err := session.Simulate(request)
+	return v2.simulationResponse(ctx, simulationResult, err, (*string)(params.Format))
+}
+
+// AdvanceSimulationSession adds empty blocks to a simulation session.
+// (POST /v2/simulate/sessions/{session-id}/advance)
+func (v2 *Handlers) AdvanceSimulationSession(ctx echo.Context, sessionID string) error {
+	session, err := v2.simulationSession(sessionID)
+	if err != nil {
+		return notFound(ctx, err, err.Error(), v2.Log)
+	}
+
+	buf := new(bytes.Buffer)
+	_, err = buf.ReadFrom(http.MaxBytesReader(nil, ctx.Request().Body, 1024))
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	var req model.SimulationSessionAdvanceRequest
+	err = decode(protocol.JSONStrictHandle, buf.Bytes(), &req)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	err = session.Advance(req.Rounds, nilToZero(req.Seconds))
+	if err != nil {
+		var invalidTxErr simulation.InvalidRequestError
+		switch {
+		case errors.As(err, &invalidTxErr):
+			return badRequest(ctx, invalidTxErr, invalidTxErr.Error(), v2.Log)
+		default:
+			return internalError(ctx, err, err.Error(), v2.Log)
+		}
+	}
+	return v2.simulationSessionResponse(ctx, sessionID, session)
+}
+
+// SimulationSessionAccountInformation gets account information at the latest round of a simulation session.
+// (GET /v2/simulate/sessions/{session-id}/accounts/{address})
+func (v2 *Handlers) SimulationSessionAccountInformation(ctx echo.Context, sessionID string, address basics.Address) error {
+	session, err := v2.simulationSession(sessionID)
+	if err != nil {
+		return notFound(ctx, err, err.Error(), v2.Log)
+	}
+
+	hdr, err := session.BlockHdr()
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+	record, lastRound, amountWithoutPendingRewards, err := session.LookupAccount(address)
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+
+	consensus := config.Consensus[hdr.CurrentProtocol]
+	account, err := basicAccount(address, record, lastRound, amountWithoutPendingRewards, &consensus)
+	if err != nil {
+		return internalError(ctx, err, errInternalFailure, v2.Log)
+	}
+	response := model.AccountResponse(account)
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// SimulationSessionAccountApplicationInformation gets account information about a given app at
+// the latest round of a simulation session.
+// (GET /v2/simulate/sessions/{session-id}/accounts/{address}/applications/{application-id})
+func (v2 *Handlers) SimulationSessionAccountApplicationInformation(ctx echo.Context, sessionID string, address basics.Address, applicationID basics.AppIndex) error {
+	session, err := v2.simulationSession(sessionID)
+	if err != nil {
+		return notFound(ctx, err, err.Error(), v2.Log)
+	}
+
+	record, lastRound, err := session.LookupApplication(address, applicationID)
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+	if record.AppParams == nil && record.AppLocalState == nil {
+		return notFound(ctx, errors.New(errAccountAppDoesNotExist), errAccountAppDoesNotExist, v2.Log)
+	}
+
+	response := accountApplicationResponse(address, applicationID, record, lastRound)
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// SimulationSessionApplicationBoxByName returns the value of an application's box at the latest
+// round of a simulation session.
+// (GET /v2/simulate/sessions/{session-id}/applications/{application-id}/box)
+func (v2 *Handlers) SimulationSessionApplicationBoxByName(ctx echo.Context, sessionID string, applicationID basics.AppIndex, params model.SimulationSessionApplicationBoxByNameParams) error {
+	session, err := v2.simulationSession(sessionID)
+	if err != nil {
+		return notFound(ctx, err, err.Error(), v2.Log)
+	}
+
+	boxNameBytes, err := apps.NewAppCallBytes(params.Name)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	boxName, err := boxNameBytes.Raw()
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	value, lastRound, err := session.LookupKv(apps.MakeBoxKey(uint64(applicationID), string(boxName)))
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+	if value == nil {
+		return notFound(ctx, errors.New(errBoxDoesNotExist), errBoxDoesNotExist, v2.Log)
+	}
+
+	response := model.BoxResponse{
+		Round: lastRound,
+		Name:  boxName,
+		Value: value,
+	}
+	if params.AbiType != nil {
+		response.DecodedValue, err = decodeBoxValue(*params.AbiType, value)
+		if err != nil {
+			return badRequest(ctx, err, fmt.Sprintf(errFailedDecodingBox, *params.AbiType, err), v2.Log)
+		}
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
 // TealDryrun takes transactions and additional simulated ledger state and returns debugging information.
 // (POST /v2/teal/dryrun)
 func (v2 *Handlers) TealDryrun(ctx echo.Context) error {
@@ -1623,13 +1891,50 @@ func (v2 *Handlers) getPendingTransactions(ctx echo.Context, max *uint64, format
 	return ctx.Blob(http.StatusOK, contentType, data)
 }
 
+// isWithinDir returns true if path names an entry of dir, or of one of its subdirectories.
+func isWithinDir(dir string, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return false
+	}
+	return rel != "." && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
 // startCatchup Given a catchpoint, it starts catching up to this catchpoint
-func (v2 *Handlers) startCatchup(ctx echo.Context, catchpoint string, minRounds basics.Round) error {
+func (v2 *Handlers) startCatchup(ctx echo.Context, catchpoint string, minRounds basics.Round, catchpointFile string) error {
 	catchpointRound, _, err := ledgercore.ParseCatchpointLabel(catchpoint)
 	if err != nil {
 		return badRequest(ctx, err, errFailedToParseCatchpoint, v2.Log)
 	}
 
+	if catchpointFile != "" {
+		if !filepath.IsAbs(catchpointFile) {
+			return badRequest(ctx, nil, errCatchpointFileNotAbsolute, v2.Log)
+		}
+		// only the files of the import directory may be loaded, which is verified again once symbolic links are
+		// resolved, so that the API can't be used to probe the rest of the file system.
+		importDir := v2.Node.CatchpointImportDir()
+		if !isWithinDir(importDir, catchpointFile) {
+			return badRequest(ctx, nil, fmt.Sprintf(errCatchpointFileOutsideImportDir, importDir), v2.Log)
+		}
+		resolvedFile, resolveErr := filepath.EvalSymlinks(catchpointFile)
+		if resolveErr != nil {
+			return badRequest(ctx, resolveErr, fmt.Sprintf(errFailedToOpenCatchpointFile, resolveErr), v2.Log)
+		}
+		resolvedDir, resolveErr := filepath.EvalSymlinks(importDir)
+		if resolveErr != nil || !isWithinDir(resolvedDir, resolvedFile) {
+			return badRequest(ctx, resolveErr, fmt.Sprintf(errCatchpointFileOutsideImportDir, importDir), v2.Log)
+		}
+		catchpointFile = resolvedFile
+		stat, statErr := os.Stat(catchpointFile)
+		if statErr == nil && !stat.Mode().IsRegular() {
+			statErr = fmt.Errorf("%s is not a regular file", catchpointFile)
+		}
+		if statErr != nil {
+			return badRequest(ctx, statErr, fmt.Sprintf(errFailedToOpenCatchpointFile, statErr), v2.Log)
+		}
+	}
+
 	if minRounds > 0 {
 		ledgerRound := v2.Node.LedgerForAPI().Latest()
 		if catchpointRound < (ledgerRound + basics.Round(minRounds)) {
@@ -1642,7 +1947,7 @@ func (v2 *Handlers) startCatchup(ctx echo.Context, catchpoint string, minRounds
 
 	// Select 200/201, or return an error
 	var code int
-	err = v2.Node.StartCatchup(catchpoint)
+	err = v2.Node.StartCatchup(catchpoint, catchpointFile)
 	switch err.(type) {
 	case nil:
 		code = http.StatusCreated
@@ -1770,6 +2075,13 @@ func (v2 *Handlers) GetApplicationBoxes(ctx echo.Context, applicationID basics.A
 	return ctx.JSON(http.StatusOK, response)
 }
 
+// decodeBoxValue returns the JSON form of a box value of abiType, which is an
+// ABI type or an AVM type
+func decodeBoxValue(abiType string, value []byte) ([]byte, error) {
+	// An empty specification knows no structs, only the types themselves
+	return (&appspec.Spec{}).Decode(abiType, value)
+}
+
 // GetApplicationBoxByName returns the value of an application's box
 // (GET /v2/applications/{application-id}/box)
 func (v2 *Handlers) GetApplicationBoxByName(ctx echo.Context, applicationID basics.AppIndex, params model.GetApplicationBoxByNameParams) error {
@@ -1799,6 +2111,12 @@ func (v2 *Handlers) GetApplicationBoxByName(ctx echo.Context, applicationID basi
 		Name:  boxName,
 		Value: value,
 	}
+	if params.AbiType != nil {
+		response.DecodedValue, err = decodeBoxValue(*params.AbiType, value)
+		if err != nil {
+			return badRequest(ctx, err, fmt.Sprintf(errFailedDecodingBox, *params.AbiType, err), v2.Log)
+		}
+	}
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -1839,7 +2157,7 @@ func (v2 *Handlers) GetPendingTransactionsByAddress(ctx echo.Context, address ba
 // (POST /v2/catchup/{catchpoint})
 func (v2 *Handlers) StartCatchup(ctx echo.Context, catchpoint string, params model.StartCatchupParams) error {
 	min := nilToZero(params.Min)
-	return v2.startCatchup(ctx, catchpoint, min)
+	return v2.startCatchup(ctx, catchpoint, min, nilToZero(params.File))
 }
 
 // AbortCatchup Given a catchpoint, it aborts catching up to this catchpoint
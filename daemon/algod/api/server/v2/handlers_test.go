@@ -23,7 +23,10 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/simulation"
 	"github.com/algorand/go-algorand/test/partitiontest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -45,6 +48,30 @@ func TestApplicationBoxesMaxKeys(t *testing.T) {
 	require.Equal(t, uint64(math.MaxUint64), applicationBoxesMaxKeys(0, 0))
 }
 
+func TestDecodeBoxValue(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var addr basics.Address
+	addr[0] = 1
+	value := append([]byte{0, 0, 0, 0, 0, 0, 0, 7}, addr[:]...)
+	decoded, err := decodeBoxValue("(uint64,address)", value)
+	require.NoError(t, err)
+	require.Equal(t, `[7,"`+addr.String()+`"]`, string(decoded))
+
+	decoded, err = decodeBoxValue("AVMString", []byte("hello"))
+	require.NoError(t, err)
+	require.Equal(t, `"hello"`, string(decoded))
+
+	// Values which are not of the type are refused
+	_, err = decodeBoxValue("(uint64,address)", value[:20])
+	require.Error(t, err)
+	_, err = decodeBoxValue("uint64", value)
+	require.Error(t, err)
+	_, err = decodeBoxValue("Point", value)
+	require.Error(t, err)
+}
+
 type tagNode struct {
 	children map[string]*tagNode
 }
@@ -207,6 +234,19 @@ func TestSimulateResponseStruct(t *testing.T) {
 		preEncodedTxPath(generatedResponseGraph).children = preEncodedTxPath(customResponseGraph).children
 	}
 
+	expectedGeneratedPopulatedGraph := map[string]*tagNode{
+		"<value>": {children: make(map[string]*tagNode)},
+	}
+	populatedTxPath := func(graph *tagNode) *tagNode {
+		// Resolve the field model.SimulationResponse{}.TxnGroups[0].PopulatedTxns[0]
+		return graph.children["txn-groups"].children["<value>"].children["populated-txns"].children["<value>"]
+	}
+	if assert.Equal(t, expectedGeneratedPopulatedGraph, populatedTxPath(generatedResponseGraph).children) {
+		// Like the request's transactions, populated transactions are json.RawMessage in the
+		// generated type and transactions.SignedTxn in the custom type.
+		populatedTxPath(generatedResponseGraph).children = populatedTxPath(customResponseGraph).children
+	}
+
 	generatedResponseGraph.AssertEquals(t, customResponseGraph)
 }
 
@@ -238,3 +278,91 @@ func TestSimulateRequestStruct(t *testing.T) {
 
 	generatedResponseGraph.AssertEquals(t, customResponseGraph)
 }
+
+func TestConvertStateOverrides(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr := basics.Address{1, 2, 3}
+	balance := uint64(5_000_000)
+	frozen := true
+	value := []byte("v")
+	overrides := model.SimulateStateOverrides{
+		Accounts: &[]model.SimulateAccountOverride{{
+			Address:       addr.String(),
+			Balance:       &balance,
+			AssetHoldings: &[]model.SimulateAssetHoldingOverride{{AssetId: 7, Amount: 10, IsFrozen: &frozen}},
+			AppLocals: &[]model.SimulateAppStateOverride{{
+				AppId: 8,
+				Kvs:   []model.AvmKeyValue{{Key: []byte("k"), Value: model.AvmValue{Type: uint64(basics.TealUintType), Uint: &balance}}},
+			}},
+		}},
+		AppGlobals: &[]model.SimulateAppStateOverride{{
+			AppId: 8,
+			Kvs:   []model.AvmKeyValue{{Key: []byte("g"), Value: model.AvmValue{Type: uint64(basics.TealBytesType), Bytes: &value}}},
+		}},
+		Boxes: &[]model.SimulateBoxOverride{
+			{AppId: 8, Name: []byte("set"), Value: &value},
+			{AppId: 8, Name: []byte("deleted")},
+		},
+	}
+
+	converted, err := convertStateOverrides(overrides)
+	require.NoError(t, err)
+	require.Equal(t, simulation.StateOverrides{
+		Accounts: []simulation.AccountOverride{{
+			Address:       addr,
+			Balance:       &basics.MicroAlgos{Raw: balance},
+			AssetHoldings: []simulation.AssetHoldingOverride{{AssetID: 7, Amount: 10, Frozen: true}},
+			AppLocals: []simulation.AppStateOverride{{
+				AppID:     8,
+				KeyValues: basics.TealKeyValue{"k": {Type: basics.TealUintType, Uint: balance}},
+			}},
+		}},
+		AppGlobals: []simulation.AppStateOverride{{
+			AppID:     8,
+			KeyValues: basics.TealKeyValue{"g": {Type: basics.TealBytesType, Bytes: "v"}},
+		}},
+		Boxes: []simulation.BoxOverride{
+			{AppID: 8, Name: []byte("set"), Value: []byte("v")},
+			{AppID: 8, Name: []byte("deleted")},
+		},
+	}, converted)
+
+	(*overrides.Accounts)[0].Address = "not an address"
+	_, err = convertStateOverrides(overrides)
+	require.Error(t, err)
+
+	(*overrides.Accounts)[0].Address = addr.String()
+	(*overrides.AppGlobals)[0].Kvs[0].Value.Type = 3
+	_, err = convertStateOverrides(overrides)
+	require.ErrorContains(t, err, "invalid value type")
+}
+
+func TestConvertTxnProfile(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	require.Nil(t, convertTxnProfile(nil))
+
+	hash := crypto.Hash([]byte("program"))
+	profile := simulation.TransactionProfile{
+		ApprovalProgram: &simulation.ProgramProfile{
+			Hash:        hash,
+			Cost:        5,
+			PCs:         []simulation.PCProfile{{PC: 1, Hits: 2, Cost: 2}, {PC: 3, Hits: 1, Cost: 3}},
+			Subroutines: []simulation.SubroutineProfile{{PC: 3, Calls: 1, Cost: 3}},
+		},
+		InnerProfiles: []simulation.TransactionProfile{{}},
+	}
+
+	require.Equal(t, &model.SimulationTransactionExecProfile{
+		ApprovalProgramProfile: &model.SimulationProgramProfile{
+			Hash:        hash[:],
+			Cost:        5,
+			Pcs:         []model.SimulationPCProfile{{Pc: 1, Hits: 2, Cost: 2}, {Pc: 3, Hits: 1, Cost: 3}},
+			Subroutines: &[]model.SimulationSubroutineProfile{{Pc: 3, Calls: 1, Cost: 3}},
+		},
+		InnerProfiles: &[]model.SimulationTransactionExecProfile{{}},
+	}, convertTxnProfile(&profile))
+}
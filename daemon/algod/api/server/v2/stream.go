@@ -0,0 +1,389 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-codec/codec"
+	"github.com/labstack/echo/v4"
+
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/blockstream"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// StreamHeartbeatInterval is how often an idle block stream sends a comment line, so that the
+// client and any proxies in between can tell the stream is still alive.
+var StreamHeartbeatInterval = 15 * time.Second
+
+// streamWriteTimeout bounds each write to a stream client. It replaces the server's write
+// timeout, which would otherwise end every stream after RestWriteTimeoutSeconds.
+const streamWriteTimeout = 30 * time.Second
+
+// StreamedBlock is the data of a block event of the block stream.
+type StreamedBlock struct {
+	Block bookkeeping.Block     `codec:"block"`
+	Delta ledgercore.StateDelta `codec:"delta"`
+}
+
+// sseWriter writes server-sent events to a response.
+type sseWriter struct {
+	resp *echo.Response
+	rc   *http.ResponseController
+}
+
+func (w *sseWriter) write(s string) error {
+	err := w.rc.SetWriteDeadline(time.Now().Add(streamWriteTimeout))
+	if err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+	if _, err = w.resp.Write([]byte(s)); err != nil {
+		return err
+	}
+	w.resp.Flush()
+	return nil
+}
+
+func (w *sseWriter) event(id string, event string, data string) error {
+	var b strings.Builder
+	if id != "" {
+		fmt.Fprintf(&b, "id: %s\n", id)
+	}
+	fmt.Fprintf(&b, "event: %s\n", event)
+	// each line of the data goes in its own field, the client joins them back with newlines
+	for _, line := range strings.Split(data, "\n") {
+		fmt.Fprintf(&b, "data: %s\n", line)
+	}
+	b.WriteString("\n")
+	return w.write(b.String())
+}
+
+// encodeEventData encodes the data of an event. Binary encodings are base64 encoded, as events
+// are text.
+func encodeEventData(handle codec.Handle, obj interface{}) (string, error) {
+	data, err := encode(handle, obj)
+	if err != nil {
+		return "", err
+	}
+	if handle != protocol.JSONStrictHandle {
+		return base64.StdEncoding.EncodeToString(data), nil
+	}
+	return string(data), nil
+}
+
+// blockStreamer sends the events for the committed blocks, in round order and without gaps, to
+// one client.
+type blockStreamer struct {
+	ledger LedgerForAPI
+	w      *sseWriter
+	// next is the round of the next block to send.
+	next basics.Round
+	// deltas is set when the events of a block are made from its state delta.
+	deltas bool
+	// events writes the events of a block.
+	events func(w *sseWriter, block bookkeeping.Block, delta ledgercore.StateDelta) error
+}
+
+func (s *blockStreamer) send(block bookkeeping.Block, delta ledgercore.StateDelta) error {
+	if err := s.events(s.w, block, delta); err != nil {
+		return err
+	}
+	s.next = block.Round() + 1
+	return nil
+}
+
+// fetch reads a block to stream, and its state delta if needed, from the ledger.
+func (s *blockStreamer) fetch(rnd basics.Round) (block bookkeeping.Block, delta ledgercore.StateDelta, err error) {
+	block, err = s.ledger.Block(rnd)
+	if err != nil {
+		return
+	}
+	if s.deltas {
+		delta, err = s.ledger.GetStateDeltaForRound(rnd)
+		if err != nil {
+			// The ledger only holds the deltas of the rounds after the ones committed to disk.
+			if oldest := s.ledger.LatestTrackerCommitted() + 1; rnd < oldest {
+				err = fmt.Errorf(errStreamRoundNotResumable, rnd, oldest)
+			}
+		}
+	}
+	return
+}
+
+// catchUp sends the blocks up to rnd from the ledger.
+func (s *blockStreamer) catchUp(rnd basics.Round) error {
+	for s.next <= rnd {
+		block, delta, err := s.fetch(s.next)
+		if err != nil {
+			return err
+		}
+		if err = s.send(block, delta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// streamStatus checks that the node can serve a stream.
+func (v2 *Handlers) streamStatus(ctx echo.Context) error {
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		// node is currently catching up to the requested catchpoint.
+		return serviceUnavailable(ctx, fmt.Errorf("streaming failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+	return nil
+}
+
+// stream runs a stream until the client goes away or the server shuts down. Failures after the
+// stream started are reported to the client as error events, since the response status has
+// already been sent by then.
+func (v2 *Handlers) stream(ctx echo.Context, s *blockStreamer) error {
+	// Subscribe before reading the latest round, so that every later block is either caught up
+	// from the ledger or received from the subscription.
+	stream := v2.Node.BlockStream()
+	sub := stream.Subscribe(blockstream.DefaultCapacity)
+	defer func() { sub.Close() }()
+
+	latest := s.ledger.Latest()
+	if s.next <= latest {
+		if _, _, err := s.fetch(s.next); err != nil {
+			return notFound(ctx, err, fmt.Sprintf(errFailedRetrievingStreamStart, s.next, err), v2.Log)
+		}
+	}
+
+	resp := ctx.Response()
+	resp.Header().Set(echo.HeaderContentType, "text/event-stream")
+	resp.Header().Set(echo.HeaderCacheControl, "no-cache")
+	resp.WriteHeader(http.StatusOK)
+	s.w = &sseWriter{resp: resp, rc: http.NewResponseController(resp)}
+	if err := s.w.write(": stream started\n\n"); err != nil {
+		return nil
+	}
+
+	end := func(err error) error {
+		v2.Log.Infof("ending the stream at round %d: %v", s.next, err)
+		s.w.event("", "error", err.Error())
+		return nil
+	}
+
+	if err := s.catchUp(latest); err != nil {
+		return end(err)
+	}
+	heartbeat := time.NewTicker(StreamHeartbeatInterval)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-v2.Shutdown:
+			return end(errors.New(errServiceShuttingDown))
+		case <-ctx.Request().Context().Done():
+			return nil
+		case <-heartbeat.C:
+			if err := s.w.write(":\n\n"); err != nil {
+				return nil
+			}
+		case entry, ok := <-sub.Blocks():
+			if !ok {
+				// The client fell behind the subscription's buffer: resubscribe, and send the
+				// missed blocks from the ledger.
+				sub = stream.Subscribe(blockstream.DefaultCapacity)
+				if err := s.catchUp(s.ledger.Latest()); err != nil {
+					return end(err)
+				}
+				continue
+			}
+			rnd := entry.Block.Round()
+			if rnd < s.next {
+				continue
+			}
+			if err := s.catchUp(rnd - 1); err != nil {
+				return end(err)
+			}
+			if err := s.send(entry.Block, entry.Delta); err != nil {
+				return end(err)
+			}
+		}
+	}
+}
+
+// StreamBlocks streams the committed blocks, along with their state deltas, as server-sent events.
+// (GET /v2/stream/blocks)
+func (v2 *Handlers) StreamBlocks(ctx echo.Context, params model.StreamBlocksParams) error {
+	handle, _, err := getCodecHandle((*string)(params.Format))
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	}
+	if err = v2.streamStatus(ctx); err != nil {
+		return err
+	}
+
+	ledger := v2.Node.LedgerForAPI()
+	streamer := blockStreamer{ledger: ledger, deltas: true}
+	switch lastEventID := ctx.Request().Header.Get("Last-Event-ID"); {
+	case params.Round != nil:
+		streamer.next = *params.Round
+	case lastEventID != "":
+		last, err1 := strconv.ParseUint(lastEventID, 10, 64)
+		if err1 != nil {
+			return badRequest(ctx, err1, errFailedParsingLastEventID, v2.Log)
+		}
+		streamer.next = basics.Round(last) + 1
+	default:
+		streamer.next = ledger.Latest() + 1
+	}
+	streamer.events = func(w *sseWriter, block bookkeeping.Block, delta ledgercore.StateDelta) error {
+		if handle == protocol.JSONStrictHandle {
+			// Txleases is keyed by an object, which cannot be represented in JSON.
+			delta.Txleases = nil
+		}
+		data, err := encodeEventData(handle, StreamedBlock{Block: block, Delta: delta})
+		if err != nil {
+			return err
+		}
+		return w.event(strconv.FormatUint(uint64(block.Round()), 10), "block", data)
+	}
+	return v2.stream(ctx, &streamer)
+}
+
+// StreamedTransaction is the data of a transaction event of the transaction stream.
+type StreamedTransaction struct {
+	Round            basics.Round                 `codec:"round"`
+	IntraRoundOffset uint64                       `codec:"intra-round-offset"`
+	Inner            bool                         `codec:"inner,omitempty"`
+	Txn              transactions.SignedTxnWithAD `codec:"txn"`
+}
+
+// parseTxnFilter converts the parameters of a transaction stream into a filter.
+func parseTxnFilter(params model.StreamTransactionsParams) (filter blockstream.TxnFilter, err error) {
+	if params.Address != nil {
+		filter.Address, err = basics.UnmarshalChecksumAddress(*params.Address)
+		if err != nil {
+			return
+		}
+	}
+	if params.AddressRole != nil {
+		filter.AddressRole = blockstream.AddressRole(*params.AddressRole)
+	}
+	filter.AppID = nilToZero(params.ApplicationId)
+	filter.AssetID = nilToZero(params.AssetId)
+	if params.TxType != nil {
+		filter.TxType = protocol.TxType(*params.TxType)
+	}
+	if params.NotePrefix != nil {
+		filter.NotePrefix, err = base64.StdEncoding.DecodeString(*params.NotePrefix)
+		if err != nil {
+			return filter, fmt.Errorf("invalid note-prefix: %w", err)
+		}
+	}
+	if params.LogContains != nil {
+		filter.LogContains, err = base64.StdEncoding.DecodeString(*params.LogContains)
+		if err != nil {
+			return filter, fmt.Errorf("invalid log-contains: %w", err)
+		}
+	}
+	return filter, filter.Validate()
+}
+
+// parseTxnEventID parses the round:intra-round-offset id of a transaction event.
+func parseTxnEventID(id string) (basics.Round, uint64, error) {
+	rnd, offset, found := strings.Cut(id, ":")
+	if !found {
+		return 0, 0, fmt.Errorf("invalid transaction event id %s", id)
+	}
+	r, err := strconv.ParseUint(rnd, 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	o, err := strconv.ParseUint(offset, 10, 64)
+	if err != nil {
+		return 0, 0, err
+	}
+	return basics.Round(r), o, nil
+}
+
+// StreamTransactions streams the committed transactions matching a filter as server-sent events.
+// (GET /v2/stream/transactions)
+func (v2 *Handlers) StreamTransactions(ctx echo.Context, params model.StreamTransactionsParams) error {
+	handle, _, err := getCodecHandle((*string)(params.Format))
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	}
+	filter, err := parseTxnFilter(params)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	if err = v2.streamStatus(ctx); err != nil {
+		return err
+	}
+
+	ledger := v2.Node.LedgerForAPI()
+	streamer := blockStreamer{ledger: ledger}
+	// skip is the number of transactions of the first round which were already sent.
+	var skip uint64
+	switch lastEventID := ctx.Request().Header.Get("Last-Event-ID"); {
+	case params.Round != nil:
+		streamer.next = *params.Round
+	case lastEventID != "":
+		var offset uint64
+		streamer.next, offset, err = parseTxnEventID(lastEventID)
+		if err != nil {
+			return badRequest(ctx, err, errFailedParsingLastEventID, v2.Log)
+		}
+		skip = offset + 1
+	default:
+		streamer.next = ledger.Latest() + 1
+	}
+	first := streamer.next
+	streamer.events = func(w *sseWriter, block bookkeeping.Block, _ ledgercore.StateDelta) error {
+		matches, err := filter.MatchBlock(block)
+		if err != nil {
+			return err
+		}
+		for _, m := range matches {
+			if block.Round() == first && m.IntraRoundOffset < skip {
+				continue
+			}
+			data, err := encodeEventData(handle, StreamedTransaction{
+				Round:            block.Round(),
+				IntraRoundOffset: m.IntraRoundOffset,
+				Inner:            m.Inner,
+				Txn:              m.Txn,
+			})
+			if err != nil {
+				return err
+			}
+			if err = w.event(fmt.Sprintf("%d:%d", block.Round(), m.IntraRoundOffset), "transaction", data); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return v2.stream(ctx, &streamer)
+}
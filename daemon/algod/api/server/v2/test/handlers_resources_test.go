@@ -63,6 +63,10 @@ func (l *mockLedger) GetStateDeltaForRound(rnd basics.Round) (ledgercore.StateDe
 	return args.Get(0).(ledgercore.StateDelta), args.Error(1)
 }
 
+func (l *mockLedger) LatestTrackerCommitted() basics.Round {
+	return 0
+}
+
 func (l *mockLedger) LookupAccount(round basics.Round, addr basics.Address) (ledgercore.AccountData, basics.Round, basics.MicroAlgos, error) {
 	ad, ok := l.accounts[addr]
 	if !ok { // return empty / not found
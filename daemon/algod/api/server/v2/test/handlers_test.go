@@ -27,6 +27,8 @@ import (
 	"net"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"reflect"
 	"slices"
 	"strings"
@@ -1402,6 +1404,103 @@ func TestSimulateTransactionMultipleGroups(t *testing.T) {
 	require.Contains(t, bodyString, "expected 1 transaction group, got 2")
 }
 
+func TestSimulationSession(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	numAccounts := 5
+	offlineAccounts := true
+	mockLedger, roots, _, _, releasefunc := testingenvWithBalances(t, 999_998, 999_999, numAccounts, 1, offlineAccounts)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil, cannedStatusReportGolden, false)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+	call := func(body []byte, handle func(echo.Context) error) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+		rec := httptest.NewRecorder()
+		require.NoError(t, handle(echo.New().NewContext(req, rec)))
+		return rec
+	}
+
+	// sessions are part of the developer API
+	rec := call([]byte("{}"), handler.StartSimulationSession)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	mockNode.config.EnableDeveloperAPI = true
+
+	rec = call([]byte("{}"), handler.StartSimulationSession)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var session model.SimulationSessionResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &session))
+	require.Equal(t, mockLedger.Latest(), session.Round)
+	id := session.SessionId
+
+	hdr, err := mockLedger.BlockHdr(mockLedger.Latest())
+	require.NoError(t, err)
+	txnInfo := simulationtesting.TxnInfo{LatestHeader: hdr}
+	sender := roots[0]
+	receiver := roots[1]
+	before, _, _, err := mockLedger.LookupLatest(receiver.Address())
+	require.NoError(t, err)
+
+	stxn := txnInfo.NewTxn(txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   sender.Address(),
+		Receiver: receiver.Address(),
+		Amount:   100_000,
+	}).Txn().Sign(sender.Secrets())
+	request := v2.PreEncodedSimulateRequest{
+		TxnGroups: []v2.PreEncodedSimulateRequestTransactionGroup{{Txns: []transactions.SignedTxn{stxn}}},
+	}
+	rec = call(protocol.EncodeReflect(&request), func(c echo.Context) error {
+		return handler.SimulateSessionTransaction(c, id, model.SimulateSessionTransactionParams{})
+	})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.NotContains(t, rec.Body.String(), "failure-message")
+
+	rec = call(nil, func(c echo.Context) error {
+		return handler.SimulationSessionAccountInformation(c, id, receiver.Address())
+	})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var account model.AccountResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &account))
+	require.Equal(t, session.Round+1, account.Round)
+	require.Equal(t, before.MicroAlgos.Raw+100_000, account.Amount)
+
+	rec = call([]byte(`{"rounds": 10, "seconds": 100}`), func(c echo.Context) error {
+		return handler.AdvanceSimulationSession(c, id)
+	})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var advanced model.SimulationSessionResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &advanced))
+	require.Equal(t, session.Round+11, advanced.Round)
+	require.Equal(t, session.Timestamp+100, advanced.Timestamp)
+
+	rec = call([]byte(`{"rounds": 1, "seconds": 100}`), func(c echo.Context) error {
+		return handler.AdvanceSimulationSession(c, id)
+	})
+	require.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+
+	rec = call(nil, func(c echo.Context) error {
+		return handler.SimulationSessionApplicationBoxByName(c, id, 1, model.SimulationSessionApplicationBoxByNameParams{Name: "str:missing"})
+	})
+	require.Equal(t, http.StatusNotFound, rec.Code, rec.Body.String())
+
+	rec = call(nil, func(c echo.Context) error { return handler.EndSimulationSession(c, id) })
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	rec = call(nil, func(c echo.Context) error {
+		return handler.SimulationSessionAccountInformation(c, id, receiver.Address())
+	})
+	require.Equal(t, http.StatusNotFound, rec.Code, rec.Body.String())
+
+	// the ledger is untouched
+	after, _, _, err := mockLedger.LookupLatest(receiver.Address())
+	require.NoError(t, err)
+	require.Equal(t, before.MicroAlgos, after.MicroAlgos)
+}
+
 func startCatchupTest(t *testing.T, catchpoint string, nodeError error, expectedCode int) {
 	startCatchupTestFull(t, catchpoint, nodeError, expectedCode, 0, "")
 }
@@ -1464,6 +1563,47 @@ func TestStartCatchup(t *testing.T) {
 	startCatchupTest(t, badCatchPoint, nil, 400)
 }
 
+func TestStartCatchupFromFile(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mockLedger, _, _, _, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil, cannedStatusReportGolden, false)
+	handler := v2.Handlers{Node: mockNode, Log: logging.Base(), Shutdown: make(chan struct{})}
+
+	catchpoint := "5894690#DVFRZUYHEFKRLK5N6DNJRR4IABEVN2D6H76F3ZSEPIE6MKXMQWQA"
+	mockNode.catchpointImportDir = t.TempDir()
+	catchpointFile := filepath.Join(mockNode.catchpointImportDir, "catchpoint.tar")
+	require.NoError(t, os.WriteFile(catchpointFile, []byte{}, 0644))
+	outsideFile := filepath.Join(t.TempDir(), "catchpoint.tar")
+	require.NoError(t, os.WriteFile(outsideFile, []byte{}, 0644))
+	linkedFile := filepath.Join(mockNode.catchpointImportDir, "linked.tar")
+	require.NoError(t, os.Symlink(outsideFile, linkedFile))
+
+	testCases := []struct {
+		file         string
+		expectedCode int
+	}{
+		{catchpointFile, http.StatusCreated},
+		{"catchpoint.tar", http.StatusBadRequest},
+		{catchpointFile + ".missing", http.StatusBadRequest},
+		{mockNode.catchpointImportDir, http.StatusBadRequest},
+		// files outside of the catchpoint import directory are rejected.
+		{outsideFile, http.StatusBadRequest},
+		{filepath.Join(mockNode.catchpointImportDir, "..", filepath.Base(filepath.Dir(outsideFile)), "catchpoint.tar"), http.StatusBadRequest},
+		{linkedFile, http.StatusBadRequest},
+	}
+	for _, tc := range testCases {
+		e := echo.New()
+		rec := httptest.NewRecorder()
+		c := e.NewContext(httptest.NewRequest(http.MethodPost, "/", nil), rec)
+		err := handler.StartCatchup(c, catchpoint, model.StartCatchupParams{File: &tc.file})
+		require.NoError(t, err)
+		require.Equal(t, tc.expectedCode, rec.Code, tc.file)
+	}
+}
+
 func abortCatchupTest(t *testing.T, catchpoint string, expectedCode int) {
 	numAccounts := 1
 	numTransactions := 1
@@ -35,6 +35,7 @@ import (
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger/blockstream"
 	"github.com/algorand/go-algorand/ledger/simulation"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/node"
@@ -97,6 +98,11 @@ type mockNode struct {
 	devmode         bool
 	timestampOffset *int64
 	PartKeyBinary   []byte
+
+	catchpointImportDir string
+
+	simulationSessions *simulation.SessionRegistry
+	blockStream        *blockstream.Stream
 }
 
 func (m *mockNode) InstallParticipationKey(partKeyBinary []byte) (account.ParticipationID, error) {
@@ -177,6 +183,20 @@ func (m *mockNode) Simulate(request simulation.Request) (simulation.Result, erro
 	return simulator.Simulate(request)
 }
 
+func (m *mockNode) SimulationSessions() *simulation.SessionRegistry {
+	if m.simulationSessions == nil {
+		m.simulationSessions = simulation.MakeSessionRegistry(m.ledger.(*data.Ledger), m.config.EnableDeveloperAPI)
+	}
+	return m.simulationSessions
+}
+
+func (m *mockNode) BlockStream() *blockstream.Stream {
+	if m.blockStream == nil {
+		m.blockStream = blockstream.MakeStream()
+	}
+	return m.blockStream
+}
+
 func (m *mockNode) GetPendingTransaction(txID transactions.Txid) (res node.TxnWithStatus, found bool) {
 	res = node.TxnWithStatus{}
 	found = true
@@ -196,10 +216,14 @@ func (m *mockNode) Config() config.Local {
 	return m.config
 }
 
-func (m *mockNode) StartCatchup(catchpoint string) error {
+func (m *mockNode) StartCatchup(catchpoint string, catchpointFile string) error {
 	return m.err
 }
 
+func (m *mockNode) CatchpointImportDir() string {
+	return m.catchpointImportDir
+}
+
 func (m *mockNode) AbortCatchup(catchpoint string) error {
 	return m.err
 }
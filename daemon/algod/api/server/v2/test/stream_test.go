@@ -0,0 +1,292 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package test
+
+import (
+	"bufio"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/agreement"
+	v2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2"
+	datagroup "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/data"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/nonparticipating/public"
+	"github.com/algorand/go-algorand/data"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+type streamEvent struct {
+	id    string
+	event string
+	data  string
+}
+
+// readStreamEvent reads the next event of a server-sent event stream, skipping comments
+func readStreamEvent(t *testing.T, r *bufio.Reader) streamEvent {
+	var ev streamEvent
+	for {
+		line, err := r.ReadString('\n')
+		require.NoError(t, err)
+		line = strings.TrimSuffix(line, "\n")
+		if line == "" {
+			if ev.event != "" {
+				return ev
+			}
+			continue
+		}
+		field, value, _ := strings.Cut(line, ": ")
+		switch field {
+		case "id":
+			ev.id = value
+		case "event":
+			ev.event = value
+		case "data":
+			if ev.data != "" {
+				ev.data += "\n"
+			}
+			ev.data += value
+		}
+	}
+}
+
+// addStreamedRound adds a block with the given transactions to the ledger
+func addStreamedRound(a *require.Assertions, h v2.Handlers, txns ...transactions.Transaction) {
+	ledger := h.Node.LedgerForAPI()
+	genBlk, err := ledger.Block(0)
+	a.NoError(err)
+	lastBlk, err := ledger.Block(ledger.Latest())
+	a.NoError(err)
+	blk := newEmptyBlock(a, lastBlk, genBlk, ledger)
+	blk.BlockHeader.CurrentProtocol = protocol.ConsensusCurrentVersion
+	for _, txn := range txns {
+		txn.GenesisHash = genBlk.GenesisHash()
+		txib, err := blk.EncodeSignedTxn(transactions.SignedTxn{Txn: txn}, transactions.ApplyData{})
+		a.NoError(err)
+		blk.Payset = append(blk.Payset, txib)
+	}
+	blk.TxnCommitments, err = blk.PaysetCommit()
+	a.NoError(err)
+	a.NoError(ledger.(*data.Ledger).AddBlock(blk, agreement.Certificate{}))
+}
+
+// serveStreams serves the routes of the handlers, and returns a function getting a stream
+func serveStreams(t *testing.T, handler *v2.Handlers) func(path string, lastEventID string) *http.Response {
+	e := echo.New()
+	public.RegisterHandlers(e, handler)
+	datagroup.RegisterHandlers(e, handler)
+	server := httptest.NewServer(e)
+	t.Cleanup(server.Close)
+	return func(path string, lastEventID string) *http.Response {
+		req, err := http.NewRequest(http.MethodGet, server.URL+path, nil)
+		require.NoError(t, err)
+		if lastEventID != "" {
+			req.Header.Set("Last-Event-ID", lastEventID)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		return resp
+	}
+}
+
+func TestStreamBlocks(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	a := require.New(t)
+
+	handler, _, _, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+	handler.Node.LedgerForAPI().(*data.Ledger).RegisterBlockListeners([]ledgercore.BlockListener{handler.Node.BlockStream()})
+	insertRounds(a, handler, 3)
+
+	get := serveStreams(t, &handler)
+
+	// resume from a past round, then receive the new rounds
+	resp := get("/v2/stream/blocks?round=2", "")
+	defer resp.Body.Close()
+	a.Equal(http.StatusOK, resp.StatusCode)
+	a.Equal("text/event-stream", resp.Header.Get("Content-Type"))
+	r := bufio.NewReader(resp.Body)
+	for _, rnd := range []basics.Round{2, 3} {
+		ev := readStreamEvent(t, r)
+		a.Equal("block", ev.event)
+		a.Equal(strconv.FormatUint(uint64(rnd), 10), ev.id)
+		var sb v2.StreamedBlock
+		a.NoError(protocol.DecodeJSON([]byte(ev.data), &sb))
+		a.Equal(rnd, sb.Block.Round())
+		a.Equal(rnd, sb.Delta.Hdr.Round)
+	}
+	addStreamedRound(a, handler)
+	ev := readStreamEvent(t, r)
+	a.Equal("4", ev.id)
+
+	// reconnect after the last event received, in msgpack
+	resp2 := get("/v2/stream/blocks?format=msgpack", "3")
+	defer resp2.Body.Close()
+	a.Equal(http.StatusOK, resp2.StatusCode)
+	r2 := bufio.NewReader(resp2.Body)
+	ev = readStreamEvent(t, r2)
+	a.Equal("4", ev.id)
+	raw, err := base64.StdEncoding.DecodeString(ev.data)
+	a.NoError(err)
+	var sb v2.StreamedBlock
+	a.NoError(protocol.DecodeReflect(raw, &sb))
+	a.Equal(basics.Round(4), sb.Block.Round())
+
+	addStreamedRound(a, handler)
+	for _, r := range []*bufio.Reader{r, r2} {
+		ev = readStreamEvent(t, r)
+		a.Equal("5", ev.id)
+	}
+
+	// a stream without a start round begins with the next round
+	resp3 := get("/v2/stream/blocks", "")
+	defer resp3.Body.Close()
+	r3 := bufio.NewReader(resp3.Body)
+	addStreamedRound(a, handler)
+	ev = readStreamEvent(t, r3)
+	a.Equal("6", ev.id)
+
+	// rounds without state deltas cannot be streamed
+	resp4 := get("/v2/stream/blocks?round=0", "")
+	resp4.Body.Close()
+	a.Equal(http.StatusNotFound, resp4.StatusCode)
+	resp4 = get("/v2/stream/blocks?format=bad", "")
+	resp4.Body.Close()
+	a.Equal(http.StatusBadRequest, resp4.StatusCode)
+
+	// closed streams end their subscriptions
+	resp.Body.Close()
+	resp2.Body.Close()
+	resp3.Body.Close()
+	a.Eventually(func() bool { return handler.Node.BlockStream().Subscribers() == 0 }, 10*time.Second, 10*time.Millisecond)
+}
+
+func TestStreamBlocksResumeWindow(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	a := require.New(t)
+
+	handler, _, _, _, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+	ledger := handler.Node.LedgerForAPI()
+	// add rounds until the trackers commit some of them, dropping their deltas
+	a.Eventually(func() bool {
+		addStreamedRound(a, handler)
+		return ledger.LatestTrackerCommitted() > 1
+	}, 30*time.Second, 100*time.Millisecond)
+
+	get := serveStreams(t, &handler)
+	resp := get("/v2/stream/blocks?round=1", "")
+	defer resp.Body.Close()
+	a.Equal(http.StatusNotFound, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	a.NoError(err)
+	a.Contains(string(body), "the state delta of round 1 is no longer held, the oldest round a stream can resume from is ")
+
+	// the oldest round named can be streamed
+	_, after, _ := strings.Cut(string(body), "resume from is ")
+	oldest, err := strconv.ParseUint(strings.TrimRight(after, "\"}\n"), 10, 64)
+	a.NoError(err)
+	a.Greater(oldest, uint64(1))
+	resp2 := get(fmt.Sprintf("/v2/stream/blocks?round=%d", oldest), "")
+	defer resp2.Body.Close()
+	a.Equal(http.StatusOK, resp2.StatusCode)
+}
+
+func TestStreamTransactions(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	a := require.New(t)
+
+	handler, _, _, roots, _, releasefunc := setupTestForMethodGet(t, cannedStatusReportGolden)
+	defer releasefunc()
+	handler.Node.LedgerForAPI().(*data.Ledger).RegisterBlockListeners([]ledgercore.BlockListener{handler.Node.BlockStream()})
+	insertRounds(a, handler, 3)
+	get := serveStreams(t, &handler)
+
+	// the account of retOneProgram is funded by testingenv
+	lhash := logic.HashProgram(retOneProgram)
+	var funded basics.Address
+	copy(funded[:], lhash[:])
+	watched := roots[0].Address()
+	pay := func(receiver basics.Address, note string) transactions.Transaction {
+		return transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender:     funded,
+				Fee:        basics.MicroAlgos{Raw: proto.MinTxnFee},
+				FirstValid: 1,
+				LastValid:  100,
+				Note:       []byte(note),
+			},
+			PaymentTxnFields: transactions.PaymentTxnFields{Receiver: receiver, Amount: basics.MicroAlgos{Raw: 1000}},
+		}
+	}
+	offsets := func(r *bufio.Reader, n int) []string {
+		var ids []string
+		for i := 0; i < n; i++ {
+			ev := readStreamEvent(t, r)
+			a.Equal("transaction", ev.event)
+			var st v2.StreamedTransaction
+			a.NoError(protocol.DecodeJSON([]byte(ev.data), &st))
+			a.Equal(fmt.Sprintf("%d:%d", st.Round, st.IntraRoundOffset), ev.id)
+			ids = append(ids, ev.id)
+		}
+		return ids
+	}
+
+	query := "?address=" + watched.String() + "&address-role=receiver"
+	resp := get("/v2/stream/transactions"+query, "")
+	defer resp.Body.Close()
+	a.Equal(http.StatusOK, resp.StatusCode)
+	notes := get("/v2/stream/transactions?note-prefix="+base64.StdEncoding.EncodeToString([]byte("watch:")), "")
+	defer notes.Body.Close()
+
+	addStreamedRound(a, handler, pay(watched, "watch:1"), pay(sinkAddr, "watch:2"), pay(watched, "other"))
+	addStreamedRound(a, handler, pay(watched, "watch:3"))
+	a.Equal([]string{"4:0", "4:2", "5:0"}, offsets(bufio.NewReader(resp.Body), 3))
+	a.Equal([]string{"4:0", "4:1", "5:0"}, offsets(bufio.NewReader(notes.Body), 3))
+
+	// resume after a transaction, from the ledger
+	resumed := get("/v2/stream/transactions"+query, "4:0")
+	defer resumed.Body.Close()
+	a.Equal([]string{"4:2", "5:0"}, offsets(bufio.NewReader(resumed.Body), 2))
+
+	for _, bad := range []string{"?address-role=receiver", "?address=nope", "?note-prefix=!", "?tx-type=xfer"} {
+		resp := get("/v2/stream/transactions"+bad, "")
+		resp.Body.Close()
+		a.Equal(http.StatusBadRequest, resp.StatusCode, bad)
+	}
+	resp = get("/v2/stream/transactions", "4")
+	resp.Body.Close()
+	a.Equal(http.StatusBadRequest, resp.StatusCode)
+}
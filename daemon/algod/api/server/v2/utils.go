@@ -469,12 +469,47 @@ func convertTxnTrace(txnTrace *simulation.TransactionTrace) *model.SimulationTra
 	}
 }
 
+func convertProgramProfile(profile *simulation.ProgramProfile) *model.SimulationProgramProfile {
+	if profile == nil {
+		return nil
+	}
+	return &model.SimulationProgramProfile{
+		Hash: profile.Hash[:],
+		Cost: profile.Cost,
+		Pcs: convertSlice(profile.PCs, func(pc simulation.PCProfile) model.SimulationPCProfile {
+			return model.SimulationPCProfile{Pc: pc.PC, Hits: pc.Hits, Cost: pc.Cost}
+		}),
+		Subroutines: sliceOrNil(convertSlice(profile.Subroutines,
+			func(sub simulation.SubroutineProfile) model.SimulationSubroutineProfile {
+				return model.SimulationSubroutineProfile{Pc: sub.PC, Calls: sub.Calls, Cost: sub.Cost}
+			}),
+		),
+	}
+}
+
+func convertTxnProfile(txnProfile *simulation.TransactionProfile) *model.SimulationTransactionExecProfile {
+	if txnProfile == nil {
+		return nil
+	}
+	return &model.SimulationTransactionExecProfile{
+		ApprovalProgramProfile:   convertProgramProfile(txnProfile.ApprovalProgram),
+		ClearStateProgramProfile: convertProgramProfile(txnProfile.ClearStateProgram),
+		LogicSigProfile:          convertProgramProfile(txnProfile.LogicSig),
+		InnerProfiles: sliceOrNil(convertSlice(txnProfile.InnerProfiles,
+			func(profile simulation.TransactionProfile) model.SimulationTransactionExecProfile {
+				return *convertTxnProfile(&profile)
+			}),
+		),
+	}
+}
+
 func convertTxnResult(txnResult simulation.TxnResult) PreEncodedSimulateTxnResult {
 	result := PreEncodedSimulateTxnResult{
 		Txn:                      ConvertInnerTxn(&txnResult.Txn),
 		AppBudgetConsumed:        omitEmpty(txnResult.AppBudgetConsumed),
 		LogicSigBudgetConsumed:   omitEmpty(txnResult.LogicSigBudgetConsumed),
 		TransactionTrace:         convertTxnTrace(txnResult.Trace),
+		TransactionProfile:       convertTxnProfile(txnResult.Profile),
 		UnnamedResourcesAccessed: convertUnnamedResourcesAccessed(txnResult.UnnamedResourcesAccessed),
 	}
 
@@ -573,6 +608,8 @@ func convertTxnGroupResult(txnGroupResult simulation.TxnGroupResult) PreEncodedS
 		AppBudgetAdded:           omitEmpty(txnGroupResult.AppBudgetAdded),
 		AppBudgetConsumed:        omitEmpty(txnGroupResult.AppBudgetConsumed),
 		UnnamedResourcesAccessed: convertUnnamedResourcesAccessed(txnGroupResult.UnnamedResourcesAccessed),
+		PopulatedTxns:            txnGroupResult.PopulatedTxns,
+		PopulateFailureMessage:   omitEmpty(txnGroupResult.PopulateFailureMessage),
 	}
 
 	if len(txnGroupResult.FailedAt) > 0 {
@@ -593,6 +630,7 @@ func convertSimulationResult(result simulation.Result) PreEncodedSimulateRespons
 			MaxLogCalls:           result.EvalOverrides.MaxLogCalls,
 			ExtraOpcodeBudget:     omitEmpty(result.EvalOverrides.ExtraOpcodeBudget),
 			FixSigners:            omitEmpty(result.EvalOverrides.FixSigners),
+			PopulateResources:     omitEmpty(result.EvalOverrides.PopulateResources),
 		}
 	}
 
@@ -606,11 +644,19 @@ func convertSimulationResult(result simulation.Result) PreEncodedSimulateRespons
 	}
 }
 
-func convertSimulationRequest(request PreEncodedSimulateRequest) simulation.Request {
+func convertSimulationRequest(request PreEncodedSimulateRequest) (simulation.Request, error) {
 	txnGroups := make([][]transactions.SignedTxn, len(request.TxnGroups))
 	for i, txnGroup := range request.TxnGroups {
 		txnGroups[i] = txnGroup.Txns
 	}
+	var overrides *simulation.StateOverrides
+	if request.StateOverrides != nil {
+		converted, err := convertStateOverrides(*request.StateOverrides)
+		if err != nil {
+			return simulation.Request{}, err
+		}
+		overrides = &converted
+	}
 	return simulation.Request{
 		TxnGroups:             txnGroups,
 		Round:                 request.Round,
@@ -620,7 +666,78 @@ func convertSimulationRequest(request PreEncodedSimulateRequest) simulation.Requ
 		ExtraOpcodeBudget:     request.ExtraOpcodeBudget,
 		TraceConfig:           request.ExecTraceConfig,
 		FixSigners:            request.FixSigners,
+		PopulateResources:     request.PopulateResources,
+		StateOverrides:        overrides,
+	}, nil
+}
+
+func convertAvmKeyValues(kvs []model.AvmKeyValue) (basics.TealKeyValue, error) {
+	tkv := make(basics.TealKeyValue, len(kvs))
+	for _, kv := range kvs {
+		value := basics.TealValue{Type: basics.TealType(kv.Value.Type)}
+		switch value.Type {
+		case basics.TealBytesType:
+			value.Bytes = string(nilToZero(kv.Value.Bytes))
+		case basics.TealUintType:
+			value.Uint = nilToZero(kv.Value.Uint)
+		default:
+			return nil, fmt.Errorf("invalid value type %d for key %q", kv.Value.Type, kv.Key)
+		}
+		tkv[string(kv.Key)] = value
+	}
+	return tkv, nil
+}
+
+func convertAppStateOverrides(overrides []model.SimulateAppStateOverride) ([]simulation.AppStateOverride, error) {
+	converted := make([]simulation.AppStateOverride, len(overrides))
+	for i, override := range overrides {
+		kvs, err := convertAvmKeyValues(override.Kvs)
+		if err != nil {
+			return nil, err
+		}
+		converted[i] = simulation.AppStateOverride{AppID: override.AppId, KeyValues: kvs}
+	}
+	return converted, nil
+}
+
+func convertStateOverrides(overrides model.SimulateStateOverrides) (simulation.StateOverrides, error) {
+	var converted simulation.StateOverrides
+	for _, acct := range nilToZero(overrides.Accounts) {
+		addr, err := basics.UnmarshalChecksumAddress(acct.Address)
+		if err != nil {
+			return simulation.StateOverrides{}, err
+		}
+		override := simulation.AccountOverride{Address: addr}
+		if acct.Balance != nil {
+			override.Balance = &basics.MicroAlgos{Raw: *acct.Balance}
+		}
+		for _, holding := range nilToZero(acct.AssetHoldings) {
+			override.AssetHoldings = append(override.AssetHoldings, simulation.AssetHoldingOverride{
+				AssetID: holding.AssetId,
+				Amount:  holding.Amount,
+				Frozen:  nilToZero(holding.IsFrozen),
+			})
+		}
+		override.AppLocals, err = convertAppStateOverrides(nilToZero(acct.AppLocals))
+		if err != nil {
+			return simulation.StateOverrides{}, err
+		}
+		converted.Accounts = append(converted.Accounts, override)
+	}
+	var err error
+	converted.AppGlobals, err = convertAppStateOverrides(nilToZero(overrides.AppGlobals))
+	if err != nil {
+		return simulation.StateOverrides{}, err
+	}
+	for _, box := range nilToZero(overrides.Boxes) {
+		override := simulation.BoxOverride{AppID: box.AppId, Name: box.Name}
+		if box.Value != nil {
+			// Keep an empty value distinct from a deleted box
+			override.Value = append([]byte{}, *box.Value...)
+		}
+		converted.Boxes = append(converted.Boxes, override)
 	}
+	return converted, nil
 }
 
 // printableUTF8OrEmpty checks to see if the entire string is a UTF8 printable string.
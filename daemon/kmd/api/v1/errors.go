@@ -24,3 +24,6 @@ var errCouldNotDecode = fmt.Errorf("could not decode request body")
 var errCouldNotDecodeAddress = fmt.Errorf("could not decode address")
 var errCouldNotDecodeTx = fmt.Errorf("could not decode transaction")
 var errInvalidAPIToken = fmt.Errorf("invalid API token")
+var errNoHDWallets = fmt.Errorf("wallet driver does not support HD wallets")
+var errNoBackup = fmt.Errorf("wallet driver does not support backups")
+var errAuditLog = fmt.Errorf("could not record the operation in the audit log")
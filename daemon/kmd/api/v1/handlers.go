@@ -17,17 +17,21 @@
 package v1
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/gorilla/mux"
 
 	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/audit"
 	"github.com/algorand/go-algorand/daemon/kmd/lib/kmdapi"
+	"github.com/algorand/go-algorand/daemon/kmd/policy"
 	"github.com/algorand/go-algorand/daemon/kmd/session"
 	"github.com/algorand/go-algorand/daemon/kmd/wallet"
 	"github.com/algorand/go-algorand/daemon/kmd/wallet/driver"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/protocol"
 )
@@ -35,7 +39,8 @@ import (
 // reqContext is passed to each of the handlers below via wrapCtx, allowing
 // handlers to interact with kmd's session store
 type reqContext struct {
-	sm *session.Manager
+	sm  *session.Manager
+	log logging.Logger
 }
 
 // errorResponse sets the specified status code (should != 200), and fills in the
@@ -51,6 +56,123 @@ func errorResponse(w http.ResponseWriter, status int, err error) {
 	w.Write(protocol.EncodeJSON(resp))
 }
 
+// checkSigningPolicy calls approve with the ID of the wallet, to check the
+// request against the wallet's signing policy, and logs denials
+func checkSigningPolicy(ctx reqContext, wlt wallet.Wallet, approve func(walletID []byte) error) error {
+	md, err := wlt.Metadata()
+	if err != nil {
+		return err
+	}
+
+	err = approve(md.ID)
+	if err != nil {
+		ctx.log.Warnf("signing policy of wallet %s (%s) denied a request: %v", md.ID, md.Name, err)
+	}
+	return err
+}
+
+// approveTransactions checks txns against the signing policy of the wallet.
+// If they are approved, cancel must be called if they could not be signed.
+func approveTransactions(ctx reqContext, wlt wallet.Wallet, txns []transactions.Transaction) (cancel func(), err error) {
+	err = checkSigningPolicy(ctx, wlt, func(walletID []byte) (err error) {
+		cancel, err = ctx.sm.Policies().ApproveTransactions(walletID, txns)
+		return err
+	})
+	return cancel, err
+}
+
+// approveProgram checks that the signing policy of the wallet allows signing programs
+func approveProgram(ctx reqContext, wlt wallet.Wallet) error {
+	return checkSigningPolicy(ctx, wlt, ctx.sm.Policies().ApproveProgram)
+}
+
+// signingErrorStatus returns the status to respond with when signing failed
+// with err: 403 if a signing policy denied it, 400 otherwise
+func signingErrorStatus(err error) int {
+	var denial *policy.DenialError
+	if errors.As(err, &denial) {
+		return http.StatusForbidden
+	}
+	return http.StatusBadRequest
+}
+
+// recordOperation appends the outcome of an operation, opErr, to the audit
+// log. The result of an operation which could not be recorded must not be
+// returned, so if that fails, it responds with an error and returns false.
+func recordOperation(ctx reqContext, w http.ResponseWriter, walletHandleToken string, wlt wallet.Wallet, entry audit.Entry, opErr error) bool {
+	if opErr != nil {
+		entry.Error = opErr.Error()
+	}
+	err := ctx.sm.RecordOperation([]byte(walletHandleToken), wlt, entry)
+	if err != nil {
+		ctx.log.Errorf("could not record %s in the audit log: %v", entry.Operation, err)
+		errorResponse(w, http.StatusInternalServerError, errAuditLog)
+		return false
+	}
+	return true
+}
+
+// recordWalletOperation is recordOperation for an operation which is not
+// made through a wallet handle
+func recordWalletOperation(ctx reqContext, w http.ResponseWriter, entry audit.Entry, opErr error) bool {
+	if opErr != nil {
+		entry.Error = opErr.Error()
+	}
+	err := ctx.sm.RecordWalletOperation(entry)
+	if err != nil {
+		ctx.log.Errorf("could not record %s in the audit log: %v", entry.Operation, err)
+		errorResponse(w, http.StatusInternalServerError, errAuditLog)
+		return false
+	}
+	return true
+}
+
+// recordIntent appends an entry announcing an operation which is about to
+// change the wallet, so that it is recorded even if recording its outcome
+// fails. If that fails, it responds with an error and returns false, and the
+// operation must not be carried out.
+func recordIntent(ctx reqContext, w http.ResponseWriter, walletHandleToken string, wlt wallet.Wallet, entry audit.Entry) bool {
+	entry.Intent = true
+	return recordOperation(ctx, w, walletHandleToken, wlt, entry, nil)
+}
+
+// txnAuditEntry describes signing tx with pk, or with its sender's key if pk
+// is empty
+func txnAuditEntry(op audit.Operation, tx transactions.Transaction, pk crypto.PublicKey) audit.Entry {
+	signer := basics.Address(pk)
+	if (pk == crypto.PublicKey{}) {
+		signer = tx.Src()
+	}
+	entry := audit.Entry{
+		Operation: op,
+		Address:   signer.String(),
+		Txid:      tx.ID().String(),
+	}
+	if !tx.Group.IsZero() {
+		entry.Group = tx.Group.String()
+	}
+	return entry
+}
+
+// keyAuditEntry describes an operation which created the key or multisig
+// account addr, unless it failed
+func keyAuditEntry(op audit.Operation, addr crypto.Digest, opErr error) audit.Entry {
+	entry := audit.Entry{Operation: op}
+	if opErr == nil {
+		entry.Address = basics.Address(addr).String()
+	}
+	return entry
+}
+
+// programAuditEntry describes signing program on behalf of addr
+func programAuditEntry(op audit.Operation, program []byte, addr basics.Address) audit.Entry {
+	return audit.Entry{
+		Operation:   op,
+		Address:     addr.String(),
+		ProgramHash: logic.HashProgram(program).String(),
+	}
+}
+
 // successResponse is a helper that returns a 200 and an encoded response
 func successResponse(w http.ResponseWriter, resp kmdapi.APIV1Response) {
 	w.Header().Set("Content-Type", "application/json")
@@ -71,6 +193,24 @@ func encodeAddresses(addrs []crypto.Digest) (userAddrs []string) {
 	return
 }
 
+// encodeDerivationPaths is a helper to list the derivation paths of the keys
+// of an HD wallet by user-facing address, or nil for other wallets
+func encodeDerivationPaths(w wallet.Wallet) (map[string]string, error) {
+	hdWallet, ok := w.(wallet.HDWallet)
+	if !ok {
+		return nil, nil
+	}
+	paths, err := hdWallet.KeyDerivationPaths()
+	if err != nil || len(paths) == 0 {
+		return nil, err
+	}
+	userPaths := make(map[string]string, len(paths))
+	for addr, path := range paths {
+		userPaths[encodeAddress(addr)] = path
+	}
+	return userPaths, nil
+}
+
 // apiWalletFromMetadata is a helper to convert our internal wallet metadata
 // format into the APIV1 representation of a wallet
 func apiWalletFromMetadata(metadata wallet.Metadata) kmdapi.APIV1Wallet {
@@ -81,6 +221,7 @@ func apiWalletFromMetadata(metadata wallet.Metadata) kmdapi.APIV1Wallet {
 		DriverVersion:         metadata.DriverVersion,
 		SupportsMnemonicUX:    metadata.SupportsMnemonicUX,
 		SupportedTransactions: metadata.SupportedTransactions,
+		HD:                    metadata.HD,
 	}
 }
 
@@ -172,7 +313,20 @@ func postWalletHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Create the wallet via its driver
-	err = walletDriver.CreateWallet(walletName, walletID, []byte(req.WalletPassword), req.MasterDerivationKey)
+	if req.HD {
+		hdDriver, ok := walletDriver.(driver.HDDriver)
+		if !ok {
+			errorResponse(w, http.StatusBadRequest, errNoHDWallets)
+			return
+		}
+		entropyLen := req.HDEntropyLen
+		if entropyLen == 0 {
+			entropyLen = len(req.MasterDerivationKey)
+		}
+		err = hdDriver.CreateHDWallet(walletName, walletID, []byte(req.WalletPassword), req.MasterDerivationKey, req.HDGenerate, entropyLen, req.HDPassphrase, req.HDAccount)
+	} else {
+		err = walletDriver.CreateWallet(walletName, walletID, []byte(req.WalletPassword), req.MasterDerivationKey)
+	}
 	if err != nil {
 		errorResponse(w, http.StatusBadRequest, err)
 		return
@@ -350,6 +504,9 @@ func postMasterKeyExportHandler(ctx reqContext, w http.ResponseWriter, r *http.R
 
 	// Export the master derivation key
 	mdk, err := wallet.ExportMasterDerivationKey([]byte(req.WalletPassword))
+	if !recordOperation(ctx, w, req.WalletHandleToken, wallet, audit.Entry{Operation: audit.OpExportMasterKey}, err) {
+		return
+	}
 	if err != nil {
 		errorResponse(w, http.StatusBadRequest, err)
 		return
@@ -364,6 +521,142 @@ func postMasterKeyExportHandler(ctx reqContext, w http.ResponseWriter, r *http.R
 	successResponse(w, resp)
 }
 
+// postWalletBackupHandler handles `POST /v1/wallet/backup`
+func postWalletBackupHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/wallet/backup BackupWallet
+	//---
+	//    Summary: Back up a wallet
+	//    Description: >
+	//      Returns a bundle, encrypted with the backup password, of everything needed to restore
+	//      the wallet with `POST /v1/wallet/restore`: its master derivation key, the keys imported
+	//      into it, and the preimages of its multisig accounts.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Backup Wallet Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/BackupWalletRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/BackupWalletResponse"
+	var req kmdapi.APIV1POSTWalletBackupRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Fetch the wallet from the WalletHandleToken
+	wlt, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+	backupWallet, ok := wlt.(wallet.BackupWallet)
+	if !ok {
+		errorResponse(w, http.StatusBadRequest, errNoBackup)
+		return
+	}
+
+	// Back up the wallet
+	backup, err := backupWallet.Backup([]byte(req.WalletPassword), []byte(req.BackupPassword))
+	if !recordOperation(ctx, w, req.WalletHandleToken, wlt, audit.Entry{Operation: audit.OpBackupWallet}, err) {
+		return
+	}
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTWalletBackupResponse{
+		Backup: backup,
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// postWalletRestoreHandler handles `POST /v1/wallet/restore`
+func postWalletRestoreHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/wallet/restore RestoreWallet
+	//---
+	//    Summary: Restore a wallet
+	//    Description: >
+	//      Creates the wallet of a bundle made by `POST /v1/wallet/backup`, with the ID it had.
+	//      Nothing is created unless the whole wallet is restored.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Restore Wallet Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/RestoreWalletRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/RestoreWalletResponse"
+	var req kmdapi.APIV1POSTWalletRestoreRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Fetch the wallet driver
+	walletDriver, err := driver.FetchWalletDriver(req.WalletDriverName)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+	restoreDriver, ok := walletDriver.(driver.RestoreDriver)
+	if !ok {
+		errorResponse(w, http.StatusBadRequest, errNoBackup)
+		return
+	}
+
+	// Restore the wallet. Its ID is only known once the bundle is decrypted.
+	if !recordWalletOperation(ctx, w, audit.Entry{Operation: audit.OpRestoreWallet, Intent: true}, nil) {
+		return
+	}
+	walletID, err := restoreDriver.RestoreWallet([]byte(req.WalletName), []byte(req.WalletPassword), req.Backup, []byte(req.BackupPassword))
+	if !recordWalletOperation(ctx, w, audit.Entry{Operation: audit.OpRestoreWallet, WalletID: string(walletID)}, err) {
+		return
+	}
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Fetch metadata about the wallet we just restored
+	wlt, err := walletDriver.FetchWallet(walletID)
+	if err != nil {
+		errorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+	metadata, err := wlt.Metadata()
+	if err != nil {
+		errorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTWalletRestoreResponse{
+		Wallet: apiWalletFromMetadata(metadata),
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
 // postWalletReleaseHandler handles `POST /v1/wallet/release`
 func postWalletReleaseHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 	// swagger:operation POST /v1/wallet/release ReleaseWalletHandleToken
@@ -567,7 +860,17 @@ func postKeyImportHandler(ctx reqContext, w http.ResponseWriter, r *http.Request
 	}
 
 	// Import the key
+	intent := audit.Entry{Operation: audit.OpImportKey}
+	if pk, err := crypto.SecretKeyToPublicKey(req.PrivateKey); err == nil {
+		intent.Address = basics.Address(pk).String()
+	}
+	if !recordIntent(ctx, w, req.WalletHandleToken, wallet, intent) {
+		return
+	}
 	addr, err := wallet.ImportKey(req.PrivateKey)
+	if !recordOperation(ctx, w, req.WalletHandleToken, wallet, keyAuditEntry(audit.OpImportKey, addr, err), err) {
+		return
+	}
 	if err != nil {
 		errorResponse(w, http.StatusBadRequest, err)
 		return
@@ -625,6 +928,9 @@ func postKeyExportHandler(ctx reqContext, w http.ResponseWriter, r *http.Request
 
 	// Export the key
 	secretKey, err := wallet.ExportKey(crypto.Digest(reqAddr), []byte(req.WalletPassword))
+	if !recordOperation(ctx, w, req.WalletHandleToken, wallet, audit.Entry{Operation: audit.OpExportKey, Address: reqAddr.String()}, err) {
+		return
+	}
 	if err != nil {
 		errorResponse(w, http.StatusBadRequest, err)
 		return
@@ -676,7 +982,13 @@ func postKeyHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Generate the key
+	if !recordIntent(ctx, w, req.WalletHandleToken, wallet, audit.Entry{Operation: audit.OpGenerateKey}) {
+		return
+	}
 	addr, err := wallet.GenerateKey(req.DisplayMnemonic)
+	if !recordOperation(ctx, w, req.WalletHandleToken, wallet, keyAuditEntry(audit.OpGenerateKey, addr, err), err) {
+		return
+	}
 	if err != nil {
 		errorResponse(w, http.StatusInternalServerError, err)
 		return
@@ -733,7 +1045,13 @@ func deleteKeyHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Delete the key
+	if !recordIntent(ctx, w, req.WalletHandleToken, wallet, audit.Entry{Operation: audit.OpDeleteKey, Address: reqAddr.String()}) {
+		return
+	}
 	err = wallet.DeleteKey(crypto.Digest(reqAddr), []byte(req.WalletPassword))
+	if !recordOperation(ctx, w, req.WalletHandleToken, wallet, audit.Entry{Operation: audit.OpDeleteKey, Address: reqAddr.String()}, err) {
+		return
+	}
 	if err != nil {
 		errorResponse(w, http.StatusBadRequest, err)
 		return
@@ -792,6 +1110,13 @@ func postKeyListHandler(ctx reqContext, w http.ResponseWriter, r *http.Request)
 		Addresses: encodeAddresses(addrs),
 	}
 
+	// Include the derivation paths of the keys of HD wallets
+	resp.DerivationPaths, err = encodeDerivationPaths(wallet)
+	if err != nil {
+		errorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
 	// Return and encode the response
 	successResponse(w, resp)
 }
@@ -842,10 +1167,20 @@ func postTransactionSignHandler(ctx reqContext, w http.ResponseWriter, r *http.R
 		return
 	}
 
-	// Sign the transaction
-	stx, err := wallet.SignTransaction(tx, req.PublicKey, []byte(req.WalletPassword))
+	// Check the transaction against the wallet's signing policy, and sign it
+	var stx []byte
+	cancel, err := approveTransactions(ctx, wallet, []transactions.Transaction{tx})
+	if err == nil {
+		stx, err = wallet.SignTransaction(tx, req.PublicKey, []byte(req.WalletPassword))
+		if err != nil {
+			cancel()
+		}
+	}
+	if !recordOperation(ctx, w, req.WalletHandleToken, wallet, txnAuditEntry(audit.OpSignTransaction, tx, req.PublicKey), err) {
+		return
+	}
 	if err != nil {
-		errorResponse(w, http.StatusBadRequest, err)
+		errorResponse(w, signingErrorStatus(err), err)
 		return
 	}
 
@@ -858,6 +1193,109 @@ func postTransactionSignHandler(ctx reqContext, w http.ResponseWriter, r *http.R
 	successResponse(w, resp)
 }
 
+// postTransactionGroupSignHandler handles `POST /v1/transaction/sign-group`
+func postTransactionGroupSignHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/transaction/sign-group SignTransactionGroup
+	//---
+	//    Summary: Sign a transaction group
+	//    Description: >
+	//      Checks that the group ID of the passed transactions matches them,
+	//      and signs each of them which is authorized by a key or a multisig
+	//      account of the wallet. The signing policy of the wallet is checked
+	//      against all of the transactions it signs at once.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Sign Transaction Group Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/SignTransactionGroupRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/SignTransactionGroupResponse"
+	var req kmdapi.APIV1POSTTransactionGroupSignRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Fetch the wallet from the WalletHandleToken
+	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Decode the transactions
+	stxns := make([]transactions.SignedTxn, len(req.Transactions))
+	for i := range req.Transactions {
+		err = protocol.Decode(req.Transactions[i], &stxns[i])
+		if err != nil {
+			errorResponse(w, http.StatusBadRequest, errCouldNotDecodeTx)
+			return
+		}
+	}
+
+	// Work out which transactions the wallet signs, and check all of them
+	// against its signing policy before signing any
+	var signed []transactions.SignedTxn
+	var indexes []int
+	plan, err := driver.PlanGroupSigning(wallet, stxns)
+	if err == nil {
+		indexes = plan.Indexes()
+		txns := make([]transactions.Transaction, len(indexes))
+		for i, index := range indexes {
+			txns[i] = stxns[index].Txn
+		}
+		var cancel func()
+		cancel, err = approveTransactions(ctx, wallet, txns)
+		if err == nil {
+			signed, err = plan.Sign([]byte(req.WalletPassword))
+			if err != nil {
+				cancel()
+			}
+		}
+	}
+
+	// Record each transaction signed, or the group if none could be
+	if len(indexes) == 0 {
+		entry := audit.Entry{Operation: audit.OpSignTransactionGroup}
+		if len(stxns) > 0 && !stxns[0].Txn.Group.IsZero() {
+			entry.Group = stxns[0].Txn.Group.String()
+		}
+		if !recordOperation(ctx, w, req.WalletHandleToken, wallet, entry, err) {
+			return
+		}
+	}
+	for _, index := range indexes {
+		tx := stxns[index].Txn
+		entry := txnAuditEntry(audit.OpSignTransactionGroup, tx, crypto.PublicKey(stxns[index].Authorizer()))
+		if !recordOperation(ctx, w, req.WalletHandleToken, wallet, entry, err) {
+			return
+		}
+	}
+	if err != nil {
+		errorResponse(w, signingErrorStatus(err), err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTTransactionGroupSignResponse{
+		SignedTransactions: make([][]byte, len(signed)),
+	}
+	for i := range signed {
+		resp.SignedTransactions[i] = protocol.Encode(&signed[i])
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
 // postProgramSignHandler handles `POST /v1/program/sign`
 func postProgramSignHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 	// swagger:operation POST /v1/program/sign SignProgram
@@ -901,9 +1339,17 @@ func postProgramSignHandler(ctx reqContext, w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	stx, err := wallet.SignProgram(req.Program, crypto.Digest(reqAddr), []byte(req.WalletPassword))
+	// Check that the wallet's signing policy allows signing programs, and sign it
+	var stx []byte
+	err = approveProgram(ctx, wallet)
+	if err == nil {
+		stx, err = wallet.SignProgram(req.Program, crypto.Digest(reqAddr), []byte(req.WalletPassword))
+	}
+	if !recordOperation(ctx, w, req.WalletHandleToken, wallet, programAuditEntry(audit.OpSignProgram, req.Program, reqAddr), err) {
+		return
+	}
 	if err != nil {
-		errorResponse(w, http.StatusBadRequest, err)
+		errorResponse(w, signingErrorStatus(err), err)
 		return
 	}
 
@@ -1003,7 +1449,17 @@ func postMultisigImportHandler(ctx reqContext, w http.ResponseWriter, r *http.Re
 	}
 
 	// Import the key
+	intent := audit.Entry{Operation: audit.OpImportMultisig}
+	if addr, err := crypto.MultisigAddrGen(req.Version, req.Threshold, req.PKs); err == nil {
+		intent.Address = basics.Address(addr).String()
+	}
+	if !recordIntent(ctx, w, req.WalletHandleToken, wallet, intent) {
+		return
+	}
 	addr, err := wallet.ImportMultisigAddr(req.Version, req.Threshold, req.PKs)
+	if !recordOperation(ctx, w, req.WalletHandleToken, wallet, keyAuditEntry(audit.OpImportMultisig, addr, err), err) {
+		return
+	}
 	if err != nil {
 		errorResponse(w, http.StatusBadRequest, err)
 		return
@@ -1126,10 +1582,20 @@ func postMultisigTransactionSignHandler(ctx reqContext, w http.ResponseWriter, r
 		return
 	}
 
-	// Sign the transaction
-	msig, err := wallet.MultisigSignTransaction(tx, req.PublicKey, req.PartialMsig, []byte(req.WalletPassword), req.AuthAddr)
+	// Check the transaction against the wallet's signing policy, and sign it
+	var msig crypto.MultisigSig
+	cancel, err := approveTransactions(ctx, wallet, []transactions.Transaction{tx})
+	if err == nil {
+		msig, err = wallet.MultisigSignTransaction(tx, req.PublicKey, req.PartialMsig, []byte(req.WalletPassword), req.AuthAddr)
+		if err != nil {
+			cancel()
+		}
+	}
+	if !recordOperation(ctx, w, req.WalletHandleToken, wallet, txnAuditEntry(audit.OpMultisigSignTransaction, tx, req.PublicKey), err) {
+		return
+	}
 	if err != nil {
-		errorResponse(w, http.StatusBadRequest, err)
+		errorResponse(w, signingErrorStatus(err), err)
 		return
 	}
 
@@ -1185,10 +1651,17 @@ func postMultisigProgramSignHandler(ctx reqContext, w http.ResponseWriter, r *ht
 		return
 	}
 
-	// Sign the transaction
-	msig, err := wallet.MultisigSignProgram(req.Program, crypto.Digest(reqAddr), req.PublicKey, req.PartialMsig, []byte(req.WalletPassword))
+	// Check that the wallet's signing policy allows signing programs, and sign it
+	var msig crypto.MultisigSig
+	err = approveProgram(ctx, wallet)
+	if err == nil {
+		msig, err = wallet.MultisigSignProgram(req.Program, crypto.Digest(reqAddr), req.PublicKey, req.PartialMsig, []byte(req.WalletPassword))
+	}
+	if !recordOperation(ctx, w, req.WalletHandleToken, wallet, programAuditEntry(audit.OpMultisigSignProgram, req.Program, reqAddr), err) {
+		return
+	}
 	if err != nil {
-		errorResponse(w, http.StatusBadRequest, err)
+		errorResponse(w, signingErrorStatus(err), err)
 		return
 	}
 
@@ -1244,7 +1717,13 @@ func deleteMultisigHandler(ctx reqContext, w http.ResponseWriter, r *http.Reques
 	}
 
 	// Delete the key
+	if !recordIntent(ctx, w, req.WalletHandleToken, wallet, audit.Entry{Operation: audit.OpDeleteMultisig, Address: reqAddr.String()}) {
+		return
+	}
 	err = wallet.DeleteMultisigAddr(crypto.Digest(reqAddr), []byte(req.WalletPassword))
+	if !recordOperation(ctx, w, req.WalletHandleToken, wallet, audit.Entry{Operation: audit.OpDeleteMultisig, Address: reqAddr.String()}, err) {
+		return
+	}
 	if err != nil {
 		errorResponse(w, http.StatusBadRequest, err)
 		return
@@ -1289,7 +1768,8 @@ func RegisterHandlers(router *mux.Router, sm *session.Manager, log logging.Logge
 
 	// ctx holds the global context passed to each of the handlers
 	ctx := reqContext{
-		sm: sm,
+		sm:  sm,
+		log: log,
 	}
 
 	router.HandleFunc("/wallets", wrapCtx(ctx, getWalletsHandler)).Methods("GET")
@@ -1300,6 +1780,8 @@ func RegisterHandlers(router *mux.Router, sm *session.Manager, log logging.Logge
 	router.HandleFunc("/wallet/rename", wrapCtx(ctx, postWalletRenameHandler)).Methods("POST")
 	router.HandleFunc("/wallet/info", wrapCtx(ctx, postWalletInfoHandler)).Methods("POST")
 	router.HandleFunc("/master-key/export", wrapCtx(ctx, postMasterKeyExportHandler)).Methods("POST")
+	router.HandleFunc("/wallet/backup", wrapCtx(ctx, postWalletBackupHandler)).Methods("POST")
+	router.HandleFunc("/wallet/restore", wrapCtx(ctx, postWalletRestoreHandler)).Methods("POST")
 
 	router.HandleFunc("/key/list", wrapCtx(ctx, postKeyListHandler)).Methods("POST")
 	router.HandleFunc("/key/import", wrapCtx(ctx, postKeyImportHandler)).Methods("POST")
@@ -1315,5 +1797,6 @@ func RegisterHandlers(router *mux.Router, sm *session.Manager, log logging.Logge
 	router.HandleFunc("/multisig", wrapCtx(ctx, deleteMultisigHandler)).Methods("DELETE")
 
 	router.HandleFunc("/transaction/sign", wrapCtx(ctx, postTransactionSignHandler)).Methods("POST")
+	router.HandleFunc("/transaction/sign-group", wrapCtx(ctx, postTransactionGroupSignHandler)).Methods("POST")
 	router.HandleFunc("/program/sign", wrapCtx(ctx, postProgramSignHandler)).Methods("POST")
 }
@@ -0,0 +1,214 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package audit implements kmd's audit log: an append-only file recording
+// every signing and key-management operation.
+//
+// The log holds one JSON entry per line. Each entry includes the hash of the
+// entry before it, and its own hash covers every other field, so that editing,
+// removing or reordering entries breaks the chain. Truncating the end of the
+// log can only be detected by comparing with a previously recorded head hash.
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+)
+
+// Filename is the name of the audit log in kmd's data directory
+const Filename = "audit.log"
+
+// Operation is the kind of operation an entry records
+type Operation string
+
+// The operations which are recorded
+const (
+	OpSignTransaction         Operation = "sign_txn"
+	OpSignTransactionGroup    Operation = "sign_txn_group"
+	OpSignProgram             Operation = "sign_program"
+	OpMultisigSignTransaction Operation = "multisig_sign_txn"
+	OpMultisigSignProgram     Operation = "multisig_sign_program"
+	OpImportKey               Operation = "import_key"
+	OpGenerateKey             Operation = "generate_key"
+	OpExportKey               Operation = "export_key"
+	OpDeleteKey               Operation = "delete_key"
+	OpImportMultisig          Operation = "import_multisig"
+	OpDeleteMultisig          Operation = "delete_multisig"
+	OpExportMasterKey         Operation = "export_master_key"
+	OpBackupWallet            Operation = "backup_wallet"
+	OpRestoreWallet           Operation = "restore_wallet"
+)
+
+// Entry is a record of one operation
+type Entry struct {
+	Seq       uint64    `json:"seq"`
+	Time      time.Time `json:"time"`
+	WalletID  string    `json:"wallet_id"`
+	Handle    string    `json:"handle,omitempty"`
+	Operation Operation `json:"op"`
+	// Intent is set on the entry written before an operation which changes
+	// a wallet is carried out. The entry recording its outcome follows.
+	Intent bool `json:"intent,omitempty"`
+
+	// Address is the key or multisig account operated on, or signing
+	Address     string `json:"address,omitempty"`
+	Txid        string `json:"txid,omitempty"`
+	Group       string `json:"group,omitempty"`
+	ProgramHash string `json:"program_hash,omitempty"`
+	// Error is set when the operation failed, or was denied
+	Error string `json:"error,omitempty"`
+
+	Prev string `json:"prev"`
+	Hash string `json:"hash"`
+}
+
+// computeHash returns the hash of all of the entry's fields but Hash
+func (e Entry) computeHash() string {
+	e.Hash = ""
+	data, err := json.Marshal(e)
+	if err != nil {
+		// An Entry holds nothing which cannot be encoded
+		panic(err)
+	}
+	return crypto.Hash(data).String()
+}
+
+// Log appends entries to an audit log file
+type Log struct {
+	mu   deadlock.Mutex
+	f    *os.File
+	seq  uint64
+	prev string
+}
+
+// Open opens the audit log at path for appending, creating it if needed. The
+// existing entries are not verified; only the last one is read, to continue
+// the chain from it.
+func Open(path string) (*Log, error) {
+	l := &Log{prev: crypto.Digest{}.String()}
+	err := Read(path, func(e Entry) error {
+		l.seq = e.Seq + 1
+		l.prev = e.Hash
+		return nil
+	})
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	l.f, err = os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Append chains e to the log and writes it to disk, filling in its sequence
+// number, time and hashes. The entry is synced before Append returns.
+func (l *Log) Append(e Entry) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	e.Seq = l.seq
+	e.Time = time.Now().UTC().Round(0)
+	e.Prev = l.prev
+	e.Hash = e.computeHash()
+
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	_, err = l.f.Write(append(data, '\n'))
+	if err != nil {
+		return err
+	}
+	err = l.f.Sync()
+	if err != nil {
+		return err
+	}
+
+	l.seq++
+	l.prev = e.Hash
+	return nil
+}
+
+// Close closes the log file
+func (l *Log) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.f.Close()
+}
+
+// Read calls fn with each entry of the audit log at path, in order
+func Read(path string, fn func(Entry) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	for line := 1; ; line++ {
+		data, err := r.ReadBytes('\n')
+		if err == io.EOF && len(data) == 0 {
+			return nil
+		}
+		if err != nil && err != io.EOF {
+			return err
+		}
+		var e Entry
+		err = json.Unmarshal(data, &e)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+		err = fn(e)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// ErrBrokenChain is returned by Verify when the log has been tampered with
+var ErrBrokenChain = errors.New("audit log hash chain is broken")
+
+// Verify checks the hash chain of the audit log at path, returning the number
+// of entries and the hash of the last one
+func Verify(path string) (count uint64, head string, err error) {
+	head = crypto.Digest{}.String()
+	err = Read(path, func(e Entry) error {
+		if e.Seq != count {
+			return fmt.Errorf("%w: entry %d has sequence number %d", ErrBrokenChain, count, e.Seq)
+		}
+		if e.Prev != head {
+			return fmt.Errorf("%w: entry %d does not follow the previous entry", ErrBrokenChain, e.Seq)
+		}
+		if e.computeHash() != e.Hash {
+			return fmt.Errorf("%w: entry %d does not match its hash", ErrBrokenChain, e.Seq)
+		}
+		count++
+		head = e.Hash
+		return nil
+	})
+	return count, head, err
+}
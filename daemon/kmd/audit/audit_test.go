@@ -0,0 +1,91 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package audit
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestLog(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), Filename)
+	l, err := Open(path)
+	require.NoError(t, err)
+	require.NoError(t, l.Append(Entry{WalletID: "w1", Handle: "h1", Operation: OpSignTransaction, Txid: "TX1"}))
+	require.NoError(t, l.Append(Entry{WalletID: "w1", Handle: "h1", Operation: OpExportKey, Error: "wrong password"}))
+	require.NoError(t, l.Close())
+
+	// Reopening continues the chain
+	l, err = Open(path)
+	require.NoError(t, err)
+	require.NoError(t, l.Append(Entry{WalletID: "w2", Operation: OpSignProgram, ProgramHash: "PROG"}))
+	require.NoError(t, l.Append(Entry{WalletID: "w2", Operation: OpDeleteKey, Address: "ADDR", Intent: true}))
+	require.NoError(t, l.Close())
+
+	var entries []Entry
+	require.NoError(t, Read(path, func(e Entry) error {
+		entries = append(entries, e)
+		return nil
+	}))
+	require.Len(t, entries, 4)
+	for i, e := range entries {
+		require.EqualValues(t, i, e.Seq)
+		if i > 0 {
+			require.Equal(t, entries[i-1].Hash, e.Prev)
+		}
+	}
+	require.Equal(t, OpSignProgram, entries[2].Operation)
+	require.False(t, entries[2].Intent)
+	require.True(t, entries[3].Intent)
+
+	count, head, err := Verify(path)
+	require.NoError(t, err)
+	require.EqualValues(t, 4, count)
+	require.Equal(t, entries[3].Hash, head)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := bytes.SplitAfter(data, []byte("\n"))
+
+	// Editing an entry breaks its hash
+	tampered := bytes.Replace(data, []byte(`"txid":"TX1"`), []byte(`"txid":"TX2"`), 1)
+	require.NoError(t, os.WriteFile(path, tampered, 0600))
+	_, _, err = Verify(path)
+	require.ErrorIs(t, err, ErrBrokenChain)
+	require.ErrorContains(t, err, "entry 0 does not match its hash")
+
+	// So does turning an intent into an outcome
+	tampered = bytes.Replace(data, []byte(`"intent":true,`), nil, 1)
+	require.NoError(t, os.WriteFile(path, tampered, 0600))
+	_, _, err = Verify(path)
+	require.ErrorIs(t, err, ErrBrokenChain)
+	require.ErrorContains(t, err, "entry 3 does not match its hash")
+
+	// Removing an entry breaks the chain
+	require.NoError(t, os.WriteFile(path, append(append([]byte{}, lines[0]...), lines[2]...), 0600))
+	_, _, err = Verify(path)
+	require.ErrorIs(t, err, ErrBrokenChain)
+}
@@ -59,6 +59,26 @@ func (kcl KMDClient) CreateWallet(walletName []byte, walletDriverName string, wa
 	return
 }
 
+// CreateHDWallet wraps kmdapi.APIV1POSTWalletRequest, creating a wallet which
+// derives its keys by path in the given account, from the BIP39 mnemonic whose
+// entropy is the first entropyLen bytes of walletMDK, or a new one if generate
+// is set, and from bip39Passphrase
+func (kcl KMDClient) CreateHDWallet(walletName []byte, walletDriverName string, walletPassword []byte, walletMDK crypto.MasterDerivationKey, generate bool, entropyLen int, bip39Passphrase string, account uint32) (resp kmdapi.APIV1POSTWalletResponse, err error) {
+	req := kmdapi.APIV1POSTWalletRequest{
+		WalletName:          string(walletName),
+		WalletDriverName:    walletDriverName,
+		WalletPassword:      string(walletPassword),
+		MasterDerivationKey: walletMDK,
+		HD:                  true,
+		HDAccount:           account,
+		HDEntropyLen:        entropyLen,
+		HDGenerate:          generate,
+		HDPassphrase:        bip39Passphrase,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
 // RenameWallet wraps kmdapi.APIV1POSTWalletRenameRequest
 func (kcl KMDClient) RenameWallet(walletID []byte, newWalletName []byte, walletPassword []byte) (resp kmdapi.APIV1POSTWalletRenameResponse, err error) {
 	req := kmdapi.APIV1POSTWalletRenameRequest{
@@ -208,6 +228,30 @@ func (kcl KMDClient) ExportMasterDerivationKey(walletHandle []byte, walletPasswo
 	return
 }
 
+// BackupWallet wraps kmdapi.APIV1POSTWalletBackupRequest
+func (kcl KMDClient) BackupWallet(walletHandle []byte, walletPassword []byte, backupPassword []byte) (resp kmdapi.APIV1POSTWalletBackupResponse, err error) {
+	req := kmdapi.APIV1POSTWalletBackupRequest{
+		WalletHandleToken: string(walletHandle),
+		WalletPassword:    string(walletPassword),
+		BackupPassword:    string(backupPassword),
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// RestoreWallet wraps kmdapi.APIV1POSTWalletRestoreRequest
+func (kcl KMDClient) RestoreWallet(walletName []byte, walletDriverName string, walletPassword []byte, backup []byte, backupPassword []byte) (resp kmdapi.APIV1POSTWalletRestoreResponse, err error) {
+	req := kmdapi.APIV1POSTWalletRestoreRequest{
+		WalletName:       string(walletName),
+		WalletDriverName: walletDriverName,
+		WalletPassword:   string(walletPassword),
+		Backup:           backup,
+		BackupPassword:   string(backupPassword),
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
 // SignTransaction wraps kmdapi.APIV1POSTTransactionSignRequest
 func (kcl KMDClient) SignTransaction(walletHandle, pw []byte, pk crypto.PublicKey, tx transactions.Transaction) (resp kmdapi.APIV1POSTTransactionSignResponse, err error) {
 	txBytes := protocol.Encode(&tx)
@@ -221,6 +265,19 @@ func (kcl KMDClient) SignTransaction(walletHandle, pw []byte, pk crypto.PublicKe
 	return
 }
 
+// SignTransactionGroup wraps kmdapi.APIV1POSTTransactionGroupSignRequest
+func (kcl KMDClient) SignTransactionGroup(walletHandle, pw []byte, stxns []transactions.SignedTxn) (resp kmdapi.APIV1POSTTransactionGroupSignResponse, err error) {
+	req := kmdapi.APIV1POSTTransactionGroupSignRequest{
+		WalletHandleToken: string(walletHandle),
+		WalletPassword:    string(pw),
+	}
+	for i := range stxns {
+		req.Transactions = append(req.Transactions, protocol.Encode(&stxns[i]))
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
 // SignProgram wraps kmdapi.APIV1POSTProgramSignRequest
 func (kcl KMDClient) SignProgram(walletHandle, pw []byte, addr string, data []byte) (resp kmdapi.APIV1POSTProgramSignResponse, err error) {
 	req := kmdapi.APIV1POSTProgramSignRequest{
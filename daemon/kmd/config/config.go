@@ -18,9 +18,12 @@ package config
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
 
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/util/codecs"
 )
 
@@ -41,12 +44,17 @@ type KMDConfig struct {
 	Address             string       `json:"address"`
 	AllowedOrigins      []string     `json:"allowed_origins"`
 	AllowHeaderPNA      bool         `json:"allow_header_pna"`
+
+	// SigningPolicies maps wallet IDs to the policy enforced when signing
+	// with the keys of that wallet
+	SigningPolicies map[string]SigningPolicy `json:"signing_policies"`
 }
 
 // DriverConfig contains config info specific to each wallet driver
 type DriverConfig struct {
 	SQLiteWalletDriverConfig SQLiteWalletDriverConfig `json:"sqlite"`
 	LedgerWalletDriverConfig LedgerWalletDriverConfig `json:"ledger"`
+	RemoteWalletDriverConfig RemoteWalletDriverConfig `json:"remote"`
 }
 
 // SQLiteWalletDriverConfig is configuration specific to the SQLiteWalletDriver
@@ -61,6 +69,90 @@ type LedgerWalletDriverConfig struct {
 	Disable bool `json:"disable"`
 }
 
+// RemoteWalletDriverConfig is configuration specific to the RemoteWalletDriver
+type RemoteWalletDriverConfig struct {
+	Signers []RemoteSignerConfig `json:"signers"`
+}
+
+// RemoteSignerConfig describes an out-of-process signer, each of which is
+// exposed as a wallet by the RemoteWalletDriver
+type RemoteSignerConfig struct {
+	Name        string `json:"name"`
+	Socket      string `json:"socket"`
+	TimeoutSecs uint64 `json:"timeout_secs"`
+	// PasswordVerifier checks the password of the wallet, which must be
+	// given to open it and to sign with it. It is made from the password
+	// with `algokey signer password-verifier`.
+	PasswordVerifier string `json:"password_verifier"`
+}
+
+// SigningPolicy restricts what kmd will sign with the keys of a wallet. Empty
+// or zero fields place no restriction, except that rekeying or closing an
+// account, and signing programs, are forbidden unless explicitly allowed.
+// Closing out transfers the whole balance, so it is also forbidden when the
+// amount of microAlgos or of the asset closed out is limited.
+type SigningPolicy struct {
+	// MaxAmount is the most microAlgos a single transaction may spend, its
+	// payment and its fee together
+	MaxAmount uint64 `json:"max_amount"`
+	// WindowMaxAmount is the most microAlgos, fees included, that may be
+	// spent by the transactions signed within any WindowSecs seconds
+	WindowMaxAmount uint64 `json:"window_max_amount"`
+	WindowSecs      uint64 `json:"window_secs"`
+	// AssetLimits limits the amounts of assets, by asset ID, that may be
+	// transferred. Transfers of assets not listed are not limited by amount.
+	AssetLimits map[basics.AssetIndex]AssetLimit `json:"asset_limits"`
+
+	AllowedReceivers []basics.Address    `json:"allowed_receivers"`
+	AllowedAppIDs    []basics.AppIndex   `json:"allowed_app_ids"`
+	AllowedAssetIDs  []basics.AssetIndex `json:"allowed_asset_ids"`
+	AllowedTxTypes   []protocol.TxType   `json:"allowed_tx_types"`
+
+	AllowRekey bool `json:"allow_rekey"`
+	AllowClose bool `json:"allow_close"`
+	// AllowProgramSigning allows delegating to logic signatures, which would
+	// otherwise bypass the policy
+	AllowProgramSigning bool `json:"allow_program_signing"`
+}
+
+// AssetLimit limits the amount of an asset that a signing policy allows
+// transferring, as MaxAmount and WindowMaxAmount do for microAlgos
+type AssetLimit struct {
+	// MaxAmount is the largest transfer, in base units, of a single transaction
+	MaxAmount uint64 `json:"max_amount"`
+	// WindowMaxAmount is the most that may be transferred by the transactions
+	// signed within any WindowSecs seconds of the policy
+	WindowMaxAmount uint64 `json:"window_max_amount"`
+}
+
+var knownTxTypes = map[protocol.TxType]bool{
+	protocol.PaymentTx:         true,
+	protocol.KeyRegistrationTx: true,
+	protocol.AssetConfigTx:     true,
+	protocol.AssetTransferTx:   true,
+	protocol.AssetFreezeTx:     true,
+	protocol.ApplicationCallTx: true,
+	protocol.StateProofTx:      true,
+	protocol.HeartbeatTx:       true,
+}
+
+// Validate ensures that the policy is consistent
+func (p SigningPolicy) Validate() error {
+	hasWindow := p.WindowMaxAmount != 0
+	for _, limit := range p.AssetLimits {
+		hasWindow = hasWindow || limit.WindowMaxAmount != 0
+	}
+	if hasWindow != (p.WindowSecs != 0) {
+		return ErrSigningPolicyWindow
+	}
+	for _, txType := range p.AllowedTxTypes {
+		if !knownTxTypes[txType] {
+			return fmt.Errorf("%w: %s", ErrSigningPolicyTxType, txType)
+		}
+	}
+	return nil
+}
+
 // ScryptParams stores the parameters used for key derivation. This allows
 // upgrading security parameters over time
 type ScryptParams struct {
@@ -101,6 +193,25 @@ func (k KMDConfig) Validate() error {
 			return ErrSQLiteWalletNotAbsolute
 		}
 	}
+	// Remote signers must have distinct names, and absolute socket paths
+	names := make(map[string]bool)
+	for _, signer := range k.DriverConfig.RemoteWalletDriverConfig.Signers {
+		if signer.Name == "" || names[signer.Name] {
+			return ErrRemoteSignerName
+		}
+		names[signer.Name] = true
+		if !filepath.IsAbs(signer.Socket) {
+			return ErrRemoteSignerNotAbsolute
+		}
+		if signer.PasswordVerifier == "" {
+			return fmt.Errorf("%w: %s", ErrRemoteSignerPassword, signer.Name)
+		}
+	}
+	for id, policy := range k.SigningPolicies {
+		if err := policy.Validate(); err != nil {
+			return fmt.Errorf("signing policy of wallet %s: %w", id, err)
+		}
+	}
 	return nil
 }
 
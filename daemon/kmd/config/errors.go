@@ -22,3 +22,18 @@ import (
 
 // ErrSQLiteWalletNotAbsolute is returned when the passed sqlite wallet directory is relative
 var ErrSQLiteWalletNotAbsolute = fmt.Errorf("sqlite wallets path must be absolute path")
+
+// ErrRemoteSignerName is returned when a remote signer has no name, or the same name as another one
+var ErrRemoteSignerName = fmt.Errorf("remote signers must have distinct, non-empty names")
+
+// ErrRemoteSignerNotAbsolute is returned when the passed remote signer socket is relative
+var ErrRemoteSignerNotAbsolute = fmt.Errorf("remote signer socket path must be absolute path")
+
+// ErrRemoteSignerPassword is returned when a remote signer has no password verifier
+var ErrRemoteSignerPassword = fmt.Errorf("remote signer has no password_verifier")
+
+// ErrSigningPolicyWindow is returned when a signing policy has a window amount but no window length, or the reverse
+var ErrSigningPolicyWindow = fmt.Errorf("signing policy window_secs must be set if and only if a window_max_amount is")
+
+// ErrSigningPolicyTxType is returned when a signing policy allows an unknown transaction type
+var ErrSigningPolicyTxType = fmt.Errorf("signing policy allows unknown transaction type")
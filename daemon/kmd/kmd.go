@@ -63,6 +63,12 @@ func Start(startConfig StartConfig) (died chan error, sock string, err error) {
 		return
 	}
 
+	// Start the session manager, which keeps the audit log
+	sm, err := session.MakeManager(kmdCfg)
+	if err != nil {
+		return
+	}
+
 	// Configure the wallet API server
 	serverCfg := server.WalletServerConfig{
 		APIToken:       apiToken,
@@ -70,7 +76,7 @@ func Start(startConfig StartConfig) (died chan error, sock string, err error) {
 		Address:        kmdCfg.Address,
 		AllowedOrigins: kmdCfg.AllowedOrigins,
 		AllowHeaderPNA: kmdCfg.AllowHeaderPNA,
-		SessionManager: session.MakeManager(kmdCfg),
+		SessionManager: sm,
 		Log:            startConfig.Log,
 		Timeout:        startConfig.Timeout,
 	}
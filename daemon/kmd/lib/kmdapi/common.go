@@ -45,6 +45,7 @@ type APIV1Wallet struct {
 	DriverVersion         uint32            `json:"driver_version"`
 	SupportsMnemonicUX    bool              `json:"mnemonic_ux"`
 	SupportedTransactions []protocol.TxType `json:"supported_txs"`
+	HD                    bool              `json:"hd"`
 }
 
 // APIV1WalletHandle includes the wallet the handle corresponds to
@@ -49,6 +49,16 @@ type APIV1POSTWalletRequest struct {
 	WalletDriverName    string                   `json:"wallet_driver_name"`
 	WalletPassword      string                   `json:"wallet_password"`
 	MasterDerivationKey APIV1MasterDerivationKey `json:"master_derivation_key"`
+	// HD wallets derive their keys by BIP32-Ed25519 path from a BIP39
+	// mnemonic, whose entropy is the first HDEntropyLen bytes of the master
+	// derivation key (all of it if zero), and from the BIP39 passphrase
+	// HDPassphrase, in the account HDAccount. HDGenerate generates a new
+	// mnemonic, and requires a blank master derivation key.
+	HD           bool   `json:"hd"`
+	HDAccount    uint32 `json:"hd_account"`
+	HDEntropyLen int    `json:"hd_entropy_len"`
+	HDGenerate   bool   `json:"hd_generate"`
+	HDPassphrase string `json:"hd_passphrase"`
 }
 
 // APIV1POSTWalletInitRequest is the request for `POST /v1/wallet/init`
@@ -109,6 +119,33 @@ type APIV1POSTMasterKeyExportRequest struct {
 	WalletPassword    string `json:"wallet_password"`
 }
 
+// APIV1POSTWalletBackupRequest is the request for `POST /v1/wallet/backup`
+//
+// swagger:model BackupWalletRequest
+type APIV1POSTWalletBackupRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+	WalletPassword    string `json:"wallet_password"`
+	// The password the bundle is encrypted with, which must not be blank
+	BackupPassword string `json:"backup_password"`
+}
+
+// APIV1POSTWalletRestoreRequest is the request for `POST /v1/wallet/restore`
+//
+// swagger:model RestoreWalletRequest
+type APIV1POSTWalletRestoreRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	// The name of the restored wallet, if it is not to keep its own
+	WalletName       string `json:"wallet_name"`
+	WalletDriverName string `json:"wallet_driver_name"`
+	WalletPassword   string `json:"wallet_password"`
+	// swagger:strfmt byte
+	Backup         []byte `json:"backup"`
+	BackupPassword string `json:"backup_password"`
+}
+
 // APIV1POSTKeyImportRequest is the request for `POST /v1/key/import`
 //
 // swagger:model ImportKeyRequest
@@ -178,6 +215,19 @@ type APIV1POSTTransactionSignRequest struct {
 	WalletPassword string           `json:"wallet_password"`
 }
 
+// APIV1POSTTransactionGroupSignRequest is the request for `POST /v1/transaction/sign-group`
+//
+// swagger:model SignTransactionGroupRequest
+type APIV1POSTTransactionGroupSignRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+	// Base64 encodings of the msgpack encodings of the `SignedTxn` objects
+	// of the group, in order. Partial multisig signatures are added to.
+	Transactions   [][]byte `json:"transactions"`
+	WalletPassword string   `json:"wallet_password"`
+}
+
 // APIV1POSTProgramSignRequest is the request for `POST /v1/program/sign`
 //
 // swagger:model SignProgramRequest
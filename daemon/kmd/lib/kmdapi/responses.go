@@ -173,6 +173,36 @@ type exportMasterKeyResponse struct {
 	Body *APIV1POSTMasterKeyExportResponse
 }
 
+// APIV1POSTWalletBackupResponse is the response to `POST /v1/wallet/backup`
+// friendly:BackupWalletResponse
+type APIV1POSTWalletBackupResponse struct {
+	APIV1ResponseEnvelope
+
+	// swagger:strfmt byte
+	Backup []byte `json:"backup"`
+}
+
+// Response to `POST /v1/wallet/backup`
+// swagger:response BackupWalletResponse
+type backupWalletResponse struct {
+	//	in:body
+	Body *APIV1POSTWalletBackupResponse
+}
+
+// APIV1POSTWalletRestoreResponse is the response to `POST /v1/wallet/restore`
+// friendly:RestoreWalletResponse
+type APIV1POSTWalletRestoreResponse struct {
+	APIV1ResponseEnvelope
+	Wallet APIV1Wallet `json:"wallet"`
+}
+
+// Response to `POST /v1/wallet/restore`
+// swagger:response RestoreWalletResponse
+type restoreWalletResponse struct {
+	//	in:body
+	Body *APIV1POSTWalletRestoreResponse
+}
+
 // APIV1POSTKeyImportResponse is the response to `POST /v1/key/import`
 // friendly:ImportKeyResponse
 type APIV1POSTKeyImportResponse struct {
@@ -233,6 +263,9 @@ type deleteKeyResponse struct {
 type APIV1POSTKeyListResponse struct {
 	APIV1ResponseEnvelope
 	Addresses []string `json:"addresses"`
+	// DerivationPaths maps the addresses of the keys of an HD wallet which
+	// were derived by path to their paths
+	DerivationPaths map[string]string `json:"derivation_paths,omitempty"`
 }
 
 // Response to `POST /v1/key/list`
@@ -258,6 +291,21 @@ type signTransactionResponse struct {
 	Body *APIV1POSTTransactionSignResponse
 }
 
+// APIV1POSTTransactionGroupSignResponse is the response to `POST /v1/transaction/sign-group`
+// friendly:SignTransactionGroupResponse
+type APIV1POSTTransactionGroupSignResponse struct {
+	APIV1ResponseEnvelope
+
+	SignedTransactions [][]byte `json:"signed_transactions"`
+}
+
+// Response to `POST /v1/transaction/sign-group`
+// swagger:response SignTransactionGroupResponse
+type signTransactionGroupResponse struct {
+	//	in:body
+	Body *APIV1POSTTransactionGroupSignResponse
+}
+
 // APIV1POSTProgramSignResponse is the response to `POST /v1/data/sign`
 // friendly:SignProgramResponse
 type APIV1POSTProgramSignResponse struct {
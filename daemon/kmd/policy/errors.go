@@ -0,0 +1,67 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package policy
+
+import (
+	"fmt"
+)
+
+// ErrMaxAmount is returned when a transaction pays more than the policy allows
+var ErrMaxAmount = fmt.Errorf("signing policy: amount exceeds the per-transaction limit")
+
+// ErrWindowAmount is returned when signing would pay more within the policy's window than it allows
+var ErrWindowAmount = fmt.Errorf("signing policy: amount exceeds the limit for the time window")
+
+// ErrReceiver is returned when a transaction pays a receiver the policy does not allow
+var ErrReceiver = fmt.Errorf("signing policy: receiver not allowed")
+
+// ErrAppID is returned when a transaction calls an application the policy does not allow
+var ErrAppID = fmt.Errorf("signing policy: application not allowed")
+
+// ErrAssetID is returned when a transaction involves an asset the policy does not allow
+var ErrAssetID = fmt.Errorf("signing policy: asset not allowed")
+
+// ErrTxType is returned when a transaction is of a type the policy does not allow
+var ErrTxType = fmt.Errorf("signing policy: transaction type not allowed")
+
+// ErrRekey is returned when a transaction rekeys its sender and the policy does not allow it
+var ErrRekey = fmt.Errorf("signing policy: rekeying not allowed")
+
+// ErrClose is returned when a transaction closes out its sender and the policy does not allow it
+var ErrClose = fmt.Errorf("signing policy: closing out not allowed")
+
+// ErrProgram is returned when signing a program and the policy does not allow it
+var ErrProgram = fmt.Errorf("signing policy: program signing not allowed")
+
+// DenialError is returned when a signing policy denies a request. It wraps one
+// of the errors above, which identifies the rule that denied it.
+type DenialError struct {
+	Rule   error
+	Detail string
+}
+
+func (e *DenialError) Error() string {
+	return fmt.Sprintf("%v: %s", e.Rule, e.Detail)
+}
+
+func (e *DenialError) Unwrap() error {
+	return e.Rule
+}
+
+func deny(rule error, format string, args ...interface{}) *DenialError {
+	return &DenialError{Rule: rule, Detail: fmt.Sprintf(format, args...)}
+}
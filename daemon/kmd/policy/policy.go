@@ -0,0 +1,274 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package policy enforces the signing policies configured for kmd wallets.
+package policy
+
+import (
+	"math"
+	"slices"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// Enforcer evaluates requests to sign against the policy of the wallet they
+// are made to. Wallets without a policy may sign anything.
+type Enforcer struct {
+	mu       deadlock.Mutex
+	policies map[string]*walletPolicy
+	clock    func() time.Time
+}
+
+type walletPolicy struct {
+	config.SigningPolicy
+
+	// spent records the spends approved within the window, by transaction
+	// ID, so that signing a transaction again (for instance with another key
+	// of a multisig) does not count it twice
+	spent map[transactions.Txid][]spend
+}
+
+// spend is an amount of microAlgos, or of an asset, spent by a transaction.
+// The microAlgos spent include its fee.
+type spend struct {
+	at     time.Time
+	asset  basics.AssetIndex
+	amount uint64
+	// closes is set when the transaction also transfers whatever remains
+	// of the sender's balance, which is not known to kmd
+	closes bool
+}
+
+// MakeEnforcer creates an Enforcer for the policies, which are keyed by
+// wallet ID and must have been validated.
+func MakeEnforcer(policies map[string]config.SigningPolicy) *Enforcer {
+	e := &Enforcer{
+		policies: make(map[string]*walletPolicy),
+		clock:    time.Now,
+	}
+	for id, p := range policies {
+		e.policies[id] = &walletPolicy{
+			SigningPolicy: p,
+			spent:         make(map[transactions.Txid][]spend),
+		}
+	}
+	return e
+}
+
+// ApproveTransactions checks that the policy of the wallet allows signing all
+// of txns, which are considered together: the window limits apply to their
+// total. If it does, what they transfer is held against the window while
+// they are signed. The caller must call cancel if they could not be signed,
+// so that only transactions actually signed are charged.
+func (e *Enforcer) ApproveTransactions(walletID []byte, txns []transactions.Transaction) (cancel func(), err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	cancel = func() {}
+	p, ok := e.policies[string(walletID)]
+	if !ok {
+		return cancel, nil
+	}
+
+	for i := range txns {
+		err = p.check(&txns[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if p.WindowSecs == 0 {
+		return cancel, nil
+	}
+
+	now := e.clock()
+	windowStart := now.Add(-time.Duration(p.WindowSecs) * time.Second)
+	totals := make(map[basics.AssetIndex]uint64)
+	for txid, sps := range p.spent {
+		if !sps[0].at.After(windowStart) {
+			delete(p.spent, txid)
+			continue
+		}
+		for _, sp := range sps {
+			totals[sp.asset] += sp.amount
+		}
+	}
+	pending := make(map[transactions.Txid][]spend)
+	for i := range txns {
+		txid := txns[i].ID()
+		if _, ok := p.spent[txid]; ok {
+			continue
+		}
+		var sps []spend
+		for _, sp := range spendsOf(&txns[i]) {
+			if _, windowMax := p.limits(sp.asset); windowMax != 0 {
+				sp.at = now
+				sps = append(sps, sp)
+			}
+		}
+		if len(sps) > 0 {
+			pending[txid] = sps
+		}
+	}
+	for _, sps := range pending {
+		for _, sp := range sps {
+			_, windowMax := p.limits(sp.asset)
+			total, overflowed := basics.OAdd(totals[sp.asset], sp.amount)
+			if overflowed || total > windowMax {
+				if sp.asset == 0 {
+					return nil, deny(ErrWindowAmount, "signing would spend more than %d, fees included, within %d seconds", windowMax, p.WindowSecs)
+				}
+				return nil, deny(ErrWindowAmount, "signing would transfer more than %d of asset %d within %d seconds", windowMax, sp.asset, p.WindowSecs)
+			}
+			totals[sp.asset] = total
+		}
+	}
+	for txid, sps := range pending {
+		p.spent[txid] = sps
+	}
+	return func() {
+		e.mu.Lock()
+		defer e.mu.Unlock()
+		for txid := range pending {
+			delete(p.spent, txid)
+		}
+	}, nil
+}
+
+// ApproveProgram checks that the policy of the wallet allows signing programs.
+func (e *Enforcer) ApproveProgram(walletID []byte) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	p, ok := e.policies[string(walletID)]
+	if !ok || p.AllowProgramSigning {
+		return nil
+	}
+	return deny(ErrProgram, "logic signatures would bypass the policy")
+}
+
+func (p *walletPolicy) check(tx *transactions.Transaction) error {
+	if len(p.AllowedTxTypes) > 0 && !slices.Contains(p.AllowedTxTypes, tx.Type) {
+		return deny(ErrTxType, "transaction %s is of type %s", tx.ID(), tx.Type)
+	}
+	if !p.AllowRekey && !tx.RekeyTo.IsZero() {
+		return deny(ErrRekey, "transaction %s rekeys to %s", tx.ID(), tx.RekeyTo)
+	}
+	if !p.AllowClose && (!tx.CloseRemainderTo.IsZero() || !tx.AssetCloseTo.IsZero()) {
+		return deny(ErrClose, "transaction %s closes out %s", tx.ID(), tx.Sender)
+	}
+
+	for _, sp := range spendsOf(tx) {
+		if err := p.checkSpend(tx, sp); err != nil {
+			return err
+		}
+	}
+
+	switch tx.Type {
+	case protocol.PaymentTx:
+		if err := p.checkReceiver(tx, tx.Receiver); err != nil {
+			return err
+		}
+		if !tx.CloseRemainderTo.IsZero() {
+			return p.checkReceiver(tx, tx.CloseRemainderTo)
+		}
+	case protocol.AssetTransferTx:
+		if err := p.checkAsset(tx, tx.XferAsset); err != nil {
+			return err
+		}
+		if err := p.checkReceiver(tx, tx.AssetReceiver); err != nil {
+			return err
+		}
+		if !tx.AssetCloseTo.IsZero() {
+			return p.checkReceiver(tx, tx.AssetCloseTo)
+		}
+	case protocol.AssetConfigTx:
+		return p.checkAsset(tx, tx.ConfigAsset)
+	case protocol.AssetFreezeTx:
+		return p.checkAsset(tx, tx.FreezeAsset)
+	case protocol.ApplicationCallTx:
+		if len(p.AllowedAppIDs) > 0 && !slices.Contains(p.AllowedAppIDs, tx.ApplicationID) {
+			return deny(ErrAppID, "transaction %s calls application %d", tx.ID(), tx.ApplicationID)
+		}
+	}
+	return nil
+}
+
+// spendsOf returns what tx spends: its fee and the amount it pays in
+// microAlgos, whatever its type, and what it transfers of an asset
+func spendsOf(tx *transactions.Transaction) []spend {
+	algos := spend{amount: tx.Fee.Raw}
+	if tx.Type == protocol.PaymentTx {
+		amount, overflowed := basics.OAdd(algos.amount, tx.Amount.Raw)
+		if overflowed {
+			amount = math.MaxUint64
+		}
+		algos.amount = amount
+		algos.closes = !tx.CloseRemainderTo.IsZero()
+	}
+	sps := []spend{algos}
+	if tx.Type == protocol.AssetTransferTx {
+		sps = append(sps, spend{asset: tx.XferAsset, amount: tx.AssetAmount, closes: !tx.AssetCloseTo.IsZero()})
+	}
+	return sps
+}
+
+// limits returns the per-transaction and window limits on transferring the
+// asset, or microAlgos if it is 0. Zero means unlimited.
+func (p *walletPolicy) limits(asset basics.AssetIndex) (max uint64, windowMax uint64) {
+	if asset == 0 {
+		return p.MaxAmount, p.WindowMaxAmount
+	}
+	limit := p.AssetLimits[asset]
+	return limit.MaxAmount, limit.WindowMaxAmount
+}
+
+// checkSpend checks sp against the limits on its asset. Closing out counts
+// as transferring the sender's whole balance, so it is denied whenever the
+// amount is limited.
+func (p *walletPolicy) checkSpend(tx *transactions.Transaction, sp spend) error {
+	max, windowMax := p.limits(sp.asset)
+	if sp.closes && (max != 0 || windowMax != 0) {
+		return deny(ErrMaxAmount, "transaction %s closes out %s, transferring its whole balance", tx.ID(), tx.Sender)
+	}
+	if max == 0 || sp.amount <= max {
+		return nil
+	}
+	if sp.asset == 0 {
+		return deny(ErrMaxAmount, "transaction %s spends %d with its fee, more than %d", tx.ID(), sp.amount, max)
+	}
+	return deny(ErrMaxAmount, "transaction %s transfers %d of asset %d, more than %d", tx.ID(), sp.amount, sp.asset, max)
+}
+
+func (p *walletPolicy) checkReceiver(tx *transactions.Transaction, receiver basics.Address) error {
+	if len(p.AllowedReceivers) > 0 && !slices.Contains(p.AllowedReceivers, receiver) {
+		return deny(ErrReceiver, "transaction %s pays %s", tx.ID(), receiver)
+	}
+	return nil
+}
+
+func (p *walletPolicy) checkAsset(tx *transactions.Transaction, asset basics.AssetIndex) error {
+	if len(p.AllowedAssetIDs) > 0 && !slices.Contains(p.AllowedAssetIDs, asset) {
+		return deny(ErrAssetID, "transaction %s involves asset %d", tx.ID(), asset)
+	}
+	return nil
+}
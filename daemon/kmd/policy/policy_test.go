@@ -0,0 +1,165 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package policy
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/txntest"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestEnforcer(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var hot, payee, stranger basics.Address
+	crypto.RandBytes(hot[:])
+	crypto.RandBytes(payee[:])
+	crypto.RandBytes(stranger[:])
+
+	policies := map[string]config.SigningPolicy{
+		"hot": {
+			MaxAmount:        100,
+			WindowMaxAmount:  250,
+			WindowSecs:       60,
+			AllowedReceivers: []basics.Address{payee},
+			AllowedAppIDs:    []basics.AppIndex{7},
+			AllowedAssetIDs:  []basics.AssetIndex{9, 11},
+			AssetLimits:      map[basics.AssetIndex]config.AssetLimit{11: {MaxAmount: 10, WindowMaxAmount: 15}},
+			AllowedTxTypes:   []protocol.TxType{protocol.PaymentTx, protocol.AssetTransferTx, protocol.ApplicationCallTx},
+		},
+	}
+	for _, p := range policies {
+		require.NoError(t, p.Validate())
+	}
+	e := MakeEnforcer(policies)
+	now := time.Unix(1000, 0)
+	e.clock = func() time.Time { return now }
+
+	approveCancel := func(txns ...txntest.Txn) (func(), error) {
+		var txs []transactions.Transaction
+		for _, txn := range txns {
+			txn.Sender = hot
+			txs = append(txs, txn.Txn())
+		}
+		return e.ApproveTransactions([]byte("hot"), txs)
+	}
+	approve := func(txns ...txntest.Txn) error {
+		_, err := approveCancel(txns...)
+		return err
+	}
+	pay := func(amount uint64, note string) txntest.Txn {
+		return txntest.Txn{Type: protocol.PaymentTx, Receiver: payee, Amount: amount, Note: []byte(note)}
+	}
+
+	// Other wallets are unrestricted
+	_, err := e.ApproveTransactions([]byte("cold"), []transactions.Transaction{
+		txntest.Txn{Type: protocol.PaymentTx, Sender: hot, Amount: 1000, RekeyTo: stranger}.Txn(),
+	})
+	require.NoError(t, err)
+	require.NoError(t, e.ApproveProgram([]byte("cold")))
+
+	require.ErrorIs(t, approve(pay(101, "a")), ErrMaxAmount)
+	require.ErrorIs(t, approve(txntest.Txn{Type: protocol.PaymentTx, Receiver: stranger}), ErrReceiver)
+	require.ErrorIs(t, approve(txntest.Txn{Type: protocol.ApplicationCallTx, ApplicationID: 8}), ErrAppID)
+	require.ErrorIs(t, approve(txntest.Txn{Type: protocol.AssetTransferTx, XferAsset: 10, AssetReceiver: payee}), ErrAssetID)
+	require.ErrorIs(t, approve(txntest.Txn{Type: protocol.KeyRegistrationTx}), ErrTxType)
+	require.ErrorIs(t, approve(txntest.Txn{Type: protocol.PaymentTx, Receiver: payee, RekeyTo: stranger}), ErrRekey)
+	require.ErrorIs(t, approve(txntest.Txn{Type: protocol.PaymentTx, Receiver: payee, CloseRemainderTo: payee}), ErrClose)
+	require.ErrorIs(t, approve(txntest.Txn{Type: protocol.AssetTransferTx, XferAsset: 9, AssetReceiver: payee, AssetCloseTo: payee}), ErrClose)
+	require.ErrorIs(t, e.ApproveProgram([]byte("hot")), ErrProgram)
+
+	var denial *DenialError
+	require.ErrorAs(t, approve(pay(101, "a")), &denial)
+	require.Contains(t, denial.Error(), "spends 101 with its fee, more than 100")
+
+	require.NoError(t, approve(txntest.Txn{Type: protocol.ApplicationCallTx, ApplicationID: 7}))
+	require.NoError(t, approve(txntest.Txn{Type: protocol.AssetTransferTx, XferAsset: 9, AssetReceiver: payee}))
+
+	// The window applies to the whole group, and signing the same
+	// transaction again does not count it twice
+	require.NoError(t, approve(pay(100, "a"), pay(100, "b")))
+	require.NoError(t, approve(pay(100, "a")))
+	require.ErrorIs(t, approve(pay(30, "c"), pay(30, "d")), ErrWindowAmount)
+	require.NoError(t, approve(pay(50, "c")))
+	require.ErrorIs(t, approve(pay(1, "d")), ErrWindowAmount)
+
+	now = now.Add(61 * time.Second)
+	require.NoError(t, approve(pay(100, "d"), pay(100, "e")))
+
+	// Fees are spent too, by transactions of every type
+	now = now.Add(61 * time.Second)
+	require.ErrorIs(t, approve(txntest.Txn{Type: protocol.ApplicationCallTx, ApplicationID: 7, Fee: 101}), ErrMaxAmount)
+	require.ErrorIs(t, approve(txntest.Txn{Type: protocol.PaymentTx, Receiver: payee, Amount: 60, Fee: 41}), ErrMaxAmount)
+	require.NoError(t, approve(txntest.Txn{Type: protocol.PaymentTx, Receiver: payee, Amount: 60, Fee: 40}))
+	require.NoError(t, approve(txntest.Txn{Type: protocol.ApplicationCallTx, ApplicationID: 7, Fee: 100}))
+	require.NoError(t, approve(txntest.Txn{Type: protocol.AssetTransferTx, XferAsset: 9, AssetReceiver: payee, Fee: 50}))
+	require.ErrorIs(t, approve(txntest.Txn{Type: protocol.ApplicationCallTx, ApplicationID: 7, Fee: 1, Note: []byte("a")}), ErrWindowAmount)
+	require.ErrorIs(t, approve(txntest.Txn{Type: protocol.PaymentTx, Receiver: payee, Amount: 1, Fee: uint64(math.MaxUint64)}), ErrMaxAmount)
+
+	// Transactions that could not be signed are not charged
+	now = now.Add(61 * time.Second)
+	cancel, err := approveCancel(pay(100, "f"), pay(100, "g"))
+	require.NoError(t, err)
+	require.ErrorIs(t, approve(pay(100, "h")), ErrWindowAmount)
+	cancel()
+	require.NoError(t, approve(pay(100, "h"), pay(100, "i")))
+
+	// Assets with limits are limited like microAlgos, others are not
+	xfer := func(asset basics.AssetIndex, amount uint64, note string) txntest.Txn {
+		return txntest.Txn{Type: protocol.AssetTransferTx, XferAsset: asset, AssetReceiver: payee, AssetAmount: amount, Note: []byte(note)}
+	}
+	require.ErrorIs(t, approve(xfer(11, 11, "a")), ErrMaxAmount)
+	require.ErrorAs(t, approve(xfer(11, 11, "a")), &denial)
+	require.Contains(t, denial.Error(), "transfers 11 of asset 11, more than 10")
+	require.NoError(t, approve(xfer(11, 10, "a")))
+	require.ErrorIs(t, approve(xfer(11, 6, "b")), ErrWindowAmount)
+	require.NoError(t, approve(xfer(11, 5, "b")))
+	require.NoError(t, approve(xfer(9, 1000000, "a")))
+
+	// Closing out counts as transferring the whole balance, and must go to
+	// an allowed receiver
+	policies["closer"] = config.SigningPolicy{AllowClose: true, MaxAmount: 100, AssetLimits: map[basics.AssetIndex]config.AssetLimit{11: {MaxAmount: 10}}}
+	policies["unlimited"] = config.SigningPolicy{AllowClose: true, AllowedReceivers: []basics.Address{payee}}
+	e = MakeEnforcer(policies)
+	closeOut := func(wallet string, txn txntest.Txn) error {
+		txn.Sender = hot
+		_, err := e.ApproveTransactions([]byte(wallet), []transactions.Transaction{txn.Txn()})
+		return err
+	}
+	require.ErrorIs(t, closeOut("closer", txntest.Txn{Type: protocol.PaymentTx, Receiver: payee, CloseRemainderTo: payee}), ErrMaxAmount)
+	require.ErrorIs(t, closeOut("closer", txntest.Txn{Type: protocol.AssetTransferTx, XferAsset: 11, AssetReceiver: payee, AssetCloseTo: payee}), ErrMaxAmount)
+	require.NoError(t, closeOut("closer", txntest.Txn{Type: protocol.AssetTransferTx, XferAsset: 9, AssetReceiver: payee, AssetCloseTo: payee}))
+	require.NoError(t, closeOut("unlimited", txntest.Txn{Type: protocol.PaymentTx, Receiver: payee, CloseRemainderTo: payee}))
+	require.ErrorIs(t, closeOut("unlimited", txntest.Txn{Type: protocol.PaymentTx, Receiver: payee, CloseRemainderTo: stranger}), ErrReceiver)
+	require.ErrorIs(t, closeOut("unlimited", txntest.Txn{Type: protocol.AssetTransferTx, XferAsset: 9, AssetReceiver: payee, AssetCloseTo: stranger}), ErrReceiver)
+
+	require.ErrorIs(t, config.SigningPolicy{WindowSecs: 10}.Validate(), config.ErrSigningPolicyWindow)
+	require.ErrorIs(t, config.SigningPolicy{AssetLimits: map[basics.AssetIndex]config.AssetLimit{11: {WindowMaxAmount: 10}}}.Validate(), config.ErrSigningPolicyWindow)
+	require.NoError(t, config.SigningPolicy{WindowSecs: 10, AssetLimits: map[basics.AssetIndex]config.AssetLimit{11: {WindowMaxAmount: 10}}}.Validate())
+	require.ErrorIs(t, config.SigningPolicy{AllowedTxTypes: []protocol.TxType{"xfer"}}.Validate(), config.ErrSigningPolicyTxType)
+}
@@ -0,0 +1,107 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package remotesigner
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+)
+
+// ErrBadSignature is returned when a signer answers with a signature which does not verify.
+var ErrBadSignature = errors.New("the remote signer returned an invalid signature")
+
+// SignerError is an error reported by the signer.
+type SignerError struct {
+	Message string
+}
+
+func (e *SignerError) Error() string {
+	return fmt.Sprintf("remote signer: %s", e.Message)
+}
+
+// Client calls a signer. Every call uses a new connection, so that the signer may be restarted
+// at any time.
+type Client struct {
+	socket  string
+	timeout time.Duration
+}
+
+// MakeClient creates a client for the signer listening on the socket. Calls fail after timeout.
+func MakeClient(socket string, timeout time.Duration) *Client {
+	return &Client{socket: socket, timeout: timeout}
+}
+
+func (c *Client) call(req *Request) (*Response, error) {
+	conn, err := net.DialTimeout("unix", c.socket, c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if err = conn.SetDeadline(time.Now().Add(c.timeout)); err != nil {
+		return nil, err
+	}
+	if err = WriteMessage(conn, req); err != nil {
+		return nil, err
+	}
+	var resp Response
+	if err = ReadMessage(conn, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Error != "" {
+		return nil, &SignerError{Message: resp.Error}
+	}
+	return &resp, nil
+}
+
+// ListKeys returns the public keys the signer holds.
+func (c *Client) ListKeys() ([]crypto.PublicKey, error) {
+	resp, err := c.call(&Request{Method: MethodListKeys})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Keys, nil
+}
+
+// SignTransaction has the signer sign tx with the key pk.
+func (c *Client) SignTransaction(tx transactions.Transaction, pk crypto.PublicKey) (crypto.Signature, error) {
+	resp, err := c.call(&Request{Method: MethodSignTransaction, Key: pk, Txn: tx})
+	if err != nil {
+		return crypto.Signature{}, err
+	}
+	if !crypto.SignatureVerifier(pk).Verify(tx, resp.Sig) {
+		return crypto.Signature{}, ErrBadSignature
+	}
+	return resp.Sig, nil
+}
+
+// SignProgram has the signer sign a program with the key pk.
+func (c *Client) SignProgram(program []byte, pk crypto.PublicKey) (crypto.Signature, error) {
+	resp, err := c.call(&Request{Method: MethodSignProgram, Key: pk, Program: program})
+	if err != nil {
+		return crypto.Signature{}, err
+	}
+	if !crypto.SignatureVerifier(pk).Verify(logic.Program(program), resp.Sig) {
+		return crypto.Signature{}, ErrBadSignature
+	}
+	return resp.Sig, nil
+}
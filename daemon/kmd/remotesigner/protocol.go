@@ -0,0 +1,95 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package remotesigner implements the protocol kmd's remote wallet driver uses to have an
+// out-of-process signer sign on its behalf, along with a client and a reference signer.
+//
+// A signer listens on a Unix socket. Each message is a msgpack encoded Request or Response,
+// preceded by its length as a 4 byte big endian integer. A connection carries any number of
+// requests, each answered by one response, in order.
+package remotesigner
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// The methods a signer serves.
+const (
+	// MethodListKeys returns the public keys the signer holds.
+	MethodListKeys = "list_keys"
+	// MethodSignTransaction signs Txn with Key.
+	MethodSignTransaction = "sign_txn"
+	// MethodSignProgram signs Program, as a logic.Program, with Key.
+	MethodSignProgram = "sign_program"
+)
+
+// MaxMessageSize bounds the size of the messages exchanged with a signer.
+const MaxMessageSize = 1 << 20
+
+// Request is a call to a signer.
+type Request struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Method  string                   `codec:"method"`
+	Key     crypto.PublicKey         `codec:"key"`
+	Txn     transactions.Transaction `codec:"txn"`
+	Program []byte                   `codec:"prog"`
+}
+
+// Response is a signer's answer to a Request. Error is set when the request failed.
+type Response struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Error string             `codec:"error"`
+	Keys  []crypto.PublicKey `codec:"keys"`
+	Sig   crypto.Signature   `codec:"sig"`
+}
+
+// WriteMessage writes a Request or a Response to w.
+func WriteMessage(w io.Writer, msg interface{}) error {
+	data := protocol.EncodeReflect(msg)
+	if len(data) > MaxMessageSize {
+		return fmt.Errorf("message of %d bytes exceeds the maximum of %d", len(data), MaxMessageSize)
+	}
+	buf := make([]byte, 4+len(data))
+	binary.BigEndian.PutUint32(buf, uint32(len(data)))
+	copy(buf[4:], data)
+	_, err := w.Write(buf)
+	return err
+}
+
+// ReadMessage reads a Request or a Response from r into msg.
+func ReadMessage(r io.Reader, msg interface{}) error {
+	var size [4]byte
+	if _, err := io.ReadFull(r, size[:]); err != nil {
+		return err
+	}
+	n := binary.BigEndian.Uint32(size[:])
+	if n > MaxMessageSize {
+		return fmt.Errorf("message of %d bytes exceeds the maximum of %d", n, MaxMessageSize)
+	}
+	data := make([]byte, n)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return err
+	}
+	return protocol.DecodeReflect(data, msg)
+}
@@ -0,0 +1,104 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package remotesigner
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"slices"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/logging"
+)
+
+// Server is a reference signer, which holds its keys in memory. Real signers are expected to
+// keep their keys in an HSM, or behind similar protections, and to speak the same protocol.
+type Server struct {
+	keys map[crypto.PublicKey]*crypto.SignatureSecrets
+	log  logging.Logger
+}
+
+// MakeServer creates a signer holding the given keys.
+func MakeServer(keys []*crypto.SignatureSecrets, log logging.Logger) *Server {
+	s := &Server{keys: make(map[crypto.PublicKey]*crypto.SignatureSecrets), log: log}
+	for _, key := range keys {
+		s.keys[crypto.PublicKey(key.SignatureVerifier)] = key
+	}
+	return s
+}
+
+// Serve answers the connections accepted on l until l is closed.
+func (s *Server) Serve(l net.Listener) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+		go s.serveConn(conn)
+	}
+}
+
+func (s *Server) serveConn(conn net.Conn) {
+	defer conn.Close()
+	for {
+		var req Request
+		err := ReadMessage(conn, &req)
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				s.log.Warnf("remote signer: failed to read a request: %v", err)
+			}
+			return
+		}
+		resp := s.handle(&req)
+		if err = WriteMessage(conn, resp); err != nil {
+			s.log.Warnf("remote signer: failed to write a response: %v", err)
+			return
+		}
+	}
+}
+
+func (s *Server) handle(req *Request) *Response {
+	switch req.Method {
+	case MethodListKeys:
+		keys := make([]crypto.PublicKey, 0, len(s.keys))
+		for pk := range s.keys {
+			keys = append(keys, pk)
+		}
+		slices.SortFunc(keys, func(a, b crypto.PublicKey) int { return bytes.Compare(a[:], b[:]) })
+		return &Response{Keys: keys}
+	case MethodSignTransaction, MethodSignProgram:
+		key, ok := s.keys[req.Key]
+		if !ok {
+			return &Response{Error: "key does not exist in this signer"}
+		}
+		if req.Method == MethodSignTransaction {
+			s.log.Infof("remote signer: signing transaction %s with %s", req.Txn.ID(), req.Key)
+			return &Response{Sig: key.Sign(req.Txn)}
+		}
+		s.log.Infof("remote signer: signing a program of %d bytes with %s", len(req.Program), req.Key)
+		return &Response{Sig: key.Sign(logic.Program(req.Program))}
+	default:
+		return &Response{Error: fmt.Sprintf("unknown method %s", req.Method)}
+	}
+}
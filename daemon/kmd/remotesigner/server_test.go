@@ -0,0 +1,79 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package remotesigner
+
+import (
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/data/txntest"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestClientServer(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var seed crypto.Seed
+	crypto.RandBytes(seed[:])
+	key := crypto.GenerateSignatureSecrets(seed)
+	pk := crypto.PublicKey(key.SignatureVerifier)
+
+	socket := filepath.Join(t.TempDir(), "signer.sock")
+	listener, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	done := make(chan error)
+	go func() { done <- MakeServer([]*crypto.SignatureSecrets{key}, logging.TestingLog(t)).Serve(listener) }()
+	defer func() {
+		listener.Close()
+		require.NoError(t, <-done)
+	}()
+
+	client := MakeClient(socket, 5*time.Second)
+	keys, err := client.ListKeys()
+	require.NoError(t, err)
+	require.Equal(t, []crypto.PublicKey{pk}, keys)
+
+	tx := txntest.Txn{Type: protocol.PaymentTx, Sender: basics.Address(pk), Amount: 5}.Txn()
+	sig, err := client.SignTransaction(tx, pk)
+	require.NoError(t, err)
+	require.True(t, key.Verify(tx, sig))
+
+	program := []byte{0x06, 0x81, 0x01}
+	sig, err = client.SignProgram(program, pk)
+	require.NoError(t, err)
+	require.True(t, key.Verify(logic.Program(program), sig))
+
+	var other crypto.PublicKey
+	crypto.RandBytes(other[:])
+	_, err = client.SignTransaction(tx, other)
+	var signerErr *SignerError
+	require.ErrorAs(t, err, &signerErr)
+	require.Contains(t, signerErr.Message, "key does not exist")
+
+	_, err = MakeClient(filepath.Join(t.TempDir(), "missing.sock"), time.Second).ListKeys()
+	require.Error(t, err)
+}
@@ -18,9 +18,12 @@ package session
 
 import (
 	"context"
+	"path/filepath"
 	"time"
 
+	"github.com/algorand/go-algorand/daemon/kmd/audit"
 	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/policy"
 	"github.com/algorand/go-algorand/daemon/kmd/wallet"
 	"github.com/algorand/go-deadlock"
 )
@@ -38,22 +41,61 @@ type Manager struct {
 	Initialized     bool
 	walletHandles   map[string]walletHandle
 	sessionLifetime time.Duration
+	policies        *policy.Enforcer
+	auditLog        *audit.Log
 	Kill            context.CancelFunc
 	ctx             context.Context
 	mux             deadlock.Mutex
 }
 
 // MakeManager initializes and returns a *Manager using the kmd global
-// configuration
-func MakeManager(cfg config.KMDConfig) *Manager {
+// configuration, opening the audit log in kmd's data directory
+func MakeManager(cfg config.KMDConfig) (*Manager, error) {
+	auditLog, err := audit.Open(filepath.Join(cfg.DataDir, audit.Filename))
+	if err != nil {
+		return nil, err
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	sm := &Manager{
 		Initialized:     true,
 		walletHandles:   make(map[string]walletHandle),
 		sessionLifetime: time.Duration(cfg.SessionLifetimeSecs * uint64(time.Second)),
+		policies:        policy.MakeEnforcer(cfg.SigningPolicies),
+		auditLog:        auditLog,
 		Kill:            cancel,
 		ctx:             ctx,
 	}
 	go sm.cleanUpExpiredHandles()
-	return sm
+	return sm, nil
+}
+
+// Policies returns the enforcer of the wallets' signing policies
+func (sm *Manager) Policies() *policy.Enforcer {
+	return sm.policies
+}
+
+// RecordOperation appends an entry for an operation made through a wallet
+// handle to the audit log, filling in the wallet ID and the handle ID. The
+// handle's secret is never recorded.
+func (sm *Manager) RecordOperation(walletHandleToken []byte, w wallet.Wallet, entry audit.Entry) error {
+	handleID, _, err := splitHandle(walletHandleToken)
+	if err == nil {
+		entry.Handle = string(handleID)
+	}
+
+	md, err := w.Metadata()
+	if err != nil {
+		return err
+	}
+	entry.WalletID = string(md.ID)
+
+	return sm.auditLog.Append(entry)
+}
+
+// RecordWalletOperation appends an entry for an operation which is not made
+// through a wallet handle, such as restoring a wallet, to the audit log. The
+// entry's wallet ID is left to the caller, since the wallet may not exist yet.
+func (sm *Manager) RecordWalletOperation(entry audit.Entry) error {
+	return sm.auditLog.Append(entry)
 }
@@ -28,6 +28,7 @@ import (
 var walletDrivers = map[string]Driver{
 	sqliteWalletDriverName: &SQLiteWalletDriver{},
 	ledgerWalletDriverName: &LedgerWalletDriver{},
+	remoteWalletDriverName: &RemoteWalletDriver{},
 }
 
 // Driver is the interface that all wallet drivers must expose in order to be
@@ -42,6 +43,20 @@ type Driver interface {
 	FetchWallet(id []byte) (wallet.Wallet, error)
 }
 
+// HDDriver is implemented by wallet drivers which can create wallets that
+// derive their keys by BIP32-Ed25519 path from a BIP39 mnemonic, whose
+// entropy is the first entropyLen bytes of mdk unless generate is set, and a
+// BIP39 passphrase
+type HDDriver interface {
+	CreateHDWallet(name []byte, id []byte, pw []byte, mdk crypto.MasterDerivationKey, generate bool, entropyLen int, bip39Passphrase string, account uint32) error
+}
+
+// RestoreDriver is implemented by wallet drivers which can restore a wallet
+// from a backup bundle, encrypting it with pw. It returns the wallet's ID.
+type RestoreDriver interface {
+	RestoreWallet(name []byte, pw []byte, bundle []byte, backupPw []byte) ([]byte, error)
+}
+
 // InitWalletDrivers accepts a KMDConfig and uses it to initialize each driver
 func InitWalletDrivers(cfg config.KMDConfig, log logging.Logger) error {
 	for _, driver := range walletDrivers {
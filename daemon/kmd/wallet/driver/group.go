@@ -0,0 +1,204 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/algorand/go-algorand/config/bounds"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+var errEmptyGroup = fmt.Errorf("transaction group is empty")
+var errGroupTooLarge = fmt.Errorf("transaction group has more than %d transactions", bounds.MaxTxGroupSize)
+var errGroupMismatch = fmt.Errorf("group ID does not match the transactions of the group")
+var errNothingToSign = fmt.Errorf("no transaction in the group can be signed by this wallet")
+
+// groupSigning describes how a wallet signs one transaction of a group: with
+// key, or by adding the signatures of msigKeys to msig
+type groupSigning struct {
+	index    int
+	key      crypto.PublicKey
+	msig     crypto.MultisigSig
+	msigKeys []crypto.PublicKey
+}
+
+// checkGroup checks that the group ID of the transactions is the one they
+// form. A single transaction does not need a group ID.
+func checkGroup(stxns []transactions.SignedTxn) error {
+	if len(stxns) == 0 {
+		return errEmptyGroup
+	}
+	if len(stxns) > bounds.MaxTxGroupSize {
+		return errGroupTooLarge
+	}
+	if len(stxns) == 1 && stxns[0].Txn.Group.IsZero() {
+		return nil
+	}
+
+	var group transactions.TxGroup
+	for i := range stxns {
+		tx := stxns[i].Txn
+		if tx.Group != stxns[0].Txn.Group || tx.Group.IsZero() {
+			return errGroupMismatch
+		}
+		tx.Group = crypto.Digest{}
+		group.TxGroupHashes = append(group.TxGroupHashes, crypto.Digest(tx.ID()))
+	}
+	if crypto.HashObj(group) != stxns[0].Txn.Group {
+		return errGroupMismatch
+	}
+	return nil
+}
+
+// GroupSigningPlan describes which transactions of a group a wallet signs,
+// and how. It is made once, so that the transactions can be checked before
+// the very same ones are signed.
+type GroupSigningPlan struct {
+	w        wallet.Wallet
+	stxns    []transactions.SignedTxn
+	signings []groupSigning
+}
+
+// PlanGroupSigning checks the group and works out which of its transactions
+// w can sign. Transactions which already carry a signature or a logic
+// signature are left alone, as are multisig signatures already made by the
+// keys of w. stxns must not be modified until the plan is carried out.
+func PlanGroupSigning(w wallet.Wallet, stxns []transactions.SignedTxn) (*GroupSigningPlan, error) {
+	err := checkGroup(stxns)
+	if err != nil {
+		return nil, err
+	}
+
+	addrs, err := w.ListKeys()
+	if err != nil {
+		return nil, err
+	}
+	keys := make(map[crypto.PublicKey]bool, len(addrs))
+	for _, addr := range addrs {
+		keys[crypto.PublicKey(addr)] = true
+	}
+
+	var plan []groupSigning
+	for i := range stxns {
+		stxn := &stxns[i]
+		if !stxn.Sig.Blank() || !stxn.Lsig.Blank() {
+			continue
+		}
+		authorizer := stxn.Authorizer()
+
+		if stxn.Msig.Blank() && keys[crypto.PublicKey(authorizer)] {
+			plan = append(plan, groupSigning{index: i, key: crypto.PublicKey(authorizer)})
+			continue
+		}
+
+		msig := stxn.Msig
+		if msig.Blank() {
+			version, threshold, pks, err := w.LookupMultisigPreimage(crypto.Digest(authorizer))
+			if errors.Is(err, errMsigDataNotFound) || errors.Is(err, errNotSupported) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			msig = crypto.MultisigSig{Version: version, Threshold: threshold}
+			for _, pk := range pks {
+				msig.Subsigs = append(msig.Subsigs, crypto.MultisigSubsig{Key: pk})
+			}
+		}
+
+		var msigKeys []crypto.PublicKey
+		for _, subsig := range msig.Subsigs {
+			if keys[subsig.Key] && subsig.Sig.Blank() && !slices.Contains(msigKeys, subsig.Key) {
+				msigKeys = append(msigKeys, subsig.Key)
+			}
+		}
+		if len(msigKeys) > 0 {
+			plan = append(plan, groupSigning{index: i, msig: msig, msigKeys: msigKeys})
+		}
+	}
+
+	if len(plan) == 0 {
+		return nil, errNothingToSign
+	}
+	return &GroupSigningPlan{w: w, stxns: stxns, signings: plan}, nil
+}
+
+// Indexes returns the indexes of the transactions of the group which the
+// wallet signs
+func (p *GroupSigningPlan) Indexes() []int {
+	indexes := make([]int, len(p.signings))
+	for i, signing := range p.signings {
+		indexes[i] = signing.index
+	}
+	return indexes
+}
+
+// Sign signs the transactions of the plan, using the single transaction
+// signing methods of its wallet, and returns the whole group
+func (p *GroupSigningPlan) Sign(pw []byte) ([]transactions.SignedTxn, error) {
+	w := p.w
+	signed := make([]transactions.SignedTxn, len(p.stxns))
+	copy(signed, p.stxns)
+	for _, signing := range p.signings {
+		stxn := &signed[signing.index]
+		authorizer := stxn.Authorizer()
+
+		if len(signing.msigKeys) == 0 {
+			enc, err := w.SignTransaction(stxn.Txn, signing.key, pw)
+			if err != nil {
+				return nil, err
+			}
+			var res transactions.SignedTxn
+			err = protocol.Decode(enc, &res)
+			if err != nil {
+				return nil, err
+			}
+			stxn.Sig = res.Sig
+			stxn.AuthAddr = res.AuthAddr
+			continue
+		}
+
+		var err error
+		msig := signing.msig
+		for _, pk := range signing.msigKeys {
+			msig, err = w.MultisigSignTransaction(stxn.Txn, pk, msig, pw, crypto.Digest(authorizer))
+			if err != nil {
+				return nil, err
+			}
+		}
+		stxn.Msig = msig
+		if authorizer != stxn.Txn.Sender {
+			stxn.AuthAddr = authorizer
+		}
+	}
+	return signed, nil
+}
+
+// signTransactionGroup implements SignTransactionGroup for every driver
+func signTransactionGroup(w wallet.Wallet, stxns []transactions.SignedTxn, pw []byte) ([]transactions.SignedTxn, error) {
+	plan, err := PlanGroupSigning(w, stxns)
+	if err != nil {
+		return nil, err
+	}
+	return plan.Sign(pw)
+}
@@ -0,0 +1,129 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/txntest"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// groupOf returns txns as a group of unsigned transactions
+func groupOf(txns ...transactions.Transaction) []transactions.SignedTxn {
+	var group transactions.TxGroup
+	for _, tx := range txns {
+		group.TxGroupHashes = append(group.TxGroupHashes, crypto.Digest(tx.ID()))
+	}
+	gid := crypto.HashObj(group)
+	stxns := make([]transactions.SignedTxn, len(txns))
+	for i, tx := range txns {
+		tx.Group = gid
+		stxns[i].Txn = tx
+	}
+	return stxns
+}
+
+func TestSignTransactionGroup(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := config.DefaultConfig(t.TempDir())
+	cfg.DriverConfig.SQLiteWalletDriverConfig.WalletsDir = t.TempDir()
+	cfg.DriverConfig.SQLiteWalletDriverConfig.UnsafeScrypt = true
+	cfg.DriverConfig.SQLiteWalletDriverConfig.ScryptParams = config.ScryptParams{ScryptN: 2, ScryptR: 1, ScryptP: 1}
+
+	var swd SQLiteWalletDriver
+	require.NoError(t, swd.InitWithConfig(cfg, logging.TestingLog(t)))
+	pw := []byte("pw")
+	require.NoError(t, swd.CreateWallet([]byte("group"), []byte("group-id"), pw, crypto.MasterDerivationKey{}))
+	w, err := swd.FetchWallet([]byte("group-id"))
+	require.NoError(t, err)
+	require.NoError(t, w.Init(pw))
+
+	own, err := w.GenerateKey(false)
+	require.NoError(t, err)
+	var other, stranger crypto.PublicKey
+	crypto.RandBytes(other[:])
+	crypto.RandBytes(stranger[:])
+	msigAddr, err := w.ImportMultisigAddr(1, 2, []crypto.PublicKey{crypto.PublicKey(own), other})
+	require.NoError(t, err)
+
+	pay := func(sender basics.Address, amount uint64) transactions.Transaction {
+		return txntest.Txn{Type: protocol.PaymentTx, Sender: sender, Receiver: basics.Address(stranger), Amount: amount}.Txn()
+	}
+	rekeyed := pay(basics.Address(stranger), 3)
+	stxns := groupOf(
+		pay(basics.Address(own), 1),
+		pay(basics.Address(msigAddr), 2),
+		pay(basics.Address(stranger), 4),
+		rekeyed,
+	)
+	stxns[3].AuthAddr = basics.Address(own)
+
+	plan, err := PlanGroupSigning(w, stxns)
+	require.NoError(t, err)
+	require.Equal(t, []int{0, 1, 3}, plan.Indexes())
+	planned, err := plan.Sign(pw)
+	require.NoError(t, err)
+
+	signed, err := w.SignTransactionGroup(stxns, pw)
+	require.NoError(t, err)
+	require.Equal(t, planned, signed)
+	require.Len(t, signed, len(stxns))
+	require.False(t, signed[0].Sig.Blank())
+	require.True(t, signed[0].AuthAddr.IsZero())
+	require.Len(t, signed[1].Msig.Subsigs, 2)
+	require.False(t, signed[1].Msig.Subsigs[0].Sig.Blank())
+	require.True(t, signed[1].Msig.Subsigs[1].Sig.Blank())
+	require.Equal(t, stxns[2], signed[2])
+	require.False(t, signed[3].Sig.Blank())
+	require.Equal(t, basics.Address(own), signed[3].AuthAddr)
+	for i := range signed {
+		require.Equal(t, stxns[i].Txn, signed[i].Txn)
+	}
+
+	// Nothing is left for the wallet to sign once it has signed the group
+	_, err = w.SignTransactionGroup(signed, pw)
+	require.ErrorIs(t, err, errNothingToSign)
+
+	// The group ID must match the transactions
+	tampered := append([]transactions.SignedTxn{}, stxns...)
+	tampered[2].Txn.Amount = basics.MicroAlgos{Raw: 5}
+	_, err = w.SignTransactionGroup(tampered, pw)
+	require.ErrorIs(t, err, errGroupMismatch)
+	_, err = w.SignTransactionGroup(stxns[:2], pw)
+	require.ErrorIs(t, err, errGroupMismatch)
+
+	// A lone transaction needs no group ID
+	signed, err = w.SignTransactionGroup([]transactions.SignedTxn{{Txn: pay(basics.Address(own), 6)}}, pw)
+	require.NoError(t, err)
+	require.False(t, signed[0].Sig.Blank())
+	_, err = w.SignTransactionGroup(nil, pw)
+	require.ErrorIs(t, err, errEmptyGroup)
+
+	_, err = w.SignTransactionGroup(stxns, []byte("wrong"))
+	require.Error(t, err)
+}
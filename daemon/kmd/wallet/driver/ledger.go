@@ -367,6 +367,11 @@ func (lw *LedgerWallet) SignProgram(data []byte, src crypto.Digest, pw []byte) (
 	return sig[:], nil
 }
 
+// SignTransactionGroup implements the Wallet interface.
+func (lw *LedgerWallet) SignTransactionGroup(stxns []transactions.SignedTxn, pw []byte) ([]transactions.SignedTxn, error) {
+	return signTransactionGroup(lw, stxns, pw)
+}
+
 // MultisigSignTransaction implements the Wallet interface.
 func (lw *LedgerWallet) MultisigSignTransaction(tx transactions.Transaction, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte, signer crypto.Digest) (crypto.MultisigSig, error) {
 	isValidKey := false
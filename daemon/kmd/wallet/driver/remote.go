@@ -0,0 +1,405 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/remotesigner"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+const (
+	remoteWalletDriverName    = "remote"
+	remoteWalletDriverVersion = 1
+	remoteIDLen               = 16
+	defaultRemoteTimeoutSecs  = 10
+)
+
+var remoteWalletSupportedTxs = []protocol.TxType{
+	protocol.PaymentTx, protocol.KeyRegistrationTx, protocol.AssetConfigTx,
+	protocol.AssetTransferTx, protocol.AssetFreezeTx, protocol.ApplicationCallTx,
+}
+
+// RemoteWalletDriver provides access to out-of-process signers, such as
+// ones fronting an HSM, which speak the protocol in package remotesigner.
+// Each signer configured in kmd_config.json is exposed as one wallet whose
+// keys are the keys the signer holds. Keys never leave the signer, so they
+// cannot be imported, exported, generated or deleted through kmd.
+type RemoteWalletDriver struct {
+	mu      deadlock.Mutex
+	wallets map[string]*RemoteWallet
+	log     logging.Logger
+}
+
+// RemoteWallet represents a particular signer under the RemoteWalletDriver
+type RemoteWallet struct {
+	id       string
+	name     string
+	client   *remotesigner.Client
+	verifier []byte
+
+	// Once the password has been checked against the verifier, which is
+	// slow by design, it is checked against a fast salted hash instead,
+	// like the password of a SQLiteWallet
+	mu             deadlock.Mutex
+	passwordHashed bool
+	passwordSalt   [saltLen]byte
+	passwordHash   crypto.Digest
+}
+
+// MakeRemotePasswordVerifier returns the password_verifier to configure for a
+// remote signer, so that its wallet is opened with pw. Like the master key of
+// a SQLite wallet, it is encrypted under a key derived from pw with scrypt.
+func MakeRemotePasswordVerifier(pw []byte, cfg config.ScryptParams) (string, error) {
+	blob, err := encryptBlobWithPasswordBlankOK(nil, PTRemotePasswordVerifier, pw, &cfg)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(blob), nil
+}
+
+// InitWithConfig creates a wallet for each of the configured signers. The
+// signers are not contacted until a wallet is used, so that kmd may start
+// before them.
+func (rwd *RemoteWalletDriver) InitWithConfig(cfg config.KMDConfig, log logging.Logger) error {
+	rwd.mu.Lock()
+	defer rwd.mu.Unlock()
+
+	rwd.log = log
+	rwd.wallets = make(map[string]*RemoteWallet)
+	for _, signer := range cfg.DriverConfig.RemoteWalletDriverConfig.Signers {
+		timeout := signer.TimeoutSecs
+		if timeout == 0 {
+			timeout = defaultRemoteTimeoutSecs
+		}
+		verifier, err := base64.StdEncoding.DecodeString(signer.PasswordVerifier)
+		if err != nil {
+			return fmt.Errorf("password_verifier of remote signer %s: %w", signer.Name, err)
+		}
+		rw := &RemoteWallet{
+			id:       signerNameToID(signer.Name),
+			name:     signer.Name,
+			client:   remotesigner.MakeClient(signer.Socket, time.Duration(timeout)*time.Second),
+			verifier: verifier,
+		}
+		rwd.wallets[rw.id] = rw
+	}
+	return nil
+}
+
+// ListWalletMetadatas returns all wallets supported by this driver.
+func (rwd *RemoteWalletDriver) ListWalletMetadatas() (metadatas []wallet.Metadata, err error) {
+	rwd.mu.Lock()
+	defer rwd.mu.Unlock()
+
+	for _, w := range rwd.wallets {
+		md, err := w.Metadata()
+		if err != nil {
+			return nil, err
+		}
+
+		metadatas = append(metadatas, md)
+	}
+
+	// Sort metadatas by ID
+	sort.Slice(metadatas, func(i, j int) bool {
+		return bytes.Compare(metadatas[i].ID, metadatas[j].ID) < 0
+	})
+
+	return metadatas, nil
+}
+
+// CreateWallet implements the Driver interface. Remote wallets are added by
+// configuring a signer instead.
+func (rwd *RemoteWalletDriver) CreateWallet(name []byte, id []byte, pw []byte, mdk crypto.MasterDerivationKey) error {
+	return errNotSupported
+}
+
+// RenameWallet implements the Driver interface.
+func (rwd *RemoteWalletDriver) RenameWallet(newName []byte, id []byte, pw []byte) error {
+	return errNotSupported
+}
+
+// FetchWallet looks up a wallet by ID and returns it
+func (rwd *RemoteWalletDriver) FetchWallet(id []byte) (w wallet.Wallet, err error) {
+	rwd.mu.Lock()
+	defer rwd.mu.Unlock()
+
+	rw, ok := rwd.wallets[string(id)]
+	if !ok {
+		return nil, errWalletNotFound
+	}
+
+	return rw, nil
+}
+
+func signerNameToID(name string) string {
+	hash := sha512.Sum512_256([]byte(remoteWalletDriverName + ":" + name))
+	return fmt.Sprintf("%x", hash[:remoteIDLen])
+}
+
+// Init checks the password against the configured password verifier, and
+// remembers a hash of it for subsequent operations
+func (rw *RemoteWallet) Init(pw []byte) error {
+	_, err := decryptBlobWithPassword(rw.verifier, PTRemotePasswordVerifier, pw)
+	if err != nil {
+		return err
+	}
+
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+	if rw.passwordHashed {
+		return nil
+	}
+	err = fillRandomBytes(rw.passwordSalt[:])
+	if err != nil {
+		return err
+	}
+	rw.passwordHash = fastHashWithSalt(pw, rw.passwordSalt[:])
+	rw.passwordHashed = true
+	return nil
+}
+
+// CheckPassword checks the password against the configured password
+// verifier. It's the same as Init but doesn't remember the password hash.
+func (rw *RemoteWallet) CheckPassword(pw []byte) error {
+	rw.mu.Lock()
+	if rw.passwordHashed {
+		pwhash := fastHashWithSalt(pw, rw.passwordSalt[:])
+		rw.mu.Unlock()
+		if subtle.ConstantTimeCompare(pwhash[:], rw.passwordHash[:]) == 1 {
+			return nil
+		}
+		return errDecrypt
+	}
+	rw.mu.Unlock()
+
+	_, err := decryptBlobWithPassword(rw.verifier, PTRemotePasswordVerifier, pw)
+	return err
+}
+
+// ExportMasterDerivationKey implements the Wallet interface.
+func (rw *RemoteWallet) ExportMasterDerivationKey(pw []byte) (crypto.MasterDerivationKey, error) {
+	return crypto.MasterDerivationKey{}, errNotSupported
+}
+
+// Metadata implements the Wallet interface.
+func (rw *RemoteWallet) Metadata() (wallet.Metadata, error) {
+	return wallet.Metadata{
+		ID:                    []byte(rw.id),
+		Name:                  []byte(rw.name),
+		DriverName:            remoteWalletDriverName,
+		DriverVersion:         remoteWalletDriverVersion,
+		SupportedTransactions: remoteWalletSupportedTxs,
+	}, nil
+}
+
+// ListKeys implements the Wallet interface.
+func (rw *RemoteWallet) ListKeys() ([]crypto.Digest, error) {
+	pks, err := rw.client.ListKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	addrs := make([]crypto.Digest, len(pks))
+	for i, pk := range pks {
+		addrs[i] = publicKeyToAddress(pk)
+	}
+	return addrs, nil
+}
+
+// ImportKey implements the Wallet interface.
+func (rw *RemoteWallet) ImportKey(sk crypto.PrivateKey) (crypto.Digest, error) {
+	return crypto.Digest{}, errNotSupported
+}
+
+// ExportKey implements the Wallet interface.
+func (rw *RemoteWallet) ExportKey(pk crypto.Digest, pw []byte) (crypto.PrivateKey, error) {
+	return crypto.PrivateKey{}, errNotSupported
+}
+
+// GenerateKey implements the Wallet interface.
+func (rw *RemoteWallet) GenerateKey(displayMnemonic bool) (crypto.Digest, error) {
+	return crypto.Digest{}, errNotSupported
+}
+
+// DeleteKey implements the Wallet interface.
+func (rw *RemoteWallet) DeleteKey(pk crypto.Digest, pw []byte) error {
+	return errNotSupported
+}
+
+// ImportMultisigAddr implements the Wallet interface.
+func (rw *RemoteWallet) ImportMultisigAddr(version, threshold uint8, pks []crypto.PublicKey) (crypto.Digest, error) {
+	return crypto.Digest{}, errNotSupported
+}
+
+// LookupMultisigPreimage implements the Wallet interface.
+func (rw *RemoteWallet) LookupMultisigPreimage(crypto.Digest) (version, threshold uint8, pks []crypto.PublicKey, err error) {
+	return 0, 0, nil, errMsigDataNotFound
+}
+
+// ListMultisigAddrs implements the Wallet interface.
+func (rw *RemoteWallet) ListMultisigAddrs() (addrs []crypto.Digest, err error) {
+	return nil, nil
+}
+
+// DeleteMultisigAddr implements the Wallet interface.
+func (rw *RemoteWallet) DeleteMultisigAddr(addr crypto.Digest, pw []byte) error {
+	return errNotSupported
+}
+
+// SignTransaction implements the Wallet interface. If pk is empty, the
+// transaction is signed with the key of its sender.
+func (rw *RemoteWallet) SignTransaction(tx transactions.Transaction, pk crypto.PublicKey, pw []byte) ([]byte, error) {
+	err := rw.CheckPassword(pw)
+	if err != nil {
+		return nil, err
+	}
+
+	if (pk == crypto.PublicKey{}) {
+		pk = crypto.PublicKey(tx.Src())
+	}
+
+	sig, err := rw.client.SignTransaction(tx, pk)
+	if err != nil {
+		return nil, err
+	}
+
+	stxn := transactions.SignedTxn{
+		Txn: tx,
+		Sig: sig,
+	}
+
+	// Set the AuthAddr if the key we signed with doesn't match the txn sender
+	if basics.Address(pk) != tx.Sender {
+		stxn.AuthAddr = basics.Address(pk)
+	}
+
+	return protocol.Encode(&stxn), nil
+}
+
+// SignProgram implements the Wallet interface.
+func (rw *RemoteWallet) SignProgram(data []byte, src crypto.Digest, pw []byte) ([]byte, error) {
+	err := rw.CheckPassword(pw)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := rw.client.SignProgram(data, crypto.PublicKey(src))
+	if err != nil {
+		return nil, err
+	}
+
+	return sig[:], nil
+}
+
+// SignTransactionGroup implements the Wallet interface.
+func (rw *RemoteWallet) SignTransactionGroup(stxns []transactions.SignedTxn, pw []byte) ([]transactions.SignedTxn, error) {
+	return signTransactionGroup(rw, stxns, pw)
+}
+
+// MultisigSignTransaction implements the Wallet interface. Remote wallets do
+// not store multisig preimages, so a partial multisig must always be given.
+func (rw *RemoteWallet) MultisigSignTransaction(tx transactions.Transaction, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte, signer crypto.Digest) (crypto.MultisigSig, error) {
+	err := rw.CheckPassword(pw)
+	if err != nil {
+		return partial, err
+	}
+
+	err = checkMultisigPartial(partial, pk, crypto.Digest(tx.Src()), signer)
+	if err != nil {
+		return partial, err
+	}
+
+	sig, err := rw.client.SignTransaction(tx, pk)
+	if err != nil {
+		return partial, err
+	}
+
+	return addMultisigSubsig(partial, pk, sig), nil
+}
+
+// MultisigSignProgram implements the Wallet interface.
+func (rw *RemoteWallet) MultisigSignProgram(data []byte, src crypto.Digest, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte) (crypto.MultisigSig, error) {
+	err := rw.CheckPassword(pw)
+	if err != nil {
+		return partial, err
+	}
+
+	err = checkMultisigPartial(partial, pk, src, src)
+	if err != nil {
+		return partial, err
+	}
+
+	sig, err := rw.client.SignProgram(data, pk)
+	if err != nil {
+		return partial, err
+	}
+
+	return addMultisigSubsig(partial, pk, sig), nil
+}
+
+// checkMultisigPartial checks that partial is the preimage of either src or
+// signer, and that pk is one of its keys
+func checkMultisigPartial(partial crypto.MultisigSig, pk crypto.PublicKey, src crypto.Digest, signer crypto.Digest) error {
+	if partial.Version == 0 && partial.Threshold == 0 && len(partial.Subsigs) == 0 {
+		return errMsigDataNotFound
+	}
+
+	addr, err := crypto.MultisigAddrGenWithSubsigs(partial.Version, partial.Threshold, partial.Subsigs)
+	if err != nil {
+		return err
+	}
+	if addr != src && addr != signer {
+		return errMsigWrongAddr
+	}
+
+	for _, subsig := range partial.Subsigs {
+		if subsig.Key == pk {
+			return nil
+		}
+	}
+	return errMsigWrongKey
+}
+
+// addMultisigSubsig sets the signature of pk in a copy of partial
+func addMultisigSubsig(partial crypto.MultisigSig, pk crypto.PublicKey, sig crypto.Signature) crypto.MultisigSig {
+	res := partial
+	res.Subsigs = make([]crypto.MultisigSubsig, len(partial.Subsigs))
+	copy(res.Subsigs, partial.Subsigs)
+	for i := range res.Subsigs {
+		if res.Subsigs[i].Key == pk {
+			res.Subsigs[i].Sig = sig
+		}
+	}
+	return res
+}
@@ -0,0 +1,129 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/remotesigner"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/txntest"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestRemoteWallet(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	keys := make([]*crypto.SignatureSecrets, 2)
+	pks := make([]crypto.PublicKey, 2)
+	for i := range keys {
+		var seed crypto.Seed
+		crypto.RandBytes(seed[:])
+		keys[i] = crypto.GenerateSignatureSecrets(seed)
+		pks[i] = crypto.PublicKey(keys[i].SignatureVerifier)
+	}
+
+	socket := filepath.Join(t.TempDir(), "signer.sock")
+	listener, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	defer listener.Close()
+	go remotesigner.MakeServer(keys, logging.TestingLog(t)).Serve(listener)
+
+	cfg := config.DefaultConfig(t.TempDir())
+	cfg.DriverConfig.RemoteWalletDriverConfig.Signers = []config.RemoteSignerConfig{{Name: "hsm", Socket: socket}}
+	require.ErrorIs(t, cfg.Validate(), config.ErrRemoteSignerPassword)
+
+	pw := []byte("hunter2")
+	verifier, err := MakeRemotePasswordVerifier(pw, config.ScryptParams{ScryptN: 2, ScryptR: 1, ScryptP: 1})
+	require.NoError(t, err)
+	cfg.DriverConfig.RemoteWalletDriverConfig.Signers[0].PasswordVerifier = verifier
+	require.NoError(t, cfg.Validate())
+
+	var rwd RemoteWalletDriver
+	require.NoError(t, rwd.InitWithConfig(cfg, logging.TestingLog(t)))
+	mds, err := rwd.ListWalletMetadatas()
+	require.NoError(t, err)
+	require.Len(t, mds, 1)
+	require.Equal(t, "hsm", string(mds[0].Name))
+	require.Equal(t, remoteWalletDriverName, mds[0].DriverName)
+
+	w, err := rwd.FetchWallet(mds[0].ID)
+	require.NoError(t, err)
+	require.ErrorIs(t, w.Init([]byte("wrong")), errDecrypt)
+	require.ErrorIs(t, w.CheckPassword([]byte("wrong")), errDecrypt)
+	require.NoError(t, w.CheckPassword(pw))
+	require.NoError(t, w.Init(pw))
+	require.ErrorIs(t, w.CheckPassword([]byte("wrong")), errDecrypt)
+	require.NoError(t, w.CheckPassword(pw))
+	addrs, err := w.ListKeys()
+	require.NoError(t, err)
+	require.ElementsMatch(t, []crypto.Digest{crypto.Digest(pks[0]), crypto.Digest(pks[1])}, addrs)
+
+	_, err = w.GenerateKey(false)
+	require.ErrorIs(t, err, errNotSupported)
+
+	// Signing for the sender, and for a rekeyed sender
+	tx := txntest.Txn{Type: protocol.PaymentTx, Sender: basics.Address(pks[0]), Amount: 5}.Txn()
+	var stxn transactions.SignedTxn
+	_, err = w.SignTransaction(tx, crypto.PublicKey{}, []byte("wrong"))
+	require.ErrorIs(t, err, errDecrypt)
+	enc, err := w.SignTransaction(tx, crypto.PublicKey{}, pw)
+	require.NoError(t, err)
+	require.NoError(t, protocol.Decode(enc, &stxn))
+	require.True(t, keys[0].Verify(tx, stxn.Sig))
+	require.True(t, stxn.AuthAddr.IsZero())
+
+	enc, err = w.SignTransaction(tx, pks[1], pw)
+	require.NoError(t, err)
+	require.NoError(t, protocol.Decode(enc, &stxn))
+	require.True(t, keys[1].Verify(tx, stxn.Sig))
+	require.Equal(t, basics.Address(pks[1]), stxn.AuthAddr)
+
+	// Multisig requires a partial signature naming the key
+	msig := crypto.MultisigSig{Version: 1, Threshold: 2, Subsigs: []crypto.MultisigSubsig{{Key: pks[0]}, {Key: pks[1]}}}
+	maddr, err := crypto.MultisigAddrGenWithSubsigs(msig.Version, msig.Threshold, msig.Subsigs)
+	require.NoError(t, err)
+	tx.Sender = basics.Address(maddr)
+
+	_, err = w.MultisigSignTransaction(tx, pks[0], crypto.MultisigSig{}, pw, crypto.Digest{})
+	require.ErrorIs(t, err, errMsigDataNotFound)
+	var stranger crypto.PublicKey
+	crypto.RandBytes(stranger[:])
+	_, err = w.MultisigSignTransaction(tx, stranger, msig, pw, crypto.Digest{})
+	require.ErrorIs(t, err, errMsigWrongKey)
+
+	for _, pk := range pks {
+		msig, err = w.MultisigSignTransaction(tx, pk, msig, pw, crypto.Digest{})
+		require.NoError(t, err)
+	}
+	err = crypto.MultisigVerify(tx, maddr, msig)
+	require.NoError(t, err)
+
+	sig, err := w.SignProgram([]byte{0x06, 0x81, 0x01}, crypto.Digest(pks[0]), pw)
+	require.NoError(t, err)
+	require.Len(t, sig, len(crypto.Signature{}))
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/daemon/kmd/config"
 	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/logging"
@@ -78,6 +79,15 @@ CREATE TABLE IF NOT EXISTS msig_addrs (
 	threshold INT NOT NULL,
 	pks BLOB NOT NULL
 );
+
+CREATE TABLE IF NOT EXISTS hd_metadata (
+	hd_params_encrypted BLOB NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS key_paths (
+	address BLOB PRIMARY KEY,
+	path TEXT NOT NULL
+);
 `
 
 // SQLiteWalletDriver is the default wallet driver used by kmd. Keys are stored
@@ -100,6 +110,10 @@ type SQLiteWallet struct {
 	walletPasswordHashed bool
 	dbPath               string
 	cfg                  config.SQLiteWalletDriverConfig
+
+	// hdAccountKey is the key of the account from which an HD wallet
+	// derives its keys, or nil if this is not an HD wallet
+	hdAccountKey *crypto.BIP32Ed25519Key
 }
 
 // The following msgpack codec interface was lifted from algod's network
@@ -197,6 +211,11 @@ func walletMetadataFromDB(db *sqlx.DB) (metadata wallet.Metadata, err error) {
 		return
 	}
 
+	hd, err := isHDWallet(db)
+	if err != nil {
+		return
+	}
+
 	// Build the Metadata
 	metadata = wallet.Metadata{
 		ID:                    walletID,
@@ -206,6 +225,7 @@ func walletMetadataFromDB(db *sqlx.DB) (metadata wallet.Metadata, err error) {
 		SupportsMnemonicUX:    sqliteWalletHasMnemonicUX,
 		SupportsMasterKey:     sqliteWalletHasMasterKey,
 		SupportedTransactions: sqliteWalletSupportedTxs,
+		HD:                    hd,
 	}
 
 	return
@@ -412,6 +432,11 @@ func (swd *SQLiteWalletDriver) claimWalletNameID(name []byte, id []byte) (dbPath
 // CreateWallet ensures that a wallet of the given name/id combo doesn't exist,
 // and initializes a database with the appropriate name.
 func (swd *SQLiteWalletDriver) CreateWallet(name []byte, id []byte, pw []byte, mdk crypto.MasterDerivationKey) error {
+	return swd.createWallet(name, id, pw, mdk, nil)
+}
+
+// createWallet creates a wallet, which is an HD wallet if hd is not nil
+func (swd *SQLiteWalletDriver) createWallet(name []byte, id []byte, pw []byte, mdk crypto.MasterDerivationKey, hd *hdParams) error {
 	if len(name) > sqliteMaxWalletNameLen {
 		return errNameTooLong
 	}
@@ -433,10 +458,17 @@ func (swd *SQLiteWalletDriver) CreateWallet(name []byte, id []byte, pw []byte, m
 	}
 	defer db.Close()
 
+	_, err = swd.initWalletDB(db, name, id, pw, mdk, hd)
+	return err
+}
+
+// initWalletDB runs the schema in the database of a new wallet and stores its
+// metadata, returning its master encryption key
+func (swd *SQLiteWalletDriver) initWalletDB(db sqlx.Execer, name []byte, id []byte, pw []byte, mdk crypto.MasterDerivationKey, hd *hdParams) ([]byte, error) {
 	// Run the schema
-	_, err = db.Exec(walletSchema)
+	_, err := db.Exec(walletSchema)
 	if err != nil {
-		return errDatabase
+		return nil, errDatabase
 	}
 
 	// Generate the master encryption password, used to encrypt the master
@@ -444,15 +476,17 @@ func (swd *SQLiteWalletDriver) CreateWallet(name []byte, id []byte, pw []byte, m
 	var masterKey [masterKeyLen]byte
 	err = fillRandomBytes(masterKey[:])
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// If we were passed a blank master derivation key, generate one here
+	// If we were passed a blank master derivation key, generate one here. HD
+	// wallets generate their mnemonics themselves, since blank entropy is a
+	// valid mnemonic.
 	masterDerivationKey := mdk
-	if masterDerivationKey == (crypto.MasterDerivationKey{}) {
+	if hd == nil && masterDerivationKey == (crypto.MasterDerivationKey{}) {
 		err = fillRandomBytes(masterDerivationKey[:])
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
@@ -460,14 +494,14 @@ func (swd *SQLiteWalletDriver) CreateWallet(name []byte, id []byte, pw []byte, m
 	// may be blank)
 	encryptedMEPBlob, err := encryptBlobWithPasswordBlankOK(masterKey[:], PTMasterKey, pw, &swd.sqliteCfg.ScryptParams)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Encrypt the master derivation key using the master encryption password
 	// (which may not be blank)
 	encryptedMDKBlob, err := encryptBlobWithKey(masterDerivationKey[:], PTMasterDerivationKey, masterKey[:])
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Encrypt the max key index using the master encryption password. We encrypt
@@ -476,16 +510,29 @@ func (swd *SQLiteWalletDriver) CreateWallet(name []byte, id []byte, pw []byte, m
 	maxKeyIdx := 0
 	encryptedIdxBlob, err := encryptBlobWithKey(msgpackEncode(maxKeyIdx), PTMaxKeyIdx, masterKey[:])
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Store the metadata row in the database
 	_, err = db.Exec("INSERT INTO metadata (driver_name, driver_version, wallet_id, wallet_name, mep_encrypted, mdk_encrypted, max_key_idx_encrypted) VALUES(?, ?, ?, ?, ?, ?, ?)", sqliteWalletDriverName, sqliteWalletDriverVersion, id, name, encryptedMEPBlob, encryptedMDKBlob, encryptedIdxBlob)
 	if err != nil {
-		return errDatabase
+		return nil, errDatabase
 	}
 
-	return nil
+	// Store the derivation parameters of an HD wallet, encrypted for the
+	// same reason as the max key index
+	if hd != nil {
+		encryptedHDBlob, err := encryptBlobWithKey(msgpackEncode(hd), PTHDParams, masterKey[:])
+		if err != nil {
+			return nil, err
+		}
+		_, err = db.Exec("INSERT INTO hd_metadata (hd_params_encrypted) VALUES(?)", encryptedHDBlob)
+		if err != nil {
+			return nil, errDatabase
+		}
+	}
+
+	return masterKey[:], nil
 }
 
 // FetchWallet looks up a wallet by ID and returns it, failing if there's more
@@ -662,9 +709,16 @@ func (sw *SQLiteWallet) Init(pw []byte) error {
 		return err
 	}
 
+	// Derive the account key of an HD wallet
+	hdAccountKey, err := sw.deriveHDAccountKey(masterEncryptionKey, masterDerivationKey)
+	if err != nil {
+		return err
+	}
+
 	// Initialize wallet
 	sw.masterEncryptionKey = masterEncryptionKey
 	sw.masterDerivationKey = masterDerivationKey
+	sw.hdAccountKey = hdAccountKey
 	err = fillRandomBytes(sw.walletPasswordSalt[:])
 	if err != nil {
 		return err
@@ -802,14 +856,23 @@ func (sw *SQLiteWallet) fetchSecretKey(addr crypto.Digest) (sk crypto.PrivateKey
 		return
 	}
 
-	// Decrypt the secret key
-	skEncoded, err := decryptBlobWithPassword(blob, PTSecretKey, sw.masterEncryptionKey)
+	// Decrypt the secret key. Keys derived by path have no ed25519 seed, so
+	// they cannot be exported as a PrivateKey
+	typedSK, err := decryptTypedBlobWithPassword(blob, sw.masterEncryptionKey)
 	if err != nil {
 		return
 	}
+	if typedSK.Type == PTExtendedSecretKey {
+		err = errExportHDKey
+		return
+	}
+	if typedSK.Type != PTSecretKey {
+		err = errTypeMismatch
+		return
+	}
 
 	// Decode the secret key candidate
-	err = msgpackDecode(skEncoded, &skCandidate)
+	err = msgpackDecode(typedSK.Plaintext, &skCandidate)
 	if err != nil {
 		return
 	}
@@ -882,6 +945,11 @@ func (sw *SQLiteWallet) GenerateKey(displayMnemonic bool) (addr crypto.Digest, e
 // computes the next key that should be generated, inserts it, and returns
 // its address
 func (sw *SQLiteWallet) generateKeyTxLocked(tx *sqlx.Tx) (addr crypto.Digest, err error) {
+	// HD wallets derive the key at the next index of their account instead
+	if sw.hdAccountKey != nil {
+		return sw.generateHDKeyTxLocked(tx)
+	}
+
 	// Fetch the encrypted highest index
 	var encryptedHighestIndexBlob []byte
 	err = tx.Get(&encryptedHighestIndexBlob, "SELECT max_key_idx_encrypted FROM metadata LIMIT 1")
@@ -991,6 +1059,17 @@ func (sw *SQLiteWallet) DeleteKey(addr crypto.Digest, pw []byte) (err error) {
 
 	// Delete the key
 	_, err = db.Exec("DELETE FROM keys WHERE address=?", addr[:])
+	if err != nil {
+		err = errDatabase
+		return
+	}
+
+	// Delete its derivation path, if it has one
+	hd, err := isHDWallet(db)
+	if err != nil || !hd {
+		return
+	}
+	_, err = db.Exec("DELETE FROM key_paths WHERE address=?", addr[:])
 	if err != nil {
 		err = errDatabase
 	}
@@ -1118,25 +1197,25 @@ func (sw *SQLiteWallet) SignTransaction(tx transactions.Transaction, pk crypto.P
 	}
 
 	// Fetch the required key
-	var sk crypto.PrivateKey
 	if (pk == crypto.PublicKey{}) {
-		sk, err = sw.fetchSecretKey(crypto.Digest(tx.Src()))
-	} else {
-		sk, err = sw.fetchSecretKey(crypto.Digest(pk))
+		pk = crypto.PublicKey(tx.Src())
 	}
+	signer, err := sw.fetchSigner(crypto.Digest(pk))
 	if err != nil {
 		return
 	}
 
-	// Generate the signature secrets
-	secrets, err := crypto.SecretKeyToSignatureSecrets(sk)
-	if err != nil {
-		err = errSKToPK
-		return
+	// Sign the transaction
+	stxn := transactions.SignedTxn{
+		Txn: tx,
+		Sig: signer.Sign(tx),
+	}
+
+	// Set the AuthAddr if the key we signed with doesn't match the txn sender
+	if basics.Address(pk) != tx.Sender {
+		stxn.AuthAddr = basics.Address(pk)
 	}
 
-	// Sign the transaction
-	stxn := tx.Sign(secrets)
 	stx = protocol.Encode(&stxn)
 	return
 }
@@ -1150,25 +1229,23 @@ func (sw *SQLiteWallet) SignProgram(data []byte, src crypto.Digest, pw []byte) (
 	}
 
 	// Fetch the required key
-	sk, err := sw.fetchSecretKey(crypto.Digest(src))
+	signer, err := sw.fetchSigner(src)
 	if err != nil {
 		return
 	}
 
-	// Generate the signature secrets
-	secrets, err := crypto.SecretKeyToSignatureSecrets(sk)
-	if err != nil {
-		err = errSKToPK
-		return
-	}
-
 	progb := logic.Program(data)
 	// Sign the transaction
-	sig := secrets.Sign(&progb)
+	sig := signer.Sign(&progb)
 	stx = sig[:]
 	return
 }
 
+// SignTransactionGroup implements the Wallet interface.
+func (sw *SQLiteWallet) SignTransactionGroup(stxns []transactions.SignedTxn, pw []byte) ([]transactions.SignedTxn, error) {
+	return signTransactionGroup(sw, stxns, pw)
+}
+
 // MultisigSignTransaction starts a multisig signature or adds a signature to a
 // partially signed multisig transaction signature of the passed transaction
 // using the key
@@ -1191,23 +1268,14 @@ func (sw *SQLiteWallet) MultisigSignTransaction(tx transactions.Transaction, pk
 		}
 
 		// Fetch the required secret key
-		var sk crypto.PrivateKey
-		sk, err = sw.fetchSecretKey(publicKeyToAddress(pk))
-		if err != nil {
-			return
-		}
-
-		// Convert the secret key to crypto.SignatureSecrets
-		var secrets *crypto.SignatureSecrets
-		secrets, err = crypto.SecretKeyToSignatureSecrets(sk)
+		var secrets keySigner
+		secrets, err = sw.fetchSigner(publicKeyToAddress(pk))
 		if err != nil {
-			err = errSKToPK
 			return
 		}
 
 		// Sign the transaction
-		from := crypto.Digest(tx.Src())
-		sig, err = crypto.MultisigSign(tx, from, version, threshold, pks, *secrets)
+		sig, err = multisigSign(tx, version, threshold, pks, pk, secrets)
 		return
 	}
 
@@ -1239,20 +1307,14 @@ func (sw *SQLiteWallet) MultisigSignTransaction(tx transactions.Transaction, pk
 	}
 
 	// Fetch the required secret key
-	sk, err := sw.fetchSecretKey(publicKeyToAddress(pk))
-	if err != nil {
-		return
-	}
-
-	// Convert the secret key to crypto.SignatureSecrets
-	secrets, err := crypto.SecretKeyToSignatureSecrets(sk)
+	secrets, err := sw.fetchSigner(publicKeyToAddress(pk))
 	if err != nil {
 		return
 	}
 
 	// Sign the transaction, and merge the multisig into the partial
 	version, threshold, pks := partial.Preimage()
-	msig2, err := crypto.MultisigSign(tx, addr, version, threshold, pks, *secrets)
+	msig2, err := multisigSign(tx, version, threshold, pks, pk, secrets)
 	if err != nil {
 		return
 	}
@@ -1282,24 +1344,15 @@ func (sw *SQLiteWallet) MultisigSignProgram(data []byte, src crypto.Digest, pk c
 		}
 
 		// Fetch the required secret key
-		var sk crypto.PrivateKey
-		sk, err = sw.fetchSecretKey(publicKeyToAddress(pk))
-		if err != nil {
-			return
-		}
-
-		// Convert the secret key to crypto.SignatureSecrets
-		var secrets *crypto.SignatureSecrets
-		secrets, err = crypto.SecretKeyToSignatureSecrets(sk)
+		var secrets keySigner
+		secrets, err = sw.fetchSigner(publicKeyToAddress(pk))
 		if err != nil {
-			err = errSKToPK
 			return
 		}
 
 		// Sign the transaction
-		from := src
 		progb := logic.Program(data)
-		sig, err = crypto.MultisigSign(&progb, from, version, threshold, pks, *secrets)
+		sig, err = multisigSign(&progb, version, threshold, pks, pk, secrets)
 		return
 	}
 
@@ -1329,13 +1382,7 @@ func (sw *SQLiteWallet) MultisigSignProgram(data []byte, src crypto.Digest, pk c
 	}
 
 	// Fetch the required secret key
-	sk, err := sw.fetchSecretKey(publicKeyToAddress(pk))
-	if err != nil {
-		return
-	}
-
-	// Convert the secret key to crypto.SignatureSecrets
-	secrets, err := crypto.SecretKeyToSignatureSecrets(sk)
+	secrets, err := sw.fetchSigner(publicKeyToAddress(pk))
 	if err != nil {
 		return
 	}
@@ -1343,7 +1390,7 @@ func (sw *SQLiteWallet) MultisigSignProgram(data []byte, src crypto.Digest, pk c
 	// Sign the transaction, and merge the multisig into the partial
 	version, threshold, pks := partial.Preimage()
 	progb := logic.Program(data)
-	msig2, err := crypto.MultisigSign(&progb, addr, version, threshold, pks, *secrets)
+	msig2, err := multisigSign(&progb, version, threshold, pks, pk, secrets)
 	if err != nil {
 		return
 	}
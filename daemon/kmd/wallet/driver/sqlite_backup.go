@@ -0,0 +1,365 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"database/sql"
+	"os"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/algorand/go-algorand/crypto"
+)
+
+// A backup bundle holds everything needed to restore a wallet: its master
+// derivation key, from which generated keys are derived again, the keys
+// which were imported into it, and the preimages of its multisig accounts.
+// It is encrypted with a password of its own, like the master encryption key
+// of a wallet is.
+
+const walletBackupVersion = 1
+
+// restoreSuffix is appended to the path of a wallet database while it is
+// being restored, so that it is not listed until it is complete
+const restoreSuffix = ".restore"
+
+// walletBackup is the plaintext of a backup bundle
+type walletBackup struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Version             uint32                     `codec:"version"`
+	ID                  []byte                     `codec:"id"`
+	Name                []byte                     `codec:"name"`
+	MasterDerivationKey crypto.MasterDerivationKey `codec:"mdk"`
+	MaxKeyIdx           uint64                     `codec:"max_key_idx"`
+	HD                  *hdParams                  `codec:"hd"`
+
+	// GeneratedKeys are the indexes of the generated keys of the wallet
+	GeneratedKeys []uint64            `codec:"generated"`
+	ImportedKeys  []crypto.PrivateKey `codec:"imported"`
+	Multisigs     []backupMultisig    `codec:"msig"`
+}
+
+// backupMultisig is the preimage of a multisig account
+type backupMultisig struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Version   uint8              `codec:"version"`
+	Threshold uint8              `codec:"threshold"`
+	PKs       []crypto.PublicKey `codec:"pks"`
+}
+
+// Backup returns a bundle from which RestoreWallet recreates the wallet,
+// encrypted with backupPw
+func (sw *SQLiteWallet) Backup(pw []byte, backupPw []byte) (bundle []byte, err error) {
+	// Check the password
+	err = sw.CheckPassword(pw)
+	if err != nil {
+		return
+	}
+	if len(backupPw) == 0 {
+		err = errBlankBackupPassword
+		return
+	}
+
+	// Connect to the database
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sw.dbPath))
+	if err != nil {
+		err = errDatabaseConnect
+		return
+	}
+	defer db.Close()
+
+	// Read everything in one transaction, so that the bundle is consistent
+	tx, err := db.Beginx()
+	if err != nil {
+		err = errDatabase
+		return
+	}
+	defer tx.Rollback()
+
+	b := walletBackup{Version: walletBackupVersion}
+	copy(b.MasterDerivationKey[:], sw.masterDerivationKey)
+
+	var encryptedIdxBlob []byte
+	row := tx.QueryRow("SELECT wallet_id, wallet_name, max_key_idx_encrypted FROM metadata LIMIT 1")
+	err = row.Scan(&b.ID, &b.Name, &encryptedIdxBlob)
+	if err != nil {
+		err = errDatabase
+		return
+	}
+	idxBlob, err := decryptBlobWithPassword(encryptedIdxBlob, PTMaxKeyIdx, sw.masterEncryptionKey)
+	if err != nil {
+		return
+	}
+	err = msgpackDecode(idxBlob, &b.MaxKeyIdx)
+	if err != nil {
+		return
+	}
+
+	hd, err := isHDWallet(tx)
+	if err != nil {
+		return
+	}
+	if hd {
+		var params hdParams
+		params, err = fetchHDParams(tx, sw.masterEncryptionKey)
+		if err != nil {
+			return
+		}
+		b.HD = &params
+	}
+
+	// Generated keys are derived again from the master derivation key, so
+	// only their indexes are needed
+	rows, err := tx.Query("SELECT address, secret_key_encrypted, key_idx FROM keys")
+	if err != nil {
+		err = errDatabase
+		return
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var addr crypto.Digest
+		var addrBytes, blob []byte
+		var keyIdx sql.NullInt64
+		err = rows.Scan(&addrBytes, &blob, &keyIdx)
+		if err != nil {
+			err = errDatabase
+			return
+		}
+		if keyIdx.Valid {
+			b.GeneratedKeys = append(b.GeneratedKeys, uint64(keyIdx.Int64))
+			continue
+		}
+
+		var skBlob []byte
+		skBlob, err = decryptBlobWithPassword(blob, PTSecretKey, sw.masterEncryptionKey)
+		if err != nil {
+			return
+		}
+		var sk crypto.PrivateKey
+		err = msgpackDecode(skBlob, &sk)
+		if err != nil {
+			return
+		}
+		secrets, err1 := crypto.SecretKeyToSignatureSecrets(sk)
+		if err1 != nil {
+			err = errSKToPK
+			return
+		}
+		copy(addr[:], addrBytes)
+		if publicKeyToAddress(secrets.SignatureVerifier) != addr {
+			err = errTampering
+			return
+		}
+		b.ImportedKeys = append(b.ImportedKeys, sk)
+	}
+	err = rows.Err()
+	if err != nil {
+		err = errDatabase
+		return
+	}
+
+	msigRows, err := tx.Query("SELECT address, version, threshold, pks FROM msig_addrs")
+	if err != nil {
+		err = errDatabase
+		return
+	}
+	defer msigRows.Close()
+	for msigRows.Next() {
+		var addrBytes, pksBlob []byte
+		var version, threshold int
+		err = msigRows.Scan(&addrBytes, &version, &threshold, &pksBlob)
+		if err != nil {
+			err = errDatabase
+			return
+		}
+		msig := backupMultisig{Version: uint8(version), Threshold: uint8(threshold)}
+		err = msgpackDecode(pksBlob, &msig.PKs)
+		if err != nil {
+			return
+		}
+
+		// Sanity check: make sure the preimage is correct
+		var addr, addr2 crypto.Digest
+		copy(addr[:], addrBytes)
+		addr2, err = crypto.MultisigAddrGen(msig.Version, msig.Threshold, msig.PKs)
+		if err != nil || addr2 != addr {
+			err = errTampering
+			return
+		}
+		b.Multisigs = append(b.Multisigs, msig)
+	}
+	err = msigRows.Err()
+	if err != nil {
+		err = errDatabase
+		return
+	}
+
+	return encryptBlobWithPasswordBlankOK(msgpackEncode(b), PTWalletBackup, backupPw, &sw.cfg.ScryptParams)
+}
+
+// RestoreWallet creates the wallet of a bundle made by Backup, encrypted
+// with pw, and returns its ID. The wallet keeps the ID it had, and its name
+// unless name is not blank. It is only added to the wallets once it has
+// been completely restored.
+func (swd *SQLiteWalletDriver) RestoreWallet(name []byte, pw []byte, bundle []byte, backupPw []byte) ([]byte, error) {
+	plaintext, err := decryptBlobWithPassword(bundle, PTWalletBackup, backupPw)
+	if err != nil {
+		return nil, err
+	}
+	var b walletBackup
+	err = msgpackDecode(plaintext, &b)
+	if err != nil {
+		return nil, err
+	}
+	if b.Version != walletBackupVersion {
+		return nil, errBackupVersion
+	}
+
+	if len(name) == 0 {
+		name = b.Name
+	}
+	if len(name) > sqliteMaxWalletNameLen {
+		return nil, errNameTooLong
+	}
+	if len(b.ID) > sqliteMaxWalletIDLen {
+		return nil, errIDTooLong
+	}
+
+	dbPath, err := swd.claimWalletNameID(name, b.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the database next to where it belongs, and move it there once
+	// it is complete
+	restorePath := dbPath + restoreSuffix
+	os.Remove(restorePath)
+	err = swd.writeRestoredWallet(restorePath, name, pw, &b)
+	if err == nil {
+		err = os.Rename(restorePath, dbPath)
+	}
+	if err != nil {
+		os.Remove(restorePath)
+		return nil, err
+	}
+	return b.ID, nil
+}
+
+// writeRestoredWallet creates the database of the wallet of a bundle at
+// dbPath, in one transaction
+func (swd *SQLiteWalletDriver) writeRestoredWallet(dbPath string, name []byte, pw []byte, b *walletBackup) error {
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(dbPath))
+	if err != nil {
+		return errDatabaseConnect
+	}
+	defer db.Close()
+
+	tx, err := db.Beginx()
+	if err != nil {
+		return errDatabase
+	}
+	defer tx.Rollback()
+
+	masterKey, err := swd.initWalletDB(tx, name, b.ID, pw, b.MasterDerivationKey, b.HD)
+	if err != nil {
+		return err
+	}
+
+	// Restore the max key index, so that generated keys are not generated
+	// again
+	encryptedIdxBlob, err := encryptBlobWithKey(msgpackEncode(b.MaxKeyIdx), PTMaxKeyIdx, masterKey)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec("UPDATE metadata SET max_key_idx_encrypted = ?", encryptedIdxBlob)
+	if err != nil {
+		return errDatabase
+	}
+
+	// Derive the generated keys again
+	var accountKey *crypto.BIP32Ed25519Key
+	if b.HD != nil {
+		accountKey, err = hdAccountKey(b.MasterDerivationKey[:], *b.HD)
+		if err != nil {
+			return err
+		}
+	}
+	for _, index := range b.GeneratedKeys {
+		if accountKey != nil {
+			if index >= crypto.BIP32HardenedOffset {
+				return errTooManyKeys
+			}
+			err = insertHDKey(tx, masterKey, b.HD.Account, uint32(index), accountKey.Child(0).Child(uint32(index)))
+			if err != nil {
+				return checkDBError(err)
+			}
+			continue
+		}
+
+		pk, sk, err := extractKeyWithIndex(b.MasterDerivationKey[:], index)
+		if err != nil {
+			return err
+		}
+		err = insertSecretKey(tx, masterKey, publicKeyToAddress(pk), sk, &index)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, rawSK := range b.ImportedKeys {
+		// Don't trust the public part of the secret key, as in ImportKey
+		seed, err := crypto.SecretKeyToSeed(rawSK)
+		if err != nil {
+			return errSKToPK
+		}
+		secrets := crypto.GenerateSignatureSecrets(seed)
+		err = insertSecretKey(tx, masterKey, publicKeyToAddress(secrets.SignatureVerifier), crypto.PrivateKey(secrets.SK), nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, msig := range b.Multisigs {
+		addr, err := crypto.MultisigAddrGen(msig.Version, msig.Threshold, msig.PKs)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec("INSERT INTO msig_addrs (address, version, threshold, pks) VALUES (?, ?, ?, ?)", addr[:], msig.Version, msig.Threshold, msgpackEncode(msig.PKs))
+		if err != nil {
+			return checkDBError(err)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errDatabase
+	}
+	return nil
+}
+
+// insertSecretKey encrypts and inserts an ed25519 key, which was generated at
+// index if index is not nil, or imported
+func insertSecretKey(tx sqlx.Execer, masterEncryptionKey []byte, addr crypto.Digest, sk crypto.PrivateKey, index *uint64) error {
+	skEncrypted, err := encryptBlobWithKey(msgpackEncode(sk), PTSecretKey, masterEncryptionKey)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec("INSERT INTO keys (address, secret_key_encrypted, key_idx) VALUES(?, ?, ?)", addr[:], skEncrypted, index)
+	return checkDBError(err)
+}
@@ -0,0 +1,167 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// testSQLiteDriver returns a sqlite driver with its own wallets directory
+func testSQLiteDriver(t *testing.T) *SQLiteWalletDriver {
+	cfg := config.DefaultConfig(t.TempDir())
+	cfg.DriverConfig.SQLiteWalletDriverConfig.WalletsDir = t.TempDir()
+	cfg.DriverConfig.SQLiteWalletDriverConfig.UnsafeScrypt = true
+	cfg.DriverConfig.SQLiteWalletDriverConfig.ScryptParams = config.ScryptParams{ScryptN: 2, ScryptR: 1, ScryptP: 1}
+
+	var swd SQLiteWalletDriver
+	require.NoError(t, swd.InitWithConfig(cfg, logging.TestingLog(t)))
+	return &swd
+}
+
+// testInitWallet fetches and initializes a wallet
+func testInitWallet(t *testing.T, swd *SQLiteWalletDriver, id []byte, pw []byte) wallet.Wallet {
+	w, err := swd.FetchWallet(id)
+	require.NoError(t, err)
+	require.NoError(t, w.Init(pw))
+	return w
+}
+
+func TestBackupRestoreWallet(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	swd := testSQLiteDriver(t)
+	pw := []byte("pw")
+	backupPw := []byte("backup")
+	require.NoError(t, swd.CreateWallet([]byte("orig"), []byte("orig-id"), pw, crypto.MasterDerivationKey{}))
+	w := testInitWallet(t, swd, []byte("orig-id"), pw)
+
+	gen1, err := w.GenerateKey(false)
+	require.NoError(t, err)
+	gen2, err := w.GenerateKey(false)
+	require.NoError(t, err)
+	require.NoError(t, w.DeleteKey(gen1, pw))
+	var sk crypto.PrivateKey
+	crypto.RandBytes(sk[:])
+	imported, err := w.ImportKey(sk)
+	require.NoError(t, err)
+	var other crypto.PublicKey
+	crypto.RandBytes(other[:])
+	msigAddr, err := w.ImportMultisigAddr(1, 2, []crypto.PublicKey{crypto.PublicKey(gen2), other})
+	require.NoError(t, err)
+
+	_, err = w.(*SQLiteWallet).Backup([]byte("wrong"), backupPw)
+	require.Equal(t, errDecrypt, err)
+	_, err = w.(*SQLiteWallet).Backup(pw, nil)
+	require.Equal(t, errBlankBackupPassword, err)
+	bundle, err := w.(*SQLiteWallet).Backup(pw, backupPw)
+	require.NoError(t, err)
+
+	// The wallet cannot be restored where it already exists, and leaves
+	// nothing behind
+	_, err = swd.RestoreWallet(nil, pw, bundle, backupPw)
+	require.Equal(t, errSameName, err)
+	_, err = swd.RestoreWallet([]byte("copy"), pw, bundle, backupPw)
+	require.Equal(t, errSameID, err)
+	matches, err := filepath.Glob(filepath.Join(swd.walletsDir(), "*"+restoreSuffix))
+	require.NoError(t, err)
+	require.Empty(t, matches)
+
+	swd2 := testSQLiteDriver(t)
+	_, err = swd2.RestoreWallet(nil, pw, bundle, []byte("wrong"))
+	require.Equal(t, errDecrypt, err)
+	newPw := []byte("new pw")
+	id, err := swd2.RestoreWallet(nil, newPw, bundle, backupPw)
+	require.NoError(t, err)
+	require.Equal(t, []byte("orig-id"), id)
+	restored := testInitWallet(t, swd2, id, newPw)
+
+	md, err := restored.Metadata()
+	require.NoError(t, err)
+	require.Equal(t, []byte("orig"), md.Name)
+	keys, err := restored.ListKeys()
+	require.NoError(t, err)
+	require.ElementsMatch(t, []crypto.Digest{gen2, imported}, keys)
+	origSK, err := w.ExportKey(imported, pw)
+	require.NoError(t, err)
+	restoredSK, err := restored.ExportKey(imported, newPw)
+	require.NoError(t, err)
+	require.Equal(t, origSK, restoredSK)
+	msigs, err := restored.ListMultisigAddrs()
+	require.NoError(t, err)
+	require.Equal(t, []crypto.Digest{msigAddr}, msigs)
+
+	// Both wallets generate the same key next
+	next, err := w.GenerateKey(false)
+	require.NoError(t, err)
+	restoredNext, err := restored.GenerateKey(false)
+	require.NoError(t, err)
+	require.Equal(t, next, restoredNext)
+}
+
+func TestBackupRestoreHDWallet(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	swd := testSQLiteDriver(t)
+	pw := []byte("pw")
+	require.NoError(t, swd.CreateHDWallet([]byte("hd"), []byte("hd-id"), pw, crypto.MasterDerivationKey{}, true, 32, "extra", 3))
+	w := testInitWallet(t, swd, []byte("hd-id"), pw)
+	_, err := w.GenerateKey(false)
+	require.NoError(t, err)
+	_, err = w.GenerateKey(false)
+	require.NoError(t, err)
+	bundle, err := w.(*SQLiteWallet).Backup(pw, []byte("backup"))
+	require.NoError(t, err)
+
+	swd2 := testSQLiteDriver(t)
+	id, err := swd2.RestoreWallet([]byte("renamed"), pw, bundle, []byte("backup"))
+	require.NoError(t, err)
+	restored := testInitWallet(t, swd2, id, pw)
+
+	md, err := restored.Metadata()
+	require.NoError(t, err)
+	require.True(t, md.HD)
+	require.Equal(t, []byte("renamed"), md.Name)
+	paths, err := w.(*SQLiteWallet).KeyDerivationPaths()
+	require.NoError(t, err)
+	restoredPaths, err := restored.(*SQLiteWallet).KeyDerivationPaths()
+	require.NoError(t, err)
+	require.Equal(t, paths, restoredPaths)
+	require.Len(t, restoredPaths, 2)
+
+	next, err := w.GenerateKey(false)
+	require.NoError(t, err)
+	restoredNext, err := restored.GenerateKey(false)
+	require.NoError(t, err)
+	require.Equal(t, next, restoredNext)
+
+	// Nothing but the restored wallet is left in the wallets directory
+	entries, err := os.ReadDir(swd2.walletsDir())
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+}
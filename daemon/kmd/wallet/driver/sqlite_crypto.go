@@ -51,6 +51,17 @@ var (
 	PTMasterDerivationKey plaintextType = "master_derivation_key"
 	// PTMaxKeyIdx is the plaintext type for the maximum key index
 	PTMaxKeyIdx plaintextType = "max_key_idx"
+	// PTExtendedSecretKey is the plaintext type for a secret key derived by
+	// path in an HD wallet
+	PTExtendedSecretKey plaintextType = "extended_secret_key"
+	// PTHDParams is the plaintext type for the derivation parameters of an HD
+	// wallet
+	PTHDParams plaintextType = "hd_params"
+	// PTWalletBackup is the plaintext type for a wallet backup bundle
+	PTWalletBackup plaintextType = "wallet_backup"
+	// PTRemotePasswordVerifier is the plaintext type for the password
+	// verifier of a remote wallet
+	PTRemotePasswordVerifier plaintextType = "remote_password_verifier"
 )
 
 // typedPlaintext prevents us from confusing differently typed data encrypted
@@ -184,6 +195,23 @@ func encryptBlobWithPasswordBlankOK(plaintext []byte, ptType plaintextType, pass
 }
 
 func decryptBlobWithPassword(blob []byte, ptType plaintextType, password []byte) (plaintext []byte, err error) {
+	typedPT, err := decryptTypedBlobWithPassword(blob, password)
+	if err != nil {
+		return
+	}
+
+	// Make sure the type is what we expected
+	if typedPT.Type != ptType {
+		err = errTypeMismatch
+		return
+	}
+
+	return typedPT.Plaintext, nil
+}
+
+// decryptTypedBlobWithPassword decrypts a blob whose type the caller does
+// not know in advance
+func decryptTypedBlobWithPassword(blob []byte, password []byte) (typedPT typedPlaintext, err error) {
 	// Decode blob from msgpack
 	var dbblob encryptedDBBlob
 	err = msgpackDecode(blob, &dbblob)
@@ -210,23 +238,13 @@ func decryptBlobWithPassword(blob []byte, ptType plaintextType, password []byte)
 	// Decrypt the ciphertext
 	encodedPT, ok := secretbox.Open(nil, dbblob.Ciphertext, &dbblob.Nonce, key)
 	if !ok {
-		return nil, errDecrypt
+		err = errDecrypt
+		return
 	}
 
 	// Decode the typedPlaintext
-	var typedPT typedPlaintext
 	err = msgpackDecode(encodedPT, &typedPT)
-	if err != nil {
-		return
-	}
-
-	// Make sure the type is what we expected
-	if typedPT.Type != ptType {
-		err = errTypeMismatch
-		return
-	}
-
-	return typedPT.Plaintext, nil
+	return
 }
 
 // extractKeyWithIndex accepts the master derivation key and an index which
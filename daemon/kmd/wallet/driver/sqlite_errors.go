@@ -44,3 +44,9 @@ var errNameTooLong = fmt.Errorf("wallet name too long, must be <= %d bytes", sql
 var errIDTooLong = fmt.Errorf("wallet id too long, must be <= %d bytes", sqliteMaxWalletIDLen)
 var errMsigWrongAddr = fmt.Errorf("given multisig preimage hashes to neither Sender nor AuthAddr")
 var errMsigWrongKey = fmt.Errorf("given key is not a possible signer for this multisig")
+var errExportHDKey = fmt.Errorf("keys derived by path cannot be exported, back up the wallet's mnemonic instead")
+var errHDAccount = fmt.Errorf("HD wallet account must be less than 2^31")
+var errHDEntropyLen = fmt.Errorf("HD wallet mnemonic must have 12, 15, 18, 21 or 24 words, and a new one has 24")
+var errHDGenerateKey = fmt.Errorf("cannot generate a new HD wallet mnemonic when recovering a master derivation key")
+var errBlankBackupPassword = fmt.Errorf("backup password must not be blank")
+var errBackupVersion = fmt.Errorf("unsupported wallet backup version")
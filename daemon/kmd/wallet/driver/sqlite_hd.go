@@ -0,0 +1,353 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"slices"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/passphrase"
+)
+
+// HD wallets derive their keys by BIP32-Ed25519 path (ARC-0052) from a BIP39
+// mnemonic, so that they hold the same accounts as hardware and mobile
+// wallets recovered from that mnemonic. The master derivation key of an HD
+// wallet starts with the entropy of its 12 to 24 word mnemonic, which is
+// combined with an optional BIP39 passphrase, and its keys are at
+// m/44'/283'/account'/0/index for consecutive indexes.
+
+const (
+	hdPurpose  = 44
+	hdCoinType = 283
+)
+
+// hdParams are the derivation parameters of an HD wallet
+type hdParams struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Account   uint32 `codec:"account"`
+	NextIndex uint32 `codec:"next_index"`
+	// EntropyLen is the length of the entropy of the mnemonic, at the start
+	// of the master derivation key
+	EntropyLen uint8 `codec:"entropy_len"`
+	// Passphrase is the BIP39 passphrase of the mnemonic
+	Passphrase string `codec:"passphrase"`
+}
+
+// entropy returns the entropy of the mnemonic of the wallet
+func (p hdParams) entropy(masterDerivationKey []byte) []byte {
+	return masterDerivationKey[:p.EntropyLen]
+}
+
+// hdAccountPath is the derivation path of an account of an HD wallet
+func hdAccountPath(account uint32) []uint32 {
+	return []uint32{
+		hdPurpose + crypto.BIP32HardenedOffset,
+		hdCoinType + crypto.BIP32HardenedOffset,
+		account + crypto.BIP32HardenedOffset,
+	}
+}
+
+// keySigner signs messages with a key of the wallet: an ed25519 key which
+// was imported or generated, or a key derived by path in an HD wallet
+type keySigner interface {
+	Sign(message crypto.Hashable) crypto.Signature
+}
+
+// CreateHDWallet creates a wallet which derives its keys by path from the
+// BIP39 mnemonic whose entropy is the first entropyLen bytes of mdk, and from
+// the BIP39 passphrase bip39Passphrase, in the given account. entropyLen is
+// 16, 20, 24, 28 or 32, for mnemonics of 12 to 24 words. If generate is set,
+// mdk must be blank and a new 24 word mnemonic is generated instead.
+func (swd *SQLiteWalletDriver) CreateHDWallet(name []byte, id []byte, pw []byte, mdk crypto.MasterDerivationKey, generate bool, entropyLen int, bip39Passphrase string, account uint32) error {
+	if account >= crypto.BIP32HardenedOffset {
+		return errHDAccount
+	}
+	if entropyLen < 16 || entropyLen > len(mdk) || entropyLen%4 != 0 {
+		return errHDEntropyLen
+	}
+	if generate {
+		if mdk != (crypto.MasterDerivationKey{}) {
+			return errHDGenerateKey
+		}
+		if entropyLen != len(mdk) {
+			return errHDEntropyLen
+		}
+		err := fillRandomBytes(mdk[:])
+		if err != nil {
+			return err
+		}
+	}
+	for _, b := range mdk[entropyLen:] {
+		if b != 0 {
+			return errHDEntropyLen
+		}
+	}
+	params := hdParams{Account: account, EntropyLen: uint8(entropyLen), Passphrase: bip39Passphrase}
+	return swd.createWallet(name, id, pw, mdk, &params)
+}
+
+// isHDWallet reports whether the wallet derives its keys by path. Wallets
+// created before HD wallets existed have no hd_metadata table.
+func isHDWallet(db sqlx.Queryer) (bool, error) {
+	var cnt int
+	err := sqlx.Get(db, &cnt, "SELECT COUNT(1) FROM sqlite_master WHERE type='table' AND name='hd_metadata'")
+	if err != nil {
+		return false, errDatabase
+	}
+	if cnt == 0 {
+		return false, nil
+	}
+
+	err = sqlx.Get(db, &cnt, "SELECT COUNT(1) FROM hd_metadata")
+	if err != nil {
+		return false, errDatabase
+	}
+	return cnt > 0, nil
+}
+
+// fetchHDParams decrypts the derivation parameters of an HD wallet
+func fetchHDParams(db sqlx.Queryer, masterEncryptionKey []byte) (params hdParams, err error) {
+	var blob []byte
+	err = sqlx.Get(db, &blob, "SELECT hd_params_encrypted FROM hd_metadata LIMIT 1")
+	if err != nil {
+		err = errDatabase
+		return
+	}
+
+	paramsBlob, err := decryptBlobWithPassword(blob, PTHDParams, masterEncryptionKey)
+	if err != nil {
+		return
+	}
+	err = msgpackDecode(paramsBlob, &params)
+	return
+}
+
+// deriveHDAccountKey derives the key of the account of an HD wallet from its
+// mnemonic, or returns nil if the wallet is not an HD wallet
+func (sw *SQLiteWallet) deriveHDAccountKey(masterEncryptionKey []byte, masterDerivationKey []byte) (*crypto.BIP32Ed25519Key, error) {
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sw.dbPath))
+	if err != nil {
+		return nil, errDatabaseConnect
+	}
+	defer db.Close()
+
+	hd, err := isHDWallet(db)
+	if err != nil || !hd {
+		return nil, err
+	}
+	params, err := fetchHDParams(db, masterEncryptionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return hdAccountKey(masterDerivationKey, params)
+}
+
+// hdAccountKey derives the key of the account of an HD wallet from its BIP39
+// mnemonic, whose entropy is at the start of masterDerivationKey
+func hdAccountKey(masterDerivationKey []byte, params hdParams) (*crypto.BIP32Ed25519Key, error) {
+	mnemonic, err := passphrase.EntropyToBIP39Mnemonic(params.entropy(masterDerivationKey))
+	if err != nil {
+		return nil, err
+	}
+	root := crypto.BIP32Ed25519KeyFromSeed(passphrase.BIP39Seed(mnemonic, params.Passphrase))
+	return root.DerivePath(hdAccountPath(params.Account)), nil
+}
+
+// generateHDKeyTxLocked derives the key at the next index of the account of
+// an HD wallet, inserts it with its path, and returns its address
+func (sw *SQLiteWallet) generateHDKeyTxLocked(tx *sqlx.Tx) (addr crypto.Digest, err error) {
+	params, err := fetchHDParams(tx, sw.masterEncryptionKey)
+	if err != nil {
+		return
+	}
+
+	// Skip any key at the next index which was imported manually
+	var key *crypto.BIP32Ed25519Key
+	for {
+		if params.NextIndex >= crypto.BIP32HardenedOffset {
+			err = errTooManyKeys
+			return
+		}
+
+		key = sw.hdAccountKey.Child(0).Child(params.NextIndex)
+		addr = publicKeyToAddress(key.PublicKey())
+
+		var cnt int
+		err = tx.Get(&cnt, "SELECT COUNT(1) FROM keys WHERE address=?", addr[:])
+		if err != nil {
+			err = errDatabase
+			return
+		}
+		if cnt == 0 {
+			break
+		}
+		params.NextIndex++
+	}
+
+	err = insertHDKey(tx, sw.masterEncryptionKey, params.Account, params.NextIndex, key)
+	if err != nil {
+		return
+	}
+
+	// Encrypt and store the new parameters
+	params.NextIndex++
+	encryptedHDBlob, err := encryptBlobWithKey(msgpackEncode(params), PTHDParams, sw.masterEncryptionKey)
+	if err != nil {
+		return
+	}
+	_, err = tx.Exec("UPDATE hd_metadata SET hd_params_encrypted = ?", encryptedHDBlob)
+	if err != nil {
+		return
+	}
+
+	return addr, nil
+}
+
+// insertHDKey encrypts and inserts the key at index of the account of an HD
+// wallet, along with its path
+func insertHDKey(tx sqlx.Execer, masterEncryptionKey []byte, account uint32, index uint32, key *crypto.BIP32Ed25519Key) error {
+	// Encrypt the encoded secret key
+	sk := key.SecretKey()
+	skEncrypted, err := encryptBlobWithKey(msgpackEncode(sk), PTExtendedSecretKey, masterEncryptionKey)
+	if err != nil {
+		return err
+	}
+
+	// Insert the key and its path into the database
+	addr := publicKeyToAddress(key.PublicKey())
+	_, err = tx.Exec("INSERT INTO keys (address, secret_key_encrypted, key_idx) VALUES(?, ?, ?)", addr[:], skEncrypted, index)
+	if err != nil {
+		return err
+	}
+	path := append(hdAccountPath(account), 0, index)
+	_, err = tx.Exec("INSERT INTO key_paths (address, path) VALUES(?, ?)", addr[:], crypto.FormatBIP32Path(path))
+	return err
+}
+
+// KeyDerivationPaths returns the derivation paths of the keys of an HD wallet
+// which were derived by path. Keys which were imported have none.
+func (sw *SQLiteWallet) KeyDerivationPaths() (paths map[crypto.Digest]string, err error) {
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sw.dbPath))
+	if err != nil {
+		return nil, errDatabaseConnect
+	}
+	defer db.Close()
+
+	paths = make(map[crypto.Digest]string)
+	hd, err := isHDWallet(db)
+	if err != nil || !hd {
+		return
+	}
+
+	rows, err := db.Query("SELECT address, path FROM key_paths")
+	if err != nil {
+		return nil, errDatabase
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var addrBytes []byte
+		var path string
+		err = rows.Scan(&addrBytes, &path)
+		if err != nil {
+			return nil, errDatabase
+		}
+		var addr crypto.Digest
+		copy(addr[:], addrBytes)
+		paths[addr] = path
+	}
+	return paths, rows.Err()
+}
+
+// fetchSigner retrieves the secret key for a given address, checking that it
+// is the key of the address
+func (sw *SQLiteWallet) fetchSigner(addr crypto.Digest) (keySigner, error) {
+	// Connect to the database
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sw.dbPath))
+	if err != nil {
+		return nil, errDatabaseConnect
+	}
+	defer db.Close()
+
+	// Fetch the encrypted secret key from the database
+	var blob []byte
+	err = db.Get(&blob, "SELECT secret_key_encrypted FROM keys WHERE address=?", addr[:])
+	if err != nil {
+		return nil, errKeyNotFound
+	}
+
+	typedSK, err := decryptTypedBlobWithPassword(blob, sw.masterEncryptionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var signer keySigner
+	var pk crypto.PublicKey
+	switch typedSK.Type {
+	case PTSecretKey:
+		var sk crypto.PrivateKey
+		err = msgpackDecode(typedSK.Plaintext, &sk)
+		if err != nil {
+			return nil, err
+		}
+		secrets, err := crypto.SecretKeyToSignatureSecrets(sk)
+		if err != nil {
+			return nil, errSKToPK
+		}
+		signer, pk = secrets, secrets.SignatureVerifier
+	case PTExtendedSecretKey:
+		var sk crypto.ExtendedSecretKey
+		err = msgpackDecode(typedSK.Plaintext, &sk)
+		if err != nil {
+			return nil, err
+		}
+		secrets := crypto.ExtendedSecretKeyToSignatureSecrets(sk)
+		signer, pk = secrets, secrets.SignatureVerifier
+	default:
+		return nil, errTypeMismatch
+	}
+
+	// Ensure the derived address matches the one we used to look the key up
+	if publicKeyToAddress(pk) != addr {
+		return nil, errTampering
+	}
+	return signer, nil
+}
+
+// multisigSign is crypto.MultisigSign for any keySigner: it signs msg with pk,
+// one of the keys of the multisig account of version, threshold and pks
+func multisigSign(msg crypto.Hashable, version, threshold uint8, pks []crypto.PublicKey, pk crypto.PublicKey, signer keySigner) (sig crypto.MultisigSig, err error) {
+	if !slices.Contains(pks, pk) {
+		err = errMsigWrongKey
+		return
+	}
+
+	sig.Version = version
+	sig.Threshold = threshold
+	sig.Subsigs = make([]crypto.MultisigSubsig, len(pks))
+	s := signer.Sign(msg)
+	for i := range pks {
+		sig.Subsigs[i].Key = pks[i]
+		if pks[i] == pk {
+			sig.Subsigs[i].Sig = s
+		}
+	}
+	return
+}
@@ -0,0 +1,158 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/passphrase"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/txntest"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestHDWallet(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := config.DefaultConfig(t.TempDir())
+	cfg.DriverConfig.SQLiteWalletDriverConfig.WalletsDir = t.TempDir()
+	cfg.DriverConfig.SQLiteWalletDriverConfig.UnsafeScrypt = true
+	cfg.DriverConfig.SQLiteWalletDriverConfig.ScryptParams = config.ScryptParams{ScryptN: 2, ScryptR: 1, ScryptP: 1}
+
+	var swd SQLiteWalletDriver
+	require.NoError(t, swd.InitWithConfig(cfg, logging.TestingLog(t)))
+
+	// The mnemonic of the test vectors of ARC-0052
+	entropy, err := passphrase.BIP39MnemonicToEntropy("salon zoo engage submit smile frost later decide wing sight chaos renew lizard rely canal coral scene hobby scare step bus leaf tobacco slice")
+	require.NoError(t, err)
+	var mdk crypto.MasterDerivationKey
+	copy(mdk[:], entropy)
+
+	pw := []byte("pw")
+	require.NoError(t, swd.CreateHDWallet([]byte("hd"), []byte("hd-id"), pw, mdk, false, 32, "", 0))
+	require.Equal(t, errHDAccount, swd.CreateHDWallet([]byte("bad"), []byte("bad-id"), pw, mdk, false, 32, "", crypto.BIP32HardenedOffset))
+	require.Equal(t, errHDEntropyLen, swd.CreateHDWallet([]byte("bad"), []byte("bad-id"), pw, mdk, false, 16, "", 0))
+	require.Equal(t, errHDEntropyLen, swd.CreateHDWallet([]byte("bad"), []byte("bad-id"), pw, crypto.MasterDerivationKey{}, true, 16, "", 0))
+	require.Equal(t, errHDGenerateKey, swd.CreateHDWallet([]byte("bad"), []byte("bad-id"), pw, mdk, true, 32, "", 0))
+	w, err := swd.FetchWallet([]byte("hd-id"))
+	require.NoError(t, err)
+	require.NoError(t, w.Init(pw))
+
+	md, err := w.Metadata()
+	require.NoError(t, err)
+	require.True(t, md.HD)
+	exported, err := w.ExportMasterDerivationKey(pw)
+	require.NoError(t, err)
+	require.Equal(t, mdk, exported)
+
+	// Keys are derived at consecutive indexes, skipping imported ones
+	addr0, err := w.GenerateKey(false)
+	require.NoError(t, err)
+	require.Equal(t, "7bda7ac12627b2c259f1df6875d30c10b35f55b33ad2cc8ea2736eaa3ebcfab9", hex.EncodeToString(addr0[:]))
+	var imported crypto.PrivateKey
+	crypto.RandBytes(imported[:])
+	importedAddr, err := w.ImportKey(imported)
+	require.NoError(t, err)
+	addr1, err := w.GenerateKey(false)
+	require.NoError(t, err)
+	require.Equal(t, "5bae8828f111064637ac5061bd63bc4fcfe4a833252305f25eeab9c64ecdf519", hex.EncodeToString(addr1[:]))
+
+	paths, err := w.(*SQLiteWallet).KeyDerivationPaths()
+	require.NoError(t, err)
+	require.Equal(t, map[crypto.Digest]string{addr0: "m/44'/283'/0'/0/0", addr1: "m/44'/283'/0'/0/1"}, paths)
+
+	// Derived keys sign, but cannot be exported
+	_, err = w.ExportKey(addr0, pw)
+	require.Equal(t, errExportHDKey, err)
+	_, err = w.ExportKey(importedAddr, pw)
+	require.NoError(t, err)
+
+	tx := txntest.Txn{Type: protocol.PaymentTx, Sender: basics.Address(addr0), Receiver: basics.Address(addr1), Amount: 1}.Txn()
+	encoded, err := w.SignTransaction(tx, crypto.PublicKey{}, pw)
+	require.NoError(t, err)
+	var stxn transactions.SignedTxn
+	require.NoError(t, protocol.Decode(encoded, &stxn))
+	require.True(t, crypto.SignatureVerifier(addr0).Verify(tx, stxn.Sig))
+	require.True(t, stxn.AuthAddr.IsZero())
+
+	msigAddr, err := w.ImportMultisigAddr(1, 1, []crypto.PublicKey{crypto.PublicKey(addr0), crypto.PublicKey(addr1)})
+	require.NoError(t, err)
+	tx.Sender = basics.Address(msigAddr)
+	msig, err := w.MultisigSignTransaction(tx, crypto.PublicKey(addr1), crypto.MultisigSig{}, pw, crypto.Digest{})
+	require.NoError(t, err)
+	require.NoError(t, crypto.MultisigVerify(tx, msigAddr, msig))
+
+	// Deleting a key deletes its path
+	require.NoError(t, w.DeleteKey(addr0, pw))
+	paths, err = w.(*SQLiteWallet).KeyDerivationPaths()
+	require.NoError(t, err)
+	require.Equal(t, map[crypto.Digest]string{addr1: "m/44'/283'/0'/0/1"}, paths)
+
+	// Other accounts derive other keys
+	require.NoError(t, swd.CreateHDWallet([]byte("hd1"), []byte("hd1-id"), pw, mdk, false, 32, "", 1))
+	w1, err := swd.FetchWallet([]byte("hd1-id"))
+	require.NoError(t, err)
+	require.NoError(t, w1.Init(pw))
+	addr, err := w1.GenerateKey(false)
+	require.NoError(t, err)
+	require.Equal(t, "358d8c4382992849a764438e02b1c45c2ca4e86bbcfe10fd5b963f3610012bc9", hex.EncodeToString(addr[:]))
+}
+
+func TestHDWalletShortMnemonic(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	swd := testSQLiteDriver(t)
+	pw := []byte("pw")
+
+	// 12 and 18 word mnemonics, with a BIP39 passphrase, including one of
+	// blank entropy
+	for _, mnemonic := range []string{
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+		"legal winner thank year wave sausage worth useful legal winner thank yellow",
+		"letter advice cage absurd amount doctor acoustic avoid letter advice cage absurd amount doctor acoustic avoid letter always",
+	} {
+		entropy, err := passphrase.BIP39MnemonicToEntropy(mnemonic)
+		require.NoError(t, err)
+		var mdk crypto.MasterDerivationKey
+		copy(mdk[:], entropy)
+
+		id := []byte(fmt.Sprintf("hd-%d-%x", len(entropy), entropy[0]))
+		require.NoError(t, swd.CreateHDWallet(id, id, pw, mdk, false, len(entropy), "TREZOR", 0))
+		w := testInitWallet(t, swd, id, pw)
+		addr, err := w.GenerateKey(false)
+		require.NoError(t, err)
+
+		root := crypto.BIP32Ed25519KeyFromSeed(passphrase.BIP39Seed(mnemonic, "TREZOR"))
+		key := root.DerivePath(hdAccountPath(0)).Child(0).Child(0)
+		require.Equal(t, publicKeyToAddress(key.PublicKey()), addr)
+
+		exported, err := w.ExportMasterDerivationKey(pw)
+		require.NoError(t, err)
+		require.Equal(t, mdk, exported)
+	}
+}
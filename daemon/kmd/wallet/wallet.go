@@ -55,10 +55,29 @@ type Wallet interface {
 
 	MultisigSignTransaction(tx transactions.Transaction, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte, signer crypto.Digest) (crypto.MultisigSig, error)
 
+	// SignTransactionGroup checks that the group ID of stxns matches them,
+	// and signs each transaction whose authorizer is a key or a multisig
+	// account of the wallet, adding to any partial multisig signature. It
+	// returns the whole group.
+	SignTransactionGroup(stxns []transactions.SignedTxn, pw []byte) ([]transactions.SignedTxn, error)
+
 	SignProgram(program []byte, src crypto.Digest, pw []byte) ([]byte, error)
 	MultisigSignProgram(program []byte, src crypto.Digest, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte) (crypto.MultisigSig, error)
 }
 
+// HDWallet is implemented by wallets which may derive their keys by path.
+// KeyDerivationPaths maps the addresses of those keys to their paths.
+type HDWallet interface {
+	KeyDerivationPaths() (map[crypto.Digest]string, error)
+}
+
+// BackupWallet is implemented by wallets which can be backed up. Backup
+// returns a bundle, encrypted with backupPw, of everything needed to restore
+// the wallet with its driver.
+type BackupWallet interface {
+	Backup(pw []byte, backupPw []byte) ([]byte, error)
+}
+
 // Metadata represents high-level information about a wallet, like its name, id
 // and what operations it supports
 type Metadata struct {
@@ -69,6 +88,9 @@ type Metadata struct {
 	SupportsMnemonicUX    bool
 	SupportsMasterKey     bool
 	SupportedTransactions []protocol.TxType
+	// HD is set for wallets which derive their keys by BIP32-Ed25519 path
+	// from a BIP39 mnemonic
+	HD bool
 }
 
 // GenerateWalletID generates a random hex wallet ID
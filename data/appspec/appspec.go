@@ -0,0 +1,345 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package appspec reads ARC-56 application specifications, and the ARC-32
+// ones which preceded them. A specification describes the programs, state
+// and ABI methods of an application, so that it can be deployed and called
+// with its arguments and results encoded as the structs it names.
+package appspec
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/algorand/avm-abi/abi"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+)
+
+// The AVM types which may be given instead of ABI types for storage and
+// default values
+const (
+	AVMBytes  = "AVMBytes"
+	AVMString = "AVMString"
+	AVMUint64 = "AVMUint64"
+)
+
+// The sources of default argument values
+const (
+	SourceBox     = "box"
+	SourceGlobal  = "global"
+	SourceLocal   = "local"
+	SourceLiteral = "literal"
+	SourceMethod  = "method"
+)
+
+// Spec is an ARC-56 application specification. Only the parts needed to
+// deploy and call an application are kept.
+type Spec struct {
+	Name              string                      `json:"name"`
+	Desc              string                      `json:"desc,omitempty"`
+	Structs           map[string][]StructField    `json:"structs"`
+	Methods           []Method                    `json:"methods"`
+	State             State                       `json:"state"`
+	BareActions       Actions                     `json:"bareActions"`
+	Source            *Programs                   `json:"source,omitempty"`
+	ByteCode          *Programs                   `json:"byteCode,omitempty"`
+	TemplateVariables map[string]TemplateVariable `json:"templateVariables,omitempty"`
+	Networks          map[string]Network          `json:"networks,omitempty"`
+}
+
+// StructField is a field of a named struct. Its type is an ABI type or the
+// name of another struct, unless the field is itself an anonymous struct
+// of Fields.
+type StructField struct {
+	Name   string
+	Type   string
+	Fields []StructField
+}
+
+// UnmarshalJSON reads a field whose type is a string or a list of fields
+func (f *StructField) UnmarshalJSON(data []byte) error {
+	var field struct {
+		Name string          `json:"name"`
+		Type json.RawMessage `json:"type"`
+	}
+	err := json.Unmarshal(data, &field)
+	if err != nil {
+		return err
+	}
+	f.Name = field.Name
+	if strings.HasPrefix(strings.TrimSpace(string(field.Type)), "[") {
+		return json.Unmarshal(field.Type, &f.Fields)
+	}
+	return json.Unmarshal(field.Type, &f.Type)
+}
+
+// Method is an ABI method of the application
+type Method struct {
+	Name     string  `json:"name"`
+	Desc     string  `json:"desc,omitempty"`
+	Args     []Arg   `json:"args"`
+	Returns  Returns `json:"returns"`
+	Actions  Actions `json:"actions"`
+	Readonly bool    `json:"readonly,omitempty"`
+}
+
+// Arg is an argument of a method. Type is always an ABI type, which is the
+// tuple of the fields of Struct if the argument is a struct.
+type Arg struct {
+	Type         string        `json:"type"`
+	Struct       string        `json:"struct,omitempty"`
+	Name         string        `json:"name,omitempty"`
+	Desc         string        `json:"desc,omitempty"`
+	DefaultValue *DefaultValue `json:"defaultValue,omitempty"`
+}
+
+// Returns is the result of a method
+type Returns struct {
+	Type   string `json:"type"`
+	Struct string `json:"struct,omitempty"`
+	Desc   string `json:"desc,omitempty"`
+}
+
+// DefaultValue tells where the value of an argument which was not given is
+// found. Data is the base64 encoding of the value for literals, of the key
+// for state and boxes, and the signature of a readonly method for methods.
+// Type is the type of the value found, when it is not the argument's.
+type DefaultValue struct {
+	Data   string `json:"data"`
+	Type   string `json:"type,omitempty"`
+	Source string `json:"source"`
+}
+
+// Actions are the on-completion actions, named as in ARC-56, with which
+// an application may be created or called
+type Actions struct {
+	Create []string `json:"create"`
+	Call   []string `json:"call"`
+}
+
+// Programs are the approval and clear state programs, as TEAL source or
+// bytecode
+type Programs struct {
+	Approval []byte `json:"approval"`
+	Clear    []byte `json:"clear"`
+}
+
+// TemplateVariable is a TMPL_ variable of the programs, with an optional
+// default value encoded as its type
+type TemplateVariable struct {
+	Type  string `json:"type"`
+	Value []byte `json:"value,omitempty"`
+}
+
+// Network records the application deployed on a network, keyed by the
+// base64 genesis hash of the network
+type Network struct {
+	AppID basics.AppIndex `json:"appID"`
+}
+
+// State describes the schema and the layout of the application's storage
+type State struct {
+	Schema struct {
+		Global Schema `json:"global"`
+		Local  Schema `json:"local"`
+	} `json:"schema"`
+	Keys struct {
+		Global map[string]StorageKey `json:"global"`
+		Local  map[string]StorageKey `json:"local"`
+		Box    map[string]StorageKey `json:"box"`
+	} `json:"keys"`
+	Maps struct {
+		Global map[string]StorageMap `json:"global"`
+		Local  map[string]StorageMap `json:"local"`
+		Box    map[string]StorageMap `json:"box"`
+	} `json:"maps"`
+}
+
+// Schema is the number of values of each type a state may hold
+type Schema struct {
+	Ints  uint64 `json:"ints"`
+	Bytes uint64 `json:"bytes"`
+}
+
+// StateSchema returns s as a basics.StateSchema
+func (s Schema) StateSchema() basics.StateSchema {
+	return basics.StateSchema{NumUint: s.Ints, NumByteSlice: s.Bytes}
+}
+
+// StorageKey is a single value of state or box storage
+type StorageKey struct {
+	KeyType   string `json:"keyType"`
+	ValueType string `json:"valueType"`
+	Key       []byte `json:"key"`
+	Desc      string `json:"desc,omitempty"`
+}
+
+// StorageMap is a collection of state or box values whose keys begin with
+// Prefix
+type StorageMap struct {
+	KeyType   string `json:"keyType"`
+	ValueType string `json:"valueType"`
+	Prefix    []byte `json:"prefix,omitempty"`
+	Desc      string `json:"desc,omitempty"`
+}
+
+// Parse reads an ARC-56 specification, or an ARC-32 one which it converts
+func Parse(data []byte) (*Spec, error) {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return nil, err
+	}
+
+	var spec *Spec
+	if _, ok := fields["contract"]; ok {
+		spec, err = parseARC32(data)
+	} else {
+		spec = &Spec{}
+		err = json.Unmarshal(data, spec)
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = spec.check()
+	if err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// check makes sure that the types the specification names exist
+func (s *Spec) check() error {
+	for name := range s.Structs {
+		_, err := s.abiType(name, 0)
+		if err != nil {
+			return fmt.Errorf("struct %s: %w", name, err)
+		}
+	}
+	for _, m := range s.Methods {
+		if m.Name == "" {
+			return fmt.Errorf("method without a name")
+		}
+		for _, arg := range m.Args {
+			if arg.Struct != "" && s.Structs[arg.Struct] == nil {
+				return fmt.Errorf("method %s: argument %s is unknown struct %s", m.Name, arg.Name, arg.Struct)
+			}
+			if abi.IsTransactionType(arg.Type) || abi.IsReferenceType(arg.Type) {
+				continue
+			}
+			_, err := abi.TypeOf(arg.Type)
+			if err != nil {
+				return fmt.Errorf("method %s: argument %s: %w", m.Name, arg.Name, err)
+			}
+		}
+		if m.Returns.Struct != "" && s.Structs[m.Returns.Struct] == nil {
+			return fmt.Errorf("method %s: returns unknown struct %s", m.Name, m.Returns.Struct)
+		}
+		if m.Returns.Type != "" && m.Returns.Type != abi.VoidReturnType {
+			_, err := abi.TypeOf(m.Returns.Type)
+			if err != nil {
+				return fmt.Errorf("method %s: returns: %w", m.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Signature returns the ARC-4 signature of m
+func (m *Method) Signature() string {
+	types := make([]string, len(m.Args))
+	for i, arg := range m.Args {
+		types[i] = arg.Type
+	}
+	returns := m.Returns.Type
+	if returns == "" {
+		returns = abi.VoidReturnType
+	}
+	return m.Name + "(" + strings.Join(types, ",") + ")" + returns
+}
+
+// Method returns the method with a name or signature
+func (s *Spec) Method(name string) (*Method, error) {
+	var found []*Method
+	for i := range s.Methods {
+		m := &s.Methods[i]
+		if m.Name == name || m.Signature() == name {
+			found = append(found, m)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("%s has no method %s", s.Name, name)
+	case 1:
+		return found[0], nil
+	}
+	var sigs []string
+	for _, m := range found {
+		sigs = append(sigs, m.Signature())
+	}
+	return nil, fmt.Errorf("%s has several methods named %s, give one of %s", s.Name, name, strings.Join(sigs, ", "))
+}
+
+// MethodsFor returns the methods which allow an action, when creating the
+// application or calling it
+func (s *Spec) MethodsFor(create bool, oc transactions.OnCompletion) []*Method {
+	var methods []*Method
+	for i := range s.Methods {
+		if s.Methods[i].Actions.Allows(create, oc) {
+			methods = append(methods, &s.Methods[i])
+		}
+	}
+	return methods
+}
+
+// Allows tells if the actions include oc, when creating the application or
+// calling it
+func (a Actions) Allows(create bool, oc transactions.OnCompletion) bool {
+	actions := a.Call
+	if create {
+		actions = a.Create
+	}
+	return slices.Contains(actions, ActionName(oc))
+}
+
+// ActionName returns the ARC-56 name of an on-completion action
+func ActionName(oc transactions.OnCompletion) string {
+	switch oc {
+	case transactions.NoOpOC:
+		return "NoOp"
+	case transactions.OptInOC:
+		return "OptIn"
+	case transactions.CloseOutOC:
+		return "CloseOut"
+	case transactions.ClearStateOC:
+		return "ClearState"
+	case transactions.UpdateApplicationOC:
+		return "UpdateApplication"
+	case transactions.DeleteApplicationOC:
+		return "DeleteApplication"
+	}
+	return oc.String()
+}
+
+// AppID returns the application recorded for the network with a genesis
+// hash, given in base64, or zero
+func (s *Spec) AppID(genesisHash string) basics.AppIndex {
+	return s.Networks[genesisHash].AppID
+}
@@ -0,0 +1,296 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package appspec
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func b64(s string) string {
+	return base64.StdEncoding.EncodeToString([]byte(s))
+}
+
+var arc56 = `{
+  "name": "Points",
+  "structs": {
+    "Point": [{"name": "x", "type": "uint64"}, {"name": "y", "type": "uint64"}],
+    "Segment": [
+      {"name": "from", "type": "Point"},
+      {"name": "to", "type": "Point"},
+      {"name": "meta", "type": [{"name": "label", "type": "string"}, {"name": "owner", "type": "address"}]}
+    ]
+  },
+  "methods": [
+    {"name": "add", "args": [{"type": "(uint64,uint64)", "struct": "Point", "name": "p"}], "returns": {"type": "void"}, "actions": {"create": ["NoOp"], "call": ["NoOp"]}},
+    {"name": "add", "args": [{"type": "uint64", "name": "x"}, {"type": "uint64", "name": "y"}], "returns": {"type": "void"}, "actions": {"create": [], "call": ["NoOp"]}},
+    {"name": "longest", "args": [], "returns": {"type": "((uint64,uint64),(uint64,uint64),(string,address))", "struct": "Segment"}, "actions": {"create": [], "call": ["NoOp"]}, "readonly": true}
+  ],
+  "state": {"schema": {"global": {"ints": 2, "bytes": 1}, "local": {"ints": 0, "bytes": 0}}, "keys": {"global": {}, "local": {}, "box": {"points": {"keyType": "AVMString", "valueType": "(uint64,uint64)[]", "key": "` + b64("points") + `"}}}, "maps": {"global": {"counts": {"keyType": "AVMString", "valueType": "AVMUint64", "prefix": "` + b64("c") + `"}, "rest": {"keyType": "AVMBytes", "valueType": "AVMBytes"}}, "local": {}, "box": {"owned": {"keyType": "address", "valueType": "Point", "prefix": "` + b64("o") + `"}}}},
+  "bareActions": {"create": [], "call": ["UpdateApplication"]},
+  "source": {"approval": "` + b64("#pragma version 10\nint TMPL_LIMIT\nbyte TMPL_NAME\npop\n") + `", "clear": "` + b64("#pragma version 10\nint 1\n") + `"},
+  "templateVariables": {"LIMIT": {"type": "AVMUint64", "value": "AAAAAAAAAAo="}, "NAME": {"type": "AVMBytes"}},
+  "networks": {"genesis": {"appID": 12}}
+}`
+
+func TestSpec(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	s, err := Parse([]byte(arc56))
+	require.NoError(t, err)
+	require.Equal(t, basics.AppIndex(12), s.AppID("genesis"))
+	require.Zero(t, s.AppID("other"))
+	require.Equal(t, Schema{Ints: 2, Bytes: 1}, s.State.Schema.Global)
+
+	_, err = s.Method("add")
+	require.ErrorContains(t, err, "add((uint64,uint64))void, add(uint64,uint64)void")
+	m, err := s.Method("add((uint64,uint64))void")
+	require.NoError(t, err)
+	require.Equal(t, "Point", m.Args[0].Struct)
+	require.Equal(t, []*Method{m}, s.MethodsFor(true, transactions.NoOpOC))
+	require.Len(t, s.MethodsFor(false, transactions.NoOpOC), 3)
+	require.True(t, s.BareActions.Allows(false, transactions.UpdateApplicationOC))
+	require.False(t, s.BareActions.Allows(true, transactions.UpdateApplicationOC))
+	_, err = s.Method("remove")
+	require.Error(t, err)
+
+	// Structs are objects named by their fields, in order
+	var owner basics.Address
+	owner[0] = 1
+	segment := `{"from":{"x":1,"y":2},"to":{"x":3,"y":4},"meta":{"label":"a","owner":"` + owner.String() + `"}}`
+	encoded, err := s.Encode("Segment", []byte(segment))
+	require.NoError(t, err)
+	decoded, err := s.Decode("Segment", encoded)
+	require.NoError(t, err)
+	require.Equal(t, segment, string(decoded))
+
+	plain, err := s.ToABIJSON("Segment", []byte(segment))
+	require.NoError(t, err)
+	require.JSONEq(t, `[[1,2],[3,4],["a","`+owner.String()+`"]]`, string(plain))
+	same, err := s.ToABIJSON("Point", []byte("[1, 2]"))
+	require.NoError(t, err)
+	require.Equal(t, "[1, 2]", string(same))
+
+	_, err = s.ToABIJSON("Point", []byte(`{"x": 1}`))
+	require.ErrorContains(t, err, "missing field y")
+	_, err = s.ToABIJSON("Point", []byte(`{"x": 1, "y": 2, "z": 3}`))
+	require.ErrorContains(t, err, "unknown field z")
+
+	// Storage and default values may be AVM types
+	count, err := s.Decode(AVMUint64, []byte{0, 0, 0, 0, 0, 0, 1, 0})
+	require.NoError(t, err)
+	require.Equal(t, "256", string(count))
+	arg := Arg{Type: "address"}
+	value, err := s.ArgJSON(arg, AVMBytes, owner[:])
+	require.NoError(t, err)
+	require.Equal(t, `"`+owner.String()+`"`, string(value))
+	value, err = s.ArgJSON(Arg{Type: "string"}, AVMBytes, []byte("hi"))
+	require.NoError(t, err)
+	require.Equal(t, `"hi"`, string(value))
+	value, err = s.ArgJSON(Arg{Type: "(uint64,uint64)", Struct: "Point"}, "", encoded[:16])
+	require.NoError(t, err)
+	require.Equal(t, `{"x":1,"y":2}`, string(value))
+}
+
+func TestDecodeStorage(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	s, err := Parse([]byte(arc56))
+	require.NoError(t, err)
+
+	// Keys win over maps
+	points := append([]byte{0, 1}, make([]byte, 16)...)
+	points[9] = 5
+	v, err := s.DecodeStorage(SourceBox, []byte("points"), points)
+	require.NoError(t, err)
+	require.Equal(t, StorageValue{Name: "points", Value: []byte(`[[5,0]]`)}, *v)
+
+	var owner basics.Address
+	owner[0] = 1
+	v, err = s.DecodeStorage(SourceBox, append([]byte("o"), owner[:]...), points[2:])
+	require.NoError(t, err)
+	require.Equal(t, "owned", v.Name)
+	require.Equal(t, `"`+owner.String()+`"`, string(v.Key))
+	require.Equal(t, `{"x":5,"y":0}`, string(v.Value))
+
+	_, err = s.DecodeStorage(SourceBox, []byte("o1"), points[2:])
+	require.ErrorContains(t, err, "owned key")
+	// Values which are cut short or run on are refused rather than misread
+	_, err = s.DecodeStorage(SourceBox, []byte("points"), points[:10])
+	require.ErrorContains(t, err, "points: 10 bytes are not a (uint64,uint64)[]")
+	_, err = s.DecodeStorage(SourceBox, []byte("points"), points[:3])
+	require.ErrorContains(t, err, "points: 3 bytes are not a (uint64,uint64)[]")
+	_, err = s.DecodeStorage(SourceBox, []byte("points"), append(points, 0))
+	require.ErrorContains(t, err, "points: input byte not fully consumed")
+	v, err = s.DecodeStorage(SourceBox, []byte("unknown"), nil)
+	require.NoError(t, err)
+	require.Nil(t, v)
+	v, err = s.DecodeStorage(SourceLocal, []byte("points"), points)
+	require.NoError(t, err)
+	require.Nil(t, v)
+
+	// The longest prefix wins, and the empty prefix holds everything else
+	v, err = s.DecodeStorage(SourceGlobal, []byte("cats"), []byte{0, 0, 0, 0, 0, 0, 0, 9})
+	require.NoError(t, err)
+	require.Equal(t, StorageValue{Name: "counts", Key: []byte(`"ats"`), Value: []byte("9")}, *v)
+	v, err = s.DecodeStorage(SourceGlobal, []byte("dogs"), []byte{1})
+	require.NoError(t, err)
+	require.Equal(t, StorageValue{Name: "rest", Key: []byte(`"ZG9ncw=="`), Value: []byte(`"AQ=="`)}, *v)
+}
+
+func TestSpecErrors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	for _, tc := range []struct {
+		spec string
+		err  string
+	}{
+		{`{"methods": [{"args": []}]}`, "method without a name"},
+		{`{"methods": [{"name": "f", "args": [{"type": "uint7"}]}]}`, "method f"},
+		{`{"methods": [{"name": "f", "args": [{"type": "uint64", "struct": "P"}]}]}`, "unknown struct P"},
+		{`{"structs": {"P": [{"name": "p", "type": "P"}]}}`, "nested too deeply"},
+		{`{"structs": {"P": [{"name": "p", "type": "Q"}]}}`, "struct P"},
+		{`[]`, "cannot unmarshal"},
+	} {
+		_, err := Parse([]byte(tc.spec))
+		require.ErrorContains(t, err, tc.err, tc.spec)
+	}
+}
+
+func TestSpecCompile(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	s, err := Parse([]byte(arc56))
+	require.NoError(t, err)
+	require.Equal(t, []string{"LIMIT", "NAME"}, s.TemplateNames())
+
+	_, _, err = s.Compile(nil)
+	require.ErrorContains(t, err, "approval program needs values for template variables NAME")
+
+	// The declared type wins over the form of the value
+	name, err := s.TemplateValue("NAME", []byte{0, 0, 0, 0, 0, 0, 0, 5}, true)
+	require.NoError(t, err)
+	require.False(t, name.Int)
+	limit, err := s.TemplateValue("LIMIT", []byte{1, 0}, false)
+	require.NoError(t, err)
+	require.Equal(t, TemplateValue{Int: true, Uint: 256}, limit)
+	_, err = s.TemplateValue("LIMIT", make([]byte, 9), false)
+	require.Error(t, err)
+
+	approval, clear, err := s.Compile(map[string]TemplateValue{"NAME": {Bytes: []byte("x")}})
+	require.NoError(t, err)
+	ops, err := logic.AssembleString("#pragma version 10\nint 10\nbyte 0x78\npop\n")
+	require.NoError(t, err)
+	require.Equal(t, ops.Program, approval)
+	require.NotEmpty(t, clear)
+
+	approval, _, err = s.Compile(map[string]TemplateValue{"NAME": {Bytes: []byte("x")}, "LIMIT": limit})
+	require.NoError(t, err)
+	ops, err = logic.AssembleString("#pragma version 10\nint 256\nbyte 0x78\npop\n")
+	require.NoError(t, err)
+	require.Equal(t, ops.Program, approval)
+
+	// Without source, bytecode is used as it is
+	s.Source = nil
+	_, _, err = s.Compile(nil)
+	require.ErrorContains(t, err, "neither source nor bytecode")
+	s.ByteCode = &Programs{Approval: []byte{10, 1}, Clear: []byte{10, 1}}
+	_, _, err = s.Compile(nil)
+	require.ErrorContains(t, err, "no source")
+	s.TemplateVariables = nil
+	approval, _, err = s.Compile(nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte{10, 1}, approval)
+}
+
+func TestARC32(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	spec := `{
+  "hints": {
+    "move((uint64,uint64),uint64)(uint64,uint64)": {
+      "structs": {"p": {"name": "Point", "elements": [["x", "uint64"], ["y", "uint64"]]}, "output": {"name": "Point", "elements": [["x", "uint64"], ["y", "uint64"]]}},
+      "default_arguments": {"by": {"source": "constant", "data": 3}},
+      "call_config": {"no_op": "CALL"}
+    },
+    "scaled(uint64)uint64": {
+      "read_only": true,
+      "default_arguments": {"factor": {"source": "global-state", "data": "factor"}},
+      "call_config": {"no_op": "ALL", "opt_in": "CREATE"}
+    },
+    "name(string)void": {
+      "default_arguments": {"n": {"source": "abi-method", "data": {"name": "scaled", "args": [{"type": "uint64", "name": "factor"}], "returns": {"type": "uint64"}}}}
+    }
+  },
+  "source": {"approval": "` + b64("#pragma version 8\nint 1\n") + `", "clear": "` + b64("#pragma version 8\nint 1\n") + `"},
+  "state": {"global": {"num_byte_slices": 1, "num_uints": 1}, "local": {"num_byte_slices": 0, "num_uints": 0}},
+  "schema": {"global": {"declared": {"factor": {"type": "uint64", "key": "factor", "descr": ""}}, "reserved": {}}, "local": {"declared": {}, "reserved": {}}},
+  "contract": {
+    "name": "Mover",
+    "methods": [
+      {"name": "move", "args": [{"type": "(uint64,uint64)", "name": "p"}, {"type": "uint64", "name": "by"}], "returns": {"type": "(uint64,uint64)"}},
+      {"name": "scaled", "args": [{"type": "uint64", "name": "factor"}], "returns": {"type": "uint64"}},
+      {"name": "name", "args": [{"type": "string", "name": "n"}], "returns": {"type": "void"}}
+    ],
+    "networks": {"genesis": {"appID": 7}}
+  },
+  "bare_call_config": {"no_op": "CREATE", "update_application": "CALL", "delete_application": "NEVER"}
+}`
+	s, err := Parse([]byte(spec))
+	require.NoError(t, err)
+	require.Equal(t, "Mover", s.Name)
+	require.Equal(t, basics.AppIndex(7), s.AppID("genesis"))
+	require.Equal(t, Actions{Create: []string{"NoOp"}, Call: []string{"UpdateApplication"}}, s.BareActions)
+	require.Equal(t, Schema{Ints: 1, Bytes: 1}, s.State.Schema.Global)
+	require.Equal(t, StorageKey{KeyType: AVMString, ValueType: AVMUint64, Key: []byte("factor")}, s.State.Keys.Global["factor"])
+
+	move, err := s.Method("move")
+	require.NoError(t, err)
+	require.Equal(t, "Point", move.Args[0].Struct)
+	require.Equal(t, "Point", move.Returns.Struct)
+	require.Equal(t, Actions{Call: []string{"NoOp"}}, move.Actions)
+	require.Equal(t, &DefaultValue{Source: SourceLiteral, Data: "AAAAAAAAAAM="}, move.Args[1].DefaultValue)
+	value, err := s.ArgJSON(move.Args[1], "", []byte{0, 0, 0, 0, 0, 0, 0, 3})
+	require.NoError(t, err)
+	require.Equal(t, "3", string(value))
+
+	scaled, err := s.Method("scaled")
+	require.NoError(t, err)
+	require.True(t, scaled.Readonly)
+	require.Equal(t, Actions{Create: []string{"NoOp", "OptIn"}, Call: []string{"NoOp"}}, scaled.Actions)
+	require.Equal(t, &DefaultValue{Source: SourceGlobal, Type: AVMUint64, Data: b64("factor")}, scaled.Args[0].DefaultValue)
+
+	name, err := s.Method("name")
+	require.NoError(t, err)
+	require.Equal(t, Actions{Call: []string{"NoOp"}}, name.Actions)
+	require.Equal(t, &DefaultValue{Source: SourceMethod, Data: "scaled(uint64)uint64"}, name.Args[0].DefaultValue)
+
+	_, err = Parse([]byte(`{"contract": {"methods": [{"name": "f", "args": [{"type": "uint64", "name": "a"}], "returns": {"type": "void"}}]}, "hints": {"f(uint64)void": {"default_arguments": {"a": {"source": "nowhere"}}}}}`))
+	require.ErrorContains(t, err, "unknown source nowhere")
+}
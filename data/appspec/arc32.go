@@ -0,0 +1,262 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package appspec
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/algorand/avm-abi/abi"
+)
+
+// arc32Spec is an ARC-32 application specification
+type arc32Spec struct {
+	Hints  map[string]arc32Hint `json:"hints"`
+	Source *Programs            `json:"source"`
+	State  struct {
+		Global arc32Schema `json:"global"`
+		Local  arc32Schema `json:"local"`
+	} `json:"state"`
+	Schema struct {
+		Global arc32Declared `json:"global"`
+		Local  arc32Declared `json:"local"`
+	} `json:"schema"`
+	Contract struct {
+		Name     string             `json:"name"`
+		Desc     string             `json:"desc"`
+		Methods  []arc32Method      `json:"methods"`
+		Networks map[string]Network `json:"networks"`
+	} `json:"contract"`
+	BareCallConfig map[string]string `json:"bare_call_config"`
+}
+
+type arc32Hint struct {
+	Structs          map[string]arc32Struct  `json:"structs"`
+	ReadOnly         bool                    `json:"read_only"`
+	DefaultArguments map[string]arc32Default `json:"default_arguments"`
+	CallConfig       map[string]string       `json:"call_config"`
+}
+
+type arc32Struct struct {
+	Name     string      `json:"name"`
+	Elements [][2]string `json:"elements"`
+}
+
+type arc32Default struct {
+	Source string          `json:"source"`
+	Data   json.RawMessage `json:"data"`
+}
+
+type arc32Schema struct {
+	NumUints      uint64 `json:"num_uints"`
+	NumByteSlices uint64 `json:"num_byte_slices"`
+}
+
+type arc32Declared struct {
+	Declared map[string]struct {
+		Type  string `json:"type"`
+		Key   string `json:"key"`
+		Descr string `json:"descr"`
+	} `json:"declared"`
+}
+
+type arc32Method struct {
+	Name string `json:"name"`
+	Desc string `json:"desc"`
+	Args []struct {
+		Type string `json:"type"`
+		Name string `json:"name"`
+		Desc string `json:"desc"`
+	} `json:"args"`
+	Returns struct {
+		Type string `json:"type"`
+		Desc string `json:"desc"`
+	} `json:"returns"`
+}
+
+func (m arc32Method) signature() string {
+	types := make([]string, len(m.Args))
+	for i, arg := range m.Args {
+		types[i] = arg.Type
+	}
+	return m.Name + "(" + strings.Join(types, ",") + ")" + m.Returns.Type
+}
+
+// arc32Actions are the ARC-32 names of the on-completion actions, in the
+// order of their ARC-56 names
+var arc32Actions = []struct{ arc32, arc56 string }{
+	{"no_op", "NoOp"},
+	{"opt_in", "OptIn"},
+	{"close_out", "CloseOut"},
+	{"update_application", "UpdateApplication"},
+	{"delete_application", "DeleteApplication"},
+}
+
+// actions converts an ARC-32 call config, which tells if an action may be
+// used to CALL the application, to CREATE it, for ALL of these or NEVER
+func actions(config map[string]string) Actions {
+	var a Actions
+	for _, action := range arc32Actions {
+		switch config[action.arc32] {
+		case "CALL":
+			a.Call = append(a.Call, action.arc56)
+		case "CREATE":
+			a.Create = append(a.Create, action.arc56)
+		case "ALL":
+			a.Call = append(a.Call, action.arc56)
+			a.Create = append(a.Create, action.arc56)
+		}
+	}
+	return a
+}
+
+// parseARC32 reads an ARC-32 specification into the ARC-56 form
+func parseARC32(data []byte) (*Spec, error) {
+	var old arc32Spec
+	err := json.Unmarshal(data, &old)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Spec{
+		Name:        old.Contract.Name,
+		Desc:        old.Contract.Desc,
+		Structs:     make(map[string][]StructField),
+		BareActions: actions(old.BareCallConfig),
+		Source:      old.Source,
+		Networks:    old.Contract.Networks,
+	}
+	s.State.Schema.Global = Schema{Ints: old.State.Global.NumUints, Bytes: old.State.Global.NumByteSlices}
+	s.State.Schema.Local = Schema{Ints: old.State.Local.NumUints, Bytes: old.State.Local.NumByteSlices}
+	s.State.Keys.Global = declaredKeys(old.Schema.Global)
+	s.State.Keys.Local = declaredKeys(old.Schema.Local)
+
+	for _, om := range old.Contract.Methods {
+		hint := old.Hints[om.signature()]
+		m := Method{
+			Name:     om.Name,
+			Desc:     om.Desc,
+			Returns:  Returns{Type: om.Returns.Type, Desc: om.Returns.Desc},
+			Readonly: hint.ReadOnly,
+		}
+		if hint.CallConfig == nil {
+			m.Actions.Call = []string{"NoOp"}
+		} else {
+			m.Actions = actions(hint.CallConfig)
+		}
+
+		// Structs are hinted by the name of the argument, or as output
+		structName := func(key string) string {
+			st, ok := hint.Structs[key]
+			if !ok {
+				return ""
+			}
+			fields := make([]StructField, len(st.Elements))
+			for i, e := range st.Elements {
+				fields[i] = StructField{Name: e[0], Type: e[1]}
+			}
+			s.Structs[st.Name] = fields
+			return st.Name
+		}
+		m.Returns.Struct = structName("output")
+
+		for _, oa := range om.Args {
+			arg := Arg{Type: oa.Type, Name: oa.Name, Desc: oa.Desc, Struct: structName(oa.Name)}
+			if d, ok := hint.DefaultArguments[oa.Name]; ok {
+				arg.DefaultValue, err = arc32DefaultValue(arg, d, old)
+				if err != nil {
+					return nil, fmt.Errorf("method %s: default for %s: %w", om.Name, oa.Name, err)
+				}
+			}
+			m.Args = append(m.Args, arg)
+		}
+		s.Methods = append(s.Methods, m)
+	}
+	return s, nil
+}
+
+func declaredKeys(d arc32Declared) map[string]StorageKey {
+	if len(d.Declared) == 0 {
+		return nil
+	}
+	keys := make(map[string]StorageKey, len(d.Declared))
+	for name, decl := range d.Declared {
+		keys[name] = StorageKey{
+			KeyType:   AVMString,
+			ValueType: avmType(decl.Type),
+			Key:       []byte(decl.Key),
+			Desc:      decl.Descr,
+		}
+	}
+	return keys
+}
+
+// avmType converts the type of an ARC-32 declared value
+func avmType(typ string) string {
+	if typ == "uint64" {
+		return AVMUint64
+	}
+	return AVMBytes
+}
+
+// arc32DefaultValue converts an ARC-32 default argument into an ARC-56 default
+// value
+func arc32DefaultValue(arg Arg, d arc32Default, old arc32Spec) (*DefaultValue, error) {
+	switch d.Source {
+	case "constant":
+		t, err := abi.TypeOf(arg.Type)
+		if err != nil {
+			return nil, err
+		}
+		v, err := t.UnmarshalFromJSON(d.Data)
+		if err != nil {
+			return nil, err
+		}
+		encoded, err := t.Encode(v)
+		if err != nil {
+			return nil, err
+		}
+		return &DefaultValue{Source: SourceLiteral, Data: base64.StdEncoding.EncodeToString(encoded)}, nil
+	case "global-state", "local-state":
+		var key string
+		err := json.Unmarshal(d.Data, &key)
+		if err != nil {
+			return nil, err
+		}
+		declared, source := old.Schema.Global, SourceGlobal
+		if d.Source == "local-state" {
+			declared, source = old.Schema.Local, SourceLocal
+		}
+		typ := AVMBytes
+		for _, decl := range declared.Declared {
+			if decl.Key == key {
+				typ = avmType(decl.Type)
+			}
+		}
+		return &DefaultValue{Source: source, Type: typ, Data: base64.StdEncoding.EncodeToString([]byte(key))}, nil
+	case "abi-method":
+		var m arc32Method
+		err := json.Unmarshal(d.Data, &m)
+		if err != nil {
+			return nil, err
+		}
+		return &DefaultValue{Source: SourceMethod, Data: m.signature()}, nil
+	}
+	return nil, fmt.Errorf("unknown source %s", d.Source)
+}
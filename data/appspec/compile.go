@@ -0,0 +1,161 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package appspec
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/algorand/go-algorand/data/transactions/logic"
+)
+
+// templatePrefix begins the names of template variables in TEAL source
+const templatePrefix = "TMPL_"
+
+var templatePattern = regexp.MustCompile(templatePrefix + `[A-Za-z0-9_]+`)
+
+// TemplateValue is the value of a template variable, which is substituted
+// into TEAL source as an integer or as bytes
+type TemplateValue struct {
+	Int   bool
+	Uint  uint64
+	Bytes []byte
+}
+
+// teal returns the TEAL literal of v
+func (v TemplateValue) teal() string {
+	if v.Int {
+		return strconv.FormatUint(v.Uint, 10)
+	}
+	return "0x" + hex.EncodeToString(v.Bytes)
+}
+
+// IsIntType tells if values of a template variable of typ are substituted
+// as integers
+func IsIntType(typ string) bool {
+	if typ == AVMUint64 {
+		return true
+	}
+	var bits int
+	_, err := fmt.Sscanf(typ, "uint%d", &bits)
+	return err == nil && bits <= 64 && typ == fmt.Sprintf("uint%d", bits)
+}
+
+// TemplateNames returns the names, without their prefix, of the template
+// variables declared by the specification or used by its source
+func (s *Spec) TemplateNames() []string {
+	var names []string
+	for name := range s.TemplateVariables {
+		names = append(names, strings.TrimPrefix(name, templatePrefix))
+	}
+	if s.Source != nil {
+		for _, src := range [][]byte{s.Source.Approval, s.Source.Clear} {
+			for _, match := range templatePattern.FindAll(src, -1) {
+				names = append(names, strings.TrimPrefix(string(match), templatePrefix))
+			}
+		}
+	}
+	slices.Sort(names)
+	return slices.Compact(names)
+}
+
+// variable returns the declaration of a template variable
+func (s *Spec) variable(name string) (TemplateVariable, bool) {
+	v, ok := s.TemplateVariables[name]
+	if !ok {
+		v, ok = s.TemplateVariables[templatePrefix+name]
+	}
+	return v, ok
+}
+
+// TemplateValue returns the value of a template variable of s, given as
+// raw bytes, using the type it is declared with. Undeclared variables are
+// integers if asInt is set.
+func (s *Spec) TemplateValue(name string, raw []byte, asInt bool) (TemplateValue, error) {
+	if v, ok := s.variable(name); ok && v.Type != "" {
+		asInt = IsIntType(v.Type)
+	}
+	if !asInt {
+		return TemplateValue{Bytes: raw}, nil
+	}
+	if len(raw) > 8 {
+		return TemplateValue{}, fmt.Errorf("template variable %s is an integer, not %d bytes", name, len(raw))
+	}
+	var buf [8]byte
+	copy(buf[8-len(raw):], raw)
+	return TemplateValue{Int: true, Uint: binary.BigEndian.Uint64(buf[:])}, nil
+}
+
+// Compile returns the approval and clear state programs of s, assembling
+// its source with the template variables replaced by values, or by the
+// defaults it declares. Bytecode is used when there is no source.
+func (s *Spec) Compile(values map[string]TemplateValue) (approval []byte, clear []byte, err error) {
+	if s.Source == nil || len(s.Source.Approval) == 0 || len(s.Source.Clear) == 0 {
+		if s.ByteCode == nil || len(s.ByteCode.Approval) == 0 || len(s.ByteCode.Clear) == 0 {
+			return nil, nil, fmt.Errorf("%s has neither source nor bytecode", s.Name)
+		}
+		if len(s.TemplateVariables) != 0 {
+			return nil, nil, fmt.Errorf("%s has template variables but no source to substitute them in", s.Name)
+		}
+		return s.ByteCode.Approval, s.ByteCode.Clear, nil
+	}
+
+	approval, err = s.assemble("approval", s.Source.Approval, values)
+	if err != nil {
+		return nil, nil, err
+	}
+	clear, err = s.assemble("clear", s.Source.Clear, values)
+	if err != nil {
+		return nil, nil, err
+	}
+	return approval, clear, nil
+}
+
+func (s *Spec) assemble(which string, src []byte, values map[string]TemplateValue) ([]byte, error) {
+	var missing []string
+	text := templatePattern.ReplaceAllStringFunc(string(src), func(match string) string {
+		name := strings.TrimPrefix(match, templatePrefix)
+		value, ok := values[name]
+		if !ok {
+			if v, declared := s.variable(name); declared && v.Value != nil {
+				var err error
+				value, err = s.TemplateValue(name, v.Value, false)
+				ok = err == nil
+			}
+		}
+		if !ok {
+			missing = append(missing, name)
+			return match
+		}
+		return value.teal()
+	})
+	if len(missing) != 0 {
+		slices.Sort(missing)
+		return nil, fmt.Errorf("%s program needs values for template variables %s", which, strings.Join(slices.Compact(missing), ", "))
+	}
+
+	ops, err := logic.AssembleString(text)
+	if err != nil {
+		return nil, fmt.Errorf("%s program: %w", which, err)
+	}
+	return ops.Program, nil
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package appspec
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// StorageValue is a value of state or box storage decoded by the key or map
+// which holds it
+type StorageValue struct {
+	// Name is the name of the key or map
+	Name string
+	// Key is the JSON form of the value's key within a map, and nil for
+	// values held by a key
+	Key []byte
+	// Value is the JSON form of the value
+	Value []byte
+}
+
+// storage returns the keys and maps of the storage named by source
+func (s *Spec) storage(source string) (map[string]StorageKey, map[string]StorageMap) {
+	switch source {
+	case SourceGlobal:
+		return s.State.Keys.Global, s.State.Maps.Global
+	case SourceLocal:
+		return s.State.Keys.Local, s.State.Maps.Local
+	case SourceBox:
+		return s.State.Keys.Box, s.State.Maps.Box
+	}
+	return nil, nil
+}
+
+// DecodeStorage decodes the value stored under key in global state, local
+// state or a box, as named by source. The key which is exactly key wins over
+// maps, and the map with the longest matching prefix over the others. It
+// returns nil when the spec declares no key or map for key.
+func (s *Spec) DecodeStorage(source string, key, value []byte) (*StorageValue, error) {
+	keys, maps := s.storage(source)
+	for name, k := range keys {
+		if !bytes.Equal(k.Key, key) {
+			continue
+		}
+		decoded, err := s.Decode(k.ValueType, value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		return &StorageValue{Name: name, Value: decoded}, nil
+	}
+
+	var found string
+	var longest *StorageMap
+	for name, m := range maps {
+		if !bytes.HasPrefix(key, m.Prefix) {
+			continue
+		}
+		if longest == nil || len(m.Prefix) > len(longest.Prefix) ||
+			len(m.Prefix) == len(longest.Prefix) && name < found {
+			found, longest = name, &m
+		}
+	}
+	if longest == nil {
+		return nil, nil
+	}
+	decodedKey, err := s.Decode(longest.KeyType, key[len(longest.Prefix):])
+	if err != nil {
+		return nil, fmt.Errorf("%s key: %w", found, err)
+	}
+	decoded, err := s.Decode(longest.ValueType, value)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", found, err)
+	}
+	return &StorageValue{Name: found, Key: decodedKey, Value: decoded}, nil
+}
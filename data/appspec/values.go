@@ -0,0 +1,264 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package appspec
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/algorand/avm-abi/abi"
+
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+// maxStructDepth bounds the nesting of structs, so that structs which
+// contain themselves are caught
+const maxStructDepth = 16
+
+// ABIType returns the ABI type of typ, which is an ABI type or the name of
+// a struct
+func (s *Spec) ABIType(typ string) (abi.Type, error) {
+	str, err := s.abiType(typ, 0)
+	if err != nil {
+		return abi.Type{}, err
+	}
+	return abi.TypeOf(str)
+}
+
+func (s *Spec) abiType(typ string, depth int) (string, error) {
+	fields, ok := s.Structs[typ]
+	if !ok {
+		_, err := abi.TypeOf(typ)
+		if err != nil {
+			return "", err
+		}
+		return typ, nil
+	}
+	return s.tupleType(fields, depth)
+}
+
+func (s *Spec) tupleType(fields []StructField, depth int) (string, error) {
+	if depth >= maxStructDepth {
+		return "", fmt.Errorf("structs are nested too deeply")
+	}
+	types := make([]string, len(fields))
+	for i, f := range fields {
+		var err error
+		if f.Fields != nil {
+			types[i], err = s.tupleType(f.Fields, depth+1)
+		} else {
+			types[i], err = s.abiType(f.Type, depth+1)
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+	return "(" + strings.Join(types, ",") + ")", nil
+}
+
+// FromABIJSON rewrites the JSON form of a value of typ made by the abi
+// package, where structs are arrays of their fields, so that structs are
+// objects keyed by the names of their fields
+func (s *Spec) FromABIJSON(typ string, value []byte) ([]byte, error) {
+	fields, ok := s.Structs[typ]
+	if !ok {
+		return value, nil
+	}
+	return s.structFromABIJSON(fields, value)
+}
+
+func (s *Spec) structFromABIJSON(fields []StructField, value []byte) ([]byte, error) {
+	var elems []json.RawMessage
+	err := json.Unmarshal(value, &elems)
+	if err != nil {
+		return nil, err
+	}
+	if len(elems) != len(fields) {
+		return nil, fmt.Errorf("%d values for %d fields", len(elems), len(fields))
+	}
+
+	// Build the object by hand to keep the fields in order
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, f := range fields {
+		var elem []byte
+		if f.Fields != nil {
+			elem, err = s.structFromABIJSON(f.Fields, elems[i])
+		} else {
+			elem, err = s.FromABIJSON(f.Type, elems[i])
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", f.Name, err)
+		}
+		name, err := json.Marshal(f.Name)
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(elem)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// ToABIJSON rewrites the JSON form of a value of typ, where structs are
+// objects keyed by the names of their fields, into the form the abi package
+// reads. Structs which are already arrays are left alone.
+func (s *Spec) ToABIJSON(typ string, value []byte) ([]byte, error) {
+	fields, ok := s.Structs[typ]
+	if !ok {
+		return value, nil
+	}
+	return s.structToABIJSON(fields, value)
+}
+
+func (s *Spec) structToABIJSON(fields []StructField, value []byte) ([]byte, error) {
+	value = bytes.TrimSpace(value)
+	if !bytes.HasPrefix(value, []byte("{")) {
+		return value, nil
+	}
+	var obj map[string]json.RawMessage
+	err := json.Unmarshal(value, &obj)
+	if err != nil {
+		return nil, err
+	}
+
+	elems := make([]json.RawMessage, len(fields))
+	for i, f := range fields {
+		elem, ok := obj[f.Name]
+		if !ok {
+			return nil, fmt.Errorf("missing field %s", f.Name)
+		}
+		delete(obj, f.Name)
+		if f.Fields != nil {
+			elems[i], err = s.structToABIJSON(f.Fields, elem)
+		} else {
+			elems[i], err = s.ToABIJSON(f.Type, elem)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", f.Name, err)
+		}
+	}
+	for name := range obj {
+		return nil, fmt.Errorf("unknown field %s", name)
+	}
+	return json.Marshal(elems)
+}
+
+// Decode returns the JSON form of an encoded value of typ, which is an ABI
+// type, the name of a struct or an AVM type
+func (s *Spec) Decode(typ string, encoded []byte) ([]byte, error) {
+	switch typ {
+	case AVMBytes:
+		return json.Marshal(encoded)
+	case AVMString:
+		return json.Marshal(string(encoded))
+	case AVMUint64:
+		if len(encoded) != 8 {
+			return nil, fmt.Errorf("%d bytes are not a uint64", len(encoded))
+		}
+		return json.Marshal(binary.BigEndian.Uint64(encoded))
+	}
+
+	t, err := s.ABIType(typ)
+	if err != nil {
+		return nil, err
+	}
+	value, err := decodeABI(t, encoded)
+	if err != nil {
+		return nil, err
+	}
+	plain, err := t.MarshalToJSON(value)
+	if err != nil {
+		return nil, err
+	}
+	return s.FromABIJSON(typ, plain)
+}
+
+// decodeABI decodes a value of t. The abi package trusts the lengths it
+// finds in encoded, so it may panic on values it did not encode, or read
+// them without noticing that bytes are missing or left over. Values which
+// do not encode back to encoded are refused.
+func decodeABI(t abi.Type, encoded []byte) (value interface{}, err error) {
+	defer func() {
+		if recover() != nil {
+			value, err = nil, fmt.Errorf("%d bytes are not a %s", len(encoded), t.String())
+		}
+	}()
+	value, err = t.Decode(encoded)
+	if err != nil {
+		return nil, err
+	}
+	again, err := t.Encode(value)
+	if err != nil || !bytes.Equal(again, encoded) {
+		return nil, fmt.Errorf("%d bytes are not a %s", len(encoded), t.String())
+	}
+	return value, nil
+}
+
+// Encode returns the encoding of the JSON form of a value of typ, which is
+// an ABI type or the name of a struct
+func (s *Spec) Encode(typ string, value []byte) ([]byte, error) {
+	t, err := s.ABIType(typ)
+	if err != nil {
+		return nil, err
+	}
+	plain, err := s.ToABIJSON(typ, value)
+	if err != nil {
+		return nil, err
+	}
+	v, err := t.UnmarshalFromJSON(plain)
+	if err != nil {
+		return nil, err
+	}
+	return t.Encode(v)
+}
+
+// ArgJSON returns the JSON form of a default value for arg. The value was
+// found encoded as typ, which is empty when it is encoded as the argument.
+// Values of AVM types are not ABI encoded, so bytes are taken as the
+// argument's string, address or byte array when they can be.
+func (s *Spec) ArgJSON(arg Arg, typ string, value []byte) ([]byte, error) {
+	argType := arg.Type
+	if arg.Struct != "" {
+		argType = arg.Struct
+	}
+	if typ == "" {
+		typ = argType
+	}
+	if typ == AVMBytes || typ == AVMString {
+		switch {
+		case argType == "string":
+			return json.Marshal(string(value))
+		case argType == "address" && len(value) == len(basics.Address{}):
+			return json.Marshal(basics.Address(value).String())
+		case argType == "byte[]" || argType == fmt.Sprintf("byte[%d]", len(value)):
+			return json.Marshal(value)
+		case typ == AVMBytes:
+			// The bytes may be an ABI encoded value
+			typ = argType
+		}
+	}
+	return s.Decode(typ, value)
+}
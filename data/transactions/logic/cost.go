@@ -0,0 +1,321 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"math"
+	"slices"
+)
+
+// ExitCost bounds the opcode cost of the paths that end the program at one instruction.
+type ExitCost struct {
+	// PC is the pc of the return or err instruction, or the length of the program for paths that
+	// run past its last instruction.
+	PC int
+	// Op is the name of the instruction, or empty for the end of the program.
+	Op string
+	// Min is the lowest cost of the paths, when the byte arguments of opcodes are empty.
+	Min int
+	// Max is the highest cost of the paths, when the byte arguments of opcodes have the maximum
+	// length. It is only meaningful if Bounded is true.
+	Max int
+	// Bounded is false if a path may go through a loop or a recursive subroutine call, so that its
+	// cost is only limited by the opcode budget.
+	Bounded bool
+	// LengthDependent is true if Max depends on the length of byte arguments, i.e. it would be
+	// lower if they were empty.
+	LengthDependent bool
+}
+
+// CostEstimate bounds the opcode cost of a program, over all of its control flow paths.
+type CostEstimate struct {
+	// Min, Max, Bounded and LengthDependent are like the fields of ExitCost, for the paths to
+	// every exit.
+	Min             int
+	Max             int
+	Bounded         bool
+	LengthDependent bool
+
+	// Exits bounds the cost of each exit of the program, in pc order.
+	Exits []ExitCost
+	// Dominating holds the pcs of the instructions of a path that costs Max, in execution order,
+	// including the instructions of the subroutines it calls. It is empty if Bounded is false.
+	Dominating []int
+	// LengthDependentPCs holds the pcs of the reachable opcodes whose cost depends on the length
+	// of their byte arguments.
+	LengthDependentPCs []int
+}
+
+// EstimateCost computes bounds on the opcode cost of program, which runs in the given mode, from
+// the cost details of its opcodes and its control flow. Every path counts, even paths that no
+// input can take, so the bounds are conservative. Prior to v4, programs cannot loop, and Max is
+// the exact worst case.
+func EstimateCost(program []byte, mode RunMode) (*CostEstimate, error) {
+	flow, err := makeProgramFlow(program, mode)
+	if err != nil {
+		return nil, err
+	}
+	return flow.estimateCost(), nil
+}
+
+// unboundedCost is the cost of paths through loops or recursive subroutines.
+const unboundedCost = math.MaxInt
+
+func addCost(a, b int) int {
+	if a == unboundedCost || b == unboundedCost {
+		return unboundedCost
+	}
+	return a + b
+}
+
+func (flow *programFlow) estimateCost() *CostEstimate {
+	minCost := func(in *flowInstruction) int { return in.minCost }
+	maxCost := func(in *flowInstruction) int { return in.maxCost }
+	cheapest := flow.pathCosts(false, minCost)
+	worst := flow.pathCosts(true, maxCost)
+	// the most expensive paths if byte arguments were empty, to tell which exits depend on length
+	worstEmpty := flow.pathCosts(true, minCost)
+
+	estimate := &CostEstimate{Bounded: true}
+	worstExit := -1
+	for i := range flow.instructions {
+		if _, ok := worst.exits[i]; !ok {
+			continue
+		}
+		exit := ExitCost{
+			PC:      flow.instructions[i].pc,
+			Min:     cheapest.exits[i].cost,
+			Max:     worst.exits[i].cost,
+			Bounded: worst.exits[i].cost != unboundedCost,
+		}
+		if spec := flow.instructions[i].spec; spec != nil {
+			exit.Op = spec.Name
+		}
+		if exit.Bounded {
+			exit.LengthDependent = exit.Max != worstEmpty.exits[i].cost
+		} else {
+			exit.Max = 0
+		}
+		estimate.Exits = append(estimate.Exits, exit)
+
+		if len(estimate.Exits) == 1 || exit.Min < estimate.Min {
+			estimate.Min = exit.Min
+		}
+		estimate.Bounded = estimate.Bounded && exit.Bounded
+		estimate.LengthDependent = estimate.LengthDependent || exit.LengthDependent
+		if exit.Bounded && (worstExit == -1 || exit.Max > estimate.Max) {
+			estimate.Max = exit.Max
+			worstExit = i
+		}
+	}
+
+	if estimate.Bounded {
+		for _, i := range worst.path(worst.exits[worstExit].function, worstExit) {
+			// the end of the program is not an instruction
+			if flow.instructions[i].spec != nil {
+				estimate.Dominating = append(estimate.Dominating, flow.instructions[i].pc)
+			}
+		}
+	} else {
+		estimate.Max = 0
+	}
+	reached := flow.reachable()
+	for i, in := range flow.instructions {
+		if reached[i] && in.minCost != in.maxCost {
+			estimate.LengthDependentPCs = append(estimate.LengthDependentPCs, in.pc)
+		}
+	}
+	return estimate
+}
+
+// pathCosts holds either the cheapest or the most expensive paths through a program, for one
+// way of costing its instructions. Functions are keyed by the index of their entry.
+type pathCosts struct {
+	flow  *programFlow
+	worst bool
+
+	// dist[f][i] is the cost of the chosen path from the entry of f to its member i, before
+	// executing i, counting the subroutines called along the way. It is -1 for instructions that
+	// are not members of f. For the most expensive paths, it is unboundedCost for members that a
+	// loop or a recursive call may precede.
+	dist map[int][]int
+	// pred[f][i] is the member of f before i on the chosen path, or -1 for the entry of f.
+	pred map[int][]int
+	// ret[f] is the cost of executing f up to, and including, the chosen retsub retsub[f], which
+	// is -1 if f cannot return.
+	ret    map[int]int
+	retsub map[int]int
+	// entry[f] is the cost of the chosen path that enters f, through the callsub caller[f] of
+	// the function callerFunction[f].
+	entry          map[int]int
+	caller         map[int]int
+	callerFunction map[int]int
+	// exits holds the chosen path to each exit of the program.
+	exits map[int]pathExit
+}
+
+type pathExit struct {
+	cost int
+	// function is the function the path ends in.
+	function int
+}
+
+func (paths *pathCosts) better(a, b int) bool {
+	if paths.worst {
+		return a > b
+	}
+	return a < b
+}
+
+// calleeCost is the cost of a call to the function that starts at callee, excluding the callsub.
+func (paths *pathCosts) calleeCost(callee int) int {
+	if ret, ok := paths.ret[callee]; ok {
+		return ret
+	}
+	// the call is recursive. The most expensive path may recurse without bound, while the
+	// cheapest path may not recurse.
+	if paths.worst {
+		return unboundedCost
+	}
+	return 0
+}
+
+// pathCosts chooses the cheapest paths, or the most expensive ones if worst is true, with cost
+// giving the cost of each instruction.
+func (flow *programFlow) pathCosts(worst bool, cost func(*flowInstruction) int) *pathCosts {
+	paths := &pathCosts{
+		flow:           flow,
+		worst:          worst,
+		dist:           make(map[int][]int, len(flow.order)),
+		pred:           make(map[int][]int, len(flow.order)),
+		ret:            make(map[int]int, len(flow.order)),
+		retsub:         make(map[int]int, len(flow.order)),
+		entry:          make(map[int]int, len(flow.order)),
+		caller:         make(map[int]int, len(flow.order)),
+		callerFunction: make(map[int]int, len(flow.order)),
+		exits:          make(map[int]pathExit),
+	}
+
+	// callees come before their callers in reverse order, except for recursive calls
+	for fi := len(flow.order) - 1; fi >= 0; fi-- {
+		f := flow.order[fi]
+		position := make(map[int]int, len(f.members))
+		for k, i := range f.members {
+			position[i] = k
+		}
+		dist := make([]int, len(flow.instructions))
+		pred := make([]int, len(flow.instructions))
+		for i := range dist {
+			dist[i] = -1
+			pred[i] = -1
+		}
+		dist[f.entry] = 0
+		ret, retsub := 0, -1
+		for k, i := range f.members {
+			if worst && f.loopHeads[i] {
+				dist[i] = unboundedCost
+			}
+			c := cost(&flow.instructions[i])
+			if callee, ok := flow.isCall(i); ok {
+				c = addCost(c, paths.calleeCost(callee))
+			}
+			after := addCost(dist[i], c)
+			if in := &flow.instructions[i]; in.spec != nil && in.spec.Name == "retsub" {
+				if retsub == -1 || paths.better(after, ret) {
+					ret, retsub = after, i
+				}
+			}
+			for _, s := range flow.successors(i) {
+				if position[s] <= k {
+					// a back edge, which only matters to the most expensive paths, whose loop
+					// heads are unbounded already
+					continue
+				}
+				if dist[s] == -1 || paths.better(after, dist[s]) {
+					dist[s], pred[s] = after, i
+				}
+			}
+		}
+		paths.dist[f.entry] = dist
+		paths.pred[f.entry] = pred
+		paths.ret[f.entry] = ret
+		paths.retsub[f.entry] = retsub
+	}
+
+	// callers come before their callees, except for recursive calls
+	paths.entry[flow.main().entry] = 0
+	for _, f := range flow.order {
+		if worst && flow.recursive[f.entry] {
+			paths.entry[f.entry] = unboundedCost
+		}
+		for _, i := range f.members {
+			callee, ok := flow.isCall(i)
+			if !ok {
+				continue
+			}
+			c := addCost(addCost(paths.entry[f.entry], paths.dist[f.entry][i]), cost(&flow.instructions[i]))
+			if previous, ok := paths.entry[callee]; !ok || paths.better(c, previous) {
+				paths.entry[callee] = c
+				paths.caller[callee] = i
+				paths.callerFunction[callee] = f.entry
+			}
+		}
+	}
+
+	for _, f := range flow.order {
+		for _, i := range f.members {
+			if !flow.isExit(i) {
+				continue
+			}
+			c := addCost(addCost(paths.entry[f.entry], paths.dist[f.entry][i]), cost(&flow.instructions[i]))
+			if previous, ok := paths.exits[i]; !ok || paths.better(c, previous.cost) {
+				paths.exits[i] = pathExit{cost: c, function: f.entry}
+			}
+		}
+	}
+	return paths
+}
+
+// path returns the instructions of the chosen path from the start of the program to member i
+// of function f, including i.
+func (paths *pathCosts) path(f int, i int) []int {
+	var path []int
+	if f != paths.flow.main().entry {
+		path = paths.path(paths.callerFunction[f], paths.caller[f])
+	}
+	return append(path, paths.local(f, i)...)
+}
+
+// local returns the instructions of the chosen path from the entry of function f to its member
+// i, including i, and the instructions of the subroutines called along the way.
+func (paths *pathCosts) local(f int, i int) []int {
+	var members []int
+	for j := i; j != -1; j = paths.pred[f][j] {
+		members = append(members, j)
+	}
+	slices.Reverse(members)
+	var path []int
+	for _, j := range members {
+		path = append(path, j)
+		if callee, ok := paths.flow.isCall(j); ok && j != i {
+			if retsub := paths.retsub[callee]; retsub != -1 {
+				path = append(path, paths.local(callee, retsub)...)
+			}
+		}
+	}
+	return path
+}
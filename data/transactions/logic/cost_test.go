@@ -0,0 +1,141 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"testing"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/stretchr/testify/require"
+)
+
+// linePCs returns the pcs of the opcodes on the given source lines, counted from 1.
+func linePCs(t *testing.T, ops *OpStream, lines ...int) []int {
+	t.Helper()
+	pcs := make([]int, len(lines))
+	for i, line := range lines {
+		pcs[i] = lintPC(t, ops, line)
+	}
+	return pcs
+}
+
+func TestEstimateCost(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ops, err := AssembleString(`#pragma version 8
+txn OnCompletion
+bnz other
+pushbytes "AA=="
+base64_decode StdEncoding
+callsub sub
+pushint 1
+return
+other:
+callsub sub
+err
+sub:
+sha256
+retsub`)
+	require.NoError(t, err)
+	estimate, err := EstimateCost(ops.Program, ModeApp)
+	require.NoError(t, err)
+
+	// base64_decode costs 1 + 1 per 16 bytes
+	require.Equal(t, &CostEstimate{
+		Min:             1 + 1 + 1 + 35 + 1 + 1,
+		Max:             1 + 1 + 1 + (1 + 4096/16) + 1 + 35 + 1 + 1 + 1,
+		Bounded:         true,
+		LengthDependent: true,
+		Exits: []ExitCost{{
+			// txn, bnz, pushbytes, base64_decode, callsub, sha256, retsub, pushint, return
+			PC:              lintPC(t, ops, 8),
+			Op:              "return",
+			Min:             1 + 1 + 1 + 1 + 1 + 35 + 1 + 1 + 1,
+			Max:             1 + 1 + 1 + (1 + 4096/16) + 1 + 35 + 1 + 1 + 1,
+			Bounded:         true,
+			LengthDependent: true,
+		}, {
+			// txn, bnz, callsub, sha256, retsub, err
+			PC:      lintPC(t, ops, 11),
+			Op:      "err",
+			Min:     1 + 1 + 1 + 35 + 1 + 1,
+			Max:     1 + 1 + 1 + 35 + 1 + 1,
+			Bounded: true,
+		}},
+		Dominating:         linePCs(t, ops, 2, 3, 4, 5, 6, 13, 14, 7, 8),
+		LengthDependentPCs: linePCs(t, ops, 5),
+	}, estimate)
+}
+
+func TestEstimateCostBranches(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// a v3 LogicSig, whose paths join at the end of the program
+	ops, err := AssembleString(`#pragma version 3
+arg 0
+len
+bz short
+arg 0
+sha512_256
+pop
+short:
+pushint 1`)
+	require.NoError(t, err)
+	estimate, err := EstimateCost(ops.Program, ModeSig)
+	require.NoError(t, err)
+	require.Equal(t, 1+1+1+1, estimate.Min)
+	require.Equal(t, 1+1+1+1+45+1+1, estimate.Max)
+	require.True(t, estimate.Bounded)
+	require.False(t, estimate.LengthDependent)
+	require.Equal(t, []ExitCost{{PC: len(ops.Program), Min: estimate.Min, Max: estimate.Max, Bounded: true}}, estimate.Exits)
+	require.Equal(t, linePCs(t, ops, 2, 3, 4, 5, 6, 7, 9), estimate.Dominating)
+	require.Empty(t, estimate.LengthDependentPCs)
+}
+
+func TestEstimateCostUnbounded(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ops, err := AssembleString(`#pragma version 8
+pushint 3
+callsub countdown
+return
+countdown:
+proto 1 1
+frame_dig -1
+bz done
+frame_dig -1
+pushint 1
+-
+callsub countdown
+retsub
+done:
+pushint 1
+retsub`)
+	require.NoError(t, err)
+	estimate, err := EstimateCost(ops.Program, ModeApp)
+	require.NoError(t, err)
+	// the cheapest path does not recurse: pushint, callsub, proto, frame_dig, bz, pushint, retsub,
+	// return
+	require.Equal(t, 8, estimate.Min)
+	require.False(t, estimate.Bounded)
+	require.Zero(t, estimate.Max)
+	require.Empty(t, estimate.Dominating)
+	require.Equal(t, []ExitCost{{PC: lintPC(t, ops, 4), Op: "return", Min: 8}}, estimate.Exits)
+}
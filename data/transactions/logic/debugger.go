@@ -18,12 +18,14 @@ package logic
 
 import (
 	"bytes"
+	"cmp"
 	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
 	"strings"
 
 	"github.com/algorand/go-algorand/config"
@@ -54,6 +56,10 @@ type debuggerEvalTracerAdaptor struct {
 	debugger   Debugger
 	txnDepth   int
 	debugState *DebugState
+	// boxesStale is set when the boxes in debugState may no longer match
+	// the ledger, so that they are only read again after opcodes that can
+	// modify them.
+	boxesStale bool
 }
 
 // MakeEvalTracerDebuggerAdaptor creates an adaptor that externally adheres to the EvalTracer
@@ -85,6 +91,7 @@ func (a *debuggerEvalTracerAdaptor) BeforeProgram(cx *EvalContext) {
 		return
 	}
 	a.debugState = makeDebugState(cx)
+	a.boxesStale = true
 	a.debugger.Register(a.refreshDebugState(cx, nil))
 }
 
@@ -95,6 +102,9 @@ func (a *debuggerEvalTracerAdaptor) BeforeOpcode(cx *EvalContext) {
 		return
 	}
 	a.debugger.Update(a.refreshDebugState(cx, nil))
+	// the opcode about to run determines whether the next update must
+	// read the boxes again
+	a.boxesStale = a.boxesStale || debugOpWritesBoxes(cx.GetOpSpec().Name)
 }
 
 // AfterProgram invokes the debugger's Complete hook
@@ -148,6 +158,17 @@ type DebugState struct {
 
 	// global/local state changes are updated every step. Stateful TEAL only.
 	transactions.EvalDelta
+
+	// Boxes are the existing boxes available to the transaction group, with their contents,
+	// updated every step. Stateful TEAL only.
+	Boxes []DebugBox `codec:"boxes"`
+}
+
+// DebugBox is a box, as of a step of a program
+type DebugBox struct {
+	App   basics.AppIndex `codec:"app"`
+	Name  []byte          `codec:"name"`
+	Value []byte          `codec:"value"`
 }
 
 // GetProgramID returns program or execution ID that is string representation of sha256 checksum.
@@ -157,22 +178,28 @@ func GetProgramID(program []byte) string {
 	return hex.EncodeToString(hash[:])
 }
 
-func makeDebugState(cx *EvalContext) *DebugState {
-	disasm, dsInfo, err := disassembleInstrumented(cx.program, nil)
+// MakeDebugState creates a DebugState with the fields set once on Register, for debuggers that
+// replay a recorded execution of program, such as a simulate trace. Globals are left empty.
+func MakeDebugState(program []byte, txnGroup []transactions.SignedTxnWithAD, groupIndex int, proto *config.ConsensusParams) *DebugState {
+	disasm, dsInfo, err := disassembleInstrumented(program, nil)
 	if err != nil {
 		// Report disassembly error as program text
 		disasm = err.Error()
 	}
 
-	// initialize DebuggerState with immutable fields
-	ds := &DebugState{
-		ExecID:      GetProgramID(cx.program),
+	return &DebugState{
+		ExecID:      GetProgramID(program),
 		Disassembly: disasm,
 		PCOffset:    dsInfo.pcOffset,
-		GroupIndex:  int(cx.groupIndex),
-		TxnGroup:    cx.TxnGroup,
-		Proto:       cx.Proto,
+		GroupIndex:  groupIndex,
+		TxnGroup:    txnGroup,
+		Proto:       proto,
 	}
+}
+
+func makeDebugState(cx *EvalContext) *DebugState {
+	// initialize DebuggerState with immutable fields
+	ds := MakeDebugState(cx.program, cx.TxnGroup, int(cx.groupIndex), cx.Proto)
 
 	globals := make([]basics.TealValue, len(globalFieldSpecs))
 	for _, fs := range globalFieldSpecs {
@@ -253,27 +280,75 @@ func (sv stackValue) toEncodedTealValue() basics.TealValue {
 	return basics.TealValue{Type: basics.TealUintType, Uint: sv.Uint}
 }
 
+// CallFrameAt returns the CallFrame of a subroutine call by the callsub at pc.
+func (d *DebugState) CallFrameAt(pc int) CallFrame {
+	lines := strings.Split(d.Disassembly, "\n")
+	callsubLineNum := d.PCToLine(pc)
+	label := ""
+	if callsubLineNum < len(lines) {
+		callSubLine := strings.Fields(lines[callsubLineNum])
+		if len(callSubLine) > 1 && callSubLine[0] == "callsub" {
+			label = callSubLine[1]
+		}
+	}
+	return CallFrame{
+		FrameLine: callsubLineNum,
+		LabelName: label,
+	}
+}
+
 // parseCallStack initializes an array of CallFrame objects from the raw
 // callstack.
 func (d *DebugState) parseCallstack(callstack []frame) []CallFrame {
 	callFrames := make([]CallFrame, 0)
-	lines := strings.Split(d.Disassembly, "\n")
 	for _, fr := range callstack {
 		// The callsub is pc - 3 from the callstack pc
-		callsubLineNum := d.PCToLine(fr.retpc - 3)
-		callSubLine := strings.Fields(lines[callsubLineNum])
-		label := ""
-		if callSubLine[0] == "callsub" {
-			label = callSubLine[1]
-		}
-		callFrames = append(callFrames, CallFrame{
-			FrameLine: callsubLineNum,
-			LabelName: label,
-		})
+		callFrames = append(callFrames, d.CallFrameAt(fr.retpc-3))
 	}
 	return callFrames
 }
 
+// debugOpWritesBoxes reports whether the opcode can change the contents of
+// the boxes reported by debugBoxes. Inner app calls may write to boxes of
+// their own.
+func debugOpWritesBoxes(name string) bool {
+	switch name {
+	case "box_create", "box_put", "box_del", "box_replace", "box_splice", "box_resize", "itxn_submit":
+		return true
+	}
+	return false
+}
+
+// debugBoxes returns the existing boxes available to the transaction group, sorted by app and
+// name.
+func debugBoxes(cx *EvalContext) []DebugBox {
+	if cx.available == nil {
+		return nil
+	}
+	refs := make([]BoxRef, 0, len(cx.available.boxes))
+	for br := range cx.available.boxes {
+		if len(br.Name) != 0 {
+			refs = append(refs, br)
+		}
+	}
+	slices.SortFunc(refs, func(a, b BoxRef) int {
+		if a.App != b.App {
+			return cmp.Compare(a.App, b.App)
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	var boxes []DebugBox
+	for _, br := range refs {
+		value, exists, err := cx.Ledger.GetBox(br.App, br.Name)
+		if err != nil || !exists {
+			continue
+		}
+		boxes = append(boxes, DebugBox{App: br.App, Name: []byte(br.Name), Value: value})
+	}
+	return boxes
+}
+
 func (a *debuggerEvalTracerAdaptor) refreshDebugState(cx *EvalContext, evalError error) *DebugState {
 	ds := a.debugState
 
@@ -302,6 +377,10 @@ func (a *debuggerEvalTracerAdaptor) refreshDebugState(cx *EvalContext, evalError
 
 	if cx.runMode == ModeApp {
 		ds.EvalDelta = cx.txn.EvalDelta
+		if a.boxesStale {
+			ds.Boxes = debugBoxes(cx)
+			a.boxesStale = false
+		}
 	}
 
 	return ds
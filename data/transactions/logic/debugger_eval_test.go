@@ -297,3 +297,52 @@ func TestCallStackUpdate(t *testing.T) {
 	require.Len(t, testDbg.state.Stack, 1)
 	require.Equal(t, testDbg.state.CallStack, expectedCallFrames)
 }
+
+func TestDebuggerBoxes(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	testDbg := testDebugger{}
+	ep, _, ledger := MakeSampleEnv()
+	ledger.NewApp(basics.Address{}, 888, basics.AppParams{})
+	ep.Tracer = MakeEvalTracerDebuggerAdaptor(&testDbg)
+	TestApp(t, `byte "self"; int 4; box_create; assert
+byte "self"; int 1; byte 0x0102; box_replace
+int 1`, ep)
+
+	require.Equal(t, []DebugBox{{App: 888, Name: []byte("self"), Value: []byte{0, 1, 2, 0}}}, testDbg.state.Boxes)
+}
+
+type boxesDebugger struct {
+	testDebugger
+	boxes [][]DebugBox
+}
+
+func (d *boxesDebugger) Update(state *DebugState) {
+	d.testDebugger.Update(state)
+	d.boxes = append(d.boxes, state.Boxes)
+}
+
+func TestDebuggerBoxesOnlyReadAfterBoxOpcodes(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	testDbg := boxesDebugger{}
+	ep, _, ledger := MakeSampleEnv()
+	ledger.NewApp(basics.Address{}, 888, basics.AppParams{})
+	ep.Tracer = MakeEvalTracerDebuggerAdaptor(&testDbg)
+	TestApp(t, `byte "self"; int 4; box_create; assert
+int 1; pop; int 1`, ep)
+
+	// one update per opcode: intcblock, byte, int, box_create, assert, int, pop, int
+	require.Len(t, testDbg.boxes, 8)
+	for _, boxes := range testDbg.boxes[:4] {
+		require.Empty(t, boxes)
+	}
+	created := testDbg.boxes[4]
+	require.Equal(t, []DebugBox{{App: 888, Name: []byte("self"), Value: []byte{0, 0, 0, 0}}}, created)
+	// the boxes are not read again by the opcodes that follow
+	for _, boxes := range testDbg.boxes[5:] {
+		require.Same(t, &created[0], &boxes[0])
+	}
+}
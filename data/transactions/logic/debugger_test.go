@@ -17,6 +17,8 @@
 package logic
 
 import (
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/algorand/go-algorand/test/partitiontest"
@@ -94,3 +96,25 @@ func TestParseCallstack(t *testing.T) {
 	cfs := dState.parseCallstack(callstack)
 	require.Equal(t, expectedCallFrames, cfs)
 }
+
+func TestMakeDebugState(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ops, err := AssembleStringWithVersion(TestCallStackProgram, LogicVersion)
+	require.NoError(t, err)
+	ds := MakeDebugState(ops.Program, nil, 0, nil)
+	require.Equal(t, GetProgramID(ops.Program), ds.ExecID)
+	require.NotEmpty(t, ds.Disassembly)
+
+	var callsubs []int
+	for pc, location := range ops.OffsetToSource {
+		if strings.HasPrefix(strings.Split(TestCallStackProgram, "\n")[location.Line], "callsub") {
+			callsubs = append(callsubs, pc)
+		}
+	}
+	slices.Sort(callsubs)
+	require.Len(t, callsubs, 2)
+	require.Equal(t, "label1", ds.CallFrameAt(callsubs[0]).LabelName)
+	require.Equal(t, "label2", ds.CallFrameAt(callsubs[1]).LabelName)
+}
@@ -0,0 +1,288 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"fmt"
+
+	"github.com/algorand/go-algorand/data/transactions"
+)
+
+// programFlow is the control flow graph of a program, decoded from its bytecode. Its nodes are
+// the program's instructions, followed by a node for the end of the program, which branches that
+// target len(program) and the last instruction continue to.
+type programFlow struct {
+	program []byte
+	version uint64
+	mode    RunMode
+
+	instructions []flowInstruction
+	// index maps a pc to the index of the instruction that starts there, or -1.
+	index []int
+
+	// functions holds the main program and the subroutines that it may call, keyed by the index
+	// of their entry. order lists them so that callers come before their callees, except for
+	// recursive calls.
+	functions map[int]*flowFunction
+	order     []*flowFunction
+	// recursive holds the entries of the functions that may call themselves.
+	recursive map[int]bool
+}
+
+// flowInstruction is one node of a programFlow.
+type flowInstruction struct {
+	pc   int
+	spec *OpSpec // nil for the end of the program
+	// next is the index of the instruction that follows in the program.
+	next int
+	// targets are the indexes of the instructions that the instruction may branch to, or the
+	// subroutine it calls.
+	targets []int
+	// minCost and maxCost are the opcode cost of the instruction when its byte arguments are
+	// empty, and when they have the maximum length.
+	minCost int
+	maxCost int
+}
+
+// flowFunction is the main program, or a subroutine.
+type flowFunction struct {
+	entry int
+	// members are the instructions reachable from entry without entering a subroutine, in
+	// reverse postorder, so that the source of every edge that is not a back edge comes before
+	// its destination.
+	members []int
+	// loopHeads are the members that are the target of a back edge.
+	loopHeads map[int]bool
+	// callers are the reachable callsub instructions that call the function.
+	callers []int
+}
+
+// maxLengthStack is a stack of byte values of the maximum length, so that OpDetails.Cost returns
+// the worst-case cost of the opcodes whose cost depends on the length of their arguments.
+var maxLengthStack = func() []stackValue {
+	stack := make([]stackValue, 5)
+	for i := range stack {
+		stack[i].Bytes = make([]byte, maxStringSize)
+	}
+	return stack
+}()
+
+// makeProgramFlow decodes program, applying the same static checks as CheckContract and
+// CheckSignature, and builds its control flow graph.
+func makeProgramFlow(program []byte, mode RunMode) (*programFlow, error) {
+	version, vlen, err := transactions.ProgramVersion(program)
+	if err != nil {
+		return nil, err
+	}
+	if version > LogicVersion {
+		return nil, fmt.Errorf("program version %d greater than max supported version %d", version, LogicVersion)
+	}
+
+	cx := EvalContext{EvalParams: &EvalParams{}, runMode: mode}
+	cx.program = program
+	cx.version = version
+	cx.pc = vlen
+	cx.branchTargets = make([]bool, len(program)+1)
+	cx.instructionStarts = make([]bool, len(program)+1)
+
+	flow := &programFlow{
+		program:   program,
+		version:   version,
+		mode:      mode,
+		index:     make([]int, len(program)+1),
+		functions: make(map[int]*flowFunction),
+		recursive: make(map[int]bool),
+	}
+	for i := range flow.index {
+		flow.index[i] = -1
+	}
+	var targets [][]int
+	for cx.pc < len(program) {
+		pc := cx.pc
+		spec := &opsByOpcode[version][program[pc]]
+		if _, err := cx.checkStep(); err != nil {
+			return nil, fmt.Errorf("pc=%3d %w", cx.pc, err)
+		}
+		var branches []int
+		for i, imm := range spec.Immediates {
+			switch imm.kind {
+			case immLabel:
+				branches = append(branches, pc+1+i+2+decodeBranchOffset(program, pc+1+i))
+			case immLabels:
+				labels, _, err := parseLabels(program, pc+1+i)
+				if err != nil {
+					return nil, fmt.Errorf("pc=%3d %w", pc, err)
+				}
+				branches = append(branches, labels...)
+			}
+		}
+		flow.index[pc] = len(flow.instructions)
+		flow.instructions = append(flow.instructions, flowInstruction{
+			pc:      pc,
+			spec:    spec,
+			next:    len(flow.instructions) + 1,
+			minCost: spec.OpDetails.Cost(program, pc, blankStack),
+			maxCost: spec.OpDetails.Cost(program, pc, maxLengthStack),
+		})
+		targets = append(targets, branches)
+	}
+	flow.index[len(program)] = len(flow.instructions)
+	flow.instructions = append(flow.instructions, flowInstruction{pc: len(program), next: -1})
+
+	for i, branches := range targets {
+		for _, target := range branches {
+			if target < 0 || target > len(program) || flow.index[target] == -1 {
+				return nil, fmt.Errorf("pc=%3d branch target %d is not an instruction", flow.instructions[i].pc, target)
+			}
+			flow.instructions[i].targets = append(flow.instructions[i].targets, flow.index[target])
+		}
+	}
+
+	flow.findFunctions()
+	return flow, nil
+}
+
+// successors returns the instructions that may follow instruction i within its function. A
+// callsub continues to the next instruction, as if its subroutine returned, and retsub has no
+// successors.
+func (flow *programFlow) successors(i int) []int {
+	in := &flow.instructions[i]
+	if in.spec == nil {
+		return nil
+	}
+	switch in.spec.Name {
+	case "b":
+		return in.targets
+	case "bz", "bnz", "switch", "match":
+		return append([]int{in.next}, in.targets...)
+	case "return", "err", "retsub":
+		return nil
+	default:
+		return []int{in.next}
+	}
+}
+
+// isCall tells if instruction i is a callsub, and returns the entry of its subroutine.
+func (flow *programFlow) isCall(i int) (int, bool) {
+	in := &flow.instructions[i]
+	if in.spec == nil || in.spec.Name != "callsub" {
+		return 0, false
+	}
+	return in.targets[0], true
+}
+
+// isExit tells if the program ends at instruction i.
+func (flow *programFlow) isExit(i int) bool {
+	in := &flow.instructions[i]
+	return in.spec == nil || in.spec.Name == "return" || in.spec.Name == "err"
+}
+
+// mayApprove tells if the program may approve when it ends at instruction i.
+func (flow *programFlow) mayApprove(i int) bool {
+	in := &flow.instructions[i]
+	return in.spec == nil || in.spec.Name == "return"
+}
+
+// findFunctions finds the main program and the subroutines it may call, and orders them.
+func (flow *programFlow) findFunctions() {
+	main := flow.function(0)
+
+	// depth first search of the call graph, to order functions and find recursive ones
+	const (
+		unvisited = iota
+		active
+		done
+	)
+	state := make(map[int]int)
+	var postorder []*flowFunction
+	var visit func(f *flowFunction)
+	visit = func(f *flowFunction) {
+		state[f.entry] = active
+		for _, i := range f.members {
+			callee, ok := flow.isCall(i)
+			if !ok {
+				continue
+			}
+			g := flow.function(callee)
+			g.callers = append(g.callers, i)
+			switch state[callee] {
+			case unvisited:
+				visit(g)
+			case active:
+				flow.recursive[callee] = true
+			}
+		}
+		state[f.entry] = done
+		postorder = append(postorder, f)
+	}
+	visit(main)
+	for i := len(postorder) - 1; i >= 0; i-- {
+		flow.order = append(flow.order, postorder[i])
+	}
+}
+
+// function returns the function that starts at entry, finding its members on first use.
+func (flow *programFlow) function(entry int) *flowFunction {
+	if f, ok := flow.functions[entry]; ok {
+		return f
+	}
+	f := &flowFunction{entry: entry, loopHeads: make(map[int]bool)}
+	flow.functions[entry] = f
+
+	const (
+		unvisited = iota
+		active
+		done
+	)
+	state := make(map[int]int)
+	var postorder []int
+	var visit func(i int)
+	visit = func(i int) {
+		state[i] = active
+		for _, s := range flow.successors(i) {
+			switch state[s] {
+			case unvisited:
+				visit(s)
+			case active:
+				f.loopHeads[s] = true
+			}
+		}
+		state[i] = done
+		postorder = append(postorder, i)
+	}
+	visit(entry)
+	for i := len(postorder) - 1; i >= 0; i-- {
+		f.members = append(f.members, postorder[i])
+	}
+	return f
+}
+
+// main returns the main program.
+func (flow *programFlow) main() *flowFunction {
+	return flow.order[0]
+}
+
+// reachable tells, for each instruction, if any path from the start of the program reaches it.
+func (flow *programFlow) reachable() []bool {
+	reached := make([]bool, len(flow.instructions))
+	for _, f := range flow.order {
+		for _, i := range f.members {
+			reached[i] = true
+		}
+	}
+	return reached
+}
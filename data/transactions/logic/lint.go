@@ -0,0 +1,278 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// LintCheck names a check performed by Lint.
+type LintCheck string
+
+const (
+	// LintUnreachable reports instructions that no path from the start of the program reaches.
+	LintUnreachable LintCheck = "unreachable"
+	// LintRekeyTo reports LogicSig exits that may approve without reading the RekeyTo field.
+	LintRekeyTo LintCheck = "rekey-to"
+	// LintCloseRemainderTo reports LogicSig exits that may approve without reading the
+	// CloseRemainderTo field.
+	LintCloseRemainderTo LintCheck = "close-remainder-to"
+	// LintAssetCloseTo reports LogicSig exits that may approve without reading the AssetCloseTo
+	// field.
+	LintAssetCloseTo LintCheck = "asset-close-to"
+	// LintOnCompletion reports application exits that may approve without reading the
+	// OnCompletion field.
+	LintOnCompletion LintCheck = "on-completion"
+	// LintUnboundedLoop reports loops and recursive subroutines, whose cost is only limited by the
+	// opcode budget.
+	LintUnboundedLoop LintCheck = "unbounded-loop"
+)
+
+// LintChecks lists every check performed by Lint.
+var LintChecks = []LintCheck{
+	LintUnreachable, LintRekeyTo, LintCloseRemainderTo, LintAssetCloseTo, LintOnCompletion, LintUnboundedLoop,
+}
+
+// LintFinding is a potential problem found by Lint.
+type LintFinding struct {
+	// PC is the pc of the instruction the finding is about. It is the length of the program for
+	// paths that run past its last instruction.
+	PC      int
+	Check   LintCheck
+	Message string
+}
+
+// LintReport is the result of Lint.
+type LintReport struct {
+	// Findings are sorted by PC.
+	Findings []LintFinding
+	// Exits bounds the opcode cost of the paths that end at each exit of the program.
+	Exits []ExitCost
+}
+
+// lintedFields are the transaction fields that programs are expected to read before approving.
+var lintedFields = []struct {
+	field TxnField
+	check LintCheck
+	mode  RunMode
+}{
+	{RekeyTo, LintRekeyTo, ModeSig},
+	{CloseRemainderTo, LintCloseRemainderTo, ModeSig},
+	{AssetCloseTo, LintAssetCloseTo, ModeSig},
+	{OnCompletion, LintOnCompletion, ModeApp},
+}
+
+// Lint analyzes the bytecode of a program that runs in the given mode (ModeSig or ModeApp), and
+// reports constructs that are often mistakes, along with bounds on the cost of each of its
+// exits. Subroutines are analyzed once, for all of their callers, so findings in subroutines hold
+// for every call. The program must pass the static checks of CheckSignature or CheckContract,
+// except for the pre-v4 cost limit.
+//
+// A field counts as read if a txn, gtxn or gtxns opcode reads it on the path, for any
+// transaction of the group. Clear state programs cannot reject, so their OnCompletion findings
+// can be ignored.
+func Lint(program []byte, mode RunMode) (*LintReport, error) {
+	if mode != ModeSig && mode != ModeApp {
+		return nil, fmt.Errorf("cannot lint a program in mode %s", mode)
+	}
+	flow, err := makeProgramFlow(program, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &LintReport{Exits: flow.estimateCost().Exits}
+	report.Findings = append(report.Findings, flow.lintUnreachable()...)
+	report.Findings = append(report.Findings, flow.lintFields()...)
+	report.Findings = append(report.Findings, flow.lintLoops()...)
+	slices.SortStableFunc(report.Findings, func(a, b LintFinding) int { return a.PC - b.PC })
+	return report, nil
+}
+
+// lintUnreachable reports each run of unreachable instructions at its first instruction.
+func (flow *programFlow) lintUnreachable() []LintFinding {
+	reached := flow.reachable()
+	var findings []LintFinding
+	// the last instruction is the end of the program, which is not code
+	for i := 0; i < len(flow.instructions)-1; i++ {
+		if reached[i] {
+			continue
+		}
+		start := i
+		for i+1 < len(flow.instructions)-1 && !reached[i+1] {
+			i++
+		}
+		findings = append(findings, LintFinding{
+			PC:      flow.instructions[start].pc,
+			Check:   LintUnreachable,
+			Message: fmt.Sprintf("%d unreachable instruction(s), starting with %s", i-start+1, flow.instructions[start].spec.Name),
+		})
+	}
+	return findings
+}
+
+// lintLoops reports the heads of loops, and recursive subroutines.
+func (flow *programFlow) lintLoops() []LintFinding {
+	heads := make(map[int]bool)
+	for _, f := range flow.order {
+		for head := range f.loopHeads {
+			heads[head] = true
+		}
+	}
+	var findings []LintFinding
+	for _, head := range slices.Sorted(maps.Keys(heads)) {
+		findings = append(findings, LintFinding{
+			PC:      flow.instructions[head].pc,
+			Check:   LintUnboundedLoop,
+			Message: "loop cannot be bounded statically, so its cost is only limited by the opcode budget",
+		})
+	}
+	for _, f := range flow.order {
+		if flow.recursive[f.entry] {
+			findings = append(findings, LintFinding{
+				PC:      flow.instructions[f.entry].pc,
+				Check:   LintUnboundedLoop,
+				Message: "subroutine may call itself, so its cost is only limited by the opcode budget",
+			})
+		}
+	}
+	return findings
+}
+
+// readsField returns the transaction field that instruction i reads, if it is a txn, gtxn or gtxns.
+func (flow *programFlow) readsField(i int) (TxnField, bool) {
+	in := &flow.instructions[i]
+	if in.spec == nil {
+		return 0, false
+	}
+	switch in.spec.Name {
+	case "txn", "gtxn", "gtxns":
+	default:
+		return 0, false
+	}
+	for j, imm := range in.spec.Immediates {
+		if imm.Group == &TxnScalarFields {
+			return TxnField(flow.program[in.pc+1+j]), true
+		}
+	}
+	return 0, false
+}
+
+// lintFields reports the approving exits that some path reaches without reading the fields in
+// lintedFields that apply to the program.
+func (flow *programFlow) lintFields() []LintFinding {
+	var fields []int
+	for k, lf := range lintedFields {
+		if lf.mode != flow.mode {
+			continue
+		}
+		if lf.field == RekeyTo && flow.version < rekeyingEnabledVersion {
+			// v1 LogicSigs cannot approve transactions that rekey
+			continue
+		}
+		fields = append(fields, k)
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+
+	read := flow.fieldsRead()
+	var findings []LintFinding
+	for i := range flow.instructions {
+		if read[i] == nil || !flow.mayApprove(i) {
+			continue
+		}
+		for _, k := range fields {
+			if (*read[i])&(1<<k) != 0 {
+				continue
+			}
+			where := "the end of the program"
+			if spec := flow.instructions[i].spec; spec != nil {
+				where = spec.Name
+			}
+			findings = append(findings, LintFinding{
+				PC:      flow.instructions[i].pc,
+				Check:   lintedFields[k].check,
+				Message: fmt.Sprintf("a path may reach %s and approve without reading %s", where, lintedFields[k].field),
+			})
+		}
+	}
+	return findings
+}
+
+// fieldsRead computes, for each instruction, the set of lintedFields (as bits of their index) that
+// every path from the start of the program reads before reaching it. It is nil for unreachable
+// instructions. Paths enter subroutines at callsub, and leave them at retsub to every place the
+// subroutine returns to.
+func (flow *programFlow) fieldsRead() []*uint64 {
+	returns := make(map[int][]int)
+	for _, f := range flow.order {
+		var sites []int
+		for _, caller := range f.callers {
+			sites = append(sites, flow.instructions[caller].next)
+		}
+		for _, i := range f.members {
+			if in := &flow.instructions[i]; in.spec != nil && in.spec.Name == "retsub" {
+				returns[i] = append(returns[i], sites...)
+			}
+		}
+	}
+	successors := func(i int) []int {
+		if callee, ok := flow.isCall(i); ok {
+			return []int{callee}
+		}
+		if sites, ok := returns[i]; ok {
+			return sites
+		}
+		return flow.successors(i)
+	}
+	gen := func(i int) uint64 {
+		field, ok := flow.readsField(i)
+		if !ok {
+			return 0
+		}
+		var bits uint64
+		for k, lf := range lintedFields {
+			if lf.field == field {
+				bits |= 1 << k
+			}
+		}
+		return bits
+	}
+
+	read := make([]*uint64, len(flow.instructions))
+	start := uint64(0)
+	read[flow.main().entry] = &start
+	work := []int{flow.main().entry}
+	for len(work) > 0 {
+		i := work[len(work)-1]
+		work = work[:len(work)-1]
+		out := *read[i] | gen(i)
+		for _, s := range successors(i) {
+			if read[s] == nil {
+				in := out
+				read[s] = &in
+				work = append(work, s)
+			} else if *read[s]&out != *read[s] {
+				*read[s] &= out
+				work = append(work, s)
+			}
+		}
+	}
+	return read
+}
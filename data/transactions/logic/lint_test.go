@@ -0,0 +1,230 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/stretchr/testify/require"
+)
+
+// lintSource assembles and lints source, and returns its findings as "check@line" strings, with
+// lines counted from 1, and "end" for the end of the program.
+func lintSource(t *testing.T, source string, mode RunMode) ([]string, *LintReport) {
+	t.Helper()
+	ops, err := AssembleString(source)
+	require.NoError(t, err)
+	report, err := Lint(ops.Program, mode)
+	require.NoError(t, err)
+	findings := make([]string, 0, len(report.Findings))
+	for _, finding := range report.Findings {
+		findings = append(findings, fmt.Sprintf("%s@%s", finding.Check, lintLine(ops, finding.PC)))
+	}
+	return findings, report
+}
+
+func lintLine(ops *OpStream, pc int) string {
+	if pc == len(ops.Program) {
+		return "end"
+	}
+	return fmt.Sprint(ops.OffsetToSource[pc].Line + 1)
+}
+
+// lintPC returns the pc of the opcode on a source line, counted from 1.
+func lintPC(t *testing.T, ops *OpStream, line int) int {
+	t.Helper()
+	for pc, location := range ops.OffsetToSource {
+		if location.Line == line-1 {
+			return pc
+		}
+	}
+	require.FailNow(t, "no opcode on line", line)
+	return 0
+}
+
+func TestLintUnreachable(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	findings, _ := lintSource(t, `#pragma version 8
+txn OnCompletion
+callsub used
+return
+pushint 2
+pop
+used:
+retsub
+unused:
+pushint 3
+retsub`, ModeApp)
+	require.Equal(t, []string{"unreachable@5", "unreachable@10"}, findings)
+
+	// subroutines are reachable through their callers, and so is the code they return to
+	findings, _ = lintSource(t, `#pragma version 8
+txn OnCompletion
+callsub sub
+pushint 1
+return
+sub:
+retsub`, ModeApp)
+	require.Empty(t, findings)
+}
+
+func TestLintLogicSigFields(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	findings, _ := lintSource(t, `#pragma version 8
+txn RekeyTo
+global ZeroAddress
+==
+assert
+pushint 1`, ModeSig)
+	require.Equal(t, []string{"close-remainder-to@end", "asset-close-to@end"}, findings)
+
+	// only the path that skips the checks is reported
+	findings, _ = lintSource(t, `#pragma version 8
+txn TypeEnum
+int appl
+==
+bnz skip
+txn RekeyTo
+global ZeroAddress
+==
+gtxn 0 CloseRemainderTo
+global ZeroAddress
+==
+&&
+pushint 0
+gtxns AssetCloseTo
+global ZeroAddress
+==
+&&
+return
+skip:
+pushint 1
+return`, ModeSig)
+	require.Equal(t, []string{"rekey-to@21", "close-remainder-to@21", "asset-close-to@21"}, findings)
+
+	// fields read in a subroutine count for the code that it returns to
+	findings, _ = lintSource(t, `#pragma version 8
+callsub check
+pushint 1
+return
+check:
+txn RekeyTo
+txn CloseRemainderTo
+txn AssetCloseTo
+popn 3
+retsub`, ModeSig)
+	require.Empty(t, findings)
+
+	// v1 LogicSigs cannot rekey
+	findings, _ = lintSource(t, `#pragma version 1
+txn CloseRemainderTo
+txn AssetCloseTo
+==`, ModeSig)
+	require.Empty(t, findings)
+
+	// err never approves
+	findings, _ = lintSource(t, "#pragma version 8\nerr", ModeSig)
+	require.Empty(t, findings)
+}
+
+func TestLintOnCompletion(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	findings, _ := lintSource(t, `#pragma version 8
+txn OnCompletion
+int NoOp
+==`, ModeApp)
+	require.Empty(t, findings)
+
+	findings, _ = lintSource(t, `#pragma version 8
+txn ApplicationID
+bz create
+txn OnCompletion
+int NoOp
+==
+return
+create:
+pushint 1`, ModeApp)
+	require.Equal(t, []string{"on-completion@end"}, findings)
+
+	// LogicSigs are not checked for OnCompletion, nor apps for the LogicSig fields
+	findings, _ = lintSource(t, "#pragma version 8\npushint 1", ModeApp)
+	require.Equal(t, []string{"on-completion@end"}, findings)
+}
+
+func TestLintLoops(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	findings, report := lintSource(t, `#pragma version 8
+txn OnCompletion
+pushint 3
+loop:
+pushint 1
+-
+dup
+bnz loop
+return`, ModeApp)
+	require.Equal(t, []string{"unbounded-loop@5"}, findings)
+	// txn, pushint, pushint, -, dup, bnz, return
+	require.Equal(t, []ExitCost{{PC: report.Exits[0].PC, Op: "return", Min: 7, Bounded: false}}, report.Exits)
+
+	findings, report = lintSource(t, `#pragma version 8
+txn OnCompletion
+pushint 3
+callsub countdown
+return
+countdown:
+proto 1 1
+frame_dig -1
+bz done
+frame_dig -1
+pushint 1
+-
+callsub countdown
+retsub
+done:
+pushint 1
+retsub`, ModeApp)
+	require.Equal(t, []string{"unbounded-loop@7"}, findings)
+	require.Len(t, report.Exits, 1)
+	require.False(t, report.Exits[0].Bounded)
+}
+
+func TestLintInvalidProgram(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	_, err := Lint([]byte{0x08, 0xff}, ModeApp)
+	require.ErrorContains(t, err, "illegal opcode")
+
+	// app opcodes in a LogicSig
+	ops, err := AssembleString("#pragma version 8\nbyte \"k\"\napp_global_get")
+	require.NoError(t, err)
+	_, err = Lint(ops.Program, ModeSig)
+	require.ErrorContains(t, err, "not allowed in current mode")
+
+	_, err = Lint(ops.Program, modeAny)
+	require.ErrorContains(t, err, "cannot lint")
+}
@@ -0,0 +1,437 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package signreq implements signing requests, which carry a group of
+// transactions between the machines which sign it, typically offline ones.
+// A request holds the unsigned group, the accounts which must authorize its
+// transactions, and the signatures collected so far. Each signer adds their
+// signatures to a copy of the request, the copies are merged, and once
+// every transaction is authorized the request is finalized into a signed
+// group ready to be sent.
+package signreq
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/algorand/go-algorand/config/bounds"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// Version is the version of the signing requests this package makes
+const Version = 1
+
+var errVersion = fmt.Errorf("unsupported signing request version")
+var errEmptyGroup = fmt.Errorf("signing request has no transactions")
+var errGroupTooLarge = fmt.Errorf("signing request has too many transactions")
+var errGroupMismatch = fmt.Errorf("group ID does not match the transactions of the group")
+var errLogicSig = fmt.Errorf("transactions authorized by logic signatures cannot be part of a signing request")
+var errRoster = fmt.Errorf("signing request roster does not match its transactions")
+var errNothingToSign = fmt.Errorf("no transaction of the signing request can be signed by these keys")
+var errMismatch = fmt.Errorf("signing requests are for different transactions")
+var errConflict = fmt.Errorf("signing requests carry different signatures by the same key")
+
+// Request is a signing request
+type Request struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Version uint64 `codec:"v"`
+
+	// Txns are the transactions of the group. The transactions of multisig
+	// accounts carry the preimage of the account in Msig, with the
+	// signatures collected so far.
+	Txns []transactions.SignedTxn `codec:"txns"`
+
+	// Roster lists the accounts which authorize the transactions, in the
+	// order they first appear in
+	Roster []Account `codec:"roster"`
+}
+
+// Account is an account which authorizes transactions of a request. The
+// Multisig preimage of a multisig account has no signatures; it is blank for
+// single key accounts.
+type Account struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Address  basics.Address     `codec:"addr"`
+	Multisig crypto.MultisigSig `codec:"msig"`
+}
+
+// SignFunc signs the transaction of stxn with the key pk
+type SignFunc func(pk crypto.PublicKey, stxn transactions.SignedTxn) (crypto.Signature, error)
+
+// New makes a signing request for stxns, which are given a group ID if they
+// have none. msigs are the preimages of the multisig accounts which
+// authorize transactions that do not carry one already.
+func New(stxns []transactions.SignedTxn, msigs []crypto.MultisigSig) (*Request, error) {
+	if len(stxns) == 0 {
+		return nil, errEmptyGroup
+	}
+	if len(stxns) > bounds.MaxTxGroupSize {
+		return nil, errGroupTooLarge
+	}
+
+	r := &Request{Version: Version, Txns: make([]transactions.SignedTxn, len(stxns))}
+	for i, stxn := range stxns {
+		r.Txns[i] = stxn
+		r.Txns[i].Msig = copyMultisig(stxn.Msig)
+	}
+
+	ungrouped := true
+	for _, stxn := range r.Txns {
+		ungrouped = ungrouped && stxn.Txn.Group.IsZero()
+	}
+	if ungrouped && len(r.Txns) > 1 {
+		gid := groupID(r.Txns)
+		for i := range r.Txns {
+			r.Txns[i].Txn.Group = gid
+		}
+	}
+
+	for i := range r.Txns {
+		stxn := &r.Txns[i]
+		if !stxn.Lsig.Blank() {
+			return nil, errLogicSig
+		}
+		if !stxn.Msig.Blank() {
+			continue
+		}
+		for _, msig := range msigs {
+			if multisigAddress(msig) == stxn.Authorizer() {
+				stxn.Msig = preimage(msig)
+				break
+			}
+		}
+	}
+
+	for _, stxn := range r.Txns {
+		if slices.ContainsFunc(r.Roster, func(a Account) bool { return a.Address == stxn.Authorizer() }) {
+			continue
+		}
+		acct := Account{Address: stxn.Authorizer()}
+		if !stxn.Msig.Blank() {
+			acct.Multisig = preimage(stxn.Msig)
+		}
+		r.Roster = append(r.Roster, acct)
+	}
+
+	err := r.check()
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Decode decodes a signing request and checks that it is consistent
+func Decode(data []byte) (*Request, error) {
+	var r Request
+	err := protocol.DecodeReflect(data, &r)
+	if err != nil {
+		return nil, err
+	}
+	if r.Version != Version {
+		return nil, errVersion
+	}
+	err = r.check()
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// Encode encodes the signing request
+func (r *Request) Encode() []byte {
+	return protocol.EncodeReflect(r)
+}
+
+// check checks that the transactions form a group, that the roster lists
+// the accounts which authorize them, and that the signatures they carry are
+// valid
+func (r *Request) check() error {
+	if len(r.Txns) == 0 {
+		return errEmptyGroup
+	}
+	if len(r.Txns) > bounds.MaxTxGroupSize {
+		return errGroupTooLarge
+	}
+	if len(r.Txns) > 1 || !r.Txns[0].Txn.Group.IsZero() {
+		for _, stxn := range r.Txns {
+			if stxn.Txn.Group != r.Txns[0].Txn.Group {
+				return errGroupMismatch
+			}
+		}
+		if groupID(r.Txns) != r.Txns[0].Txn.Group {
+			return errGroupMismatch
+		}
+	}
+
+	for i, stxn := range r.Txns {
+		if !stxn.Lsig.Blank() {
+			return errLogicSig
+		}
+		acct, ok := r.account(stxn.Authorizer())
+		if !ok || !acct.Multisig.Equal(preimage(stxn.Msig)) {
+			return errRoster
+		}
+		if !acct.Multisig.Blank() && multisigAddress(acct.Multisig) != acct.Address {
+			return errRoster
+		}
+		err := verifySignatures(stxn)
+		if err != nil {
+			return fmt.Errorf("transaction %d: %w", i, err)
+		}
+	}
+	for _, acct := range r.Roster {
+		if !slices.ContainsFunc(r.Txns, func(stxn transactions.SignedTxn) bool { return stxn.Authorizer() == acct.Address }) {
+			return errRoster
+		}
+	}
+	return nil
+}
+
+// account returns the account of the roster at addr
+func (r *Request) account(addr basics.Address) (Account, bool) {
+	for _, acct := range r.Roster {
+		if acct.Address == addr {
+			return acct, true
+		}
+	}
+	return Account{}, false
+}
+
+// Keys returns the keys which can sign transactions of the request, in the
+// order of the roster
+func (r *Request) Keys() []crypto.PublicKey {
+	var keys []crypto.PublicKey
+	for _, acct := range r.Roster {
+		if acct.Multisig.Blank() {
+			keys = append(keys, crypto.PublicKey(acct.Address))
+			continue
+		}
+		for _, subsig := range acct.Multisig.Subsigs {
+			if !slices.Contains(keys, subsig.Key) {
+				keys = append(keys, subsig.Key)
+			}
+		}
+	}
+	return keys
+}
+
+// Sign adds the signatures which keys still have to make, made by sign, and
+// returns how many it added. It is an error if keys have nothing to sign.
+func (r *Request) Sign(keys []crypto.PublicKey, sign SignFunc) (int, error) {
+	signed := 0
+	for i := range r.Txns {
+		stxn := &r.Txns[i]
+		if stxn.Msig.Blank() {
+			pk := crypto.PublicKey(stxn.Authorizer())
+			if !stxn.Sig.Blank() || !slices.Contains(keys, pk) {
+				continue
+			}
+			sig, err := signWith(sign, pk, *stxn)
+			if err != nil {
+				return signed, fmt.Errorf("transaction %d: %w", i, err)
+			}
+			stxn.Sig = sig
+			signed++
+			continue
+		}
+
+		for j := range stxn.Msig.Subsigs {
+			subsig := &stxn.Msig.Subsigs[j]
+			if !subsig.Sig.Blank() || !slices.Contains(keys, subsig.Key) {
+				continue
+			}
+			sig, err := signWith(sign, subsig.Key, *stxn)
+			if err != nil {
+				return signed, fmt.Errorf("transaction %d: %w", i, err)
+			}
+			subsig.Sig = sig
+			signed++
+		}
+	}
+	if signed == 0 {
+		return 0, errNothingToSign
+	}
+	return signed, nil
+}
+
+// signWith signs stxn with pk, and checks the signature
+func signWith(sign SignFunc, pk crypto.PublicKey, stxn transactions.SignedTxn) (crypto.Signature, error) {
+	sig, err := sign(pk, stxn)
+	if err != nil {
+		return crypto.Signature{}, err
+	}
+	if !crypto.SignatureVerifier(pk).Verify(stxn.Txn, sig) {
+		return crypto.Signature{}, fmt.Errorf("invalid signature by %s", basics.Address(pk))
+	}
+	return sig, nil
+}
+
+// Merge returns a request with the signatures of all of reqs, which must be
+// copies of the same request
+func Merge(reqs ...*Request) (*Request, error) {
+	if len(reqs) == 0 {
+		return nil, errEmptyGroup
+	}
+	merged := &Request{Version: Version, Roster: reqs[0].Roster}
+	merged.Txns = make([]transactions.SignedTxn, len(reqs[0].Txns))
+	for i, stxn := range reqs[0].Txns {
+		merged.Txns[i] = stxn
+		merged.Txns[i].Msig = copyMultisig(stxn.Msig)
+	}
+
+	for _, r := range reqs[1:] {
+		if len(r.Txns) != len(merged.Txns) || len(r.Roster) != len(merged.Roster) {
+			return nil, errMismatch
+		}
+		for i, acct := range r.Roster {
+			if acct.Address != merged.Roster[i].Address || !acct.Multisig.Equal(merged.Roster[i].Multisig) {
+				return nil, errMismatch
+			}
+		}
+		for i, stxn := range r.Txns {
+			m := &merged.Txns[i]
+			if stxn.ID() != m.ID() || stxn.AuthAddr != m.AuthAddr {
+				return nil, errMismatch
+			}
+			if m.Sig.Blank() {
+				m.Sig = stxn.Sig
+			} else if !stxn.Sig.Blank() && stxn.Sig != m.Sig {
+				return nil, errConflict
+			}
+			if m.Msig.Blank() {
+				continue
+			}
+			msig, err := crypto.MultisigMerge(m.Msig, stxn.Msig)
+			if err != nil {
+				return nil, errConflict
+			}
+			m.Msig = msig
+		}
+	}
+	return merged, nil
+}
+
+// Finalize returns the signed group, once every transaction of the request
+// is authorized. Multisig transactions keep the signatures beyond their
+// threshold.
+func (r *Request) Finalize() ([]transactions.SignedTxn, error) {
+	stxns := make([]transactions.SignedTxn, len(r.Txns))
+	for i, stxn := range r.Txns {
+		if stxn.Msig.Blank() {
+			if stxn.Sig.Blank() {
+				return nil, fmt.Errorf("transaction %d is not signed by %s", i, stxn.Authorizer())
+			}
+		} else if stxn.Msig.Signatures() < int(stxn.Msig.Threshold) {
+			return nil, fmt.Errorf("transaction %d has %d of the %d signatures %s needs", i, stxn.Msig.Signatures(), stxn.Msig.Threshold, stxn.Authorizer())
+		}
+		err := verifySignatures(stxn)
+		if err != nil {
+			return nil, fmt.Errorf("transaction %d: %w", i, err)
+		}
+		stxns[i] = stxn
+		stxns[i].Msig = copyMultisig(stxn.Msig)
+	}
+	return stxns, nil
+}
+
+// verifySignatures checks the signatures stxn carries, which may not be
+// enough to authorize it yet
+func verifySignatures(stxn transactions.SignedTxn) error {
+	if !stxn.Sig.Blank() {
+		if !stxn.Msig.Blank() {
+			return fmt.Errorf("both a signature and a multisig signature")
+		}
+		if !crypto.SignatureVerifier(stxn.Authorizer()).Verify(stxn.Txn, stxn.Sig) {
+			return fmt.Errorf("invalid signature by %s", stxn.Authorizer())
+		}
+	}
+	for _, subsig := range stxn.Msig.Subsigs {
+		if !subsig.Sig.Blank() && !crypto.SignatureVerifier(subsig.Key).Verify(stxn.Txn, subsig.Sig) {
+			return fmt.Errorf("invalid signature by %s", basics.Address(subsig.Key))
+		}
+	}
+	return nil
+}
+
+// ParseMultisig parses the preimage of a multisig account from its
+// threshold and the addresses of its keys, separated by spaces, as in
+// "2 ADDR1 ADDR2 ADDR3"
+func ParseMultisig(params string) (crypto.MultisigSig, error) {
+	fields := strings.Fields(params)
+	if len(fields) < 3 {
+		return crypto.MultisigSig{}, fmt.Errorf("multisig %q needs a threshold and at least 2 addresses", params)
+	}
+	threshold, err := strconv.ParseUint(fields[0], 10, 8)
+	if err != nil || threshold < 1 {
+		return crypto.MultisigSig{}, fmt.Errorf("multisig %q has an invalid threshold", params)
+	}
+	pks := make([]crypto.PublicKey, len(fields)-1)
+	for i, addrStr := range fields[1:] {
+		addr, err := basics.UnmarshalChecksumAddress(addrStr)
+		if err != nil {
+			return crypto.MultisigSig{}, err
+		}
+		pks[i] = crypto.PublicKey(addr)
+	}
+	_, err = crypto.MultisigAddrGen(1, uint8(threshold), pks)
+	if err != nil {
+		return crypto.MultisigSig{}, err
+	}
+	return crypto.MultisigPreimageFromPKs(1, uint8(threshold), pks), nil
+}
+
+// groupID returns the group ID of stxns
+func groupID(stxns []transactions.SignedTxn) crypto.Digest {
+	var group transactions.TxGroup
+	for _, stxn := range stxns {
+		tx := stxn.Txn
+		tx.Group = crypto.Digest{}
+		group.TxGroupHashes = append(group.TxGroupHashes, crypto.Digest(tx.ID()))
+	}
+	return crypto.HashObj(group)
+}
+
+// multisigAddress returns the address of the multisig account of msig, or
+// the zero address if msig is not valid
+func multisigAddress(msig crypto.MultisigSig) basics.Address {
+	version, threshold, pks := msig.Preimage()
+	addr, err := crypto.MultisigAddrGen(version, threshold, pks)
+	if err != nil {
+		return basics.Address{}
+	}
+	return basics.Address(addr)
+}
+
+// preimage returns msig without its signatures
+func preimage(msig crypto.MultisigSig) crypto.MultisigSig {
+	if msig.Blank() {
+		return crypto.MultisigSig{}
+	}
+	return crypto.MultisigPreimageFromPKs(msig.Preimage())
+}
+
+// copyMultisig returns a copy of msig which does not share its subsigs
+func copyMultisig(msig crypto.MultisigSig) crypto.MultisigSig {
+	msig.Subsigs = slices.Clone(msig.Subsigs)
+	return msig
+}
@@ -0,0 +1,157 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package signreq
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/txntest"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// testSigner returns a SignFunc which signs with secrets
+func testSigner(secrets ...*crypto.SignatureSecrets) SignFunc {
+	return func(pk crypto.PublicKey, stxn transactions.SignedTxn) (crypto.Signature, error) {
+		for _, s := range secrets {
+			if crypto.PublicKey(s.SignatureVerifier) == pk {
+				return s.Sign(stxn.Txn), nil
+			}
+		}
+		return crypto.Signature{}, nil
+	}
+}
+
+func TestSigningRequest(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var keys []*crypto.SignatureSecrets
+	var pks []crypto.PublicKey
+	for i := 0; i < 4; i++ {
+		s := crypto.GenerateSignatureSecrets(crypto.Seed{byte(i + 1)})
+		keys = append(keys, s)
+		pks = append(pks, crypto.PublicKey(s.SignatureVerifier))
+	}
+	single := basics.Address(pks[0])
+
+	// A 2 of 3 multisig account of the other keys
+	msig := crypto.MultisigPreimageFromPKs(1, 2, pks[1:])
+	msigDigest, err := crypto.MultisigAddrGen(1, 2, pks[1:])
+	require.NoError(t, err)
+	msigAddr := basics.Address(msigDigest)
+
+	stxns := []transactions.SignedTxn{
+		{Txn: txntest.Txn{Type: protocol.PaymentTx, Sender: single, Receiver: msigAddr, Amount: 1500000, Fee: 1000, FirstValid: 10, LastValid: 1010}.Txn()},
+		{Txn: txntest.Txn{Type: protocol.AssetTransferTx, Sender: msigAddr, AssetReceiver: single, XferAsset: 7, AssetAmount: 5, Fee: 1000, FirstValid: 10, LastValid: 1010}.Txn()},
+	}
+	r, err := New(stxns, []crypto.MultisigSig{msig})
+	require.NoError(t, err)
+	require.False(t, r.Txns[0].Txn.Group.IsZero())
+	require.Equal(t, []Account{{Address: single}, {Address: msigAddr, Multisig: msig}}, r.Roster)
+	require.Equal(t, pks, r.Keys())
+
+	summary := r.Summary()
+	require.Contains(t, summary, "pays 1.500000 Algos from "+single.String())
+	require.Contains(t, summary, "transfers 5 units of asset 7")
+	require.Contains(t, summary, "pending, 0 of 2 signatures")
+
+	// Requests survive being written out
+	decoded, err := Decode(r.Encode())
+	require.NoError(t, err)
+	require.Equal(t, r.Encode(), decoded.Encode())
+
+	_, err = r.Finalize()
+	require.Error(t, err)
+	_, err = decoded.Sign([]crypto.PublicKey{{}}, testSigner())
+	require.Equal(t, errNothingToSign, err)
+
+	// Two copies are signed apart and merged
+	n, err := r.Sign([]crypto.PublicKey{pks[0], pks[1]}, testSigner(keys[0], keys[1]))
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	_, err = r.Finalize()
+	require.Error(t, err)
+
+	n, err = decoded.Sign([]crypto.PublicKey{pks[3]}, testSigner(keys[3]))
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+
+	merged, err := Merge(r, decoded)
+	require.NoError(t, err)
+	require.Contains(t, merged.Summary(), "complete, 2 of 2 signatures")
+	signed, err := merged.Finalize()
+	require.NoError(t, err)
+	require.True(t, crypto.SignatureVerifier(pks[0]).Verify(signed[0].Txn, signed[0].Sig))
+	require.NoError(t, crypto.MultisigVerify(signed[1].Txn, msigDigest, signed[1].Msig))
+
+	// Merging left the requests alone
+	require.Equal(t, 1, decoded.Txns[1].Msig.Signatures())
+
+	// Bad signatures are caught
+	bad, err := Decode(decoded.Encode())
+	require.NoError(t, err)
+	_, err = bad.Sign([]crypto.PublicKey{pks[0]}, testSigner(keys[1]))
+	require.Error(t, err)
+	bad.Txns[1].Msig.Subsigs[2].Sig = keys[3].Sign(stxns[1].Txn)
+	_, err = Decode(bad.Encode())
+	require.Error(t, err)
+
+	// Requests for other transactions do not merge
+	other, err := New(stxns[:1], nil)
+	require.NoError(t, err)
+	_, err = Merge(r, other)
+	require.Equal(t, errMismatch, err)
+}
+
+func TestSigningRequestGroup(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var a, b basics.Address
+	crypto.RandBytes(a[:])
+	crypto.RandBytes(b[:])
+	stxns := []transactions.SignedTxn{
+		{Txn: txntest.Txn{Type: protocol.PaymentTx, Sender: a, Receiver: b}.Txn()},
+		{Txn: txntest.Txn{Type: protocol.PaymentTx, Sender: b, Receiver: a}.Txn()},
+	}
+
+	// A group which was already formed is kept, but must be complete
+	r, err := New(stxns, nil)
+	require.NoError(t, err)
+	_, err = New(r.Txns, nil)
+	require.NoError(t, err)
+	_, err = New(r.Txns[:1], nil)
+	require.Equal(t, errGroupMismatch, err)
+
+	// Multisig preimages must be those of the authorizer
+	msig, err := ParseMultisig("1 " + a.String() + " " + b.String())
+	require.NoError(t, err)
+	stxns[0].Msig = msig
+	_, err = New(stxns, nil)
+	require.Equal(t, errRoster, err)
+
+	_, err = ParseMultisig("1 " + a.String())
+	require.Error(t, err)
+	_, err = New(nil, nil)
+	require.Equal(t, errEmptyGroup, err)
+}
@@ -0,0 +1,179 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package signreq
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// Summary describes the request for the people who sign it: what each
+// transaction does, and who has signed it so far
+func (r *Request) Summary() string {
+	var b strings.Builder
+	if r.Txns[0].Txn.Group.IsZero() {
+		fmt.Fprintf(&b, "Signing request for 1 transaction\n")
+	} else {
+		fmt.Fprintf(&b, "Signing request for a group of %d transactions\n", len(r.Txns))
+		fmt.Fprintf(&b, "Group ID: %s\n", r.Txns[0].Txn.Group)
+	}
+
+	for i, stxn := range r.Txns {
+		tx := stxn.Txn
+		fmt.Fprintf(&b, "\n[%d] %s\n", i, stxn.ID())
+		for _, effect := range effects(tx) {
+			fmt.Fprintf(&b, "    %s\n", effect)
+		}
+		if !tx.RekeyTo.IsZero() {
+			fmt.Fprintf(&b, "    WARNING: rekeys %s to %s\n", tx.Sender, tx.RekeyTo)
+		}
+		fmt.Fprintf(&b, "    fee %s, valid rounds %d-%d", formatAlgos(tx.Fee), tx.FirstValid, tx.LastValid)
+		if tx.GenesisID != "" {
+			fmt.Fprintf(&b, " on %s", tx.GenesisID)
+		}
+		fmt.Fprintf(&b, "\n")
+		if len(tx.Note) > 0 {
+			fmt.Fprintf(&b, "    note: %d bytes\n", len(tx.Note))
+		}
+		fmt.Fprintf(&b, "    %s\n", signatureStatus(stxn))
+	}
+
+	fmt.Fprintf(&b, "\nSigners:\n")
+	for _, acct := range r.Roster {
+		if acct.Multisig.Blank() {
+			fmt.Fprintf(&b, "  %s\n", acct.Address)
+			continue
+		}
+		fmt.Fprintf(&b, "  %s (multisig, %d of %d)\n", acct.Address, acct.Multisig.Threshold, len(acct.Multisig.Subsigs))
+		for _, subsig := range acct.Multisig.Subsigs {
+			fmt.Fprintf(&b, "    %s\n", basics.Address(subsig.Key))
+		}
+	}
+	return b.String()
+}
+
+// effects describes what tx does
+func effects(tx transactions.Transaction) []string {
+	switch tx.Type {
+	case protocol.PaymentTx:
+		out := []string{fmt.Sprintf("pays %s from %s to %s", formatAlgos(tx.Amount), tx.Sender, tx.Receiver)}
+		if !tx.CloseRemainderTo.IsZero() {
+			out = append(out, fmt.Sprintf("WARNING: closes %s, sending all its remaining Algos to %s", tx.Sender, tx.CloseRemainderTo))
+		}
+		return out
+
+	case protocol.AssetTransferTx:
+		var out []string
+		switch {
+		case !tx.AssetSender.IsZero():
+			out = append(out, fmt.Sprintf("claws back %d units of asset %d from %s to %s", tx.AssetAmount, tx.XferAsset, tx.AssetSender, tx.AssetReceiver))
+		case tx.AssetAmount == 0 && tx.AssetReceiver == tx.Sender:
+			out = append(out, fmt.Sprintf("opts %s in to asset %d", tx.Sender, tx.XferAsset))
+		default:
+			out = append(out, fmt.Sprintf("transfers %d units of asset %d from %s to %s", tx.AssetAmount, tx.XferAsset, tx.Sender, tx.AssetReceiver))
+		}
+		if !tx.AssetCloseTo.IsZero() {
+			out = append(out, fmt.Sprintf("WARNING: closes the holding of asset %d of %s, sending all its remaining units to %s", tx.XferAsset, tx.Sender, tx.AssetCloseTo))
+		}
+		return out
+
+	case protocol.AssetConfigTx:
+		switch {
+		case tx.ConfigAsset == 0:
+			return []string{fmt.Sprintf("creates asset %q (%s), total %d with %d decimals, managed by %s", tx.AssetParams.AssetName, tx.AssetParams.UnitName, tx.AssetParams.Total, tx.AssetParams.Decimals, tx.AssetParams.Manager)}
+		case tx.AssetParams == basics.AssetParams{}:
+			return []string{fmt.Sprintf("WARNING: destroys asset %d", tx.ConfigAsset)}
+		default:
+			return []string{fmt.Sprintf("reconfigures asset %d: manager %s, reserve %s, freeze %s, clawback %s", tx.ConfigAsset, tx.AssetParams.Manager, tx.AssetParams.Reserve, tx.AssetParams.Freeze, tx.AssetParams.Clawback)}
+		}
+
+	case protocol.AssetFreezeTx:
+		verb := "unfreezes"
+		if tx.AssetFrozen {
+			verb = "freezes"
+		}
+		return []string{fmt.Sprintf("%s asset %d of %s", verb, tx.FreezeAsset, tx.FreezeAccount)}
+
+	case protocol.ApplicationCallTx:
+		var out []string
+		if tx.ApplicationID == 0 {
+			out = append(out, fmt.Sprintf("creates an application, with %s", tx.OnCompletion))
+		} else {
+			out = append(out, fmt.Sprintf("calls application %d with %s", tx.ApplicationID, tx.OnCompletion))
+		}
+		if len(tx.ApplicationArgs) > 0 {
+			out = append(out, fmt.Sprintf("%d arguments", len(tx.ApplicationArgs)))
+		}
+		if tx.ApplicationID != 0 && (tx.OnCompletion == transactions.UpdateApplicationOC || tx.OnCompletion == transactions.DeleteApplicationOC) {
+			out[0] = "WARNING: " + out[0]
+		}
+		return out
+
+	case protocol.KeyRegistrationTx:
+		switch {
+		case tx.Nonparticipation:
+			return []string{fmt.Sprintf("WARNING: marks %s as never participating again", tx.Sender)}
+		case tx.VotePK == crypto.OneTimeSignatureVerifier{}:
+			return []string{fmt.Sprintf("takes %s offline", tx.Sender)}
+		default:
+			return []string{fmt.Sprintf("registers participation keys for %s, valid rounds %d-%d", tx.Sender, tx.VoteFirst, tx.VoteLast)}
+		}
+	}
+	return []string{fmt.Sprintf("%s transaction from %s", tx.Type, tx.Sender)}
+}
+
+// signatureStatus describes who has signed stxn, and who still may
+func signatureStatus(stxn transactions.SignedTxn) string {
+	auth := stxn.Authorizer()
+	if stxn.Msig.Blank() {
+		if stxn.Sig.Blank() {
+			return fmt.Sprintf("authorized by %s: pending", auth)
+		}
+		return fmt.Sprintf("authorized by %s: signed", auth)
+	}
+
+	var signed, pending []string
+	for _, subsig := range stxn.Msig.Subsigs {
+		if subsig.Sig.Blank() {
+			pending = append(pending, basics.Address(subsig.Key).String())
+		} else {
+			signed = append(signed, basics.Address(subsig.Key).String())
+		}
+	}
+	state := "pending"
+	if len(signed) >= int(stxn.Msig.Threshold) {
+		state = "complete"
+	}
+	out := fmt.Sprintf("authorized by multisig %s: %s, %d of %d signatures", auth, state, len(signed), stxn.Msig.Threshold)
+	if len(signed) > 0 {
+		out += "\n      signed by: " + strings.Join(signed, ", ")
+	}
+	if len(pending) > 0 && state == "pending" {
+		out += "\n      not signed by: " + strings.Join(pending, ", ")
+	}
+	return out
+}
+
+// formatAlgos formats an amount of microalgos in Algos
+func formatAlgos(amount basics.MicroAlgos) string {
+	return fmt.Sprintf("%d.%06d Algos", amount.Raw/1000000, amount.Raw%1000000)
+}
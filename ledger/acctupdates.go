@@ -724,6 +724,27 @@ func (r *readCloseSizer) Size() (int64, error) {
 	return r.size, nil
 }
 
+// readSeekCloseSizer is a readCloseSizer over a stream that supports seeking.
+type readSeekCloseSizer struct {
+	readCloseSizer
+	seeker io.Seeker
+}
+
+// Seek implements io.Seeker by seeking the associated stream.
+func (r *readSeekCloseSizer) Seek(offset int64, whence int) (int64, error) {
+	return r.seeker.Seek(offset, whence)
+}
+
+// makeReadCloseSizer returns a ReadCloseSizer for the provided stream. The returned
+// value implements io.Seeker only if the stream does, so that callers could check
+// for seeking support with a type assertion.
+func makeReadCloseSizer(rc io.ReadCloser, size int64) ReadCloseSizer {
+	if seeker, ok := rc.(io.Seeker); ok {
+		return &readSeekCloseSizer{readCloseSizer: readCloseSizer{ReadCloser: rc, size: size}, seeker: seeker}
+	}
+	return &readCloseSizer{ReadCloser: rc, size: size}
+}
+
 // functions below this line are all internal functions
 
 // latestTotalsImpl returns the totals of all accounts for the most recent round, as well as the round number
@@ -21,6 +21,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"runtime"
 	"strings"
@@ -2727,3 +2728,31 @@ func TestAcctUpdatesLookupStateDelta(t *testing.T) {
 	require.Contains(t, data.Assets, aidx3)
 	require.NotContains(t, data.Assets, aidx2)
 }
+
+func TestReadCloseSizerSeek(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// streams that cannot seek do not pretend to
+	rcs := makeReadCloseSizer(io.NopCloser(bytes.NewReader([]byte("stream"))), 6)
+	_, ok := rcs.(io.Seeker)
+	require.False(t, ok)
+
+	f, err := os.Create(t.TempDir() + "/stream")
+	require.NoError(t, err)
+	_, err = f.WriteString("stream")
+	require.NoError(t, err)
+	rcs = makeReadCloseSizer(f, 6)
+	defer rcs.Close()
+	seeker, ok := rcs.(io.ReadSeeker)
+	require.True(t, ok)
+	pos, err := seeker.Seek(2, io.SeekStart)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), pos)
+	data, err := io.ReadAll(seeker)
+	require.NoError(t, err)
+	require.Equal(t, "ream", string(data))
+	size, err := rcs.Size()
+	require.NoError(t, err)
+	require.Equal(t, int64(6), size)
+}
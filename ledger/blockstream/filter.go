@@ -0,0 +1,164 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package blockstream
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// AddressRole restricts which fields of a transaction an address filter looks at.
+type AddressRole string
+
+const (
+	// AnyRole matches the address in any of the fields of a transaction which name an account.
+	AnyRole AddressRole = ""
+	// SenderRole matches the sender, and the clawed back account of an asset transfer.
+	SenderRole AddressRole = "sender"
+	// ReceiverRole matches the receivers, and the close to accounts, of payments and asset
+	// transfers.
+	ReceiverRole AddressRole = "receiver"
+)
+
+// TxnFilter selects transactions. A transaction matches when it satisfies every criterion which
+// is set; the zero TxnFilter matches every transaction.
+type TxnFilter struct {
+	Address     basics.Address
+	AddressRole AddressRole
+	AppID       basics.AppIndex
+	AssetID     basics.AssetIndex
+	TxType      protocol.TxType
+	NotePrefix  []byte
+	// LogContains matches the application calls which logged a message containing it.
+	LogContains []byte
+}
+
+// MatchedTxn is a transaction of a block matched by a TxnFilter.
+type MatchedTxn struct {
+	// IntraRoundOffset is the position of the transaction in the block, counting the inner
+	// transactions, in depth first order, right after the transaction which issued them.
+	IntraRoundOffset uint64
+	// Inner is set for inner transactions.
+	Inner bool
+	Txn   transactions.SignedTxnWithAD
+}
+
+// Validate checks the filter for unknown address roles and transaction types.
+func (f *TxnFilter) Validate() error {
+	switch f.AddressRole {
+	case AnyRole, SenderRole, ReceiverRole:
+	default:
+		return fmt.Errorf("unknown address role %s", f.AddressRole)
+	}
+	if f.AddressRole != AnyRole && f.Address.IsZero() {
+		return fmt.Errorf("address role %s given without an address", f.AddressRole)
+	}
+	switch f.TxType {
+	case "", protocol.PaymentTx, protocol.KeyRegistrationTx, protocol.AssetConfigTx, protocol.AssetTransferTx,
+		protocol.AssetFreezeTx, protocol.ApplicationCallTx, protocol.StateProofTx, protocol.HeartbeatTx:
+	default:
+		return fmt.Errorf("unknown transaction type %s", f.TxType)
+	}
+	return nil
+}
+
+func (f *TxnFilter) matchAddress(stxn *transactions.SignedTxnWithAD) bool {
+	txn := &stxn.Txn
+	sender := txn.Sender == f.Address || txn.AssetSender == f.Address
+	if f.AddressRole == SenderRole {
+		return sender
+	}
+	receiver := txn.Receiver == f.Address || txn.CloseRemainderTo == f.Address ||
+		txn.AssetReceiver == f.Address || txn.AssetCloseTo == f.Address
+	if f.AddressRole == ReceiverRole {
+		return receiver
+	}
+	if sender || receiver || txn.FreezeAccount == f.Address || txn.RekeyTo == f.Address {
+		return true
+	}
+	if txn.HeartbeatTxnFields != nil && txn.HbAddress == f.Address {
+		return true
+	}
+	return slices.Contains(txn.Accounts, f.Address)
+}
+
+func (f *TxnFilter) matchLogs(stxn *transactions.SignedTxnWithAD) bool {
+	for _, log := range stxn.EvalDelta.Logs {
+		if strings.Contains(log, string(f.LogContains)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Match tells whether a transaction matches the filter. It does not look at the inner
+// transactions of stxn.
+func (f *TxnFilter) Match(stxn *transactions.SignedTxnWithAD) bool {
+	txn := &stxn.Txn
+	if !f.Address.IsZero() && !f.matchAddress(stxn) {
+		return false
+	}
+	if f.AppID != 0 && txn.ApplicationID != f.AppID && stxn.ApplicationID != f.AppID {
+		return false
+	}
+	if f.AssetID != 0 && txn.XferAsset != f.AssetID && txn.ConfigAsset != f.AssetID &&
+		txn.FreezeAsset != f.AssetID && stxn.ConfigAsset != f.AssetID {
+		return false
+	}
+	if f.TxType != "" && txn.Type != f.TxType {
+		return false
+	}
+	if len(f.NotePrefix) > 0 && !bytes.HasPrefix(txn.Note, f.NotePrefix) {
+		return false
+	}
+	if len(f.LogContains) > 0 && !f.matchLogs(stxn) {
+		return false
+	}
+	return true
+}
+
+// MatchBlock returns the transactions of a block, inner transactions included, which match the
+// filter.
+func (f *TxnFilter) MatchBlock(block bookkeeping.Block) ([]MatchedTxn, error) {
+	payset, err := block.DecodePaysetFlat()
+	if err != nil {
+		return nil, err
+	}
+	var matches []MatchedTxn
+	var offset uint64
+	var walk func(stxn *transactions.SignedTxnWithAD, inner bool)
+	walk = func(stxn *transactions.SignedTxnWithAD, inner bool) {
+		if f.Match(stxn) {
+			matches = append(matches, MatchedTxn{IntraRoundOffset: offset, Inner: inner, Txn: *stxn})
+		}
+		offset++
+		for i := range stxn.EvalDelta.InnerTxns {
+			walk(&stxn.EvalDelta.InnerTxns[i], true)
+		}
+	}
+	for i := range payset {
+		walk(&payset[i], false)
+	}
+	return matches, nil
+}
@@ -0,0 +1,94 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package blockstream
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/txntest"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestTxnFilterMatchBlock(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var alice, bob, carol basics.Address
+	crypto.RandBytes(alice[:])
+	crypto.RandBytes(bob[:])
+	crypto.RandBytes(carol[:])
+	app := basics.AppIndex(10)
+
+	pay := txntest.Txn{Type: protocol.PaymentTx, Sender: alice, Receiver: bob, Note: []byte("invoice:1")}
+	axfer := txntest.Txn{Type: protocol.AssetTransferTx, Sender: bob, AssetReceiver: carol, XferAsset: 7}
+	call := transactions.SignedTxnWithAD{
+		SignedTxn: txntest.Txn{Type: protocol.ApplicationCallTx, Sender: carol, ApplicationID: app}.SignedTxn(),
+	}
+	call.EvalDelta.Logs = []string{"swap:done"}
+	call.EvalDelta.InnerTxns = []transactions.SignedTxnWithAD{
+		{SignedTxn: txntest.Txn{Type: protocol.PaymentTx, Sender: app.Address(), Receiver: alice}.SignedTxn()},
+		{SignedTxn: txntest.Txn{Type: protocol.AssetConfigTx, Sender: app.Address()}.SignedTxn(),
+			ApplyData: transactions.ApplyData{ConfigAsset: 8}},
+	}
+
+	block := makeBlock(5)
+	block.CurrentProtocol = protocol.ConsensusCurrentVersion
+	crypto.RandBytes(block.BlockHeader.GenesisHash[:])
+	for _, stxn := range []transactions.SignedTxnWithAD{
+		{SignedTxn: pay.SignedTxn()}, {SignedTxn: axfer.SignedTxn()}, call,
+	} {
+		stxn.Txn.GenesisHash = block.BlockHeader.GenesisHash
+		txib, err := block.EncodeSignedTxn(stxn.SignedTxn, stxn.ApplyData)
+		require.NoError(t, err)
+		block.Payset = append(block.Payset, txib)
+	}
+
+	offsets := func(f TxnFilter) []uint64 {
+		require.NoError(t, f.Validate())
+		matches, err := f.MatchBlock(block)
+		require.NoError(t, err)
+		var res []uint64
+		for _, m := range matches {
+			require.Equal(t, m.IntraRoundOffset > 2, m.Inner)
+			res = append(res, m.IntraRoundOffset)
+		}
+		return res
+	}
+
+	require.Equal(t, []uint64{0, 1, 2, 3, 4}, offsets(TxnFilter{}))
+	require.Equal(t, []uint64{0, 3}, offsets(TxnFilter{Address: alice}))
+	require.Equal(t, []uint64{0}, offsets(TxnFilter{Address: alice, AddressRole: SenderRole}))
+	require.Equal(t, []uint64{3}, offsets(TxnFilter{Address: alice, AddressRole: ReceiverRole}))
+	require.Equal(t, []uint64{1, 2}, offsets(TxnFilter{Address: carol}))
+	require.Equal(t, []uint64{2}, offsets(TxnFilter{AppID: app}))
+	require.Equal(t, []uint64{1}, offsets(TxnFilter{AssetID: 7}))
+	require.Equal(t, []uint64{4}, offsets(TxnFilter{AssetID: 8}))
+	require.Equal(t, []uint64{0, 3}, offsets(TxnFilter{TxType: protocol.PaymentTx}))
+	require.Equal(t, []uint64{0}, offsets(TxnFilter{NotePrefix: []byte("invoice:")}))
+	require.Equal(t, []uint64{2}, offsets(TxnFilter{LogContains: []byte("done")}))
+	require.Empty(t, offsets(TxnFilter{Address: bob, TxType: protocol.ApplicationCallTx}))
+
+	require.ErrorContains(t, (&TxnFilter{AddressRole: "payer"}).Validate(), "unknown address role")
+	require.ErrorContains(t, (&TxnFilter{AddressRole: SenderRole}).Validate(), "without an address")
+	require.ErrorContains(t, (&TxnFilter{TxType: "xfer"}).Validate(), "unknown transaction type")
+}
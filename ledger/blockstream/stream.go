@@ -0,0 +1,111 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package blockstream fans the blocks committed to the ledger out to subscribers, such as the
+// clients of the block streaming REST endpoint.
+package blockstream
+
+import (
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// DefaultCapacity is the number of blocks a subscriber may fall behind by before it is dropped.
+const DefaultCapacity = 64
+
+// Entry is a committed block, along with the state delta it applied to the ledger.
+type Entry struct {
+	Block bookkeeping.Block
+	Delta ledgercore.StateDelta
+}
+
+// Stream is a ledgercore.BlockListener which passes the committed blocks on to its subscribers.
+// It never blocks the ledger's block notifier: a subscriber which does not keep up is dropped,
+// and is expected to catch up from the ledger itself.
+type Stream struct {
+	mu          deadlock.Mutex
+	subscribers map[*Subscription]struct{}
+}
+
+// Subscription receives the blocks committed after it was made.
+type Subscription struct {
+	stream *Stream
+	ch     chan Entry
+	// lagged is set when the subscription was dropped for falling behind. It is guarded by
+	// stream.mu, and only changes before ch is closed.
+	lagged bool
+}
+
+// MakeStream creates a Stream without subscribers.
+func MakeStream() *Stream {
+	return &Stream{subscribers: make(map[*Subscription]struct{})}
+}
+
+// OnNewBlock implements ledgercore.BlockListener.
+func (s *Stream) OnNewBlock(block bookkeeping.Block, delta ledgercore.StateDelta) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for sub := range s.subscribers {
+		select {
+		case sub.ch <- Entry{Block: block, Delta: delta}:
+		default:
+			sub.lagged = true
+			delete(s.subscribers, sub)
+			close(sub.ch)
+		}
+	}
+}
+
+// Subscribe starts a subscription which buffers up to capacity blocks.
+func (s *Stream) Subscribe(capacity int) *Subscription {
+	sub := &Subscription{stream: s, ch: make(chan Entry, capacity)}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.subscribers[sub] = struct{}{}
+	return sub
+}
+
+// Subscribers returns the number of active subscriptions.
+func (s *Stream) Subscribers() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.subscribers)
+}
+
+// Blocks returns the channel the committed blocks are delivered on, in round order. It is
+// closed when the subscription ends, either by Close or by falling behind.
+func (sub *Subscription) Blocks() <-chan Entry {
+	return sub.ch
+}
+
+// Lagged reports whether the subscription was dropped because its buffer was full.
+func (sub *Subscription) Lagged() bool {
+	sub.stream.mu.Lock()
+	defer sub.stream.mu.Unlock()
+	return sub.lagged
+}
+
+// Close ends the subscription. It is safe to call more than once.
+func (sub *Subscription) Close() {
+	sub.stream.mu.Lock()
+	defer sub.stream.mu.Unlock()
+	if _, ok := sub.stream.subscribers[sub]; ok {
+		delete(sub.stream.subscribers, sub)
+		close(sub.ch)
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package blockstream
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func makeBlock(rnd basics.Round) bookkeeping.Block {
+	return bookkeeping.Block{BlockHeader: bookkeeping.BlockHeader{Round: rnd}}
+}
+
+func TestStream(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	s := MakeStream()
+	s.OnNewBlock(makeBlock(1), ledgercore.StateDelta{})
+
+	fast := s.Subscribe(4)
+	slow := s.Subscribe(1)
+	require.Equal(t, 2, s.Subscribers())
+
+	s.OnNewBlock(makeBlock(2), ledgercore.StateDelta{})
+	s.OnNewBlock(makeBlock(3), ledgercore.StateDelta{})
+
+	// the slow subscriber is dropped once its buffer is full
+	require.True(t, slow.Lagged())
+	e, ok := <-slow.Blocks()
+	require.True(t, ok)
+	require.Equal(t, basics.Round(2), e.Block.Round())
+	_, ok = <-slow.Blocks()
+	require.False(t, ok)
+	require.Equal(t, 1, s.Subscribers())
+	slow.Close()
+
+	for _, rnd := range []basics.Round{2, 3} {
+		e := <-fast.Blocks()
+		require.Equal(t, rnd, e.Block.Round())
+	}
+	require.False(t, fast.Lagged())
+	fast.Close()
+	fast.Close()
+	_, ok = <-fast.Blocks()
+	require.False(t, ok)
+	require.False(t, fast.Lagged())
+	require.Zero(t, s.Subscribers())
+
+	// blocks are not delivered to closed subscriptions
+	s.OnNewBlock(makeBlock(4), ledgercore.StateDelta{})
+}
@@ -0,0 +1,723 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/encoded"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/msgp/msgp"
+)
+
+const (
+	// CatchpointDeltaContentFileName is a name of a file with the catchpoint delta header info inside tar archive
+	CatchpointDeltaContentFileName = "delta.msgpack"
+	// catchpointDeltaChunkFileNameTemplate is a template name of files with catchpoint delta data
+	catchpointDeltaChunkFileNameTemplate = "deltachunk.%d.msgpack"
+	catchpointDeltaChunkFileNamePrefix   = "deltachunk."
+
+	// maxCatchpointDeltaEntrySize bounds the size of a single tar entry of a catchpoint delta file we are willing to read.
+	maxCatchpointDeltaEntrySize = 512 * 1024 * 1024
+
+	// maxCatchpointDeltaChunkSize bounds the encoded size of the records held by a single catchpoint delta chunk, which
+	// matches the size of the accounts and KVs of a catchpoint file chunk. A chunk holding a single record may exceed it.
+	maxCatchpointDeltaChunkSize = BalancesPerCatchpointFileChunk * (MaxEncodedBaseAccountDataSize + encoded.MaxEncodedKVDataSize)
+
+	// catchpointDeltaKeysJournalFileName is the name of the file, in the catchpoint data directory, persisting the keys
+	// of the records written since the last catchpoint first stage.
+	catchpointDeltaKeysJournalFileName = "deltakeys.journal"
+)
+
+// errNoCatchpointDeltaBase is returned when a catchpoint delta does not apply on top of the staged catchpoint.
+var errNoCatchpointDeltaBase = errors.New("catchpoint delta does not apply on top of the staged catchpoint")
+
+// CatchpointDeltaFileHeader is the header of a catchpoint delta file. A delta file describes the changes between the
+// catchpoint BaseCatchpoint and the catchpoint Catchpoint, which is created CatchpointInterval rounds later. Applying
+// the delta on top of the staged balances of the base catchpoint yields the staged balances of the newer catchpoint.
+//
+//msgp:ignore CatchpointDeltaFileHeader
+type CatchpointDeltaFileHeader struct {
+	Version           uint64                   `codec:"version"`
+	BaseRound         basics.Round             `codec:"baseRound"`
+	BaseCatchpoint    string                   `codec:"baseCatchpoint"`
+	BalancesRound     basics.Round             `codec:"balancesRound"`
+	BlocksRound       basics.Round             `codec:"blocksRound"`
+	Totals            ledgercore.AccountTotals `codec:"accountTotals"`
+	TotalChunks       uint64                   `codec:"chunksCount"`
+	Catchpoint        string                   `codec:"catchpoint"`
+	BlockHeaderDigest crypto.Digest            `codec:"blockHeaderDigest"`
+}
+
+// CatchpointDeltaChunk defines the encoding of "deltachunk.X.msgpack" files in the catchpoint delta file. Accounts
+// and KVs hold the records that changed since the base catchpoint, while the online accounts and online round params
+// tables are short-lived history and are carried over as a whole.
+//
+//msgp:ignore CatchpointDeltaChunk
+type CatchpointDeltaChunk struct {
+	Accounts          []CatchpointDeltaAccount            `codec:"acct"`
+	KVs               []CatchpointDeltaKV                 `codec:"kv"`
+	OnlineAccounts    []encoded.OnlineAccountRecordV6     `codec:"oa"`
+	OnlineRoundParams []encoded.OnlineRoundParamsRecordV6 `codec:"orp"`
+}
+
+func (chunk *CatchpointDeltaChunk) empty() bool {
+	return len(chunk.Accounts) == 0 && len(chunk.KVs) == 0 && len(chunk.OnlineAccounts) == 0 && len(chunk.OnlineRoundParams) == 0
+}
+
+// CatchpointDeltaAccount is an account that changed since the base catchpoint. An empty AccountData marks an account
+// that was deleted along with all of its resources. Resources lists the resources of the account that changed, where an
+// empty resource data marks a deleted resource.
+//
+//msgp:ignore CatchpointDeltaAccount
+type CatchpointDeltaAccount struct {
+	Address     basics.Address    `codec:"addr"`
+	AccountData []byte            `codec:"data"`
+	Resources   map[uint64][]byte `codec:"rsc"`
+}
+
+// CatchpointDeltaKV is a KV that changed since the base catchpoint.
+//
+//msgp:ignore CatchpointDeltaKV
+type CatchpointDeltaKV struct {
+	Key     []byte `codec:"k"`
+	Value   []byte `codec:"v"`
+	Deleted bool   `codec:"d"`
+}
+
+// catchpointDeltaKeys tracks the accounts, resources and KVs written to the database since the round of the
+// last catchpoint first stage. It holds a superset of the changed records; records that were written back with
+// their original value are harmless. The keys are mirrored to an append-only journal file, so that they could be
+// restored once the tracker is loaded again. The keys are only accessed from the commit routine, so that they are
+// not protected by a lock.
+type catchpointDeltaKeys struct {
+	// baseRound is the first stage round since which the keys are tracked, or zero if the keys are incomplete,
+	// i.e. after the tracker was loaded without a journal covering the commits since the last first stage round.
+	baseRound basics.Round
+	accounts  map[basics.Address]map[basics.CreatableIndex]struct{}
+	kvs       map[string]struct{}
+
+	// journalPath is the path of the journal file, or empty if the keys are not persisted. journal is open for
+	// appending while the keys are complete and all of them were persisted.
+	journalPath string
+	journal     *os.File
+}
+
+// catchpointDeltaKeysRecord is a record of the catchpoint delta keys journal. The first record of the journal holds
+// the base round, and each of the following ones holds the keys written by a single commit, up to Round.
+//
+//msgp:ignore catchpointDeltaKeysRecord
+type catchpointDeltaKeysRecord struct {
+	BaseRound basics.Round                 `codec:"base"`
+	Round     basics.Round                 `codec:"rnd"`
+	Accounts  []catchpointDeltaKeysAccount `codec:"acct"`
+	KVs       [][]byte                     `codec:"kv"`
+}
+
+// catchpointDeltaKeysAccount lists an account, and the resources of that account, written by a single commit.
+//
+//msgp:ignore catchpointDeltaKeysAccount
+type catchpointDeltaKeysAccount struct {
+	Address   basics.Address          `codec:"addr"`
+	Resources []basics.CreatableIndex `codec:"rsc"`
+}
+
+// reset starts tracking the keys written after the given first stage round. The journal, if any, is rewritten to
+// hold the new base round only.
+func (k *catchpointDeltaKeys) reset(baseRound basics.Round) error {
+	k.clear(baseRound)
+	if k.journalPath == "" {
+		return nil
+	}
+	k.closeJournal()
+	if baseRound == 0 {
+		err := os.Remove(k.journalPath)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	err := os.MkdirAll(filepath.Dir(k.journalPath), 0700)
+	if err != nil {
+		return err
+	}
+	k.journal, err = os.OpenFile(k.journalPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	return k.appendJournal(&catchpointDeltaKeysRecord{BaseRound: baseRound, Round: baseRound})
+}
+
+func (k *catchpointDeltaKeys) clear(baseRound basics.Round) {
+	k.baseRound = baseRound
+	k.accounts = make(map[basics.Address]map[basics.CreatableIndex]struct{})
+	k.kvs = make(map[string]struct{})
+}
+
+// load restores the keys persisted to the journal at journalPath, and keeps persisting the keys recorded afterwards.
+// The keys are incomplete unless the journal covers all the commits up to dbRound.
+func (k *catchpointDeltaKeys) load(journalPath string, dbRound basics.Round) error {
+	k.closeJournal()
+	k.journalPath = journalPath
+	k.clear(0)
+
+	baseRound, lastRound, err := k.readJournal()
+	if err == nil && baseRound != 0 && baseRound <= dbRound && lastRound >= dbRound {
+		k.journal, err = os.OpenFile(journalPath, os.O_WRONLY|os.O_APPEND, 0600)
+		if err == nil {
+			k.baseRound = baseRound
+			return nil
+		}
+	}
+	// the records written since the last first stage round are unknown, so the next delta file can't be generated.
+	resetErr := k.reset(0)
+	if err != nil {
+		return err
+	}
+	return resetErr
+}
+
+// readJournal adds the keys listed in the journal, and returns its base round along with the round of its last commit.
+// A journal that is missing has a zero base round.
+func (k *catchpointDeltaKeys) readJournal() (baseRound basics.Round, lastRound basics.Round, err error) {
+	f, err := os.Open(k.journalPath)
+	if os.IsNotExist(err) {
+		return 0, 0, nil
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+	defer f.Close()
+	in := bufio.NewReader(f)
+	var lenBuf [4]byte
+	for {
+		_, err = io.ReadFull(in, lenBuf[:])
+		if err == io.EOF {
+			return baseRound, lastRound, nil
+		}
+		if err != nil {
+			return 0, 0, err
+		}
+		recordLen := binary.BigEndian.Uint32(lenBuf[:])
+		if recordLen > maxCatchpointDeltaEntrySize {
+			return 0, 0, fmt.Errorf("catchpoint delta keys journal record has an invalid size of %d", recordLen)
+		}
+		data := make([]byte, recordLen)
+		_, err = io.ReadFull(in, data)
+		if err != nil {
+			return 0, 0, err
+		}
+		var record catchpointDeltaKeysRecord
+		err = protocol.DecodeReflect(data, &record)
+		if err != nil {
+			return 0, 0, err
+		}
+		if baseRound == 0 {
+			if record.BaseRound == 0 {
+				return 0, 0, errors.New("catchpoint delta keys journal has no base round")
+			}
+			baseRound = record.BaseRound
+		}
+		lastRound = record.Round
+		for _, acct := range record.Accounts {
+			k.add(acct.Address, acct.Resources)
+		}
+		for _, key := range record.KVs {
+			k.kvs[string(key)] = struct{}{}
+		}
+	}
+}
+
+// appendJournal persists a journal record. Failing to do so drops the journal, so that the keys would be known to
+// be incomplete once the tracker is loaded again.
+func (k *catchpointDeltaKeys) appendJournal(record *catchpointDeltaKeysRecord) error {
+	data := protocol.EncodeReflect(record)
+	buf := make([]byte, 4, 4+len(data))
+	binary.BigEndian.PutUint32(buf, uint32(len(data)))
+	_, err := k.journal.Write(append(buf, data...))
+	if err == nil {
+		// the record has to be persisted before the database transaction writing these records is committed.
+		err = k.journal.Sync()
+	}
+	if err != nil {
+		k.closeJournal()
+		os.Remove(k.journalPath)
+	}
+	return err
+}
+
+func (k *catchpointDeltaKeys) closeJournal() {
+	if k.journal != nil {
+		k.journal.Close()
+		k.journal = nil
+	}
+}
+
+func (k *catchpointDeltaKeys) add(addr basics.Address, resources []basics.CreatableIndex) {
+	if len(resources) == 0 {
+		if _, ok := k.accounts[addr]; !ok {
+			k.accounts[addr] = nil
+		}
+		return
+	}
+	accountResources := k.accounts[addr]
+	if accountResources == nil {
+		accountResources = make(map[basics.CreatableIndex]struct{}, len(resources))
+		k.accounts[addr] = accountResources
+	}
+	for _, aidx := range resources {
+		accountResources[aidx] = struct{}{}
+	}
+}
+
+// record adds the keys of the records written by a single commit, up to the given round.
+func (k *catchpointDeltaKeys) record(accountsDeltas *compactAccountDeltas, resourcesDeltas *compactResourcesDeltas, kvDeltas map[string]modifiedKvValue, round basics.Round) error {
+	if k.accounts == nil {
+		k.clear(0)
+	}
+	record := catchpointDeltaKeysRecord{Round: round}
+	for i := range accountsDeltas.deltas {
+		addr := accountsDeltas.deltas[i].address
+		k.add(addr, nil)
+		record.Accounts = append(record.Accounts, catchpointDeltaKeysAccount{Address: addr})
+	}
+	for i := range resourcesDeltas.deltas {
+		addr := resourcesDeltas.deltas[i].address
+		aidx := resourcesDeltas.deltas[i].oldResource.Aidx
+		k.add(addr, []basics.CreatableIndex{aidx})
+		record.Accounts = append(record.Accounts, catchpointDeltaKeysAccount{Address: addr, Resources: []basics.CreatableIndex{aidx}})
+	}
+	for key := range kvDeltas {
+		k.kvs[key] = struct{}{}
+		record.KVs = append(record.KVs, []byte(key))
+	}
+	if k.journal == nil {
+		return nil
+	}
+	return k.appendJournal(&record)
+}
+
+// catchpointDeltaDataWriter writes the (snappy compressed) first stage data of a catchpoint delta file.
+type catchpointDeltaDataWriter struct {
+	tar      *tar.Writer
+	chunk    CatchpointDeltaChunk
+	chunkNum uint64
+	// chunkResources counts the resources held by the accounts of the current chunk.
+	chunkResources int
+	// chunkSize estimates the encoded size of the records of the current chunk.
+	chunkSize int
+}
+
+func (w *catchpointDeltaDataWriter) writeEntry(name string, data []byte) error {
+	err := w.tar.WriteHeader(&tar.Header{
+		Name: name,
+		Mode: 0600,
+		Size: int64(len(data)),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = w.tar.Write(data)
+	return err
+}
+
+// flushChunk writes the current chunk, if it holds any record.
+func (w *catchpointDeltaDataWriter) flushChunk() error {
+	if w.chunk.empty() {
+		return nil
+	}
+	w.chunkNum++
+	err := w.writeEntry(fmt.Sprintf(catchpointDeltaChunkFileNameTemplate, w.chunkNum), protocol.EncodeReflect(&w.chunk))
+	w.chunk = CatchpointDeltaChunk{}
+	w.chunkResources = 0
+	w.chunkSize = 0
+	return err
+}
+
+// reserve makes room in the current chunk for a record of the given encoded size, flushing the chunk if the record
+// would take it over maxCatchpointDeltaChunkSize.
+func (w *catchpointDeltaDataWriter) reserve(size int) error {
+	if !w.chunk.empty() && w.chunkSize+size > maxCatchpointDeltaChunkSize {
+		err := w.flushChunk()
+		if err != nil {
+			return err
+		}
+	}
+	w.chunkSize += size
+	return nil
+}
+
+func (w *catchpointDeltaDataWriter) addAccount(acct CatchpointDeltaAccount) error {
+	size := len(acct.Address) + len(acct.AccountData) + msgp.MapHeaderSize
+	for _, res := range acct.Resources {
+		size += msgp.Uint64Size + len(res)
+	}
+	err := w.reserve(size)
+	if err != nil {
+		return err
+	}
+	w.chunk.Accounts = append(w.chunk.Accounts, acct)
+	w.chunkResources += len(acct.Resources)
+	if len(w.chunk.Accounts) >= BalancesPerCatchpointFileChunk || w.chunkResources >= ResourcesPerCatchpointFileChunk {
+		return w.flushChunk()
+	}
+	return nil
+}
+
+func (w *catchpointDeltaDataWriter) addKV(kv CatchpointDeltaKV) error {
+	err := w.reserve(len(kv.Key) + len(kv.Value) + msgp.BoolSize)
+	if err != nil {
+		return err
+	}
+	w.chunk.KVs = append(w.chunk.KVs, kv)
+	if len(w.chunk.KVs) >= BalancesPerCatchpointFileChunk {
+		return w.flushChunk()
+	}
+	return nil
+}
+
+func (w *catchpointDeltaDataWriter) addOnlineAccount(oa *encoded.OnlineAccountRecordV6) error {
+	err := w.reserve(oa.Msgsize())
+	if err != nil {
+		return err
+	}
+	w.chunk.OnlineAccounts = append(w.chunk.OnlineAccounts, *oa)
+	if len(w.chunk.OnlineAccounts) >= BalancesPerCatchpointFileChunk {
+		return w.flushChunk()
+	}
+	return nil
+}
+
+func (w *catchpointDeltaDataWriter) addOnlineRoundParams(or *encoded.OnlineRoundParamsRecordV6) error {
+	err := w.reserve(or.Msgsize())
+	if err != nil {
+		return err
+	}
+	w.chunk.OnlineRoundParams = append(w.chunk.OnlineRoundParams, *or)
+	if len(w.chunk.OnlineRoundParams) >= BalancesPerCatchpointFileChunk {
+		return w.flushChunk()
+	}
+	return nil
+}
+
+// writeCatchpointDeltaData writes the first stage data file of a catchpoint delta, holding the current content of the
+// records listed by keys, as well as the online accounts and online round params tables and the state proof
+// verification data. The database must not be modified while the data is being written.
+func writeCatchpointDeltaData(ctx context.Context, tx trackerdb.SnapshotScope, params config.ConsensusParams, filePath string, keys *catchpointDeltaKeys, accountsRound basics.Round, onlineExcludeBefore basics.Round, encodedSPData []byte) (err error) {
+	err = os.MkdirAll(filepath.Dir(filePath), 0700)
+	if err != nil {
+		return err
+	}
+	file, err := os.OpenFile(filePath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		file.Close()
+		if err != nil {
+			os.Remove(filePath)
+		}
+	}()
+	compressor, err := catchpointStage1Encoder(file)
+	if err != nil {
+		return err
+	}
+	w := catchpointDeltaDataWriter{tar: tar.NewWriter(compressor)}
+
+	if len(encodedSPData) > 0 {
+		err = w.writeEntry(catchpointSPVerificationFileName, encodedSPData)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = writeCatchpointDeltaRecords(ctx, tx, &w, keys)
+	if err != nil {
+		return err
+	}
+
+	if params.EnableCatchpointsWithOnlineAccounts {
+		onlineAccountRows, err := makeCatchpointOrderedOnlineAccountsIterFactory(tx.MakeOrderedOnlineAccountsIter, accountsRound, params)(ctx, false, onlineExcludeBefore)
+		if err != nil {
+			return err
+		}
+		defer onlineAccountRows.Close()
+		for onlineAccountRows.Next() {
+			oa, err := onlineAccountRows.GetItem()
+			if err != nil {
+				return err
+			}
+			err = w.addOnlineAccount(oa)
+			if err != nil {
+				return err
+			}
+		}
+
+		onlineRoundParamsRows, err := tx.MakeOnlineRoundParamsIter(ctx, false, onlineExcludeBefore)
+		if err != nil {
+			return err
+		}
+		defer onlineRoundParamsRows.Close()
+		for onlineRoundParamsRows.Next() {
+			or, err := onlineRoundParamsRows.GetItem()
+			if err != nil {
+				return err
+			}
+			err = w.addOnlineRoundParams(or)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err = w.flushChunk()
+	if err != nil {
+		return err
+	}
+	err = w.tar.Close()
+	if err != nil {
+		return err
+	}
+	err = compressor.Close()
+	if err != nil {
+		return err
+	}
+	return file.Close()
+}
+
+// writeCatchpointDeltaRecords writes the current content of the accounts, resources and KVs listed by keys, in a
+// deterministic order.
+func writeCatchpointDeltaRecords(ctx context.Context, tx trackerdb.SnapshotScope, w *catchpointDeltaDataWriter, keys *catchpointDeltaKeys) error {
+	ar, err := tx.MakeAccountsReader()
+	if err != nil {
+		return err
+	}
+	aor, err := tx.MakeAccountsOptimizedReader()
+	if err != nil {
+		return err
+	}
+	defer aor.Close()
+
+	addresses := make([]basics.Address, 0, len(keys.accounts))
+	for addr := range keys.accounts {
+		addresses = append(addresses, addr)
+	}
+	sort.Slice(addresses, func(i, j int) bool { return bytes.Compare(addresses[i][:], addresses[j][:]) < 0 })
+
+	for _, addr := range addresses {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		pad, err := aor.LookupAccount(addr)
+		if err != nil {
+			return err
+		}
+		acct := CatchpointDeltaAccount{Address: addr}
+		// a deleted account implies all of its resources were deleted as well.
+		if pad.Ref != nil {
+			acct.AccountData = protocol.Encode(&pad.AccountData)
+			if len(keys.accounts[addr]) > 0 {
+				acct.Resources = make(map[uint64][]byte, len(keys.accounts[addr]))
+			}
+			for aidx := range keys.accounts[addr] {
+				data, err := ar.LookupResourceDataByAddrID(pad.Ref, aidx)
+				if err != nil && !errors.Is(err, trackerdb.ErrNotFound) {
+					return err
+				}
+				acct.Resources[uint64(aidx)] = data
+			}
+		}
+		err = w.addAccount(acct)
+		if err != nil {
+			return err
+		}
+	}
+
+	kvKeys := make([]string, 0, len(keys.kvs))
+	for key := range keys.kvs {
+		kvKeys = append(kvKeys, key)
+	}
+	sort.Strings(kvKeys)
+	for _, key := range kvKeys {
+		pv, err := aor.LookupKeyValue(key)
+		if err != nil {
+			return err
+		}
+		err = w.addKV(CatchpointDeltaKV{Key: []byte(key), Value: pv.Value, Deleted: pv.Value == nil})
+		if err != nil {
+			return err
+		}
+	}
+	return w.flushChunk()
+}
+
+// repackCatchpointDelta takes the header and the (snappy compressed) first stage data of a catchpoint delta from
+// dataPath, and writes them as a gzip compressed tar file to outPath, with the header in the first "file".
+func repackCatchpointDelta(ctx context.Context, header CatchpointDeltaFileHeader, dataPath string, outPath string) error {
+	// the header lists the number of chunks, so we count them first.
+	err := forEachCatchpointDeltaDataEntry(ctx, dataPath, func(name string, _ []byte) error {
+		if strings.HasPrefix(name, catchpointDeltaChunkFileNamePrefix) {
+			header.TotalChunks++
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	fout, err := os.OpenFile(outPath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	defer fout.Close()
+	compressorOut, err := gzip.NewWriterLevel(fout, gzip.BestSpeed)
+	if err != nil {
+		return err
+	}
+	defer compressorOut.Close()
+	w := catchpointDeltaDataWriter{tar: tar.NewWriter(compressorOut)}
+	defer w.tar.Close()
+
+	err = w.writeEntry(CatchpointDeltaContentFileName, protocol.EncodeReflect(&header))
+	if err != nil {
+		return err
+	}
+	err = forEachCatchpointDeltaDataEntry(ctx, dataPath, w.writeEntry)
+	if err != nil {
+		return err
+	}
+
+	err = w.tar.Close()
+	if err != nil {
+		return err
+	}
+	err = compressorOut.Close()
+	if err != nil {
+		return err
+	}
+	return fout.Close()
+}
+
+// forEachCatchpointDeltaDataEntry calls f for every entry of the first stage data of a catchpoint delta.
+func forEachCatchpointDeltaDataEntry(ctx context.Context, dataPath string, f func(name string, data []byte) error) error {
+	fin, err := os.OpenFile(dataPath, os.O_RDONLY, 0666)
+	if err != nil {
+		return err
+	}
+	defer fin.Close()
+	compressorIn, err := catchpointStage1Decoder(fin)
+	if err != nil {
+		return err
+	}
+	defer compressorIn.Close()
+	return forEachCatchpointEntry(ctx, tar.NewReader(compressorIn), f)
+}
+
+// forEachCatchpointEntry calls f for every entry of the provided catchpoint (or catchpoint delta) tar stream.
+func forEachCatchpointEntry(ctx context.Context, in *tar.Reader, f func(name string, data []byte) error) error {
+	for {
+		err := ctx.Err()
+		if err != nil {
+			return err
+		}
+		header, err := in.Next()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if header.Size < 0 || header.Size > maxCatchpointDeltaEntrySize {
+			return fmt.Errorf("catchpoint entry %s has an invalid size of %d", header.Name, header.Size)
+		}
+		data := make([]byte, header.Size)
+		_, err = io.ReadFull(in, data)
+		if err != nil {
+			return err
+		}
+		err = f(header.Name, data)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// prepareNormalizedAccountDeltas decodes the accounts of a catchpoint delta chunk, and calculates their merkle trie hashes.
+func prepareNormalizedAccountDeltas(accts []CatchpointDeltaAccount, proto config.ConsensusParams) (deltas []trackerdb.NormalizedAccountDelta, err error) {
+	deltas = make([]trackerdb.NormalizedAccountDelta, len(accts))
+	for i, acct := range accts {
+		delta := &deltas[i]
+		delta.Address = acct.Address
+		if len(acct.AccountData) == 0 {
+			delta.Deleted = true
+			continue
+		}
+		err = protocol.Decode(acct.AccountData, &delta.AccountData)
+		if err != nil {
+			return nil, err
+		}
+		delta.EncodedAccountData = acct.AccountData
+		delta.NormalizedBalance = basics.NormalizedOnlineAccountBalance(
+			delta.AccountData.Status,
+			delta.AccountData.RewardsBase,
+			delta.AccountData.MicroAlgos,
+			proto.RewardUnit)
+		delta.AccountHash = trackerdb.AccountHashBuilderV6(acct.Address, &delta.AccountData, acct.AccountData)
+
+		for cidx, res := range acct.Resources {
+			aidx := basics.CreatableIndex(cidx)
+			if len(res) == 0 {
+				delta.DeletedResources = append(delta.DeletedResources, aidx)
+				continue
+			}
+			var resData trackerdb.ResourcesData
+			err = protocol.Decode(res, &resData)
+			if err != nil {
+				return nil, err
+			}
+			if delta.Resources == nil {
+				delta.Resources = make(map[basics.CreatableIndex]trackerdb.ResourcesData, len(acct.Resources))
+				delta.EncodedResources = make(map[basics.CreatableIndex][]byte, len(acct.Resources))
+				delta.ResourceHashes = make(map[basics.CreatableIndex][]byte, len(acct.Resources))
+			}
+			delta.ResourceHashes[aidx], err = trackerdb.ResourcesHashBuilderV6(&resData, acct.Address, aidx, resData.UpdateRound, res)
+			if err != nil {
+				return nil, err
+			}
+			delta.Resources[aidx] = resData
+			delta.EncodedResources[aidx] = res
+		}
+	}
+	return deltas, nil
+}
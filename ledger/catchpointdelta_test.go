@@ -0,0 +1,259 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/encoded"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// processTestCatchpointStream feeds the entries of a catchpoint file, or catchpoint delta file, to the accessor.
+func processTestCatchpointStream(t *testing.T, accessor CatchpointCatchupAccessor, stream ReadCloseSizer, progress *CatchpointCatchupAccessorProgress) error {
+	defer stream.Close()
+	gz, err := gzip.NewReader(stream)
+	require.NoError(t, err)
+	defer gz.Close()
+	return forEachCatchpointEntry(context.Background(), tar.NewReader(gz), func(name string, data []byte) error {
+		return accessor.ProcessStagingBalances(context.Background(), name, data, progress)
+	})
+}
+
+func TestCatchpointDeltaChain(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// create new protocol version, which has lower lookback
+	testProtocolVersion := protocol.ConsensusVersion("test-protocol-TestCatchpointDeltaChain")
+	protoParams := config.Consensus[protocol.ConsensusCurrentVersion]
+	protoParams.CatchpointLookback = 32
+	protoParams.EnableCatchpointsWithSPContexts = true
+	config.Consensus[testProtocolVersion] = protoParams
+	defer func() {
+		delete(config.Consensus, testProtocolVersion)
+	}()
+
+	accts := []map[basics.Address]basics.AccountData{ledgertesting.RandomAccounts(20, true)}
+	addSinkAndPoolAccounts(accts)
+
+	ml := makeMockLedgerForTracker(t, false, 1, testProtocolVersion, accts)
+	defer ml.Close()
+
+	cfg := config.GetDefaultLocal()
+	cfg.CatchpointInterval = 4
+	cfg.CatchpointTracking = 2
+	cfg.MaxAcctLookback = 0
+	cfg.EnableCatchpointDeltaFiles = true
+	ct := newCatchpointTracker(t, ml, cfg, t.TempDir())
+	defer ct.close()
+	au := ml.trackers.accts
+
+	isCatchpointRound := func(rnd basics.Round) bool {
+		return (uint64(rnd) > protoParams.CatchpointLookback) && (uint64(rnd)%cfg.CatchpointInterval == 0)
+	}
+
+	const lastRound = basics.Round(48)
+	rewardLevel := uint64(0)
+	lastCreatableID := basics.CreatableIndex(crypto.RandUint64() % 512)
+	knownCreatables := make(map[basics.CreatableIndex]bool)
+	labels := make(map[basics.Round]string)
+	for i := basics.Round(1); i <= lastRound; i++ {
+		rewardLevelDelta := crypto.RandUint64() % 5
+		rewardLevel += rewardLevelDelta
+		base := accts[i-1]
+		updates, totals := ledgertesting.RandomDeltasBalancedFull(1, base, rewardLevel, &lastCreatableID)
+		prevRound, prevTotals, err := au.LatestTotals()
+		require.Equal(t, i-1, prevRound)
+		require.NoError(t, err)
+
+		newPool := totals[testPoolAddr]
+		newPool.MicroAlgos.Raw -= prevTotals.RewardUnits() * rewardLevelDelta
+		updates.Upsert(testPoolAddr, newPool)
+
+		blk := bookkeeping.Block{
+			BlockHeader: bookkeeping.BlockHeader{
+				Round: i,
+			},
+		}
+		blk.RewardsLevel = rewardLevel
+		blk.CurrentProtocol = testProtocolVersion
+		delta := ledgercore.MakeStateDelta(&blk.BlockHeader, 0, updates.Len(), 0)
+		delta.Accts.MergeAccounts(updates)
+		delta.Creatables = creatablesFromUpdates(base, updates, knownCreatables)
+		delta.Totals = ledgertesting.CalculateNewRoundAccountTotals(t, updates, rewardLevel, protoParams, base, prevTotals)
+		// a box is written every round, and the box written two rounds ago is deleted.
+		delta.AddKvMod(fmt.Sprintf("bx:%d", i), ledgercore.KvValueDelta{Data: []byte(strings.Repeat("x", int(i)))})
+		if i > 2 {
+			delta.AddKvMod(fmt.Sprintf("bx:%d", i-2), ledgercore.KvValueDelta{Data: nil, OldData: []byte(strings.Repeat("x", int(i-2)))})
+		}
+
+		ml.addBlock(blockEntry{block: blk}, delta)
+		accts = append(accts, applyPartialDeltas(base, updates))
+
+		if uint64(i)%cfg.CatchpointInterval == 0 {
+			ml.trackers.committedUpTo(i)
+			ml.trackers.waitAccountsWriting()
+			// Let catchpoint data generation finish so that nothing gets skipped.
+			for ct.isWritingCatchpointDataFile() {
+				time.Sleep(time.Millisecond)
+			}
+		}
+		if isCatchpointRound(i) {
+			labels[i] = ct.GetLastCatchpointLabel()
+			require.NotEmpty(t, labels[i])
+		}
+	}
+
+	// the changes made before the tracker was loaded are unknown, so the first catchpoint has no delta file.
+	_, err := ct.GetCatchpointDeltaStream(36)
+	require.ErrorAs(t, err, &ledgercore.ErrNoEntry{})
+
+	// create a ledger and catch up from the first catchpoint file, followed by the delta files of the next ones.
+	var initState ledgercore.InitState
+	initState.Block.CurrentProtocol = protocol.ConsensusCurrentVersion
+	l, err := OpenLedger(logging.TestingLog(t), t.Name()+"FromDeltas", true, initState, config.GetDefaultLocal())
+	require.NoError(t, err)
+	defer l.Close()
+	accessor := MakeCatchpointCatchupAccessor(l, l.log)
+	require.NoError(t, accessor.ResetStagingBalances(context.Background(), true))
+
+	var progress CatchpointCatchupAccessorProgress
+	stream, err := ct.GetCatchpointStream(36)
+	require.NoError(t, err)
+	require.NoError(t, processTestCatchpointStream(t, accessor, stream, &progress))
+	require.Equal(t, labels[36], progress.Catchpoint)
+
+	// a delta file only applies on top of its base catchpoint.
+	stream, err = ct.GetCatchpointDeltaStream(44)
+	require.NoError(t, err)
+	err = processTestCatchpointStream(t, accessor, stream, &progress)
+	require.ErrorIs(t, err, errNoCatchpointDeltaBase)
+
+	for rnd := basics.Round(40); rnd <= lastRound; rnd += basics.Round(cfg.CatchpointInterval) {
+		stream, err = ct.GetCatchpointDeltaStream(rnd)
+		require.NoError(t, err)
+		require.NoError(t, processTestCatchpointStream(t, accessor, stream, &progress))
+		require.Equal(t, labels[rnd], progress.Catchpoint)
+
+		// the merkle trie is built again after each delta file, to verify the balances it leads to.
+		require.NoError(t, accessor.BuildMerkleTrie(context.Background(), nil))
+		balancesHash, spVerificationHash, onlineAccountsHash, onlineRoundParamsHash, totals, err := accessor.GetVerifyData(context.Background())
+		require.NoError(t, err)
+		label, err := MakeCatchpointLabel(progress.Version, rnd, progress.BlockHeaderDigest, balancesHash, spVerificationHash, onlineAccountsHash, onlineRoundParamsHash, totals)
+		require.NoError(t, err)
+		require.Equal(t, labels[rnd], label)
+	}
+}
+
+func TestCatchpointDeltaKeysJournal(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	addr1 := ledgertesting.RandomAddress()
+	addr2 := ledgertesting.RandomAddress()
+	accountsDeltas := compactAccountDeltas{deltas: []accountDelta{{address: addr1}}}
+	resourcesDeltas := compactResourcesDeltas{deltas: []resourceDelta{{address: addr2, oldResource: trackerdb.PersistedResourcesData{Aidx: 7}}}}
+	kvDeltas := map[string]modifiedKvValue{"bx:1": {}}
+
+	journalPath := filepath.Join(t.TempDir(), "catchpoints", catchpointDeltaKeysJournalFileName)
+	var keys catchpointDeltaKeys
+	require.NoError(t, keys.load(journalPath, 10))
+	require.Zero(t, keys.baseRound)
+	require.NoError(t, keys.reset(16))
+	require.NoError(t, keys.record(&accountsDeltas, &compactResourcesDeltas{}, nil, 18))
+	require.NoError(t, keys.record(&compactAccountDeltas{}, &resourcesDeltas, kvDeltas, 20))
+	keys.closeJournal()
+
+	// the keys written up to the database round are restored
+	var loaded catchpointDeltaKeys
+	require.NoError(t, loaded.load(journalPath, 20))
+	defer loaded.closeJournal()
+	require.Equal(t, basics.Round(16), loaded.baseRound)
+	require.Equal(t, keys.accounts, loaded.accounts)
+	require.Equal(t, keys.kvs, loaded.kvs)
+	require.Contains(t, loaded.accounts[addr2], basics.CreatableIndex(7))
+
+	// the journal keeps being appended to
+	kvDeltas = map[string]modifiedKvValue{"bx:2": {}}
+	require.NoError(t, loaded.record(&compactAccountDeltas{}, &compactResourcesDeltas{}, kvDeltas, 24))
+	loaded.closeJournal()
+	require.NoError(t, loaded.load(journalPath, 24))
+	require.Contains(t, loaded.kvs, "bx:2")
+	require.Len(t, loaded.kvs, 2)
+
+	// a journal that doesn't cover all the commits up to the database round is incomplete
+	require.NoError(t, loaded.load(journalPath, 28))
+	require.Zero(t, loaded.baseRound)
+	require.Empty(t, loaded.accounts)
+	require.NoFileExists(t, journalPath)
+}
+
+func TestCatchpointDeltaChunkSize(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var out bytes.Buffer
+	w := catchpointDeltaDataWriter{tar: tar.NewWriter(&out)}
+	const count = 400
+	for i := 0; i < count; i++ {
+		acct := CatchpointDeltaAccount{
+			Address:     ledgertesting.RandomAddress(),
+			AccountData: make([]byte, MaxEncodedBaseAccountDataSize),
+			Resources:   map[uint64][]byte{1: make([]byte, MaxEncodedBaseResourceDataSize)},
+		}
+		require.NoError(t, w.addAccount(acct))
+	}
+	for i := 0; i < count; i++ {
+		require.NoError(t, w.addKV(CatchpointDeltaKV{Key: []byte(fmt.Sprintf("bx:%d", i)), Value: make([]byte, encoded.MaxEncodedKVDataSize-64)}))
+	}
+	require.NoError(t, w.flushChunk())
+	require.NoError(t, w.tar.Close())
+
+	// neither the accounts nor the KVs fill a chunk by count, yet together they are too large for a single chunk.
+	var accounts, kvs int
+	err := forEachCatchpointEntry(context.Background(), tar.NewReader(&out), func(name string, data []byte) error {
+		require.LessOrEqual(t, len(data), maxCatchpointDeltaChunkSize)
+		var chunk CatchpointDeltaChunk
+		require.NoError(t, protocol.DecodeReflect(data, &chunk))
+		accounts += len(chunk.Accounts)
+		kvs += len(chunk.KVs)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Greater(t, w.chunkNum, uint64(1))
+	require.Equal(t, count, accounts)
+	require.Equal(t, count, kvs)
+}
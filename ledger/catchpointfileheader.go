@@ -17,11 +17,21 @@
 package ledger
 
 import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
 )
 
+// maxCatchpointFileHeaderSize bounds the size of the header entry of a catchpoint file we are willing to read.
+const maxCatchpointFileHeaderSize = 1024 * 1024
+
 // CatchpointFileHeader is the content we would have in the "content.msgpack" file in the catchpoint tar archive.
 // we need it to be public, as it's being decoded externally by the catchpointdump utility.
 type CatchpointFileHeader struct {
@@ -39,3 +49,68 @@ type CatchpointFileHeader struct {
 	Catchpoint             string                   `codec:"catchpoint"`
 	BlockHeaderDigest      crypto.Digest            `codec:"blockHeaderDigest"`
 }
+
+// CatchpointFileReader reads a catchpoint file, which is either a tar or a gzip compressed tar, starting with its header.
+type CatchpointFileReader struct {
+	// Header is the decoded header of the catchpoint file, and EncodedHeader is the content of its header entry.
+	Header        CatchpointFileHeader
+	EncodedHeader []byte
+	// Entries reads the entries of the catchpoint file following its header.
+	Entries *tar.Reader
+
+	gzipReader *gzip.Reader
+}
+
+// OpenCatchpointFile reads the header at the start of the provided catchpoint file stream, and returns a reader
+// positioned on the entry following it. The caller has to close the returned reader, which doesn't close in.
+func OpenCatchpointFile(in io.Reader) (*CatchpointFileReader, error) {
+	reader := bufio.NewReader(in)
+	cfr := &CatchpointFileReader{}
+	if prefix, err := reader.Peek(2); err == nil && prefix[0] == 0x1f && prefix[1] == 0x8b {
+		cfr.gzipReader, err = gzip.NewReader(reader)
+		if err != nil {
+			return nil, err
+		}
+		cfr.Entries = tar.NewReader(cfr.gzipReader)
+	} else {
+		cfr.Entries = tar.NewReader(reader)
+	}
+
+	err := cfr.readHeader()
+	if err != nil {
+		cfr.Close()
+		return nil, err
+	}
+	return cfr, nil
+}
+
+func (cfr *CatchpointFileReader) readHeader() error {
+	header, err := cfr.Entries.Next()
+	if err != nil {
+		return fmt.Errorf("unable to read the catchpoint file header : %w", err)
+	}
+	if header.Name != CatchpointContentFileName {
+		return fmt.Errorf("the catchpoint file starts with %s rather than %s", header.Name, CatchpointContentFileName)
+	}
+	if header.Size < 1 || header.Size > maxCatchpointFileHeaderSize {
+		return fmt.Errorf("the catchpoint file has a header with data size of %d", header.Size)
+	}
+	cfr.EncodedHeader = make([]byte, header.Size)
+	_, err = io.ReadFull(cfr.Entries, cfr.EncodedHeader)
+	if err != nil {
+		return fmt.Errorf("unable to read the catchpoint file header : %w", err)
+	}
+	err = protocol.Decode(cfr.EncodedHeader, &cfr.Header)
+	if err != nil {
+		return fmt.Errorf("unable to decode the catchpoint file header : %w", err)
+	}
+	return nil
+}
+
+// Close releases the decompressor of the catchpoint file, if any.
+func (cfr *CatchpointFileReader) Close() error {
+	if cfr.gzipReader != nil {
+		return cfr.gzipReader.Close()
+	}
+	return nil
+}
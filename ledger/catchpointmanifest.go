@@ -0,0 +1,185 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// maxCatchpointManifestSize bounds the size of a manifest file we are willing to read.
+const maxCatchpointManifestSize = 64 * 1024 * 1024
+
+// CatchpointManifest describes the layout of a catchpoint file. Every tar entry of
+// the file is compressed as a separate gzip member, so each entry can be fetched
+// with an HTTP range request and verified on its own before it is processed.
+//
+//msgp:ignore CatchpointManifest
+type CatchpointManifest struct {
+	// Round is the catchpoint round, matching the BlocksRound of the file header.
+	Round basics.Round `codec:"round"`
+	// Catchpoint is the label of the catchpoint the file was created for.
+	Catchpoint string `codec:"catchpoint"`
+	// FileSize is the size of the catchpoint file, in bytes.
+	FileSize int64 `codec:"size"`
+	// Chunks lists the tar entries of the file in the order they appear in it.
+	Chunks []CatchpointManifestChunk `codec:"chunks"`
+}
+
+// CatchpointManifestChunk describes a single tar entry of a catchpoint file.
+//
+//msgp:ignore CatchpointManifestChunk
+type CatchpointManifestChunk struct {
+	// Name is the tar entry name, i.e. content.msgpack or balances.N.msgpack.
+	Name string `codec:"name"`
+	// Offset is where the gzip member holding the entry starts in the file.
+	Offset int64 `codec:"off"`
+	// Length is the compressed length of the gzip member.
+	Length int64 `codec:"len"`
+	// Size is the uncompressed length of the entry content.
+	Size int64 `codec:"esize"`
+	// Hash is the hash of the uncompressed entry content.
+	Hash crypto.Digest `codec:"hash"`
+}
+
+// Compatible checks that two manifests describe the same catchpoint content. The
+// offsets may differ, since peers might have compressed the content differently.
+func (m *CatchpointManifest) Compatible(other *CatchpointManifest) bool {
+	if m.Round != other.Round || m.Catchpoint != other.Catchpoint || len(m.Chunks) != len(other.Chunks) {
+		return false
+	}
+	for i := range m.Chunks {
+		a, b := &m.Chunks[i], &other.Chunks[i]
+		if a.Name != b.Name || a.Size != b.Size || a.Hash != b.Hash {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate checks that the chunks are laid out back to back within the file.
+func (m *CatchpointManifest) Validate() error {
+	var offset int64
+	for i := range m.Chunks {
+		chunk := &m.Chunks[i]
+		if chunk.Offset != offset || chunk.Length <= 0 || chunk.Size < 0 {
+			return fmt.Errorf("catchpoint manifest chunk %d (%s) has an invalid layout", i, chunk.Name)
+		}
+		offset += chunk.Length
+	}
+	if offset > m.FileSize {
+		return fmt.Errorf("catchpoint manifest chunks exceed the file size %d", m.FileSize)
+	}
+	return nil
+}
+
+// countingWriter counts the bytes written through it.
+type countingWriter struct {
+	io.Writer
+	written int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.Writer.Write(p)
+	w.written += int64(n)
+	return n, err
+}
+
+// catchpointManifestBuilder is the sink for the tar writer of a catchpoint file.
+// It compresses every tar entry into a separate gzip member and records the
+// member boundaries in the manifest.
+type catchpointManifestBuilder struct {
+	out         countingWriter
+	member      *gzip.Writer
+	memberStart int64
+	manifest    CatchpointManifest
+}
+
+func makeCatchpointManifestBuilder(out io.Writer, header *CatchpointFileHeader) (*catchpointManifestBuilder, error) {
+	b := &catchpointManifestBuilder{
+		out: countingWriter{Writer: out},
+		manifest: CatchpointManifest{
+			Round:      header.BlocksRound,
+			Catchpoint: header.Catchpoint,
+		},
+	}
+	var err error
+	b.member, err = gzip.NewWriterLevel(&b.out, gzip.BestSpeed)
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func (b *catchpointManifestBuilder) Write(p []byte) (int, error) {
+	return b.member.Write(p)
+}
+
+// entryDone closes the gzip member holding the entry that was just written (the tar
+// writer must have been flushed) and starts a new member for the following one.
+func (b *catchpointManifestBuilder) entryDone(name string, data []byte) error {
+	err := b.member.Close()
+	if err != nil {
+		return err
+	}
+	b.manifest.Chunks = append(b.manifest.Chunks, CatchpointManifestChunk{
+		Name:   name,
+		Offset: b.memberStart,
+		Length: b.out.written - b.memberStart,
+		Size:   int64(len(data)),
+		Hash:   crypto.Hash(data),
+	})
+	b.memberStart = b.out.written
+	b.member.Reset(&b.out)
+	return nil
+}
+
+// Close closes the last gzip member, which holds the tar trailer.
+func (b *catchpointManifestBuilder) Close() error {
+	err := b.member.Close()
+	if err != nil {
+		return err
+	}
+	b.manifest.FileSize = b.out.written
+	return nil
+}
+
+// writeCatchpointManifest stores the manifest next to its catchpoint file.
+func writeCatchpointManifest(path string, manifest *CatchpointManifest) error {
+	return os.WriteFile(path, protocol.EncodeReflect(manifest), 0644)
+}
+
+// readCatchpointManifest loads a manifest stored by writeCatchpointManifest.
+func readCatchpointManifest(path string) (manifest CatchpointManifest, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return CatchpointManifest{}, err
+	}
+	defer f.Close()
+	bytes, err := io.ReadAll(io.LimitReader(f, maxCatchpointManifestSize))
+	if err != nil {
+		return CatchpointManifest{}, err
+	}
+	err = protocol.DecodeReflect(bytes, &manifest)
+	return manifest, err
+}
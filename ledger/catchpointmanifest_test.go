@@ -0,0 +1,110 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestCatchpointManifestMatchesFile(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	testProtocolVersion := protocol.ConsensusVersion("test-protocol-TestCatchpointManifestMatchesFile")
+	protoParams := config.Consensus[protocol.ConsensusCurrentVersion]
+	protoParams.CatchpointLookback = 32
+	config.Consensus[testProtocolVersion] = protoParams
+	temporaryDirectory := t.TempDir()
+	defer func() {
+		delete(config.Consensus, testProtocolVersion)
+	}()
+
+	accts := ledgertesting.RandomAccounts(BalancesPerCatchpointFileChunk*3, false)
+	ml := makeMockLedgerForTracker(t, true, 10, testProtocolVersion, []map[basics.Address]basics.AccountData{accts})
+	defer ml.Close()
+
+	conf := config.GetDefaultLocal()
+	conf.CatchpointInterval = 1
+	conf.Archival = true
+	au, _ := newAcctUpdates(t, ml, conf)
+	err := au.loadFromDisk(ml, 0)
+	require.NoError(t, err)
+	au.close()
+	catchpointDataFilePath := filepath.Join(temporaryDirectory, "15.data")
+	catchpointFilePath := filepath.Join(temporaryDirectory, "15.catchpoint")
+	header := testWriteCatchpoint(t, protoParams, ml.trackerDB(), catchpointDataFilePath, catchpointFilePath, 0, 0)
+
+	manifest, err := readCatchpointManifest(trackerdb.MakeCatchpointManifestFilePath(catchpointFilePath))
+	require.NoError(t, err)
+	require.NoError(t, manifest.Validate())
+	require.Equal(t, header.BlocksRound, manifest.Round)
+	require.Equal(t, header.Catchpoint, manifest.Catchpoint)
+	require.True(t, manifest.Compatible(&manifest))
+
+	file, err := os.ReadFile(catchpointFilePath)
+	require.NoError(t, err)
+	require.Equal(t, int64(len(file)), manifest.FileSize)
+
+	// every chunk is a standalone gzip member holding a single tar entry.
+	require.Len(t, manifest.Chunks, int(header.TotalChunks)+2) // content.msgpack and stateProofVerificationContext.msgpack
+	require.Equal(t, CatchpointContentFileName, manifest.Chunks[0].Name)
+	for _, chunk := range manifest.Chunks {
+		gz, err := gzip.NewReader(bytes.NewReader(file[chunk.Offset : chunk.Offset+chunk.Length]))
+		require.NoError(t, err)
+		tr := tar.NewReader(gz)
+		hdr, err := tr.Next()
+		require.NoError(t, err)
+		require.Equal(t, chunk.Name, hdr.Name)
+		data, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		require.Equal(t, chunk.Size, int64(len(data)))
+		require.Equal(t, chunk.Hash, crypto.Hash(data))
+	}
+
+	// the file as a whole is still a regular gzip compressed tar.
+	gz, err := gzip.NewReader(bytes.NewReader(file))
+	require.NoError(t, err)
+	tr := tar.NewReader(gz)
+	for i := range manifest.Chunks {
+		hdr, err := tr.Next()
+		require.NoError(t, err)
+		require.Equal(t, manifest.Chunks[i].Name, hdr.Name)
+	}
+	_, err = tr.Next()
+	require.Equal(t, io.EOF, err)
+
+	// removing the catchpoint file removes its manifest as well.
+	err = trackerdb.RemoveSingleCatchpointFileFromDisk(temporaryDirectory, "15.catchpoint")
+	require.NoError(t, err)
+	_, err = os.Stat(trackerdb.MakeCatchpointManifestFilePath(catchpointFilePath))
+	require.True(t, os.IsNotExist(err))
+}
@@ -19,7 +19,6 @@ package ledger
 import (
 	"archive/tar"
 	"bytes"
-	"compress/gzip"
 	"context"
 	"database/sql"
 	"encoding/base32"
@@ -109,6 +108,13 @@ type catchpointTracker struct {
 	// enableGeneratingCatchpointFiles determines whether catchpoints files should be generated by the trackers.
 	enableGeneratingCatchpointFiles bool
 
+	// enableCatchpointDeltaFiles determines whether catchpoint delta files, describing the changes since the previous
+	// catchpoint, should be generated along with the catchpoint files.
+	enableCatchpointDeltaFiles bool
+
+	// deltaKeys tracks the records written since the last first stage round, for generating the next catchpoint delta file.
+	deltaKeys catchpointDeltaKeys
+
 	// log copied from ledger
 	log logging.Logger
 
@@ -183,6 +189,8 @@ func (ct *catchpointTracker) initialize(cfg config.Local, paths DirsAndPrefix) {
 		ct.enableGeneratingCatchpointFiles = true
 	}
 
+	ct.enableCatchpointDeltaFiles = ct.enableGeneratingCatchpointFiles && cfg.EnableCatchpointDeltaFiles
+
 	ct.catchpointFileHistoryLength = cfg.CatchpointFileHistoryLength
 	if cfg.CatchpointFileHistoryLength < -1 {
 		ct.catchpointFileHistoryLength = -1
@@ -215,6 +223,14 @@ func (ct *catchpointTracker) getSPVerificationData() (encodedData []byte, spVeri
 
 func (ct *catchpointTracker) finishFirstStage(ctx context.Context, dbRound basics.Round, onlineAccountsForgetBefore basics.Round, blockProto protocol.ConsensusVersion, updatingBalancesDuration time.Duration) error {
 	ct.log.Infof("finishing catchpoint's first stage dbRound: %d", dbRound)
+	if ct.enableCatchpointDeltaFiles {
+		// whatever the outcome, the next catchpoint delta file describes the records written after this round.
+		defer func() {
+			if resetErr := ct.deltaKeys.reset(dbRound); resetErr != nil {
+				ct.log.Warnf("unable to persist catchpoint delta keys for round %d: %v", dbRound, resetErr)
+			}
+		}()
+	}
 
 	var totalAccounts, totalKVs, totalOnlineAccounts, totalOnlineRoundParams uint64
 	var totalChunks uint64
@@ -283,6 +299,9 @@ func (ct *catchpointTracker) finishFirstStage(ctx context.Context, dbRound basic
 		catchpointGenerationStats.BalancesWriteTime = uint64(updatingBalancesDuration.Nanoseconds())
 		totalAccounts, totalKVs, totalOnlineAccounts, totalOnlineRoundParams, totalChunks, biggestChunkLen, err = ct.generateCatchpointData(
 			ctx, params, dbRound, onlineExcludeBefore, &catchpointGenerationStats, spVerificationEncodedData)
+		if err == nil && ct.enableCatchpointDeltaFiles {
+			ct.generateCatchpointDeltaData(ctx, params, dbRound, onlineExcludeBefore, spVerificationEncodedData)
+		}
 		ct.catchpointDataWriting.Store(0)
 		if err != nil {
 			return err
@@ -413,6 +432,14 @@ func (ct *catchpointTracker) loadFromDisk(l ledgerForTracker, dbRound basics.Rou
 	ct.catchpointDataSlowWriting = make(chan struct{}, 1)
 	close(ct.catchpointDataSlowWriting)
 	ct.catchpointsMu.Unlock()
+	if ct.enableCatchpointDeltaFiles {
+		// the records written before the tracker was loaded are restored from their journal, if it covers all of them.
+		journalPath := filepath.Join(ct.tmpDir, trackerdb.CatchpointDirName, catchpointDeltaKeysJournalFileName)
+		err = ct.deltaKeys.load(journalPath, dbRound)
+		if err != nil {
+			ct.log.Warnf("unable to load catchpoint delta keys: %v", err)
+		}
+	}
 
 	err = ct.dbs.Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) error {
 		return ct.initializeHashes(ctx, tx, dbRound)
@@ -630,6 +657,14 @@ func (ct *catchpointTracker) commitRound(ctx context.Context, tx trackerdb.Trans
 		return err
 	}
 
+	if ct.enableCatchpointDeltaFiles {
+		recordErr := ct.deltaKeys.record(&dcc.compactAccountDeltas, &dcc.compactResourcesDeltas, dcc.compactKvDeltas, dcc.newBase())
+		if recordErr != nil {
+			// the keys are still complete in memory, yet the next catchpoint delta file would be skipped after a restart.
+			ct.log.Warnf("unable to persist catchpoint delta keys for round %d: %v", dcc.newBase(), recordErr)
+		}
+	}
+
 	if dcc.updateStats {
 		now := time.Duration(time.Now().UnixNano())
 		dcc.stats.MerkleTrieUpdateDuration = now - dcc.stats.MerkleTrieUpdateDuration
@@ -683,7 +718,9 @@ func (ct *catchpointTracker) postCommit(ctx context.Context, dcc *deferredCommit
 	}
 }
 
-func doRepackCatchpoint(ctx context.Context, header CatchpointFileHeader, biggestChunkLen uint64, in *tar.Reader, out *tar.Writer) error {
+// doRepackCatchpoint copies the header and the chunks into out. entryDone, when
+// not nil, is called after each entry was written and flushed.
+func doRepackCatchpoint(ctx context.Context, header CatchpointFileHeader, biggestChunkLen uint64, in *tar.Reader, out *tar.Writer, entryDone func(name string, data []byte) error) error {
 	bytes := protocol.Encode(&header)
 
 	err := out.WriteHeader(&tar.Header{
@@ -699,6 +736,10 @@ func doRepackCatchpoint(ctx context.Context, header CatchpointFileHeader, bigges
 	if err != nil {
 		return err
 	}
+	err = repackEntryDone(out, entryDone, CatchpointContentFileName, bytes)
+	if err != nil {
+		return err
+	}
 
 	// make buffer for re-use that can fit biggest chunk
 	buf := make([]byte, biggestChunkLen)
@@ -733,14 +774,33 @@ func doRepackCatchpoint(ctx context.Context, header CatchpointFileHeader, bigges
 		if err != nil {
 			return err
 		}
+		err = repackEntryDone(out, entryDone, header.Name, buf[:header.Size])
+		if err != nil {
+			return err
+		}
 	}
 }
 
+func repackEntryDone(out *tar.Writer, entryDone func(name string, data []byte) error, name string, data []byte) error {
+	if entryDone == nil {
+		return nil
+	}
+	// flush the entry padding so that the entry ends on the current output.
+	err := out.Flush()
+	if err != nil {
+		return err
+	}
+	return entryDone(name, data)
+}
+
 // repackCatchpoint takes the header (that must be made "late" in order to have
 // the latest blockhash) and the (snappy compressed) catchpoint data from
 // dataPath and regurgitates it to look like catchpoints have always looked - a
 // tar file with the header in the first "file" and the catchpoint data in file
-// chunks, all compressed with gzip instead of snappy.
+// chunks, all compressed with gzip instead of snappy. Each tar entry is compressed
+// as its own gzip member, and the member boundaries are written to a manifest
+// file next to outPath so that peers can download and verify the file in chunks.
+// Readers that are unaware of the manifest see a regular multistream gzip file.
 func repackCatchpoint(ctx context.Context, header CatchpointFileHeader, biggestChunkLen uint64, dataPath string, outPath string) error {
 	// Initialize streams.
 	fin, err := os.OpenFile(dataPath, os.O_RDONLY, 0666)
@@ -763,17 +823,17 @@ func repackCatchpoint(ctx context.Context, header CatchpointFileHeader, biggestC
 	}
 	defer fout.Close()
 
-	gzipOut, err := gzip.NewWriterLevel(fout, gzip.BestSpeed)
+	manifestOut, err := makeCatchpointManifestBuilder(fout, &header)
 	if err != nil {
 		return err
 	}
-	defer gzipOut.Close()
+	defer manifestOut.Close()
 
-	tarOut := tar.NewWriter(gzipOut)
+	tarOut := tar.NewWriter(manifestOut)
 	defer tarOut.Close()
 
 	// Repack.
-	err = doRepackCatchpoint(ctx, header, biggestChunkLen, tarIn, tarOut)
+	err = doRepackCatchpoint(ctx, header, biggestChunkLen, tarIn, tarOut, manifestOut.entryDone)
 	if err != nil {
 		return err
 	}
@@ -784,7 +844,7 @@ func repackCatchpoint(ctx context.Context, header CatchpointFileHeader, biggestC
 		return err
 	}
 
-	err = gzipOut.Close()
+	err = manifestOut.Close()
 	if err != nil {
 		return err
 	}
@@ -794,6 +854,11 @@ func repackCatchpoint(ctx context.Context, header CatchpointFileHeader, biggestC
 		return err
 	}
 
+	err = writeCatchpointManifest(trackerdb.MakeCatchpointManifestFilePath(outPath), &manifestOut.manifest)
+	if err != nil {
+		return err
+	}
+
 	err = compressorIn.Close()
 	if err != nil {
 		return err
@@ -840,6 +905,7 @@ func (ct *catchpointTracker) createCatchpoint(ctx context.Context, accountsRound
 	}
 
 	ct.catchpointsMu.Lock()
+	baseLabel := ct.lastCatchpointLabel
 	ct.lastCatchpointLabel = label
 	ct.catchpointsMu.Unlock()
 
@@ -888,6 +954,14 @@ func (ct *catchpointTracker) createCatchpoint(ctx context.Context, accountsRound
 		return err
 	}
 
+	if ct.enableCatchpointDeltaFiles {
+		err = ct.createCatchpointDelta(ctx, &header, baseLabel, catchpointDataFilePath, absCatchpointFilePath)
+		if err != nil {
+			// the catchpoint file is usable without its delta file.
+			ct.log.Warnf("unable to create catchpoint delta file for round %d: %v", round, err)
+		}
+	}
+
 	fileInfo, err := os.Stat(absCatchpointFilePath)
 	if err != nil {
 		return err
@@ -1078,6 +1152,7 @@ func (ct *catchpointTracker) cancelWrite(dcc *deferredCommitContext) {
 // be called even if loadFromDisk() is not called or does
 // not succeed.
 func (ct *catchpointTracker) close() {
+	ct.deltaKeys.closeJournal()
 }
 
 // accountsUpdateBalances applies the given compactAccountDeltas to the merkle trie
@@ -1415,6 +1490,74 @@ func (ct *catchpointTracker) recordFirstStageInfo(ctx context.Context, tx tracke
 	return nil
 }
 
+// generateCatchpointDeltaData writes the first stage data of the catchpoint delta file, describing the changes since
+// the previous first stage round. Like generateCatchpointData, it expects the accounts data not to be modified in the
+// background during its execution. Failing to generate the delta data only means the catchpoint would have no delta file.
+func (ct *catchpointTracker) generateCatchpointDeltaData(ctx context.Context, params config.ConsensusParams, accountsRound basics.Round, onlineExcludeBefore basics.Round, encodedSPData []byte) {
+	if ct.deltaKeys.baseRound == 0 || accountsRound != ct.deltaKeys.baseRound+basics.Round(ct.catchpointInterval) {
+		ct.log.Infof("catchpoint delta data for round %d is not generated, since the changes since round %d are not fully tracked", accountsRound, accountsRound.SubSaturate(basics.Round(ct.catchpointInterval)))
+		return
+	}
+
+	startTime := time.Now()
+	relCatchpointDeltaDataFilePath := filepath.Join(trackerdb.CatchpointDirName, trackerdb.MakeCatchpointDeltaFilePath(makeCatchpointDataFilePath(accountsRound)))
+	catchpointDeltaDataFilePath := filepath.Join(ct.tmpDir, relCatchpointDeltaDataFilePath)
+	err := ct.dbs.Snapshot(func(dbCtx context.Context, tx trackerdb.SnapshotScope) error {
+		return writeCatchpointDeltaData(dbCtx, tx, params, catchpointDeltaDataFilePath, &ct.deltaKeys, accountsRound, onlineExcludeBefore, encodedSPData)
+	})
+	if err != nil {
+		ct.log.Warnf("unable to generate catchpoint delta data for round %d: %v", accountsRound, err)
+		return
+	}
+
+	ct.log.With("accountsRound", accountsRound).
+		With("writingDuration", uint64(time.Since(startTime).Nanoseconds())).
+		With("accountsCount", len(ct.deltaKeys.accounts)).
+		With("kvsCount", len(ct.deltaKeys.kvs)).
+		With("filepath", relCatchpointDeltaDataFilePath).
+		Infof("Catchpoint delta data file was generated")
+}
+
+// createCatchpointDelta creates the delta file of the catchpoint described by header, out of the first stage delta data
+// stored next to the catchpoint data file. The delta applies on top of the previous catchpoint, whose label is baseLabel.
+func (ct *catchpointTracker) createCatchpointDelta(ctx context.Context, header *CatchpointFileHeader, baseLabel string, catchpointDataFilePath string, absCatchpointFilePath string) error {
+	catchpointDeltaDataFilePath := trackerdb.MakeCatchpointDeltaFilePath(catchpointDataFilePath)
+	_, err := os.Stat(catchpointDeltaDataFilePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	baseRound := header.BlocksRound.SubSaturate(basics.Round(ct.catchpointInterval))
+	if baseLabel == "" {
+		return nil
+	}
+	if labelRound, _, err := ledgercore.ParseCatchpointLabel(baseLabel); err != nil || labelRound != baseRound {
+		// the previous catchpoint was skipped, so the delta data can't be applied on top of the last label.
+		return nil
+	}
+
+	deltaHeader := CatchpointDeltaFileHeader{
+		Version:           header.Version,
+		BaseRound:         baseRound,
+		BaseCatchpoint:    baseLabel,
+		BalancesRound:     header.BalancesRound,
+		BlocksRound:       header.BlocksRound,
+		Totals:            header.Totals,
+		Catchpoint:        header.Catchpoint,
+		BlockHeaderDigest: header.BlockHeaderDigest,
+	}
+	absCatchpointDeltaFilePath := trackerdb.MakeCatchpointDeltaFilePath(absCatchpointFilePath)
+	err = repackCatchpointDelta(ctx, deltaHeader, catchpointDeltaDataFilePath, absCatchpointDeltaFilePath)
+	if err != nil {
+		os.Remove(absCatchpointDeltaFilePath)
+		return err
+	}
+	return nil
+}
+
 func makeCatchpointDataFilePath(accountsRound basics.Round) string {
 	return strconv.FormatInt(int64(accountsRound), 10) + ".data"
 }
@@ -1484,7 +1627,7 @@ func (ct *catchpointTracker) GetCatchpointStream(round basics.Round) (ReadCloseS
 		catchpointPath := filepath.Join(ct.dbDirectory, dbFileName)
 		file, openErr := os.OpenFile(catchpointPath, os.O_RDONLY, 0666)
 		if openErr == nil && file != nil {
-			return &readCloseSizer{ReadCloser: file, size: fileSize}, nil
+			return makeReadCloseSizer(file, fileSize), nil
 		}
 		// else, see if this is a file-not-found error
 		if os.IsNotExist(openErr) {
@@ -1515,7 +1658,7 @@ func (ct *catchpointTracker) GetCatchpointStream(round basics.Round) (ReadCloseS
 		fileInfo, err := file.Stat()
 		if err != nil {
 			// we couldn't get the stat, so just return with the file.
-			return &readCloseSizer{ReadCloser: file, size: -1}, nil //nolint:nilerr // intentionally ignoring Stat error
+			return makeReadCloseSizer(file, -1), nil //nolint:nilerr // intentionally ignoring Stat error
 		}
 		crw, err := ct.dbs.MakeCatchpointReaderWriter()
 		if err != nil {
@@ -1525,11 +1668,75 @@ func (ct *catchpointTracker) GetCatchpointStream(round basics.Round) (ReadCloseS
 		if err != nil {
 			ct.log.Warnf("catchpointTracker.GetCatchpointStream() unable to save missing catchpoint entry: %v", err)
 		}
-		return &readCloseSizer{ReadCloser: file, size: fileInfo.Size()}, nil
+		return makeReadCloseSizer(file, fileInfo.Size()), nil
 	}
 	return nil, ledgercore.ErrNoEntry{}
 }
 
+// GetCatchpointManifest returns the chunk manifest of the catchpoint file associated with the provided round.
+// Catchpoint files created before manifests were introduced have none, and ledgercore.ErrNoEntry is returned for them.
+func (ct *catchpointTracker) GetCatchpointManifest(round basics.Round) (CatchpointManifest, error) {
+	dbFileName := ""
+	err := ct.dbs.Snapshot(func(ctx context.Context, tx trackerdb.SnapshotScope) (err error) {
+		cr, err := tx.MakeCatchpointReader()
+		if err != nil {
+			return err
+		}
+
+		dbFileName, _, _, err = cr.GetCatchpoint(ctx, round)
+		return
+	})
+	if err != nil && err != sql.ErrNoRows {
+		return CatchpointManifest{}, fmt.Errorf("catchpointTracker.GetCatchpointManifest() unable to lookup catchpoint %d: %v", round, err)
+	}
+	if dbFileName == "" {
+		dbFileName = filepath.Join(trackerdb.CatchpointDirName, trackerdb.MakeCatchpointFilePath(round))
+	}
+	manifestPath := trackerdb.MakeCatchpointManifestFilePath(filepath.Join(ct.dbDirectory, dbFileName))
+	manifest, err := readCatchpointManifest(manifestPath)
+	if os.IsNotExist(err) {
+		return CatchpointManifest{}, ledgercore.ErrNoEntry{Round: round}
+	}
+	if err != nil {
+		return CatchpointManifest{}, fmt.Errorf("catchpointTracker.GetCatchpointManifest() unable to read catchpoint manifest '%s' %v", manifestPath, err)
+	}
+	return manifest, nil
+}
+
+// GetCatchpointDeltaStream returns a ReadCloseSizer to the catchpoint delta file associated with the provided round,
+// describing the changes since the catchpoint CatchpointInterval rounds earlier.
+func (ct *catchpointTracker) GetCatchpointDeltaStream(round basics.Round) (ReadCloseSizer, error) {
+	dbFileName := ""
+	err := ct.dbs.Snapshot(func(ctx context.Context, tx trackerdb.SnapshotScope) (err error) {
+		cr, err := tx.MakeCatchpointReader()
+		if err != nil {
+			return err
+		}
+
+		dbFileName, _, _, err = cr.GetCatchpoint(ctx, round)
+		return
+	})
+	if err != nil && err != sql.ErrNoRows {
+		return nil, fmt.Errorf("catchpointTracker.GetCatchpointDeltaStream() unable to lookup catchpoint %d: %v", round, err)
+	}
+	if dbFileName == "" {
+		dbFileName = filepath.Join(trackerdb.CatchpointDirName, trackerdb.MakeCatchpointFilePath(round))
+	}
+	deltaPath := trackerdb.MakeCatchpointDeltaFilePath(filepath.Join(ct.dbDirectory, dbFileName))
+	file, err := os.OpenFile(deltaPath, os.O_RDONLY, 0666)
+	if os.IsNotExist(err) {
+		return nil, ledgercore.ErrNoEntry{Round: round}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("catchpointTracker.GetCatchpointDeltaStream() unable to open catchpoint delta file '%s' %v", deltaPath, err)
+	}
+	fileInfo, err := file.Stat()
+	if err != nil {
+		return makeReadCloseSizer(file, -1), nil //nolint:nilerr // intentionally ignoring Stat error
+	}
+	return makeReadCloseSizer(file, fileInfo.Size()), nil
+}
+
 func (ct *catchpointTracker) catchpointEnabled() bool {
 	return ct.catchpointInterval != 0
 }
@@ -22,6 +22,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"path/filepath"
 	"strings"
 	"sync"
 	"time"
@@ -42,6 +43,10 @@ import (
 	"github.com/algorand/go-algorand/util/metrics"
 )
 
+// keptCatchpointFileName is the name of the catchpoint file kept from the last catchup that downloaded one, which
+// catchpoint delta files are applied to by later catchups.
+const keptCatchpointFileName = "catchup.catchpoint"
+
 // CatchpointCatchupAccessor is an interface for the accessor wrapping the database storage for the catchpoint catchup functionality.
 type CatchpointCatchupAccessor interface {
 	// GetState returns the current state of the catchpoint catchup
@@ -56,6 +61,16 @@ type CatchpointCatchupAccessor interface {
 	// SetLabel set the catchpoint catchup label
 	SetLabel(ctx context.Context, label string) (err error)
 
+	// GetCatchpointFile returns the local catchpoint file the catchup loads the ledger from, if any
+	GetCatchpointFile(ctx context.Context) (path string, err error)
+
+	// SetCatchpointFile set the local catchpoint file the catchup loads the ledger from
+	SetCatchpointFile(ctx context.Context, path string) (err error)
+
+	// GetKeptCatchpointFile returns the path of the catchpoint file kept from the last catchup that downloaded one, or
+	// an empty string if the ledger has no directory to keep it in
+	GetKeptCatchpointFile() (path string)
+
 	// ResetStagingBalances resets the current staging balances, preparing for a new set of balances to be added
 	ResetStagingBalances(ctx context.Context, newCatchup bool) (err error)
 
@@ -264,6 +279,7 @@ type CatchupAccessorClientLedger interface {
 	GenesisHash() crypto.Digest
 	BlockHdr(rnd basics.Round) (blk bookkeeping.BlockHeader, err error)
 	Latest() (rnd basics.Round)
+	GetCatchpointStream(round basics.Round) (ReadCloseSizer, error)
 }
 
 // MakeCatchpointCatchupAccessor creates a CatchpointCatchupAccessor given a ledger
@@ -323,6 +339,33 @@ func (c *catchpointCatchupAccessorImpl) SetLabel(ctx context.Context, label stri
 	return
 }
 
+// GetCatchpointFile returns the local catchpoint file the catchup loads the ledger from, if any
+func (c *catchpointCatchupAccessorImpl) GetCatchpointFile(ctx context.Context) (path string, err error) {
+	path, err = c.catchpointStore.ReadCatchpointStateString(ctx, trackerdb.CatchpointStateCatchupFile)
+	if err != nil {
+		return "", fmt.Errorf("unable to read catchpoint catchup state '%s': %v", trackerdb.CatchpointStateCatchupFile, err)
+	}
+	return
+}
+
+// GetKeptCatchpointFile returns the path of the catchpoint file kept from the last catchup that downloaded one, or
+// an empty string if the ledger has no directory to keep it in
+func (c *catchpointCatchupAccessorImpl) GetKeptCatchpointFile() (path string) {
+	if c.ledger.dirsAndPrefix.CatchpointGenesisDir == "" {
+		return ""
+	}
+	return filepath.Join(c.ledger.dirsAndPrefix.CatchpointGenesisDir, trackerdb.CatchpointDirName, keptCatchpointFileName)
+}
+
+// SetCatchpointFile set the local catchpoint file the catchup loads the ledger from
+func (c *catchpointCatchupAccessorImpl) SetCatchpointFile(ctx context.Context, path string) (err error) {
+	err = c.catchpointStore.WriteCatchpointStateString(ctx, trackerdb.CatchpointStateCatchupFile, path)
+	if err != nil {
+		return fmt.Errorf("unable to write catchpoint catchup state '%s': %v", trackerdb.CatchpointStateCatchupFile, err)
+	}
+	return
+}
+
 // ResetStagingBalances resets the current staging balances, preparing for a new set of balances to be added
 func (c *catchpointCatchupAccessorImpl) ResetStagingBalances(ctx context.Context, newCatchup bool) (err error) {
 	if !newCatchup {
@@ -355,6 +398,10 @@ func (c *catchpointCatchupAccessorImpl) ResetStagingBalances(ctx context.Context
 			if err != nil {
 				return err
 			}
+			err = crw.WriteCatchpointStateString(ctx, trackerdb.CatchpointStateCatchupFile, "")
+			if err != nil {
+				return err
+			}
 			err = crw.WriteCatchpointStateUint64(ctx, trackerdb.CatchpointStateCatchupState, 0)
 			if err != nil {
 				return fmt.Errorf("unable to write catchpoint catchup state '%s': %v", trackerdb.CatchpointStateCatchupState, err)
@@ -382,6 +429,13 @@ type CatchpointCatchupAccessorProgress struct {
 	Version                    uint64
 	TotalAccountHashes         uint64
 
+	// Catchpoint and BlockHeaderDigest are the label and the block header digest stated by the last processed
+	// catchpoint (or catchpoint delta) header, describing the staged balances.
+	Catchpoint        string
+	BlockHeaderDigest crypto.Digest
+	// seenDeltaHeader is set once a catchpoint delta header was processed on top of the staged balances.
+	seenDeltaHeader bool
+
 	// Having the cachedTrie here would help to accelerate the catchup process since the trie maintain an internal cache of nodes.
 	// While rebuilding the trie, we don't want to force and reload (some) of these nodes into the cache for each catchpoint file chunk.
 	cachedTrie *merkletrie.Trie
@@ -400,6 +454,14 @@ func (c *catchpointCatchupAccessorImpl) ProcessStagingBalances(ctx context.Conte
 	if sectionName == CatchpointContentFileName {
 		return c.processStagingContent(ctx, bytes, progress)
 	}
+	// catchpoint delta files are processed on top of a catchpoint file; delta.msgpack comes first, followed by
+	// stateProofVerificationContext.msgpack and then by deltachunk.x.msgpack.
+	if sectionName == CatchpointDeltaContentFileName {
+		return c.processStagingDeltaContent(ctx, bytes, progress)
+	}
+	if strings.HasPrefix(sectionName, catchpointDeltaChunkFileNamePrefix) && strings.HasSuffix(sectionName, catchpointBalancesFileNameSuffix) {
+		return c.processStagingDeltaChunk(ctx, bytes, progress)
+	}
 	if sectionName == catchpointSPVerificationFileName {
 		return c.processStagingStateProofVerificationContext(bytes)
 	}
@@ -494,12 +556,150 @@ func (c *catchpointCatchupAccessorImpl) processStagingContent(ctx context.Contex
 
 		progress.TotalChunks = fileHeader.TotalChunks
 		progress.Version = fileHeader.Version
+		progress.Catchpoint = fileHeader.Catchpoint
+		progress.BlockHeaderDigest = fileHeader.BlockHeaderDigest
 		c.ledger.setSynchronousMode(ctx, c.ledger.accountsRebuildSynchronousMode)
 	}
 
 	return err
 }
 
+// processStagingDeltaContent deserialize the given bytes as a catchpoint delta header, and prepares the staging
+// balances of the base catchpoint for applying the delta on top of them.
+func (c *catchpointCatchupAccessorImpl) processStagingDeltaContent(ctx context.Context, bytes []byte, progress *CatchpointCatchupAccessorProgress) (err error) {
+	var deltaHeader CatchpointDeltaFileHeader
+	err = protocol.DecodeReflect(bytes, &deltaHeader)
+	if err != nil {
+		return err
+	}
+	if !progress.SeenHeader || progress.ProcessedAccounts != progress.TotalAccounts {
+		return fmt.Errorf("CatchpointCatchupAccessorImpl::processStagingDeltaContent: the base catchpoint %s was not fully processed", deltaHeader.BaseCatchpoint)
+	}
+	if progress.Catchpoint != deltaHeader.BaseCatchpoint {
+		return fmt.Errorf("CatchpointCatchupAccessorImpl::processStagingDeltaContent: %w : delta base %s, staged %s", errNoCatchpointDeltaBase, deltaHeader.BaseCatchpoint, progress.Catchpoint)
+	}
+	switch deltaHeader.Version {
+	case CatchpointFileVersionV6:
+	case CatchpointFileVersionV7:
+	case CatchpointFileVersionV8:
+
+	default:
+		return fmt.Errorf("CatchpointCatchupAccessorImpl::processStagingDeltaContent: unable to process catchpoint delta - version %d is not supported", deltaHeader.Version)
+	}
+
+	err = c.ledger.trackerDB().Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) (err error) {
+		cw, err := tx.MakeCatchpointWriter()
+		if err != nil {
+			return err
+		}
+		err = cw.WriteCatchpointStateUint64(ctx, trackerdb.CatchpointStateCatchupVersion, deltaHeader.Version)
+		if err != nil {
+			return fmt.Errorf("CatchpointCatchupAccessorImpl::processStagingDeltaContent: unable to write catchpoint catchup version '%s': %v", trackerdb.CatchpointStateCatchupVersion, err)
+		}
+		err = cw.WriteCatchpointStateUint64(ctx, trackerdb.CatchpointStateCatchupBlockRound, uint64(deltaHeader.BlocksRound))
+		if err != nil {
+			return fmt.Errorf("CatchpointCatchupAccessorImpl::processStagingDeltaContent: unable to write catchpoint catchup state '%s': %v", trackerdb.CatchpointStateCatchupBlockRound, err)
+		}
+		err = cw.WriteCatchpointStateUint64(ctx, trackerdb.CatchpointStateCatchupHashRound, uint64(deltaHeader.BlocksRound))
+		if err != nil {
+			return fmt.Errorf("CatchpointCatchupAccessorImpl::processStagingDeltaContent: unable to write catchpoint catchup state '%s': %v", trackerdb.CatchpointStateCatchupHashRound, err)
+		}
+		aw, err := tx.MakeAccountsWriter()
+		if err != nil {
+			return err
+		}
+		err = aw.AccountsPutTotals(deltaHeader.Totals, true)
+		if err != nil {
+			return err
+		}
+
+		// applying the delta replaces pending hashes, which has to be looked up by their value.
+		_, err = tx.ResetTransactionWarnDeadline(ctx, time.Now().Add(120*time.Second))
+		if err != nil {
+			return err
+		}
+		err = cw.CreateCatchpointStagingHashesIndex(ctx)
+		if err != nil {
+			return err
+		}
+		// the online accounts, online round params and state proof verification data are carried over as a whole.
+		return cw.ResetCatchpointStagingOnlineData(ctx)
+	})
+	if err != nil {
+		return err
+	}
+
+	progress.seenDeltaHeader = true
+	progress.TotalChunks += deltaHeader.TotalChunks
+	progress.Version = deltaHeader.Version
+	progress.Catchpoint = deltaHeader.Catchpoint
+	progress.BlockHeaderDigest = deltaHeader.BlockHeaderDigest
+	c.ledger.setSynchronousMode(ctx, c.ledger.accountsRebuildSynchronousMode)
+	return nil
+}
+
+// processStagingDeltaChunk deserialize the given bytes as a catchpoint delta chunk, and applies it onto the staging balances.
+func (c *catchpointCatchupAccessorImpl) processStagingDeltaChunk(ctx context.Context, bytes []byte, progress *CatchpointCatchupAccessorProgress) (err error) {
+	if !progress.seenDeltaHeader {
+		return fmt.Errorf("CatchpointCatchupAccessorImpl::processStagingDeltaChunk: delta content chunk was missing")
+	}
+	var chunk CatchpointDeltaChunk
+	err = protocol.DecodeReflect(bytes, &chunk)
+	if err != nil {
+		return err
+	}
+	if chunk.empty() {
+		return fmt.Errorf("processStagingDeltaChunk received an empty chunk")
+	}
+
+	accountDeltas, err := prepareNormalizedAccountDeltas(chunk.Accounts, c.ledger.GenesisProto())
+	if err != nil {
+		return err
+	}
+	keys := make([][]byte, len(chunk.KVs))
+	values := make([][]byte, len(chunk.KVs))
+	hashes := make([][]byte, len(chunk.KVs))
+	for i, kv := range chunk.KVs {
+		keys[i] = kv.Key
+		if kv.Deleted {
+			continue
+		}
+		// as in writeKVs, empty boxes are written as an empty byte string rather than nil.
+		values[i] = kv.Value
+		if values[i] == nil {
+			values[i] = []byte{}
+		}
+		hashes[i] = trackerdb.KvHashBuilderV6(string(keys[i]), values[i])
+	}
+
+	start := time.Now()
+	err = c.ledger.trackerDB().Transaction(func(ctx context.Context, tx trackerdb.TransactionScope) (err error) {
+		cw, err := tx.MakeCatchpointWriter()
+		if err != nil {
+			return err
+		}
+		err = cw.WriteCatchpointStagingAccountDeltas(ctx, accountDeltas)
+		if err != nil {
+			return err
+		}
+		err = cw.WriteCatchpointStagingKVDeltas(ctx, keys, values, hashes)
+		if err != nil {
+			return err
+		}
+		err = cw.WriteCatchpointStagingOnlineAccounts(ctx, chunk.OnlineAccounts)
+		if err != nil {
+			return err
+		}
+		return cw.WriteCatchpointStagingOnlineRoundParams(ctx, chunk.OnlineRoundParams)
+	})
+	if err != nil {
+		return err
+	}
+	progress.BalancesWriteDuration += time.Since(start)
+	progress.ProcessedBytes += uint64(len(bytes))
+	return nil
+}
+
 // processStagingBalances deserialize the given bytes as a temporary staging balances
 func (c *catchpointCatchupAccessorImpl) processStagingBalances(ctx context.Context, bytes []byte, progress *CatchpointCatchupAccessorProgress) (err error) {
 	if !progress.SeenHeader {
@@ -804,7 +1004,13 @@ func (c *catchpointCatchupAccessorImpl) BuildMerkleTrie(ctx context.Context, pro
 			return err
 		}
 
-		return crw.CreateCatchpointStagingHashesIndex(ctx)
+		err = crw.CreateCatchpointStagingHashesIndex(ctx)
+		if err != nil {
+			return err
+		}
+		// the trie is built from scratch, even when it was already built for the balances the staged catchpoint
+		// delta files were applied to.
+		return crw.ResetCatchpointStagingAccountHashes(ctx)
 	})
 	if err != nil {
 		return
@@ -1124,8 +1330,20 @@ func (c *catchpointCatchupAccessorImpl) VerifyCatchpoint(ctx context.Context, bl
 		return fmt.Errorf("block round in block header doesn't match block round in catchpoint:  %d != %d", blockRound, blk.Round())
 	}
 
+	generatedLabel, err := MakeCatchpointLabel(version, blockRound, blk.Digest(), balancesHash, spVerificationHash, onlineAccountsHash, onlineRoundParamsHash, totals)
+	if err != nil {
+		return err
+	}
+
+	if catchpointLabel != generatedLabel {
+		return fmt.Errorf("catchpoint hash mismatch; expected %s, calculated %s", catchpointLabel, generatedLabel)
+	}
+	return nil
+}
+
+// MakeCatchpointLabel generates the label of a catchpoint of the given file version out of the data returned by GetVerifyData.
+func MakeCatchpointLabel(version uint64, blockRound basics.Round, blockDigest crypto.Digest, balancesHash, spVerificationHash, onlineAccountsHash, onlineRoundParamsHash crypto.Digest, totals ledgercore.AccountTotals) (string, error) {
 	var catchpointLabelMaker ledgercore.CatchpointLabelMaker
-	blockDigest := blk.Digest()
 	if version <= CatchpointFileVersionV6 {
 		catchpointLabelMaker = ledgercore.MakeCatchpointLabelMakerV6(blockRound, &blockDigest, &balancesHash, totals)
 	} else if version == CatchpointFileVersionV7 {
@@ -1133,14 +1351,9 @@ func (c *catchpointCatchupAccessorImpl) VerifyCatchpoint(ctx context.Context, bl
 	} else if version == CatchpointFileVersionV8 {
 		catchpointLabelMaker = ledgercore.MakeCatchpointLabelMakerCurrent(blockRound, &blockDigest, &balancesHash, totals, &spVerificationHash, &onlineAccountsHash, &onlineRoundParamsHash)
 	} else {
-		return fmt.Errorf("unable to verify catchpoint - version %d not supported", version)
+		return "", fmt.Errorf("unable to verify catchpoint - version %d not supported", version)
 	}
-	generatedLabel := ledgercore.MakeLabel(catchpointLabelMaker)
-
-	if catchpointLabel != generatedLabel {
-		return fmt.Errorf("catchpoint hash mismatch; expected %s, calculated %s", catchpointLabel, generatedLabel)
-	}
-	return nil
+	return ledgercore.MakeLabel(catchpointLabelMaker), nil
 }
 
 // StoreBalancesRound calculates the balances round based on the first block and the associated consensus parameters, and
@@ -1371,6 +1584,11 @@ func (c *catchpointCatchupAccessorImpl) finishBalances(ctx context.Context) (err
 			return err
 		}
 
+		err = crw.WriteCatchpointStateString(ctx, trackerdb.CatchpointStateCatchupFile, "")
+		if err != nil {
+			return err
+		}
+
 		if hashRound != 0 {
 			err = crw.WriteCatchpointStateUint64(ctx, trackerdb.CatchpointStateCatchupHashRound, 0)
 			if err != nil {
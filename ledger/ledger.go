@@ -21,6 +21,7 @@ import (
 	"database/sql"
 	"fmt"
 	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/algorand/go-deadlock"
@@ -89,6 +90,7 @@ type Ledger struct {
 	notifier       blockNotifier
 	metrics        metricsTracker
 	spVerification spVerificationTracker
+	history        stateHistory
 
 	trackers  trackerRegistry
 	trackerMu deadlock.RWMutex
@@ -100,6 +102,9 @@ type Ledger struct {
 
 	dirsAndPrefix DirsAndPrefix
 
+	// dbMem determines whether the ledger databases are kept in memory.
+	dbMem bool
+
 	tracer logic.EvalTracer
 }
 
@@ -153,6 +158,7 @@ func OpenLedger[T string | DirsAndPrefix](
 		verifiedTxnCache:               verify.MakeVerifiedTransactionCache(verifiedCacheSize),
 		cfg:                            cfg,
 		dirsAndPrefix:                  dirs,
+		dbMem:                          dbMem,
 		tracer:                         tracer,
 	}
 
@@ -237,6 +243,7 @@ func (l *Ledger) reloadLedger() error {
 	// set account updates tracker as a driver to calculate tracker db round and committing offsets
 	trackers := []ledgerTracker{
 		&l.accts,          // update the balances
+		&l.history,        // keep overwritten state for reconstructing older rounds; must follow accts
 		&l.catchpoint,     // catchpoints tracker : update catchpoint labels, create catchpoint files
 		&l.acctsOnline,    // update online account balances history
 		&l.txTail,         // update the transaction tail, tracking the recent 1000 txn
@@ -249,6 +256,7 @@ func (l *Ledger) reloadLedger() error {
 
 	l.accts.initialize(l.cfg)
 	l.acctsOnline.initialize(l.cfg)
+	l.history.initialize(l.cfg, &l.accts, l.dirsAndPrefix, l.dbMem)
 
 	l.catchpoint.initialize(l.cfg, l.dirsAndPrefix)
 
@@ -507,7 +515,11 @@ func (l *Ledger) GetLastCatchpointLabel() string {
 func (l *Ledger) GetCreatorForRound(rnd basics.Round, cidx basics.CreatableIndex, ctype basics.CreatableType) (creator basics.Address, ok bool, err error) {
 	l.trackerMu.RLock()
 	defer l.trackerMu.RUnlock()
-	return l.accts.GetCreatorForRound(rnd, cidx, ctype)
+	creator, ok, err = l.accts.GetCreatorForRound(rnd, cidx, ctype)
+	if l.history.handles(rnd, err) {
+		return l.history.getCreatorForRound(rnd, cidx, ctype)
+	}
+	return creator, ok, err
 }
 
 // GetCreator is like GetCreatorForRound, but for the latest round and race-free
@@ -573,6 +585,10 @@ func (l *Ledger) LookupAccount(round basics.Round, addr basics.Address) (data le
 	defer l.trackerMu.RUnlock()
 
 	data, rnd, rewardsVersion, rewardsLevel, err := l.accts.lookupWithoutRewards(round, addr, true /* take lock */)
+	if l.history.handles(round, err) {
+		rnd = round
+		data, rewardsVersion, rewardsLevel, err = l.history.lookupWithoutRewards(round, addr)
+	}
 	if err != nil {
 		return ledgercore.AccountData{}, basics.Round(0), basics.MicroAlgos{}, err
 	}
@@ -608,6 +624,9 @@ func (l *Ledger) lookupResource(rnd basics.Round, addr basics.Address, aidx basi
 
 	// Intentionally apply (pending) rewards up to rnd.
 	res, _, err := l.accts.LookupResource(rnd, addr, aidx, ctype)
+	if l.history.handles(rnd, err) {
+		res, err = l.history.lookupResource(rnd, addr, aidx, ctype)
+	}
 	if err != nil {
 		return ledgercore.AccountResource{}, err
 	}
@@ -620,7 +639,11 @@ func (l *Ledger) LookupKv(rnd basics.Round, key string) ([]byte, error) {
 	l.trackerMu.RLock()
 	defer l.trackerMu.RUnlock()
 
-	return l.accts.LookupKv(rnd, key)
+	value, err := l.accts.LookupKv(rnd, key)
+	if l.history.handles(rnd, err) {
+		return l.history.lookupKv(rnd, key)
+	}
+	return value, err
 }
 
 // LookupKeysByPrefix searches keys with specific prefix, up to `maxKeyNum`
@@ -696,6 +719,10 @@ func (l *Ledger) LookupWithoutRewards(rnd basics.Round, addr basics.Address) (le
 	var result ledgercore.AccountData
 
 	result, validThrough, err := l.accts.LookupWithoutRewards(rnd, addr)
+	if l.history.handles(rnd, err) {
+		validThrough = rnd
+		result, _, _, err = l.history.lookupWithoutRewards(rnd, addr)
+	}
 	if err != nil {
 		return ledgercore.AccountData{}, basics.Round(0), err
 	}
@@ -714,7 +741,39 @@ func (l *Ledger) LatestTotals() (basics.Round, ledgercore.AccountTotals, error)
 func (l *Ledger) Totals(rnd basics.Round) (ledgercore.AccountTotals, error) {
 	l.trackerMu.RLock()
 	defer l.trackerMu.RUnlock()
-	return l.accts.Totals(rnd)
+	totals, err := l.accts.Totals(rnd)
+	if l.history.handles(rnd, err) {
+		return l.history.totals(rnd)
+	}
+	return totals, err
+}
+
+// PinStateRound keeps the account state as of round rnd available until the returned release
+// function is called, even once the accounts tracker and the state history (see
+// StateHistoryRounds) would otherwise have moved past it. Only the latest round, or a round the
+// state history still covers, can be pinned. The history kept for it grows with every block added
+// while the pin is held; it is in memory unless the node keeps a state history anyway.
+func (l *Ledger) PinStateRound(rnd basics.Round) (release func(), err error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	err = l.history.pin(rnd, l.Latest())
+	if err != nil {
+		return nil, err
+	}
+	var once sync.Once
+	return func() { once.Do(func() { l.history.unpin(rnd) }) }, nil
+}
+
+// OldestStateRound returns the oldest round for which the ledger can answer account state
+// questions, either from the accounts tracker or from the state history (see StateHistoryRounds).
+func (l *Ledger) OldestStateRound() basics.Round {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	oldest := l.trackers.getDbRound()
+	if base, ok := l.history.oldest(); ok && base < oldest {
+		oldest = base
+	}
+	return oldest
 }
 
 // OnlineCirculation returns the online totals of all accounts at the end of round rnd.
@@ -730,6 +789,12 @@ func (l *Ledger) CheckDup(currentProto config.ConsensusParams, current basics.Ro
 	return l.txTail.checkDup(currentProto, current, firstValid, lastValid, txid, txl)
 }
 
+// CheckDupAsOf is like CheckDup, but ignores the transactions and leases confirmed after round asOf.
+// It returns an error if the transaction tail no longer covers what is needed to tell.
+func (l *Ledger) CheckDupAsOf(asOf basics.Round, currentProto config.ConsensusParams, current basics.Round, firstValid basics.Round, lastValid basics.Round, txid transactions.Txid, txl ledgercore.Txlease) error {
+	return l.txTail.checkDupAsOf(asOf, currentProto, current, firstValid, lastValid, txid, txl)
+}
+
 // CheckConfirmedTail checks if a transaction txid happens to have LastValid greater than the current round at the time of calling and has been already committed to the ledger.
 // If both conditions are met it returns true.
 // This function could be used as filter to check if a transaction is committed to the ledger, and no extra checks needed if it says true.
@@ -906,6 +971,20 @@ func (l *Ledger) GetCatchpointStream(round basics.Round) (ReadCloseSizer, error)
 	return l.catchpoint.GetCatchpointStream(round)
 }
 
+// GetCatchpointManifest returns the chunk manifest of the catchpoint file for the provided round.
+func (l *Ledger) GetCatchpointManifest(round basics.Round) (CatchpointManifest, error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	return l.catchpoint.GetCatchpointManifest(round)
+}
+
+// GetCatchpointDeltaStream returns a ReadCloseSizer to the catchpoint delta file for the provided round.
+func (l *Ledger) GetCatchpointDeltaStream(round basics.Round) (ReadCloseSizer, error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	return l.catchpoint.GetCatchpointDeltaStream(round)
+}
+
 // ledgerForTracker methods
 func (l *Ledger) trackerDB() trackerdb.Store {
 	return l.trackerDBs
@@ -0,0 +1,114 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/txntest"
+	"github.com/algorand/go-algorand/ledger/simulation"
+	simulationtesting "github.com/algorand/go-algorand/ledger/simulation/testing"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/stretchr/testify/require"
+)
+
+// waitForTrackers keeps adding blocks until the ledger's trackers have committed past rnd. The
+// trackers only commit every few seconds, so this can take a while.
+func waitForTrackers(t *testing.T, env *simulationtesting.Environment, rnd basics.Round) {
+	t.Helper()
+	deadline := time.Now().Add(30 * time.Second)
+	for env.Ledger.LatestTrackerCommitted() <= rnd {
+		require.True(t, time.Now().Before(deadline), "trackers did not commit past round %d", rnd)
+		env.TransferAlgos(env.Accounts[2].Addr, env.Accounts[3].Addr, 1)
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+func TestSimulateHistoricalRound(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	cfg := config.GetDefaultLocal()
+	cfg.Archival = true
+	cfg.StateHistoryRounds = 16
+	env := simulationtesting.PrepareSimulatorTestWithConfig(t, cfg)
+	defer env.Close()
+	s := simulation.MakeSimulator(env.Ledger, false)
+	sender := env.Accounts[0]
+	receiver := env.Accounts[1]
+
+	// Round 0 means "latest", so make sure the rounds used below are past it.
+	env.TransferAlgos(env.Accounts[2].Addr, env.Accounts[3].Addr, 1)
+
+	// receiver spends more than it had before being funded
+	before, _, _, err := env.Ledger.LookupLatest(receiver.Addr)
+	require.NoError(t, err)
+	spend := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   receiver.Addr,
+		Receiver: sender.Addr,
+		Amount:   before.MicroAlgos.Raw + 1_000_000,
+	}).Txn().Sign(receiver.Sk)
+
+	fund := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   sender.Addr,
+		Receiver: receiver.Addr,
+		Amount:   2_000_000,
+	}).Txn().Sign(sender.Sk)
+	beforeFunding := env.Ledger.Latest()
+	env.Txn(fund)
+	funded := env.Ledger.Latest()
+
+	for i := 0; i < 5; i++ {
+		env.TransferAlgos(env.Accounts[2].Addr, env.Accounts[3].Addr, 1)
+	}
+
+	result, err := s.Simulate(simulation.Request{Round: beforeFunding, TxnGroups: [][]transactions.SignedTxn{{spend}}})
+	require.NoError(t, err)
+	require.Contains(t, result.TxnGroups[0].FailureMessage, "overspend")
+
+	result, err = s.Simulate(simulation.Request{Round: funded, TxnGroups: [][]transactions.SignedTxn{{spend}}})
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+
+	// A transaction that was confirmed after the simulation round can be replayed, but not one
+	// that was already confirmed by then.
+	result, err = s.Simulate(simulation.Request{Round: beforeFunding, TxnGroups: [][]transactions.SignedTxn{{fund}}})
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+	result, err = s.Simulate(simulation.Request{Round: funded, TxnGroups: [][]transactions.SignedTxn{{fund}}})
+	require.NoError(t, err)
+	require.Contains(t, result.TxnGroups[0].FailureMessage, "transaction already in ledger")
+
+	// Once the round falls out of the state history, and the accounts tracker has flushed past
+	// it, it can no longer be simulated.
+	for i := 0; i < 20; i++ {
+		env.TransferAlgos(env.Accounts[2].Addr, env.Accounts[3].Addr, 1)
+	}
+	waitForTrackers(t, &env, beforeFunding)
+	require.Greater(t, env.Ledger.OldestStateRound(), beforeFunding)
+
+	_, err = s.Simulate(simulation.Request{Round: beforeFunding, TxnGroups: [][]transactions.SignedTxn{{spend}}})
+	require.ErrorAs(t, err, &simulation.InvalidRequestError{})
+	require.ErrorContains(t, err, "no longer available")
+}
@@ -0,0 +1,414 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// resourcePacker assigns the unnamed resources accessed by a group to the reference arrays of the
+// group's app calls, appending app calls to the group when the existing ones are full.
+type resourcePacker struct {
+	proto *config.ConsensusParams
+	txns  []transactions.SignedTxn
+
+	// apps holds the app called by each transaction, including apps created by the group. It is 0
+	// for other transactions and for the app calls added by the packer.
+	apps []basics.AppIndex
+
+	// padding is the template of the app calls added by the packer. It is made on first use.
+	padding *transactions.Transaction
+}
+
+// populateResources returns the transactions of a group that succeeded with the unnamed resources
+// recorded in its result assigned to their Accounts, ForeignApps, ForeignAssets and Boxes.
+//
+// Resources accessed by programs that cannot share resources (prior to v9) go to the transaction
+// that accessed them. Other resources go to the first app call with room for them, in the order:
+// asset holdings and app locals (next to the account, asset or app they pair with), boxes (next to
+// their app), empty box refs, apps, accounts and assets. When no app call has room, an app call
+// that creates and immediately deletes an app is appended to the group, so that its references can
+// be shared. Since the transactions change, their signatures are removed and the group ID is
+// recomputed, leaving them ready to sign.
+func populateResources(txns []transactions.SignedTxn, result *TxnGroupResult, proto *config.ConsensusParams) ([]transactions.SignedTxn, error) {
+	p := resourcePacker{
+		proto: proto,
+		txns:  make([]transactions.SignedTxn, len(txns)),
+		apps:  make([]basics.AppIndex, len(txns)),
+	}
+	for i := range txns {
+		p.txns[i] = txns[i]
+		// clone the reference arrays, since the packer appends to them
+		tx := &p.txns[i].Txn
+		tx.Accounts = slices.Clone(tx.Accounts)
+		tx.ForeignApps = slices.Clone(tx.ForeignApps)
+		tx.ForeignAssets = slices.Clone(tx.ForeignAssets)
+		tx.Boxes = slices.Clone(tx.Boxes)
+		if tx.Type == protocol.ApplicationCallTx {
+			p.apps[i] = tx.ApplicationID
+			if p.apps[i] == 0 {
+				p.apps[i] = result.Txns[i].Txn.ApplyData.ApplicationID
+			}
+		}
+	}
+
+	for i := range result.Txns {
+		if local := result.Txns[i].UnnamedResourcesAccessed; local != nil {
+			if err := p.assignLocal(i, local); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if global := result.UnnamedResourcesAccessed; global != nil {
+		if err := p.assignGlobal(global); err != nil {
+			return nil, err
+		}
+	}
+
+	p.finish(txns)
+	return p.txns, nil
+}
+
+// assignLocal assigns the resources that only transaction i may use.
+func (p *resourcePacker) assignLocal(i int, resources *ResourceTracker) error {
+	for _, app := range slices.Sorted(maps.Keys(resources.Apps)) {
+		if p.hasApp(i, app) {
+			continue
+		}
+		if !p.hasRoom(i, 0, 1, 0, 0) {
+			return fmt.Errorf("transaction %d has no room for app %d", i, app)
+		}
+		p.txns[i].Txn.ForeignApps = append(p.txns[i].Txn.ForeignApps, app)
+	}
+	for _, addr := range sortedAddresses(resources.Accounts) {
+		if p.hasAccount(i, addr) {
+			continue
+		}
+		if !p.hasRoom(i, 1, 0, 0, 0) {
+			return fmt.Errorf("transaction %d has no room for account %s", i, addr)
+		}
+		p.txns[i].Txn.Accounts = append(p.txns[i].Txn.Accounts, addr)
+	}
+	for _, asset := range slices.Sorted(maps.Keys(resources.Assets)) {
+		if p.hasAsset(i, asset) {
+			continue
+		}
+		if !p.hasRoom(i, 0, 0, 1, 0) {
+			return fmt.Errorf("transaction %d has no room for asset %d", i, asset)
+		}
+		p.txns[i].Txn.ForeignAssets = append(p.txns[i].Txn.ForeignAssets, asset)
+	}
+	return nil
+}
+
+// assignGlobal assigns the resources that any transaction of the group may provide.
+func (p *resourcePacker) assignGlobal(resources *ResourceTracker) error {
+	holdings := slices.SortedFunc(maps.Keys(resources.AssetHoldings), func(a, b ledgercore.AccountAsset) int {
+		return cmp.Or(bytes.Compare(a.Address[:], b.Address[:]), cmp.Compare(a.Asset, b.Asset))
+	})
+	for _, holding := range holdings {
+		addr, asset := holding.Address, holding.Asset
+		err := p.assignPair(
+			func(i int) bool { return p.hasAccount(i, addr) },
+			func(i int) bool { return p.hasAsset(i, asset) },
+			func(i int) { p.txns[i].Txn.Accounts = append(p.txns[i].Txn.Accounts, addr) },
+			func(i int) { p.txns[i].Txn.ForeignAssets = append(p.txns[i].Txn.ForeignAssets, asset) },
+			0, 1,
+		)
+		if err != nil {
+			return fmt.Errorf("cannot assign holding of asset %d by %s: %w", asset, addr, err)
+		}
+	}
+
+	locals := slices.SortedFunc(maps.Keys(resources.AppLocals), func(a, b ledgercore.AccountApp) int {
+		return cmp.Or(bytes.Compare(a.Address[:], b.Address[:]), cmp.Compare(a.App, b.App))
+	})
+	for _, local := range locals {
+		addr, app := local.Address, local.App
+		err := p.assignPair(
+			func(i int) bool { return p.hasAccount(i, addr) },
+			func(i int) bool { return p.hasApp(i, app) },
+			func(i int) { p.txns[i].Txn.Accounts = append(p.txns[i].Txn.Accounts, addr) },
+			func(i int) { p.txns[i].Txn.ForeignApps = append(p.txns[i].Txn.ForeignApps, app) },
+			1, 0,
+		)
+		if err != nil {
+			return fmt.Errorf("cannot assign local state of app %d for %s: %w", app, addr, err)
+		}
+	}
+
+	boxes := slices.SortedFunc(maps.Keys(resources.Boxes), func(a, b logic.BoxRef) int {
+		return cmp.Or(cmp.Compare(a.App, b.App), cmp.Compare(a.Name, b.Name))
+	})
+	for _, box := range boxes {
+		if err := p.assignBox(box); err != nil {
+			return fmt.Errorf("cannot assign box %q of app %d: %w", box.Name, box.App, err)
+		}
+	}
+	for range resources.NumEmptyBoxRefs {
+		i, err := p.find(func(i int) bool { return p.hasRoom(i, 0, 0, 0, 1) })
+		if err != nil {
+			return fmt.Errorf("cannot assign empty box reference: %w", err)
+		}
+		p.txns[i].Txn.Boxes = append(p.txns[i].Txn.Boxes, transactions.BoxRef{})
+	}
+
+	for _, app := range slices.Sorted(maps.Keys(resources.Apps)) {
+		if p.anyHas(func(i int) bool { return p.hasApp(i, app) }) {
+			continue
+		}
+		i, err := p.find(func(i int) bool { return p.hasRoom(i, 0, 1, 0, 0) })
+		if err != nil {
+			return fmt.Errorf("cannot assign app %d: %w", app, err)
+		}
+		p.txns[i].Txn.ForeignApps = append(p.txns[i].Txn.ForeignApps, app)
+	}
+	for _, addr := range sortedAddresses(resources.Accounts) {
+		if p.anyHas(func(i int) bool { return p.hasAccount(i, addr) }) {
+			continue
+		}
+		i, err := p.find(func(i int) bool { return p.hasRoom(i, 1, 0, 0, 0) })
+		if err != nil {
+			return fmt.Errorf("cannot assign account %s: %w", addr, err)
+		}
+		p.txns[i].Txn.Accounts = append(p.txns[i].Txn.Accounts, addr)
+	}
+	for _, asset := range slices.Sorted(maps.Keys(resources.Assets)) {
+		if p.anyHas(func(i int) bool { return p.hasAsset(i, asset) }) {
+			continue
+		}
+		i, err := p.find(func(i int) bool { return p.hasRoom(i, 0, 0, 1, 0) })
+		if err != nil {
+			return fmt.Errorf("cannot assign asset %d: %w", asset, err)
+		}
+		p.txns[i].Txn.ForeignAssets = append(p.txns[i].Txn.ForeignAssets, asset)
+	}
+	return nil
+}
+
+// assignPair makes an account available in the same app call as an asset or app, the other
+// resource of the pair. It prefers an app call that already has both, then one that has either,
+// and adds both to an app call otherwise. apps and assets are the room needed by the other
+// resource.
+func (p *resourcePacker) assignPair(hasAccount, hasOther func(int) bool, addAccount, addOther func(int), apps, assets int) error {
+	if p.anyHas(func(i int) bool { return hasAccount(i) && hasOther(i) }) {
+		return nil
+	}
+	for i := range p.txns {
+		if hasAccount(i) && p.hasRoom(i, 0, apps, assets, 0) {
+			addOther(i)
+			return nil
+		}
+	}
+	for i := range p.txns {
+		if hasOther(i) && p.hasRoom(i, 1, 0, 0, 0) {
+			addAccount(i)
+			return nil
+		}
+	}
+	i, err := p.find(func(i int) bool { return p.hasRoom(i, 1, apps, assets, 0) })
+	if err != nil {
+		return err
+	}
+	addAccount(i)
+	addOther(i)
+	return nil
+}
+
+// assignBox adds a box reference next to the box's app, adding the app to ForeignApps if needed.
+func (p *resourcePacker) assignBox(box logic.BoxRef) error {
+	for i := range p.txns {
+		if index, ok := p.boxIndex(i, box.App); ok && p.hasRoom(i, 0, 0, 0, 1) {
+			p.txns[i].Txn.Boxes = append(p.txns[i].Txn.Boxes, transactions.BoxRef{Index: index, Name: []byte(box.Name)})
+			return nil
+		}
+	}
+	i, err := p.find(func(i int) bool { return p.hasRoom(i, 0, 1, 0, 1) })
+	if err != nil {
+		return err
+	}
+	p.txns[i].Txn.ForeignApps = append(p.txns[i].Txn.ForeignApps, box.App)
+	p.txns[i].Txn.Boxes = append(p.txns[i].Txn.Boxes, transactions.BoxRef{Index: uint64(len(p.txns[i].Txn.ForeignApps)), Name: []byte(box.Name)})
+	return nil
+}
+
+// boxIndex returns the index that box references of transaction i use for app.
+func (p *resourcePacker) boxIndex(i int, app basics.AppIndex) (uint64, bool) {
+	if p.txns[i].Txn.Type != protocol.ApplicationCallTx {
+		return 0, false
+	}
+	if p.apps[i] == app {
+		return 0, true
+	}
+	if index := slices.Index(p.txns[i].Txn.ForeignApps, app); index != -1 {
+		return uint64(index) + 1, true
+	}
+	return 0, false
+}
+
+// anyHas tells if has is true for any transaction of the group.
+func (p *resourcePacker) anyHas(has func(int) bool) bool {
+	for i := range p.txns {
+		if has(i) {
+			return true
+		}
+	}
+	return false
+}
+
+// find returns the first app call for which fits is true, appending an app call to the group if
+// there is none.
+func (p *resourcePacker) find(fits func(int) bool) (int, error) {
+	for i := range p.txns {
+		if fits(i) {
+			return i, nil
+		}
+	}
+	if err := p.addPadding(); err != nil {
+		return 0, err
+	}
+	i := len(p.txns) - 1
+	if !fits(i) {
+		return 0, fmt.Errorf("the resource does not fit in an app call")
+	}
+	return i, nil
+}
+
+// addPadding appends an app call that creates and deletes an app which approves. It is sent by the
+// sender of the group's first app call, with the same validity.
+func (p *resourcePacker) addPadding() error {
+	if len(p.txns) >= p.proto.MaxTxGroupSize {
+		return fmt.Errorf("the group already has %d transactions", len(p.txns))
+	}
+	if p.padding == nil {
+		first := slices.IndexFunc(p.txns, func(stxn transactions.SignedTxn) bool {
+			return stxn.Txn.Type == protocol.ApplicationCallTx
+		})
+		if first == -1 {
+			return fmt.Errorf("the group has no app call")
+		}
+		ops, err := logic.AssembleString(fmt.Sprintf("#pragma version %d\nint 1", p.proto.LogicSigVersion))
+		if err != nil {
+			return err
+		}
+		header := p.txns[first].Txn.Header
+		p.padding = &transactions.Transaction{
+			Type: protocol.ApplicationCallTx,
+			Header: transactions.Header{
+				Sender:      header.Sender,
+				Fee:         basics.MicroAlgos{Raw: p.proto.MinTxnFee},
+				FirstValid:  header.FirstValid,
+				LastValid:   header.LastValid,
+				GenesisID:   header.GenesisID,
+				GenesisHash: header.GenesisHash,
+			},
+			ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+				OnCompletion:      transactions.DeleteApplicationOC,
+				ApprovalProgram:   ops.Program,
+				ClearStateProgram: ops.Program,
+			},
+		}
+	}
+	p.txns = append(p.txns, transactions.SignedTxn{Txn: *p.padding})
+	p.apps = append(p.apps, 0)
+	return nil
+}
+
+// hasRoom tells if transaction i is an app call that can reference the given number of additional
+// accounts, apps, assets and boxes.
+func (p *resourcePacker) hasRoom(i int, accounts, apps, assets, boxes int) bool {
+	tx := &p.txns[i].Txn
+	if tx.Type != protocol.ApplicationCallTx {
+		return false
+	}
+	total := len(tx.Accounts) + len(tx.ForeignApps) + len(tx.ForeignAssets) + len(tx.Boxes)
+	return len(tx.Accounts)+accounts <= p.proto.MaxAppTxnAccounts &&
+		len(tx.ForeignApps)+apps <= p.proto.MaxAppTxnForeignApps &&
+		len(tx.ForeignAssets)+assets <= p.proto.MaxAppTxnForeignAssets &&
+		len(tx.Boxes)+boxes <= p.proto.MaxAppBoxReferences &&
+		total+accounts+apps+assets+boxes <= p.proto.MaxAppTotalTxnReferences
+}
+
+// hasAccount tells if app call i makes addr available, including through its apps' accounts.
+func (p *resourcePacker) hasAccount(i int, addr basics.Address) bool {
+	tx := &p.txns[i].Txn
+	if tx.Type != protocol.ApplicationCallTx {
+		return false
+	}
+	if tx.Sender == addr || slices.Contains(tx.Accounts, addr) {
+		return true
+	}
+	if p.apps[i] != 0 && p.apps[i].Address() == addr {
+		return true
+	}
+	return slices.ContainsFunc(tx.ForeignApps, func(app basics.AppIndex) bool { return app.Address() == addr })
+}
+
+func (p *resourcePacker) hasApp(i int, app basics.AppIndex) bool {
+	_, ok := p.boxIndex(i, app)
+	return ok
+}
+
+func (p *resourcePacker) hasAsset(i int, asset basics.AssetIndex) bool {
+	return p.txns[i].Txn.Type == protocol.ApplicationCallTx && slices.Contains(p.txns[i].Txn.ForeignAssets, asset)
+}
+
+// finish recomputes the group ID, and removes the signatures of the transactions that changed.
+// Logic sigs are kept, since their signatures only cover the program.
+func (p *resourcePacker) finish(original []transactions.SignedTxn) {
+	if len(p.txns) > 1 || !p.txns[0].Txn.Group.IsZero() {
+		var group transactions.TxGroup
+		for i := range p.txns {
+			p.txns[i].Txn.Group = crypto.Digest{}
+			group.TxGroupHashes = append(group.TxGroupHashes, crypto.Digest(p.txns[i].Txn.ID()))
+		}
+		groupID := crypto.HashObj(group)
+		for i := range p.txns {
+			p.txns[i].Txn.Group = groupID
+		}
+	}
+	for i := range original {
+		if p.txns[i].Txn.ID() == original[i].Txn.ID() {
+			continue
+		}
+		p.txns[i].Sig = crypto.Signature{}
+		if len(p.txns[i].Msig.Subsigs) != 0 {
+			p.txns[i].Msig.Subsigs = slices.Clone(p.txns[i].Msig.Subsigs)
+			for j := range p.txns[i].Msig.Subsigs {
+				p.txns[i].Msig.Subsigs[j].Sig = crypto.Signature{}
+			}
+		}
+	}
+}
+
+func sortedAddresses(addresses map[basics.Address]struct{}) []basics.Address {
+	return slices.SortedFunc(maps.Keys(addresses), func(a, b basics.Address) int {
+		return bytes.Compare(a[:], b[:])
+	})
+}
@@ -0,0 +1,303 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/txntest"
+	"github.com/algorand/go-algorand/ledger/simulation"
+	simulationtesting "github.com/algorand/go-algorand/ledger/simulation/testing"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/stretchr/testify/require"
+)
+
+// populateAndResimulate simulates group with PopulateResources, then signs the populated group and
+// checks that it succeeds without unnamed resources.
+func populateAndResimulate(t *testing.T, env simulationtesting.Environment, group []transactions.SignedTxn, sk *crypto.SignatureSecrets) []transactions.SignedTxn {
+	t.Helper()
+	simulator := simulation.MakeSimulator(env.Ledger, false)
+
+	result, err := simulator.Simulate(simulation.Request{TxnGroups: [][]transactions.SignedTxn{group}})
+	require.NoError(t, err)
+	require.NotEmpty(t, result.TxnGroups[0].FailureMessage)
+
+	result, err = simulator.Simulate(simulation.Request{
+		TxnGroups:             [][]transactions.SignedTxn{group},
+		AllowUnnamedResources: true,
+		PopulateResources:     true,
+	})
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+	require.Empty(t, result.TxnGroups[0].PopulateFailureMessage)
+	require.True(t, result.EvalOverrides.PopulateResources)
+	populated := result.TxnGroups[0].PopulatedTxns
+	require.GreaterOrEqual(t, len(populated), len(group))
+
+	signed := make([]transactions.SignedTxn, len(populated))
+	for i := range populated {
+		require.True(t, populated[i].Sig.Blank())
+		signed[i] = populated[i].Txn.Sign(sk)
+	}
+	result, err = simulator.Simulate(simulation.Request{TxnGroups: [][]transactions.SignedTxn{signed}})
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+	return populated
+}
+
+func TestPopulateResources(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+	sender := env.Accounts[0]
+
+	assetID := env.CreateAsset(env.Accounts[1].Addr, basics.AssetParams{Total: 100})
+	env.OptIntoAsset(env.Accounts[2].Addr, assetID)
+	otherAppID := env.CreateApp(env.Accounts[3].Addr, simulationtesting.AppParams{
+		ApprovalProgram:   "#pragma version 9\nint 1",
+		ClearStateProgram: "#pragma version 9\nint 1",
+	})
+	env.OptIntoApp(env.Accounts[4].Addr, otherAppID)
+
+	program := fmt.Sprintf(`#pragma version 9
+txn ApplicationID
+bz end
+addr %[1]s
+balance
+pop
+int %[2]d
+asset_params_get AssetTotal
+assert
+pop
+int %[3]d
+app_params_get AppCreator
+assert
+pop
+addr %[4]s
+int %[2]d
+asset_holding_get AssetBalance
+assert
+pop
+addr %[5]s
+int %[3]d
+byte "k"
+app_local_get_ex
+pop
+pop
+byte "box"
+box_len
+pop
+pop
+end:
+int 1`, env.Accounts[5].Addr, assetID, otherAppID, env.Accounts[2].Addr, env.Accounts[4].Addr)
+	appID := env.CreateApp(sender.Addr, simulationtesting.AppParams{
+		ApprovalProgram:   program,
+		ClearStateProgram: "#pragma version 9\nint 1",
+	})
+
+	pay := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   sender.Addr,
+		Receiver: sender.Addr,
+	})
+	appCall := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:          protocol.ApplicationCallTx,
+		Sender:        sender.Addr,
+		ApplicationID: appID,
+	})
+	txntest.Group(&pay, &appCall)
+
+	populated := populateAndResimulate(t, env, []transactions.SignedTxn{pay.Txn().Sign(sender.Sk), appCall.Txn().Sign(sender.Sk)}, sender.Sk)
+	require.Len(t, populated, 2)
+	// the payment only gets the new group ID
+	require.NotEqual(t, appCall.Txn().Group, populated[1].Txn.Group)
+	require.Equal(t, populated[0].Txn.Group, populated[1].Txn.Group)
+	expectedPay := pay.Txn()
+	expectedPay.Group = populated[0].Txn.Group
+	require.Equal(t, expectedPay, populated[0].Txn)
+
+	tx := populated[1].Txn
+	require.ElementsMatch(t, []basics.Address{env.Accounts[2].Addr, env.Accounts[4].Addr, env.Accounts[5].Addr}, tx.Accounts)
+	require.Equal(t, []basics.AssetIndex{assetID}, tx.ForeignAssets)
+	require.Equal(t, []basics.AppIndex{otherAppID}, tx.ForeignApps)
+	require.Equal(t, []transactions.BoxRef{{Index: 0, Name: []byte("box")}}, tx.Boxes)
+}
+
+func TestPopulateResourcesOverflow(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+	sender := env.Accounts[0]
+
+	// the app reads the balances of 10 accounts, more than one app call may reference
+	var program strings.Builder
+	program.WriteString("#pragma version 9\ntxn ApplicationID\nbz end\n")
+	for i := range 10 {
+		fmt.Fprintf(&program, "addr %s\nbalance\npop\n", basics.Address{byte(i + 1)})
+	}
+	program.WriteString("end:\nint 1")
+	appID := env.CreateApp(sender.Addr, simulationtesting.AppParams{
+		ApprovalProgram:   program.String(),
+		ClearStateProgram: "#pragma version 9\nint 1",
+	})
+
+	appCall := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:          protocol.ApplicationCallTx,
+		Sender:        sender.Addr,
+		ApplicationID: appID,
+	})
+
+	populated := populateAndResimulate(t, env, []transactions.SignedTxn{appCall.Txn().Sign(sender.Sk)}, sender.Sk)
+	proto := env.TxnInfo.CurrentProtocolParams()
+	require.Len(t, populated, 3)
+	var accounts int
+	for i, stxn := range populated {
+		accounts += len(stxn.Txn.Accounts)
+		require.False(t, stxn.Txn.Group.IsZero())
+		if i > 0 {
+			// padding app calls create and delete an app
+			require.Zero(t, stxn.Txn.ApplicationID)
+			require.Equal(t, transactions.DeleteApplicationOC, stxn.Txn.OnCompletion)
+			require.Equal(t, sender.Addr, stxn.Txn.Sender)
+			require.Equal(t, proto.MinTxnFee, stxn.Txn.Fee.Raw)
+		}
+	}
+	require.Equal(t, 10, accounts)
+}
+
+// TestPopulateResourcesResimulated checks that a populated group that no longer passes is not
+// returned.
+func TestPopulateResourcesResimulated(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+	sender := env.Accounts[0]
+
+	// the app reads more accounts than one app call may reference, but only approves when called
+	// alone
+	var program strings.Builder
+	program.WriteString("#pragma version 9\ntxn ApplicationID\nbz end\n")
+	for i := range 10 {
+		fmt.Fprintf(&program, "addr %s\nbalance\npop\n", basics.Address{byte(i + 1)})
+	}
+	program.WriteString("global GroupSize\nint 1\n==\nreturn\nend:\nint 1")
+	appID := env.CreateApp(sender.Addr, simulationtesting.AppParams{
+		ApprovalProgram:   program.String(),
+		ClearStateProgram: "#pragma version 9\nint 1",
+	})
+
+	appCall := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:          protocol.ApplicationCallTx,
+		Sender:        sender.Addr,
+		ApplicationID: appID,
+	})
+	result, err := simulation.MakeSimulator(env.Ledger, false).Simulate(simulation.Request{
+		TxnGroups:             [][]transactions.SignedTxn{{appCall.Txn().Sign(sender.Sk)}},
+		AllowUnnamedResources: true,
+		PopulateResources:     true,
+	})
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+	require.Contains(t, result.TxnGroups[0].PopulateFailureMessage, "the populated group fails")
+	require.Nil(t, result.TxnGroups[0].PopulatedTxns)
+}
+
+func TestPopulateResourcesWithoutSharing(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+	sender := env.Accounts[0]
+
+	// programs prior to v9 can only use their own transaction's resources
+	program := fmt.Sprintf(`#pragma version 8
+txn ApplicationID
+bz end
+addr %s
+balance
+pop
+end:
+int 1`, env.Accounts[1].Addr)
+	appID := env.CreateApp(sender.Addr, simulationtesting.AppParams{
+		ApprovalProgram:   program,
+		ClearStateProgram: "#pragma version 8\nint 1",
+	})
+	otherAppID := env.CreateApp(sender.Addr, simulationtesting.AppParams{
+		ApprovalProgram:   "#pragma version 8\nint 1",
+		ClearStateProgram: "#pragma version 8\nint 1",
+	})
+
+	otherCall := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:          protocol.ApplicationCallTx,
+		Sender:        sender.Addr,
+		ApplicationID: otherAppID,
+	})
+	appCall := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:          protocol.ApplicationCallTx,
+		Sender:        sender.Addr,
+		ApplicationID: appID,
+	})
+	txntest.Group(&otherCall, &appCall)
+
+	populated := populateAndResimulate(t, env, []transactions.SignedTxn{otherCall.Txn().Sign(sender.Sk), appCall.Txn().Sign(sender.Sk)}, sender.Sk)
+	require.Len(t, populated, 2)
+	require.Empty(t, populated[0].Txn.Accounts)
+	require.Equal(t, []basics.Address{env.Accounts[1].Addr}, populated[1].Txn.Accounts)
+}
+
+func TestPopulateResourcesRequiresUnnamedResources(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+	sender := env.Accounts[0]
+
+	pay := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   sender.Addr,
+		Receiver: sender.Addr,
+	})
+	_, err := simulation.MakeSimulator(env.Ledger, false).Simulate(simulation.Request{
+		TxnGroups:         [][]transactions.SignedTxn{{pay.Txn().Sign(sender.Sk)}},
+		PopulateResources: true,
+	})
+	require.ErrorContains(t, err, "PopulateResources requires AllowUnnamedResources")
+
+	// a group without unnamed resources is returned as it is, signatures included
+	group := []transactions.SignedTxn{pay.Txn().Sign(sender.Sk)}
+	result, err := simulation.MakeSimulator(env.Ledger, false).Simulate(simulation.Request{
+		TxnGroups:             [][]transactions.SignedTxn{group},
+		AllowUnnamedResources: true,
+		PopulateResources:     true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, group, result.TxnGroups[0].PopulatedTxns)
+}
@@ -0,0 +1,201 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation
+
+import (
+	"slices"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+)
+
+// PCProfile aggregates the evaluations of the opcode at one PC of a program.
+type PCProfile struct {
+	// PC is the program counter of the opcode.
+	PC int
+	// Hits is the number of times the opcode was evaluated.
+	Hits int
+	// Cost is the total opcode cost of those evaluations.
+	Cost int
+}
+
+// SubroutineProfile aggregates the calls to one subroutine of a program.
+type SubroutineProfile struct {
+	// PC is the program counter of the first opcode of the subroutine (the target of callsub),
+	// which is the subroutine's proto opcode if it declares a frame.
+	PC int
+	// Calls is the number of times the subroutine was called.
+	Calls int
+	// Cost is the total opcode cost of those calls, from the first opcode of the subroutine to its
+	// retsub, including the cost of any subroutines it calls. The cost of recursive calls is
+	// therefore counted once for every active call.
+	Cost int
+}
+
+// ProgramProfile is the profile of one program evaluation.
+type ProgramProfile struct {
+	// Hash is the SHA512_256 hash digest of the program.
+	Hash crypto.Digest
+	// Cost is the total opcode cost of the evaluation.
+	Cost int
+	// PCs holds the PCs that were evaluated, in increasing order.
+	PCs []PCProfile
+	// Subroutines holds the subroutines that were called, in increasing order of PC.
+	Subroutines []SubroutineProfile
+}
+
+// TransactionProfile contains the profiles of the programs evaluated for a single transaction
+// (including its inners). It has the same shape as TransactionTrace.
+type TransactionProfile struct {
+	ApprovalProgram   *ProgramProfile
+	ClearStateProgram *ProgramProfile
+	LogicSig          *ProgramProfile
+
+	// InnerProfiles contains the profiles for inner transactions, if this transaction spawned any.
+	// Like TransactionTrace.InnerTraces, it only contains the immediate children.
+	InnerProfiles []TransactionProfile
+}
+
+// LineProfile aggregates the evaluations of the opcodes assembled from one source line.
+type LineProfile struct {
+	// Line is the source line, counting from 0 like logic.SourceLocation.
+	Line int
+	// Hits is the number of times the line was evaluated, i.e. the most times any of its opcodes
+	// was evaluated.
+	Hits int
+	// Cost is the total opcode cost of the line's opcodes.
+	Cost int
+}
+
+// Lines maps the profile back to source lines, using the assembler's map from PCs to source
+// locations (logic.OpStream.OffsetToSource). PCs that are not in the map are ignored.
+func (p *ProgramProfile) Lines(offsetToSource map[int]logic.SourceLocation) []LineProfile {
+	byLine := make(map[int]*LineProfile)
+	for _, pc := range p.PCs {
+		location, ok := offsetToSource[pc.PC]
+		if !ok {
+			continue
+		}
+		line, ok := byLine[location.Line]
+		if !ok {
+			line = &LineProfile{Line: location.Line}
+			byLine[location.Line] = line
+		}
+		line.Hits = max(line.Hits, pc.Hits)
+		line.Cost += pc.Cost
+	}
+	lines := make([]LineProfile, 0, len(byLine))
+	for _, line := range byLine {
+		lines = append(lines, *line)
+	}
+	slices.SortFunc(lines, func(a, b LineProfile) int { return a.Line - b.Line })
+	return lines
+}
+
+// programProfiler builds the profile of a running program.
+type programProfiler struct {
+	profile     *ProgramProfile
+	pcs         map[int]*PCProfile
+	subroutines map[int]*SubroutineProfile
+
+	// pc, op and costBefore are the PC, name and cost of the program before the current opcode,
+	// since the PC has moved on by the time AfterOpcode is called.
+	pc         int
+	op         string
+	costBefore int
+	// calling is set after a callsub, so that the next opcode enters a subroutine.
+	calling bool
+	// frames holds the entry PC, and the cost before entering, of the active subroutine calls.
+	frames []profileFrame
+}
+
+type profileFrame struct {
+	pc         int
+	costBefore int
+}
+
+func makeProgramProfiler(cx *logic.EvalContext) *programProfiler {
+	return &programProfiler{
+		profile:     &ProgramProfile{Hash: crypto.Hash(cx.GetProgram())},
+		pcs:         make(map[int]*PCProfile),
+		subroutines: make(map[int]*SubroutineProfile),
+	}
+}
+
+func (pp *programProfiler) beforeOpcode(cx *logic.EvalContext) {
+	pp.pc = cx.PC()
+	pp.op = cx.GetOpSpec().Name
+	pp.costBefore = cx.Cost()
+	if pp.calling {
+		pp.calling = false
+		pp.frames = append(pp.frames, profileFrame{pc: pp.pc, costBefore: pp.costBefore})
+	}
+}
+
+func (pp *programProfiler) afterOpcode(cx *logic.EvalContext, evalError error) {
+	pc, ok := pp.pcs[pp.pc]
+	if !ok {
+		pc = &PCProfile{PC: pp.pc}
+		pp.pcs[pp.pc] = pc
+	}
+	pc.Hits++
+	pc.Cost += cx.Cost() - pp.costBefore
+
+	if evalError != nil {
+		return
+	}
+	switch pp.op {
+	case "callsub":
+		pp.calling = true
+	case "retsub":
+		if len(pp.frames) > 0 {
+			pp.exitSubroutine(cx.Cost())
+		}
+	}
+}
+
+func (pp *programProfiler) exitSubroutine(cost int) {
+	frame := pp.frames[len(pp.frames)-1]
+	pp.frames = pp.frames[:len(pp.frames)-1]
+	sub, ok := pp.subroutines[frame.pc]
+	if !ok {
+		sub = &SubroutineProfile{PC: frame.pc}
+		pp.subroutines[frame.pc] = sub
+	}
+	sub.Calls++
+	sub.Cost += cost - frame.costBefore
+}
+
+// finish completes the profile once the program has exited. Subroutines that were still active,
+// because the program exited or failed inside them, are counted up to that point.
+func (pp *programProfiler) finish(cx *logic.EvalContext) *ProgramProfile {
+	for len(pp.frames) > 0 {
+		pp.exitSubroutine(cx.Cost())
+	}
+	pp.profile.Cost = cx.Cost()
+	pp.profile.PCs = make([]PCProfile, 0, len(pp.pcs))
+	for _, pc := range pp.pcs {
+		pp.profile.PCs = append(pp.profile.PCs, *pc)
+	}
+	slices.SortFunc(pp.profile.PCs, func(a, b PCProfile) int { return a.PC - b.PC })
+	pp.profile.Subroutines = make([]SubroutineProfile, 0, len(pp.subroutines))
+	for _, sub := range pp.subroutines {
+		pp.profile.Subroutines = append(pp.profile.Subroutines, *sub)
+	}
+	slices.SortFunc(pp.profile.Subroutines, func(a, b SubroutineProfile) int { return a.PC - b.PC })
+	return pp.profile
+}
@@ -0,0 +1,307 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation_test
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/data/txntest"
+	"github.com/algorand/go-algorand/ledger/simulation"
+	simulationtesting "github.com/algorand/go-algorand/ledger/simulation/testing"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/stretchr/testify/require"
+)
+
+const profiledApprovalProgram = `#pragma version 8
+int 0
+store 0
+loop:
+load 0
+int 1
++
+dup
+store 0
+int 3
+<
+bnz loop
+int 5
+callsub square
+callsub square
+pop
+int 1
+return
+square:
+proto 1 1
+frame_dig -1
+itob
+sha256
+pop
+frame_dig -1
+dup
+*
+retsub`
+
+// sourceLine returns the 0-based line of the first source line equal to text.
+func sourceLine(t *testing.T, source string, text string) int {
+	t.Helper()
+	line := slices.Index(strings.Split(source, "\n"), text)
+	require.NotEqual(t, -1, line, text)
+	return line
+}
+
+// sourcePC returns the PC of the opcode assembled from the given source line.
+func sourcePC(t *testing.T, ops *logic.OpStream, line int) int {
+	t.Helper()
+	for pc, location := range ops.OffsetToSource {
+		if location.Line == line {
+			return pc
+		}
+	}
+	require.FailNow(t, "no opcode for line", line)
+	return 0
+}
+
+func lineProfile(t *testing.T, lines []simulation.LineProfile, line int) simulation.LineProfile {
+	t.Helper()
+	i := slices.IndexFunc(lines, func(l simulation.LineProfile) bool { return l.Line == line })
+	require.NotEqual(t, -1, i, line)
+	return lines[i]
+}
+
+func requireConsistentProfile(t *testing.T, profile *simulation.ProgramProfile, program []byte, cost int) {
+	t.Helper()
+	require.NotNil(t, profile)
+	require.Equal(t, crypto.Hash(program), profile.Hash)
+	require.Equal(t, cost, profile.Cost)
+	var sum int
+	for i, pc := range profile.PCs {
+		if i > 0 {
+			require.Less(t, profile.PCs[i-1].PC, pc.PC)
+		}
+		sum += pc.Cost
+	}
+	require.Equal(t, cost, sum)
+}
+
+func TestOpcodeCostProfile(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+	sender := env.Accounts[0]
+
+	approval, err := logic.AssembleString(profiledApprovalProgram)
+	require.NoError(t, err)
+
+	lsigSource := `#pragma version 8
+byte "a"
+keccak256
+pop
+int 1`
+	lsig, err := logic.AssembleString(lsigSource)
+	require.NoError(t, err)
+	lsigAddr := basics.Address(crypto.HashObj(logic.Program(lsig.Program)))
+
+	pay := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   sender.Addr,
+		Receiver: lsigAddr,
+		Amount:   1_000_000,
+	})
+	appCall := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:              protocol.ApplicationCallTx,
+		Sender:            lsigAddr,
+		ApprovalProgram:   profiledApprovalProgram,
+		ClearStateProgram: "#pragma version 8\nint 1",
+	})
+	txntest.Group(&pay, &appCall)
+	signedAppCall := appCall.SignedTxn()
+	signedAppCall.Lsig = transactions.LogicSig{Logic: lsig.Program}
+
+	request := simulation.Request{
+		TxnGroups:   [][]transactions.SignedTxn{{pay.Txn().Sign(sender.Sk), signedAppCall}},
+		TraceConfig: simulation.ExecTraceConfig{Profile: true},
+	}
+
+	_, err = simulation.MakeSimulator(env.Ledger, false).Simulate(request)
+	require.ErrorContains(t, err, "requesting for opcode cost profiles")
+
+	result, err := simulation.MakeSimulator(env.Ledger, true).Simulate(request)
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+
+	// the payment has no programs, but still gets an empty profile
+	require.Equal(t, &simulation.TransactionProfile{}, result.TxnGroups[0].Txns[0].Profile)
+	// the execution trace was not asked for
+	require.Nil(t, result.TxnGroups[0].Txns[1].Trace)
+
+	profile := result.TxnGroups[0].Txns[1].Profile
+	require.NotNil(t, profile)
+	require.Nil(t, profile.ClearStateProgram)
+	require.Empty(t, profile.InnerProfiles)
+
+	requireConsistentProfile(t, profile.LogicSig, lsig.Program, result.TxnGroups[0].Txns[1].LogicSigBudgetConsumed)
+	lsigLines := profile.LogicSig.Lines(lsig.OffsetToSource)
+	require.Equal(t, simulation.LineProfile{Line: sourceLine(t, lsigSource, "keccak256"), Hits: 1, Cost: 130},
+		lineProfile(t, lsigLines, sourceLine(t, lsigSource, "keccak256")))
+
+	approvalProfile := profile.ApprovalProgram
+	requireConsistentProfile(t, approvalProfile, approval.Program, result.TxnGroups[0].Txns[1].AppBudgetConsumed)
+
+	lines := approvalProfile.Lines(approval.OffsetToSource)
+	require.Equal(t, 3, lineProfile(t, lines, sourceLine(t, profiledApprovalProgram, "load 0")).Hits)
+	require.Equal(t, 3, lineProfile(t, lines, sourceLine(t, profiledApprovalProgram, "bnz loop")).Cost)
+	require.Equal(t, simulation.LineProfile{Line: sourceLine(t, profiledApprovalProgram, "sha256"), Hits: 2, Cost: 70},
+		lineProfile(t, lines, sourceLine(t, profiledApprovalProgram, "sha256")))
+
+	// proto, frame_dig, itob, sha256, pop, frame_dig, dup, *, retsub
+	require.Equal(t, []simulation.SubroutineProfile{{
+		PC:    sourcePC(t, approval, sourceLine(t, profiledApprovalProgram, "proto 1 1")),
+		Calls: 2,
+		Cost:  2 * (8 + 35),
+	}}, approvalProfile.Subroutines)
+}
+
+func TestOpcodeCostProfileRecursion(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+	sender := env.Accounts[0]
+
+	// countdown calls itself until its argument is 0, and the program fails inside the innermost
+	// call, leaving every call active
+	source := `#pragma version 8
+int 3
+callsub countdown
+int 1
+return
+countdown:
+proto 1 0
+frame_dig -1
+bz fail
+frame_dig -1
+int 1
+-
+callsub countdown
+retsub
+fail:
+err`
+	approval, err := logic.AssembleString(source)
+	require.NoError(t, err)
+
+	appCall := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:              protocol.ApplicationCallTx,
+		Sender:            sender.Addr,
+		ApprovalProgram:   source,
+		ClearStateProgram: "#pragma version 8\nint 1",
+	})
+
+	result, err := simulation.MakeSimulator(env.Ledger, true).Simulate(simulation.Request{
+		TxnGroups:   [][]transactions.SignedTxn{{appCall.Txn().Sign(sender.Sk)}},
+		TraceConfig: simulation.ExecTraceConfig{Profile: true},
+	})
+	require.NoError(t, err)
+	require.Contains(t, result.TxnGroups[0].FailureMessage, "err opcode executed")
+
+	approvalProfile := result.TxnGroups[0].Txns[0].Profile.ApprovalProgram
+	requireConsistentProfile(t, approvalProfile, approval.Program, result.TxnGroups[0].Txns[0].AppBudgetConsumed)
+
+	// the calls with arguments 3, 2, 1 cost 7 each up to their callsub (proto, frame_dig, bz,
+	// frame_dig, intc, -, callsub), plus the calls they make, and the call with argument 0 costs 4
+	// (proto, frame_dig, bz, err)
+	require.Equal(t, []simulation.SubroutineProfile{{
+		PC:    sourcePC(t, approval, sourceLine(t, source, "proto 1 0")),
+		Calls: 4,
+		Cost:  (3*7 + 4) + (2*7 + 4) + (7 + 4) + 4,
+	}}, approvalProfile.Subroutines)
+}
+
+func TestOpcodeCostProfileInners(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+	sender := env.Accounts[0]
+
+	innerSource := `#pragma version 8
+byte "a"
+sha256
+pop
+int 1`
+	inner, err := logic.AssembleString(innerSource)
+	require.NoError(t, err)
+	innerAppID := env.CreateApp(sender.Addr, simulationtesting.AppParams{
+		ApprovalProgram:   innerSource,
+		ClearStateProgram: "#pragma version 8\nint 1",
+	})
+
+	outerSource := fmt.Sprintf(`#pragma version 8
+txn ApplicationID
+bz create
+itxn_begin
+int appl
+itxn_field TypeEnum
+int %d
+itxn_field ApplicationID
+itxn_next
+int pay
+itxn_field TypeEnum
+txn Sender
+itxn_field Receiver
+itxn_submit
+create:
+int 1`, innerAppID)
+	outer, err := logic.AssembleString(outerSource)
+	require.NoError(t, err)
+	outerAppID := env.CreateApp(sender.Addr, simulationtesting.AppParams{
+		ApprovalProgram:   outerSource,
+		ClearStateProgram: "#pragma version 8\nint 1",
+	})
+	env.TransferAlgos(sender.Addr, outerAppID.Address(), 1_000_000)
+
+	appCall := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:          protocol.ApplicationCallTx,
+		Sender:        sender.Addr,
+		ApplicationID: outerAppID,
+		ForeignApps:   []basics.AppIndex{innerAppID},
+	})
+
+	result, err := simulation.MakeSimulator(env.Ledger, true).Simulate(simulation.Request{
+		TxnGroups:   [][]transactions.SignedTxn{{appCall.Txn().Sign(sender.Sk)}},
+		TraceConfig: simulation.ExecTraceConfig{Profile: true},
+	})
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+
+	profile := result.TxnGroups[0].Txns[0].Profile
+	require.Len(t, profile.InnerProfiles, 2)
+	requireConsistentProfile(t, profile.ApprovalProgram, outer.Program, result.TxnGroups[0].Txns[0].AppBudgetConsumed-profile.InnerProfiles[0].ApprovalProgram.Cost)
+	requireConsistentProfile(t, profile.InnerProfiles[0].ApprovalProgram, inner.Program, 3+35)
+	require.Equal(t, simulation.TransactionProfile{}, profile.InnerProfiles[1])
+}
@@ -0,0 +1,424 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+const (
+	// MaxSessions is the maximum number of simulation sessions a node keeps at once.
+	MaxSessions = 32
+	// SessionIdleTimeout is how long a session is kept after it was last used.
+	SessionIdleTimeout = 30 * time.Minute
+	// MaxSessionAdvanceRounds is the maximum number of rounds a session can advance in one call.
+	MaxSessionAdvanceRounds = 1000
+)
+
+// ErrTooManySessions is returned when a session cannot be started because MaxSessions are in use.
+var ErrTooManySessions = errors.New("too many simulation sessions")
+
+// sessionState is what a session has accumulated on top of its base round, other than the
+// state overlay itself.
+type sessionState struct {
+	// base is the ledger round the session started from.
+	base basics.Round
+	// headers[i] is the header of the simulated round base+1+i.
+	headers []bookkeeping.BlockHeader
+
+	// txids and txleases record the last valid round of the transactions, and the expiration
+	// of the leases, confirmed by the session's blocks.
+	txids    map[transactions.Txid]basics.Round
+	txleases map[ledgercore.Txlease]basics.Round
+}
+
+func (ss *sessionState) round() basics.Round {
+	return ss.base + basics.Round(len(ss.headers))
+}
+
+func (ss *sessionState) checkDup(proto config.ConsensusParams, current basics.Round, txid transactions.Txid, txl ledgercore.Txlease) error {
+	if lastValid, ok := ss.txids[txid]; ok && current <= lastValid {
+		return &ledgercore.TransactionInLedgerError{Txid: txid, InBlockEvaluator: false}
+	}
+	if proto.SupportTransactionLeases && (txl.Lease != [32]byte{}) {
+		if expires, ok := ss.txleases[txl]; ok && current <= expires {
+			return ledgercore.MakeLeaseInLedgerError(txid, txl, false)
+		}
+	}
+	return nil
+}
+
+// Session simulates a sequence of blocks. Every simulation whose groups all succeed becomes a
+// new block of the session, and later simulations and queries see its effects. Sessions never
+// modify the ledger.
+//
+// The blocks of a session keep the timestamp of the previous block, so that time only passes
+// when the session is advanced. Online account state is not tracked by sessions; it is always
+// that of the base round, and is only available as long as the ledger's online accounts
+// tracker keeps it.
+//
+// The ledger keeps the state of the base round for as long as the session is open, however far
+// the ledger moves on in the meantime, so sessions must be closed once they are no longer used.
+type Session struct {
+	mu        deadlock.Mutex
+	simulator Simulator
+	release   func()
+}
+
+// StartSession starts a session on top of round rnd (the latest round if zero), with the given
+// state overrides applied. The overrides become part of the session's state. Unless the node
+// keeps a state history (see the StateHistoryRounds node config), only the latest round can be
+// used as the base of a session.
+func (s Simulator) StartSession(rnd basics.Round, overrides *StateOverrides) (*Session, error) {
+	start, err := s.startRound(rnd)
+	if err != nil {
+		return nil, err
+	}
+	s.ledger.start = start
+
+	release, err := s.ledger.Ledger.PinStateRound(start)
+	if err != nil {
+		return nil, InvalidRequestError{SimulatorError{fmt.Errorf("cannot start a session at round %d: %w", start, err)}}
+	}
+	overlay, err := makeStateOverlay(s.ledger, start, overrides)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	if overlay == nil {
+		overlay = makeEmptyStateOverlay()
+	}
+	overlay.creators = make(map[basics.CreatableIndex]ledgercore.ModifiedCreatable)
+	s.ledger.overlay = overlay
+
+	s.ledger.session = &sessionState{
+		base:     start,
+		txids:    make(map[transactions.Txid]basics.Round),
+		txleases: make(map[ledgercore.Txlease]basics.Round),
+	}
+	return &Session{simulator: s, release: release}, nil
+}
+
+// Close ends the session, letting the ledger drop the state of its base round.
+func (s *Session) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.release()
+}
+
+// Round returns the latest round of the session.
+func (s *Session) Round() basics.Round {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.simulator.ledger.start
+}
+
+// BlockHdr returns the header of the latest round of the session.
+func (s *Session) BlockHdr() (bookkeeping.BlockHeader, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.simulator.ledger.BlockHdr(s.simulator.ledger.start)
+}
+
+// Simulate simulates the request on top of the session's latest round. If every group
+// succeeds, the resulting block is added to the session.
+func (s *Session) Simulate(request Request) (Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l := &s.simulator.ledger
+	if request.Round != 0 && request.Round != l.start {
+		return Result{}, InvalidRequestError{
+			SimulatorError{fmt.Errorf("session is at round %d, cannot simulate at round %d", l.start, request.Round)},
+		}
+	}
+	if !request.StateOverrides.Empty() {
+		return Result{}, InvalidRequestError{
+			SimulatorError{errors.New("state overrides can only be given when starting a session")},
+		}
+	}
+
+	result, err := s.simulator.simulate(request)
+	if err != nil || result.Block == nil {
+		return result, err
+	}
+	for _, group := range result.TxnGroups {
+		if group.FailureMessage != "" {
+			return result, nil
+		}
+	}
+	return result, s.apply(result.Block)
+}
+
+// Advance adds the given number of empty blocks to the session. The timestamps of the blocks
+// move forward by seconds in total, spread over the blocks since each block may only be
+// MaxTimestampIncrement seconds past the previous one.
+func (s *Session) Advance(rounds uint64, seconds int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if rounds == 0 || rounds > MaxSessionAdvanceRounds {
+		return InvalidRequestError{
+			SimulatorError{fmt.Errorf("rounds must be between 1 and %d, got %d", MaxSessionAdvanceRounds, rounds)},
+		}
+	}
+	if seconds < 0 {
+		return InvalidRequestError{SimulatorError{fmt.Errorf("seconds must not be negative, got %d", seconds)}}
+	}
+
+	l := &s.simulator.ledger
+	prev, err := l.BlockHdr(l.start)
+	if err != nil {
+		return err
+	}
+	maxIncrement := config.Consensus[prev.CurrentProtocol].MaxTimestampIncrement
+	if seconds > int64(rounds)*maxIncrement {
+		return InvalidRequestError{
+			SimulatorError{fmt.Errorf("cannot advance %d seconds in %d rounds, at most %d seconds per round are allowed", seconds, rounds, maxIncrement)},
+		}
+	}
+
+	target := prev.TimeStamp + seconds
+	for i := uint64(0); i < rounds; i++ {
+		hdr := bookkeeping.MakeBlock(prev).BlockHeader
+		// spread what is left evenly over the remaining blocks, rounding up
+		left := int64(rounds - i)
+		hdr.TimeStamp = prev.TimeStamp + (target-prev.TimeStamp+left-1)/left
+		vb, err := s.simulator.evaluate(hdr, nil, logic.NullEvalTracer{})
+		if err != nil {
+			return err
+		}
+		if err := s.apply(vb); err != nil {
+			return err
+		}
+		prev = vb.Block().BlockHeader
+	}
+	return nil
+}
+
+// apply adds the block to the session, folding its state changes into the session's overlay.
+func (s *Session) apply(vb *ledgercore.ValidatedBlock) error {
+	l := &s.simulator.ledger
+	o := l.overlay
+	delta := vb.Delta()
+
+	// Resource deltas only describe the parts that changed, so look up the rest before any
+	// of them is replaced.
+	for _, rec := range delta.Accts.GetAllAssetResources() {
+		res, err := l.LookupAsset(l.start, rec.Addr, rec.Aidx)
+		if err != nil {
+			return err
+		}
+		if rec.Params.Deleted {
+			res.AssetParams = nil
+		} else if rec.Params.Params != nil {
+			res.AssetParams = rec.Params.Params
+		}
+		if rec.Holding.Deleted {
+			res.AssetHolding = nil
+		} else if rec.Holding.Holding != nil {
+			res.AssetHolding = rec.Holding.Holding
+		}
+		o.assets[ledgercore.AccountAsset{Address: rec.Addr, Asset: rec.Aidx}] = res
+	}
+	for _, rec := range delta.Accts.GetAllAppResources() {
+		res, err := l.LookupApplication(l.start, rec.Addr, rec.Aidx)
+		if err != nil {
+			return err
+		}
+		if rec.Params.Deleted {
+			res.AppParams = nil
+		} else if rec.Params.Params != nil {
+			res.AppParams = rec.Params.Params
+		}
+		if rec.State.Deleted {
+			res.AppLocalState = nil
+		} else if rec.State.LocalState != nil {
+			res.AppLocalState = rec.State.LocalState
+		}
+		o.apps[ledgercore.AccountApp{Address: rec.Addr, App: rec.Aidx}] = res
+	}
+	for i := 0; i < delta.Accts.Len(); i++ {
+		addr, ad := delta.Accts.GetByIdx(i)
+		o.accounts[addr] = ad
+	}
+	for key, kv := range delta.KvMods {
+		o.kvs[key] = kv.Data
+	}
+	for cidx, mc := range delta.Creatables {
+		o.creators[cidx] = mc
+	}
+
+	ss := l.session
+	for txid, included := range delta.Txids {
+		ss.txids[txid] = included.LastValid
+	}
+	for txl, expires := range delta.Txleases {
+		ss.txleases[txl] = expires
+	}
+	o.totals = &delta.Totals
+	ss.headers = append(ss.headers, vb.Block().BlockHeader)
+	l.start = ss.round()
+	return nil
+}
+
+// LookupAccount returns the account at the session's latest round, like Ledger.LookupAccount:
+// pending rewards are applied, and the balance without them is returned as well.
+func (s *Session) LookupAccount(addr basics.Address) (ledgercore.AccountData, basics.Round, basics.MicroAlgos, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	l := s.simulator.ledger
+	hdr, err := l.BlockHdr(l.start)
+	if err != nil {
+		return ledgercore.AccountData{}, 0, basics.MicroAlgos{}, err
+	}
+	ad, _, err := l.LookupWithoutRewards(l.start, addr)
+	if err != nil {
+		return ledgercore.AccountData{}, 0, basics.MicroAlgos{}, err
+	}
+	withoutRewards := ad.MicroAlgos
+	ad = ad.WithUpdatedRewards(config.Consensus[hdr.CurrentProtocol].RewardUnit, hdr.RewardsLevel)
+	return ad, l.start, withoutRewards, nil
+}
+
+// LookupApplication returns an account's application resource at the session's latest round.
+func (s *Session) LookupApplication(addr basics.Address, aidx basics.AppIndex) (ledgercore.AppResource, basics.Round, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	l := s.simulator.ledger
+	res, err := l.LookupApplication(l.start, addr, aidx)
+	return res, l.start, err
+}
+
+// LookupCreator returns the creator of a creatable at the session's latest round.
+func (s *Session) LookupCreator(cidx basics.CreatableIndex, ctype basics.CreatableType) (basics.Address, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	l := s.simulator.ledger
+	return l.GetCreatorForRound(l.start, cidx, ctype)
+}
+
+// LookupKv returns the value of a key (e.g. a box) at the session's latest round.
+func (s *Session) LookupKv(key string) ([]byte, basics.Round, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	l := s.simulator.ledger
+	value, err := l.LookupKv(l.start, key)
+	return value, l.start, err
+}
+
+// SessionRegistry keeps the simulation sessions of a node, identified by random IDs. Sessions
+// that are not used for SessionIdleTimeout are closed and discarded.
+type SessionRegistry struct {
+	mu           deadlock.Mutex
+	ledger       *data.Ledger
+	developerAPI bool
+	sessions     map[string]*registeredSession
+}
+
+type registeredSession struct {
+	session  *Session
+	lastUsed time.Time
+	// expiry closes the session once it has been idle for SessionIdleTimeout.
+	expiry *time.Timer
+}
+
+// MakeSessionRegistry creates an empty session registry for the ledger.
+func MakeSessionRegistry(ledger *data.Ledger, developerAPI bool) *SessionRegistry {
+	return &SessionRegistry{
+		ledger:       ledger,
+		developerAPI: developerAPI,
+		sessions:     make(map[string]*registeredSession),
+	}
+}
+
+// Start starts a new session (see Simulator.StartSession) and returns its ID.
+func (r *SessionRegistry) Start(rnd basics.Round, overrides *StateOverrides) (string, *Session, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.sessions) >= MaxSessions {
+		return "", nil, ErrTooManySessions
+	}
+	session, err := MakeSimulator(r.ledger, r.developerAPI).StartSession(rnd, overrides)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var idBytes [16]byte
+	crypto.RandBytes(idBytes[:])
+	id := hex.EncodeToString(idBytes[:])
+	rs := &registeredSession{session: session, lastUsed: time.Now()}
+	rs.expiry = time.AfterFunc(SessionIdleTimeout, func() { r.expire(id, rs) })
+	r.sessions[id] = rs
+	return id, session, nil
+}
+
+// Get returns the session with the given ID.
+func (r *SessionRegistry) Get(id string) (*Session, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rs, ok := r.sessions[id]
+	if !ok {
+		return nil, false
+	}
+	rs.lastUsed = time.Now()
+	rs.expiry.Reset(SessionIdleTimeout)
+	return rs.session, true
+}
+
+// End discards the session with the given ID, returning false if there is no such session.
+func (r *SessionRegistry) End(id string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rs, ok := r.sessions[id]
+	if !ok {
+		return false
+	}
+	rs.expiry.Stop()
+	delete(r.sessions, id)
+	rs.session.Close()
+	return true
+}
+
+// expire discards the session once its idle timer fires, unless it was used or ended since.
+func (r *SessionRegistry) expire(id string, rs *registeredSession) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.sessions[id] != rs || time.Since(rs.lastUsed) < SessionIdleTimeout {
+		return
+	}
+	delete(r.sessions, id)
+	rs.session.Close()
+}
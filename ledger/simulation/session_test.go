@@ -0,0 +1,347 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/algorand/avm-abi/apps"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/data/txntest"
+	"github.com/algorand/go-algorand/ledger/simulation"
+	simulationtesting "github.com/algorand/go-algorand/ledger/simulation/testing"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionPayments(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+	sender := env.Accounts[0]
+	receiver := env.Accounts[1]
+
+	session, err := simulation.MakeSimulator(env.Ledger, false).StartSession(0, nil)
+	require.NoError(t, err)
+	defer session.Close()
+	start := session.Round()
+	require.Equal(t, env.Ledger.Latest(), start)
+
+	before, _, _, err := session.LookupAccount(receiver.Addr)
+	require.NoError(t, err)
+
+	amount := uint64(2_000_000)
+	fund := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   sender.Addr,
+		Receiver: receiver.Addr,
+		Amount:   amount,
+	}).Txn().Sign(sender.Sk)
+	spend := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   receiver.Addr,
+		Receiver: sender.Addr,
+		Amount:   before.MicroAlgos.Raw + 1_000_000,
+	}).Txn().Sign(receiver.Sk)
+
+	// receiver cannot spend more than it has yet; the failed block is not kept
+	result, err := session.Simulate(simulation.Request{TxnGroups: [][]transactions.SignedTxn{{spend}}})
+	require.NoError(t, err)
+	require.Contains(t, result.TxnGroups[0].FailureMessage, "overspend")
+	require.Equal(t, start, session.Round())
+
+	result, err = session.Simulate(simulation.Request{TxnGroups: [][]transactions.SignedTxn{{fund}}})
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+	require.Equal(t, start+1, session.Round())
+
+	after, rnd, _, err := session.LookupAccount(receiver.Addr)
+	require.NoError(t, err)
+	require.Equal(t, start+1, rnd)
+	require.Equal(t, before.MicroAlgos.Raw+amount, after.MicroAlgos.Raw)
+
+	result, err = session.Simulate(simulation.Request{TxnGroups: [][]transactions.SignedTxn{{spend}}})
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+	require.Equal(t, start+2, session.Round())
+
+	// transactions confirmed by the session cannot be repeated
+	result, err = session.Simulate(simulation.Request{TxnGroups: [][]transactions.SignedTxn{{fund}}})
+	require.NoError(t, err)
+	require.Contains(t, result.TxnGroups[0].FailureMessage, "already in ledger")
+
+	// the ledger itself is untouched
+	require.Equal(t, start, env.Ledger.Latest())
+	ad, _, err := env.Ledger.LookupWithoutRewards(start, receiver.Addr)
+	require.NoError(t, err)
+	require.Equal(t, before.MicroAlgos, ad.MicroAlgos)
+
+	_, err = session.Simulate(simulation.Request{Round: start + 5, TxnGroups: [][]transactions.SignedTxn{{spend}}})
+	require.ErrorAs(t, err, &simulation.InvalidRequestError{})
+	require.ErrorContains(t, err, "session is at round")
+}
+
+func TestSessionAppsAndTime(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+	sender := env.Accounts[0]
+
+	session, err := simulation.MakeSimulator(env.Ledger, false).StartSession(0, nil)
+	require.NoError(t, err)
+	defer session.Close()
+	hdr, err := session.BlockHdr()
+	require.NoError(t, err)
+	unlockRound := hdr.Round + 20
+	unlockTime := hdr.TimeStamp + 3600
+
+	// The app only approves calls after unlockRound and unlockTime, and counts them in a box.
+	approval := fmt.Sprintf(`#pragma version 8
+txn ApplicationID
+bz end
+global Round
+int %d
+>=
+assert
+global LatestTimestamp
+int %d
+>=
+assert
+byte "calls"
+int 8
+box_create
+pop
+byte "calls"
+byte "calls"
+box_get
+assert
+btoi
+int 1
++
+itob
+box_put
+end:
+int 1`, unlockRound, unlockTime)
+	ops, err := logic.AssembleString(approval)
+	require.NoError(t, err)
+	clear, err := logic.AssembleString("#pragma version 8\nint 1")
+	require.NoError(t, err)
+
+	create := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:              protocol.ApplicationCallTx,
+		Sender:            sender.Addr,
+		ApprovalProgram:   ops.Program,
+		ClearStateProgram: clear.Program,
+	}).Txn().Sign(sender.Sk)
+	result, err := session.Simulate(simulation.Request{TxnGroups: [][]transactions.SignedTxn{{create}}})
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+	appID := result.TxnGroups[0].Txns[0].Txn.ApplyData.ApplicationID
+	require.NotZero(t, appID)
+
+	creator, ok, err := session.LookupCreator(basics.CreatableIndex(appID), basics.AppCreatable)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, sender.Addr, creator)
+	res, _, err := session.LookupApplication(sender.Addr, appID)
+	require.NoError(t, err)
+	require.NotNil(t, res.AppParams)
+
+	fund := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   sender.Addr,
+		Receiver: appID.Address(),
+		Amount:   1_000_000,
+	}).Txn().Sign(sender.Sk)
+	result, err = session.Simulate(simulation.Request{TxnGroups: [][]transactions.SignedTxn{{fund}}})
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+
+	call := func(note string) simulation.Result {
+		stxn := env.TxnInfo.NewTxn(txntest.Txn{
+			Type:          protocol.ApplicationCallTx,
+			Sender:        sender.Addr,
+			ApplicationID: appID,
+			Boxes:         []transactions.BoxRef{{Name: []byte("calls")}},
+			Note:          []byte(note),
+		}).Txn().Sign(sender.Sk)
+		result, err := session.Simulate(simulation.Request{TxnGroups: [][]transactions.SignedTxn{{stxn}}})
+		require.NoError(t, err)
+		return result
+	}
+
+	require.Contains(t, call("early").TxnGroups[0].FailureMessage, "assert failed")
+
+	// advancing the rounds alone is not enough
+	require.NoError(t, session.Advance(20, 0))
+	require.Contains(t, call("no time").TxnGroups[0].FailureMessage, "assert failed")
+
+	require.NoError(t, session.Advance(144, 3600))
+	require.Empty(t, call("first").TxnGroups[0].FailureMessage)
+	require.Empty(t, call("second").TxnGroups[0].FailureMessage)
+
+	value, _, err := session.LookupKv(apps.MakeBoxKey(uint64(appID), "calls"))
+	require.NoError(t, err)
+	require.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0, 2}, value)
+
+	err = session.Advance(0, 0)
+	require.ErrorAs(t, err, &simulation.InvalidRequestError{})
+	err = session.Advance(1, 3600)
+	require.ErrorAs(t, err, &simulation.InvalidRequestError{})
+}
+
+func TestSessionStateOverrides(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+	sender := env.Accounts[0]
+
+	balance := basics.MicroAlgos{Raw: 1_000_000_000_000}
+	session, err := simulation.MakeSimulator(env.Ledger, false).StartSession(0, &simulation.StateOverrides{
+		Accounts: []simulation.AccountOverride{{Address: sender.Addr, Balance: &balance}},
+	})
+	require.NoError(t, err)
+	defer session.Close()
+
+	ad, _, _, err := session.LookupAccount(sender.Addr)
+	require.NoError(t, err)
+	require.Equal(t, balance, ad.MicroAlgos)
+
+	stxn := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   sender.Addr,
+		Receiver: env.Accounts[1].Addr,
+		Amount:   balance.Raw / 2,
+	}).Txn().Sign(sender.Sk)
+	result, err := session.Simulate(simulation.Request{TxnGroups: [][]transactions.SignedTxn{{stxn}}})
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+
+	_, err = session.Simulate(simulation.Request{
+		TxnGroups:      [][]transactions.SignedTxn{{stxn}},
+		StateOverrides: &simulation.StateOverrides{Accounts: []simulation.AccountOverride{{Address: sender.Addr, Balance: &balance}}},
+	})
+	require.ErrorAs(t, err, &simulation.InvalidRequestError{})
+}
+
+func TestSessionRegistry(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+
+	registry := simulation.MakeSessionRegistry(env.Ledger, false)
+	id, session, err := registry.Start(0, nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, id)
+
+	got, ok := registry.Get(id)
+	require.True(t, ok)
+	require.Same(t, session, got)
+
+	require.True(t, registry.End(id))
+	require.False(t, registry.End(id))
+	_, ok = registry.Get(id)
+	require.False(t, ok)
+
+	for i := 0; i < simulation.MaxSessions; i++ {
+		_, _, err = registry.Start(0, nil)
+		require.NoError(t, err)
+	}
+	_, _, err = registry.Start(0, nil)
+	require.ErrorIs(t, err, simulation.ErrTooManySessions)
+}
+
+// TestSessionWhileLedgerAdvances checks that, with the default node config, a session keeps
+// working on top of its base round while the ledger keeps adding blocks and flushing its state.
+func TestSessionWhileLedgerAdvances(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTestWithConfig(t, config.GetDefaultLocal())
+	defer env.Close()
+	sender := env.Accounts[0]
+	receiver := env.Accounts[1]
+
+	// Round 0 means "latest", so make sure the rounds used below are past it, and that the
+	// accounts tracker has let go of some of them.
+	waitForTrackers(t, &env, 1)
+
+	// without a state history, sessions can only start at the latest round
+	_, err := simulation.MakeSimulator(env.Ledger, false).StartSession(env.Ledger.OldestStateRound()-1, nil)
+	require.ErrorAs(t, err, &simulation.InvalidRequestError{})
+	require.ErrorContains(t, err, "no longer available")
+	_, err = simulation.MakeSimulator(env.Ledger, false).StartSession(env.Ledger.Latest()-1, nil)
+	require.ErrorAs(t, err, &simulation.InvalidRequestError{})
+	require.ErrorContains(t, err, "cannot start a session")
+
+	session, err := simulation.MakeSimulator(env.Ledger, false).StartSession(0, nil)
+	require.NoError(t, err)
+	defer session.Close()
+	base := session.Round()
+	before, _, _, err := session.LookupAccount(receiver.Addr)
+	require.NoError(t, err)
+	fund := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   sender.Addr,
+		Receiver: receiver.Addr,
+		Amount:   2_000_000,
+		Note:     []byte("session"),
+	}).Txn().Sign(sender.Sk)
+
+	// the real ledger moves well past the base round, and pays the receiver along the way
+	for i := 0; i < 20; i++ {
+		env.TransferAlgos(sender.Addr, receiver.Addr, 1000)
+	}
+	waitForTrackers(t, &env, base)
+
+	ad, _, _, err := session.LookupAccount(receiver.Addr)
+	require.NoError(t, err)
+	require.Equal(t, before.MicroAlgos, ad.MicroAlgos)
+
+	result, err := session.Simulate(simulation.Request{TxnGroups: [][]transactions.SignedTxn{{fund}}})
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+	require.NoError(t, session.Advance(10, 0))
+
+	for i := 0; i < 20; i++ {
+		env.TransferAlgos(sender.Addr, receiver.Addr, 1000)
+	}
+
+	ad, rnd, _, err := session.LookupAccount(receiver.Addr)
+	require.NoError(t, err)
+	require.Equal(t, base+11, rnd)
+	require.Equal(t, before.MicroAlgos.Raw+2_000_000, ad.MicroAlgos.Raw)
+	require.NoError(t, session.Advance(1, 0))
+
+	// once closed, the ledger lets go of the base round
+	session.Close()
+	env.TransferAlgos(sender.Addr, receiver.Addr, 1000)
+	require.Greater(t, env.Ledger.OldestStateRound(), base)
+}
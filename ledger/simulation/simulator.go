@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data"
 	"github.com/algorand/go-algorand/data/basics"
@@ -42,12 +43,27 @@ type Request struct {
 	ExtraOpcodeBudget     int
 	TraceConfig           ExecTraceConfig
 	FixSigners            bool
+	PopulateResources     bool
+	StateOverrides        *StateOverrides
 }
 
-// simulatorLedger patches the ledger interface to use a constant latest round.
+// simulatorLedger patches the ledger interface to use a constant latest round, and to return
+// any state overrides requested for that round. Within a session, the latest round may be past
+// the ledger's, in which case the session supplies the block headers of the simulated rounds.
 type simulatorLedger struct {
 	*data.Ledger
-	start basics.Round
+	start   basics.Round
+	overlay *stateOverlay
+	session *sessionState
+}
+
+// ledgerRound maps rnd to the round whose state the underlying ledger should be asked for.
+// Rounds simulated by a session do not exist in the ledger, so the session's base is used.
+func (l simulatorLedger) ledgerRound(rnd basics.Round) basics.Round {
+	if l.session != nil && rnd > l.session.base {
+		return l.session.base
+	}
+	return rnd
 }
 
 // Latest is part of the ledger.Ledger interface.
@@ -58,10 +74,118 @@ func (l simulatorLedger) Latest() basics.Round {
 
 // LatestTotals is part of the ledger.Ledger interface.
 func (l simulatorLedger) LatestTotals() (basics.Round, ledgercore.AccountTotals, error) {
+	if l.overlay != nil && l.overlay.totals != nil {
+		return l.start, *l.overlay.totals, nil
+	}
 	totals, err := l.Totals(l.start)
 	return l.start, totals, err
 }
 
+// BlockHdr is part of the ledger.Ledger interface.
+func (l simulatorLedger) BlockHdr(rnd basics.Round) (bookkeeping.BlockHeader, error) {
+	if l.session != nil && rnd > l.session.base {
+		if rnd > l.session.round() {
+			return bookkeeping.BlockHeader{}, ledgercore.ErrNoEntry{Round: rnd, Latest: l.session.round()}
+		}
+		return l.session.headers[rnd-l.session.base-1], nil
+	}
+	return l.Ledger.BlockHdr(rnd)
+}
+
+// LookupWithoutRewards is part of the ledger.Ledger interface.
+func (l simulatorLedger) LookupWithoutRewards(rnd basics.Round, addr basics.Address) (ledgercore.AccountData, basics.Round, error) {
+	if l.overlay != nil && rnd == l.start {
+		if ad, ok := l.overlay.accounts[addr]; ok {
+			return ad, rnd, nil
+		}
+	}
+	return l.Ledger.LookupWithoutRewards(l.ledgerRound(rnd), addr)
+}
+
+// LookupAsset is part of the ledger.Ledger interface.
+func (l simulatorLedger) LookupAsset(rnd basics.Round, addr basics.Address, aidx basics.AssetIndex) (ledgercore.AssetResource, error) {
+	if l.overlay != nil && rnd == l.start {
+		if res, ok := l.overlay.assets[ledgercore.AccountAsset{Address: addr, Asset: aidx}]; ok {
+			return res, nil
+		}
+	}
+	return l.Ledger.LookupAsset(l.ledgerRound(rnd), addr, aidx)
+}
+
+// LookupApplication is part of the ledger.Ledger interface.
+func (l simulatorLedger) LookupApplication(rnd basics.Round, addr basics.Address, aidx basics.AppIndex) (ledgercore.AppResource, error) {
+	if l.overlay != nil && rnd == l.start {
+		if res, ok := l.overlay.apps[ledgercore.AccountApp{Address: addr, App: aidx}]; ok {
+			return res, nil
+		}
+	}
+	return l.Ledger.LookupApplication(l.ledgerRound(rnd), addr, aidx)
+}
+
+// LookupKv is part of the ledger.Ledger interface.
+func (l simulatorLedger) LookupKv(rnd basics.Round, key string) ([]byte, error) {
+	if l.overlay != nil && rnd == l.start {
+		if value, ok := l.overlay.kvs[key]; ok {
+			return value, nil
+		}
+	}
+	return l.Ledger.LookupKv(l.ledgerRound(rnd), key)
+}
+
+// GetCreatorForRound is part of the ledger.Ledger interface.
+func (l simulatorLedger) GetCreatorForRound(rnd basics.Round, cidx basics.CreatableIndex, ctype basics.CreatableType) (basics.Address, bool, error) {
+	if l.overlay != nil && rnd == l.start {
+		if mc, ok := l.overlay.creators[cidx]; ok && mc.Ctype == ctype {
+			return mc.Creator, mc.Created, nil
+		}
+	}
+	return l.Ledger.GetCreatorForRound(l.ledgerRound(rnd), cidx, ctype)
+}
+
+// LookupAgreement is part of the ledger.Ledger interface. Sessions do not track online account
+// changes, so online state is that of the session's base round.
+func (l simulatorLedger) LookupAgreement(rnd basics.Round, addr basics.Address) (basics.OnlineAccountData, error) {
+	return l.Ledger.LookupAgreement(l.ledgerRound(rnd), addr)
+}
+
+// OnlineCirculation is part of the ledger.Ledger interface.
+func (l simulatorLedger) OnlineCirculation(rnd basics.Round, voteRnd basics.Round) (basics.MicroAlgos, error) {
+	return l.Ledger.OnlineCirculation(l.ledgerRound(rnd), l.ledgerRound(voteRnd))
+}
+
+// GetKnockOfflineCandidates is part of the ledger.Ledger interface.
+func (l simulatorLedger) GetKnockOfflineCandidates(rnd basics.Round, proto config.ConsensusParams) (map[basics.Address]basics.OnlineAccountData, error) {
+	return l.Ledger.GetKnockOfflineCandidates(l.ledgerRound(rnd), proto)
+}
+
+// VotersForStateProof is part of the ledger.Ledger interface.
+func (l simulatorLedger) VotersForStateProof(rnd basics.Round) (*ledgercore.VotersForRound, error) {
+	return l.Ledger.VotersForStateProof(l.ledgerRound(rnd))
+}
+
+// CheckDup is part of the ledger.Ledger interface. When simulating against a past round, only
+// the transactions and leases confirmed up to that round are considered. If the ledger no longer
+// knows enough to tell, the check fails rather than passing silently. Within a session,
+// transactions confirmed by the session's own blocks are checked as well.
+func (l simulatorLedger) CheckDup(currentProto config.ConsensusParams, current basics.Round, firstValid basics.Round, lastValid basics.Round, txid transactions.Txid, txl ledgercore.Txlease) error {
+	if l.session != nil {
+		if err := l.session.checkDup(currentProto, current, txid, txl); err != nil {
+			return err
+		}
+	}
+	base := l.ledgerRound(l.start)
+	if base >= l.Ledger.Latest() {
+		return l.Ledger.CheckDup(currentProto, current, firstValid, lastValid, txid, txl)
+	}
+	err := l.Ledger.CheckDupAsOf(base, currentProto, current, firstValid, lastValid, txid, txl)
+	var txnInLedger *ledgercore.TransactionInLedgerError
+	var leaseInLedger *ledgercore.LeaseInLedgerError
+	if err != nil && !errors.As(err, &txnInLedger) && !errors.As(err, &leaseInLedger) {
+		return fmt.Errorf("cannot check for duplicate transactions as of round %d: %w", base, err)
+	}
+	return err
+}
+
 // LookupLatest would implicitly use the latest round in the _underlying_
 // Ledger, it would give wrong results if that ledger has moved forward. But it
 // should never be called, as the REST API is the only code using this function,
@@ -120,7 +244,7 @@ type Simulator struct {
 // MakeSimulator creates a new simulator from a ledger.
 func MakeSimulator(ledger *data.Ledger, developerAPI bool) *Simulator {
 	return &Simulator{
-		ledger:       simulatorLedger{ledger, 0}, // start round to be specified in Simulate method
+		ledger:       simulatorLedger{Ledger: ledger}, // start round to be specified in Simulate method
 		developerAPI: developerAPI,
 	}
 }
@@ -215,6 +339,10 @@ func (s Simulator) simulateWithTracer(txgroup []transactions.SignedTxnWithAD, tr
 	}
 	nextBlock := bookkeeping.MakeBlock(prevBlockHdr)
 	hdr := nextBlock.BlockHeader
+	if s.ledger.session != nil {
+		// a session's clock only moves when the session is advanced
+		hdr.TimeStamp = prevBlockHdr.TimeStamp
+	}
 
 	if overrides.FixSigners {
 		// Map of rekeys for senders in the group
@@ -234,7 +362,7 @@ func (s Simulator) simulateWithTracer(txgroup []transactions.SignedTxnWithAD, tr
 				// Otherwise lookup the sender's account and set the txn auth addr to the account's auth addr
 				if txnHasNoSignature(*stxn) {
 					var data ledgercore.AccountData
-					data, _, _, err = s.ledger.LookupAccount(s.ledger.start, sender)
+					data, _, err = s.ledger.LookupWithoutRewards(s.ledger.start, sender)
 					if err != nil {
 						return nil, err
 					}
@@ -279,8 +407,42 @@ func (s Simulator) simulateWithTracer(txgroup []transactions.SignedTxnWithAD, tr
 	return vb, err
 }
 
+// startRound returns the round a simulation requested at rnd starts from, or an error if the
+// ledger can no longer reconstruct its state. Zero means the latest round.
+func (s Simulator) startRound(rnd basics.Round) (basics.Round, error) {
+	if rnd == 0 {
+		// Access underlying data.Ledger to get the real latest round
+		return s.ledger.Ledger.Latest(), nil
+	}
+	if oldest := s.ledger.Ledger.OldestStateRound(); rnd < oldest {
+		return 0, InvalidRequestError{
+			SimulatorError{
+				err: fmt.Errorf("state for round %d is no longer available, oldest available round is %d (see the StateHistoryRounds node config)", rnd, oldest),
+			},
+		}
+	}
+	return rnd, nil
+}
+
 // Simulate simulates a transaction group using the simulator. Will error if the transaction group is not well-formed.
 func (s Simulator) Simulate(simulateRequest Request) (Result, error) {
+	start, err := s.startRound(simulateRequest.Round)
+	if err != nil {
+		return Result{}, err
+	}
+	s.ledger.start = start
+
+	overlay, err := makeStateOverlay(s.ledger, s.ledger.start, simulateRequest.StateOverrides)
+	if err != nil {
+		return Result{}, err
+	}
+	s.ledger.overlay = overlay
+
+	return s.simulate(simulateRequest)
+}
+
+// simulate evaluates the request's transaction group on top of s.ledger.start.
+func (s Simulator) simulate(simulateRequest Request) (Result, error) {
 	if simulateRequest.FixSigners && !simulateRequest.AllowEmptySignatures {
 		return Result{}, InvalidRequestError{
 			SimulatorError{
@@ -289,11 +451,12 @@ func (s Simulator) Simulate(simulateRequest Request) (Result, error) {
 		}
 	}
 
-	if simulateRequest.Round != 0 {
-		s.ledger.start = simulateRequest.Round
-	} else {
-		// Access underlying data.Ledger to get the real latest round
-		s.ledger.start = s.ledger.Ledger.Latest()
+	if simulateRequest.PopulateResources && !simulateRequest.AllowUnnamedResources {
+		return Result{}, InvalidRequestError{
+			SimulatorError{
+				errors.New("PopulateResources requires AllowUnnamedResources to be enabled"),
+			},
+		}
 	}
 
 	if len(simulateRequest.TxnGroups) != 1 {
@@ -376,5 +539,39 @@ func (s Simulator) Simulate(simulateRequest Request) (Result, error) {
 		}
 	}
 
+	if simulateRequest.PopulateResources && simulatorTracer.result.TxnGroups[0].FailureMessage == "" {
+		groupResult := &simulatorTracer.result.TxnGroups[0]
+		proto := config.Consensus[block.Block().CurrentProtocol]
+		populated, err := populateResources(simulateRequest.TxnGroups[0], groupResult, &proto)
+		if err == nil {
+			err = s.checkPopulated(populated, simulateRequest)
+		}
+		if err != nil {
+			groupResult.PopulateFailureMessage = err.Error()
+		} else {
+			groupResult.PopulatedTxns = populated
+		}
+	}
+
 	return *simulatorTracer.result, nil
 }
+
+// checkPopulated simulates a group returned by populateResources without unnamed resources, and
+// returns an error if it does not pass as well. The populated group may not, e.g. if a program
+// checks the group size and app calls had to be appended to hold the resources.
+func (s Simulator) checkPopulated(populated []transactions.SignedTxn, request Request) error {
+	result, err := s.simulate(Request{
+		TxnGroups:            [][]transactions.SignedTxn{populated},
+		AllowEmptySignatures: true,
+		AllowMoreLogging:     request.AllowMoreLogging,
+		ExtraOpcodeBudget:    request.ExtraOpcodeBudget,
+		FixSigners:           request.FixSigners,
+	})
+	if err != nil {
+		return fmt.Errorf("the populated group cannot be simulated: %w", err)
+	}
+	if msg := result.TxnGroups[0].FailureMessage; msg != "" {
+		return fmt.Errorf("the populated group fails: %s", msg)
+	}
+	return nil
+}
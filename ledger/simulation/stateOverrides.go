@@ -0,0 +1,324 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation
+
+import (
+	"fmt"
+
+	"github.com/algorand/avm-abi/apps"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// StateOverrides describes ledger state that is patched on top of the simulation round before the
+// request is evaluated. Overrides only exist for the duration of the simulation and are never
+// written to the ledger.
+type StateOverrides struct {
+	Accounts   []AccountOverride
+	AppGlobals []AppStateOverride
+	Boxes      []BoxOverride
+}
+
+// AccountOverride replaces parts of an account's state.
+type AccountOverride struct {
+	Address basics.Address
+	// Balance, if set, replaces the account's MicroAlgo balance.
+	Balance *basics.MicroAlgos
+	// AssetHoldings replace (or create, if the account is not opted in) asset holdings.
+	AssetHoldings []AssetHoldingOverride
+	// AppLocals set keys in the account's local state (opting the account in if needed).
+	AppLocals []AppStateOverride
+}
+
+// AssetHoldingOverride replaces an asset holding of an account.
+type AssetHoldingOverride struct {
+	AssetID basics.AssetIndex
+	Amount  uint64
+	Frozen  bool
+}
+
+// AppStateOverride sets the given keys in an application's global or local state. Keys that
+// are not mentioned keep their current values.
+type AppStateOverride struct {
+	AppID     basics.AppIndex
+	KeyValues basics.TealKeyValue
+}
+
+// BoxOverride replaces the contents of a box, creating the box if it does not exist. A nil
+// Value deletes the box. The name and value must fit the consensus limits on boxes, and the
+// application account is funded up to the minimum balance its boxes require, if needed.
+type BoxOverride struct {
+	AppID basics.AppIndex
+	Name  []byte
+	Value []byte
+}
+
+// Empty returns true if the overrides do not change any state.
+func (so *StateOverrides) Empty() bool {
+	return so == nil || (len(so.Accounts) == 0 && len(so.AppGlobals) == 0 && len(so.Boxes) == 0)
+}
+
+// overrideLedger is the subset of the ledger needed to resolve state overrides.
+type overrideLedger interface {
+	LookupWithoutRewards(basics.Round, basics.Address) (ledgercore.AccountData, basics.Round, error)
+	LookupAsset(basics.Round, basics.Address, basics.AssetIndex) (ledgercore.AssetResource, error)
+	LookupApplication(basics.Round, basics.Address, basics.AppIndex) (ledgercore.AppResource, error)
+	LookupKv(basics.Round, string) ([]byte, error)
+	GetCreatorForRound(basics.Round, basics.CreatableIndex, basics.CreatableType) (basics.Address, bool, error)
+	BlockHdr(basics.Round) (bookkeeping.BlockHeader, error)
+	LatestTotals() (basics.Round, ledgercore.AccountTotals, error)
+}
+
+// stateOverlay holds the fully resolved result of applying StateOverrides to a round. Every
+// entry is the complete, patched value that lookups at that round should return.
+type stateOverlay struct {
+	accounts map[basics.Address]ledgercore.AccountData
+	assets   map[ledgercore.AccountAsset]ledgercore.AssetResource
+	apps     map[ledgercore.AccountApp]ledgercore.AppResource
+	kvs      map[string][]byte
+	// totals, if set, replaces the ledger's totals to account for the changed balances.
+	totals *ledgercore.AccountTotals
+	// creators is only populated by sessions, with the creatables their blocks created or deleted.
+	creators map[basics.CreatableIndex]ledgercore.ModifiedCreatable
+}
+
+func makeEmptyStateOverlay() *stateOverlay {
+	return &stateOverlay{
+		accounts: make(map[basics.Address]ledgercore.AccountData),
+		assets:   make(map[ledgercore.AccountAsset]ledgercore.AssetResource),
+		apps:     make(map[ledgercore.AccountApp]ledgercore.AppResource),
+		kvs:      make(map[string][]byte),
+	}
+}
+
+func invalidOverride(format string, args ...interface{}) error {
+	return InvalidRequestError{SimulatorError{fmt.Errorf("invalid state override: "+format, args...)}}
+}
+
+// makeStateOverlay resolves the overrides against the ledger state at rnd.
+func makeStateOverlay(l overrideLedger, rnd basics.Round, overrides *StateOverrides) (*stateOverlay, error) {
+	if overrides.Empty() {
+		return nil, nil
+	}
+	o := makeEmptyStateOverlay()
+
+	hdr, err := l.BlockHdr(rnd)
+	if err != nil {
+		return nil, err
+	}
+	proto := config.Consensus[hdr.CurrentProtocol]
+
+	// originals holds the unmodified data of every account in o.accounts, to adjust the totals
+	originals := make(map[basics.Address]ledgercore.AccountData)
+	account := func(addr basics.Address) (ledgercore.AccountData, error) {
+		if ad, ok := o.accounts[addr]; ok {
+			return ad, nil
+		}
+		ad, _, err := l.LookupWithoutRewards(rnd, addr)
+		if err == nil {
+			originals[addr] = ad
+		}
+		return ad, err
+	}
+	app := func(addr basics.Address, aidx basics.AppIndex) (ledgercore.AppResource, error) {
+		key := ledgercore.AccountApp{Address: addr, App: aidx}
+		if res, ok := o.apps[key]; ok {
+			return res, nil
+		}
+		return l.LookupApplication(rnd, addr, aidx)
+	}
+	appParams := func(aidx basics.AppIndex) (basics.Address, *basics.AppParams, error) {
+		creator, ok, err := l.GetCreatorForRound(rnd, basics.CreatableIndex(aidx), basics.AppCreatable)
+		if err != nil {
+			return basics.Address{}, nil, err
+		}
+		if !ok {
+			return basics.Address{}, nil, invalidOverride("application %d does not exist", aidx)
+		}
+		res, err := app(creator, aidx)
+		if err != nil {
+			return basics.Address{}, nil, err
+		}
+		if res.AppParams == nil {
+			return basics.Address{}, nil, invalidOverride("application %d does not exist", aidx)
+		}
+		return creator, res.AppParams, nil
+	}
+
+	for _, acct := range overrides.Accounts {
+		ad, err := account(acct.Address)
+		if err != nil {
+			return nil, err
+		}
+		if acct.Balance != nil {
+			ad.MicroAlgos = *acct.Balance
+		}
+
+		for _, holding := range acct.AssetHoldings {
+			_, ok, err := l.GetCreatorForRound(rnd, basics.CreatableIndex(holding.AssetID), basics.AssetCreatable)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				return nil, invalidOverride("asset %d does not exist", holding.AssetID)
+			}
+			key := ledgercore.AccountAsset{Address: acct.Address, Asset: holding.AssetID}
+			res, ok := o.assets[key]
+			if !ok {
+				res, err = l.LookupAsset(rnd, acct.Address, holding.AssetID)
+				if err != nil {
+					return nil, err
+				}
+			}
+			if res.AssetHolding == nil {
+				ad.TotalAssets++
+			}
+			res.AssetHolding = &basics.AssetHolding{Amount: holding.Amount, Frozen: holding.Frozen}
+			o.assets[key] = res
+		}
+
+		for _, local := range acct.AppLocals {
+			_, params, err := appParams(local.AppID)
+			if err != nil {
+				return nil, err
+			}
+			res, err := app(acct.Address, local.AppID)
+			if err != nil {
+				return nil, err
+			}
+			var state basics.AppLocalState
+			if res.AppLocalState == nil {
+				state.Schema = params.LocalStateSchema
+				ad.TotalAppLocalStates++
+				ad.TotalAppSchema = ad.TotalAppSchema.AddSchema(state.Schema)
+			} else {
+				state.Schema = res.AppLocalState.Schema
+				state.KeyValue = res.AppLocalState.KeyValue.Clone()
+			}
+			if state.KeyValue == nil {
+				state.KeyValue = make(basics.TealKeyValue, len(local.KeyValues))
+			}
+			for k, v := range local.KeyValues {
+				state.KeyValue[k] = v
+			}
+			if err := checkSchema(state.KeyValue, state.Schema); err != nil {
+				return nil, invalidOverride("local state of %s for application %d: %v", acct.Address, local.AppID, err)
+			}
+			res.AppLocalState = &state
+			o.apps[ledgercore.AccountApp{Address: acct.Address, App: local.AppID}] = res
+		}
+
+		o.accounts[acct.Address] = ad
+	}
+
+	for _, global := range overrides.AppGlobals {
+		creator, params, err := appParams(global.AppID)
+		if err != nil {
+			return nil, err
+		}
+		patched := *params
+		patched.GlobalState = params.GlobalState.Clone()
+		if patched.GlobalState == nil {
+			patched.GlobalState = make(basics.TealKeyValue, len(global.KeyValues))
+		}
+		for k, v := range global.KeyValues {
+			patched.GlobalState[k] = v
+		}
+		if err := checkSchema(patched.GlobalState, patched.GlobalStateSchema); err != nil {
+			return nil, invalidOverride("global state of application %d: %v", global.AppID, err)
+		}
+		key := ledgercore.AccountApp{Address: creator, App: global.AppID}
+		res, err := app(creator, global.AppID)
+		if err != nil {
+			return nil, err
+		}
+		res.AppParams = &patched
+		o.apps[key] = res
+	}
+
+	for _, box := range overrides.Boxes {
+		if _, _, err := appParams(box.AppID); err != nil {
+			return nil, err
+		}
+		if len(box.Name) == 0 || len(box.Name) > proto.MaxAppKeyLen {
+			return nil, invalidOverride("box name of application %d must be 1 to %d bytes long", box.AppID, proto.MaxAppKeyLen)
+		}
+		if uint64(len(box.Value)) > proto.MaxBoxSize {
+			return nil, invalidOverride("box %q of application %d is %d bytes long, more than the %d bytes allowed", box.Name, box.AppID, len(box.Value), proto.MaxBoxSize)
+		}
+		key := apps.MakeBoxKey(uint64(box.AppID), string(box.Name))
+		current, ok := o.kvs[key]
+		if !ok {
+			var err error
+			current, err = l.LookupKv(rnd, key)
+			if err != nil {
+				return nil, err
+			}
+		}
+		appAddr := box.AppID.Address()
+		ad, err := account(appAddr)
+		if err != nil {
+			return nil, err
+		}
+		if current != nil {
+			ad.TotalBoxes--
+			ad.TotalBoxBytes -= uint64(len(box.Name) + len(current))
+		}
+		if box.Value != nil {
+			ad.TotalBoxes++
+			ad.TotalBoxBytes += uint64(len(box.Name) + len(box.Value))
+			// the ledger would never hold boxes the application account can't afford.
+			if minBalance := ad.MinBalance(&proto); ad.MicroAlgos.Raw < minBalance.Raw {
+				ad.MicroAlgos = minBalance
+			}
+		}
+		o.kvs[key] = box.Value
+		o.accounts[appAddr] = ad
+	}
+
+	_, totals, err := l.LatestTotals()
+	if err != nil {
+		return nil, err
+	}
+	rewardUnit := proto.RewardUnit
+	var ot basics.OverflowTracker
+	for addr, ad := range o.accounts {
+		totals.DelAccount(rewardUnit, originals[addr], &ot)
+		totals.AddAccount(rewardUnit, ad, &ot)
+	}
+	if ot.Overflowed {
+		return nil, invalidOverride("balances overflow the ledger's totals")
+	}
+	o.totals = &totals
+
+	return o, nil
+}
+
+// checkSchema verifies that the key-value store fits in the given schema.
+func checkSchema(kv basics.TealKeyValue, schema basics.StateSchema) error {
+	used, err := kv.ToStateSchema()
+	if err != nil {
+		return err
+	}
+	if used.NumUint > schema.NumUint || used.NumByteSlice > schema.NumByteSlice {
+		return fmt.Errorf("state uses %d uints and %d byte slices, schema allows %d and %d",
+			used.NumUint, used.NumByteSlice, schema.NumUint, schema.NumByteSlice)
+	}
+	return nil
+}
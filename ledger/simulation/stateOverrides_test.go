@@ -0,0 +1,261 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation_test
+
+import (
+	"testing"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/txntest"
+	"github.com/algorand/go-algorand/ledger/simulation"
+	simulationtesting "github.com/algorand/go-algorand/ledger/simulation/testing"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateOverridesBalance(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+	s := simulation.MakeSimulator(env.Ledger, false)
+	sender := env.Accounts[0]
+	receiver := env.Accounts[1]
+
+	amount := sender.AcctData.MicroAlgos.Raw + 1_000_000
+	stxn := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   sender.Addr,
+		Receiver: receiver.Addr,
+		Amount:   amount,
+	}).Txn().Sign(sender.Sk)
+
+	result, err := s.Simulate(simulation.Request{TxnGroups: [][]transactions.SignedTxn{{stxn}}})
+	require.NoError(t, err)
+	require.Contains(t, result.TxnGroups[0].FailureMessage, "overspend")
+
+	balance := basics.MicroAlgos{Raw: amount * 2}
+	result, err = s.Simulate(simulation.Request{
+		TxnGroups: [][]transactions.SignedTxn{{stxn}},
+		StateOverrides: &simulation.StateOverrides{
+			Accounts: []simulation.AccountOverride{{Address: sender.Addr, Balance: &balance}},
+		},
+	})
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+
+	// The override must not leak into the ledger or later simulations.
+	result, err = s.Simulate(simulation.Request{TxnGroups: [][]transactions.SignedTxn{{stxn}}})
+	require.NoError(t, err)
+	require.Contains(t, result.TxnGroups[0].FailureMessage, "overspend")
+}
+
+func TestStateOverridesAssetHolding(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+	s := simulation.MakeSimulator(env.Ledger, false)
+	creator := env.Accounts[0]
+	holder := env.Accounts[1]
+	receiver := env.Accounts[2]
+
+	assetID := env.CreateAsset(creator.Addr, basics.AssetParams{Total: 100})
+	env.OptIntoAsset(receiver.Addr, assetID)
+
+	stxn := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:          protocol.AssetTransferTx,
+		Sender:        holder.Addr,
+		AssetReceiver: receiver.Addr,
+		XferAsset:     assetID,
+		AssetAmount:   500,
+	}).Txn().Sign(holder.Sk)
+
+	result, err := s.Simulate(simulation.Request{TxnGroups: [][]transactions.SignedTxn{{stxn}}})
+	require.NoError(t, err)
+	require.Contains(t, result.TxnGroups[0].FailureMessage, "missing from")
+
+	result, err = s.Simulate(simulation.Request{
+		TxnGroups: [][]transactions.SignedTxn{{stxn}},
+		StateOverrides: &simulation.StateOverrides{
+			Accounts: []simulation.AccountOverride{{
+				Address:       holder.Addr,
+				AssetHoldings: []simulation.AssetHoldingOverride{{AssetID: assetID, Amount: 1000}},
+			}},
+		},
+	})
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+
+	_, err = s.Simulate(simulation.Request{
+		TxnGroups: [][]transactions.SignedTxn{{stxn}},
+		StateOverrides: &simulation.StateOverrides{
+			Accounts: []simulation.AccountOverride{{
+				Address:       holder.Addr,
+				AssetHoldings: []simulation.AssetHoldingOverride{{AssetID: assetID + 1000, Amount: 1000}},
+			}},
+		},
+	})
+	require.ErrorAs(t, err, &simulation.InvalidRequestError{})
+	require.ErrorContains(t, err, "does not exist")
+}
+
+func TestStateOverridesAppState(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+	s := simulation.MakeSimulator(env.Ledger, false)
+	sender := env.Accounts[0]
+
+	appID := env.CreateApp(sender.Addr, simulationtesting.AppParams{
+		ApprovalProgram: `#pragma version 8
+txn ApplicationID
+bz end
+byte "global"
+app_global_get
+byte "g"
+==
+assert
+txn Sender
+byte "local"
+app_local_get
+int 7
+==
+assert
+byte "box"
+box_get
+assert
+byte "b"
+==
+assert
+end:
+int 1`,
+		ClearStateProgram: `#pragma version 8
+int 1`,
+		GlobalStateSchema: basics.StateSchema{NumByteSlice: 1},
+		LocalStateSchema:  basics.StateSchema{NumUint: 1},
+	})
+
+	stxn := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:          protocol.ApplicationCallTx,
+		Sender:        sender.Addr,
+		ApplicationID: appID,
+		Boxes:         []transactions.BoxRef{{Name: []byte("box")}},
+	}).Txn().Sign(sender.Sk)
+
+	result, err := s.Simulate(simulation.Request{TxnGroups: [][]transactions.SignedTxn{{stxn}}})
+	require.NoError(t, err)
+	require.NotEmpty(t, result.TxnGroups[0].FailureMessage)
+
+	overrides := simulation.StateOverrides{
+		Accounts: []simulation.AccountOverride{{
+			Address: sender.Addr,
+			AppLocals: []simulation.AppStateOverride{{
+				AppID:     appID,
+				KeyValues: basics.TealKeyValue{"local": {Type: basics.TealUintType, Uint: 7}},
+			}},
+		}},
+		AppGlobals: []simulation.AppStateOverride{{
+			AppID:     appID,
+			KeyValues: basics.TealKeyValue{"global": {Type: basics.TealBytesType, Bytes: "g"}},
+		}},
+		Boxes: []simulation.BoxOverride{{AppID: appID, Name: []byte("box"), Value: []byte("b")}},
+	}
+	result, err = s.Simulate(simulation.Request{
+		TxnGroups:      [][]transactions.SignedTxn{{stxn}},
+		StateOverrides: &overrides,
+	})
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+
+	// Overrides must respect the application's schema.
+	overrides.AppGlobals[0].KeyValues["another"] = basics.TealValue{Type: basics.TealBytesType, Bytes: "x"}
+	_, err = s.Simulate(simulation.Request{
+		TxnGroups:      [][]transactions.SignedTxn{{stxn}},
+		StateOverrides: &overrides,
+	})
+	require.ErrorAs(t, err, &simulation.InvalidRequestError{})
+	require.ErrorContains(t, err, "schema")
+}
+
+func TestStateOverridesBoxLimits(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	env := simulationtesting.PrepareSimulatorTest(t)
+	defer env.Close()
+	s := simulation.MakeSimulator(env.Ledger, false)
+	sender := env.Accounts[0]
+
+	appID := env.CreateApp(sender.Addr, simulationtesting.AppParams{
+		ApprovalProgram: `#pragma version 8
+txn ApplicationID
+bz end
+byte "box"
+box_len
+assert
+int 1000
+==
+assert
+global CurrentApplicationAddress
+balance
+global CurrentApplicationAddress
+min_balance
+>=
+assert
+end:
+int 1`,
+		ClearStateProgram: `#pragma version 8
+int 1`,
+	})
+
+	stxn := env.TxnInfo.NewTxn(txntest.Txn{
+		Type:          protocol.ApplicationCallTx,
+		Sender:        sender.Addr,
+		ApplicationID: appID,
+		Boxes:         []transactions.BoxRef{{Name: []byte("box")}},
+	}).Txn().Sign(sender.Sk)
+
+	// the unfunded application account is funded for the box it is given
+	result, err := s.Simulate(simulation.Request{
+		TxnGroups: [][]transactions.SignedTxn{{stxn}},
+		StateOverrides: &simulation.StateOverrides{
+			Boxes: []simulation.BoxOverride{{AppID: appID, Name: []byte("box"), Value: make([]byte, 1000)}},
+		},
+	})
+	require.NoError(t, err)
+	require.Empty(t, result.TxnGroups[0].FailureMessage)
+
+	proto := env.TxnInfo.CurrentProtocolParams()
+	for _, box := range []simulation.BoxOverride{
+		{AppID: appID, Name: nil, Value: []byte("v")},
+		{AppID: appID, Name: make([]byte, proto.MaxAppKeyLen+1), Value: []byte("v")},
+		{AppID: appID, Name: []byte("box"), Value: make([]byte, proto.MaxBoxSize+1)},
+	} {
+		_, err = s.Simulate(simulation.Request{
+			TxnGroups:      [][]transactions.SignedTxn{{stxn}},
+			StateOverrides: &simulation.StateOverrides{Boxes: []simulation.BoxOverride{box}},
+		})
+		require.ErrorAs(t, err, &simulation.InvalidRequestError{})
+	}
+}
@@ -37,6 +37,7 @@ type TxnResult struct {
 	AppBudgetConsumed      int
 	LogicSigBudgetConsumed int
 	Trace                  *TransactionTrace
+	Profile                *TransactionProfile
 
 	// UnnamedResourcesAccessed is present if all of the following are true:
 	//  * AllowUnnamedResources is true
@@ -72,6 +73,16 @@ type TxnGroupResult struct {
 	// will be placed in the corresponding `UnnamedResourcesAccessed` field in the appropriate
 	// TxnResult struct.
 	UnnamedResourcesAccessed *ResourceTracker
+
+	// PopulatedTxns will be present if PopulateResources is true and the group succeeded. It holds
+	// the group's transactions with the unnamed resources accessed by the group added to their
+	// references, and possibly extra app calls to hold them, ready to be signed. See
+	// populateResources for how resources are assigned.
+	PopulatedTxns []transactions.SignedTxn
+	// PopulateFailureMessage explains why the resources could not be assigned, or why the group
+	// with the assigned resources fails, if PopulateResources is true and the group succeeded, but
+	// PopulatedTxns is absent.
+	PopulateFailureMessage string
 }
 
 func makeTxnGroupResult(txgroup []transactions.SignedTxn) TxnGroupResult {
@@ -95,6 +106,7 @@ type ResultEvalOverrides struct {
 	MaxLogSize            *int
 	ExtraOpcodeBudget     int
 	FixSigners            bool
+	PopulateResources     bool
 }
 
 // LogBytesLimit hardcode limit of how much bytes one can log per transaction during simulation (with AllowMoreLogging)
@@ -136,6 +148,7 @@ type ExecTraceConfig struct {
 	Stack   bool `codec:"stack-change"`
 	Scratch bool `codec:"scratch-change"`
 	State   bool `codec:"state-change"`
+	Profile bool `codec:"profile"`
 }
 
 // Result contains the result from a call to Simulator.Simulate
@@ -163,9 +176,14 @@ func (r Result) ReturnScratchChange() bool { return r.TraceConfig.Scratch }
 // ReturnStateChange tells if the simulation runs with state-change enabled.
 func (r Result) ReturnStateChange() bool { return r.TraceConfig.State }
 
+// ReturnProfile tells if the simulation returns opcode cost profiles. Profiles do not need the
+// execution trace to be enabled.
+func (r Result) ReturnProfile() bool { return r.TraceConfig.Profile }
+
 // validateSimulateRequest first checks relation between request and config variables, including developerAPI:
 // if `developerAPI` provided is turned off, this method would:
 // - error on asking for exec trace
+// - error on asking for opcode cost profiles
 func validateSimulateRequest(request Request, developerAPI bool) error {
 	if !developerAPI && request.TraceConfig.Enable {
 		return InvalidRequestError{
@@ -174,6 +192,13 @@ func validateSimulateRequest(request Request, developerAPI bool) error {
 			},
 		}
 	}
+	if !developerAPI && request.TraceConfig.Profile {
+		return InvalidRequestError{
+			SimulatorError{
+				err: fmt.Errorf("the local configuration of the node has `EnableDeveloperAPI` turned off, while requesting for opcode cost profiles"),
+			},
+		}
+	}
 	if !request.TraceConfig.Enable {
 		if request.TraceConfig.Stack {
 			return InvalidRequestError{
@@ -212,6 +237,7 @@ func makeSimulationResult(lastRound basics.Round, request Request, developerAPI
 		ExtraOpcodeBudget:     request.ExtraOpcodeBudget,
 		AllowUnnamedResources: request.AllowUnnamedResources,
 		FixSigners:            request.FixSigners,
+		PopulateResources:     request.PopulateResources,
 	}.AllowMoreLogging(request.AllowMoreLogging)
 
 	if err := validateSimulateRequest(request, developerAPI); err != nil {
@@ -99,6 +99,12 @@ type evalTracer struct {
 	// NOTE: this field scratchSlots is used only for scratch change exposure.
 	scratchSlots []int
 
+	// profileStack is the counterpart of execTraceStack for opcode cost profiles, and profilers
+	// holds the profilers of the programs being evaluated, the innermost last.
+	// NOTE: both are used only for profile exposure.
+	profileStack []*TransactionProfile
+	profilers    []*programProfiler
+
 	groups [][]transactions.SignedTxnWithAD
 }
 
@@ -241,6 +247,21 @@ func (tracer *evalTracer) BeforeTxn(ep *logic.EvalParams, groupIndex int) {
 		// In both case, we need to add to transaction trace to the stack
 		tracer.execTraceStack = append(tracer.execTraceStack, txnTraceStackElem)
 	}
+	if tracer.result.ReturnProfile() {
+		var txnProfile *TransactionProfile
+		if len(tracer.profileStack) == 0 {
+			// a logic sig profile may have been made already, see AfterProgram
+			if tracer.result.TxnGroups[0].Txns[groupIndex].Profile == nil {
+				tracer.result.TxnGroups[0].Txns[groupIndex].Profile = &TransactionProfile{}
+			}
+			txnProfile = tracer.result.TxnGroups[0].Txns[groupIndex].Profile
+		} else {
+			parent := tracer.profileStack[len(tracer.profileStack)-1]
+			parent.InnerProfiles = append(parent.InnerProfiles, TransactionProfile{})
+			txnProfile = &parent.InnerProfiles[len(parent.InnerProfiles)-1]
+		}
+		tracer.profileStack = append(tracer.profileStack, txnProfile)
+	}
 	if ep.GetCaller() == nil && tracer.unnamedResourcePolicy != nil {
 		tracer.unnamedResourcePolicy.txnRootIndex = groupIndex
 	}
@@ -257,6 +278,9 @@ func (tracer *evalTracer) AfterTxn(ep *logic.EvalParams, groupIndex int, ad tran
 		lastOne.programTraceRef = nil
 		tracer.execTraceStack = tracer.execTraceStack[:len(tracer.execTraceStack)-1]
 	}
+	if tracer.result.ReturnProfile() {
+		tracer.profileStack = tracer.profileStack[:len(tracer.profileStack)-1]
+	}
 	tracer.cursorEvalTracer.AfterTxn(ep, groupIndex, ad, evalError)
 }
 
@@ -316,6 +340,10 @@ func (tracer *evalTracer) BeforeOpcode(cx *logic.EvalContext) {
 			tracer.result.InitialStates.increment(cx)
 		}
 	}
+
+	if len(tracer.profilers) > 0 {
+		tracer.profilers[len(tracer.profilers)-1].beforeOpcode(cx)
+	}
 }
 
 func (o *OpcodeTraceUnit) appendAddedStackValue(cx *logic.EvalContext, tracer *evalTracer) {
@@ -393,6 +421,10 @@ func (o *OpcodeTraceUnit) updateNewStateValues(cx *logic.EvalContext) {
 func (tracer *evalTracer) AfterOpcode(cx *logic.EvalContext, evalError error) {
 	groupIndex := cx.GroupIndex()
 
+	if len(tracer.profilers) > 0 {
+		tracer.profilers[len(tracer.profilers)-1].afterOpcode(cx, evalError)
+	}
+
 	// NOTE: only when we have no evalError on current opcode,
 	// we can proceed for recording stack change
 	if evalError == nil && tracer.result.ReturnTrace() {
@@ -435,6 +467,10 @@ func (tracer *evalTracer) AfterOpcode(cx *logic.EvalContext, evalError error) {
 func (tracer *evalTracer) BeforeProgram(cx *logic.EvalContext) {
 	groupIndex := cx.GroupIndex()
 
+	if tracer.result.ReturnProfile() {
+		tracer.profilers = append(tracer.profilers, makeProgramProfiler(cx))
+	}
+
 	switch cx.RunMode() {
 	case logic.ModeSig:
 		// Before Program, activated for logic sig, happens before txn group execution
@@ -490,6 +526,20 @@ func (tracer *evalTracer) BeforeProgram(cx *logic.EvalContext) {
 func (tracer *evalTracer) AfterProgram(cx *logic.EvalContext, pass bool, evalError error) {
 	groupIndex := cx.GroupIndex()
 
+	if tracer.result.ReturnProfile() {
+		profile := tracer.profilers[len(tracer.profilers)-1].finish(cx)
+		tracer.profilers = tracer.profilers[:len(tracer.profilers)-1]
+		switch {
+		case cx.RunMode() == logic.ModeSig:
+			// logic sigs are evaluated before the group, so BeforeTxn has not made a profile yet
+			tracer.result.TxnGroups[0].Txns[groupIndex].Profile = &TransactionProfile{LogicSig: profile}
+		case cx.TxnGroup[groupIndex].Txn.ApplicationCallTxnFields.OnCompletion == transactions.ClearStateOC:
+			tracer.profileStack[len(tracer.profileStack)-1].ClearStateProgram = profile
+		default:
+			tracer.profileStack[len(tracer.profileStack)-1].ApprovalProgram = profile
+		}
+	}
+
 	if cx.RunMode() == logic.ModeSig {
 		// Report cost for LogicSig program and exit
 		tracer.result.TxnGroups[0].Txns[groupIndex].LogicSigBudgetConsumed = cx.Cost()
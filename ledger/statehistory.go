@@ -0,0 +1,656 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store/trackerdb"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// stateHistory keeps, for a configurable number of recent rounds, the values that each block
+// overwrote. Walking these undo records forward from a past round, and falling back to the
+// accounts tracker for anything that was not modified since, reconstructs account, resource,
+// KV and creatable state for rounds older than the accounts tracker's in-memory deltas.
+//
+// The records are written to their own database as the trackers commit, so the history
+// survives restarts: the blocks that the trackers replay on startup pick up where the stored
+// records end. Blocks cannot be applied backwards, so rounds from before the history started
+// (e.g. before the node was first run with it, or before a fast catchup) cannot be rebuilt.
+//
+// Rounds can also be pinned, e.g. by simulation sessions. The records needed to reconstruct a
+// pinned round are kept until it is unpinned, regardless of maxRounds.
+type stateHistory struct {
+	mu deadlock.RWMutex
+
+	// maxRounds is the maximum number of undo records kept for unpinned rounds; zero disables
+	// the tracker unless some round is pinned.
+	maxRounds uint64
+
+	// pins counts the pins on each pinned round.
+	pins map[basics.Round]int
+
+	au  *accountUpdates
+	log logging.Logger
+
+	// dbPath is where the records are stored; empty if they are only kept in memory, which is
+	// the case when maxRounds is zero and only pinned rounds are kept.
+	dbPath string
+	dbMem  bool
+	dbs    db.Pair
+
+	// base is the oldest reconstructable round. The records of rounds base to flushed-1 are in
+	// the database; pending[i] holds the record of round flushed+i. The record of round r
+	// holds the state as of round r of everything that was modified by round r+1.
+	base    basics.Round
+	flushed basics.Round
+	pending []stateUndo
+
+	// generation changes whenever the history is restarted, so that a concurrent flush does
+	// not mark records of the previous history as written.
+	generation uint64
+}
+
+// archivalStateHistoryRounds is the number of rounds kept by archival nodes when
+// StateHistoryRounds is left at 0, about a week of rounds.
+const archivalStateHistoryRounds = 216000
+
+// stateHistoryTables lists the tables holding undo records, all keyed by their round in rnd.
+var stateHistoryTables = []string{
+	"statehistory_rounds",
+	"statehistory_accounts",
+	"statehistory_resources",
+	"statehistory_kvs",
+	"statehistory_creators",
+}
+
+var stateHistorySchema = []string{
+	`CREATE TABLE IF NOT EXISTS statehistory_rounds (
+		rnd INTEGER PRIMARY KEY,
+		proto TEXT,
+		totals BLOB)`,
+	`CREATE TABLE IF NOT EXISTS statehistory_accounts (
+		address BLOB,
+		rnd INTEGER,
+		data BLOB,
+		PRIMARY KEY (address, rnd))`,
+	`CREATE INDEX IF NOT EXISTS statehistory_accounts_rnd_idx ON statehistory_accounts (rnd)`,
+	`CREATE TABLE IF NOT EXISTS statehistory_resources (
+		address BLOB,
+		aidx INTEGER,
+		rnd INTEGER,
+		data BLOB,
+		PRIMARY KEY (address, aidx, rnd))`,
+	`CREATE INDEX IF NOT EXISTS statehistory_resources_rnd_idx ON statehistory_resources (rnd)`,
+	`CREATE TABLE IF NOT EXISTS statehistory_kvs (
+		key BLOB,
+		rnd INTEGER,
+		value BLOB,
+		PRIMARY KEY (key, rnd))`,
+	`CREATE INDEX IF NOT EXISTS statehistory_kvs_rnd_idx ON statehistory_kvs (rnd)`,
+	`CREATE TABLE IF NOT EXISTS statehistory_creators (
+		cidx INTEGER,
+		ctype INTEGER,
+		rnd INTEGER,
+		creator BLOB,
+		PRIMARY KEY (cidx, ctype, rnd))`,
+	`CREATE INDEX IF NOT EXISTS statehistory_creators_rnd_idx ON statehistory_creators (rnd)`,
+}
+
+// stateUndo holds the values of state modified by a round, as they were before that round.
+type stateUndo struct {
+	totals       ledgercore.AccountTotals
+	rewardsProto protocol.ConsensusVersion
+	accounts     map[basics.Address]ledgercore.AccountData
+	resources    map[accountCreatable]ledgercore.AccountResource
+	kvs          map[string][]byte
+	creators     map[basics.CreatableIndex]historyCreator
+}
+
+type historyCreator struct {
+	ctype   basics.CreatableType
+	creator basics.Address
+	ok      bool
+}
+
+func (sh *stateHistory) initialize(cfg config.Local, au *accountUpdates, dirs DirsAndPrefix, dbMem bool) {
+	switch {
+	case cfg.StateHistoryRounds > 0:
+		sh.maxRounds = uint64(cfg.StateHistoryRounds)
+	case cfg.StateHistoryRounds == 0 && cfg.Archival:
+		sh.maxRounds = archivalStateHistoryRounds
+	default:
+		sh.maxRounds = 0
+	}
+	sh.au = au
+	sh.dbPath = ""
+	if sh.maxRounds != 0 {
+		sh.dbPath = filepath.Join(dirs.TrackerGenesisDir, dirs.DBFilePrefix) + ".history.sqlite"
+		sh.dbMem = dbMem
+	}
+}
+
+// loadFromDisk opens the history database and resumes the stored history if it reaches the
+// trackers' round; the blocks replayed after dbRound then extend it. Otherwise the stored
+// records are dropped on the next flush.
+func (sh *stateHistory) loadFromDisk(l ledgerForTracker, dbRound basics.Round) error {
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	sh.log = l.trackerLog()
+	sh.restart(0)
+	sh.pins = make(map[basics.Round]int)
+	if sh.dbPath == "" {
+		return nil
+	}
+
+	var err error
+	sh.dbs, err = db.OpenPair(sh.dbPath, sh.dbMem)
+	if err != nil {
+		return fmt.Errorf("stateHistory: unable to open %s: %w", sh.dbPath, err)
+	}
+	sh.dbs.Rdb.SetLogger(sh.log)
+	sh.dbs.Wdb.SetLogger(sh.log)
+
+	var first, last sql.NullInt64
+	err = sh.dbs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		for _, stmt := range stateHistorySchema {
+			if _, err0 := tx.ExecContext(ctx, stmt); err0 != nil {
+				return err0
+			}
+		}
+		return tx.QueryRowContext(ctx, "SELECT MIN(rnd), MAX(rnd) FROM statehistory_rounds").Scan(&first, &last)
+	})
+	if err != nil {
+		return fmt.Errorf("stateHistory: unable to load %s: %w", sh.dbPath, err)
+	}
+	if !first.Valid {
+		return nil
+	}
+	if basics.Round(first.Int64) > dbRound || basics.Round(last.Int64)+1 < dbRound {
+		sh.log.Infof("stateHistory: stored rounds %d to %d do not reach the tracker round %d, discarding them", first.Int64, last.Int64, dbRound)
+		return nil
+	}
+	// records from dbRound on are written again as the blocks after dbRound are replayed
+	sh.base = basics.Round(first.Int64)
+	sh.flushed = dbRound
+	sh.trim()
+	return nil
+}
+
+func (sh *stateHistory) close() {
+	sh.dbs.Close()
+	sh.dbs = db.Pair{}
+}
+
+// end returns the round after the newest record. The caller must hold sh.mu.
+func (sh *stateHistory) end() basics.Round {
+	return sh.flushed + basics.Round(len(sh.pending))
+}
+
+// empty returns true if there are no records. The caller must hold sh.mu.
+func (sh *stateHistory) empty() bool {
+	return sh.base == sh.end()
+}
+
+// restart drops all records, and starts a new history at rnd. The records in the database
+// are deleted on the next flush. The caller must hold sh.mu.
+func (sh *stateHistory) restart(rnd basics.Round) {
+	sh.base = rnd
+	sh.flushed = rnd
+	sh.pending = nil
+	sh.generation++
+}
+
+// newBlock records the values that blk is about to overwrite. It must run after the accounts
+// tracker has accepted blk, so that the previous round can still be looked up.
+func (sh *stateHistory) newBlock(blk bookkeeping.Block, delta ledgercore.StateDelta) {
+	sh.mu.RLock()
+	enabled := sh.maxRounds != 0 || len(sh.pins) != 0
+	sh.mu.RUnlock()
+	if !enabled {
+		return
+	}
+	prev := blk.Round() - 1
+
+	undo, err := sh.collect(prev, &delta)
+
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	if err != nil {
+		sh.log.Warnf("stateHistory: unable to record state for round %d, discarding history: %v", prev, err)
+		sh.restart(0)
+		return
+	}
+	if sh.empty() || sh.end() != prev {
+		// first block, or a gap (e.g. after fast catchup): restart the history here
+		sh.restart(prev)
+	}
+	sh.pending = append(sh.pending, undo)
+	sh.trim()
+}
+
+// trim drops the records that are neither within the last maxRounds nor needed for a pinned
+// round. The caller must hold sh.mu.
+func (sh *stateHistory) trim() {
+	excess := int64(sh.end()-sh.base) - int64(sh.maxRounds)
+	for rnd := range sh.pins {
+		if rnd >= sh.base && int64(rnd-sh.base) < excess {
+			excess = int64(rnd - sh.base)
+		}
+	}
+	if excess <= 0 {
+		return
+	}
+	sh.base += basics.Round(excess)
+	if sh.base > sh.flushed {
+		drop := sh.base - sh.flushed
+		sh.pending = append(sh.pending[:0:0], sh.pending[drop:]...)
+		sh.flushed = sh.base
+	}
+}
+
+// flush writes the pending records to the database, and deletes the ones that were trimmed.
+// Records are only written, never changed, so that lookups can keep reading the pending
+// records while this runs.
+func (sh *stateHistory) flush() {
+	sh.mu.RLock()
+	if sh.dbs.Wdb.Handle == nil {
+		sh.mu.RUnlock()
+		return
+	}
+	base, flushed, generation, records := sh.base, sh.flushed, sh.generation, sh.pending
+	sh.mu.RUnlock()
+
+	err := sh.dbs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		for _, table := range stateHistoryTables {
+			_, err0 := tx.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE rnd < ? OR rnd >= ?", table), base, flushed)
+			if err0 != nil {
+				return err0
+			}
+		}
+		for i := range records {
+			err0 := writeStateUndo(ctx, tx, flushed+basics.Round(i), &records[i])
+			if err0 != nil {
+				return err0
+			}
+		}
+		return nil
+	})
+
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	if sh.generation != generation {
+		// restarted in the meantime; the next flush deletes what was just written
+		return
+	}
+	if err != nil {
+		sh.log.Warnf("stateHistory: unable to write rounds %d to %d, discarding history: %v", flushed, flushed+basics.Round(len(records)), err)
+		sh.restart(0)
+		return
+	}
+	if written := flushed + basics.Round(len(records)); written > sh.flushed {
+		drop := written - sh.flushed
+		sh.pending = append(sh.pending[:0:0], sh.pending[drop:]...)
+		sh.flushed = written
+	}
+}
+
+func writeStateUndo(ctx context.Context, tx *sql.Tx, rnd basics.Round, undo *stateUndo) error {
+	_, err := tx.ExecContext(ctx, "INSERT INTO statehistory_rounds (rnd, proto, totals) VALUES (?, ?, ?)",
+		rnd, string(undo.rewardsProto), protocol.Encode(&undo.totals))
+	if err != nil {
+		return err
+	}
+	for addr, data := range undo.accounts {
+		_, err = tx.ExecContext(ctx, "INSERT INTO statehistory_accounts (address, rnd, data) VALUES (?, ?, ?)",
+			addr[:], rnd, protocol.EncodeReflect(&data))
+		if err != nil {
+			return err
+		}
+	}
+	for key, data := range undo.resources {
+		_, err = tx.ExecContext(ctx, "INSERT INTO statehistory_resources (address, aidx, rnd, data) VALUES (?, ?, ?, ?)",
+			key.address[:], key.index, rnd, protocol.EncodeReflect(&data))
+		if err != nil {
+			return err
+		}
+	}
+	for key, value := range undo.kvs {
+		// a nil value, i.e. a key that did not exist, is stored as NULL
+		_, err = tx.ExecContext(ctx, "INSERT INTO statehistory_kvs (key, rnd, value) VALUES (?, ?, ?)",
+			[]byte(key), rnd, value)
+		if err != nil {
+			return err
+		}
+	}
+	for cidx, hc := range undo.creators {
+		var creator []byte
+		if hc.ok {
+			creator = hc.creator[:]
+		}
+		_, err = tx.ExecContext(ctx, "INSERT INTO statehistory_creators (cidx, ctype, rnd, creator) VALUES (?, ?, ?, ?)",
+			cidx, hc.ctype, rnd, creator)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pin keeps rnd reconstructable until unpin is called for it. Only latest, the round the
+// next block will be recorded for, or a round already in the history can be pinned. The
+// caller must hold the ledger's trackerMu so that no new block is added in between.
+func (sh *stateHistory) pin(rnd basics.Round, latest basics.Round) error {
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	if rnd != latest && (sh.empty() || rnd < sh.base || rnd >= sh.end()) {
+		if sh.empty() {
+			return fmt.Errorf("stateHistory: round %d cannot be kept, only the latest round %d can", rnd, latest)
+		}
+		return fmt.Errorf("stateHistory: round %d cannot be kept, only rounds %d to %d can", rnd, sh.base, latest)
+	}
+	sh.pins[rnd]++
+	return nil
+}
+
+// unpin releases a pin taken by pin, dropping the records that are no longer needed.
+func (sh *stateHistory) unpin(rnd basics.Round) {
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	if sh.pins[rnd] <= 1 {
+		delete(sh.pins, rnd)
+	} else {
+		sh.pins[rnd]--
+	}
+	sh.trim()
+}
+
+// collect looks up the values at rnd of everything modified by the delta for rnd+1.
+func (sh *stateHistory) collect(rnd basics.Round, delta *ledgercore.StateDelta) (undo stateUndo, err error) {
+	sh.au.accountsMu.RLock()
+	offset, err := sh.au.roundOffset(rnd)
+	if err == nil {
+		undo.totals = sh.au.roundTotals[offset]
+		undo.rewardsProto = sh.au.versions[offset]
+	}
+	sh.au.accountsMu.RUnlock()
+	if err != nil {
+		return stateUndo{}, err
+	}
+
+	undo.accounts = make(map[basics.Address]ledgercore.AccountData, delta.Accts.Len())
+	for i := 0; i < delta.Accts.Len(); i++ {
+		addr, _ := delta.Accts.GetByIdx(i)
+		undo.accounts[addr], _, _, _, err = sh.au.lookupWithoutRewards(rnd, addr, true)
+		if err != nil {
+			return stateUndo{}, err
+		}
+	}
+
+	undo.resources = make(map[accountCreatable]ledgercore.AccountResource)
+	for _, rec := range delta.Accts.GetAllAssetResources() {
+		key := accountCreatable{address: rec.Addr, index: basics.CreatableIndex(rec.Aidx)}
+		undo.resources[key], _, err = sh.au.lookupResource(rnd, rec.Addr, key.index, basics.AssetCreatable, true)
+		if err != nil {
+			return stateUndo{}, err
+		}
+	}
+	for _, rec := range delta.Accts.GetAllAppResources() {
+		key := accountCreatable{address: rec.Addr, index: basics.CreatableIndex(rec.Aidx)}
+		undo.resources[key], _, err = sh.au.lookupResource(rnd, rec.Addr, key.index, basics.AppCreatable, true)
+		if err != nil {
+			return stateUndo{}, err
+		}
+	}
+
+	undo.kvs = make(map[string][]byte, len(delta.KvMods))
+	for key := range delta.KvMods {
+		undo.kvs[key], err = sh.au.lookupKv(rnd, key, true)
+		if err != nil {
+			return stateUndo{}, err
+		}
+	}
+
+	undo.creators = make(map[basics.CreatableIndex]historyCreator, len(delta.Creatables))
+	for cidx, mc := range delta.Creatables {
+		hc := historyCreator{ctype: mc.Ctype}
+		hc.creator, hc.ok, err = sh.au.getCreatorForRound(rnd, cidx, mc.Ctype, true)
+		if err != nil {
+			return stateUndo{}, err
+		}
+		undo.creators[cidx] = hc
+	}
+	return undo, nil
+}
+
+// oldest returns the oldest round the history can reconstruct, and false if the history is empty.
+func (sh *stateHistory) oldest() (basics.Round, bool) {
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+	return sh.base, !sh.empty()
+}
+
+// inRange returns an error if rnd is not covered by the history. The caller must hold sh.mu.
+func (sh *stateHistory) inRange(rnd basics.Round) error {
+	if sh.empty() || rnd < sh.base || rnd >= sh.end() {
+		return fmt.Errorf("stateHistory: round %d is not in the history range [%d, %d)", rnd, sh.base, sh.end())
+	}
+	return nil
+}
+
+// find looks for the first record from rnd forward that holds a value: query looks for it in
+// the stored records of rounds from to to-1, and get in a pending one. If none does, the value
+// was not modified after rnd and latest is the round whose state should be consulted instead.
+// The caller must hold the ledger's trackerMu so that no new block is added in between.
+func (sh *stateHistory) find(rnd basics.Round, query func(tx *sql.Tx, from, to basics.Round) (bool, error), get func(*stateUndo) bool) (found bool, latest basics.Round, err error) {
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+	err = sh.inRange(rnd)
+	if err != nil {
+		return false, 0, err
+	}
+	if rnd < sh.flushed {
+		err = sh.dbs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
+			found, err = query(tx, rnd, sh.flushed)
+			return
+		})
+		if err != nil || found {
+			return found, 0, err
+		}
+	}
+	start := 0
+	if rnd > sh.flushed {
+		start = int(rnd - sh.flushed)
+	}
+	for i := start; i < len(sh.pending); i++ {
+		if get(&sh.pending[i]) {
+			return true, 0, nil
+		}
+	}
+	return false, sh.end(), nil
+}
+
+// queryFirst scans the first row returned by query into dest, returning false if there is none.
+func queryFirst(tx *sql.Tx, dest interface{}, query string, args ...interface{}) (bool, error) {
+	err := tx.QueryRow(query, args...).Scan(dest)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (sh *stateHistory) lookupWithoutRewards(rnd basics.Round, addr basics.Address) (data ledgercore.AccountData, rewardsVersion protocol.ConsensusVersion, rewardsLevel uint64, err error) {
+	totals, rewardsVersion, err := sh.roundInfo(rnd)
+	if err != nil {
+		return
+	}
+	rewardsLevel = totals.RewardsLevel
+	found, latest, err := sh.find(rnd, func(tx *sql.Tx, from, to basics.Round) (bool, error) {
+		var buf []byte
+		ok, err := queryFirst(tx, &buf, "SELECT data FROM statehistory_accounts WHERE address = ? AND rnd >= ? AND rnd < ? ORDER BY rnd LIMIT 1", addr[:], from, to)
+		if !ok {
+			return false, err
+		}
+		data = ledgercore.AccountData{}
+		return true, protocol.DecodeReflect(buf, &data)
+	}, func(u *stateUndo) (ok bool) {
+		data, ok = u.accounts[addr]
+		return
+	})
+	if err != nil || found {
+		return
+	}
+	data, _, _, _, err = sh.au.lookupWithoutRewards(latest, addr, true)
+	return
+}
+
+func (sh *stateHistory) lookupResource(rnd basics.Round, addr basics.Address, aidx basics.CreatableIndex, ctype basics.CreatableType) (data ledgercore.AccountResource, err error) {
+	key := accountCreatable{address: addr, index: aidx}
+	found, latest, err := sh.find(rnd, func(tx *sql.Tx, from, to basics.Round) (bool, error) {
+		var buf []byte
+		ok, err := queryFirst(tx, &buf, "SELECT data FROM statehistory_resources WHERE address = ? AND aidx = ? AND rnd >= ? AND rnd < ? ORDER BY rnd LIMIT 1", addr[:], aidx, from, to)
+		if !ok {
+			return false, err
+		}
+		data = ledgercore.AccountResource{}
+		return true, protocol.DecodeReflect(buf, &data)
+	}, func(u *stateUndo) (ok bool) {
+		data, ok = u.resources[key]
+		return
+	})
+	if err != nil || found {
+		return
+	}
+	data, _, err = sh.au.lookupResource(latest, addr, aidx, ctype, true)
+	return
+}
+
+func (sh *stateHistory) lookupKv(rnd basics.Round, key string) (value []byte, err error) {
+	found, latest, err := sh.find(rnd, func(tx *sql.Tx, from, to basics.Round) (bool, error) {
+		return queryFirst(tx, &value, "SELECT value FROM statehistory_kvs WHERE key = ? AND rnd >= ? AND rnd < ? ORDER BY rnd LIMIT 1", []byte(key), from, to)
+	}, func(u *stateUndo) (ok bool) {
+		value, ok = u.kvs[key]
+		return
+	})
+	if err != nil || found {
+		return
+	}
+	return sh.au.lookupKv(latest, key, true)
+}
+
+func (sh *stateHistory) getCreatorForRound(rnd basics.Round, cidx basics.CreatableIndex, ctype basics.CreatableType) (creator basics.Address, ok bool, err error) {
+	found, latest, err := sh.find(rnd, func(tx *sql.Tx, from, to basics.Round) (bool, error) {
+		var buf []byte
+		has, err := queryFirst(tx, &buf, "SELECT creator FROM statehistory_creators WHERE cidx = ? AND ctype = ? AND rnd >= ? AND rnd < ? ORDER BY rnd LIMIT 1", cidx, ctype, from, to)
+		if has {
+			ok = buf != nil
+			creator = basics.Address{}
+			copy(creator[:], buf)
+		}
+		return has, err
+	}, func(u *stateUndo) bool {
+		hc, has := u.creators[cidx]
+		if has && hc.ctype == ctype {
+			creator, ok = hc.creator, hc.ok
+			return true
+		}
+		return false
+	})
+	if err != nil || found {
+		return
+	}
+	return sh.au.getCreatorForRound(latest, cidx, ctype, true)
+}
+
+func (sh *stateHistory) totals(rnd basics.Round) (ledgercore.AccountTotals, error) {
+	totals, _, err := sh.roundInfo(rnd)
+	return totals, err
+}
+
+// roundInfo returns the totals as of rnd, and the protocol version its rewards follow.
+func (sh *stateHistory) roundInfo(rnd basics.Round) (totals ledgercore.AccountTotals, rewardsProto protocol.ConsensusVersion, err error) {
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+	err = sh.inRange(rnd)
+	if err != nil {
+		return
+	}
+	if rnd >= sh.flushed {
+		u := &sh.pending[rnd-sh.flushed]
+		return u.totals, u.rewardsProto, nil
+	}
+	err = sh.dbs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		var proto string
+		var buf []byte
+		err0 := tx.QueryRowContext(ctx, "SELECT proto, totals FROM statehistory_rounds WHERE rnd = ?", rnd).Scan(&proto, &buf)
+		if err0 != nil {
+			return err0
+		}
+		rewardsProto = protocol.ConsensusVersion(proto)
+		totals = ledgercore.AccountTotals{}
+		return protocol.Decode(buf, &totals)
+	})
+	return
+}
+
+func (sh *stateHistory) committedUpTo(committedRnd basics.Round) (retRound, lookback basics.Round) {
+	return committedRnd, basics.Round(0)
+}
+
+// prepareCommit writes the pending records before the trackers' round moves on, so that the
+// blocks replayed on the next startup always continue the stored history.
+func (sh *stateHistory) prepareCommit(dcc *deferredCommitContext) error {
+	sh.flush()
+	return nil
+}
+
+func (sh *stateHistory) commitRound(context.Context, trackerdb.TransactionScope, *deferredCommitContext) error {
+	return nil
+}
+
+func (sh *stateHistory) postCommit(ctx context.Context, dcc *deferredCommitContext) {
+}
+
+func (sh *stateHistory) produceCommittingTask(committedRound basics.Round, dbRound basics.Round, dcr *deferredCommitRange) *deferredCommitRange {
+	return dcr
+}
+
+// handles returns true if err reports a round older than the accounts tracker's in-memory
+// deltas and rnd is covered by the history, i.e. the lookup should be retried against the
+// history. Otherwise the original error is returned to the caller.
+func (sh *stateHistory) handles(rnd basics.Round, err error) bool {
+	var roundOffsetErr *RoundOffsetError
+	if !errors.As(err, &roundOffsetErr) {
+		return false
+	}
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+	return sh.inRange(rnd) == nil
+}
@@ -0,0 +1,254 @@
+// Copyright (C) 2019-2025 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/txntest"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// TestStateHistoryLookups checks that lookups older than the accounts tracker's in-memory deltas
+// are answered from the state history, and fail once they fall out of it.
+func TestStateHistoryLookups(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis(ledgertesting.TurnOffRewards)
+	cfg := config.GetDefaultLocal()
+	cfg.MaxAcctLookback = 2
+	cfg.StateHistoryRounds = 10
+	l := newSimpleLedgerWithConsensusVersion(t, genBalances, protocol.ConsensusFuture, cfg)
+	defer l.Close()
+
+	pay := txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   addrs[0],
+		Receiver: addrs[1],
+		Amount:   1000,
+	}
+
+	balances := make(map[basics.Round]uint64)
+	totals := make(map[basics.Round]uint64)
+	for i := 0; i < 20; i++ {
+		eval := nextBlock(t, l)
+		pay.Note = []byte(strconv.Itoa(i))
+		txn(t, l, eval, &pay)
+		vb := endBlock(t, l, eval)
+		balances[vb.Block().Round()] = micros(t, l, addrs[1])
+		_, tot, err := l.LatestTotals()
+		require.NoError(t, err)
+		totals[vb.Block().Round()] = tot.Participating().Raw
+	}
+	triggerTrackerFlush(t, l)
+
+	latest := l.Latest()
+	oldest := l.OldestStateRound()
+	require.Equal(t, latest-10, oldest)
+	require.Less(t, oldest, l.trackers.getDbRound())
+
+	for rnd := oldest; rnd <= latest; rnd++ {
+		ad, _, err := l.LookupWithoutRewards(rnd, addrs[1])
+		require.NoError(t, err)
+		require.Equal(t, balances[rnd], ad.MicroAlgos.Raw, "round %d", rnd)
+
+		acct, _, _, err := l.LookupAccount(rnd, addrs[1])
+		require.NoError(t, err)
+		require.Equal(t, balances[rnd], acct.MicroAlgos.Raw, "round %d", rnd)
+
+		tot, err := l.Totals(rnd)
+		require.NoError(t, err)
+		require.Equal(t, totals[rnd], tot.Participating().Raw, "round %d", rnd)
+	}
+
+	_, _, err := l.LookupWithoutRewards(oldest-1, addrs[1])
+	require.ErrorAs(t, err, new(*RoundOffsetError))
+}
+
+// TestStateHistoryDisabled checks that with the state history disabled nothing older than the
+// accounts tracker's deltas can be looked up.
+func TestStateHistoryDisabled(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis(ledgertesting.TurnOffRewards)
+	cfg := config.GetDefaultLocal()
+	cfg.MaxAcctLookback = 2
+	cfg.StateHistoryRounds = -1
+	l := newSimpleLedgerWithConsensusVersion(t, genBalances, protocol.ConsensusFuture, cfg)
+	defer l.Close()
+
+	pay := txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   addrs[0],
+		Receiver: addrs[1],
+		Amount:   1000,
+	}
+	for i := 0; i < 10; i++ {
+		eval := nextBlock(t, l)
+		pay.Note = []byte(strconv.Itoa(i))
+		txn(t, l, eval, &pay)
+		endBlock(t, l, eval)
+	}
+	triggerTrackerFlush(t, l)
+
+	oldest := l.OldestStateRound()
+	require.Equal(t, l.trackers.getDbRound(), oldest)
+	_, _, err := l.LookupWithoutRewards(oldest-1, addrs[1])
+	require.ErrorAs(t, err, new(*RoundOffsetError))
+}
+
+// TestStateHistoryPin checks that a pinned round stays available without a state history,
+// and is dropped once released.
+func TestStateHistoryPin(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis(ledgertesting.TurnOffRewards)
+	cfg := config.GetDefaultLocal()
+	cfg.MaxAcctLookback = 2
+	cfg.StateHistoryRounds = -1
+	l := newSimpleLedgerWithConsensusVersion(t, genBalances, protocol.ConsensusFuture, cfg)
+	defer l.Close()
+
+	pay := txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   addrs[0],
+		Receiver: addrs[1],
+		Amount:   1000,
+	}
+	addBlocks := func(n int) {
+		for i := 0; i < n; i++ {
+			eval := nextBlock(t, l)
+			pay.Note = []byte(strconv.Itoa(int(l.Latest())))
+			txn(t, l, eval, &pay)
+			endBlock(t, l, eval)
+		}
+		triggerTrackerFlush(t, l)
+	}
+	addBlocks(3)
+
+	// only the latest round can be pinned while there is no history
+	_, err := l.PinStateRound(l.Latest() - 1)
+	require.Error(t, err)
+	pinned := l.Latest()
+	release, err := l.PinStateRound(pinned)
+	require.NoError(t, err)
+	balance := micros(t, l, addrs[1])
+
+	addBlocks(10)
+	require.Less(t, pinned, l.trackers.getDbRound())
+	require.Equal(t, pinned, l.OldestStateRound())
+	for rnd := pinned; rnd <= l.Latest(); rnd++ {
+		ad, _, err := l.LookupWithoutRewards(rnd, addrs[1])
+		require.NoError(t, err)
+		require.Equal(t, balance+1000*uint64(rnd-pinned), ad.MicroAlgos.Raw, "round %d", rnd)
+	}
+	_, err = l.Totals(pinned)
+	require.NoError(t, err)
+
+	// rounds that are still in the history can be pinned as well
+	release2, err := l.PinStateRound(pinned + 1)
+	require.NoError(t, err)
+
+	release()
+	release()
+	require.Equal(t, pinned+1, l.OldestStateRound())
+	_, _, err = l.LookupWithoutRewards(pinned, addrs[1])
+	require.ErrorAs(t, err, new(*RoundOffsetError))
+
+	release2()
+	require.Equal(t, l.trackers.getDbRound(), l.OldestStateRound())
+}
+
+// TestStateHistoryRestart checks that archival ledgers keep a state history by default, and that
+// it survives a restart.
+func TestStateHistoryRestart(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis(ledgertesting.TurnOffRewards)
+	var genHash crypto.Digest
+	crypto.RandBytes(genHash[:])
+	genBlock, err := bookkeeping.MakeGenesisBlock(protocol.ConsensusFuture, genBalances, "test", genHash)
+	require.NoError(t, err)
+	cfg := config.GetDefaultLocal()
+	cfg.MaxAcctLookback = 2
+	cfg.Archival = true
+	dbName := filepath.Join(t.TempDir(), "ledger")
+	open := func() *Ledger {
+		l, err := OpenLedger(logging.TestingLog(t), dbName, false, ledgercore.InitState{
+			Block:       genBlock,
+			Accounts:    genBalances.Balances,
+			GenesisHash: genHash,
+		}, cfg)
+		require.NoError(t, err)
+		return l
+	}
+
+	pay := txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   addrs[0],
+		Receiver: addrs[1],
+		Amount:   1000,
+	}
+	balances := make(map[basics.Round]uint64)
+	addBlocks := func(l *Ledger, n int) {
+		for i := 0; i < n; i++ {
+			eval := nextBlock(t, l)
+			pay.Note = []byte(strconv.Itoa(int(l.Latest())))
+			txn(t, l, eval, &pay)
+			vb := endBlock(t, l, eval)
+			balances[vb.Block().Round()] = micros(t, l, addrs[1])
+		}
+		triggerTrackerFlush(t, l)
+	}
+	check := func(l *Ledger) {
+		require.Equal(t, basics.Round(0), l.OldestStateRound())
+		for rnd := basics.Round(1); rnd <= l.Latest(); rnd++ {
+			ad, _, err := l.LookupWithoutRewards(rnd, addrs[1])
+			require.NoError(t, err)
+			require.Equal(t, balances[rnd], ad.MicroAlgos.Raw, "round %d", rnd)
+		}
+	}
+
+	l := open()
+	addBlocks(l, 10)
+	require.Less(t, basics.Round(1), l.trackers.getDbRound())
+	check(l)
+	l.Close()
+
+	// the blocks replayed on startup continue the stored history
+	l = open()
+	defer l.Close()
+	check(l)
+	addBlocks(l, 10)
+	check(l)
+}
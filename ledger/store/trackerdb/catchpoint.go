@@ -82,6 +82,9 @@ const (
 	CatchpointStateCatchpointLookback = CatchpointState("catchpointLookback")
 	// CatchpointStateCatchupVersion is the catchpoint version which the currently catchpoint catchup process is trying to catchup to.
 	CatchpointStateCatchupVersion = CatchpointState("catchpointCatchupVersion")
+	// CatchpointStateCatchupFile is the local catchpoint file the currently running catchpoint catchup process loads the ledger from.
+	// The variable is empty when the catchpoint file is downloaded from peers.
+	CatchpointStateCatchupFile = CatchpointState("catchpointCatchupFile")
 )
 
 // UnfinishedCatchpointRecord represents a stored record of an unfinished catchpoint.
@@ -111,6 +114,28 @@ type NormalizedAccountBalance struct {
 	PartialBalance bool
 }
 
+// NormalizedAccountDelta is a staging area for a delta catchpoint account entry before it's being applied to the catchpoint staging tables.
+type NormalizedAccountDelta struct {
+	// The public key address to which the account belongs.
+	Address basics.Address
+	// Deleted indicates that the account, along with all of its resources, no longer exists.
+	Deleted bool
+	// AccountData contains the updated baseAccountData for that account.
+	AccountData BaseAccountData
+	// EncodedAccountData contains the updated baseAccountData encoded bytes.
+	EncodedAccountData []byte
+	// AccountHash is the merkle trie hash of the updated account.
+	AccountHash []byte
+	// NormalizedBalance contains the normalized balance for the updated account.
+	NormalizedBalance uint64
+	// Resources, EncodedResources and ResourceHashes describe the resources of the account that were updated.
+	Resources        map[basics.CreatableIndex]ResourcesData
+	EncodedResources map[basics.CreatableIndex][]byte
+	ResourceHashes   map[basics.CreatableIndex][]byte
+	// DeletedResources lists the resources that were removed from the account.
+	DeletedResources []basics.CreatableIndex
+}
+
 // CatchpointFirstStageInfo For the `catchpointfirststageinfo` table.
 type CatchpointFirstStageInfo struct {
 	_struct struct{} `codec:",omitempty,omitemptyarray"`
@@ -154,7 +179,18 @@ func MakeCatchpointFilePath(round basics.Round) string {
 	return outStr
 }
 
-// RemoveSingleCatchpointFileFromDisk removes a single catchpoint file from the disk. this function does not leave empty directories
+// MakeCatchpointManifestFilePath builds the path of the chunk manifest stored next to a catchpoint file.
+func MakeCatchpointManifestFilePath(catchpointFilePath string) string {
+	return catchpointFilePath + ".manifest"
+}
+
+// MakeCatchpointDeltaFilePath builds the path of the delta file stored next to a catchpoint file, describing the
+// changes since the previous catchpoint.
+func MakeCatchpointDeltaFilePath(catchpointFilePath string) string {
+	return catchpointFilePath + ".delta"
+}
+
+// RemoveSingleCatchpointFileFromDisk removes a single catchpoint file, along with its manifest and delta files, from the disk. this function does not leave empty directories
 func RemoveSingleCatchpointFileFromDisk(dbDirectory, fileToDelete string) (err error) {
 	absCatchpointFileName := filepath.Join(dbDirectory, fileToDelete)
 	err = os.Remove(absCatchpointFileName)
@@ -162,6 +198,16 @@ func RemoveSingleCatchpointFileFromDisk(dbDirectory, fileToDelete string) (err e
 		// we can't delete the file, abort -
 		return fmt.Errorf("unable to delete old catchpoint file '%s' : %v", absCatchpointFileName, err)
 	}
+	absManifestFileName := MakeCatchpointManifestFilePath(absCatchpointFileName)
+	err = os.Remove(absManifestFileName)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("unable to delete old catchpoint manifest file '%s' : %v", absManifestFileName, err)
+	}
+	absDeltaFileName := MakeCatchpointDeltaFilePath(absCatchpointFileName)
+	err = os.Remove(absDeltaFileName)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("unable to delete old catchpoint delta file '%s' : %v", absDeltaFileName, err)
+	}
 	splitedDirName := strings.Split(fileToDelete, string(os.PathSeparator))
 
 	var subDirectoriesToScan []string
@@ -181,6 +181,10 @@ type CatchpointWriter interface {
 	WriteCatchpointStagingOnlineRoundParams(context.Context, []encoded.OnlineRoundParamsRecordV6) error
 	WriteCatchpointStagingCreatable(ctx context.Context, bals []NormalizedAccountBalance) error
 	WriteCatchpointStagingHashes(ctx context.Context, bals []NormalizedAccountBalance) error
+	WriteCatchpointStagingAccountDeltas(ctx context.Context, deltas []NormalizedAccountDelta) error
+	WriteCatchpointStagingKVDeltas(ctx context.Context, keys [][]byte, values [][]byte, hashes [][]byte) error
+	ResetCatchpointStagingOnlineData(ctx context.Context) error
+	ResetCatchpointStagingAccountHashes(ctx context.Context) error
 
 	ApplyCatchpointStagingBalances(ctx context.Context, balancesRound basics.Round, merkleRootRound basics.Round) (err error)
 	ApplyCatchpointStagingTablesV7(ctx context.Context) error
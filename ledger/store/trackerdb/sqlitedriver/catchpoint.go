@@ -501,6 +501,367 @@ func (cw *catchpointWriter) WriteCatchpointStagingOnlineRoundParams(ctx context.
 	return nil
 }
 
+// WriteCatchpointStagingAccountDeltas applies the provided account deltas onto the catchpoint staging tables. The pending hashes
+// of the replaced accounts and resources are removed from catchpointpendinghashes, so CreateCatchpointStagingHashesIndex
+// should be called beforehand.
+func (cw *catchpointWriter) WriteCatchpointStagingAccountDeltas(ctx context.Context, deltas []trackerdb.NormalizedAccountDelta) error {
+	selectAcctStmt, err := cw.e.PrepareContext(ctx, "SELECT addrid, data FROM catchpointbalances WHERE address = ?")
+	if err != nil {
+		return err
+	}
+	defer selectAcctStmt.Close()
+
+	insertAcctStmt, err := cw.e.PrepareContext(ctx, "INSERT INTO catchpointbalances(address, normalizedonlinebalance, data) VALUES(?, ?, ?)")
+	if err != nil {
+		return err
+	}
+	defer insertAcctStmt.Close()
+
+	updateAcctStmt, err := cw.e.PrepareContext(ctx, "UPDATE catchpointbalances SET normalizedonlinebalance = ?, data = ? WHERE addrid = ?")
+	if err != nil {
+		return err
+	}
+	defer updateAcctStmt.Close()
+
+	deleteAcctStmt, err := cw.e.PrepareContext(ctx, "DELETE FROM catchpointbalances WHERE addrid = ?")
+	if err != nil {
+		return err
+	}
+	defer deleteAcctStmt.Close()
+
+	selectRscStmt, err := cw.e.PrepareContext(ctx, "SELECT data FROM catchpointresources WHERE addrid = ? AND aidx = ?")
+	if err != nil {
+		return err
+	}
+	defer selectRscStmt.Close()
+
+	selectAllRscStmt, err := cw.e.PrepareContext(ctx, "SELECT aidx, data FROM catchpointresources WHERE addrid = ?")
+	if err != nil {
+		return err
+	}
+	defer selectAllRscStmt.Close()
+
+	stmts := catchpointStagingResourceStmts{}
+	stmts.insertRsc, err = cw.e.PrepareContext(ctx, "INSERT INTO catchpointresources(addrid, aidx, data) VALUES(?, ?, ?)")
+	if err != nil {
+		return err
+	}
+	defer stmts.insertRsc.Close()
+
+	stmts.deleteRsc, err = cw.e.PrepareContext(ctx, "DELETE FROM catchpointresources WHERE addrid = ? AND aidx = ?")
+	if err != nil {
+		return err
+	}
+	defer stmts.deleteRsc.Close()
+
+	stmts.insertHash, err = cw.e.PrepareContext(ctx, "INSERT INTO catchpointpendinghashes(data) VALUES(?)")
+	if err != nil {
+		return err
+	}
+	defer stmts.insertHash.Close()
+
+	stmts.deleteHash, err = cw.e.PrepareContext(ctx, "DELETE FROM catchpointpendinghashes WHERE data = ?")
+	if err != nil {
+		return err
+	}
+	defer stmts.deleteHash.Close()
+
+	stmts.insertCreator, err = cw.e.PrepareContext(ctx, "INSERT OR REPLACE INTO catchpointassetcreators(asset, creator, ctype) VALUES(?, ?, ?)")
+	if err != nil {
+		return err
+	}
+	defer stmts.insertCreator.Close()
+
+	stmts.deleteCreator, err = cw.e.PrepareContext(ctx, "DELETE FROM catchpointassetcreators WHERE asset = ? AND ctype = ?")
+	if err != nil {
+		return err
+	}
+	defer stmts.deleteCreator.Close()
+
+	for _, delta := range deltas {
+		var rowID int64
+		var oldData []byte
+		err = selectAcctStmt.QueryRowContext(ctx, delta.Address[:]).Scan(&rowID, &oldData)
+		exists := true
+		if err == sql.ErrNoRows {
+			exists = false
+		} else if err != nil {
+			return err
+		}
+
+		if exists {
+			// the account hash depends on the account data, so the previous hash has to go.
+			var oldAccountData trackerdb.BaseAccountData
+			err = protocol.Decode(oldData, &oldAccountData)
+			if err != nil {
+				return err
+			}
+			_, err = stmts.deleteHash.ExecContext(ctx, trackerdb.AccountHashBuilderV6(delta.Address, &oldAccountData, oldData))
+			if err != nil {
+				return err
+			}
+		}
+
+		if delta.Deleted {
+			if !exists {
+				continue
+			}
+			err = stmts.deleteAllResources(ctx, selectAllRscStmt, rowID, delta.Address)
+			if err != nil {
+				return err
+			}
+			_, err = deleteAcctStmt.ExecContext(ctx, rowID)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if exists {
+			_, err = updateAcctStmt.ExecContext(ctx, delta.NormalizedBalance, delta.EncodedAccountData, rowID)
+			if err != nil {
+				return err
+			}
+		} else {
+			var result sql.Result
+			result, err = insertAcctStmt.ExecContext(ctx, delta.Address[:], delta.NormalizedBalance, delta.EncodedAccountData)
+			if err != nil {
+				return err
+			}
+			rowID, err = result.LastInsertId()
+			if err != nil {
+				return err
+			}
+		}
+		_, err = stmts.insertHash.ExecContext(ctx, delta.AccountHash)
+		if err != nil {
+			return err
+		}
+
+		for _, aidx := range delta.DeletedResources {
+			err = stmts.deleteResource(ctx, selectRscStmt, rowID, delta.Address, aidx)
+			if err != nil {
+				return err
+			}
+		}
+		for aidx, resData := range delta.Resources {
+			err = stmts.deleteResource(ctx, selectRscStmt, rowID, delta.Address, aidx)
+			if err != nil {
+				return err
+			}
+			err = stmts.insertResource(ctx, rowID, delta.Address, aidx, &resData, delta.EncodedResources[aidx], delta.ResourceHashes[aidx])
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// catchpointStagingResourceStmts holds the statements used to replace resources in the catchpoint staging tables,
+// along with their pending hashes and creatables.
+type catchpointStagingResourceStmts struct {
+	insertRsc     *sql.Stmt
+	deleteRsc     *sql.Stmt
+	insertHash    *sql.Stmt
+	deleteHash    *sql.Stmt
+	insertCreator *sql.Stmt
+	deleteCreator *sql.Stmt
+}
+
+func (s *catchpointStagingResourceStmts) insertResource(ctx context.Context, rowID int64, addr basics.Address, aidx basics.CreatableIndex, resData *trackerdb.ResourcesData, encodedResData []byte, hash []byte) error {
+	_, err := s.insertRsc.ExecContext(ctx, rowID, aidx, encodedResData)
+	if err != nil {
+		return err
+	}
+	_, err = s.insertHash.ExecContext(ctx, hash)
+	if err != nil {
+		return err
+	}
+	if resData.IsOwning() {
+		if resData.IsAsset() {
+			_, err = s.insertCreator.ExecContext(ctx, aidx, addr[:], basics.AssetCreatable)
+			if err != nil {
+				return err
+			}
+		}
+		if resData.IsApp() {
+			_, err = s.insertCreator.ExecContext(ctx, aidx, addr[:], basics.AppCreatable)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// deleteResource removes a single resource, if it exists, along with its pending hash and creatable.
+func (s *catchpointStagingResourceStmts) deleteResource(ctx context.Context, selectRscStmt *sql.Stmt, rowID int64, addr basics.Address, aidx basics.CreatableIndex) error {
+	var data []byte
+	err := selectRscStmt.QueryRowContext(ctx, rowID, aidx).Scan(&data)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return s.removeResource(ctx, rowID, addr, aidx, data)
+}
+
+// deleteAllResources removes all the resources of an account, along with their pending hashes and creatables.
+func (s *catchpointStagingResourceStmts) deleteAllResources(ctx context.Context, selectAllRscStmt *sql.Stmt, rowID int64, addr basics.Address) error {
+	rows, err := selectAllRscStmt.QueryContext(ctx, rowID)
+	if err != nil {
+		return err
+	}
+	resources := make(map[basics.CreatableIndex][]byte)
+	for rows.Next() {
+		var aidx basics.CreatableIndex
+		var data []byte
+		err = rows.Scan(&aidx, &data)
+		if err != nil {
+			rows.Close()
+			return err
+		}
+		resources[aidx] = data
+	}
+	err = rows.Err()
+	rows.Close()
+	if err != nil {
+		return err
+	}
+	for aidx, data := range resources {
+		err = s.removeResource(ctx, rowID, addr, aidx, data)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *catchpointStagingResourceStmts) removeResource(ctx context.Context, rowID int64, addr basics.Address, aidx basics.CreatableIndex, data []byte) error {
+	var resData trackerdb.ResourcesData
+	err := protocol.Decode(data, &resData)
+	if err != nil {
+		return err
+	}
+	hash, err := trackerdb.ResourcesHashBuilderV6(&resData, addr, aidx, resData.UpdateRound, data)
+	if err != nil {
+		return err
+	}
+	_, err = s.deleteHash.ExecContext(ctx, hash)
+	if err != nil {
+		return err
+	}
+	if resData.IsOwning() {
+		if resData.IsAsset() {
+			_, err = s.deleteCreator.ExecContext(ctx, aidx, basics.AssetCreatable)
+			if err != nil {
+				return err
+			}
+		}
+		if resData.IsApp() {
+			_, err = s.deleteCreator.ExecContext(ctx, aidx, basics.AppCreatable)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	_, err = s.deleteRsc.ExecContext(ctx, rowID, aidx)
+	return err
+}
+
+// WriteCatchpointStagingKVDeltas applies the provided KV deltas onto the catchpoint kvstore staging table catchpointkvstore.
+// A nil value marks a deleted key. As with WriteCatchpointStagingAccountDeltas, the pending hashes of the replaced KVs are
+// removed from catchpointpendinghashes.
+func (cw *catchpointWriter) WriteCatchpointStagingKVDeltas(ctx context.Context, keys [][]byte, values [][]byte, hashes [][]byte) error {
+	selectKV, err := cw.e.PrepareContext(ctx, "SELECT value FROM catchpointkvstore WHERE key = ?")
+	if err != nil {
+		return err
+	}
+	defer selectKV.Close()
+
+	deleteKV, err := cw.e.PrepareContext(ctx, "DELETE FROM catchpointkvstore WHERE key = ?")
+	if err != nil {
+		return err
+	}
+	defer deleteKV.Close()
+
+	insertKV, err := cw.e.PrepareContext(ctx, "INSERT INTO catchpointkvstore(key, value) VALUES(?, ?)")
+	if err != nil {
+		return err
+	}
+	defer insertKV.Close()
+
+	deleteHash, err := cw.e.PrepareContext(ctx, "DELETE FROM catchpointpendinghashes WHERE data = ?")
+	if err != nil {
+		return err
+	}
+	defer deleteHash.Close()
+
+	insertHash, err := cw.e.PrepareContext(ctx, "INSERT INTO catchpointpendinghashes(data) VALUES(?)")
+	if err != nil {
+		return err
+	}
+	defer insertHash.Close()
+
+	for i := 0; i < len(keys); i++ {
+		var oldValue []byte
+		err = selectKV.QueryRowContext(ctx, keys[i]).Scan(&oldValue)
+		switch err {
+		case nil:
+			_, err = deleteHash.ExecContext(ctx, trackerdb.KvHashBuilderV6(string(keys[i]), oldValue))
+			if err != nil {
+				return err
+			}
+			_, err = deleteKV.ExecContext(ctx, keys[i])
+			if err != nil {
+				return err
+			}
+		case sql.ErrNoRows:
+		default:
+			return err
+		}
+
+		if values[i] == nil {
+			continue
+		}
+		_, err = insertKV.ExecContext(ctx, keys[i], values[i])
+		if err != nil {
+			return err
+		}
+		_, err = insertHash.ExecContext(ctx, hashes[i])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ResetCatchpointStagingOnlineData clears the online accounts, online round params and state proof verification
+// staging tables, which delta catchpoints replace as a whole.
+func (cw *catchpointWriter) ResetCatchpointStagingOnlineData(ctx context.Context) error {
+	s := []string{
+		"DELETE FROM catchpointonlineaccounts",
+		"DELETE FROM catchpointonlineroundparamstail",
+		"DELETE FROM catchpointstateproofverification",
+	}
+	for _, stmt := range s {
+		_, err := cw.e.ExecContext(ctx, stmt)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ResetCatchpointStagingAccountHashes clears the staging merkle trie, so it could be built again out of the pending
+// hashes once a catchpoint delta was applied.
+func (cw *catchpointWriter) ResetCatchpointStagingAccountHashes(ctx context.Context) error {
+	_, err := cw.e.ExecContext(ctx, "DELETE FROM catchpointaccounthashes")
+	return err
+}
+
 func (cw *catchpointWriter) ResetCatchpointStagingBalances(ctx context.Context, newCatchup bool) (err error) {
 	s := []string{
 		"DROP TABLE IF EXISTS catchpointbalances",
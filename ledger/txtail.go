@@ -382,6 +382,46 @@ func (t *txTail) checkDup(proto config.ConsensusParams, current basics.Round, fi
 	return nil
 }
 
+// checkDupAsOf is like checkDup, but only considers the transactions confirmed in rounds up to asOf, as
+// if the ledger had not moved past asOf. It returns errTxTailMissingRound when the tail no longer holds
+// what would be needed to tell.
+func (t *txTail) checkDupAsOf(asOf basics.Round, proto config.ConsensusParams, current basics.Round, firstValid basics.Round, lastValid basics.Round, txid transactions.Txid, txl ledgercore.Txlease) error {
+	t.tailMu.RLock()
+	defer t.tailMu.RUnlock()
+
+	if lastValid < t.lowWaterMark {
+		return &errTxTailMissingRound{round: lastValid}
+	}
+
+	if proto.SupportTransactionLeases && (txl.Lease != [32]byte{}) {
+		firstChecked := firstValid
+		lastChecked := lastValid
+		if proto.FixTransactionLeases {
+			firstChecked = current.SubSaturate(basics.Round(proto.MaxTxnLife))
+			lastChecked = current
+		}
+		if lastChecked > asOf {
+			lastChecked = asOf
+		}
+
+		for rnd := firstChecked; rnd <= lastChecked; rnd++ {
+			leases, ok := t.recent[rnd]
+			if !ok && rnd != 0 {
+				return &errTxTailMissingRound{round: rnd}
+			}
+			expires, ok := leases.txleases[txl]
+			if ok && current <= expires {
+				return ledgercore.MakeLeaseInLedgerError(txid, txl, false)
+			}
+		}
+	}
+
+	if deltaR, confirmed := t.lastValid[lastValid][txid]; confirmed && lastValid-basics.Round(deltaR) <= asOf {
+		return &ledgercore.TransactionInLedgerError{Txid: txid, InBlockEvaluator: false}
+	}
+	return nil
+}
+
 // checkConfirmed test to see if the given transaction id already exists.
 func (t *txTail) checkConfirmed(txid transactions.Txid) (basics.Round, bool) {
 	t.tailMu.RLock()
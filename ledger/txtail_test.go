@@ -109,6 +109,64 @@ func TestTxTailCheckdup(t *testing.T) {
 	}
 }
 
+func TestTxTailCheckDupAsOf(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	accts := ledgertesting.RandomAccounts(10, false)
+	ledger := makeMockLedgerForTracker(t, true, 1, protocol.ConsensusCurrentVersion, []map[basics.Address]basics.AccountData{accts})
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+	tail := txTail{}
+	require.NoError(t, tail.loadFromDisk(ledger, 0))
+
+	const lastRound = basics.Round(50)
+	txvalidity := basics.Round(10)
+	leasevalidity := basics.Round(32)
+	txid := func(rnd basics.Round) transactions.Txid {
+		return transactions.Transaction{Header: transactions.Header{Note: []byte{byte(rnd), 1}}}.ID()
+	}
+	lease := func(rnd basics.Round) ledgercore.Txlease {
+		return ledgercore.Txlease{Sender: basics.Address(crypto.Hash([]byte{byte(rnd), 2})), Lease: crypto.Hash([]byte{byte(rnd), 3})}
+	}
+	for rnd := basics.Round(1); rnd <= lastRound; rnd++ {
+		blk := bookkeeping.Block{
+			BlockHeader: bookkeeping.BlockHeader{
+				Round: rnd,
+				UpgradeState: bookkeeping.UpgradeState{
+					CurrentProtocol: protocol.ConsensusCurrentVersion,
+				},
+			},
+			Payset: make(transactions.Payset, 1),
+		}
+		blk.Payset[0].Txn.Note = []byte{byte(rnd), 1}
+		delta := ledgercore.MakeStateDelta(&blk.BlockHeader, 0, 1, 0)
+		delta.Txids[txid(rnd)] = ledgercore.IncludedTransactions{LastValid: rnd + txvalidity, Intra: 0}
+		delta.AddTxLease(lease(rnd), rnd+leasevalidity)
+		tail.newBlock(blk, delta)
+	}
+
+	// a transaction confirmed after the round the check is made as of is not a duplicate
+	confirmed := basics.Round(30)
+	require.NoError(t, tail.checkDupAsOf(confirmed-1, proto, confirmed, 0, confirmed+txvalidity, txid(confirmed), ledgercore.Txlease{}))
+	var txInLedgerErr *ledgercore.TransactionInLedgerError
+	err := tail.checkDupAsOf(confirmed, proto, confirmed+1, 0, confirmed+txvalidity, txid(confirmed), ledgercore.Txlease{})
+	require.ErrorAs(t, err, &txInLedgerErr)
+
+	// and neither is a lease taken after that round
+	require.NoError(t, tail.checkDupAsOf(confirmed-1, proto, confirmed, 0, confirmed, transactions.Txid{}, lease(confirmed)))
+	var leaseInLedgerErr *ledgercore.LeaseInLedgerError
+	err = tail.checkDupAsOf(confirmed, proto, confirmed+1, 0, confirmed+1, transactions.Txid{}, lease(confirmed))
+	require.ErrorAs(t, err, &leaseInLedgerErr)
+
+	// when the rounds that need to be checked are gone, the check reports it rather than passing
+	delete(tail.recent, 10)
+	var missingRoundErr *errTxTailMissingRound
+	err = tail.checkDupAsOf(confirmed-1, proto, confirmed, 0, confirmed, transactions.Txid{}, lease(confirmed))
+	require.ErrorAs(t, err, &missingRoundErr)
+	tail.committedUpTo(lastRound)
+	err = tail.checkDupAsOf(confirmed-1, proto, confirmed, 0, confirmed+txvalidity, txid(confirmed), ledgercore.Txlease{})
+	require.ErrorAs(t, err, &missingRoundErr)
+}
+
 type txTailTestLedger struct {
 	Ledger
 	protoVersion protocol.ConsensusVersion
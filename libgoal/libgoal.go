@@ -780,6 +780,16 @@ func (c *Client) GetApplicationBoxByName(index basics.AppIndex, name string) (re
 	return
 }
 
+// DecodeApplicationBoxByName is GetApplicationBoxByName with the box value also
+// decoded by algod as abiType, an ABI or AVM type.
+func (c *Client) DecodeApplicationBoxByName(index basics.AppIndex, name string, abiType string) (resp model.BoxResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.DecodeApplicationBoxByName(index, name, abiType)
+	}
+	return
+}
+
 // PendingTransactionInformation returns information about a recently issued
 // transaction based on its txid.
 func (c *Client) PendingTransactionInformation(txid string) (resp model.PendingTransactionResponse, err error) {
@@ -1127,6 +1137,16 @@ func (c *Client) Catchup(catchpointLabel string, min uint64) (model.CatchpointSt
 	return algod.Catchup(catchpointLabel, min)
 }
 
+// CatchupFromFile start catching up to the give catchpoint label, loading the ledger from a catchpoint file
+// on the node's file system.
+func (c *Client) CatchupFromFile(catchpointLabel string, min uint64, catchpointFile string) (model.CatchpointStartResponse, error) {
+	algod, err := c.ensureAlgodClient()
+	if err != nil {
+		return model.CatchpointStartResponse{}, err
+	}
+	return algod.CatchupFromFile(catchpointLabel, min, catchpointFile)
+}
+
 const defaultAppIdx = 1380011588
 
 // MakeDryrunStateBytes function creates DryrunRequest data structure in serialized form according to the format
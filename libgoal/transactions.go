@@ -51,6 +51,32 @@ func (c *Client) SignTransactionWithWallet(walletHandle, pw []byte, utx transact
 	return
 }
 
+// SignTransactionGroupWithWallet signs each transaction of a group which is
+// authorized by a key or multisig account of the wallet, adding to any partial
+// multisig signatures, and returns the whole group
+func (c *Client) SignTransactionGroupWithWallet(walletHandle, pw []byte, stxns []transactions.SignedTxn) (signed []transactions.SignedTxn, err error) {
+	kmd, err := c.ensureKmdClient()
+	if err != nil {
+		return
+	}
+
+	// Sign the group
+	resp, err := kmd.SignTransactionGroup(walletHandle, pw, stxns)
+	if err != nil {
+		return
+	}
+
+	// Decode the SignedTxns
+	signed = make([]transactions.SignedTxn, len(resp.SignedTransactions))
+	for i := range resp.SignedTransactions {
+		err = protocol.Decode(resp.SignedTransactions[i], &signed[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return
+}
+
 // SignTransactionWithWalletAndSigner signs the passed transaction under a specific signer (which may differ from the sender's address). This is necessary after an account has been rekeyed.
 // If signerAddr is the empty string, just infer spending key from the sender address.
 func (c *Client) SignTransactionWithWalletAndSigner(walletHandle, pw []byte, signerAddr string, utx transactions.Transaction) (stx transactions.SignedTxn, err error) {
@@ -45,6 +45,25 @@ func (c *Client) CreateWallet(name []byte, password []byte, mdk crypto.MasterDer
 	return []byte(resp.Wallet.ID), nil
 }
 
+// CreateHDWallet creates a kmd wallet which derives its keys by BIP32-Ed25519
+// path in the given account, from the BIP39 mnemonic whose entropy is the
+// first entropyLen bytes of mdk, or a new one if generate is set, and from
+// bip39Passphrase
+func (c *Client) CreateHDWallet(name []byte, password []byte, mdk crypto.MasterDerivationKey, generate bool, entropyLen int, bip39Passphrase string, account uint32) ([]byte, error) {
+	kmd, err := c.ensureKmdClient()
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the wallet
+	resp, err := kmd.CreateHDWallet(name, defaultWalletDriver, password, mdk, generate, entropyLen, bip39Passphrase, account)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(resp.Wallet.ID), nil
+}
+
 // RenameWallet renames a kmd wallet
 func (c *Client) RenameWallet(wid []byte, name []byte, password []byte) error {
 	// Pull the list of all wallets from kmd
@@ -222,6 +241,38 @@ func (c *Client) FindWalletNameByID(wid []byte) (name []byte, duplicate bool, er
 	return
 }
 
+// BackupWallet returns a bundle of everything needed to restore the given
+// wallet, encrypted with backupPw
+func (c *Client) BackupWallet(wh []byte, pw []byte, backupPw []byte) ([]byte, error) {
+	kmd, err := c.ensureKmdClient()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := kmd.BackupWallet(wh, pw, backupPw)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Backup, nil
+}
+
+// RestoreWallet restores the wallet of a bundle made by BackupWallet, named
+// name if it is not blank, and returns its ID
+func (c *Client) RestoreWallet(name []byte, password []byte, backup []byte, backupPw []byte) ([]byte, error) {
+	kmd, err := c.ensureKmdClient()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := kmd.RestoreWallet(name, defaultWalletDriver, password, backup, backupPw)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(resp.Wallet.ID), nil
+}
+
 // ExportMasterDerivationKey returns the master derivation key from the given wallet
 func (c *Client) ExportMasterDerivationKey(wh []byte, pw []byte) (mdk crypto.MasterDerivationKey, err error) {
 	kmd, err := c.ensureKmdClient()
@@ -34,6 +34,7 @@ import (
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/ledger/blockstream"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/ledger/simulation"
 	"github.com/algorand/go-algorand/logging"
@@ -53,6 +54,9 @@ type AlgorandFollowerNode struct {
 	ledger *data.Ledger
 	net    network.GossipNode
 
+	simulationSessions *simulation.SessionRegistry
+	blockStream        *blockstream.Stream
+
 	catchupService           *catchup.Service
 	catchpointCatchupService *catchup.CatchpointCatchupService
 	blockService             *rpcs.BlockService
@@ -125,8 +129,10 @@ func MakeFollower(log logging.Logger, rootDir string, cfg config.Local, phoneboo
 		log.Errorf("Cannot initialize ledger (%v): %v", ledgerPaths, err)
 		return nil, err
 	}
+	node.simulationSessions = simulation.MakeSessionRegistry(node.ledger, cfg.EnableDeveloperAPI)
+	node.blockStream = blockstream.MakeStream()
 
-	node.ledger.RegisterBlockListeners([]ledgercore.BlockListener{node})
+	node.ledger.RegisterBlockListeners([]ledgercore.BlockListener{node, node.blockStream})
 
 	if cfg.IsGossipServer() {
 		rpcs.MakeHealthService(node.net)
@@ -259,6 +265,16 @@ func (node *AlgorandFollowerNode) Simulate(request simulation.Request) (result s
 	return simulator.Simulate(request)
 }
 
+// SimulationSessions returns the simulation sessions started on this node.
+func (node *AlgorandFollowerNode) SimulationSessions() *simulation.SessionRegistry {
+	return node.simulationSessions
+}
+
+// BlockStream returns the stream of the blocks committed to this node's ledger.
+func (node *AlgorandFollowerNode) BlockStream() *blockstream.Stream {
+	return node.blockStream
+}
+
 // GetPendingTransaction no-ops in follower mode
 func (node *AlgorandFollowerNode) GetPendingTransaction(_ transactions.Txid) (res TxnWithStatus, found bool) {
 	return
@@ -343,9 +359,14 @@ func (node *AlgorandFollowerNode) OnNewBlock(block bookkeeping.Block, _ ledgerco
 	node.syncStatusMu.Unlock()
 }
 
-// StartCatchup starts the catchpoint mode and attempt to get to the provided catchpoint
-// this function is intended to be called externally via the REST api interface.
-func (node *AlgorandFollowerNode) StartCatchup(catchpoint string) error {
+// CatchpointImportDir returns the directory holding the catchpoint files StartCatchup may load the ledger from.
+func (node *AlgorandFollowerNode) CatchpointImportDir() string {
+	return node.config.ResolveCatchpointImportDir(node.genesisDirs.RootGenesisDir)
+}
+
+// StartCatchup starts the catchpoint mode and attempt to get to the provided catchpoint, loading the ledger from the
+// provided local catchpoint file if any. this function is intended to be called externally via the REST api interface.
+func (node *AlgorandFollowerNode) StartCatchup(catchpoint string, catchpointFile string) error {
 	node.mu.Lock()
 	defer node.mu.Unlock()
 	if node.catchpointCatchupService != nil {
@@ -358,7 +379,7 @@ func (node *AlgorandFollowerNode) StartCatchup(catchpoint string) error {
 	}
 	var err error
 	accessor := ledger.MakeCatchpointCatchupAccessor(node.ledger.Ledger, node.log)
-	node.catchpointCatchupService, err = catchup.MakeNewCatchpointCatchupService(catchpoint, node, node.log, node.net, accessor, node.config)
+	node.catchpointCatchupService, err = catchup.MakeNewCatchpointCatchupService(catchpoint, catchpointFile, node, node.log, node.net, accessor, node.config)
 	if err != nil {
 		node.log.Warnf("unable to create catchpoint catchup service : %v", err)
 		return err
@@ -368,7 +389,11 @@ func (node *AlgorandFollowerNode) StartCatchup(catchpoint string) error {
 		node.log.Warn(err.Error())
 		return MakeStartCatchpointError(catchpoint, err)
 	}
-	node.log.Infof("starting catching up toward catchpoint %s", catchpoint)
+	if catchpointFile != "" {
+		node.log.Infof("starting catching up toward catchpoint %s from catchpoint file %s", catchpoint, catchpointFile)
+	} else {
+		node.log.Infof("starting catching up toward catchpoint %s", catchpoint)
+	}
 	return nil
 }
 
@@ -46,6 +46,7 @@ import (
 	"github.com/algorand/go-algorand/data/transactions/verify"
 	"github.com/algorand/go-algorand/heartbeat"
 	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/ledger/blockstream"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/ledger/simulation"
 	"github.com/algorand/go-algorand/logging"
@@ -120,6 +121,9 @@ type AlgorandFullNode struct {
 	ledger *data.Ledger
 	net    network.GossipNode
 
+	simulationSessions *simulation.SessionRegistry
+	blockStream        *blockstream.Stream
+
 	transactionPool *pools.TransactionPool
 	txHandler       *data.TxHandler
 	accountManager  *data.AccountManager
@@ -261,6 +265,7 @@ recreateNetwork:
 		log.Errorf("Cannot initialize ledger (%v): %v", ledgerPaths, err)
 		return nil, err
 	}
+	node.simulationSessions = simulation.MakeSessionRegistry(node.ledger, cfg.EnableDeveloperAPI)
 
 	registry, err := ensureParticipationDB(node.genesisDirs.ColdGenesisDir, node.log)
 	if err != nil {
@@ -279,7 +284,8 @@ recreateNetwork:
 
 	node.transactionPool = pools.MakeTransactionPool(node.ledger.Ledger, cfg, node.log, node)
 
-	node.ledger.RegisterBlockListeners([]ledgercore.BlockListener{node.transactionPool, node})
+	node.blockStream = blockstream.MakeStream()
+	node.ledger.RegisterBlockListeners([]ledgercore.BlockListener{node.transactionPool, node, node.blockStream})
 	txHandlerOpts := data.TxHandlerOpts{
 		TxPool:        node.transactionPool,
 		ExecutionPool: node.lowPriorityCryptoVerificationPool,
@@ -669,6 +675,16 @@ func (node *AlgorandFullNode) Simulate(request simulation.Request) (result simul
 	return simulator.Simulate(request)
 }
 
+// SimulationSessions returns the simulation sessions started on this node.
+func (node *AlgorandFullNode) SimulationSessions() *simulation.SessionRegistry {
+	return node.simulationSessions
+}
+
+// BlockStream returns the stream of the blocks committed to this node's ledger.
+func (node *AlgorandFullNode) BlockStream() *blockstream.Stream {
+	return node.blockStream
+}
+
 // GetPendingTransaction looks for the required txID in the recent ledger
 // blocks, in the txpool, and in the txpool's status cache.  It returns
 // the SignedTxn (with status information), and a bool to indicate if the
@@ -1196,9 +1212,14 @@ func (node *AlgorandFullNode) Uint64() uint64 {
 	return crypto.RandUint64()
 }
 
-// StartCatchup starts the catchpoint mode and attempt to get to the provided catchpoint
-// this function is intended to be called externally via the REST api interface.
-func (node *AlgorandFullNode) StartCatchup(catchpoint string) error {
+// CatchpointImportDir returns the directory holding the catchpoint files StartCatchup may load the ledger from.
+func (node *AlgorandFullNode) CatchpointImportDir() string {
+	return node.config.ResolveCatchpointImportDir(node.genesisDirs.RootGenesisDir)
+}
+
+// StartCatchup starts the catchpoint mode and attempt to get to the provided catchpoint, loading the ledger from the
+// provided local catchpoint file if any. this function is intended to be called externally via the REST api interface.
+func (node *AlgorandFullNode) StartCatchup(catchpoint string, catchpointFile string) error {
 	node.mu.Lock()
 	defer node.mu.Unlock()
 	if node.config.Archival {
@@ -1214,7 +1235,7 @@ func (node *AlgorandFullNode) StartCatchup(catchpoint string) error {
 	}
 	var err error
 	accessor := ledger.MakeCatchpointCatchupAccessor(node.ledger.Ledger, node.log)
-	node.catchpointCatchupService, err = catchup.MakeNewCatchpointCatchupService(catchpoint, node, node.log, node.net, accessor, node.config)
+	node.catchpointCatchupService, err = catchup.MakeNewCatchpointCatchupService(catchpoint, catchpointFile, node, node.log, node.net, accessor, node.config)
 	if err != nil {
 		node.log.Warnf("unable to create catchpoint catchup service : %v", err)
 		return err
@@ -1224,7 +1245,11 @@ func (node *AlgorandFullNode) StartCatchup(catchpoint string) error {
 		node.log.Warn(err.Error())
 		return MakeStartCatchpointError(catchpoint, err)
 	}
-	node.log.Infof("starting catching up toward catchpoint %s", catchpoint)
+	if catchpointFile != "" {
+		node.log.Infof("starting catching up toward catchpoint %s from catchpoint file %s", catchpoint, catchpointFile)
+	} else {
+		node.log.Infof("starting catching up toward catchpoint %s", catchpoint)
+	}
 	return nil
 }
 
@@ -34,6 +34,7 @@ import (
 	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
 )
 
 const (
@@ -46,6 +47,23 @@ const (
 	// e.g. .Handle(LedgerServiceLedgerPath, &ls)
 	LedgerServiceLedgerPath = "/v{version:[0-9.]+}/{genesisID}/ledger/{round:[0-9a-z]+}"
 
+	// LedgerServiceManifestPath is the path serving the chunk manifest of the catchpoint file served at LedgerServiceLedgerPath
+	LedgerServiceManifestPath = LedgerServiceLedgerPath + ledgerServiceManifestSuffix
+
+	// LedgerManifestResponseContentType is the HTTP Content-Type header for a catchpoint file manifest
+	LedgerManifestResponseContentType = "application/x-algorand-catchpoint-manifest-v1"
+
+	ledgerServiceManifestSuffix = "/manifest"
+
+	// LedgerServiceDeltaPath is the path serving the catchpoint delta file, describing the changes between the previous
+	// catchpoint and the catchpoint file served at LedgerServiceLedgerPath
+	LedgerServiceDeltaPath = LedgerServiceLedgerPath + ledgerServiceDeltaSuffix
+
+	// LedgerDeltaResponseContentType is the HTTP Content-Type header for a gzip compressed catchpoint delta file
+	LedgerDeltaResponseContentType = "application/x-algorand-catchpoint-delta-v1"
+
+	ledgerServiceDeltaSuffix = "/delta"
+
 	// maxCatchpointFileSize is the default catchpoint file size, if we can't get a concreate number from the ledger.
 	maxCatchpointFileSize = 512 * 1024 * 1024 // 512MB
 
@@ -57,6 +75,10 @@ const (
 type LedgerForService interface {
 	// GetCatchpointStream returns the ReadCloseSize for a request catchpoint round
 	GetCatchpointStream(round basics.Round) (ledger.ReadCloseSizer, error)
+	// GetCatchpointManifest returns the chunk manifest of the catchpoint file for a requested round
+	GetCatchpointManifest(round basics.Round) (ledger.CatchpointManifest, error)
+	// GetCatchpointDeltaStream returns the ReadCloseSize for the catchpoint delta file of a requested round
+	GetCatchpointDeltaStream(round basics.Round) (ledger.ReadCloseSizer, error)
 }
 
 // httpGossipNode is a reduced interface for the gossipNode that only includes the methods needed by the LedgerService
@@ -86,6 +108,8 @@ func MakeLedgerService(config config.Local, ledger LedgerForService, net httpGos
 	// the underlying gorilla/mux doesn't support "unregister", so we're forced to implement it ourselves.
 	if service.enableService {
 		net.RegisterHTTPHandler(LedgerServiceLedgerPath, service)
+		net.RegisterHTTPHandler(LedgerServiceManifestPath, service)
+		net.RegisterHTTPHandler(LedgerServiceDeltaPath, service)
 	}
 	return service
 }
@@ -110,6 +134,8 @@ func (ls *LedgerService) Stop() {
 
 // ServeHTTP returns ledgers for a particular round
 // Either /v{version}/{genesisID}/ledger/{round} or ?r={round}&v={version}
+// The chunk manifest of the ledger is served at /v{version}/{genesisID}/ledger/{round}/manifest,
+// and requests carrying a Range header are served byte ranges of the stored (compressed) file.
 // Uses gorilla/mux for path argument parsing.
 func (ls *LedgerService) ServeHTTP(response http.ResponseWriter, request *http.Request) {
 	ls.stopping.Add(1)
@@ -185,6 +211,14 @@ func (ls *LedgerService) ServeHTTP(response http.ResponseWriter, request *http.R
 		response.Write([]byte(fmt.Sprintf("specified round number could not be parsed using base 36 : %v", err)))
 		return
 	}
+	if strings.HasSuffix(request.URL.Path, ledgerServiceManifestSuffix) {
+		ls.serveManifest(response, basics.Round(round))
+		return
+	}
+	if strings.HasSuffix(request.URL.Path, ledgerServiceDeltaSuffix) {
+		ls.serveDelta(response, request, basics.Round(round))
+		return
+	}
 	logging.Base().Infof("LedgerService.ServeHTTP: serving catchpoint round %d", round)
 	start := time.Now()
 	cs, err := ls.ledger.GetCatchpointStream(basics.Round(round))
@@ -222,6 +256,16 @@ func (ls *LedgerService) ServeHTTP(response http.ResponseWriter, request *http.R
 		logging.Base().Warnf("LedgerService.ServeHTTP unable to set connection timeout")
 	}
 
+	if request.Header.Get("Range") != "" {
+		if seeker, ok := cs.(io.ReadSeeker); ok {
+			// ranges address the stored file, so that downloads could be resumed or split across peers.
+			http.ServeContent(response, request, "", time.Time{}, seeker)
+			elapsed := time.Since(start)
+			logging.Base().Infof("LedgerService.ServeHTTP: served catchpoint round %d range %s in %d sec", round, request.Header.Get("Range"), int(elapsed.Seconds()))
+			return
+		}
+	}
+
 	requestedCompressedResponse := strings.Contains(request.Header.Get("Accept-Encoding"), "gzip")
 	if requestedCompressedResponse {
 		response.Header().Set("Content-Encoding", "gzip")
@@ -249,3 +293,54 @@ func (ls *LedgerService) ServeHTTP(response http.ResponseWriter, request *http.R
 		logging.Base().Infof("LedgerService.ServeHTTP: served catchpoint round %d in %d sec", round, int(elapsed.Seconds()))
 	}
 }
+
+// serveManifest writes the chunk manifest of the catchpoint file for the given round.
+func (ls *LedgerService) serveManifest(response http.ResponseWriter, round basics.Round) {
+	manifest, err := ls.ledger.GetCatchpointManifest(round)
+	if err != nil {
+		switch err.(type) {
+		case ledgercore.ErrNoEntry:
+			response.WriteHeader(http.StatusNotFound)
+			response.Write([]byte(fmt.Sprintf("catchpoint manifest for round %d is not available", round)))
+		default:
+			logging.Base().Warnf("LedgerService.ServeHTTP : failed to retrieve catchpoint manifest %d %v", round, err)
+			response.WriteHeader(http.StatusInternalServerError)
+			response.Write([]byte(fmt.Sprintf("catchpoint manifest for round %d could not be retrieved due to internal error : %v", round, err)))
+		}
+		return
+	}
+	response.Header().Set("Content-Type", LedgerManifestResponseContentType)
+	response.WriteHeader(http.StatusOK)
+	response.Write(protocol.EncodeReflect(&manifest))
+}
+
+// serveDelta writes the stored catchpoint delta file as is. Delta files are small, so unlike catchpoint files they are
+// always served compressed, and it's up to the client to decompress them.
+func (ls *LedgerService) serveDelta(response http.ResponseWriter, request *http.Request, round basics.Round) {
+	cs, err := ls.ledger.GetCatchpointDeltaStream(round)
+	if err != nil {
+		switch err.(type) {
+		case ledgercore.ErrNoEntry:
+			response.WriteHeader(http.StatusNotFound)
+			response.Write([]byte(fmt.Sprintf("catchpoint delta file for round %d is not available", round)))
+		default:
+			logging.Base().Warnf("LedgerService.ServeHTTP : failed to retrieve catchpoint delta %d %v", round, err)
+			response.WriteHeader(http.StatusInternalServerError)
+			response.Write([]byte(fmt.Sprintf("catchpoint delta file for round %d could not be retrieved due to internal error : %v", round, err)))
+		}
+		return
+	}
+	defer cs.Close()
+	response.Header().Set("Content-Type", LedgerDeltaResponseContentType)
+	if size, err := cs.Size(); err == nil {
+		response.Header().Set("Content-Length", strconv.FormatInt(size, 10))
+	}
+	response.WriteHeader(http.StatusOK)
+	if request.Method == http.MethodHead {
+		return
+	}
+	written, err := io.Copy(response, cs)
+	if err != nil {
+		logging.Base().Infof("LedgerService.ServeHTTP : unable to write catchpoint delta file for round %d, written bytes %d : %v", round, written, err)
+	}
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/partitiontest"
 )
 
@@ -59,6 +60,16 @@ func (fledger *fakeLedger) GetCatchpointStream(round basics.Round) (ledger.ReadC
 	return args.Get(0).(ledger.ReadCloseSizer), args.Error(1)
 }
 
+func (fledger *fakeLedger) GetCatchpointManifest(round basics.Round) (ledger.CatchpointManifest, error) {
+	args := fledger.Called(round)
+	return args.Get(0).(ledger.CatchpointManifest), args.Error(1)
+}
+
+func (fledger *fakeLedger) GetCatchpointDeltaStream(round basics.Round) (ledger.ReadCloseSizer, error) {
+	args := fledger.Called(round)
+	return args.Get(0).(ledger.ReadCloseSizer), args.Error(1)
+}
+
 type readCloseSizer struct {
 	io.ReadCloser
 	*mock.Mock
@@ -97,8 +108,12 @@ func TestLedgerService(t *testing.T) {
 	// Test LedgerService enabled
 	cfg.EnableLedgerService = true
 	fnet.On("RegisterHTTPHandler", LedgerServiceLedgerPath, mock.Anything).Return()
+	fnet.On("RegisterHTTPHandler", LedgerServiceManifestPath, mock.Anything).Return()
+	fnet.On("RegisterHTTPHandler", LedgerServiceDeltaPath, mock.Anything).Return()
 	ledgerService = MakeLedgerService(cfg, &l, &fnet, genesisID)
 	fnet.AssertCalled(t, "RegisterHTTPHandler", LedgerServiceLedgerPath, ledgerService)
+	fnet.AssertCalled(t, "RegisterHTTPHandler", LedgerServiceManifestPath, ledgerService)
+	fnet.AssertCalled(t, "RegisterHTTPHandler", LedgerServiceDeltaPath, ledgerService)
 	ledgerService.Start()
 	require.Equal(t, int32(1), ledgerService.running.Load())
 
@@ -202,6 +217,14 @@ func (l *mockLedgerForService) GetCatchpointStream(round basics.Round) (ledger.R
 	return mockSizedStream{buf2}, nil
 }
 
+func (l *mockLedgerForService) GetCatchpointManifest(round basics.Round) (ledger.CatchpointManifest, error) {
+	return ledger.CatchpointManifest{}, ledgercore.ErrNoEntry{Round: round}
+}
+
+func (l *mockLedgerForService) GetCatchpointDeltaStream(round basics.Round) (ledger.ReadCloseSizer, error) {
+	return nil, ledgercore.ErrNoEntry{Round: round}
+}
+
 // TestLedgerServiceP2P creates a ledger service on a node, and a p2p client tries to download
 // an empty catchpoint file from the ledger service.
 func TestLedgerServiceP2P(t *testing.T) {
@@ -231,3 +254,116 @@ func TestLedgerServiceP2P(t *testing.T) {
 
 	require.Equal(t, http.StatusOK, resp.StatusCode)
 }
+
+type seekableSizedStream struct {
+	*bytes.Reader
+}
+
+func (sss seekableSizedStream) Size() (int64, error) {
+	return sss.Reader.Size(), nil
+}
+
+func (sss seekableSizedStream) Close() error {
+	return nil
+}
+
+func TestLedgerServiceRangeAndManifest(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	genesisID := "testGenesisID"
+	cfg := config.GetDefaultLocal()
+	cfg.EnableLedgerService = true
+	l := fakeLedger{Mock: &mock.Mock{}}
+	fnet := fakeNetwork{router: mux.NewRouter(), Mock: &mock.Mock{}}
+	fnet.On("RegisterHTTPHandler", mock.Anything, mock.Anything).Return()
+	ledgerService := MakeLedgerService(cfg, &l, &fnet, genesisID)
+	ledgerService.Start()
+	defer ledgerService.Stop()
+
+	content := []byte("0123456789abcdefghij")
+	l.On("GetCatchpointStream", basics.Round(36)).Return(seekableSizedStream{bytes.NewReader(content)}, nil)
+
+	// a range request is served from the stored file as is
+	rr := httptest.NewRecorder()
+	req, err := http.NewRequest("GET", fmt.Sprintf("/v1/%s/ledger/10", genesisID), nil)
+	require.NoError(t, err)
+	req.Header.Set("Range", "bytes=5-9")
+	fnet.router.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusPartialContent, rr.Code)
+	require.Equal(t, LedgerResponseContentType, rr.Header().Get("Content-Type"))
+	require.Equal(t, "bytes 5-9/20", rr.Header().Get("Content-Range"))
+	require.Equal(t, content[5:10], rr.Body.Bytes())
+
+	// a stream that cannot seek ignores the range and serves the whole file
+	rcs := readCloseSizer{ReadCloser: io.NopCloser(bytes.NewReader(content)), Mock: &mock.Mock{}}
+	rcs.On("Size").Return(len(content), nil)
+	l.On("GetCatchpointStream", basics.Round(37)).Return(rcs, nil)
+	rr = httptest.NewRecorder()
+	req, err = http.NewRequest("GET", fmt.Sprintf("/v1/%s/ledger/11", genesisID), nil)
+	require.NoError(t, err)
+	req.Header.Set("Range", "bytes=5-9")
+	req.Header.Set("Accept-Encoding", "gzip")
+	fnet.router.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Equal(t, content, rr.Body.Bytes())
+
+	// manifest not available
+	rr = httptest.NewRecorder()
+	req, err = http.NewRequest("GET", fmt.Sprintf("/v1/%s/ledger/10/manifest", genesisID), nil)
+	require.NoError(t, err)
+	gcm := l.On("GetCatchpointManifest", basics.Round(36)).Return(ledger.CatchpointManifest{}, ledgercore.ErrNoEntry{Round: basics.Round(36)})
+	fnet.router.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusNotFound, rr.Code)
+	require.Contains(t, rr.Body.String(), "catchpoint manifest for round 36 is not available")
+
+	// manifest available
+	manifest := ledger.CatchpointManifest{
+		Round:      36,
+		Catchpoint: "36#label",
+		FileSize:   int64(len(content)),
+		Chunks:     []ledger.CatchpointManifestChunk{{Name: "content.msgpack", Length: 20, Size: 30}},
+	}
+	gcm.Unset()
+	l.On("GetCatchpointManifest", basics.Round(36)).Return(manifest, nil)
+	rr = httptest.NewRecorder()
+	fnet.router.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Equal(t, LedgerManifestResponseContentType, rr.Header().Get("Content-Type"))
+	var decoded ledger.CatchpointManifest
+	require.NoError(t, protocol.DecodeReflect(rr.Body.Bytes(), &decoded))
+	require.Equal(t, manifest, decoded)
+}
+
+func TestLedgerServiceDelta(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	genesisID := "testGenesisID"
+	cfg := config.GetDefaultLocal()
+	cfg.EnableLedgerService = true
+	l := fakeLedger{Mock: &mock.Mock{}}
+	fnet := fakeNetwork{router: mux.NewRouter(), Mock: &mock.Mock{}}
+	fnet.On("RegisterHTTPHandler", mock.Anything, mock.Anything).Return()
+	ledgerService := MakeLedgerService(cfg, &l, &fnet, genesisID)
+	ledgerService.Start()
+	defer ledgerService.Stop()
+
+	// delta not available
+	rr := httptest.NewRecorder()
+	req, err := http.NewRequest("GET", fmt.Sprintf("/v1/%s/ledger/10/delta", genesisID), nil)
+	require.NoError(t, err)
+	gcd := l.On("GetCatchpointDeltaStream", basics.Round(36)).Return(seekableSizedStream{}, ledgercore.ErrNoEntry{Round: basics.Round(36)})
+	fnet.router.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusNotFound, rr.Code)
+	require.Contains(t, rr.Body.String(), "catchpoint delta file for round 36 is not available")
+
+	// delta available; it is served as stored, even when the client accepts gzip
+	content := []byte("compressed delta content")
+	gcd.Unset()
+	l.On("GetCatchpointDeltaStream", basics.Round(36)).Return(seekableSizedStream{bytes.NewReader(content)}, nil)
+	rr = httptest.NewRecorder()
+	req.Header.Set("Accept-Encoding", "gzip")
+	fnet.router.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Equal(t, LedgerDeltaResponseContentType, rr.Header().Get("Content-Type"))
+	require.Equal(t, strconv.Itoa(len(content)), rr.Header().Get("Content-Length"))
+	require.Empty(t, rr.Header().Get("Content-Encoding"))
+	require.Equal(t, content, rr.Body.Bytes())
+}
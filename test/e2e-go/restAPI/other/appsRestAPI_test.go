@@ -20,6 +20,7 @@ import (
 	"encoding/base64"
 	"encoding/binary"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"path/filepath"
 	"sort"
@@ -524,6 +525,17 @@ end:
 		// To reduce flakiness, only check the round from boxes is within a range.
 		a.GreaterOrEqual(boxResponse.Round, currentRoundBeforeBoxes)
 		a.LessOrEqual(boxResponse.Round, currentRoundAfterBoxes)
+		a.Nil(boxResponse.DecodedValue)
+
+		// The value may be decoded as an ABI type
+		boxResponse, err = testClient.DecodeApplicationBoxByName(createdAppID, boxTest.encodedName, "byte[5]")
+		a.NoError(err)
+		a.Equal(boxTest.value, boxResponse.Value)
+		decoded, err := json.Marshal(boxTest.value)
+		a.NoError(err)
+		a.JSONEq(string(decoded), string(boxResponse.DecodedValue))
+		_, err = testClient.DecodeApplicationBoxByName(createdAppID, boxTest.encodedName, "uint64")
+		a.ErrorContains(err, "failed to decode the box as uint64")
 	}
 
 	const numberOfBoxesRemaining = uint64(3)